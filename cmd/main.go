@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"net"
 	"os"
 	"os/signal"
@@ -13,6 +14,17 @@ import (
 )
 
 func main() {
+	migrate := flag.String("migrate", "", "run a migration command (up, down or status) and exit")
+	steps := flag.Int("steps", 1, "number of migrations reverted by -migrate down")
+	flag.Parse()
+
+	if *migrate != "" {
+		if err := app.NewMigrationRunner().Run(context.Background(), *migrate, *steps, os.Stdout); err != nil {
+			log.Fatal().Err(err).Msg("failed to run migrations")
+		}
+		return
+	}
+
 	listener, application := InitApplication()
 
 	server := application.Server()
@@ -59,10 +59,28 @@ func (initializer *applicationInitializer) mountAPIRoutes(router *chi.Mux) {
 		contextPathRouter.Route("/recipe/sourdough", func(sourdoughRecipeRouter chi.Router) {
 			initializer.mountSourdoughRecipeAPIRoutes(sourdoughRecipeRouter)
 			initializer.mountSourdoughRecipeScaleAPIRoutes(sourdoughRecipeRouter)
+			initializer.mountSourdoughRecipeSubstitutionAPIRoutes(sourdoughRecipeRouter)
+			initializer.mountSourdoughRecipeRevisionAPIRoutes(sourdoughRecipeRouter)
+			initializer.mountBakeLogAPIRoutes(sourdoughRecipeRouter)
+			initializer.mountImageAPIRoutes(sourdoughRecipeRouter)
+			initializer.mountRecipeBundleAPIRoutes(sourdoughRecipeRouter)
+			initializer.mountSourdoughRecipeParseAPIRoutes(sourdoughRecipeRouter)
+			initializer.mountRecipeJsonLdAPIRoutes(sourdoughRecipeRouter)
 		})
 		contextPathRouter.Route("/flour", func(flourRouter chi.Router) {
+			initializer.mountFlourTypeAPIRoutes(flourRouter)
+			initializer.mountHydrationAPIRoutes(flourRouter)
 			initializer.mountFlourAPIRoutes(flourRouter)
 		})
+		contextPathRouter.Route("/calculate", func(calculateRouter chi.Router) {
+			initializer.mountCalculatorAPIRoutes(calculateRouter)
+		})
+		contextPathRouter.Route("/production-plans", func(productionPlanRouter chi.Router) {
+			initializer.mountProductionPlanAPIRoutes(productionPlanRouter)
+		})
+		contextPathRouter.Route("/inventory", func(inventoryRouter chi.Router) {
+			initializer.mountInventoryAPIRoutes(inventoryRouter)
+		})
 	})
 
 }
@@ -71,39 +89,176 @@ func (initializer *applicationInitializer) mountSourdoughRecipeAPIRoutes(router
 	sourdoughRecipeHandler := initializer.dependencyManager.SourdoughRecipe().Router()
 
 	router.
-		With(httpin.NewInput(rest.PageInput{})).
+		With(httpin.NewInput(rest.FindRecipeInput{})).
 		Get("/", sourdoughRecipeHandler.Find())
 	router.Post("/", sourdoughRecipeHandler.Create())
+	router.Post("/formula", sourdoughRecipeHandler.CreateFromFormula())
 	router.Route("/{id}", func(idRouter chi.Router) {
 		idRouter.Get("/", sourdoughRecipeHandler.FindById())
+		idRouter.Put("/", sourdoughRecipeHandler.Update())
+		idRouter.Post("/fork", sourdoughRecipeHandler.Fork())
+		idRouter.Get("/family-tree", sourdoughRecipeHandler.FamilyTree())
 	})
 	router.
 		With(httpin.NewInput(rest.SearchRecipeInput{})).
 		Get("/search", sourdoughRecipeHandler.Search())
+	router.
+		With(httpin.NewInput(rest.TextSearchInput{})).
+		Get("/search/text", sourdoughRecipeHandler.TextSearch())
+}
+
+func (initializer *applicationInitializer) mountRecipeBundleAPIRoutes(router chi.Router) {
+	recipeBundleHandler := initializer.dependencyManager.RecipeBundle().Router()
+
+	router.
+		With(httpin.NewInput(rest.ExportRecipeBundleInput{})).
+		Get("/export", recipeBundleHandler.Export())
+	router.
+		With(httpin.NewInput(rest.ImportRecipeBundleInput{})).
+		Post("/import", recipeBundleHandler.Import())
 }
 
 func (initializer *applicationInitializer) mountSourdoughRecipeScaleAPIRoutes(router chi.Router) {
-	router.Post("/{id}/scale", initializer.dependencyManager.SourdoughRecipeScale().Router().Scale())
+	sourdoughRecipeScaleHandler := initializer.dependencyManager.SourdoughRecipeScale().Router()
+
+	router.Post("/{id}/scale", sourdoughRecipeScaleHandler.Scale())
+	router.Post("/scale/batch", sourdoughRecipeScaleHandler.ScaleBatch())
+}
+
+func (initializer *applicationInitializer) mountSourdoughRecipeSubstitutionAPIRoutes(router chi.Router) {
+	router.Post("/{id}/substitute", initializer.dependencyManager.SourdoughRecipeSubstitution().Router().Substitute())
+}
+
+func (initializer *applicationInitializer) mountSourdoughRecipeParseAPIRoutes(router chi.Router) {
+	router.Post("/parse", initializer.dependencyManager.SourdoughRecipeParse().Router().Parse())
+}
+
+func (initializer *applicationInitializer) mountRecipeJsonLdAPIRoutes(router chi.Router) {
+	recipeJsonLdHandler := initializer.dependencyManager.RecipeJsonLd().Router()
+
+	router.Get("/{id}/jsonld", recipeJsonLdHandler.Export())
+	router.Post("/import/jsonld", recipeJsonLdHandler.Import())
+}
+
+func (initializer *applicationInitializer) mountSourdoughRecipeRevisionAPIRoutes(router chi.Router) {
+	revisionHandler := initializer.dependencyManager.SourdoughRecipeRevision().Router()
+
+	router.Route("/{id}/revisions", func(revisionRouter chi.Router) {
+		revisionRouter.
+			With(httpin.NewInput(rest.PageInput{})).
+			Get("/", revisionHandler.FindByRecipeId())
+		revisionRouter.
+			With(httpin.NewInput(rest.RevisionDiffInput{})).
+			Get("/diff", revisionHandler.Diff())
+		revisionRouter.Get("/{version}", revisionHandler.FindByVersion())
+		revisionRouter.Post("/{version}/restore", revisionHandler.Restore())
+	})
+}
+
+func (initializer *applicationInitializer) mountBakeLogAPIRoutes(router chi.Router) {
+	bakeLogHandler := initializer.dependencyManager.BakeLog().Router()
+
+	router.Route("/{id}/bakes", func(bakeLogRouter chi.Router) {
+		bakeLogRouter.Post("/", bakeLogHandler.Create())
+		bakeLogRouter.
+			With(httpin.NewInput(rest.PageInput{})).
+			Get("/", bakeLogHandler.FindByRecipeId())
+		bakeLogRouter.Get("/summary", bakeLogHandler.Summary())
+		bakeLogRouter.Get("/{bakeId}", bakeLogHandler.FindById())
+	})
+}
+
+func (initializer *applicationInitializer) mountImageAPIRoutes(router chi.Router) {
+	imageHandler := initializer.dependencyManager.Image().Router()
+
+	router.Route("/{id}/images", func(imageRouter chi.Router) {
+		imageRouter.Post("/", imageHandler.Upload())
+		imageRouter.
+			With(httpin.NewInput(rest.ImageSearchInput{})).
+			Get("/", imageHandler.FindByRecipeId())
+		imageRouter.Get("/{imageId}", imageHandler.Download())
+		imageRouter.Get("/{imageId}/thumbnail", imageHandler.Thumbnail())
+		imageRouter.Delete("/{imageId}", imageHandler.Delete())
+	})
 }
 
 func (initializer *applicationInitializer) getConfig() config.Config {
 	return initializer.dependencyManager.Common().ConfigManager().GetConfig()
 }
 
+func (initializer *applicationInitializer) mountFlourTypeAPIRoutes(router chi.Router) {
+	flourTypeHandler := initializer.dependencyManager.Flour().TypeRouter()
+
+	router.Route("/types", func(typeRouter chi.Router) {
+		typeRouter.Get("/", flourTypeHandler.FindAll())
+		typeRouter.Post("/", flourTypeHandler.Create())
+		typeRouter.Get("/{id}", flourTypeHandler.FindById())
+		typeRouter.Put("/{id}", flourTypeHandler.Update())
+		typeRouter.Delete("/{id}", flourTypeHandler.Delete())
+	})
+}
+
+func (initializer *applicationInitializer) mountHydrationAPIRoutes(router chi.Router) {
+	router.Post("/hydration", initializer.dependencyManager.Hydration().Router().Suggest())
+}
+
 func (initializer *applicationInitializer) mountFlourAPIRoutes(router chi.Router) {
 	flourHandler := initializer.dependencyManager.Flour().Router()
 
 	router.
-		With(httpin.NewInput(rest.PageInput{})).
+		With(httpin.NewInput(rest.FindFlourInput{})).
 		Get("/", flourHandler.Find())
 	router.Post("/", flourHandler.Create())
+	router.Get("/export.csv", flourHandler.ExportCsv())
+	router.
+		With(httpin.NewInput(rest.ImportFlourInput{})).
+		Post("/import", flourHandler.ImportCsv())
 	router.Route("/{id}", func(idRouter chi.Router) {
 		idRouter.Get("/", flourHandler.FindById())
 	})
 	router.
 		With(httpin.NewInput(rest.SearchFlourInput{})).
 		Get("/search", flourHandler.Search())
+	router.
+		With(httpin.NewInput(rest.TextSearchInput{})).
+		Get("/search/text", flourHandler.TextSearch())
+
+}
 
+func (initializer *applicationInitializer) mountCalculatorAPIRoutes(router chi.Router) {
+	router.Post("/sourdough", initializer.dependencyManager.Calculator().Router().CalculateSourdough())
+}
+
+func (initializer *applicationInitializer) mountProductionPlanAPIRoutes(router chi.Router) {
+	productionPlanHandler := initializer.dependencyManager.ProductionPlan().Router()
+
+	router.
+		With(httpin.NewInput(rest.PageInput{})).
+		Get("/", productionPlanHandler.Find())
+	router.Post("/", productionPlanHandler.Create())
+	router.Route("/{id}", func(idRouter chi.Router) {
+		idRouter.Get("/", productionPlanHandler.FindById())
+		idRouter.Put("/", productionPlanHandler.Update())
+		idRouter.Delete("/", productionPlanHandler.Delete())
+		idRouter.Post("/complete", productionPlanHandler.Complete())
+		idRouter.
+			With(httpin.NewInput(rest.ProductionScheduleInput{})).
+			Get("/schedule", productionPlanHandler.Schedule())
+	})
+}
+
+func (initializer *applicationInitializer) mountInventoryAPIRoutes(router chi.Router) {
+	inventoryHandler := initializer.dependencyManager.Inventory().Router()
+
+	router.
+		With(httpin.NewInput(rest.FindInventoryInput{})).
+		Get("/", inventoryHandler.Find())
+	router.Put("/", inventoryHandler.Upsert())
+	router.Post("/shortages", inventoryHandler.Shortages())
+	router.Route("/{id}", func(idRouter chi.Router) {
+		idRouter.Get("/", inventoryHandler.FindById())
+		idRouter.Delete("/", inventoryHandler.Delete())
+	})
 }
 
 func NewApplicationInitializer() domain.ApplicationInitializer {
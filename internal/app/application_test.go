@@ -50,17 +50,40 @@ func TestApplicationInitializerTestSuite(t *testing.T) {
 type ApplicationInitializerTestSuite struct {
 	test.GoMockTestSuite
 
-	configManager                         *mocks.MockConfigManager
-	dependencyManager                     *mocks.MockDependencyManager
-	commonDependencyService               *mocks.MockCommonDependencyService
-	sourdoughRecipeDependencyService      *mocks.MockSourdoughRecipeDependencyService
-	sourdoughRecipeScaleDependencyService *mocks.MockSourdoughRecipeScaleDependencyService
-	flourDependencyService                *mocks.MockFlourDependencyService
-
-	actuatorHandler             *mocks.MockActuatorHandler
-	sourdoughRecipeHandler      *mocks.MockSourdoughRecipeHandler
-	sourdoughRecipeScaleHandler *mocks.MockSourdoughRecipeScaleHandler
-	flourHandler                *mocks.MockFlourHandler
+	configManager                            *mocks.MockConfigManager
+	dependencyManager                        *mocks.MockDependencyManager
+	commonDependencyService                  *mocks.MockCommonDependencyService
+	sourdoughRecipeDependencyService         *mocks.MockSourdoughRecipeDependencyService
+	sourdoughRecipeScaleDependencyService    *mocks.MockSourdoughRecipeScaleDependencyService
+	substitutionDependencyService            *mocks.MockSourdoughRecipeSubstitutionDependencyService
+	sourdoughRecipeRevisionDependencyService *mocks.MockSourdoughRecipeRevisionDependencyService
+	bakeLogDependencyService                 *mocks.MockBakeLogDependencyService
+	imageDependencyService                   *mocks.MockImageDependencyService
+	flourDependencyService                   *mocks.MockFlourDependencyService
+	hydrationDependencyService               *mocks.MockHydrationDependencyService
+	calculatorDependencyService              *mocks.MockCalculatorDependencyService
+	productionPlanDependencyService          *mocks.MockProductionPlanDependencyService
+	inventoryDependencyService               *mocks.MockInventoryDependencyService
+	recipeBundleDependencyService            *mocks.MockRecipeBundleDependencyService
+	parseDependencyService                   *mocks.MockSourdoughRecipeParseDependencyService
+	jsonLdDependencyService                  *mocks.MockRecipeJsonLdDependencyService
+
+	actuatorHandler                *mocks.MockActuatorHandler
+	sourdoughRecipeHandler         *mocks.MockSourdoughRecipeHandler
+	sourdoughRecipeScaleHandler    *mocks.MockSourdoughRecipeScaleHandler
+	substitutionHandler            *mocks.MockSourdoughRecipeSubstitutionHandler
+	sourdoughRecipeRevisionHandler *mocks.MockSourdoughRecipeRevisionHandler
+	bakeLogHandler                 *mocks.MockBakeLogHandler
+	imageHandler                   *mocks.MockImageHandler
+	flourHandler                   *mocks.MockFlourHandler
+	flourTypeHandler               *mocks.MockFlourTypeHandler
+	hydrationHandler               *mocks.MockHydrationHandler
+	calculatorHandler              *mocks.MockCalculatorHandler
+	productionPlanHandler          *mocks.MockProductionPlanHandler
+	inventoryHandler               *mocks.MockInventoryHandler
+	recipeBundleHandler            *mocks.MockRecipeBundleHandler
+	parseHandler                   *mocks.MockSourdoughRecipeParseHandler
+	jsonLdHandler                  *mocks.MockRecipeJsonLdHandler
 
 	target *applicationInitializer
 }
@@ -73,12 +96,35 @@ func (suite *ApplicationInitializerTestSuite) SetupTest() {
 	suite.commonDependencyService = mocks.NewMockCommonDependencyService(suite.MockCtrl)
 	suite.sourdoughRecipeDependencyService = mocks.NewMockSourdoughRecipeDependencyService(suite.MockCtrl)
 	suite.sourdoughRecipeScaleDependencyService = mocks.NewMockSourdoughRecipeScaleDependencyService(suite.MockCtrl)
+	suite.substitutionDependencyService = mocks.NewMockSourdoughRecipeSubstitutionDependencyService(suite.MockCtrl)
+	suite.sourdoughRecipeRevisionDependencyService = mocks.NewMockSourdoughRecipeRevisionDependencyService(suite.MockCtrl)
+	suite.bakeLogDependencyService = mocks.NewMockBakeLogDependencyService(suite.MockCtrl)
+	suite.imageDependencyService = mocks.NewMockImageDependencyService(suite.MockCtrl)
 	suite.flourDependencyService = mocks.NewMockFlourDependencyService(suite.MockCtrl)
+	suite.hydrationDependencyService = mocks.NewMockHydrationDependencyService(suite.MockCtrl)
+	suite.calculatorDependencyService = mocks.NewMockCalculatorDependencyService(suite.MockCtrl)
+	suite.productionPlanDependencyService = mocks.NewMockProductionPlanDependencyService(suite.MockCtrl)
+	suite.inventoryDependencyService = mocks.NewMockInventoryDependencyService(suite.MockCtrl)
+	suite.recipeBundleDependencyService = mocks.NewMockRecipeBundleDependencyService(suite.MockCtrl)
+	suite.parseDependencyService = mocks.NewMockSourdoughRecipeParseDependencyService(suite.MockCtrl)
+	suite.jsonLdDependencyService = mocks.NewMockRecipeJsonLdDependencyService(suite.MockCtrl)
 
 	suite.actuatorHandler = mocks.NewMockActuatorHandler(suite.MockCtrl)
 	suite.sourdoughRecipeHandler = mocks.NewMockSourdoughRecipeHandler(suite.MockCtrl)
 	suite.sourdoughRecipeScaleHandler = mocks.NewMockSourdoughRecipeScaleHandler(suite.MockCtrl)
+	suite.substitutionHandler = mocks.NewMockSourdoughRecipeSubstitutionHandler(suite.MockCtrl)
+	suite.sourdoughRecipeRevisionHandler = mocks.NewMockSourdoughRecipeRevisionHandler(suite.MockCtrl)
+	suite.bakeLogHandler = mocks.NewMockBakeLogHandler(suite.MockCtrl)
+	suite.imageHandler = mocks.NewMockImageHandler(suite.MockCtrl)
 	suite.flourHandler = mocks.NewMockFlourHandler(suite.MockCtrl)
+	suite.flourTypeHandler = mocks.NewMockFlourTypeHandler(suite.MockCtrl)
+	suite.hydrationHandler = mocks.NewMockHydrationHandler(suite.MockCtrl)
+	suite.calculatorHandler = mocks.NewMockCalculatorHandler(suite.MockCtrl)
+	suite.productionPlanHandler = mocks.NewMockProductionPlanHandler(suite.MockCtrl)
+	suite.inventoryHandler = mocks.NewMockInventoryHandler(suite.MockCtrl)
+	suite.recipeBundleHandler = mocks.NewMockRecipeBundleHandler(suite.MockCtrl)
+	suite.parseHandler = mocks.NewMockSourdoughRecipeParseHandler(suite.MockCtrl)
+	suite.jsonLdHandler = mocks.NewMockRecipeJsonLdHandler(suite.MockCtrl)
 
 	suite.target = &applicationInitializer{dependencyManager: suite.dependencyManager}
 }
@@ -103,28 +149,155 @@ func (suite *ApplicationInitializerTestSuite) TestInitialize() {
 	suite.sourdoughRecipeDependencyService.EXPECT().Router().Return(suite.sourdoughRecipeHandler)
 	suite.sourdoughRecipeHandler.EXPECT().Create().
 		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.sourdoughRecipeHandler.EXPECT().CreateFromFormula().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
 	suite.sourdoughRecipeHandler.EXPECT().Find().
 		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
 	suite.sourdoughRecipeHandler.EXPECT().FindById().
 		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.sourdoughRecipeHandler.EXPECT().Update().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.sourdoughRecipeHandler.EXPECT().Fork().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.sourdoughRecipeHandler.EXPECT().FamilyTree().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
 	suite.sourdoughRecipeHandler.EXPECT().Search().
 		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.sourdoughRecipeHandler.EXPECT().TextSearch().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
 
 	suite.dependencyManager.EXPECT().SourdoughRecipeScale().Return(suite.sourdoughRecipeScaleDependencyService)
 	suite.sourdoughRecipeScaleDependencyService.EXPECT().Router().Return(suite.sourdoughRecipeScaleHandler)
 	suite.sourdoughRecipeScaleHandler.EXPECT().Scale().
 		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.sourdoughRecipeScaleHandler.EXPECT().ScaleBatch().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	suite.dependencyManager.EXPECT().SourdoughRecipeSubstitution().Return(suite.substitutionDependencyService)
+	suite.substitutionDependencyService.EXPECT().Router().Return(suite.substitutionHandler)
+	suite.substitutionHandler.EXPECT().Substitute().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	suite.dependencyManager.EXPECT().SourdoughRecipeRevision().Return(suite.sourdoughRecipeRevisionDependencyService)
+	suite.sourdoughRecipeRevisionDependencyService.EXPECT().Router().Return(suite.sourdoughRecipeRevisionHandler)
+	suite.sourdoughRecipeRevisionHandler.EXPECT().FindByRecipeId().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.sourdoughRecipeRevisionHandler.EXPECT().FindByVersion().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.sourdoughRecipeRevisionHandler.EXPECT().Diff().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.sourdoughRecipeRevisionHandler.EXPECT().Restore().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	suite.dependencyManager.EXPECT().BakeLog().Return(suite.bakeLogDependencyService)
+	suite.bakeLogDependencyService.EXPECT().Router().Return(suite.bakeLogHandler)
+	suite.bakeLogHandler.EXPECT().Create().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.bakeLogHandler.EXPECT().FindByRecipeId().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.bakeLogHandler.EXPECT().Summary().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.bakeLogHandler.EXPECT().FindById().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
 
-	suite.dependencyManager.EXPECT().Flour().Return(suite.flourDependencyService)
+	suite.dependencyManager.EXPECT().Image().Return(suite.imageDependencyService)
+	suite.imageDependencyService.EXPECT().Router().Return(suite.imageHandler)
+	suite.imageHandler.EXPECT().Upload().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.imageHandler.EXPECT().FindByRecipeId().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.imageHandler.EXPECT().Download().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.imageHandler.EXPECT().Thumbnail().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.imageHandler.EXPECT().Delete().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	suite.dependencyManager.EXPECT().RecipeBundle().Return(suite.recipeBundleDependencyService)
+	suite.recipeBundleDependencyService.EXPECT().Router().Return(suite.recipeBundleHandler)
+	suite.recipeBundleHandler.EXPECT().Export().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.recipeBundleHandler.EXPECT().Import().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	suite.dependencyManager.EXPECT().SourdoughRecipeParse().Return(suite.parseDependencyService)
+	suite.parseDependencyService.EXPECT().Router().Return(suite.parseHandler)
+	suite.parseHandler.EXPECT().Parse().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	suite.dependencyManager.EXPECT().RecipeJsonLd().Return(suite.jsonLdDependencyService)
+	suite.jsonLdDependencyService.EXPECT().Router().Return(suite.jsonLdHandler)
+	suite.jsonLdHandler.EXPECT().Export().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.jsonLdHandler.EXPECT().Import().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	suite.dependencyManager.EXPECT().Flour().Return(suite.flourDependencyService).Times(2)
+	suite.flourDependencyService.EXPECT().TypeRouter().Return(suite.flourTypeHandler)
+	suite.flourTypeHandler.EXPECT().FindAll().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.flourTypeHandler.EXPECT().Create().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.flourTypeHandler.EXPECT().FindById().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.flourTypeHandler.EXPECT().Update().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.flourTypeHandler.EXPECT().Delete().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.dependencyManager.EXPECT().Hydration().Return(suite.hydrationDependencyService)
+	suite.hydrationDependencyService.EXPECT().Router().Return(suite.hydrationHandler)
+	suite.hydrationHandler.EXPECT().Suggest().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
 	suite.flourDependencyService.EXPECT().Router().Return(suite.flourHandler)
 	suite.flourHandler.EXPECT().Create().
 		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
 	suite.flourHandler.EXPECT().Find().
 		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.flourHandler.EXPECT().ExportCsv().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.flourHandler.EXPECT().ImportCsv().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
 	suite.flourHandler.EXPECT().FindById().
 		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
 	suite.flourHandler.EXPECT().Search().
 		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.flourHandler.EXPECT().TextSearch().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	suite.dependencyManager.EXPECT().Calculator().Return(suite.calculatorDependencyService)
+	suite.calculatorDependencyService.EXPECT().Router().Return(suite.calculatorHandler)
+	suite.calculatorHandler.EXPECT().CalculateSourdough().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	suite.dependencyManager.EXPECT().ProductionPlan().Return(suite.productionPlanDependencyService)
+	suite.productionPlanDependencyService.EXPECT().Router().Return(suite.productionPlanHandler)
+	suite.productionPlanHandler.EXPECT().Find().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.productionPlanHandler.EXPECT().Create().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.productionPlanHandler.EXPECT().FindById().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.productionPlanHandler.EXPECT().Update().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.productionPlanHandler.EXPECT().Delete().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.productionPlanHandler.EXPECT().Complete().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.productionPlanHandler.EXPECT().Schedule().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	suite.dependencyManager.EXPECT().Inventory().Return(suite.inventoryDependencyService)
+	suite.inventoryDependencyService.EXPECT().Router().Return(suite.inventoryHandler)
+	suite.inventoryHandler.EXPECT().Find().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.inventoryHandler.EXPECT().Upsert().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.inventoryHandler.EXPECT().Shortages().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.inventoryHandler.EXPECT().FindById().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	suite.inventoryHandler.EXPECT().Delete().
+		Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
 
 	app, err := suite.target.Initialize()
 
@@ -188,28 +361,155 @@ func (suite *ApplicationInitializerTestSuite) TestInitializeRouter() {
 	suite.sourdoughRecipeDependencyService.EXPECT().Router().Return(suite.sourdoughRecipeHandler)
 	suite.sourdoughRecipeHandler.EXPECT().Create().
 		Return(defaultHandlerProvider("create sourdough recipe ok"))
+	suite.sourdoughRecipeHandler.EXPECT().CreateFromFormula().
+		Return(defaultHandlerProvider("create sourdough recipe from formula ok"))
 	suite.sourdoughRecipeHandler.EXPECT().Find().
 		Return(defaultHandlerProvider("find sourdough recipe ok"))
 	suite.sourdoughRecipeHandler.EXPECT().FindById().
 		Return(defaultHandlerProvider("find by id sourdough recipe ok"))
+	suite.sourdoughRecipeHandler.EXPECT().Update().
+		Return(defaultHandlerProvider("update sourdough recipe ok"))
+	suite.sourdoughRecipeHandler.EXPECT().Fork().
+		Return(defaultHandlerProvider("fork sourdough recipe ok"))
+	suite.sourdoughRecipeHandler.EXPECT().FamilyTree().
+		Return(defaultHandlerProvider("sourdough recipe family tree ok"))
 	suite.sourdoughRecipeHandler.EXPECT().Search().
 		Return(defaultHandlerProvider("search sourdough recipe ok"))
+	suite.sourdoughRecipeHandler.EXPECT().TextSearch().
+		Return(defaultHandlerProvider("text search sourdough recipe ok"))
 
 	suite.dependencyManager.EXPECT().SourdoughRecipeScale().Return(suite.sourdoughRecipeScaleDependencyService)
 	suite.sourdoughRecipeScaleDependencyService.EXPECT().Router().Return(suite.sourdoughRecipeScaleHandler)
 	suite.sourdoughRecipeScaleHandler.EXPECT().Scale().
 		Return(defaultHandlerProvider("scale sourdough recipe ok"))
-
-	suite.dependencyManager.EXPECT().Flour().Return(suite.flourDependencyService)
+	suite.sourdoughRecipeScaleHandler.EXPECT().ScaleBatch().
+		Return(defaultHandlerProvider("batch scale sourdough recipes ok"))
+
+	suite.dependencyManager.EXPECT().SourdoughRecipeSubstitution().Return(suite.substitutionDependencyService)
+	suite.substitutionDependencyService.EXPECT().Router().Return(suite.substitutionHandler)
+	suite.substitutionHandler.EXPECT().Substitute().
+		Return(defaultHandlerProvider("substitute flour ok"))
+
+	suite.dependencyManager.EXPECT().SourdoughRecipeRevision().Return(suite.sourdoughRecipeRevisionDependencyService)
+	suite.sourdoughRecipeRevisionDependencyService.EXPECT().Router().Return(suite.sourdoughRecipeRevisionHandler)
+	suite.sourdoughRecipeRevisionHandler.EXPECT().FindByRecipeId().
+		Return(defaultHandlerProvider("find sourdough recipe revisions ok"))
+	suite.sourdoughRecipeRevisionHandler.EXPECT().FindByVersion().
+		Return(defaultHandlerProvider("find sourdough recipe revision ok"))
+	suite.sourdoughRecipeRevisionHandler.EXPECT().Diff().
+		Return(defaultHandlerProvider("diff sourdough recipe revisions ok"))
+	suite.sourdoughRecipeRevisionHandler.EXPECT().Restore().
+		Return(defaultHandlerProvider("restore sourdough recipe revision ok"))
+
+	suite.dependencyManager.EXPECT().BakeLog().Return(suite.bakeLogDependencyService)
+	suite.bakeLogDependencyService.EXPECT().Router().Return(suite.bakeLogHandler)
+	suite.bakeLogHandler.EXPECT().Create().
+		Return(defaultHandlerProvider("create bake log ok"))
+	suite.bakeLogHandler.EXPECT().FindByRecipeId().
+		Return(defaultHandlerProvider("find bake logs ok"))
+	suite.bakeLogHandler.EXPECT().Summary().
+		Return(defaultHandlerProvider("bake log summary ok"))
+	suite.bakeLogHandler.EXPECT().FindById().
+		Return(defaultHandlerProvider("find bake log ok"))
+
+	suite.dependencyManager.EXPECT().Image().Return(suite.imageDependencyService)
+	suite.imageDependencyService.EXPECT().Router().Return(suite.imageHandler)
+	suite.imageHandler.EXPECT().Upload().
+		Return(defaultHandlerProvider("upload image ok"))
+	suite.imageHandler.EXPECT().FindByRecipeId().
+		Return(defaultHandlerProvider("find images ok"))
+	suite.imageHandler.EXPECT().Download().
+		Return(defaultHandlerProvider("download image ok"))
+	suite.imageHandler.EXPECT().Thumbnail().
+		Return(defaultHandlerProvider("image thumbnail ok"))
+	suite.imageHandler.EXPECT().Delete().
+		Return(defaultHandlerProvider("delete image ok"))
+
+	suite.dependencyManager.EXPECT().RecipeBundle().Return(suite.recipeBundleDependencyService)
+	suite.recipeBundleDependencyService.EXPECT().Router().Return(suite.recipeBundleHandler)
+	suite.recipeBundleHandler.EXPECT().Export().
+		Return(defaultHandlerProvider("export sourdough recipes ok"))
+	suite.recipeBundleHandler.EXPECT().Import().
+		Return(defaultHandlerProvider("import sourdough recipes ok"))
+
+	suite.dependencyManager.EXPECT().SourdoughRecipeParse().Return(suite.parseDependencyService)
+	suite.parseDependencyService.EXPECT().Router().Return(suite.parseHandler)
+	suite.parseHandler.EXPECT().Parse().
+		Return(defaultHandlerProvider("parse sourdough recipe ok"))
+
+	suite.dependencyManager.EXPECT().RecipeJsonLd().Return(suite.jsonLdDependencyService)
+	suite.jsonLdDependencyService.EXPECT().Router().Return(suite.jsonLdHandler)
+	suite.jsonLdHandler.EXPECT().Export().
+		Return(defaultHandlerProvider("export sourdough recipe JSON-LD ok"))
+	suite.jsonLdHandler.EXPECT().Import().
+		Return(defaultHandlerProvider("import sourdough recipe JSON-LD ok"))
+
+	suite.dependencyManager.EXPECT().Flour().Return(suite.flourDependencyService).Times(2)
+	suite.flourDependencyService.EXPECT().TypeRouter().Return(suite.flourTypeHandler)
+	suite.flourTypeHandler.EXPECT().FindAll().
+		Return(defaultHandlerProvider("find flour types ok"))
+	suite.flourTypeHandler.EXPECT().Create().
+		Return(defaultHandlerProvider("create flour type ok"))
+	suite.flourTypeHandler.EXPECT().FindById().
+		Return(defaultHandlerProvider("find flour type by id ok"))
+	suite.flourTypeHandler.EXPECT().Update().
+		Return(defaultHandlerProvider("update flour type ok"))
+	suite.flourTypeHandler.EXPECT().Delete().
+		Return(defaultHandlerProvider("delete flour type ok"))
+	suite.dependencyManager.EXPECT().Hydration().Return(suite.hydrationDependencyService)
+	suite.hydrationDependencyService.EXPECT().Router().Return(suite.hydrationHandler)
+	suite.hydrationHandler.EXPECT().Suggest().
+		Return(defaultHandlerProvider("suggest hydration ok"))
 	suite.flourDependencyService.EXPECT().Router().Return(suite.flourHandler)
 	suite.flourHandler.EXPECT().Create().
 		Return(defaultHandlerProvider("create flour ok"))
 	suite.flourHandler.EXPECT().Find().
 		Return(defaultHandlerProvider("find flour ok"))
+	suite.flourHandler.EXPECT().ExportCsv().
+		Return(defaultHandlerProvider("export flour ok"))
+	suite.flourHandler.EXPECT().ImportCsv().
+		Return(defaultHandlerProvider("import flour ok"))
 	suite.flourHandler.EXPECT().FindById().
 		Return(defaultHandlerProvider("find by id flour ok"))
 	suite.flourHandler.EXPECT().Search().
 		Return(defaultHandlerProvider("search flour ok"))
+	suite.flourHandler.EXPECT().TextSearch().
+		Return(defaultHandlerProvider("text search flour ok"))
+
+	suite.dependencyManager.EXPECT().Calculator().Return(suite.calculatorDependencyService)
+	suite.calculatorDependencyService.EXPECT().Router().Return(suite.calculatorHandler)
+	suite.calculatorHandler.EXPECT().CalculateSourdough().
+		Return(defaultHandlerProvider("calculate sourdough ok"))
+
+	suite.dependencyManager.EXPECT().ProductionPlan().Return(suite.productionPlanDependencyService)
+	suite.productionPlanDependencyService.EXPECT().Router().Return(suite.productionPlanHandler)
+	suite.productionPlanHandler.EXPECT().Find().
+		Return(defaultHandlerProvider("find production plans ok"))
+	suite.productionPlanHandler.EXPECT().Create().
+		Return(defaultHandlerProvider("create production plan ok"))
+	suite.productionPlanHandler.EXPECT().FindById().
+		Return(defaultHandlerProvider("find production plan ok"))
+	suite.productionPlanHandler.EXPECT().Update().
+		Return(defaultHandlerProvider("update production plan ok"))
+	suite.productionPlanHandler.EXPECT().Delete().
+		Return(defaultHandlerProvider("delete production plan ok"))
+	suite.productionPlanHandler.EXPECT().Complete().
+		Return(defaultHandlerProvider("complete production plan ok"))
+	suite.productionPlanHandler.EXPECT().Schedule().
+		Return(defaultHandlerProvider("production plan schedule ok"))
+
+	suite.dependencyManager.EXPECT().Inventory().Return(suite.inventoryDependencyService)
+	suite.inventoryDependencyService.EXPECT().Router().Return(suite.inventoryHandler)
+	suite.inventoryHandler.EXPECT().Find().
+		Return(defaultHandlerProvider("find inventory ok"))
+	suite.inventoryHandler.EXPECT().Upsert().
+		Return(defaultHandlerProvider("upsert inventory item ok"))
+	suite.inventoryHandler.EXPECT().Shortages().
+		Return(defaultHandlerProvider("inventory shortages ok"))
+	suite.inventoryHandler.EXPECT().FindById().
+		Return(defaultHandlerProvider("find inventory item ok"))
+	suite.inventoryHandler.EXPECT().Delete().
+		Return(defaultHandlerProvider("delete inventory item ok"))
 
 	router := suite.target.initializeRouter()
 
@@ -229,6 +529,14 @@ func (suite *ApplicationInitializerTestSuite) TestInitializeRouter() {
 		suite.Equal("create sourdough recipe ok", resp.Body.String())
 	})
 
+	suite.Run("create sourdough recipe from formula", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/api/recipe/sourdough/formula", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("create sourdough recipe from formula ok", resp.Body.String())
+	})
+
 	suite.Run("find sourdough recipe", func() {
 		resp := httptest.NewRecorder()
 		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/recipe/sourdough", nil))
@@ -237,6 +545,46 @@ func (suite *ApplicationInitializerTestSuite) TestInitializeRouter() {
 		suite.Equal("find sourdough recipe ok", resp.Body.String())
 	})
 
+	suite.Run("export sourdough recipes", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/recipe/sourdough/export", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("export sourdough recipes ok", resp.Body.String())
+	})
+
+	suite.Run("import sourdough recipes", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/api/recipe/sourdough/import", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("import sourdough recipes ok", resp.Body.String())
+	})
+
+	suite.Run("parse sourdough recipe", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/api/recipe/sourdough/parse", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("parse sourdough recipe ok", resp.Body.String())
+	})
+
+	suite.Run("export sourdough recipe JSON-LD", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/recipe/sourdough/1/jsonld", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("export sourdough recipe JSON-LD ok", resp.Body.String())
+	})
+
+	suite.Run("import sourdough recipe JSON-LD", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/api/recipe/sourdough/import/jsonld", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("import sourdough recipe JSON-LD ok", resp.Body.String())
+	})
+
 	suite.Run("find by id sourdough recipe", func() {
 		resp := httptest.NewRecorder()
 		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/recipe/sourdough/1", nil))
@@ -245,6 +593,30 @@ func (suite *ApplicationInitializerTestSuite) TestInitializeRouter() {
 		suite.Equal("find by id sourdough recipe ok", resp.Body.String())
 	})
 
+	suite.Run("update sourdough recipe", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodPut, "/api/recipe/sourdough/1", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("update sourdough recipe ok", resp.Body.String())
+	})
+
+	suite.Run("fork sourdough recipe", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/api/recipe/sourdough/1/fork", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("fork sourdough recipe ok", resp.Body.String())
+	})
+
+	suite.Run("sourdough recipe family tree", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/recipe/sourdough/1/family-tree", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("sourdough recipe family tree ok", resp.Body.String())
+	})
+
 	suite.Run("search sourdough recipe", func() {
 		resp := httptest.NewRecorder()
 		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/recipe/sourdough/search", nil))
@@ -253,6 +625,14 @@ func (suite *ApplicationInitializerTestSuite) TestInitializeRouter() {
 		suite.Equal("search sourdough recipe ok", resp.Body.String())
 	})
 
+	suite.Run("text search sourdough recipe", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/recipe/sourdough/search/text?q=rye", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("text search sourdough recipe ok", resp.Body.String())
+	})
+
 	suite.Run("scale sourdough recipe", func() {
 		resp := httptest.NewRecorder()
 		router.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/api/recipe/sourdough/1/scale", nil))
@@ -261,6 +641,126 @@ func (suite *ApplicationInitializerTestSuite) TestInitializeRouter() {
 		suite.Equal("scale sourdough recipe ok", resp.Body.String())
 	})
 
+	suite.Run("batch scale sourdough recipes", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/api/recipe/sourdough/scale/batch", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("batch scale sourdough recipes ok", resp.Body.String())
+	})
+
+	suite.Run("substitute flour", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/api/recipe/sourdough/1/substitute", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("substitute flour ok", resp.Body.String())
+	})
+
+	suite.Run("find sourdough recipe revisions", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/recipe/sourdough/1/revisions", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("find sourdough recipe revisions ok", resp.Body.String())
+	})
+
+	suite.Run("find sourdough recipe revision", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/recipe/sourdough/1/revisions/3", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("find sourdough recipe revision ok", resp.Body.String())
+	})
+
+	suite.Run("diff sourdough recipe revisions", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/recipe/sourdough/1/revisions/diff?from=3&to=7", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("diff sourdough recipe revisions ok", resp.Body.String())
+	})
+
+	suite.Run("restore sourdough recipe revision", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/api/recipe/sourdough/1/revisions/3/restore", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("restore sourdough recipe revision ok", resp.Body.String())
+	})
+
+	suite.Run("create bake log", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/api/recipe/sourdough/1/bakes", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("create bake log ok", resp.Body.String())
+	})
+
+	suite.Run("find bake logs", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/recipe/sourdough/1/bakes", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("find bake logs ok", resp.Body.String())
+	})
+
+	suite.Run("bake log summary", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/recipe/sourdough/1/bakes/summary", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("bake log summary ok", resp.Body.String())
+	})
+
+	suite.Run("find bake log", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/recipe/sourdough/1/bakes/2", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("find bake log ok", resp.Body.String())
+	})
+
+	suite.Run("upload image", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/api/recipe/sourdough/1/images", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("upload image ok", resp.Body.String())
+	})
+
+	suite.Run("find images", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/recipe/sourdough/1/images", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("find images ok", resp.Body.String())
+	})
+
+	suite.Run("download image", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/recipe/sourdough/1/images/2", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("download image ok", resp.Body.String())
+	})
+
+	suite.Run("image thumbnail", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/recipe/sourdough/1/images/2/thumbnail", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("image thumbnail ok", resp.Body.String())
+	})
+
+	suite.Run("delete image", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodDelete, "/api/recipe/sourdough/1/images/2", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("delete image ok", resp.Body.String())
+	})
+
 	suite.Run("create flour", func() {
 		resp := httptest.NewRecorder()
 		router.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/api/flour", nil))
@@ -277,6 +777,22 @@ func (suite *ApplicationInitializerTestSuite) TestInitializeRouter() {
 		suite.Equal("find flour ok", resp.Body.String())
 	})
 
+	suite.Run("export flour", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/flour/export.csv", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("export flour ok", resp.Body.String())
+	})
+
+	suite.Run("import flour", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/api/flour/import", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("import flour ok", resp.Body.String())
+	})
+
 	suite.Run("find by id flour", func() {
 		resp := httptest.NewRecorder()
 		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/flour/1", nil))
@@ -292,6 +808,142 @@ func (suite *ApplicationInitializerTestSuite) TestInitializeRouter() {
 		suite.Equal(http.StatusOK, resp.Code)
 		suite.Equal("search flour ok", resp.Body.String())
 	})
+
+	suite.Run("text search flour", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/flour/search/text?q=rye", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("text search flour ok", resp.Body.String())
+	})
+
+	flourRoutes := []struct {
+		name     string
+		method   string
+		path     string
+		expected string
+	}{
+		{"find flour types", http.MethodGet, "/api/flour/types", "find flour types ok"},
+		{"create flour type", http.MethodPost, "/api/flour/types", "create flour type ok"},
+		{"find flour type by id", http.MethodGet, "/api/flour/types/1", "find flour type by id ok"},
+		{"update flour type", http.MethodPut, "/api/flour/types/1", "update flour type ok"},
+		{"delete flour type", http.MethodDelete, "/api/flour/types/1", "delete flour type ok"},
+		{"suggest hydration", http.MethodPost, "/api/flour/hydration", "suggest hydration ok"},
+	}
+
+	for _, route := range flourRoutes {
+		suite.Run(route.name, func() {
+			resp := httptest.NewRecorder()
+			router.ServeHTTP(resp, httptest.NewRequest(route.method, route.path, nil))
+
+			suite.Equal(http.StatusOK, resp.Code)
+			suite.Equal(route.expected, resp.Body.String())
+		})
+	}
+
+	suite.Run("calculate sourdough", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/api/calculate/sourdough", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("calculate sourdough ok", resp.Body.String())
+	})
+
+	suite.Run("find production plans", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/production-plans", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("find production plans ok", resp.Body.String())
+	})
+
+	suite.Run("create production plan", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/api/production-plans", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("create production plan ok", resp.Body.String())
+	})
+
+	suite.Run("find production plan", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/production-plans/1", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("find production plan ok", resp.Body.String())
+	})
+
+	suite.Run("update production plan", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodPut, "/api/production-plans/1", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("update production plan ok", resp.Body.String())
+	})
+
+	suite.Run("delete production plan", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodDelete, "/api/production-plans/1", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("delete production plan ok", resp.Body.String())
+	})
+
+	suite.Run("production plan schedule", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/production-plans/1/schedule", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("production plan schedule ok", resp.Body.String())
+	})
+
+	suite.Run("complete production plan", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/api/production-plans/1/complete", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("complete production plan ok", resp.Body.String())
+	})
+
+	suite.Run("find inventory", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/inventory", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("find inventory ok", resp.Body.String())
+	})
+
+	suite.Run("upsert inventory item", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodPut, "/api/inventory", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("upsert inventory item ok", resp.Body.String())
+	})
+
+	suite.Run("inventory shortages", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/api/inventory/shortages", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("inventory shortages ok", resp.Body.String())
+	})
+
+	suite.Run("find inventory item", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/inventory/1", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("find inventory item ok", resp.Body.String())
+	})
+
+	suite.Run("delete inventory item", func() {
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodDelete, "/api/inventory/1", nil))
+
+		suite.Equal(http.StatusOK, resp.Code)
+		suite.Equal("delete inventory item ok", resp.Body.String())
+	})
 }
 
 func (suite *ApplicationInitializerTestSuite) TestApplicationInitializer_WithError() {
@@ -0,0 +1,124 @@
+package dependency
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/controller/rest"
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/repository"
+	"dough-calculator/internal/service"
+)
+
+type bakeLogDependencyService struct {
+	transactionRunnerCreator         func(mongoDBService domain.MongoDBService) (domain.TransactionRunner, error)
+	embeddedTransactionRunnerCreator func(database domain.EmbeddedDatabase) (domain.TransactionRunner, error)
+
+	repositoryCreator         func(mongoDBService domain.MongoDBService) (domain.BakeLogRepository, error)
+	embeddedRepositoryCreator func(database domain.EmbeddedDatabase) (domain.BakeLogRepository, error)
+	repository                domain.BakeLogRepository
+
+	serviceCreator func(
+		transactionRunner domain.TransactionRunner,
+		repository domain.BakeLogRepository,
+		sourdoughRecipeService domain.SourdoughRecipeService,
+		revisionRepository domain.SourdoughRecipeRevisionRepository,
+		inventoryService domain.InventoryService,
+	) (domain.BakeLogService, error)
+	service domain.BakeLogService
+
+	handlerCreator func(service domain.BakeLogService) (domain.BakeLogHandler, error)
+	handler        domain.BakeLogHandler
+}
+
+func (dependencyService *bakeLogDependencyService) Initialize(ctx context.Context) error {
+	sourdoughRecipeService, err := getFromContext[domain.SourdoughRecipeService](ctx, "sourdoughRecipeService")
+	if err != nil {
+		return errors.Wrap(err, "failed to get sourdoughRecipeService from context")
+	}
+
+	revisionRepository, err := getFromContext[domain.SourdoughRecipeRevisionRepository](ctx, "sourdoughRecipeRevisionRepository")
+	if err != nil {
+		return errors.Wrap(err, "failed to get sourdoughRecipeRevisionRepository from context")
+	}
+
+	inventoryService, err := getFromContext[domain.InventoryService](ctx, "inventoryService")
+	if err != nil {
+		return errors.Wrap(err, "failed to get inventoryService from context")
+	}
+
+	bakeLogRepository, err := createOnBackend(ctx, dependencyService.repositoryCreator, dependencyService.embeddedRepositoryCreator)
+	if err != nil {
+		return errors.Wrap(err, "failed to create repository")
+	}
+
+	transactionRunner, err := createOnBackend(ctx,
+		dependencyService.transactionRunnerCreator, dependencyService.embeddedTransactionRunnerCreator)
+	if err != nil {
+		return errors.Wrap(err, "failed to create transaction runner")
+	}
+
+	bakeLogService, err := dependencyService.serviceCreator(transactionRunner, bakeLogRepository, sourdoughRecipeService, revisionRepository, inventoryService)
+	if err != nil {
+		return errors.Wrap(err, "failed to create service")
+	}
+
+	bakeLogHandler, err := dependencyService.handlerCreator(bakeLogService)
+	if err != nil {
+		return errors.Wrap(err, "failed to create handler")
+	}
+
+	dependencyService.repository = bakeLogRepository
+	dependencyService.service = bakeLogService
+	dependencyService.handler = bakeLogHandler
+
+	return nil
+}
+
+func (dependencyService *bakeLogDependencyService) Repository() domain.BakeLogRepository {
+	return dependencyService.repository
+}
+
+func (dependencyService *bakeLogDependencyService) Service() domain.BakeLogService {
+	return dependencyService.service
+}
+
+func (dependencyService *bakeLogDependencyService) Router() domain.BakeLogHandler {
+	return dependencyService.handler
+}
+
+func NewBakeLogDependencyService() domain.BakeLogDependencyService {
+	return newBakeLogDependencyService(
+		repository.NewTransactionRunner,
+		repository.NewEmbeddedTransactionRunner,
+		repository.NewBakeLogRepository,
+		repository.NewEmbeddedBakeLogRepository,
+		service.NewBakeLogService,
+		rest.NewBakeLogHandler,
+	)
+}
+
+func newBakeLogDependencyService(
+	transactionRunnerCreator func(mongoDBService domain.MongoDBService) (domain.TransactionRunner, error),
+	embeddedTransactionRunnerCreator func(database domain.EmbeddedDatabase) (domain.TransactionRunner, error),
+	repositoryCreator func(mongoDBService domain.MongoDBService) (domain.BakeLogRepository, error),
+	embeddedRepositoryCreator func(database domain.EmbeddedDatabase) (domain.BakeLogRepository, error),
+	serviceCreator func(
+		transactionRunner domain.TransactionRunner,
+		repository domain.BakeLogRepository,
+		sourdoughRecipeService domain.SourdoughRecipeService,
+		revisionRepository domain.SourdoughRecipeRevisionRepository,
+		inventoryService domain.InventoryService,
+	) (domain.BakeLogService, error),
+	handlerCreator func(service domain.BakeLogService) (domain.BakeLogHandler, error),
+) domain.BakeLogDependencyService {
+	return &bakeLogDependencyService{
+		transactionRunnerCreator:         transactionRunnerCreator,
+		embeddedTransactionRunnerCreator: embeddedTransactionRunnerCreator,
+		repositoryCreator:                repositoryCreator,
+		embeddedRepositoryCreator:        embeddedRepositoryCreator,
+		serviceCreator:                   serviceCreator,
+		handlerCreator:                   handlerCreator,
+	}
+}
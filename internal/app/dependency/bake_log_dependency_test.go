@@ -0,0 +1,251 @@
+package dependency
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	"dough-calculator/internal/test"
+)
+
+type BakeLogDependencyServiceTestSuite struct {
+	test.GoMockTestSuite
+
+	mongoDBService            *mocks.MockMongoDBService
+	embeddedDatabase          *mocks.MockEmbeddedDatabase
+	sourdoughRecipeService    *mocks.MockSourdoughRecipeService
+	revisionRepository        *mocks.MockSourdoughRecipeRevisionRepository
+	inventoryService          *mocks.MockInventoryService
+	transactionRunner         *mocks.MockTransactionRunner
+	embeddedTransactionRunner *mocks.MockTransactionRunner
+	repository                *mocks.MockBakeLogRepository
+	embeddedRepository        *mocks.MockBakeLogRepository
+	service                   *mocks.MockBakeLogService
+	handler                   *mocks.MockBakeLogHandler
+
+	target domain.BakeLogDependencyService
+}
+
+func (suite *BakeLogDependencyServiceTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.mongoDBService = mocks.NewMockMongoDBService(suite.MockCtrl)
+	suite.embeddedDatabase = mocks.NewMockEmbeddedDatabase(suite.MockCtrl)
+	suite.sourdoughRecipeService = mocks.NewMockSourdoughRecipeService(suite.MockCtrl)
+	suite.revisionRepository = mocks.NewMockSourdoughRecipeRevisionRepository(suite.MockCtrl)
+	suite.inventoryService = mocks.NewMockInventoryService(suite.MockCtrl)
+	suite.transactionRunner = mocks.NewMockTransactionRunner(suite.MockCtrl)
+	suite.embeddedTransactionRunner = mocks.NewMockTransactionRunner(suite.MockCtrl)
+	suite.repository = mocks.NewMockBakeLogRepository(suite.MockCtrl)
+	suite.embeddedRepository = mocks.NewMockBakeLogRepository(suite.MockCtrl)
+	suite.service = mocks.NewMockBakeLogService(suite.MockCtrl)
+	suite.handler = mocks.NewMockBakeLogHandler(suite.MockCtrl)
+
+	suite.target = newBakeLogDependencyService(
+		func(_ domain.MongoDBService) (domain.TransactionRunner, error) {
+			return suite.transactionRunner, nil
+		},
+		func(_ domain.EmbeddedDatabase) (domain.TransactionRunner, error) {
+			return suite.embeddedTransactionRunner, nil
+		},
+		func(_ domain.MongoDBService) (domain.BakeLogRepository, error) {
+			return suite.repository, nil
+		},
+		func(_ domain.EmbeddedDatabase) (domain.BakeLogRepository, error) {
+			return suite.embeddedRepository, nil
+		},
+		func(_ domain.TransactionRunner, _ domain.BakeLogRepository, _ domain.SourdoughRecipeService, _ domain.SourdoughRecipeRevisionRepository, _ domain.InventoryService) (domain.BakeLogService, error) {
+			return suite.service, nil
+		},
+		func(_ domain.BakeLogService) (domain.BakeLogHandler, error) {
+			return suite.handler, nil
+		},
+	)
+}
+
+// context holds every dependency of the bake log dependency service except
+// the ones listed in without.
+func (suite *BakeLogDependencyServiceTestSuite) context(without ...string) context.Context {
+	values := map[string]any{
+		"mongoDBService":                    suite.mongoDBService,
+		"sourdoughRecipeService":            suite.sourdoughRecipeService,
+		"sourdoughRecipeRevisionRepository": suite.revisionRepository,
+		"inventoryService":                  suite.inventoryService,
+	}
+	for _, key := range without {
+		delete(values, key)
+	}
+
+	ctx := context.Background()
+	for key, value := range values {
+		ctx = context.WithValue(ctx, key, value)
+	}
+	return ctx
+}
+
+func (suite *BakeLogDependencyServiceTestSuite) TestInitialize() {
+	err := suite.target.Initialize(suite.context())
+
+	suite.NoError(err)
+	suite.Equal(suite.repository, suite.target.Repository())
+	suite.Equal(suite.service, suite.target.Service())
+	suite.Equal(suite.handler, suite.target.Router())
+}
+
+func (suite *BakeLogDependencyServiceTestSuite) TestInitialize_WithEmbeddedDatabase() {
+	ctx := context.WithValue(suite.context("mongoDBService"), "embeddedDatabase", suite.embeddedDatabase)
+	target := suite.target.(*bakeLogDependencyService)
+	target.serviceCreator = func(transactionRunner domain.TransactionRunner, _ domain.BakeLogRepository, _ domain.SourdoughRecipeService, _ domain.SourdoughRecipeRevisionRepository, _ domain.InventoryService) (domain.BakeLogService, error) {
+		suite.Equal(suite.embeddedTransactionRunner, transactionRunner)
+		return suite.service, nil
+	}
+
+	err := suite.target.Initialize(ctx)
+
+	suite.NoError(err)
+	suite.Equal(suite.embeddedRepository, suite.target.Repository())
+}
+
+func (suite *BakeLogDependencyServiceTestSuite) TestInitialize_WithMissingContextValues() {
+	tests := []struct {
+		name             string
+		ctx              context.Context
+		expectedErrorMsg string
+	}{
+		{
+			name:             "mongoDBService is nil",
+			ctx:              suite.context("mongoDBService"),
+			expectedErrorMsg: "failed to get mongoDBService from context",
+		},
+		{
+			name:             "sourdoughRecipeService is nil",
+			ctx:              suite.context("sourdoughRecipeService"),
+			expectedErrorMsg: "failed to get sourdoughRecipeService from context",
+		},
+		{
+			name:             "sourdoughRecipeRevisionRepository is nil",
+			ctx:              suite.context("sourdoughRecipeRevisionRepository"),
+			expectedErrorMsg: "failed to get sourdoughRecipeRevisionRepository from context",
+		},
+		{
+			name:             "inventoryService is nil",
+			ctx:              suite.context("inventoryService"),
+			expectedErrorMsg: "failed to get inventoryService from context",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			err := suite.target.Initialize(tt.ctx)
+
+			suite.ErrorContains(err, tt.expectedErrorMsg)
+			suite.Nil(suite.target.Repository())
+			suite.Nil(suite.target.Service())
+			suite.Nil(suite.target.Router())
+		})
+	}
+}
+
+func (suite *BakeLogDependencyServiceTestSuite) TestInitialize_WithError() {
+	baseService := bakeLogDependencyService{
+		transactionRunnerCreator: func(_ domain.MongoDBService) (domain.TransactionRunner, error) {
+			return suite.transactionRunner, nil
+		},
+		repositoryCreator: func(_ domain.MongoDBService) (domain.BakeLogRepository, error) {
+			return suite.repository, nil
+		},
+		serviceCreator: func(_ domain.TransactionRunner, _ domain.BakeLogRepository, _ domain.SourdoughRecipeService, _ domain.SourdoughRecipeRevisionRepository, _ domain.InventoryService) (domain.BakeLogService, error) {
+			return suite.service, nil
+		},
+		handlerCreator: func(_ domain.BakeLogService) (domain.BakeLogHandler, error) {
+			return suite.handler, nil
+		},
+	}
+
+	tests := []struct {
+		name             string
+		serviceCreator   func(service bakeLogDependencyService) domain.BakeLogDependencyService
+		expectedErrorMsg string
+	}{
+		{
+			name: "repositoryCreator",
+			serviceCreator: func(service bakeLogDependencyService) domain.BakeLogDependencyService {
+				service.repositoryCreator = func(_ domain.MongoDBService) (domain.BakeLogRepository, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create repository",
+		},
+		{
+			name: "transactionRunnerCreator",
+			serviceCreator: func(service bakeLogDependencyService) domain.BakeLogDependencyService {
+				service.transactionRunnerCreator = func(_ domain.MongoDBService) (domain.TransactionRunner, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create transaction runner",
+		},
+		{
+			name: "serviceCreator",
+			serviceCreator: func(service bakeLogDependencyService) domain.BakeLogDependencyService {
+				service.serviceCreator = func(_ domain.TransactionRunner, _ domain.BakeLogRepository, _ domain.SourdoughRecipeService, _ domain.SourdoughRecipeRevisionRepository, _ domain.InventoryService) (domain.BakeLogService, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create service",
+		},
+		{
+			name: "handlerCreator",
+			serviceCreator: func(service bakeLogDependencyService) domain.BakeLogDependencyService {
+				service.handlerCreator = func(_ domain.BakeLogService) (domain.BakeLogHandler, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create handler",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			service := tt.serviceCreator(baseService)
+
+			err := service.Initialize(suite.context())
+
+			suite.ErrorContains(err, tt.expectedErrorMsg)
+			suite.Nil(service.Repository())
+			suite.Nil(service.Service())
+			suite.Nil(service.Router())
+		})
+	}
+}
+
+func (suite *BakeLogDependencyServiceTestSuite) TestNewBakeLogDependencyService() {
+	target := NewBakeLogDependencyService().(*bakeLogDependencyService)
+
+	suite.NotNil(target)
+	suite.NotNil(target.transactionRunnerCreator)
+	suite.NotNil(target.embeddedTransactionRunnerCreator)
+	suite.NotNil(target.repositoryCreator)
+	suite.NotNil(target.embeddedRepositoryCreator)
+	suite.NotNil(target.serviceCreator)
+	suite.NotNil(target.handlerCreator)
+	suite.Nil(target.repository)
+	suite.Nil(target.service)
+	suite.Nil(target.handler)
+}
+
+func TestBakeLogDependencyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(BakeLogDependencyServiceTestSuite))
+}
@@ -0,0 +1,58 @@
+package dependency
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/controller/rest"
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/service"
+)
+
+type calculatorDependencyService struct {
+	serviceCreator func() (domain.CalculatorService, error)
+	service        domain.CalculatorService
+
+	handlerCreator func(service domain.CalculatorService) (domain.CalculatorHandler, error)
+	handler        domain.CalculatorHandler
+}
+
+func (dependencyService *calculatorDependencyService) Initialize(_ context.Context) error {
+	calculatorService, err := dependencyService.serviceCreator()
+	if err != nil {
+		return errors.Wrap(err, "failed to create service")
+	}
+
+	calculatorHandler, err := dependencyService.handlerCreator(calculatorService)
+	if err != nil {
+		return errors.Wrap(err, "failed to create handler")
+	}
+
+	dependencyService.service = calculatorService
+	dependencyService.handler = calculatorHandler
+
+	return nil
+}
+
+func (dependencyService *calculatorDependencyService) Service() domain.CalculatorService {
+	return dependencyService.service
+}
+
+func (dependencyService *calculatorDependencyService) Router() domain.CalculatorHandler {
+	return dependencyService.handler
+}
+
+func NewCalculatorDependencyService() domain.CalculatorDependencyService {
+	return newCalculatorDependencyService(service.NewCalculatorService, rest.NewCalculatorHandler)
+}
+
+func newCalculatorDependencyService(
+	serviceCreator func() (domain.CalculatorService, error),
+	handlerCreator func(service domain.CalculatorService) (domain.CalculatorHandler, error),
+) domain.CalculatorDependencyService {
+	return &calculatorDependencyService{
+		serviceCreator: serviceCreator,
+		handlerCreator: handlerCreator,
+	}
+}
@@ -0,0 +1,112 @@
+package dependency
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	"dough-calculator/internal/test"
+)
+
+type CalculatorDependencyServiceTestSuite struct {
+	test.GoMockTestSuite
+
+	service *mocks.MockCalculatorService
+	handler *mocks.MockCalculatorHandler
+
+	target domain.CalculatorDependencyService
+}
+
+func (suite *CalculatorDependencyServiceTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.service = mocks.NewMockCalculatorService(suite.MockCtrl)
+	suite.handler = mocks.NewMockCalculatorHandler(suite.MockCtrl)
+
+	suite.target = newCalculatorDependencyService(
+		func() (domain.CalculatorService, error) {
+			return suite.service, nil
+		},
+		func(_ domain.CalculatorService) (domain.CalculatorHandler, error) {
+			return suite.handler, nil
+		},
+	)
+}
+
+func (suite *CalculatorDependencyServiceTestSuite) TestInitialize() {
+	err := suite.target.Initialize(context.Background())
+
+	suite.NoError(err)
+	suite.Equal(suite.service, suite.target.Service())
+	suite.Equal(suite.handler, suite.target.Router())
+}
+
+func (suite *CalculatorDependencyServiceTestSuite) TestInitialize_WithError() {
+	baseService := calculatorDependencyService{
+		serviceCreator: func() (domain.CalculatorService, error) {
+			return suite.service, nil
+		},
+		handlerCreator: func(_ domain.CalculatorService) (domain.CalculatorHandler, error) {
+			return suite.handler, nil
+		},
+	}
+
+	tests := []struct {
+		name             string
+		serviceCreator   func(service calculatorDependencyService) domain.CalculatorDependencyService
+		expectedErrorMsg string
+	}{
+		{
+			name: "serviceCreator",
+			serviceCreator: func(service calculatorDependencyService) domain.CalculatorDependencyService {
+				service.serviceCreator = func() (domain.CalculatorService, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create service",
+		},
+		{
+			name: "handlerCreator",
+			serviceCreator: func(service calculatorDependencyService) domain.CalculatorDependencyService {
+				service.handlerCreator = func(_ domain.CalculatorService) (domain.CalculatorHandler, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create handler",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			service := tt.serviceCreator(baseService)
+
+			err := service.Initialize(context.Background())
+
+			suite.ErrorContains(err, tt.expectedErrorMsg)
+			suite.Nil(service.Service())
+			suite.Nil(service.Router())
+		})
+	}
+}
+
+func (suite *CalculatorDependencyServiceTestSuite) TestNewCalculatorDependencyService() {
+	target := NewCalculatorDependencyService().(*calculatorDependencyService)
+
+	suite.NotNil(target)
+	suite.NotNil(target.serviceCreator)
+	suite.NotNil(target.handlerCreator)
+	suite.Nil(target.service)
+	suite.Nil(target.handler)
+}
+
+func TestCalculatorDependencyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(CalculatorDependencyServiceTestSuite))
+}
@@ -20,6 +20,9 @@ type commonDependencyService struct {
 
 	mongoDBServiceCreator func(config config.Database) (domain.MongoDBService, error)
 	mongoDBService        domain.MongoDBService
+
+	embeddedDatabaseCreator func(config config.Database) (domain.EmbeddedDatabase, error)
+	embeddedDatabase        domain.EmbeddedDatabase
 }
 
 func (dependencyService *commonDependencyService) Initialize(ctx context.Context) error {
@@ -30,14 +33,30 @@ func (dependencyService *commonDependencyService) Initialize(ctx context.Context
 		return errors.Wrap(err, "failed to parse config")
 	}
 
-	mongoDBService, err := dependencyService.mongoDBServiceCreator(configManager.GetConfig().Database)
-	if err != nil {
-		return errors.Wrap(err, "failed to create mongodb service")
+	applicationConfig := configManager.GetConfig()
+	database := applicationConfig.Database
+	if database.Embedded() {
+		// images cannot go to GridFS without a MongoDB server
+		if storage := applicationConfig.Storage; storage.Type != config.StorageTypeLocal {
+			return errors.Errorf("storage type '%s' requires MongoDB, use '%s' with the %s database",
+				storage.Type, config.StorageTypeLocal, database.Type)
+		}
+
+		embeddedDatabase, err := dependencyService.embeddedDatabaseCreator(database)
+		if err != nil {
+			return errors.Wrap(err, "failed to create embedded database")
+		}
+		dependencyService.embeddedDatabase = embeddedDatabase
+	} else {
+		mongoDBService, err := dependencyService.mongoDBServiceCreator(database)
+		if err != nil {
+			return errors.Wrap(err, "failed to create mongodb service")
+		}
+		dependencyService.mongoDBService = mongoDBService
 	}
 
 	dependencyService.actuatorHandler = dependencyService.actuatorHandlerCreator()
 	dependencyService.configManager = configManager
-	dependencyService.mongoDBService = mongoDBService
 
 	return nil
 }
@@ -50,22 +69,37 @@ func (dependencyService *commonDependencyService) Actuator() domain.ActuatorHand
 	return dependencyService.actuatorHandler
 }
 
+// MongoDBService returns the MongoDB connection, nil when an embedded
+// database keeps the data.
 func (dependencyService *commonDependencyService) MongoDBService() domain.MongoDBService {
 	return dependencyService.mongoDBService
 }
 
+// EmbeddedDatabase returns the embedded database, nil when the data is kept
+// in MongoDB.
+func (dependencyService *commonDependencyService) EmbeddedDatabase() domain.EmbeddedDatabase {
+	return dependencyService.embeddedDatabase
+}
+
 func NewCommonDependencyService() domain.CommonDependencyService {
-	return newCommonDependencyService(service.NewConfigManager, rest.NewActuatorHandler, service.NewMongoDBService)
+	return newCommonDependencyService(
+		service.NewConfigManager,
+		rest.NewActuatorHandler,
+		service.NewMongoDBService,
+		service.NewEmbeddedDatabase,
+	)
 }
 
 func newCommonDependencyService(
 	configManagerCreator func() domain.ConfigManager,
 	actuatorHandlerCreator func() domain.ActuatorHandler,
 	mongoDBServiceCreator func(config config.Database) (domain.MongoDBService, error),
+	embeddedDatabaseCreator func(config config.Database) (domain.EmbeddedDatabase, error),
 ) domain.CommonDependencyService {
 	return &commonDependencyService{
-		configManagerCreator:   configManagerCreator,
-		actuatorHandlerCreator: actuatorHandlerCreator,
-		mongoDBServiceCreator:  mongoDBServiceCreator,
+		configManagerCreator:    configManagerCreator,
+		actuatorHandlerCreator:  actuatorHandlerCreator,
+		mongoDBServiceCreator:   mongoDBServiceCreator,
+		embeddedDatabaseCreator: embeddedDatabaseCreator,
 	}
 }
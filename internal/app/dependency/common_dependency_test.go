@@ -20,9 +20,10 @@ func TestCommonDependencyServiceTestSuite(t *testing.T) {
 type CommonDependencyServiceTestSuite struct {
 	test.GoMockTestSuite
 
-	actuatorHandler *mocks.MockActuatorHandler
-	configManager   *mocks.MockConfigManager
-	mongoDBService  *mocks.MockMongoDBService
+	actuatorHandler  *mocks.MockActuatorHandler
+	configManager    *mocks.MockConfigManager
+	mongoDBService   *mocks.MockMongoDBService
+	embeddedDatabase *mocks.MockEmbeddedDatabase
 
 	target domain.CommonDependencyService
 }
@@ -33,6 +34,7 @@ func (suite *CommonDependencyServiceTestSuite) SetupTest() {
 	suite.actuatorHandler = mocks.NewMockActuatorHandler(suite.MockCtrl)
 	suite.configManager = mocks.NewMockConfigManager(suite.MockCtrl)
 	suite.mongoDBService = mocks.NewMockMongoDBService(suite.MockCtrl)
+	suite.embeddedDatabase = mocks.NewMockEmbeddedDatabase(suite.MockCtrl)
 
 	suite.target = newCommonDependencyService(func() domain.ConfigManager {
 		return suite.configManager
@@ -40,6 +42,8 @@ func (suite *CommonDependencyServiceTestSuite) SetupTest() {
 		return suite.actuatorHandler
 	}, func(config config.Database) (domain.MongoDBService, error) {
 		return suite.mongoDBService, nil
+	}, func(config config.Database) (domain.EmbeddedDatabase, error) {
+		return suite.embeddedDatabase, nil
 	})
 }
 
@@ -58,6 +62,44 @@ func (suite *CommonDependencyServiceTestSuite) TestInitialize() {
 	suite.Equal(suite.configManager, suite.target.ConfigManager())
 	suite.Equal(suite.actuatorHandler, suite.target.Actuator())
 	suite.Equal(suite.mongoDBService, suite.target.MongoDBService())
+	suite.Nil(suite.target.EmbeddedDatabase())
+}
+
+func (suite *CommonDependencyServiceTestSuite) TestInitialize_WithEmbeddedDatabase() {
+	suite.configManager.EXPECT().
+		ParseConfig().
+		Return(nil)
+	suite.configManager.EXPECT().
+		GetConfig().
+		Return(config.Config{
+			Database: config.Database{Type: config.DatabaseTypeMemory},
+			Storage:  config.Storage{Type: config.StorageTypeLocal},
+		})
+
+	err := suite.target.Initialize(context.Background())
+
+	suite.NoError(err)
+
+	suite.Equal(suite.embeddedDatabase, suite.target.EmbeddedDatabase())
+	suite.Nil(suite.target.MongoDBService())
+}
+
+func (suite *CommonDependencyServiceTestSuite) TestInitialize_WithEmbeddedDatabaseAndGridFS() {
+	suite.configManager.EXPECT().
+		ParseConfig().
+		Return(nil)
+	suite.configManager.EXPECT().
+		GetConfig().
+		Return(config.Config{
+			Database: config.Database{Type: config.DatabaseTypeBolt},
+			Storage:  config.Storage{Type: config.StorageTypeGridFS},
+		})
+
+	err := suite.target.Initialize(context.Background())
+
+	suite.ErrorContains(err, "storage type 'gridfs' requires MongoDB")
+
+	suite.Nil(suite.target.EmbeddedDatabase())
 }
 
 func (suite *CommonDependencyServiceTestSuite) TestInitialize_WithErrorOnParseConfig() {
@@ -86,6 +128,8 @@ func (suite *CommonDependencyServiceTestSuite) TestInitialize_WithErrorOnMongoDB
 		return suite.actuatorHandler
 	}, func(config config.Database) (domain.MongoDBService, error) {
 		return nil, assert.AnError
+	}, func(config config.Database) (domain.EmbeddedDatabase, error) {
+		return suite.embeddedDatabase, nil
 	})
 
 	err := suite.target.Initialize(context.Background())
@@ -95,6 +139,34 @@ func (suite *CommonDependencyServiceTestSuite) TestInitialize_WithErrorOnMongoDB
 	suite.Nil(suite.target.MongoDBService())
 }
 
+func (suite *CommonDependencyServiceTestSuite) TestInitialize_WithErrorOnEmbeddedDatabaseCreator() {
+	suite.configManager.EXPECT().
+		ParseConfig().
+		Return(nil)
+	suite.configManager.EXPECT().
+		GetConfig().
+		Return(config.Config{
+			Database: config.Database{Type: config.DatabaseTypeBolt},
+			Storage:  config.Storage{Type: config.StorageTypeLocal},
+		})
+
+	suite.target = newCommonDependencyService(func() domain.ConfigManager {
+		return suite.configManager
+	}, func() domain.ActuatorHandler {
+		return suite.actuatorHandler
+	}, func(config config.Database) (domain.MongoDBService, error) {
+		return suite.mongoDBService, nil
+	}, func(config config.Database) (domain.EmbeddedDatabase, error) {
+		return nil, assert.AnError
+	})
+
+	err := suite.target.Initialize(context.Background())
+
+	suite.ErrorContains(err, "failed to create embedded database")
+
+	suite.Nil(suite.target.EmbeddedDatabase())
+}
+
 func TestNewCommonDependencyService(t *testing.T) {
 	service := NewCommonDependencyService().(*commonDependencyService)
 	assert.NotNil(t, service)
@@ -104,4 +176,6 @@ func TestNewCommonDependencyService(t *testing.T) {
 	assert.Nil(t, service.actuatorHandler)
 	assert.NotNil(t, service.mongoDBServiceCreator)
 	assert.Nil(t, service.mongoDBService)
+	assert.NotNil(t, service.embeddedDatabaseCreator)
+	assert.Nil(t, service.embeddedDatabase)
 }
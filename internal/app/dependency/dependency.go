@@ -9,10 +9,22 @@ import (
 )
 
 type dependencyManager struct {
-	commonDependencyService               domain.CommonDependencyService
-	sourdoughRecipeDependencyService      domain.SourdoughRecipeDependencyService
-	sourdoughRecipeScaleDependencyService domain.SourdoughRecipeScaleDependencyService
-	flourDependencyService                domain.FlourDependencyService
+	commonDependencyService                  domain.CommonDependencyService
+	migrationDependencyService               domain.MigrationDependencyService
+	sourdoughRecipeDependencyService         domain.SourdoughRecipeDependencyService
+	sourdoughRecipeScaleDependencyService    domain.SourdoughRecipeScaleDependencyService
+	sourdoughRecipeRevisionDependencyService domain.SourdoughRecipeRevisionDependencyService
+	bakeLogDependencyService                 domain.BakeLogDependencyService
+	imageDependencyService                   domain.ImageDependencyService
+	flourDependencyService                   domain.FlourDependencyService
+	inventoryDependencyService               domain.InventoryDependencyService
+	hydrationDependencyService               domain.HydrationDependencyService
+	substitutionDependencyService            domain.SourdoughRecipeSubstitutionDependencyService
+	calculatorDependencyService              domain.CalculatorDependencyService
+	productionPlanDependencyService          domain.ProductionPlanDependencyService
+	recipeBundleDependencyService            domain.RecipeBundleDependencyService
+	parseDependencyService                   domain.SourdoughRecipeParseDependencyService
+	jsonLdDependencyService                  domain.RecipeJsonLdDependencyService
 }
 
 func (manager *dependencyManager) Initialize(ctx context.Context) error {
@@ -23,22 +35,111 @@ func (manager *dependencyManager) Initialize(ctx context.Context) error {
 
 	ctx = context.WithValue(ctx, "configManager", manager.commonDependencyService.ConfigManager())
 	ctx = context.WithValue(ctx, "mongoDBService", manager.commonDependencyService.MongoDBService())
+	ctx = context.WithValue(ctx, "embeddedDatabase", manager.commonDependencyService.EmbeddedDatabase())
+
+	err = manager.migrationDependencyService.Initialize(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize migration dependency service")
+	}
+
+	// the repositories rely on the indexes, so migrations run before any of them is used
+	migrationService := manager.migrationDependencyService.Service()
+	if migrationService != nil && !manager.commonDependencyService.ConfigManager().GetConfig().Database.Migrations.SkipOnStartup {
+		if _, err = migrationService.Up(ctx); err != nil {
+			return errors.Wrap(err, "failed to run migrations")
+		}
+	}
+
+	// forks resolve their substituted flours in the flour catalogue
+	err = manager.flourDependencyService.Initialize(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize flour dependency service")
+	}
+
+	ctx = context.WithValue(ctx, "flourRepository", manager.flourDependencyService.Repository())
+	ctx = context.WithValue(ctx, "flourService", manager.flourDependencyService.Service())
+
+	// the recipe handlers negotiate JSON-LD, whose service is initialized last
+	ctx = context.WithValue(ctx, "recipeJsonLdService", recipeJsonLdServiceProxy{manager.jsonLdDependencyService})
 
 	err = manager.sourdoughRecipeDependencyService.Initialize(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to initialize sourdough recipe dependency service")
 	}
 
+	ctx = context.WithValue(ctx, "sourdoughRecipeRepository", manager.sourdoughRecipeDependencyService.Repository())
 	ctx = context.WithValue(ctx, "sourdoughRecipeService", manager.sourdoughRecipeDependencyService.Service())
+	ctx = context.WithValue(ctx, "sourdoughRecipeRevisionRepository", manager.sourdoughRecipeDependencyService.RevisionRepository())
+	ctx = context.WithValue(ctx, "bakeSheetRenderer", manager.sourdoughRecipeDependencyService.BakeSheetRenderer())
 
 	err = manager.sourdoughRecipeScaleDependencyService.Initialize(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to initialize sourdough recipe scale dependency service")
 	}
 
-	err = manager.flourDependencyService.Initialize(ctx)
+	ctx = context.WithValue(ctx, "sourdoughRecipeScaleService", manager.sourdoughRecipeScaleDependencyService.Service())
+
+	err = manager.sourdoughRecipeRevisionDependencyService.Initialize(ctx)
 	if err != nil {
-		return errors.Wrap(err, "failed to initialize flour dependency service")
+		return errors.Wrap(err, "failed to initialize sourdough recipe revision dependency service")
+	}
+
+	err = manager.recipeBundleDependencyService.Initialize(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize recipe bundle dependency service")
+	}
+
+	err = manager.parseDependencyService.Initialize(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize sourdough recipe parse dependency service")
+	}
+
+	ctx = context.WithValue(ctx, "sourdoughRecipeParseService", manager.parseDependencyService.Service())
+
+	err = manager.inventoryDependencyService.Initialize(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize inventory dependency service")
+	}
+
+	ctx = context.WithValue(ctx, "inventoryService", manager.inventoryDependencyService.Service())
+
+	err = manager.bakeLogDependencyService.Initialize(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize bake log dependency service")
+	}
+
+	ctx = context.WithValue(ctx, "bakeLogService", manager.bakeLogDependencyService.Service())
+
+	err = manager.imageDependencyService.Initialize(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize image dependency service")
+	}
+
+	ctx = context.WithValue(ctx, "imageService", manager.imageDependencyService.Service())
+
+	err = manager.jsonLdDependencyService.Initialize(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize recipe JSON-LD dependency service")
+	}
+
+	err = manager.hydrationDependencyService.Initialize(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize hydration dependency service")
+	}
+
+	err = manager.substitutionDependencyService.Initialize(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize sourdough recipe substitution dependency service")
+	}
+
+	err = manager.calculatorDependencyService.Initialize(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize calculator dependency service")
+	}
+
+	err = manager.productionPlanDependencyService.Initialize(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize production plan dependency service")
 	}
 
 	return nil
@@ -52,34 +153,118 @@ func (manager *dependencyManager) SourdoughRecipeScale() domain.SourdoughRecipeS
 	return manager.sourdoughRecipeScaleDependencyService
 }
 
+func (manager *dependencyManager) SourdoughRecipeSubstitution() domain.SourdoughRecipeSubstitutionDependencyService {
+	return manager.substitutionDependencyService
+}
+
+func (manager *dependencyManager) SourdoughRecipeRevision() domain.SourdoughRecipeRevisionDependencyService {
+	return manager.sourdoughRecipeRevisionDependencyService
+}
+
+func (manager *dependencyManager) BakeLog() domain.BakeLogDependencyService {
+	return manager.bakeLogDependencyService
+}
+
+func (manager *dependencyManager) Image() domain.ImageDependencyService {
+	return manager.imageDependencyService
+}
+
 func (manager *dependencyManager) Common() domain.CommonDependencyService {
 	return manager.commonDependencyService
 }
 
+func (manager *dependencyManager) Migration() domain.MigrationDependencyService {
+	return manager.migrationDependencyService
+}
+
 func (manager *dependencyManager) Flour() domain.FlourDependencyService {
 	return manager.flourDependencyService
 }
 
+func (manager *dependencyManager) Inventory() domain.InventoryDependencyService {
+	return manager.inventoryDependencyService
+}
+
+func (manager *dependencyManager) Hydration() domain.HydrationDependencyService {
+	return manager.hydrationDependencyService
+}
+
+func (manager *dependencyManager) Calculator() domain.CalculatorDependencyService {
+	return manager.calculatorDependencyService
+}
+
+func (manager *dependencyManager) ProductionPlan() domain.ProductionPlanDependencyService {
+	return manager.productionPlanDependencyService
+}
+
+func (manager *dependencyManager) RecipeBundle() domain.RecipeBundleDependencyService {
+	return manager.recipeBundleDependencyService
+}
+
+func (manager *dependencyManager) SourdoughRecipeParse() domain.SourdoughRecipeParseDependencyService {
+	return manager.parseDependencyService
+}
+
+func (manager *dependencyManager) RecipeJsonLd() domain.RecipeJsonLdDependencyService {
+	return manager.jsonLdDependencyService
+}
+
 func NewDependencyManager() domain.DependencyManager {
 	return newDependencyManager(
 		NewCommonDependencyService(),
+		NewMigrationDependencyService(),
 		NewSourdoughRecipeDependencyService(),
 		NewSourdoughRecipeScaleDependencyService(),
+		NewSourdoughRecipeRevisionDependencyService(),
+		NewBakeLogDependencyService(),
+		NewImageDependencyService(),
 		NewFlourDependencyService(),
+		NewInventoryDependencyService(),
+		NewHydrationDependencyService(),
+		NewSourdoughRecipeSubstitutionDependencyService(),
+		NewCalculatorDependencyService(),
+		NewProductionPlanDependencyService(),
+		NewRecipeBundleDependencyService(),
+		NewSourdoughRecipeParseDependencyService(),
+		NewRecipeJsonLdDependencyService(),
 	)
 }
 
 func newDependencyManager(
 	commonDependencyService domain.CommonDependencyService,
+	migrationDependencyService domain.MigrationDependencyService,
 	sourdoughRecipeDependencyService domain.SourdoughRecipeDependencyService,
 	sourdoughRecipeScaleDependencyService domain.SourdoughRecipeScaleDependencyService,
+	sourdoughRecipeRevisionDependencyService domain.SourdoughRecipeRevisionDependencyService,
+	bakeLogDependencyService domain.BakeLogDependencyService,
+	imageDependencyService domain.ImageDependencyService,
 	flourDependencyService domain.FlourDependencyService,
+	inventoryDependencyService domain.InventoryDependencyService,
+	hydrationDependencyService domain.HydrationDependencyService,
+	substitutionDependencyService domain.SourdoughRecipeSubstitutionDependencyService,
+	calculatorDependencyService domain.CalculatorDependencyService,
+	productionPlanDependencyService domain.ProductionPlanDependencyService,
+	recipeBundleDependencyService domain.RecipeBundleDependencyService,
+	parseDependencyService domain.SourdoughRecipeParseDependencyService,
+	jsonLdDependencyService domain.RecipeJsonLdDependencyService,
 ) domain.DependencyManager {
 	return &dependencyManager{
-		commonDependencyService:               commonDependencyService,
-		sourdoughRecipeDependencyService:      sourdoughRecipeDependencyService,
-		sourdoughRecipeScaleDependencyService: sourdoughRecipeScaleDependencyService,
-		flourDependencyService:                flourDependencyService,
+		commonDependencyService:                  commonDependencyService,
+		migrationDependencyService:               migrationDependencyService,
+		sourdoughRecipeDependencyService:         sourdoughRecipeDependencyService,
+		sourdoughRecipeScaleDependencyService:    sourdoughRecipeScaleDependencyService,
+		sourdoughRecipeRevisionDependencyService: sourdoughRecipeRevisionDependencyService,
+		bakeLogDependencyService:                 bakeLogDependencyService,
+		imageDependencyService:                   imageDependencyService,
+		flourDependencyService:                   flourDependencyService,
+		inventoryDependencyService:               inventoryDependencyService,
+		hydrationDependencyService:               hydrationDependencyService,
+		substitutionDependencyService:            substitutionDependencyService,
+		calculatorDependencyService:              calculatorDependencyService,
+		productionPlanDependencyService:          productionPlanDependencyService,
+		recipeBundleDependencyService:            recipeBundleDependencyService,
+		parseDependencyService:                   parseDependencyService,
+		jsonLdDependencyService:                  jsonLdDependencyService,
 	}
 }
 
@@ -100,6 +285,25 @@ func getFromContext[T any](ctx context.Context, key string) (t T, err error) {
 	return t, nil
 }
 
+// createOnBackend creates a repository or transaction runner on the
+// embedded database when the context holds one and on MongoDB otherwise.
+func createOnBackend[T any](
+	ctx context.Context,
+	mongoDBCreator func(mongoDBService domain.MongoDBService) (T, error),
+	embeddedCreator func(database domain.EmbeddedDatabase) (T, error),
+) (t T, err error) {
+	if database, err := getFromContext[domain.EmbeddedDatabase](ctx, "embeddedDatabase"); err == nil {
+		return embeddedCreator(database)
+	}
+
+	mongoDBService, err := getFromContext[domain.MongoDBService](ctx, "mongoDBService")
+	if err != nil {
+		return t, errors.Wrap(err, "failed to get mongoDBService from context")
+	}
+
+	return mongoDBCreator(mongoDBService)
+}
+
 func isNil[T any](t T) bool {
 	switch casted := any(t).(type) {
 	case interface{}:
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 
+	"dough-calculator/internal/config"
 	"dough-calculator/internal/domain"
 	"dough-calculator/internal/domain/mocks"
 	"dough-calculator/internal/test"
@@ -21,13 +22,48 @@ type DependencyManagerTestSuite struct {
 	mongoDBService          *mocks.MockMongoDBService
 	commonDependencyService *mocks.MockCommonDependencyService
 
+	migrationService           *mocks.MockMigrationService
+	migrationDependencyService *mocks.MockMigrationDependencyService
+
+	sourdoughRecipeRepository        *mocks.MockSourdoughRecipeRepository
 	sourdoughRecipeService           *mocks.MockSourdoughRecipeService
+	bakeSheetRenderer                *mocks.MockBakeSheetRenderer
 	sourdoughRecipeDependencyService *mocks.MockSourdoughRecipeDependencyService
 
+	sourdoughRecipeScaleService           *mocks.MockSourdoughRecipeScaleService
 	sourdoughRecipeScaleDependencyService *mocks.MockSourdoughRecipeScaleDependencyService
 
+	sourdoughRecipeRevisionRepository        *mocks.MockSourdoughRecipeRevisionRepository
+	sourdoughRecipeRevisionDependencyService *mocks.MockSourdoughRecipeRevisionDependencyService
+
+	bakeLogService           *mocks.MockBakeLogService
+	bakeLogDependencyService *mocks.MockBakeLogDependencyService
+
+	imageService           *mocks.MockImageService
+	imageDependencyService *mocks.MockImageDependencyService
+
+	flourRepository        *mocks.MockFlourRepository
+	flourService           *mocks.MockFlourService
 	flourDependencyService *mocks.MockFlourDependencyService
 
+	inventoryService           *mocks.MockInventoryService
+	inventoryDependencyService *mocks.MockInventoryDependencyService
+
+	hydrationDependencyService *mocks.MockHydrationDependencyService
+
+	substitutionDependencyService *mocks.MockSourdoughRecipeSubstitutionDependencyService
+
+	calculatorDependencyService *mocks.MockCalculatorDependencyService
+
+	productionPlanDependencyService *mocks.MockProductionPlanDependencyService
+
+	recipeBundleDependencyService *mocks.MockRecipeBundleDependencyService
+
+	parseService           *mocks.MockSourdoughRecipeParseService
+	parseDependencyService *mocks.MockSourdoughRecipeParseDependencyService
+
+	jsonLdDependencyService *mocks.MockRecipeJsonLdDependencyService
+
 	target domain.DependencyManager
 }
 
@@ -38,18 +74,65 @@ func (suite *DependencyManagerTestSuite) SetupTest() {
 	suite.mongoDBService = mocks.NewMockMongoDBService(suite.MockCtrl)
 	suite.commonDependencyService = mocks.NewMockCommonDependencyService(suite.MockCtrl)
 
+	suite.migrationService = mocks.NewMockMigrationService(suite.MockCtrl)
+	suite.migrationDependencyService = mocks.NewMockMigrationDependencyService(suite.MockCtrl)
+
+	suite.sourdoughRecipeRepository = mocks.NewMockSourdoughRecipeRepository(suite.MockCtrl)
 	suite.sourdoughRecipeService = mocks.NewMockSourdoughRecipeService(suite.MockCtrl)
+	suite.bakeSheetRenderer = mocks.NewMockBakeSheetRenderer(suite.MockCtrl)
 	suite.sourdoughRecipeDependencyService = mocks.NewMockSourdoughRecipeDependencyService(suite.MockCtrl)
 
+	suite.sourdoughRecipeScaleService = mocks.NewMockSourdoughRecipeScaleService(suite.MockCtrl)
 	suite.sourdoughRecipeScaleDependencyService = mocks.NewMockSourdoughRecipeScaleDependencyService(suite.MockCtrl)
 
+	suite.sourdoughRecipeRevisionRepository = mocks.NewMockSourdoughRecipeRevisionRepository(suite.MockCtrl)
+	suite.sourdoughRecipeRevisionDependencyService = mocks.NewMockSourdoughRecipeRevisionDependencyService(suite.MockCtrl)
+
+	suite.bakeLogService = mocks.NewMockBakeLogService(suite.MockCtrl)
+	suite.bakeLogDependencyService = mocks.NewMockBakeLogDependencyService(suite.MockCtrl)
+
+	suite.imageService = mocks.NewMockImageService(suite.MockCtrl)
+	suite.imageDependencyService = mocks.NewMockImageDependencyService(suite.MockCtrl)
+
+	suite.flourRepository = mocks.NewMockFlourRepository(suite.MockCtrl)
+	suite.flourService = mocks.NewMockFlourService(suite.MockCtrl)
 	suite.flourDependencyService = mocks.NewMockFlourDependencyService(suite.MockCtrl)
 
+	suite.inventoryService = mocks.NewMockInventoryService(suite.MockCtrl)
+	suite.inventoryDependencyService = mocks.NewMockInventoryDependencyService(suite.MockCtrl)
+
+	suite.hydrationDependencyService = mocks.NewMockHydrationDependencyService(suite.MockCtrl)
+
+	suite.substitutionDependencyService = mocks.NewMockSourdoughRecipeSubstitutionDependencyService(suite.MockCtrl)
+
+	suite.calculatorDependencyService = mocks.NewMockCalculatorDependencyService(suite.MockCtrl)
+
+	suite.productionPlanDependencyService = mocks.NewMockProductionPlanDependencyService(suite.MockCtrl)
+
+	suite.recipeBundleDependencyService = mocks.NewMockRecipeBundleDependencyService(suite.MockCtrl)
+
+	suite.parseService = mocks.NewMockSourdoughRecipeParseService(suite.MockCtrl)
+	suite.parseDependencyService = mocks.NewMockSourdoughRecipeParseDependencyService(suite.MockCtrl)
+
+	suite.jsonLdDependencyService = mocks.NewMockRecipeJsonLdDependencyService(suite.MockCtrl)
+
 	suite.target = newDependencyManager(
 		suite.commonDependencyService,
+		suite.migrationDependencyService,
 		suite.sourdoughRecipeDependencyService,
 		suite.sourdoughRecipeScaleDependencyService,
+		suite.sourdoughRecipeRevisionDependencyService,
+		suite.bakeLogDependencyService,
+		suite.imageDependencyService,
 		suite.flourDependencyService,
+		suite.inventoryDependencyService,
+		suite.hydrationDependencyService,
+		suite.substitutionDependencyService,
+		suite.calculatorDependencyService,
+		suite.productionPlanDependencyService,
+		suite.recipeBundleDependencyService,
+		suite.parseDependencyService,
+		suite.jsonLdDependencyService,
 	)
 }
 
@@ -63,26 +146,134 @@ func (suite *DependencyManagerTestSuite) TestInitialize() {
 		})
 	suite.commonDependencyService.EXPECT().ConfigManager().Return(suite.configManager)
 	suite.commonDependencyService.EXPECT().MongoDBService().Return(suite.mongoDBService)
+	suite.commonDependencyService.EXPECT().EmbeddedDatabase().Return(nil)
+
+	suite.migrationDependencyService.EXPECT().Initialize(gomock.Any()).
+		DoAndReturn(func(ctx context.Context) error {
+			suite.Equal(suite.configManager, ctx.Value("configManager"))
+			suite.Equal(suite.mongoDBService, ctx.Value("mongoDBService"))
+			return nil
+		})
+	suite.migrationDependencyService.EXPECT().Service().Return(suite.migrationService)
+	suite.commonDependencyService.EXPECT().ConfigManager().Return(suite.configManager)
+	suite.configManager.EXPECT().GetConfig().Return(config.Config{})
+	suite.migrationService.EXPECT().Up(gomock.Any()).Return(1, nil)
+
+	suite.flourDependencyService.EXPECT().Initialize(gomock.Any()).
+		DoAndReturn(func(ctx context.Context) error {
+			suite.Equal(suite.mongoDBService, ctx.Value("mongoDBService"))
+			return nil
+		})
+	suite.flourDependencyService.EXPECT().Repository().Return(suite.flourRepository)
+	suite.flourDependencyService.EXPECT().Service().Return(suite.flourService)
 
 	suite.sourdoughRecipeDependencyService.EXPECT().Initialize(gomock.Any()).
 		DoAndReturn(func(ctx context.Context) error {
 			suite.Equal(suite.configManager, ctx.Value("configManager"))
 			suite.Equal(suite.mongoDBService, ctx.Value("mongoDBService"))
+			suite.Equal(suite.flourRepository, ctx.Value("flourRepository"))
 			return nil
 		})
+	suite.sourdoughRecipeDependencyService.EXPECT().Repository().Return(suite.sourdoughRecipeRepository)
 	suite.sourdoughRecipeDependencyService.EXPECT().Service().Return(suite.sourdoughRecipeService)
+	suite.sourdoughRecipeDependencyService.EXPECT().RevisionRepository().Return(suite.sourdoughRecipeRevisionRepository)
+	suite.sourdoughRecipeDependencyService.EXPECT().BakeSheetRenderer().Return(suite.bakeSheetRenderer)
 
 	suite.sourdoughRecipeScaleDependencyService.EXPECT().Initialize(gomock.Any()).
 		DoAndReturn(func(ctx context.Context) error {
 			suite.Equal(suite.configManager, ctx.Value("configManager"))
 			suite.Equal(suite.mongoDBService, ctx.Value("mongoDBService"))
 			suite.Equal(suite.sourdoughRecipeService, ctx.Value("sourdoughRecipeService"))
+			suite.Equal(suite.bakeSheetRenderer, ctx.Value("bakeSheetRenderer"))
 			return nil
 		})
+	suite.sourdoughRecipeScaleDependencyService.EXPECT().Service().Return(suite.sourdoughRecipeScaleService)
 
-	suite.flourDependencyService.EXPECT().Initialize(gomock.Any()).
+	suite.sourdoughRecipeRevisionDependencyService.EXPECT().Initialize(gomock.Any()).
+		DoAndReturn(func(ctx context.Context) error {
+			suite.Equal(suite.sourdoughRecipeService, ctx.Value("sourdoughRecipeService"))
+			suite.Equal(suite.sourdoughRecipeRevisionRepository, ctx.Value("sourdoughRecipeRevisionRepository"))
+			return nil
+		})
+
+	suite.recipeBundleDependencyService.EXPECT().Initialize(gomock.Any()).
+		DoAndReturn(func(ctx context.Context) error {
+			suite.Equal(suite.mongoDBService, ctx.Value("mongoDBService"))
+			suite.Equal(suite.sourdoughRecipeRepository, ctx.Value("sourdoughRecipeRepository"))
+			suite.Equal(suite.sourdoughRecipeService, ctx.Value("sourdoughRecipeService"))
+			suite.Equal(suite.flourRepository, ctx.Value("flourRepository"))
+			suite.Equal(suite.flourService, ctx.Value("flourService"))
+			return nil
+		})
+
+	suite.parseDependencyService.EXPECT().Initialize(gomock.Any()).
+		DoAndReturn(func(ctx context.Context) error {
+			suite.Equal(suite.flourRepository, ctx.Value("flourRepository"))
+			return nil
+		})
+	suite.parseDependencyService.EXPECT().Service().Return(suite.parseService)
+
+	suite.inventoryDependencyService.EXPECT().Initialize(gomock.Any()).
+		DoAndReturn(func(ctx context.Context) error {
+			suite.Equal(suite.mongoDBService, ctx.Value("mongoDBService"))
+			suite.Equal(suite.flourRepository, ctx.Value("flourRepository"))
+			suite.Equal(suite.sourdoughRecipeScaleService, ctx.Value("sourdoughRecipeScaleService"))
+			return nil
+		})
+	suite.inventoryDependencyService.EXPECT().Service().Return(suite.inventoryService)
+
+	suite.bakeLogDependencyService.EXPECT().Initialize(gomock.Any()).
+		DoAndReturn(func(ctx context.Context) error {
+			suite.Equal(suite.mongoDBService, ctx.Value("mongoDBService"))
+			suite.Equal(suite.sourdoughRecipeService, ctx.Value("sourdoughRecipeService"))
+			suite.Equal(suite.inventoryService, ctx.Value("inventoryService"))
+			return nil
+		})
+	suite.bakeLogDependencyService.EXPECT().Service().Return(suite.bakeLogService)
+
+	suite.imageDependencyService.EXPECT().Initialize(gomock.Any()).
+		DoAndReturn(func(ctx context.Context) error {
+			suite.Equal(suite.configManager, ctx.Value("configManager"))
+			suite.Equal(suite.mongoDBService, ctx.Value("mongoDBService"))
+			suite.Equal(suite.sourdoughRecipeService, ctx.Value("sourdoughRecipeService"))
+			suite.Equal(suite.bakeLogService, ctx.Value("bakeLogService"))
+			return nil
+		})
+	suite.imageDependencyService.EXPECT().Service().Return(suite.imageService)
+
+	suite.jsonLdDependencyService.EXPECT().Initialize(gomock.Any()).
+		DoAndReturn(func(ctx context.Context) error {
+			suite.Equal(suite.configManager, ctx.Value("configManager"))
+			suite.Equal(suite.sourdoughRecipeService, ctx.Value("sourdoughRecipeService"))
+			suite.Equal(suite.parseService, ctx.Value("sourdoughRecipeParseService"))
+			suite.Equal(suite.bakeLogService, ctx.Value("bakeLogService"))
+			suite.Equal(suite.imageService, ctx.Value("imageService"))
+			return nil
+		})
+
+	suite.hydrationDependencyService.EXPECT().Initialize(gomock.Any()).
+		DoAndReturn(func(ctx context.Context) error {
+			suite.Equal(suite.flourRepository, ctx.Value("flourRepository"))
+			suite.Equal(suite.sourdoughRecipeService, ctx.Value("sourdoughRecipeService"))
+			return nil
+		})
+
+	suite.substitutionDependencyService.EXPECT().Initialize(gomock.Any()).
+		DoAndReturn(func(ctx context.Context) error {
+			suite.Equal(suite.flourRepository, ctx.Value("flourRepository"))
+			suite.Equal(suite.sourdoughRecipeService, ctx.Value("sourdoughRecipeService"))
+			return nil
+		})
+
+	suite.calculatorDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+	suite.productionPlanDependencyService.EXPECT().Initialize(gomock.Any()).
 		DoAndReturn(func(ctx context.Context) error {
 			suite.Equal(suite.mongoDBService, ctx.Value("mongoDBService"))
+			suite.Equal(suite.sourdoughRecipeService, ctx.Value("sourdoughRecipeService"))
+			suite.Equal(suite.sourdoughRecipeScaleService, ctx.Value("sourdoughRecipeScaleService"))
+			suite.Equal(suite.bakeLogService, ctx.Value("bakeLogService"))
+			suite.Equal(suite.inventoryService, ctx.Value("inventoryService"))
 			return nil
 		})
 
@@ -91,8 +282,20 @@ func (suite *DependencyManagerTestSuite) TestInitialize() {
 	suite.NoError(err)
 	suite.Equal(suite.sourdoughRecipeDependencyService, suite.target.SourdoughRecipe())
 	suite.Equal(suite.sourdoughRecipeScaleDependencyService, suite.target.SourdoughRecipeScale())
+	suite.Equal(suite.sourdoughRecipeRevisionDependencyService, suite.target.SourdoughRecipeRevision())
+	suite.Equal(suite.bakeLogDependencyService, suite.target.BakeLog())
+	suite.Equal(suite.imageDependencyService, suite.target.Image())
 	suite.Equal(suite.commonDependencyService, suite.target.Common())
+	suite.Equal(suite.migrationDependencyService, suite.target.Migration())
 	suite.Equal(suite.flourDependencyService, suite.target.Flour())
+	suite.Equal(suite.inventoryDependencyService, suite.target.Inventory())
+	suite.Equal(suite.hydrationDependencyService, suite.target.Hydration())
+	suite.Equal(suite.substitutionDependencyService, suite.target.SourdoughRecipeSubstitution())
+	suite.Equal(suite.calculatorDependencyService, suite.target.Calculator())
+	suite.Equal(suite.productionPlanDependencyService, suite.target.ProductionPlan())
+	suite.Equal(suite.recipeBundleDependencyService, suite.target.RecipeBundle())
+	suite.Equal(suite.parseDependencyService, suite.target.SourdoughRecipeParse())
+	suite.Equal(suite.jsonLdDependencyService, suite.target.RecipeJsonLd())
 }
 
 func (suite *DependencyManagerTestSuite) TestInitialize_WithError() {
@@ -106,12 +309,68 @@ func (suite *DependencyManagerTestSuite) TestInitialize_WithError() {
 			initializer:    func() { suite.commonDependencyService.EXPECT().Initialize(gomock.Any()).Return(assert.AnError) },
 			expectedErrMsg: "failed to initialize common dependency service",
 		},
+		{
+			name: "MigrationDependencyService.Initialize() returns error",
+			initializer: func() {
+				suite.commonDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.commonDependencyService.EXPECT().ConfigManager().Return(suite.configManager)
+				suite.commonDependencyService.EXPECT().MongoDBService().Return(suite.mongoDBService)
+				suite.commonDependencyService.EXPECT().EmbeddedDatabase().Return(nil)
+
+				suite.migrationDependencyService.EXPECT().Initialize(gomock.Any()).Return(assert.AnError)
+			},
+			expectedErrMsg: "failed to initialize migration dependency service",
+		},
+		{
+			name: "MigrationService.Up() returns error",
+			initializer: func() {
+				suite.commonDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.commonDependencyService.EXPECT().ConfigManager().Return(suite.configManager).Times(2)
+				suite.commonDependencyService.EXPECT().MongoDBService().Return(suite.mongoDBService)
+				suite.commonDependencyService.EXPECT().EmbeddedDatabase().Return(nil)
+				suite.configManager.EXPECT().GetConfig().Return(config.Config{})
+
+				suite.migrationDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.migrationDependencyService.EXPECT().Service().Return(suite.migrationService)
+				suite.migrationService.EXPECT().Up(gomock.Any()).Return(0, assert.AnError)
+			},
+			expectedErrMsg: "failed to run migrations",
+		},
+		{
+			name: "migrations skipped on startup",
+			initializer: func() {
+				suite.commonDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.commonDependencyService.EXPECT().ConfigManager().Return(suite.configManager).Times(2)
+				suite.commonDependencyService.EXPECT().MongoDBService().Return(suite.mongoDBService)
+				suite.commonDependencyService.EXPECT().EmbeddedDatabase().Return(nil)
+				suite.configManager.EXPECT().GetConfig().
+					Return(config.Config{Database: config.Database{Migrations: config.Migrations{SkipOnStartup: true}}})
+
+				suite.migrationDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.migrationDependencyService.EXPECT().Service().Return(suite.migrationService)
+
+				suite.flourDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.flourDependencyService.EXPECT().Repository().Return(suite.flourRepository)
+				suite.flourDependencyService.EXPECT().Service().Return(suite.flourService)
+
+				suite.sourdoughRecipeDependencyService.EXPECT().Initialize(gomock.Any()).Return(assert.AnError)
+			},
+			expectedErrMsg: "failed to initialize sourdough recipe dependency service",
+		},
 		{
 			name: "SourdoughRecipeDependencyService.Initialize() returns error",
 			initializer: func() {
 				suite.commonDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
 				suite.commonDependencyService.EXPECT().ConfigManager().Return(suite.configManager)
 				suite.commonDependencyService.EXPECT().MongoDBService().Return(suite.mongoDBService)
+				suite.commonDependencyService.EXPECT().EmbeddedDatabase().Return(nil)
+
+				suite.migrationDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.migrationDependencyService.EXPECT().Service().Return(nil)
+
+				suite.flourDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.flourDependencyService.EXPECT().Repository().Return(suite.flourRepository)
+				suite.flourDependencyService.EXPECT().Service().Return(suite.flourService)
 
 				suite.sourdoughRecipeDependencyService.EXPECT().Initialize(gomock.Any()).Return(assert.AnError)
 			},
@@ -123,30 +382,484 @@ func (suite *DependencyManagerTestSuite) TestInitialize_WithError() {
 				suite.commonDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
 				suite.commonDependencyService.EXPECT().ConfigManager().Return(suite.configManager)
 				suite.commonDependencyService.EXPECT().MongoDBService().Return(suite.mongoDBService)
+				suite.commonDependencyService.EXPECT().EmbeddedDatabase().Return(nil)
+
+				suite.migrationDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.migrationDependencyService.EXPECT().Service().Return(nil)
+
+				suite.flourDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.flourDependencyService.EXPECT().Repository().Return(suite.flourRepository)
+				suite.flourDependencyService.EXPECT().Service().Return(suite.flourService)
 
 				suite.sourdoughRecipeDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.sourdoughRecipeDependencyService.EXPECT().Repository().Return(suite.sourdoughRecipeRepository)
 				suite.sourdoughRecipeDependencyService.EXPECT().Service().Return(suite.sourdoughRecipeService)
+				suite.sourdoughRecipeDependencyService.EXPECT().RevisionRepository().Return(suite.sourdoughRecipeRevisionRepository)
+				suite.sourdoughRecipeDependencyService.EXPECT().BakeSheetRenderer().Return(suite.bakeSheetRenderer)
 
 				suite.sourdoughRecipeScaleDependencyService.EXPECT().Initialize(gomock.Any()).Return(assert.AnError)
 			},
 			expectedErrMsg: "failed to initialize sourdough recipe scale dependency service",
 		},
 		{
-			name: "FlourDependencyService.Initialize() returns error",
+			name: "SourdoughRecipeRevisionDependencyService.Initialize() returns error",
 			initializer: func() {
 				suite.commonDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
 				suite.commonDependencyService.EXPECT().ConfigManager().Return(suite.configManager)
 				suite.commonDependencyService.EXPECT().MongoDBService().Return(suite.mongoDBService)
+				suite.commonDependencyService.EXPECT().EmbeddedDatabase().Return(nil)
+
+				suite.migrationDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.migrationDependencyService.EXPECT().Service().Return(nil)
+
+				suite.flourDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.flourDependencyService.EXPECT().Repository().Return(suite.flourRepository)
+				suite.flourDependencyService.EXPECT().Service().Return(suite.flourService)
 
 				suite.sourdoughRecipeDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.sourdoughRecipeDependencyService.EXPECT().Repository().Return(suite.sourdoughRecipeRepository)
 				suite.sourdoughRecipeDependencyService.EXPECT().Service().Return(suite.sourdoughRecipeService)
+				suite.sourdoughRecipeDependencyService.EXPECT().RevisionRepository().Return(suite.sourdoughRecipeRevisionRepository)
+				suite.sourdoughRecipeDependencyService.EXPECT().BakeSheetRenderer().Return(suite.bakeSheetRenderer)
 
 				suite.sourdoughRecipeScaleDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.sourdoughRecipeScaleDependencyService.EXPECT().Service().Return(suite.sourdoughRecipeScaleService)
+
+				suite.sourdoughRecipeRevisionDependencyService.EXPECT().Initialize(gomock.Any()).Return(assert.AnError)
+			},
+			expectedErrMsg: "failed to initialize sourdough recipe revision dependency service",
+		},
+		{
+			name: "FlourDependencyService.Initialize() returns error",
+			initializer: func() {
+				suite.commonDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.commonDependencyService.EXPECT().ConfigManager().Return(suite.configManager)
+				suite.commonDependencyService.EXPECT().MongoDBService().Return(suite.mongoDBService)
+				suite.commonDependencyService.EXPECT().EmbeddedDatabase().Return(nil)
+
+				suite.migrationDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.migrationDependencyService.EXPECT().Service().Return(nil)
 
 				suite.flourDependencyService.EXPECT().Initialize(gomock.Any()).Return(assert.AnError)
 			},
 			expectedErrMsg: "failed to initialize flour dependency service",
 		},
+		{
+			name: "RecipeBundleDependencyService.Initialize() returns error",
+			initializer: func() {
+				suite.commonDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.commonDependencyService.EXPECT().ConfigManager().Return(suite.configManager)
+				suite.commonDependencyService.EXPECT().MongoDBService().Return(suite.mongoDBService)
+				suite.commonDependencyService.EXPECT().EmbeddedDatabase().Return(nil)
+
+				suite.migrationDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.migrationDependencyService.EXPECT().Service().Return(nil)
+
+				suite.flourDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.flourDependencyService.EXPECT().Repository().Return(suite.flourRepository)
+				suite.flourDependencyService.EXPECT().Service().Return(suite.flourService)
+
+				suite.sourdoughRecipeDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.sourdoughRecipeDependencyService.EXPECT().Repository().Return(suite.sourdoughRecipeRepository)
+				suite.sourdoughRecipeDependencyService.EXPECT().Service().Return(suite.sourdoughRecipeService)
+				suite.sourdoughRecipeDependencyService.EXPECT().RevisionRepository().Return(suite.sourdoughRecipeRevisionRepository)
+				suite.sourdoughRecipeDependencyService.EXPECT().BakeSheetRenderer().Return(suite.bakeSheetRenderer)
+
+				suite.sourdoughRecipeScaleDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.sourdoughRecipeScaleDependencyService.EXPECT().Service().Return(suite.sourdoughRecipeScaleService)
+
+				suite.sourdoughRecipeRevisionDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.recipeBundleDependencyService.EXPECT().Initialize(gomock.Any()).Return(assert.AnError)
+			},
+			expectedErrMsg: "failed to initialize recipe bundle dependency service",
+		},
+		{
+			name: "SourdoughRecipeParseDependencyService.Initialize() returns error",
+			initializer: func() {
+				suite.commonDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.commonDependencyService.EXPECT().ConfigManager().Return(suite.configManager)
+				suite.commonDependencyService.EXPECT().MongoDBService().Return(suite.mongoDBService)
+				suite.commonDependencyService.EXPECT().EmbeddedDatabase().Return(nil)
+
+				suite.migrationDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.migrationDependencyService.EXPECT().Service().Return(nil)
+
+				suite.flourDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.flourDependencyService.EXPECT().Repository().Return(suite.flourRepository)
+				suite.flourDependencyService.EXPECT().Service().Return(suite.flourService)
+
+				suite.sourdoughRecipeDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.sourdoughRecipeDependencyService.EXPECT().Repository().Return(suite.sourdoughRecipeRepository)
+				suite.sourdoughRecipeDependencyService.EXPECT().Service().Return(suite.sourdoughRecipeService)
+				suite.sourdoughRecipeDependencyService.EXPECT().RevisionRepository().Return(suite.sourdoughRecipeRevisionRepository)
+				suite.sourdoughRecipeDependencyService.EXPECT().BakeSheetRenderer().Return(suite.bakeSheetRenderer)
+
+				suite.sourdoughRecipeScaleDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.sourdoughRecipeScaleDependencyService.EXPECT().Service().Return(suite.sourdoughRecipeScaleService)
+
+				suite.sourdoughRecipeRevisionDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.recipeBundleDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.parseDependencyService.EXPECT().Initialize(gomock.Any()).Return(assert.AnError)
+			},
+			expectedErrMsg: "failed to initialize sourdough recipe parse dependency service",
+		},
+		{
+			name: "InventoryDependencyService.Initialize() returns error",
+			initializer: func() {
+				suite.commonDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.commonDependencyService.EXPECT().ConfigManager().Return(suite.configManager)
+				suite.commonDependencyService.EXPECT().MongoDBService().Return(suite.mongoDBService)
+				suite.commonDependencyService.EXPECT().EmbeddedDatabase().Return(nil)
+
+				suite.migrationDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.migrationDependencyService.EXPECT().Service().Return(nil)
+
+				suite.flourDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.flourDependencyService.EXPECT().Repository().Return(suite.flourRepository)
+				suite.flourDependencyService.EXPECT().Service().Return(suite.flourService)
+
+				suite.sourdoughRecipeDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.sourdoughRecipeDependencyService.EXPECT().Repository().Return(suite.sourdoughRecipeRepository)
+				suite.sourdoughRecipeDependencyService.EXPECT().Service().Return(suite.sourdoughRecipeService)
+				suite.sourdoughRecipeDependencyService.EXPECT().RevisionRepository().Return(suite.sourdoughRecipeRevisionRepository)
+				suite.sourdoughRecipeDependencyService.EXPECT().BakeSheetRenderer().Return(suite.bakeSheetRenderer)
+
+				suite.sourdoughRecipeScaleDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.sourdoughRecipeScaleDependencyService.EXPECT().Service().Return(suite.sourdoughRecipeScaleService)
+
+				suite.sourdoughRecipeRevisionDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.recipeBundleDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.parseDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.parseDependencyService.EXPECT().Service().Return(suite.parseService)
+
+				suite.inventoryDependencyService.EXPECT().Initialize(gomock.Any()).Return(assert.AnError)
+			},
+			expectedErrMsg: "failed to initialize inventory dependency service",
+		},
+		{
+			name: "BakeLogDependencyService.Initialize() returns error",
+			initializer: func() {
+				suite.commonDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.commonDependencyService.EXPECT().ConfigManager().Return(suite.configManager)
+				suite.commonDependencyService.EXPECT().MongoDBService().Return(suite.mongoDBService)
+				suite.commonDependencyService.EXPECT().EmbeddedDatabase().Return(nil)
+
+				suite.migrationDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.migrationDependencyService.EXPECT().Service().Return(nil)
+
+				suite.flourDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.flourDependencyService.EXPECT().Repository().Return(suite.flourRepository)
+				suite.flourDependencyService.EXPECT().Service().Return(suite.flourService)
+
+				suite.sourdoughRecipeDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.sourdoughRecipeDependencyService.EXPECT().Repository().Return(suite.sourdoughRecipeRepository)
+				suite.sourdoughRecipeDependencyService.EXPECT().Service().Return(suite.sourdoughRecipeService)
+				suite.sourdoughRecipeDependencyService.EXPECT().RevisionRepository().Return(suite.sourdoughRecipeRevisionRepository)
+				suite.sourdoughRecipeDependencyService.EXPECT().BakeSheetRenderer().Return(suite.bakeSheetRenderer)
+
+				suite.sourdoughRecipeScaleDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.sourdoughRecipeScaleDependencyService.EXPECT().Service().Return(suite.sourdoughRecipeScaleService)
+
+				suite.sourdoughRecipeRevisionDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.recipeBundleDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.parseDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.parseDependencyService.EXPECT().Service().Return(suite.parseService)
+
+				suite.inventoryDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.inventoryDependencyService.EXPECT().Service().Return(suite.inventoryService)
+
+				suite.bakeLogDependencyService.EXPECT().Initialize(gomock.Any()).Return(assert.AnError)
+			},
+			expectedErrMsg: "failed to initialize bake log dependency service",
+		},
+		{
+			name: "ImageDependencyService.Initialize() returns error",
+			initializer: func() {
+				suite.commonDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.commonDependencyService.EXPECT().ConfigManager().Return(suite.configManager)
+				suite.commonDependencyService.EXPECT().MongoDBService().Return(suite.mongoDBService)
+				suite.commonDependencyService.EXPECT().EmbeddedDatabase().Return(nil)
+
+				suite.migrationDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.migrationDependencyService.EXPECT().Service().Return(nil)
+
+				suite.flourDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.flourDependencyService.EXPECT().Repository().Return(suite.flourRepository)
+				suite.flourDependencyService.EXPECT().Service().Return(suite.flourService)
+
+				suite.sourdoughRecipeDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.sourdoughRecipeDependencyService.EXPECT().Repository().Return(suite.sourdoughRecipeRepository)
+				suite.sourdoughRecipeDependencyService.EXPECT().Service().Return(suite.sourdoughRecipeService)
+				suite.sourdoughRecipeDependencyService.EXPECT().RevisionRepository().Return(suite.sourdoughRecipeRevisionRepository)
+				suite.sourdoughRecipeDependencyService.EXPECT().BakeSheetRenderer().Return(suite.bakeSheetRenderer)
+
+				suite.sourdoughRecipeScaleDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.sourdoughRecipeScaleDependencyService.EXPECT().Service().Return(suite.sourdoughRecipeScaleService)
+
+				suite.sourdoughRecipeRevisionDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.recipeBundleDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.parseDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.parseDependencyService.EXPECT().Service().Return(suite.parseService)
+
+				suite.inventoryDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.inventoryDependencyService.EXPECT().Service().Return(suite.inventoryService)
+
+				suite.bakeLogDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.bakeLogDependencyService.EXPECT().Service().Return(suite.bakeLogService)
+
+				suite.imageDependencyService.EXPECT().Initialize(gomock.Any()).Return(assert.AnError)
+			},
+			expectedErrMsg: "failed to initialize image dependency service",
+		},
+		{
+			name: "RecipeJsonLdDependencyService.Initialize() returns error",
+			initializer: func() {
+				suite.commonDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.commonDependencyService.EXPECT().ConfigManager().Return(suite.configManager)
+				suite.commonDependencyService.EXPECT().MongoDBService().Return(suite.mongoDBService)
+				suite.commonDependencyService.EXPECT().EmbeddedDatabase().Return(nil)
+
+				suite.migrationDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.migrationDependencyService.EXPECT().Service().Return(nil)
+
+				suite.flourDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.flourDependencyService.EXPECT().Repository().Return(suite.flourRepository)
+				suite.flourDependencyService.EXPECT().Service().Return(suite.flourService)
+
+				suite.sourdoughRecipeDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.sourdoughRecipeDependencyService.EXPECT().Repository().Return(suite.sourdoughRecipeRepository)
+				suite.sourdoughRecipeDependencyService.EXPECT().Service().Return(suite.sourdoughRecipeService)
+				suite.sourdoughRecipeDependencyService.EXPECT().RevisionRepository().Return(suite.sourdoughRecipeRevisionRepository)
+				suite.sourdoughRecipeDependencyService.EXPECT().BakeSheetRenderer().Return(suite.bakeSheetRenderer)
+
+				suite.sourdoughRecipeScaleDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.sourdoughRecipeScaleDependencyService.EXPECT().Service().Return(suite.sourdoughRecipeScaleService)
+
+				suite.sourdoughRecipeRevisionDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.recipeBundleDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.parseDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.parseDependencyService.EXPECT().Service().Return(suite.parseService)
+
+				suite.inventoryDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.inventoryDependencyService.EXPECT().Service().Return(suite.inventoryService)
+
+				suite.bakeLogDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.bakeLogDependencyService.EXPECT().Service().Return(suite.bakeLogService)
+
+				suite.imageDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.imageDependencyService.EXPECT().Service().Return(suite.imageService)
+
+				suite.jsonLdDependencyService.EXPECT().Initialize(gomock.Any()).Return(assert.AnError)
+			},
+			expectedErrMsg: "failed to initialize recipe JSON-LD dependency service",
+		},
+		{
+			name: "HydrationDependencyService.Initialize() returns error",
+			initializer: func() {
+				suite.commonDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.commonDependencyService.EXPECT().ConfigManager().Return(suite.configManager)
+				suite.commonDependencyService.EXPECT().MongoDBService().Return(suite.mongoDBService)
+				suite.commonDependencyService.EXPECT().EmbeddedDatabase().Return(nil)
+
+				suite.migrationDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.migrationDependencyService.EXPECT().Service().Return(nil)
+
+				suite.flourDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.flourDependencyService.EXPECT().Repository().Return(suite.flourRepository)
+				suite.flourDependencyService.EXPECT().Service().Return(suite.flourService)
+
+				suite.sourdoughRecipeDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.sourdoughRecipeDependencyService.EXPECT().Repository().Return(suite.sourdoughRecipeRepository)
+				suite.sourdoughRecipeDependencyService.EXPECT().Service().Return(suite.sourdoughRecipeService)
+				suite.sourdoughRecipeDependencyService.EXPECT().RevisionRepository().Return(suite.sourdoughRecipeRevisionRepository)
+				suite.sourdoughRecipeDependencyService.EXPECT().BakeSheetRenderer().Return(suite.bakeSheetRenderer)
+
+				suite.sourdoughRecipeScaleDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.sourdoughRecipeScaleDependencyService.EXPECT().Service().Return(suite.sourdoughRecipeScaleService)
+
+				suite.sourdoughRecipeRevisionDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.recipeBundleDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.parseDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.parseDependencyService.EXPECT().Service().Return(suite.parseService)
+
+				suite.inventoryDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.inventoryDependencyService.EXPECT().Service().Return(suite.inventoryService)
+
+				suite.bakeLogDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.bakeLogDependencyService.EXPECT().Service().Return(suite.bakeLogService)
+
+				suite.imageDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.imageDependencyService.EXPECT().Service().Return(suite.imageService)
+
+				suite.jsonLdDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.hydrationDependencyService.EXPECT().Initialize(gomock.Any()).Return(assert.AnError)
+			},
+			expectedErrMsg: "failed to initialize hydration dependency service",
+		},
+		{
+			name: "SourdoughRecipeSubstitutionDependencyService.Initialize() returns error",
+			initializer: func() {
+				suite.commonDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.commonDependencyService.EXPECT().ConfigManager().Return(suite.configManager)
+				suite.commonDependencyService.EXPECT().MongoDBService().Return(suite.mongoDBService)
+				suite.commonDependencyService.EXPECT().EmbeddedDatabase().Return(nil)
+
+				suite.migrationDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.migrationDependencyService.EXPECT().Service().Return(nil)
+
+				suite.flourDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.flourDependencyService.EXPECT().Repository().Return(suite.flourRepository)
+				suite.flourDependencyService.EXPECT().Service().Return(suite.flourService)
+
+				suite.sourdoughRecipeDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.sourdoughRecipeDependencyService.EXPECT().Repository().Return(suite.sourdoughRecipeRepository)
+				suite.sourdoughRecipeDependencyService.EXPECT().Service().Return(suite.sourdoughRecipeService)
+				suite.sourdoughRecipeDependencyService.EXPECT().RevisionRepository().Return(suite.sourdoughRecipeRevisionRepository)
+				suite.sourdoughRecipeDependencyService.EXPECT().BakeSheetRenderer().Return(suite.bakeSheetRenderer)
+
+				suite.sourdoughRecipeScaleDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.sourdoughRecipeScaleDependencyService.EXPECT().Service().Return(suite.sourdoughRecipeScaleService)
+
+				suite.sourdoughRecipeRevisionDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.recipeBundleDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.parseDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.parseDependencyService.EXPECT().Service().Return(suite.parseService)
+
+				suite.inventoryDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.inventoryDependencyService.EXPECT().Service().Return(suite.inventoryService)
+
+				suite.bakeLogDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.bakeLogDependencyService.EXPECT().Service().Return(suite.bakeLogService)
+
+				suite.imageDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.imageDependencyService.EXPECT().Service().Return(suite.imageService)
+
+				suite.jsonLdDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.hydrationDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.substitutionDependencyService.EXPECT().Initialize(gomock.Any()).Return(assert.AnError)
+			},
+			expectedErrMsg: "failed to initialize sourdough recipe substitution dependency service",
+		},
+		{
+			name: "CalculatorDependencyService.Initialize() returns error",
+			initializer: func() {
+				suite.commonDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.commonDependencyService.EXPECT().ConfigManager().Return(suite.configManager)
+				suite.commonDependencyService.EXPECT().MongoDBService().Return(suite.mongoDBService)
+				suite.commonDependencyService.EXPECT().EmbeddedDatabase().Return(nil)
+
+				suite.migrationDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.migrationDependencyService.EXPECT().Service().Return(nil)
+
+				suite.flourDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.flourDependencyService.EXPECT().Repository().Return(suite.flourRepository)
+				suite.flourDependencyService.EXPECT().Service().Return(suite.flourService)
+
+				suite.sourdoughRecipeDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.sourdoughRecipeDependencyService.EXPECT().Repository().Return(suite.sourdoughRecipeRepository)
+				suite.sourdoughRecipeDependencyService.EXPECT().Service().Return(suite.sourdoughRecipeService)
+				suite.sourdoughRecipeDependencyService.EXPECT().RevisionRepository().Return(suite.sourdoughRecipeRevisionRepository)
+				suite.sourdoughRecipeDependencyService.EXPECT().BakeSheetRenderer().Return(suite.bakeSheetRenderer)
+
+				suite.sourdoughRecipeScaleDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.sourdoughRecipeScaleDependencyService.EXPECT().Service().Return(suite.sourdoughRecipeScaleService)
+
+				suite.sourdoughRecipeRevisionDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.recipeBundleDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.parseDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.parseDependencyService.EXPECT().Service().Return(suite.parseService)
+
+				suite.inventoryDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.inventoryDependencyService.EXPECT().Service().Return(suite.inventoryService)
+
+				suite.bakeLogDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.bakeLogDependencyService.EXPECT().Service().Return(suite.bakeLogService)
+
+				suite.imageDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.imageDependencyService.EXPECT().Service().Return(suite.imageService)
+
+				suite.jsonLdDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.hydrationDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.substitutionDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.calculatorDependencyService.EXPECT().Initialize(gomock.Any()).Return(assert.AnError)
+			},
+			expectedErrMsg: "failed to initialize calculator dependency service",
+		},
+		{
+			name: "ProductionPlanDependencyService.Initialize() returns error",
+			initializer: func() {
+				suite.commonDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.commonDependencyService.EXPECT().ConfigManager().Return(suite.configManager)
+				suite.commonDependencyService.EXPECT().MongoDBService().Return(suite.mongoDBService)
+				suite.commonDependencyService.EXPECT().EmbeddedDatabase().Return(nil)
+
+				suite.migrationDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.migrationDependencyService.EXPECT().Service().Return(nil)
+
+				suite.flourDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.flourDependencyService.EXPECT().Repository().Return(suite.flourRepository)
+				suite.flourDependencyService.EXPECT().Service().Return(suite.flourService)
+
+				suite.sourdoughRecipeDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.sourdoughRecipeDependencyService.EXPECT().Repository().Return(suite.sourdoughRecipeRepository)
+				suite.sourdoughRecipeDependencyService.EXPECT().Service().Return(suite.sourdoughRecipeService)
+				suite.sourdoughRecipeDependencyService.EXPECT().RevisionRepository().Return(suite.sourdoughRecipeRevisionRepository)
+				suite.sourdoughRecipeDependencyService.EXPECT().BakeSheetRenderer().Return(suite.bakeSheetRenderer)
+
+				suite.sourdoughRecipeScaleDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.sourdoughRecipeScaleDependencyService.EXPECT().Service().Return(suite.sourdoughRecipeScaleService)
+
+				suite.sourdoughRecipeRevisionDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.recipeBundleDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.parseDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.parseDependencyService.EXPECT().Service().Return(suite.parseService)
+
+				suite.inventoryDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.inventoryDependencyService.EXPECT().Service().Return(suite.inventoryService)
+
+				suite.bakeLogDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.bakeLogDependencyService.EXPECT().Service().Return(suite.bakeLogService)
+
+				suite.imageDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+				suite.imageDependencyService.EXPECT().Service().Return(suite.imageService)
+
+				suite.jsonLdDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.hydrationDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.substitutionDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.calculatorDependencyService.EXPECT().Initialize(gomock.Any()).Return(nil)
+
+				suite.productionPlanDependencyService.EXPECT().Initialize(gomock.Any()).Return(assert.AnError)
+			},
+			expectedErrMsg: "failed to initialize production plan dependency service",
+		},
 	}
 
 	for _, tt := range tests {
@@ -178,6 +891,38 @@ func (suite *DependencyManagerTestSuite) TestSourdoughRecipeScale() {
 	suite.Equal(suite.sourdoughRecipeScaleDependencyService, target.SourdoughRecipeScale())
 }
 
+func (suite *DependencyManagerTestSuite) TestSourdoughRecipeSubstitution() {
+	target := &dependencyManager{
+		substitutionDependencyService: suite.substitutionDependencyService,
+	}
+
+	suite.Equal(suite.substitutionDependencyService, target.SourdoughRecipeSubstitution())
+}
+
+func (suite *DependencyManagerTestSuite) TestSourdoughRecipeRevision() {
+	target := &dependencyManager{
+		sourdoughRecipeRevisionDependencyService: suite.sourdoughRecipeRevisionDependencyService,
+	}
+
+	suite.Equal(suite.sourdoughRecipeRevisionDependencyService, target.SourdoughRecipeRevision())
+}
+
+func (suite *DependencyManagerTestSuite) TestBakeLog() {
+	target := &dependencyManager{
+		bakeLogDependencyService: suite.bakeLogDependencyService,
+	}
+
+	suite.Equal(suite.bakeLogDependencyService, target.BakeLog())
+}
+
+func (suite *DependencyManagerTestSuite) TestImage() {
+	target := &dependencyManager{
+		imageDependencyService: suite.imageDependencyService,
+	}
+
+	suite.Equal(suite.imageDependencyService, target.Image())
+}
+
 func (suite *DependencyManagerTestSuite) TestCommon() {
 	target := &dependencyManager{
 		commonDependencyService: suite.commonDependencyService,
@@ -186,6 +931,14 @@ func (suite *DependencyManagerTestSuite) TestCommon() {
 	suite.Equal(suite.commonDependencyService, target.Common())
 }
 
+func (suite *DependencyManagerTestSuite) TestMigration() {
+	target := &dependencyManager{
+		migrationDependencyService: suite.migrationDependencyService,
+	}
+
+	suite.Equal(suite.migrationDependencyService, target.Migration())
+}
+
 func (suite *DependencyManagerTestSuite) TestFlour() {
 	target := &dependencyManager{
 		flourDependencyService: suite.flourDependencyService,
@@ -194,13 +947,81 @@ func (suite *DependencyManagerTestSuite) TestFlour() {
 	suite.Equal(suite.flourDependencyService, target.Flour())
 }
 
+func (suite *DependencyManagerTestSuite) TestInventory() {
+	target := &dependencyManager{
+		inventoryDependencyService: suite.inventoryDependencyService,
+	}
+
+	suite.Equal(suite.inventoryDependencyService, target.Inventory())
+}
+
+func (suite *DependencyManagerTestSuite) TestHydration() {
+	target := &dependencyManager{
+		hydrationDependencyService: suite.hydrationDependencyService,
+	}
+
+	suite.Equal(suite.hydrationDependencyService, target.Hydration())
+}
+
+func (suite *DependencyManagerTestSuite) TestCalculator() {
+	target := &dependencyManager{
+		calculatorDependencyService: suite.calculatorDependencyService,
+	}
+
+	suite.Equal(suite.calculatorDependencyService, target.Calculator())
+}
+
+func (suite *DependencyManagerTestSuite) TestProductionPlan() {
+	target := &dependencyManager{
+		productionPlanDependencyService: suite.productionPlanDependencyService,
+	}
+
+	suite.Equal(suite.productionPlanDependencyService, target.ProductionPlan())
+}
+
+func (suite *DependencyManagerTestSuite) TestRecipeBundle() {
+	target := &dependencyManager{
+		recipeBundleDependencyService: suite.recipeBundleDependencyService,
+	}
+
+	suite.Equal(suite.recipeBundleDependencyService, target.RecipeBundle())
+}
+
+func (suite *DependencyManagerTestSuite) TestSourdoughRecipeParse() {
+	target := &dependencyManager{
+		parseDependencyService: suite.parseDependencyService,
+	}
+
+	suite.Equal(suite.parseDependencyService, target.SourdoughRecipeParse())
+}
+
+func (suite *DependencyManagerTestSuite) TestRecipeJsonLd() {
+	target := &dependencyManager{
+		jsonLdDependencyService: suite.jsonLdDependencyService,
+	}
+
+	suite.Equal(suite.jsonLdDependencyService, target.RecipeJsonLd())
+}
+
 func (suite *DependencyManagerTestSuite) TestNewDependencyManager() {
 	target := NewDependencyManager().(*dependencyManager)
 
 	suite.NotNil(target.commonDependencyService)
+	suite.NotNil(target.migrationDependencyService)
 	suite.NotNil(target.sourdoughRecipeDependencyService)
 	suite.NotNil(target.sourdoughRecipeScaleDependencyService)
+	suite.NotNil(target.sourdoughRecipeRevisionDependencyService)
+	suite.NotNil(target.bakeLogDependencyService)
+	suite.NotNil(target.imageDependencyService)
 	suite.NotNil(target.flourDependencyService)
+	suite.NotNil(target.inventoryDependencyService)
+	suite.NotNil(target.hydrationDependencyService)
+	suite.NotNil(target.substitutionDependencyService)
+	suite.NotNil(target.calculatorDependencyService)
+	suite.NotNil(target.productionPlanDependencyService)
+	suite.NotNil(target.recipeBundleDependencyService)
+	suite.NotNil(target.parseDependencyService)
+	suite.NotNil(target.jsonLdDependencyService)
 }
 
 func TestDependencyManagerTestSuite(t *testing.T) {
@@ -12,40 +12,68 @@ import (
 )
 
 type flourDependencyService struct {
-	repositoryCreator func(mongoDBService domain.MongoDBService) (domain.FlourRepository, error)
-	repository        domain.FlourRepository
+	repositoryCreator         func(mongoDBService domain.MongoDBService) (domain.FlourRepository, error)
+	embeddedRepositoryCreator func(database domain.EmbeddedDatabase) (domain.FlourRepository, error)
+	repository                domain.FlourRepository
 
-	serviceCreator func(repository domain.FlourRepository) (domain.FlourService, error)
+	typeRepositoryCreator         func(mongoDBService domain.MongoDBService) (domain.FlourTypeRepository, error)
+	embeddedTypeRepositoryCreator func(database domain.EmbeddedDatabase) (domain.FlourTypeRepository, error)
+	typeRepository                domain.FlourTypeRepository
+
+	serviceCreator func(repository domain.FlourRepository, typeRepository domain.FlourTypeRepository) (domain.FlourService, error)
 	service        domain.FlourService
 
+	typeServiceCreator func(typeRepository domain.FlourTypeRepository, repository domain.FlourRepository) (domain.FlourTypeService, error)
+	typeService        domain.FlourTypeService
+
 	handlerCreator func(service domain.FlourService) (domain.FlourHandler, error)
 	handler        domain.FlourHandler
+
+	typeHandlerCreator func(typeService domain.FlourTypeService) (domain.FlourTypeHandler, error)
+	typeHandler        domain.FlourTypeHandler
 }
 
 func (dependencyService *flourDependencyService) Initialize(ctx context.Context) error {
-	mongoDBService, err := getFromContext[domain.MongoDBService](ctx, "mongoDBService")
+	flourRepository, err := createOnBackend(ctx, dependencyService.repositoryCreator, dependencyService.embeddedRepositoryCreator)
 	if err != nil {
-		return errors.Wrap(err, "failed to get mongoDBService from context")
+		return errors.Wrap(err, "failed to create repository")
 	}
 
-	flourRepository, err := dependencyService.repositoryCreator(mongoDBService)
+	flourTypeRepository, err := createOnBackend(ctx, dependencyService.typeRepositoryCreator, dependencyService.embeddedTypeRepositoryCreator)
 	if err != nil {
-		return errors.Wrap(err, "failed to create repository")
+		return errors.Wrap(err, "failed to create type repository")
 	}
 
-	flourService, err := dependencyService.serviceCreator(flourRepository)
+	flourService, err := dependencyService.serviceCreator(flourRepository, flourTypeRepository)
 	if err != nil {
 		return errors.Wrap(err, "failed to create service")
 	}
 
+	flourTypeService, err := dependencyService.typeServiceCreator(flourTypeRepository, flourRepository)
+	if err != nil {
+		return errors.Wrap(err, "failed to create type service")
+	}
+
+	if err = flourTypeService.SeedDefaults(ctx); err != nil {
+		return errors.Wrap(err, "failed to seed flour types")
+	}
+
 	flourHandler, err := dependencyService.handlerCreator(flourService)
 	if err != nil {
 		return errors.Wrap(err, "failed to create handler")
 	}
 
+	flourTypeHandler, err := dependencyService.typeHandlerCreator(flourTypeService)
+	if err != nil {
+		return errors.Wrap(err, "failed to create type handler")
+	}
+
 	dependencyService.repository = flourRepository
+	dependencyService.typeRepository = flourTypeRepository
 	dependencyService.service = flourService
+	dependencyService.typeService = flourTypeService
 	dependencyService.handler = flourHandler
+	dependencyService.typeHandler = flourTypeHandler
 
 	return nil
 }
@@ -62,18 +90,49 @@ func (dependencyService *flourDependencyService) Router() domain.FlourHandler {
 	return dependencyService.handler
 }
 
+func (dependencyService *flourDependencyService) TypeRepository() domain.FlourTypeRepository {
+	return dependencyService.typeRepository
+}
+
+func (dependencyService *flourDependencyService) TypeService() domain.FlourTypeService {
+	return dependencyService.typeService
+}
+
+func (dependencyService *flourDependencyService) TypeRouter() domain.FlourTypeHandler {
+	return dependencyService.typeHandler
+}
+
 func NewFlourDependencyService() domain.FlourDependencyService {
-	return newFlourDependencyService(repository.NewFlourRepository, service.NewFlourService, rest.NewFlourHandler)
+	return newFlourDependencyService(
+		repository.NewFlourRepository,
+		repository.NewEmbeddedFlourRepository,
+		repository.NewFlourTypeRepository,
+		repository.NewEmbeddedFlourTypeRepository,
+		service.NewFlourService,
+		service.NewFlourTypeService,
+		rest.NewFlourHandler,
+		rest.NewFlourTypeHandler,
+	)
 }
 
 func newFlourDependencyService(
 	repositoryCreator func(mongoDBService domain.MongoDBService) (domain.FlourRepository, error),
-	serviceCreator func(repository domain.FlourRepository) (domain.FlourService, error),
+	embeddedRepositoryCreator func(database domain.EmbeddedDatabase) (domain.FlourRepository, error),
+	typeRepositoryCreator func(mongoDBService domain.MongoDBService) (domain.FlourTypeRepository, error),
+	embeddedTypeRepositoryCreator func(database domain.EmbeddedDatabase) (domain.FlourTypeRepository, error),
+	serviceCreator func(repository domain.FlourRepository, typeRepository domain.FlourTypeRepository) (domain.FlourService, error),
+	typeServiceCreator func(typeRepository domain.FlourTypeRepository, repository domain.FlourRepository) (domain.FlourTypeService, error),
 	handlerCreator func(service domain.FlourService) (domain.FlourHandler, error),
+	typeHandlerCreator func(typeService domain.FlourTypeService) (domain.FlourTypeHandler, error),
 ) domain.FlourDependencyService {
 	return &flourDependencyService{
-		repositoryCreator: repositoryCreator,
-		serviceCreator:    serviceCreator,
-		handlerCreator:    handlerCreator,
+		repositoryCreator:             repositoryCreator,
+		embeddedRepositoryCreator:     embeddedRepositoryCreator,
+		typeRepositoryCreator:         typeRepositoryCreator,
+		embeddedTypeRepositoryCreator: embeddedTypeRepositoryCreator,
+		serviceCreator:                serviceCreator,
+		typeServiceCreator:            typeServiceCreator,
+		handlerCreator:                handlerCreator,
+		typeHandlerCreator:            typeHandlerCreator,
 	}
 }
@@ -15,11 +15,17 @@ import (
 type FlourDependencyServiceTestSuite struct {
 	test.GoMockTestSuite
 
-	configManager  *mocks.MockConfigManager
-	mongoDBService *mocks.MockMongoDBService
-	repository     *mocks.MockFlourRepository
-	service        *mocks.MockFlourService
-	handler        *mocks.MockFlourHandler
+	configManager          *mocks.MockConfigManager
+	mongoDBService         *mocks.MockMongoDBService
+	embeddedDatabase       *mocks.MockEmbeddedDatabase
+	repository             *mocks.MockFlourRepository
+	embeddedRepository     *mocks.MockFlourRepository
+	typeRepository         *mocks.MockFlourTypeRepository
+	embeddedTypeRepository *mocks.MockFlourTypeRepository
+	service                *mocks.MockFlourService
+	typeService            *mocks.MockFlourTypeService
+	handler                *mocks.MockFlourHandler
+	typeHandler            *mocks.MockFlourTypeHandler
 
 	target domain.FlourDependencyService
 }
@@ -28,33 +34,71 @@ func (suite *FlourDependencyServiceTestSuite) SetupTest() {
 	suite.GoMockTestSuite.SetupTest()
 
 	suite.mongoDBService = mocks.NewMockMongoDBService(suite.MockCtrl)
+	suite.embeddedDatabase = mocks.NewMockEmbeddedDatabase(suite.MockCtrl)
 	suite.configManager = mocks.NewMockConfigManager(suite.MockCtrl)
 	suite.repository = mocks.NewMockFlourRepository(suite.MockCtrl)
+	suite.embeddedRepository = mocks.NewMockFlourRepository(suite.MockCtrl)
+	suite.typeRepository = mocks.NewMockFlourTypeRepository(suite.MockCtrl)
+	suite.embeddedTypeRepository = mocks.NewMockFlourTypeRepository(suite.MockCtrl)
 	suite.service = mocks.NewMockFlourService(suite.MockCtrl)
+	suite.typeService = mocks.NewMockFlourTypeService(suite.MockCtrl)
 	suite.handler = mocks.NewMockFlourHandler(suite.MockCtrl)
+	suite.typeHandler = mocks.NewMockFlourTypeHandler(suite.MockCtrl)
 
 	suite.target = newFlourDependencyService(
 		func(_ domain.MongoDBService) (domain.FlourRepository, error) {
 			return suite.repository, nil
 		},
-		func(_ domain.FlourRepository) (domain.FlourService, error) {
+		func(_ domain.EmbeddedDatabase) (domain.FlourRepository, error) {
+			return suite.embeddedRepository, nil
+		},
+		func(_ domain.MongoDBService) (domain.FlourTypeRepository, error) {
+			return suite.typeRepository, nil
+		},
+		func(_ domain.EmbeddedDatabase) (domain.FlourTypeRepository, error) {
+			return suite.embeddedTypeRepository, nil
+		},
+		func(_ domain.FlourRepository, _ domain.FlourTypeRepository) (domain.FlourService, error) {
 			return suite.service, nil
 		},
+		func(_ domain.FlourTypeRepository, _ domain.FlourRepository) (domain.FlourTypeService, error) {
+			return suite.typeService, nil
+		},
 		func(_ domain.FlourService) (domain.FlourHandler, error) {
 			return suite.handler, nil
 		},
+		func(_ domain.FlourTypeService) (domain.FlourTypeHandler, error) {
+			return suite.typeHandler, nil
+		},
 	)
 }
 
 func (suite *FlourDependencyServiceTestSuite) TestInitialize() {
 	ctx := context.WithValue(context.Background(), "mongoDBService", suite.mongoDBService)
 
+	suite.typeService.EXPECT().SeedDefaults(ctx).Return(nil)
+
 	err := suite.target.Initialize(ctx)
 
 	suite.NoError(err)
 	suite.Equal(suite.repository, suite.target.Repository())
+	suite.Equal(suite.typeRepository, suite.target.TypeRepository())
 	suite.Equal(suite.service, suite.target.Service())
+	suite.Equal(suite.typeService, suite.target.TypeService())
 	suite.Equal(suite.handler, suite.target.Router())
+	suite.Equal(suite.typeHandler, suite.target.TypeRouter())
+}
+
+func (suite *FlourDependencyServiceTestSuite) TestInitialize_WithEmbeddedDatabase() {
+	ctx := context.WithValue(context.Background(), "embeddedDatabase", suite.embeddedDatabase)
+
+	suite.typeService.EXPECT().SeedDefaults(ctx).Return(nil)
+
+	err := suite.target.Initialize(ctx)
+
+	suite.NoError(err)
+	suite.Equal(suite.embeddedRepository, suite.target.Repository())
+	suite.Equal(suite.embeddedTypeRepository, suite.target.TypeRepository())
 }
 
 func (suite *FlourDependencyServiceTestSuite) TestInitialize_MongoDBServiceNil() {
@@ -73,17 +117,28 @@ func (suite *FlourDependencyServiceTestSuite) TestInitialize_WithError() {
 		repositoryCreator: func(_ domain.MongoDBService) (domain.FlourRepository, error) {
 			return suite.repository, nil
 		},
-		serviceCreator: func(_ domain.FlourRepository) (domain.FlourService, error) {
+		typeRepositoryCreator: func(_ domain.MongoDBService) (domain.FlourTypeRepository, error) {
+			return suite.typeRepository, nil
+		},
+		serviceCreator: func(_ domain.FlourRepository, _ domain.FlourTypeRepository) (domain.FlourService, error) {
 			return suite.service, nil
 		},
+		typeServiceCreator: func(_ domain.FlourTypeRepository, _ domain.FlourRepository) (domain.FlourTypeService, error) {
+			return suite.typeService, nil
+		},
 		handlerCreator: func(_ domain.FlourService) (domain.FlourHandler, error) {
 			return suite.handler, nil
 		},
+		typeHandlerCreator: func(_ domain.FlourTypeService) (domain.FlourTypeHandler, error) {
+			return suite.typeHandler, nil
+		},
 	}
 
 	tests := []struct {
 		name             string
 		serviceCreator   func(service flourDependencyService) domain.FlourDependencyService
+		seedError        error
+		seeds            bool
 		expectedErrorMsg string
 	}{
 		{
@@ -97,10 +152,21 @@ func (suite *FlourDependencyServiceTestSuite) TestInitialize_WithError() {
 			},
 			expectedErrorMsg: "failed to create repository",
 		},
+		{
+			name: "typeRepositoryCreator",
+			serviceCreator: func(service flourDependencyService) domain.FlourDependencyService {
+				service.typeRepositoryCreator = func(_ domain.MongoDBService) (domain.FlourTypeRepository, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create type repository",
+		},
 		{
 			name: "serviceCreator",
 			serviceCreator: func(service flourDependencyService) domain.FlourDependencyService {
-				service.serviceCreator = func(_ domain.FlourRepository) (domain.FlourService, error) {
+				service.serviceCreator = func(_ domain.FlourRepository, _ domain.FlourTypeRepository) (domain.FlourService, error) {
 					return nil, assert.AnError
 				}
 
@@ -108,6 +174,26 @@ func (suite *FlourDependencyServiceTestSuite) TestInitialize_WithError() {
 			},
 			expectedErrorMsg: "failed to create service",
 		},
+		{
+			name: "typeServiceCreator",
+			serviceCreator: func(service flourDependencyService) domain.FlourDependencyService {
+				service.typeServiceCreator = func(_ domain.FlourTypeRepository, _ domain.FlourRepository) (domain.FlourTypeService, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create type service",
+		},
+		{
+			name: "seedDefaults",
+			serviceCreator: func(service flourDependencyService) domain.FlourDependencyService {
+				return &service
+			},
+			seedError:        assert.AnError,
+			seeds:            true,
+			expectedErrorMsg: "failed to seed flour types",
+		},
 		{
 			name: "handlerCreator",
 			serviceCreator: func(service flourDependencyService) domain.FlourDependencyService {
@@ -117,22 +203,42 @@ func (suite *FlourDependencyServiceTestSuite) TestInitialize_WithError() {
 
 				return &service
 			},
+			seeds:            true,
 			expectedErrorMsg: "failed to create handler",
 		},
+		{
+			name: "typeHandlerCreator",
+			serviceCreator: func(service flourDependencyService) domain.FlourDependencyService {
+				service.typeHandlerCreator = func(_ domain.FlourTypeService) (domain.FlourTypeHandler, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			seeds:            true,
+			expectedErrorMsg: "failed to create type handler",
+		},
 	}
 
 	for _, tt := range tests {
 		suite.Run(tt.name, func() {
 			ctx := context.WithValue(context.Background(), "mongoDBService", suite.mongoDBService)
 
+			if tt.seeds {
+				suite.typeService.EXPECT().SeedDefaults(ctx).Return(tt.seedError)
+			}
+
 			service := tt.serviceCreator(baseService)
 
 			err := service.Initialize(ctx)
 
 			suite.ErrorContains(err, tt.expectedErrorMsg)
 			suite.Nil(service.Repository())
+			suite.Nil(service.TypeRepository())
 			suite.Nil(service.Service())
+			suite.Nil(service.TypeService())
 			suite.Nil(service.Router())
+			suite.Nil(service.TypeRouter())
 		})
 	}
 }
@@ -161,16 +267,48 @@ func (suite *FlourDependencyServiceTestSuite) TestRouter() {
 	suite.Equal(suite.handler, target.Router())
 }
 
+func (suite *FlourDependencyServiceTestSuite) TestTypeRepository() {
+	target := &flourDependencyService{
+		typeRepository: suite.typeRepository,
+	}
+
+	suite.Equal(suite.typeRepository, target.TypeRepository())
+}
+
+func (suite *FlourDependencyServiceTestSuite) TestTypeService() {
+	target := &flourDependencyService{
+		typeService: suite.typeService,
+	}
+
+	suite.Equal(suite.typeService, target.TypeService())
+}
+
+func (suite *FlourDependencyServiceTestSuite) TestTypeRouter() {
+	target := &flourDependencyService{
+		typeHandler: suite.typeHandler,
+	}
+
+	suite.Equal(suite.typeHandler, target.TypeRouter())
+}
+
 func (suite *FlourDependencyServiceTestSuite) TestNewFlourDependencyService() {
 	target := NewFlourDependencyService().(*flourDependencyService)
 
 	suite.NotNil(target)
 	suite.NotNil(target.repositoryCreator)
+	suite.NotNil(target.embeddedRepositoryCreator)
+	suite.NotNil(target.typeRepositoryCreator)
+	suite.NotNil(target.embeddedTypeRepositoryCreator)
 	suite.NotNil(target.serviceCreator)
+	suite.NotNil(target.typeServiceCreator)
 	suite.NotNil(target.handlerCreator)
+	suite.NotNil(target.typeHandlerCreator)
 	suite.Nil(target.repository)
+	suite.Nil(target.typeRepository)
 	suite.Nil(target.service)
+	suite.Nil(target.typeService)
 	suite.Nil(target.handler)
+	suite.Nil(target.typeHandler)
 }
 
 func TestFlourDependencyServiceTestSuite(t *testing.T) {
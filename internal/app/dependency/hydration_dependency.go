@@ -0,0 +1,68 @@
+package dependency
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/controller/rest"
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/service"
+)
+
+type hydrationDependencyService struct {
+	serviceCreator func(flourRepository domain.FlourRepository, sourdoughRecipeService domain.SourdoughRecipeService) (domain.HydrationService, error)
+	service        domain.HydrationService
+
+	handlerCreator func(service domain.HydrationService) (domain.HydrationHandler, error)
+	handler        domain.HydrationHandler
+}
+
+func (dependencyService *hydrationDependencyService) Initialize(ctx context.Context) error {
+	flourRepository, err := getFromContext[domain.FlourRepository](ctx, "flourRepository")
+	if err != nil {
+		return errors.Wrap(err, "failed to get flourRepository from context")
+	}
+
+	sourdoughRecipeService, err := getFromContext[domain.SourdoughRecipeService](ctx, "sourdoughRecipeService")
+	if err != nil {
+		return errors.Wrap(err, "failed to get sourdoughRecipeService from context")
+	}
+
+	hydrationService, err := dependencyService.serviceCreator(flourRepository, sourdoughRecipeService)
+	if err != nil {
+		return errors.Wrap(err, "failed to create service")
+	}
+
+	hydrationHandler, err := dependencyService.handlerCreator(hydrationService)
+	if err != nil {
+		return errors.Wrap(err, "failed to create handler")
+	}
+
+	dependencyService.service = hydrationService
+	dependencyService.handler = hydrationHandler
+
+	return nil
+}
+
+func (dependencyService *hydrationDependencyService) Service() domain.HydrationService {
+	return dependencyService.service
+}
+
+func (dependencyService *hydrationDependencyService) Router() domain.HydrationHandler {
+	return dependencyService.handler
+}
+
+func NewHydrationDependencyService() domain.HydrationDependencyService {
+	return newHydrationDependencyService(service.NewHydrationService, rest.NewHydrationHandler)
+}
+
+func newHydrationDependencyService(
+	serviceCreator func(flourRepository domain.FlourRepository, sourdoughRecipeService domain.SourdoughRecipeService) (domain.HydrationService, error),
+	handlerCreator func(service domain.HydrationService) (domain.HydrationHandler, error),
+) domain.HydrationDependencyService {
+	return &hydrationDependencyService{
+		serviceCreator: serviceCreator,
+		handlerCreator: handlerCreator,
+	}
+}
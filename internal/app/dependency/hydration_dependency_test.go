@@ -0,0 +1,150 @@
+package dependency
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	"dough-calculator/internal/test"
+)
+
+type HydrationDependencyServiceTestSuite struct {
+	test.GoMockTestSuite
+
+	flourRepository        *mocks.MockFlourRepository
+	sourdoughRecipeService *mocks.MockSourdoughRecipeService
+	service                *mocks.MockHydrationService
+	handler                *mocks.MockHydrationHandler
+
+	target domain.HydrationDependencyService
+}
+
+func (suite *HydrationDependencyServiceTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.flourRepository = mocks.NewMockFlourRepository(suite.MockCtrl)
+	suite.sourdoughRecipeService = mocks.NewMockSourdoughRecipeService(suite.MockCtrl)
+	suite.service = mocks.NewMockHydrationService(suite.MockCtrl)
+	suite.handler = mocks.NewMockHydrationHandler(suite.MockCtrl)
+
+	suite.target = newHydrationDependencyService(
+		func(_ domain.FlourRepository, _ domain.SourdoughRecipeService) (domain.HydrationService, error) {
+			return suite.service, nil
+		},
+		func(_ domain.HydrationService) (domain.HydrationHandler, error) {
+			return suite.handler, nil
+		},
+	)
+}
+
+func (suite *HydrationDependencyServiceTestSuite) context() context.Context {
+	ctx := context.WithValue(context.Background(), "flourRepository", suite.flourRepository)
+	return context.WithValue(ctx, "sourdoughRecipeService", suite.sourdoughRecipeService)
+}
+
+func (suite *HydrationDependencyServiceTestSuite) TestInitialize() {
+	err := suite.target.Initialize(suite.context())
+
+	suite.NoError(err)
+	suite.Equal(suite.service, suite.target.Service())
+	suite.Equal(suite.handler, suite.target.Router())
+}
+
+func (suite *HydrationDependencyServiceTestSuite) TestInitialize_WithMissingDependency() {
+	tests := []struct {
+		name             string
+		ctx              context.Context
+		expectedErrorMsg string
+	}{
+		{
+			name:             "flourRepository",
+			ctx:              context.WithValue(context.Background(), "sourdoughRecipeService", suite.sourdoughRecipeService),
+			expectedErrorMsg: "failed to get flourRepository from context",
+		},
+		{
+			name:             "sourdoughRecipeService",
+			ctx:              context.WithValue(context.Background(), "flourRepository", suite.flourRepository),
+			expectedErrorMsg: "failed to get sourdoughRecipeService from context",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			err := suite.target.Initialize(tt.ctx)
+
+			suite.ErrorContains(err, tt.expectedErrorMsg)
+			suite.Nil(suite.target.Service())
+			suite.Nil(suite.target.Router())
+		})
+	}
+}
+
+func (suite *HydrationDependencyServiceTestSuite) TestInitialize_WithError() {
+	baseService := hydrationDependencyService{
+		serviceCreator: func(_ domain.FlourRepository, _ domain.SourdoughRecipeService) (domain.HydrationService, error) {
+			return suite.service, nil
+		},
+		handlerCreator: func(_ domain.HydrationService) (domain.HydrationHandler, error) {
+			return suite.handler, nil
+		},
+	}
+
+	tests := []struct {
+		name             string
+		serviceCreator   func(service hydrationDependencyService) domain.HydrationDependencyService
+		expectedErrorMsg string
+	}{
+		{
+			name: "serviceCreator",
+			serviceCreator: func(service hydrationDependencyService) domain.HydrationDependencyService {
+				service.serviceCreator = func(_ domain.FlourRepository, _ domain.SourdoughRecipeService) (domain.HydrationService, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create service",
+		},
+		{
+			name: "handlerCreator",
+			serviceCreator: func(service hydrationDependencyService) domain.HydrationDependencyService {
+				service.handlerCreator = func(_ domain.HydrationService) (domain.HydrationHandler, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create handler",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			service := tt.serviceCreator(baseService)
+
+			err := service.Initialize(suite.context())
+
+			suite.ErrorContains(err, tt.expectedErrorMsg)
+			suite.Nil(service.Service())
+			suite.Nil(service.Router())
+		})
+	}
+}
+
+func (suite *HydrationDependencyServiceTestSuite) TestNewHydrationDependencyService() {
+	target := NewHydrationDependencyService().(*hydrationDependencyService)
+
+	suite.NotNil(target)
+	suite.NotNil(target.serviceCreator)
+	suite.NotNil(target.handlerCreator)
+	suite.Nil(target.service)
+	suite.Nil(target.handler)
+}
+
+func TestHydrationDependencyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(HydrationDependencyServiceTestSuite))
+}
@@ -0,0 +1,138 @@
+package dependency
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/config"
+	"dough-calculator/internal/controller/rest"
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/repository"
+	"dough-calculator/internal/service"
+)
+
+type imageDependencyService struct {
+	blobStoreCreator func(storage config.Storage, mongoDBService domain.MongoDBService) (domain.BlobStore, error)
+	blobStore        domain.BlobStore
+
+	repositoryCreator         func(mongoDBService domain.MongoDBService) (domain.ImageRepository, error)
+	embeddedRepositoryCreator func(database domain.EmbeddedDatabase) (domain.ImageRepository, error)
+	repository                domain.ImageRepository
+
+	serviceCreator func(
+		repository domain.ImageRepository,
+		blobStore domain.BlobStore,
+		sourdoughRecipeService domain.SourdoughRecipeService,
+		bakeLogService domain.BakeLogService,
+		storage config.Storage,
+	) (domain.ImageService, error)
+	service domain.ImageService
+
+	handlerCreator func(service domain.ImageService, storage config.Storage) (domain.ImageHandler, error)
+	handler        domain.ImageHandler
+}
+
+func (dependencyService *imageDependencyService) Initialize(ctx context.Context) error {
+	configManager, err := getFromContext[domain.ConfigManager](ctx, "configManager")
+	if err != nil {
+		return errors.Wrap(err, "failed to get configManager from context")
+	}
+
+	// only a GridFS blob store needs MongoDB, embedded databases run with
+	// local storage
+	var mongoDBService domain.MongoDBService
+	if _, err := getFromContext[domain.EmbeddedDatabase](ctx, "embeddedDatabase"); err != nil {
+		mongoDBService, err = getFromContext[domain.MongoDBService](ctx, "mongoDBService")
+		if err != nil {
+			return errors.Wrap(err, "failed to get mongoDBService from context")
+		}
+	}
+
+	sourdoughRecipeService, err := getFromContext[domain.SourdoughRecipeService](ctx, "sourdoughRecipeService")
+	if err != nil {
+		return errors.Wrap(err, "failed to get sourdoughRecipeService from context")
+	}
+
+	bakeLogService, err := getFromContext[domain.BakeLogService](ctx, "bakeLogService")
+	if err != nil {
+		return errors.Wrap(err, "failed to get bakeLogService from context")
+	}
+
+	storage := configManager.GetConfig().Storage
+
+	blobStore, err := dependencyService.blobStoreCreator(storage, mongoDBService)
+	if err != nil {
+		return errors.Wrap(err, "failed to create blob store")
+	}
+
+	imageRepository, err := createOnBackend(ctx, dependencyService.repositoryCreator, dependencyService.embeddedRepositoryCreator)
+	if err != nil {
+		return errors.Wrap(err, "failed to create repository")
+	}
+
+	imageService, err := dependencyService.serviceCreator(imageRepository, blobStore, sourdoughRecipeService, bakeLogService, storage)
+	if err != nil {
+		return errors.Wrap(err, "failed to create service")
+	}
+
+	imageHandler, err := dependencyService.handlerCreator(imageService, storage)
+	if err != nil {
+		return errors.Wrap(err, "failed to create handler")
+	}
+
+	dependencyService.blobStore = blobStore
+	dependencyService.repository = imageRepository
+	dependencyService.service = imageService
+	dependencyService.handler = imageHandler
+
+	return nil
+}
+
+func (dependencyService *imageDependencyService) BlobStore() domain.BlobStore {
+	return dependencyService.blobStore
+}
+
+func (dependencyService *imageDependencyService) Repository() domain.ImageRepository {
+	return dependencyService.repository
+}
+
+func (dependencyService *imageDependencyService) Service() domain.ImageService {
+	return dependencyService.service
+}
+
+func (dependencyService *imageDependencyService) Router() domain.ImageHandler {
+	return dependencyService.handler
+}
+
+func NewImageDependencyService() domain.ImageDependencyService {
+	return newImageDependencyService(
+		repository.NewBlobStore,
+		repository.NewImageRepository,
+		repository.NewEmbeddedImageRepository,
+		service.NewImageService,
+		rest.NewImageHandler,
+	)
+}
+
+func newImageDependencyService(
+	blobStoreCreator func(storage config.Storage, mongoDBService domain.MongoDBService) (domain.BlobStore, error),
+	repositoryCreator func(mongoDBService domain.MongoDBService) (domain.ImageRepository, error),
+	embeddedRepositoryCreator func(database domain.EmbeddedDatabase) (domain.ImageRepository, error),
+	serviceCreator func(
+		repository domain.ImageRepository,
+		blobStore domain.BlobStore,
+		sourdoughRecipeService domain.SourdoughRecipeService,
+		bakeLogService domain.BakeLogService,
+		storage config.Storage,
+	) (domain.ImageService, error),
+	handlerCreator func(service domain.ImageService, storage config.Storage) (domain.ImageHandler, error),
+) domain.ImageDependencyService {
+	return &imageDependencyService{
+		blobStoreCreator:          blobStoreCreator,
+		repositoryCreator:         repositoryCreator,
+		embeddedRepositoryCreator: embeddedRepositoryCreator,
+		serviceCreator:            serviceCreator,
+		handlerCreator:            handlerCreator,
+	}
+}
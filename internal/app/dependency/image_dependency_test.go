@@ -0,0 +1,249 @@
+package dependency
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"dough-calculator/internal/config"
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	"dough-calculator/internal/test"
+)
+
+type ImageDependencyServiceTestSuite struct {
+	test.GoMockTestSuite
+
+	configManager          *mocks.MockConfigManager
+	mongoDBService         *mocks.MockMongoDBService
+	embeddedDatabase       *mocks.MockEmbeddedDatabase
+	sourdoughRecipeService *mocks.MockSourdoughRecipeService
+	bakeLogService         *mocks.MockBakeLogService
+	blobStore              *mocks.MockBlobStore
+	repository             *mocks.MockImageRepository
+	embeddedRepository     *mocks.MockImageRepository
+	service                *mocks.MockImageService
+	handler                *mocks.MockImageHandler
+
+	storage config.Storage
+
+	target domain.ImageDependencyService
+}
+
+func (suite *ImageDependencyServiceTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.configManager = mocks.NewMockConfigManager(suite.MockCtrl)
+	suite.mongoDBService = mocks.NewMockMongoDBService(suite.MockCtrl)
+	suite.embeddedDatabase = mocks.NewMockEmbeddedDatabase(suite.MockCtrl)
+	suite.sourdoughRecipeService = mocks.NewMockSourdoughRecipeService(suite.MockCtrl)
+	suite.bakeLogService = mocks.NewMockBakeLogService(suite.MockCtrl)
+	suite.blobStore = mocks.NewMockBlobStore(suite.MockCtrl)
+	suite.repository = mocks.NewMockImageRepository(suite.MockCtrl)
+	suite.embeddedRepository = mocks.NewMockImageRepository(suite.MockCtrl)
+	suite.service = mocks.NewMockImageService(suite.MockCtrl)
+	suite.handler = mocks.NewMockImageHandler(suite.MockCtrl)
+
+	suite.storage = config.Storage{Type: config.StorageTypeLocal, Local: config.LocalStorage{Path: "/tmp"}}
+	suite.configManager.EXPECT().GetConfig().Return(config.Config{Storage: suite.storage}).AnyTimes()
+
+	suite.target = &imageDependencyService{
+		blobStoreCreator: func(storage config.Storage, _ domain.MongoDBService) (domain.BlobStore, error) {
+			suite.Equal(suite.storage, storage)
+			return suite.blobStore, nil
+		},
+		repositoryCreator: func(_ domain.MongoDBService) (domain.ImageRepository, error) {
+			return suite.repository, nil
+		},
+		embeddedRepositoryCreator: func(_ domain.EmbeddedDatabase) (domain.ImageRepository, error) {
+			return suite.embeddedRepository, nil
+		},
+		serviceCreator: func(
+			_ domain.ImageRepository,
+			_ domain.BlobStore,
+			_ domain.SourdoughRecipeService,
+			_ domain.BakeLogService,
+			storage config.Storage,
+		) (domain.ImageService, error) {
+			suite.Equal(suite.storage, storage)
+			return suite.service, nil
+		},
+		handlerCreator: func(_ domain.ImageService, storage config.Storage) (domain.ImageHandler, error) {
+			suite.Equal(suite.storage, storage)
+			return suite.handler, nil
+		},
+	}
+}
+
+func (suite *ImageDependencyServiceTestSuite) context() context.Context {
+	ctx := context.WithValue(context.Background(), "configManager", suite.configManager)
+	ctx = context.WithValue(ctx, "mongoDBService", suite.mongoDBService)
+	ctx = context.WithValue(ctx, "sourdoughRecipeService", suite.sourdoughRecipeService)
+	return context.WithValue(ctx, "bakeLogService", suite.bakeLogService)
+}
+
+func (suite *ImageDependencyServiceTestSuite) TestInitialize() {
+	err := suite.target.Initialize(suite.context())
+
+	suite.NoError(err)
+	suite.Equal(suite.blobStore, suite.target.BlobStore())
+	suite.Equal(suite.repository, suite.target.Repository())
+	suite.Equal(suite.service, suite.target.Service())
+	suite.Equal(suite.handler, suite.target.Router())
+}
+
+func (suite *ImageDependencyServiceTestSuite) TestInitialize_WithEmbeddedDatabase() {
+	target := *suite.target.(*imageDependencyService)
+	target.blobStoreCreator = func(_ config.Storage, mongoDBService domain.MongoDBService) (domain.BlobStore, error) {
+		suite.Nil(mongoDBService)
+		return suite.blobStore, nil
+	}
+
+	ctx := context.WithValue(suite.context(), "mongoDBService", nil)
+	ctx = context.WithValue(ctx, "embeddedDatabase", suite.embeddedDatabase)
+
+	err := target.Initialize(ctx)
+
+	suite.NoError(err)
+	suite.Equal(suite.blobStore, target.BlobStore())
+	suite.Equal(suite.embeddedRepository, target.Repository())
+}
+
+func (suite *ImageDependencyServiceTestSuite) TestInitialize_WithMissingContextValues() {
+	tests := []struct {
+		name             string
+		missingKey       string
+		expectedErrorMsg string
+	}{
+		{
+			name:             "configManager is nil",
+			missingKey:       "configManager",
+			expectedErrorMsg: "failed to get configManager from context",
+		},
+		{
+			name:             "mongoDBService is nil",
+			missingKey:       "mongoDBService",
+			expectedErrorMsg: "failed to get mongoDBService from context",
+		},
+		{
+			name:             "sourdoughRecipeService is nil",
+			missingKey:       "sourdoughRecipeService",
+			expectedErrorMsg: "failed to get sourdoughRecipeService from context",
+		},
+		{
+			name:             "bakeLogService is nil",
+			missingKey:       "bakeLogService",
+			expectedErrorMsg: "failed to get bakeLogService from context",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			ctx := context.WithValue(suite.context(), tt.missingKey, nil)
+
+			err := suite.target.Initialize(ctx)
+
+			suite.ErrorContains(err, tt.expectedErrorMsg)
+			suite.Nil(suite.target.BlobStore())
+			suite.Nil(suite.target.Repository())
+			suite.Nil(suite.target.Service())
+			suite.Nil(suite.target.Router())
+		})
+	}
+}
+
+func (suite *ImageDependencyServiceTestSuite) TestInitialize_WithError() {
+	baseService := *suite.target.(*imageDependencyService)
+
+	tests := []struct {
+		name             string
+		serviceCreator   func(service imageDependencyService) domain.ImageDependencyService
+		expectedErrorMsg string
+	}{
+		{
+			name: "blobStoreCreator",
+			serviceCreator: func(service imageDependencyService) domain.ImageDependencyService {
+				service.blobStoreCreator = func(_ config.Storage, _ domain.MongoDBService) (domain.BlobStore, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create blob store",
+		},
+		{
+			name: "repositoryCreator",
+			serviceCreator: func(service imageDependencyService) domain.ImageDependencyService {
+				service.repositoryCreator = func(_ domain.MongoDBService) (domain.ImageRepository, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create repository",
+		},
+		{
+			name: "serviceCreator",
+			serviceCreator: func(service imageDependencyService) domain.ImageDependencyService {
+				service.serviceCreator = func(
+					_ domain.ImageRepository,
+					_ domain.BlobStore,
+					_ domain.SourdoughRecipeService,
+					_ domain.BakeLogService,
+					_ config.Storage,
+				) (domain.ImageService, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create service",
+		},
+		{
+			name: "handlerCreator",
+			serviceCreator: func(service imageDependencyService) domain.ImageDependencyService {
+				service.handlerCreator = func(_ domain.ImageService, _ config.Storage) (domain.ImageHandler, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create handler",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			service := tt.serviceCreator(baseService)
+
+			err := service.Initialize(suite.context())
+
+			suite.ErrorContains(err, tt.expectedErrorMsg)
+			suite.Nil(service.BlobStore())
+			suite.Nil(service.Repository())
+			suite.Nil(service.Service())
+			suite.Nil(service.Router())
+		})
+	}
+}
+
+func (suite *ImageDependencyServiceTestSuite) TestNewImageDependencyService() {
+	target := NewImageDependencyService().(*imageDependencyService)
+
+	suite.NotNil(target)
+	suite.NotNil(target.blobStoreCreator)
+	suite.NotNil(target.repositoryCreator)
+	suite.NotNil(target.embeddedRepositoryCreator)
+	suite.NotNil(target.serviceCreator)
+	suite.NotNil(target.handlerCreator)
+	suite.Nil(target.blobStore)
+	suite.Nil(target.repository)
+	suite.Nil(target.service)
+	suite.Nil(target.handler)
+}
+
+func TestImageDependencyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ImageDependencyServiceTestSuite))
+}
@@ -0,0 +1,117 @@
+package dependency
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/controller/rest"
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/repository"
+	"dough-calculator/internal/service"
+)
+
+type inventoryDependencyService struct {
+	transactionRunnerCreator         func(mongoDBService domain.MongoDBService) (domain.TransactionRunner, error)
+	embeddedTransactionRunnerCreator func(database domain.EmbeddedDatabase) (domain.TransactionRunner, error)
+
+	repositoryCreator         func(mongoDBService domain.MongoDBService) (domain.InventoryRepository, error)
+	embeddedRepositoryCreator func(database domain.EmbeddedDatabase) (domain.InventoryRepository, error)
+	repository                domain.InventoryRepository
+
+	serviceCreator func(
+		transactionRunner domain.TransactionRunner,
+		repository domain.InventoryRepository,
+		flourRepository domain.FlourRepository,
+		sourdoughRecipeScaleService domain.SourdoughRecipeScaleService,
+	) (domain.InventoryService, error)
+	service domain.InventoryService
+
+	handlerCreator func(service domain.InventoryService) (domain.InventoryHandler, error)
+	handler        domain.InventoryHandler
+}
+
+func (dependencyService *inventoryDependencyService) Initialize(ctx context.Context) error {
+	flourRepository, err := getFromContext[domain.FlourRepository](ctx, "flourRepository")
+	if err != nil {
+		return errors.Wrap(err, "failed to get flourRepository from context")
+	}
+
+	sourdoughRecipeScaleService, err := getFromContext[domain.SourdoughRecipeScaleService](ctx, "sourdoughRecipeScaleService")
+	if err != nil {
+		return errors.Wrap(err, "failed to get sourdoughRecipeScaleService from context")
+	}
+
+	inventoryRepository, err := createOnBackend(ctx, dependencyService.repositoryCreator, dependencyService.embeddedRepositoryCreator)
+	if err != nil {
+		return errors.Wrap(err, "failed to create repository")
+	}
+
+	transactionRunner, err := createOnBackend(ctx,
+		dependencyService.transactionRunnerCreator, dependencyService.embeddedTransactionRunnerCreator)
+	if err != nil {
+		return errors.Wrap(err, "failed to create transaction runner")
+	}
+
+	inventoryService, err := dependencyService.serviceCreator(transactionRunner, inventoryRepository, flourRepository, sourdoughRecipeScaleService)
+	if err != nil {
+		return errors.Wrap(err, "failed to create service")
+	}
+
+	inventoryHandler, err := dependencyService.handlerCreator(inventoryService)
+	if err != nil {
+		return errors.Wrap(err, "failed to create handler")
+	}
+
+	dependencyService.repository = inventoryRepository
+	dependencyService.service = inventoryService
+	dependencyService.handler = inventoryHandler
+
+	return nil
+}
+
+func (dependencyService *inventoryDependencyService) Repository() domain.InventoryRepository {
+	return dependencyService.repository
+}
+
+func (dependencyService *inventoryDependencyService) Service() domain.InventoryService {
+	return dependencyService.service
+}
+
+func (dependencyService *inventoryDependencyService) Router() domain.InventoryHandler {
+	return dependencyService.handler
+}
+
+func NewInventoryDependencyService() domain.InventoryDependencyService {
+	return newInventoryDependencyService(
+		repository.NewTransactionRunner,
+		repository.NewEmbeddedTransactionRunner,
+		repository.NewInventoryRepository,
+		repository.NewEmbeddedInventoryRepository,
+		service.NewInventoryService,
+		rest.NewInventoryHandler,
+	)
+}
+
+func newInventoryDependencyService(
+	transactionRunnerCreator func(mongoDBService domain.MongoDBService) (domain.TransactionRunner, error),
+	embeddedTransactionRunnerCreator func(database domain.EmbeddedDatabase) (domain.TransactionRunner, error),
+	repositoryCreator func(mongoDBService domain.MongoDBService) (domain.InventoryRepository, error),
+	embeddedRepositoryCreator func(database domain.EmbeddedDatabase) (domain.InventoryRepository, error),
+	serviceCreator func(
+		transactionRunner domain.TransactionRunner,
+		repository domain.InventoryRepository,
+		flourRepository domain.FlourRepository,
+		sourdoughRecipeScaleService domain.SourdoughRecipeScaleService,
+	) (domain.InventoryService, error),
+	handlerCreator func(service domain.InventoryService) (domain.InventoryHandler, error),
+) domain.InventoryDependencyService {
+	return &inventoryDependencyService{
+		transactionRunnerCreator:         transactionRunnerCreator,
+		embeddedTransactionRunnerCreator: embeddedTransactionRunnerCreator,
+		repositoryCreator:                repositoryCreator,
+		embeddedRepositoryCreator:        embeddedRepositoryCreator,
+		serviceCreator:                   serviceCreator,
+		handlerCreator:                   handlerCreator,
+	}
+}
@@ -0,0 +1,243 @@
+package dependency
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	"dough-calculator/internal/test"
+)
+
+type InventoryDependencyServiceTestSuite struct {
+	test.GoMockTestSuite
+
+	mongoDBService              *mocks.MockMongoDBService
+	embeddedDatabase            *mocks.MockEmbeddedDatabase
+	flourRepository             *mocks.MockFlourRepository
+	sourdoughRecipeScaleService *mocks.MockSourdoughRecipeScaleService
+	transactionRunner           *mocks.MockTransactionRunner
+	embeddedTransactionRunner   *mocks.MockTransactionRunner
+	repository                  *mocks.MockInventoryRepository
+	embeddedRepository          *mocks.MockInventoryRepository
+	service                     *mocks.MockInventoryService
+	handler                     *mocks.MockInventoryHandler
+
+	target domain.InventoryDependencyService
+}
+
+func (suite *InventoryDependencyServiceTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.mongoDBService = mocks.NewMockMongoDBService(suite.MockCtrl)
+	suite.embeddedDatabase = mocks.NewMockEmbeddedDatabase(suite.MockCtrl)
+	suite.flourRepository = mocks.NewMockFlourRepository(suite.MockCtrl)
+	suite.sourdoughRecipeScaleService = mocks.NewMockSourdoughRecipeScaleService(suite.MockCtrl)
+	suite.transactionRunner = mocks.NewMockTransactionRunner(suite.MockCtrl)
+	suite.embeddedTransactionRunner = mocks.NewMockTransactionRunner(suite.MockCtrl)
+	suite.repository = mocks.NewMockInventoryRepository(suite.MockCtrl)
+	suite.embeddedRepository = mocks.NewMockInventoryRepository(suite.MockCtrl)
+	suite.service = mocks.NewMockInventoryService(suite.MockCtrl)
+	suite.handler = mocks.NewMockInventoryHandler(suite.MockCtrl)
+
+	suite.target = newInventoryDependencyService(
+		func(_ domain.MongoDBService) (domain.TransactionRunner, error) {
+			return suite.transactionRunner, nil
+		},
+		func(_ domain.EmbeddedDatabase) (domain.TransactionRunner, error) {
+			return suite.embeddedTransactionRunner, nil
+		},
+		func(_ domain.MongoDBService) (domain.InventoryRepository, error) {
+			return suite.repository, nil
+		},
+		func(_ domain.EmbeddedDatabase) (domain.InventoryRepository, error) {
+			return suite.embeddedRepository, nil
+		},
+		func(_ domain.TransactionRunner, _ domain.InventoryRepository, _ domain.FlourRepository, _ domain.SourdoughRecipeScaleService) (domain.InventoryService, error) {
+			return suite.service, nil
+		},
+		func(_ domain.InventoryService) (domain.InventoryHandler, error) {
+			return suite.handler, nil
+		},
+	)
+}
+
+// context holds every dependency of the inventory dependency service except
+// the ones listed in without.
+func (suite *InventoryDependencyServiceTestSuite) context(without ...string) context.Context {
+	values := map[string]any{
+		"mongoDBService":              suite.mongoDBService,
+		"flourRepository":             suite.flourRepository,
+		"sourdoughRecipeScaleService": suite.sourdoughRecipeScaleService,
+	}
+	for _, key := range without {
+		delete(values, key)
+	}
+
+	ctx := context.Background()
+	for key, value := range values {
+		ctx = context.WithValue(ctx, key, value)
+	}
+	return ctx
+}
+
+func (suite *InventoryDependencyServiceTestSuite) TestInitialize() {
+	err := suite.target.Initialize(suite.context())
+
+	suite.NoError(err)
+	suite.Equal(suite.repository, suite.target.Repository())
+	suite.Equal(suite.service, suite.target.Service())
+	suite.Equal(suite.handler, suite.target.Router())
+}
+
+func (suite *InventoryDependencyServiceTestSuite) TestInitialize_WithEmbeddedDatabase() {
+	ctx := context.WithValue(suite.context("mongoDBService"), "embeddedDatabase", suite.embeddedDatabase)
+	target := suite.target.(*inventoryDependencyService)
+	target.serviceCreator = func(transactionRunner domain.TransactionRunner, _ domain.InventoryRepository, _ domain.FlourRepository, _ domain.SourdoughRecipeScaleService) (domain.InventoryService, error) {
+		suite.Equal(suite.embeddedTransactionRunner, transactionRunner)
+		return suite.service, nil
+	}
+
+	err := suite.target.Initialize(ctx)
+
+	suite.NoError(err)
+	suite.Equal(suite.embeddedRepository, suite.target.Repository())
+}
+
+func (suite *InventoryDependencyServiceTestSuite) TestInitialize_WithMissingContextValues() {
+	tests := []struct {
+		name             string
+		ctx              context.Context
+		expectedErrorMsg string
+	}{
+		{
+			name:             "mongoDBService is nil",
+			ctx:              suite.context("mongoDBService"),
+			expectedErrorMsg: "failed to get mongoDBService from context",
+		},
+		{
+			name:             "flourRepository is nil",
+			ctx:              suite.context("flourRepository"),
+			expectedErrorMsg: "failed to get flourRepository from context",
+		},
+		{
+			name:             "sourdoughRecipeScaleService is nil",
+			ctx:              suite.context("sourdoughRecipeScaleService"),
+			expectedErrorMsg: "failed to get sourdoughRecipeScaleService from context",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			err := suite.target.Initialize(tt.ctx)
+
+			suite.ErrorContains(err, tt.expectedErrorMsg)
+			suite.Nil(suite.target.Repository())
+			suite.Nil(suite.target.Service())
+			suite.Nil(suite.target.Router())
+		})
+	}
+}
+
+func (suite *InventoryDependencyServiceTestSuite) TestInitialize_WithError() {
+	baseService := inventoryDependencyService{
+		transactionRunnerCreator: func(_ domain.MongoDBService) (domain.TransactionRunner, error) {
+			return suite.transactionRunner, nil
+		},
+		repositoryCreator: func(_ domain.MongoDBService) (domain.InventoryRepository, error) {
+			return suite.repository, nil
+		},
+		serviceCreator: func(_ domain.TransactionRunner, _ domain.InventoryRepository, _ domain.FlourRepository, _ domain.SourdoughRecipeScaleService) (domain.InventoryService, error) {
+			return suite.service, nil
+		},
+		handlerCreator: func(_ domain.InventoryService) (domain.InventoryHandler, error) {
+			return suite.handler, nil
+		},
+	}
+
+	tests := []struct {
+		name             string
+		serviceCreator   func(service inventoryDependencyService) domain.InventoryDependencyService
+		expectedErrorMsg string
+	}{
+		{
+			name: "repositoryCreator",
+			serviceCreator: func(service inventoryDependencyService) domain.InventoryDependencyService {
+				service.repositoryCreator = func(_ domain.MongoDBService) (domain.InventoryRepository, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create repository",
+		},
+		{
+			name: "transactionRunnerCreator",
+			serviceCreator: func(service inventoryDependencyService) domain.InventoryDependencyService {
+				service.transactionRunnerCreator = func(_ domain.MongoDBService) (domain.TransactionRunner, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create transaction runner",
+		},
+		{
+			name: "serviceCreator",
+			serviceCreator: func(service inventoryDependencyService) domain.InventoryDependencyService {
+				service.serviceCreator = func(_ domain.TransactionRunner, _ domain.InventoryRepository, _ domain.FlourRepository, _ domain.SourdoughRecipeScaleService) (domain.InventoryService, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create service",
+		},
+		{
+			name: "handlerCreator",
+			serviceCreator: func(service inventoryDependencyService) domain.InventoryDependencyService {
+				service.handlerCreator = func(_ domain.InventoryService) (domain.InventoryHandler, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create handler",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			service := tt.serviceCreator(baseService)
+
+			err := service.Initialize(suite.context())
+
+			suite.ErrorContains(err, tt.expectedErrorMsg)
+			suite.Nil(service.Repository())
+			suite.Nil(service.Service())
+			suite.Nil(service.Router())
+		})
+	}
+}
+
+func (suite *InventoryDependencyServiceTestSuite) TestNewInventoryDependencyService() {
+	target := NewInventoryDependencyService().(*inventoryDependencyService)
+
+	suite.NotNil(target)
+	suite.NotNil(target.transactionRunnerCreator)
+	suite.NotNil(target.embeddedTransactionRunnerCreator)
+	suite.NotNil(target.repositoryCreator)
+	suite.NotNil(target.embeddedRepositoryCreator)
+	suite.NotNil(target.serviceCreator)
+	suite.NotNil(target.handlerCreator)
+	suite.Nil(target.repository)
+	suite.Nil(target.service)
+	suite.Nil(target.handler)
+}
+
+func TestInventoryDependencyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(InventoryDependencyServiceTestSuite))
+}
@@ -0,0 +1,102 @@
+package dependency
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"dough-calculator/internal/config"
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/repository"
+	"dough-calculator/internal/service"
+)
+
+type migrationDependencyService struct {
+	repositoryCreator func(mongoDBService domain.MongoDBService) (domain.MigrationRepository, error)
+	repository        domain.MigrationRepository
+
+	migrationsCreator func(mongoDBService domain.MongoDBService) ([]domain.Migration, error)
+
+	serviceCreator func(
+		repository domain.MigrationRepository,
+		migrations []domain.Migration,
+		config config.Migrations,
+	) (domain.MigrationService, error)
+	service domain.MigrationService
+}
+
+// Initialize creates the migration service on MongoDB. The embedded
+// databases have no schema to migrate, Service stays nil on them.
+func (dependencyService *migrationDependencyService) Initialize(ctx context.Context) error {
+	configManager, err := getFromContext[domain.ConfigManager](ctx, "configManager")
+	if err != nil {
+		return errors.Wrap(err, "failed to get configManager from context")
+	}
+
+	if _, err = getFromContext[domain.EmbeddedDatabase](ctx, "embeddedDatabase"); err == nil {
+		log.Debug().Msg("Embedded database has no migrations")
+		return nil
+	}
+
+	mongoDBService, err := getFromContext[domain.MongoDBService](ctx, "mongoDBService")
+	if err != nil {
+		return errors.Wrap(err, "failed to get mongoDBService from context")
+	}
+
+	migrationRepository, err := dependencyService.repositoryCreator(mongoDBService)
+	if err != nil {
+		return errors.Wrap(err, "failed to create repository")
+	}
+
+	migrations, err := dependencyService.migrationsCreator(mongoDBService)
+	if err != nil {
+		return errors.Wrap(err, "failed to create migrations")
+	}
+
+	migrationService, err := dependencyService.serviceCreator(
+		migrationRepository,
+		migrations,
+		configManager.GetConfig().Database.Migrations,
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create service")
+	}
+
+	dependencyService.repository = migrationRepository
+	dependencyService.service = migrationService
+
+	return nil
+}
+
+func (dependencyService *migrationDependencyService) Repository() domain.MigrationRepository {
+	return dependencyService.repository
+}
+
+func (dependencyService *migrationDependencyService) Service() domain.MigrationService {
+	return dependencyService.service
+}
+
+func NewMigrationDependencyService() domain.MigrationDependencyService {
+	return newMigrationDependencyService(
+		repository.NewMigrationRepository,
+		repository.NewMigrations,
+		service.NewMigrationService,
+	)
+}
+
+func newMigrationDependencyService(
+	repositoryCreator func(mongoDBService domain.MongoDBService) (domain.MigrationRepository, error),
+	migrationsCreator func(mongoDBService domain.MongoDBService) ([]domain.Migration, error),
+	serviceCreator func(
+		repository domain.MigrationRepository,
+		migrations []domain.Migration,
+		config config.Migrations,
+	) (domain.MigrationService, error),
+) domain.MigrationDependencyService {
+	return &migrationDependencyService{
+		repositoryCreator: repositoryCreator,
+		migrationsCreator: migrationsCreator,
+		serviceCreator:    serviceCreator,
+	}
+}
@@ -0,0 +1,195 @@
+package dependency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"dough-calculator/internal/config"
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	"dough-calculator/internal/test"
+)
+
+type MigrationDependencyServiceTestSuite struct {
+	test.GoMockTestSuite
+
+	configManager    *mocks.MockConfigManager
+	mongoDBService   *mocks.MockMongoDBService
+	embeddedDatabase *mocks.MockEmbeddedDatabase
+	repository       *mocks.MockMigrationRepository
+	service          *mocks.MockMigrationService
+
+	migrations []domain.Migration
+	config     config.Migrations
+
+	target domain.MigrationDependencyService
+}
+
+func (suite *MigrationDependencyServiceTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.configManager = mocks.NewMockConfigManager(suite.MockCtrl)
+	suite.mongoDBService = mocks.NewMockMongoDBService(suite.MockCtrl)
+	suite.embeddedDatabase = mocks.NewMockEmbeddedDatabase(suite.MockCtrl)
+	suite.repository = mocks.NewMockMigrationRepository(suite.MockCtrl)
+	suite.service = mocks.NewMockMigrationService(suite.MockCtrl)
+
+	suite.migrations = []domain.Migration{{Version: 1, Description: "first"}}
+	suite.config = config.Migrations{LockTimeout: time.Minute}
+	suite.configManager.EXPECT().GetConfig().
+		Return(config.Config{Database: config.Database{Migrations: suite.config}}).AnyTimes()
+
+	suite.target = newMigrationDependencyService(
+		func(mongoDBService domain.MongoDBService) (domain.MigrationRepository, error) {
+			suite.Equal(suite.mongoDBService, mongoDBService)
+			return suite.repository, nil
+		},
+		func(mongoDBService domain.MongoDBService) ([]domain.Migration, error) {
+			suite.Equal(suite.mongoDBService, mongoDBService)
+			return suite.migrations, nil
+		},
+		func(
+			repository domain.MigrationRepository,
+			migrations []domain.Migration,
+			config config.Migrations,
+		) (domain.MigrationService, error) {
+			suite.Equal(suite.repository, repository)
+			suite.Equal(suite.migrations, migrations)
+			suite.Equal(suite.config, config)
+			return suite.service, nil
+		},
+	)
+}
+
+func (suite *MigrationDependencyServiceTestSuite) context() context.Context {
+	ctx := context.WithValue(context.Background(), "configManager", suite.configManager)
+	return context.WithValue(ctx, "mongoDBService", suite.mongoDBService)
+}
+
+func (suite *MigrationDependencyServiceTestSuite) TestInitialize() {
+	err := suite.target.Initialize(suite.context())
+
+	suite.NoError(err)
+	suite.Equal(suite.repository, suite.target.Repository())
+	suite.Equal(suite.service, suite.target.Service())
+}
+
+func (suite *MigrationDependencyServiceTestSuite) TestInitialize_WithEmbeddedDatabase() {
+	ctx := context.WithValue(suite.context(), "mongoDBService", nil)
+	ctx = context.WithValue(ctx, "embeddedDatabase", suite.embeddedDatabase)
+
+	err := suite.target.Initialize(ctx)
+
+	suite.NoError(err)
+	suite.Nil(suite.target.Repository())
+	suite.Nil(suite.target.Service())
+}
+
+func (suite *MigrationDependencyServiceTestSuite) TestInitialize_WithMissingContextValues() {
+	tests := []struct {
+		name             string
+		missingKey       string
+		expectedErrorMsg string
+	}{
+		{
+			name:             "configManager is nil",
+			missingKey:       "configManager",
+			expectedErrorMsg: "failed to get configManager from context",
+		},
+		{
+			name:             "mongoDBService is nil",
+			missingKey:       "mongoDBService",
+			expectedErrorMsg: "failed to get mongoDBService from context",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			ctx := context.WithValue(suite.context(), tt.missingKey, nil)
+
+			err := suite.target.Initialize(ctx)
+
+			suite.ErrorContains(err, tt.expectedErrorMsg)
+			suite.Nil(suite.target.Service())
+		})
+	}
+}
+
+func (suite *MigrationDependencyServiceTestSuite) TestInitialize_WithError() {
+	baseService := *suite.target.(*migrationDependencyService)
+
+	tests := []struct {
+		name             string
+		serviceCreator   func(service migrationDependencyService) domain.MigrationDependencyService
+		expectedErrorMsg string
+	}{
+		{
+			name: "repositoryCreator",
+			serviceCreator: func(service migrationDependencyService) domain.MigrationDependencyService {
+				service.repositoryCreator = func(_ domain.MongoDBService) (domain.MigrationRepository, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create repository",
+		},
+		{
+			name: "migrationsCreator",
+			serviceCreator: func(service migrationDependencyService) domain.MigrationDependencyService {
+				service.migrationsCreator = func(_ domain.MongoDBService) ([]domain.Migration, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create migrations",
+		},
+		{
+			name: "serviceCreator",
+			serviceCreator: func(service migrationDependencyService) domain.MigrationDependencyService {
+				service.serviceCreator = func(
+					_ domain.MigrationRepository,
+					_ []domain.Migration,
+					_ config.Migrations,
+				) (domain.MigrationService, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create service",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			service := tt.serviceCreator(baseService)
+
+			err := service.Initialize(suite.context())
+
+			suite.ErrorContains(err, tt.expectedErrorMsg)
+			suite.Nil(service.Repository())
+			suite.Nil(service.Service())
+		})
+	}
+}
+
+func (suite *MigrationDependencyServiceTestSuite) TestNewMigrationDependencyService() {
+	target := NewMigrationDependencyService().(*migrationDependencyService)
+
+	suite.NotNil(target)
+	suite.NotNil(target.repositoryCreator)
+	suite.NotNil(target.migrationsCreator)
+	suite.NotNil(target.serviceCreator)
+	suite.Nil(target.repository)
+	suite.Nil(target.service)
+}
+
+func TestMigrationDependencyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(MigrationDependencyServiceTestSuite))
+}
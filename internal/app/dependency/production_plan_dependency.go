@@ -0,0 +1,138 @@
+package dependency
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/controller/rest"
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/repository"
+	"dough-calculator/internal/service"
+)
+
+type productionPlanDependencyService struct {
+	transactionRunnerCreator         func(mongoDBService domain.MongoDBService) (domain.TransactionRunner, error)
+	embeddedTransactionRunnerCreator func(database domain.EmbeddedDatabase) (domain.TransactionRunner, error)
+
+	repositoryCreator         func(mongoDBService domain.MongoDBService) (domain.ProductionPlanRepository, error)
+	embeddedRepositoryCreator func(database domain.EmbeddedDatabase) (domain.ProductionPlanRepository, error)
+	repository                domain.ProductionPlanRepository
+
+	serviceCreator func(
+		transactionRunner domain.TransactionRunner,
+		repository domain.ProductionPlanRepository,
+		sourdoughRecipeService domain.SourdoughRecipeService,
+		sourdoughRecipeScaleService domain.SourdoughRecipeScaleService,
+		bakeLogService domain.BakeLogService,
+		inventoryService domain.InventoryService,
+	) (domain.ProductionPlanService, error)
+	service domain.ProductionPlanService
+
+	handlerCreator func(service domain.ProductionPlanService) (domain.ProductionPlanHandler, error)
+	handler        domain.ProductionPlanHandler
+}
+
+func (dependencyService *productionPlanDependencyService) Initialize(ctx context.Context) error {
+	sourdoughRecipeService, err := getFromContext[domain.SourdoughRecipeService](ctx, "sourdoughRecipeService")
+	if err != nil {
+		return errors.Wrap(err, "failed to get sourdoughRecipeService from context")
+	}
+
+	sourdoughRecipeScaleService, err := getFromContext[domain.SourdoughRecipeScaleService](ctx, "sourdoughRecipeScaleService")
+	if err != nil {
+		return errors.Wrap(err, "failed to get sourdoughRecipeScaleService from context")
+	}
+
+	bakeLogService, err := getFromContext[domain.BakeLogService](ctx, "bakeLogService")
+	if err != nil {
+		return errors.Wrap(err, "failed to get bakeLogService from context")
+	}
+
+	inventoryService, err := getFromContext[domain.InventoryService](ctx, "inventoryService")
+	if err != nil {
+		return errors.Wrap(err, "failed to get inventoryService from context")
+	}
+
+	productionPlanRepository, err := createOnBackend(ctx, dependencyService.repositoryCreator, dependencyService.embeddedRepositoryCreator)
+	if err != nil {
+		return errors.Wrap(err, "failed to create repository")
+	}
+
+	transactionRunner, err := createOnBackend(ctx,
+		dependencyService.transactionRunnerCreator, dependencyService.embeddedTransactionRunnerCreator)
+	if err != nil {
+		return errors.Wrap(err, "failed to create transaction runner")
+	}
+
+	productionPlanService, err := dependencyService.serviceCreator(
+		transactionRunner,
+		productionPlanRepository,
+		sourdoughRecipeService,
+		sourdoughRecipeScaleService,
+		bakeLogService,
+		inventoryService,
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create service")
+	}
+
+	productionPlanHandler, err := dependencyService.handlerCreator(productionPlanService)
+	if err != nil {
+		return errors.Wrap(err, "failed to create handler")
+	}
+
+	dependencyService.repository = productionPlanRepository
+	dependencyService.service = productionPlanService
+	dependencyService.handler = productionPlanHandler
+
+	return nil
+}
+
+func (dependencyService *productionPlanDependencyService) Repository() domain.ProductionPlanRepository {
+	return dependencyService.repository
+}
+
+func (dependencyService *productionPlanDependencyService) Service() domain.ProductionPlanService {
+	return dependencyService.service
+}
+
+func (dependencyService *productionPlanDependencyService) Router() domain.ProductionPlanHandler {
+	return dependencyService.handler
+}
+
+func NewProductionPlanDependencyService() domain.ProductionPlanDependencyService {
+	return newProductionPlanDependencyService(
+		repository.NewTransactionRunner,
+		repository.NewEmbeddedTransactionRunner,
+		repository.NewProductionPlanRepository,
+		repository.NewEmbeddedProductionPlanRepository,
+		service.NewProductionPlanService,
+		rest.NewProductionPlanHandler,
+	)
+}
+
+func newProductionPlanDependencyService(
+	transactionRunnerCreator func(mongoDBService domain.MongoDBService) (domain.TransactionRunner, error),
+	embeddedTransactionRunnerCreator func(database domain.EmbeddedDatabase) (domain.TransactionRunner, error),
+	repositoryCreator func(mongoDBService domain.MongoDBService) (domain.ProductionPlanRepository, error),
+	embeddedRepositoryCreator func(database domain.EmbeddedDatabase) (domain.ProductionPlanRepository, error),
+	serviceCreator func(
+		transactionRunner domain.TransactionRunner,
+		repository domain.ProductionPlanRepository,
+		sourdoughRecipeService domain.SourdoughRecipeService,
+		sourdoughRecipeScaleService domain.SourdoughRecipeScaleService,
+		bakeLogService domain.BakeLogService,
+		inventoryService domain.InventoryService,
+	) (domain.ProductionPlanService, error),
+	handlerCreator func(service domain.ProductionPlanService) (domain.ProductionPlanHandler, error),
+) domain.ProductionPlanDependencyService {
+	return &productionPlanDependencyService{
+		transactionRunnerCreator:         transactionRunnerCreator,
+		embeddedTransactionRunnerCreator: embeddedTransactionRunnerCreator,
+		repositoryCreator:                repositoryCreator,
+		embeddedRepositoryCreator:        embeddedRepositoryCreator,
+		serviceCreator:                   serviceCreator,
+		handlerCreator:                   handlerCreator,
+	}
+}
@@ -0,0 +1,275 @@
+package dependency
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	"dough-calculator/internal/test"
+)
+
+type ProductionPlanDependencyServiceTestSuite struct {
+	test.GoMockTestSuite
+
+	mongoDBService              *mocks.MockMongoDBService
+	embeddedDatabase            *mocks.MockEmbeddedDatabase
+	sourdoughRecipeService      *mocks.MockSourdoughRecipeService
+	sourdoughRecipeScaleService *mocks.MockSourdoughRecipeScaleService
+	bakeLogService              *mocks.MockBakeLogService
+	inventoryService            *mocks.MockInventoryService
+	transactionRunner           *mocks.MockTransactionRunner
+	embeddedTransactionRunner   *mocks.MockTransactionRunner
+	repository                  *mocks.MockProductionPlanRepository
+	embeddedRepository          *mocks.MockProductionPlanRepository
+	service                     *mocks.MockProductionPlanService
+	handler                     *mocks.MockProductionPlanHandler
+
+	target domain.ProductionPlanDependencyService
+}
+
+func (suite *ProductionPlanDependencyServiceTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.mongoDBService = mocks.NewMockMongoDBService(suite.MockCtrl)
+	suite.embeddedDatabase = mocks.NewMockEmbeddedDatabase(suite.MockCtrl)
+	suite.sourdoughRecipeService = mocks.NewMockSourdoughRecipeService(suite.MockCtrl)
+	suite.sourdoughRecipeScaleService = mocks.NewMockSourdoughRecipeScaleService(suite.MockCtrl)
+	suite.bakeLogService = mocks.NewMockBakeLogService(suite.MockCtrl)
+	suite.inventoryService = mocks.NewMockInventoryService(suite.MockCtrl)
+	suite.transactionRunner = mocks.NewMockTransactionRunner(suite.MockCtrl)
+	suite.embeddedTransactionRunner = mocks.NewMockTransactionRunner(suite.MockCtrl)
+	suite.repository = mocks.NewMockProductionPlanRepository(suite.MockCtrl)
+	suite.embeddedRepository = mocks.NewMockProductionPlanRepository(suite.MockCtrl)
+	suite.service = mocks.NewMockProductionPlanService(suite.MockCtrl)
+	suite.handler = mocks.NewMockProductionPlanHandler(suite.MockCtrl)
+
+	suite.target = newProductionPlanDependencyService(
+		func(_ domain.MongoDBService) (domain.TransactionRunner, error) {
+			return suite.transactionRunner, nil
+		},
+		func(_ domain.EmbeddedDatabase) (domain.TransactionRunner, error) {
+			return suite.embeddedTransactionRunner, nil
+		},
+		func(_ domain.MongoDBService) (domain.ProductionPlanRepository, error) {
+			return suite.repository, nil
+		},
+		func(_ domain.EmbeddedDatabase) (domain.ProductionPlanRepository, error) {
+			return suite.embeddedRepository, nil
+		},
+		func(
+			_ domain.TransactionRunner,
+			_ domain.ProductionPlanRepository,
+			_ domain.SourdoughRecipeService,
+			_ domain.SourdoughRecipeScaleService,
+			_ domain.BakeLogService,
+			_ domain.InventoryService,
+		) (domain.ProductionPlanService, error) {
+			return suite.service, nil
+		},
+		func(_ domain.ProductionPlanService) (domain.ProductionPlanHandler, error) {
+			return suite.handler, nil
+		},
+	)
+}
+
+// context holds every dependency of the production plan dependency service
+// except the ones listed in without.
+func (suite *ProductionPlanDependencyServiceTestSuite) context(without ...string) context.Context {
+	values := map[string]any{
+		"mongoDBService":              suite.mongoDBService,
+		"sourdoughRecipeService":      suite.sourdoughRecipeService,
+		"sourdoughRecipeScaleService": suite.sourdoughRecipeScaleService,
+		"bakeLogService":              suite.bakeLogService,
+		"inventoryService":            suite.inventoryService,
+	}
+	for _, key := range without {
+		delete(values, key)
+	}
+
+	ctx := context.Background()
+	for key, value := range values {
+		ctx = context.WithValue(ctx, key, value)
+	}
+	return ctx
+}
+
+func (suite *ProductionPlanDependencyServiceTestSuite) TestInitialize() {
+	err := suite.target.Initialize(suite.context())
+
+	suite.NoError(err)
+	suite.Equal(suite.repository, suite.target.Repository())
+	suite.Equal(suite.service, suite.target.Service())
+	suite.Equal(suite.handler, suite.target.Router())
+}
+
+func (suite *ProductionPlanDependencyServiceTestSuite) TestInitialize_WithEmbeddedDatabase() {
+	ctx := context.WithValue(suite.context("mongoDBService"), "embeddedDatabase", suite.embeddedDatabase)
+
+	err := suite.target.Initialize(ctx)
+
+	suite.NoError(err)
+	suite.Equal(suite.embeddedRepository, suite.target.Repository())
+}
+
+func (suite *ProductionPlanDependencyServiceTestSuite) TestInitialize_WithMissingContextValues() {
+	tests := []struct {
+		name             string
+		ctx              context.Context
+		expectedErrorMsg string
+	}{
+		{
+			name:             "mongoDBService is nil",
+			ctx:              suite.context("mongoDBService"),
+			expectedErrorMsg: "failed to get mongoDBService from context",
+		},
+		{
+			name:             "sourdoughRecipeService is nil",
+			ctx:              suite.context("sourdoughRecipeService"),
+			expectedErrorMsg: "failed to get sourdoughRecipeService from context",
+		},
+		{
+			name:             "sourdoughRecipeScaleService is nil",
+			ctx:              suite.context("sourdoughRecipeScaleService"),
+			expectedErrorMsg: "failed to get sourdoughRecipeScaleService from context",
+		},
+		{
+			name:             "bakeLogService is nil",
+			ctx:              suite.context("bakeLogService"),
+			expectedErrorMsg: "failed to get bakeLogService from context",
+		},
+		{
+			name:             "inventoryService is nil",
+			ctx:              suite.context("inventoryService"),
+			expectedErrorMsg: "failed to get inventoryService from context",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			err := suite.target.Initialize(tt.ctx)
+
+			suite.ErrorContains(err, tt.expectedErrorMsg)
+			suite.Nil(suite.target.Repository())
+			suite.Nil(suite.target.Service())
+			suite.Nil(suite.target.Router())
+		})
+	}
+}
+
+func (suite *ProductionPlanDependencyServiceTestSuite) TestInitialize_WithError() {
+	baseService := productionPlanDependencyService{
+		transactionRunnerCreator: func(_ domain.MongoDBService) (domain.TransactionRunner, error) {
+			return suite.transactionRunner, nil
+		},
+		repositoryCreator: func(_ domain.MongoDBService) (domain.ProductionPlanRepository, error) {
+			return suite.repository, nil
+		},
+		serviceCreator: func(
+			_ domain.TransactionRunner,
+			_ domain.ProductionPlanRepository,
+			_ domain.SourdoughRecipeService,
+			_ domain.SourdoughRecipeScaleService,
+			_ domain.BakeLogService,
+			_ domain.InventoryService,
+		) (domain.ProductionPlanService, error) {
+			return suite.service, nil
+		},
+		handlerCreator: func(_ domain.ProductionPlanService) (domain.ProductionPlanHandler, error) {
+			return suite.handler, nil
+		},
+	}
+
+	tests := []struct {
+		name             string
+		serviceCreator   func(service productionPlanDependencyService) domain.ProductionPlanDependencyService
+		expectedErrorMsg string
+	}{
+		{
+			name: "repositoryCreator",
+			serviceCreator: func(service productionPlanDependencyService) domain.ProductionPlanDependencyService {
+				service.repositoryCreator = func(_ domain.MongoDBService) (domain.ProductionPlanRepository, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create repository",
+		},
+		{
+			name: "transactionRunnerCreator",
+			serviceCreator: func(service productionPlanDependencyService) domain.ProductionPlanDependencyService {
+				service.transactionRunnerCreator = func(_ domain.MongoDBService) (domain.TransactionRunner, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create transaction runner",
+		},
+		{
+			name: "serviceCreator",
+			serviceCreator: func(service productionPlanDependencyService) domain.ProductionPlanDependencyService {
+				service.serviceCreator = func(
+					_ domain.TransactionRunner,
+					_ domain.ProductionPlanRepository,
+					_ domain.SourdoughRecipeService,
+					_ domain.SourdoughRecipeScaleService,
+					_ domain.BakeLogService,
+					_ domain.InventoryService,
+				) (domain.ProductionPlanService, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create service",
+		},
+		{
+			name: "handlerCreator",
+			serviceCreator: func(service productionPlanDependencyService) domain.ProductionPlanDependencyService {
+				service.handlerCreator = func(_ domain.ProductionPlanService) (domain.ProductionPlanHandler, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create handler",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			service := tt.serviceCreator(baseService)
+
+			err := service.Initialize(suite.context())
+
+			suite.ErrorContains(err, tt.expectedErrorMsg)
+			suite.Nil(service.Repository())
+			suite.Nil(service.Service())
+			suite.Nil(service.Router())
+		})
+	}
+}
+
+func (suite *ProductionPlanDependencyServiceTestSuite) TestNewProductionPlanDependencyService() {
+	target := NewProductionPlanDependencyService().(*productionPlanDependencyService)
+
+	suite.NotNil(target)
+	suite.NotNil(target.transactionRunnerCreator)
+	suite.NotNil(target.embeddedTransactionRunnerCreator)
+	suite.NotNil(target.repositoryCreator)
+	suite.NotNil(target.embeddedRepositoryCreator)
+	suite.NotNil(target.serviceCreator)
+	suite.NotNil(target.handlerCreator)
+	suite.Nil(target.repository)
+	suite.Nil(target.service)
+	suite.Nil(target.handler)
+}
+
+func TestProductionPlanDependencyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ProductionPlanDependencyServiceTestSuite))
+}
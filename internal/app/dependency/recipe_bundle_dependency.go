@@ -0,0 +1,109 @@
+package dependency
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/controller/rest"
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/repository"
+	"dough-calculator/internal/service"
+)
+
+type recipeBundleDependencyService struct {
+	transactionRunnerCreator         func(mongoDBService domain.MongoDBService) (domain.TransactionRunner, error)
+	embeddedTransactionRunnerCreator func(database domain.EmbeddedDatabase) (domain.TransactionRunner, error)
+
+	serviceCreator func(
+		transactionRunner domain.TransactionRunner,
+		recipeRepository domain.SourdoughRecipeRepository,
+		recipeService domain.SourdoughRecipeService,
+		flourRepository domain.FlourRepository,
+		flourService domain.FlourService,
+	) (domain.RecipeBundleService, error)
+	service domain.RecipeBundleService
+
+	handlerCreator func(service domain.RecipeBundleService) (domain.RecipeBundleHandler, error)
+	handler        domain.RecipeBundleHandler
+}
+
+func (dependencyService *recipeBundleDependencyService) Initialize(ctx context.Context) error {
+	recipeRepository, err := getFromContext[domain.SourdoughRecipeRepository](ctx, "sourdoughRecipeRepository")
+	if err != nil {
+		return errors.Wrap(err, "failed to get sourdoughRecipeRepository from context")
+	}
+
+	recipeService, err := getFromContext[domain.SourdoughRecipeService](ctx, "sourdoughRecipeService")
+	if err != nil {
+		return errors.Wrap(err, "failed to get sourdoughRecipeService from context")
+	}
+
+	flourRepository, err := getFromContext[domain.FlourRepository](ctx, "flourRepository")
+	if err != nil {
+		return errors.Wrap(err, "failed to get flourRepository from context")
+	}
+
+	flourService, err := getFromContext[domain.FlourService](ctx, "flourService")
+	if err != nil {
+		return errors.Wrap(err, "failed to get flourService from context")
+	}
+
+	transactionRunner, err := createOnBackend(ctx,
+		dependencyService.transactionRunnerCreator, dependencyService.embeddedTransactionRunnerCreator)
+	if err != nil {
+		return errors.Wrap(err, "failed to create transaction runner")
+	}
+
+	bundleService, err := dependencyService.serviceCreator(transactionRunner, recipeRepository, recipeService, flourRepository, flourService)
+	if err != nil {
+		return errors.Wrap(err, "failed to create service")
+	}
+
+	bundleHandler, err := dependencyService.handlerCreator(bundleService)
+	if err != nil {
+		return errors.Wrap(err, "failed to create handler")
+	}
+
+	dependencyService.service = bundleService
+	dependencyService.handler = bundleHandler
+
+	return nil
+}
+
+func (dependencyService *recipeBundleDependencyService) Service() domain.RecipeBundleService {
+	return dependencyService.service
+}
+
+func (dependencyService *recipeBundleDependencyService) Router() domain.RecipeBundleHandler {
+	return dependencyService.handler
+}
+
+func NewRecipeBundleDependencyService() domain.RecipeBundleDependencyService {
+	return newRecipeBundleDependencyService(
+		repository.NewTransactionRunner,
+		repository.NewEmbeddedTransactionRunner,
+		service.NewRecipeBundleService,
+		rest.NewRecipeBundleHandler,
+	)
+}
+
+func newRecipeBundleDependencyService(
+	transactionRunnerCreator func(mongoDBService domain.MongoDBService) (domain.TransactionRunner, error),
+	embeddedTransactionRunnerCreator func(database domain.EmbeddedDatabase) (domain.TransactionRunner, error),
+	serviceCreator func(
+		transactionRunner domain.TransactionRunner,
+		recipeRepository domain.SourdoughRecipeRepository,
+		recipeService domain.SourdoughRecipeService,
+		flourRepository domain.FlourRepository,
+		flourService domain.FlourService,
+	) (domain.RecipeBundleService, error),
+	handlerCreator func(service domain.RecipeBundleService) (domain.RecipeBundleHandler, error),
+) domain.RecipeBundleDependencyService {
+	return &recipeBundleDependencyService{
+		transactionRunnerCreator:         transactionRunnerCreator,
+		embeddedTransactionRunnerCreator: embeddedTransactionRunnerCreator,
+		serviceCreator:                   serviceCreator,
+		handlerCreator:                   handlerCreator,
+	}
+}
@@ -0,0 +1,198 @@
+package dependency
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	"dough-calculator/internal/test"
+)
+
+type RecipeBundleDependencyServiceTestSuite struct {
+	test.GoMockTestSuite
+
+	mongoDBService            *mocks.MockMongoDBService
+	embeddedDatabase          *mocks.MockEmbeddedDatabase
+	recipeRepository          *mocks.MockSourdoughRecipeRepository
+	recipeService             *mocks.MockSourdoughRecipeService
+	flourRepository           *mocks.MockFlourRepository
+	flourService              *mocks.MockFlourService
+	transactionRunner         *mocks.MockTransactionRunner
+	embeddedTransactionRunner *mocks.MockTransactionRunner
+	service                   *mocks.MockRecipeBundleService
+	handler                   *mocks.MockRecipeBundleHandler
+
+	target domain.RecipeBundleDependencyService
+}
+
+func (suite *RecipeBundleDependencyServiceTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.mongoDBService = mocks.NewMockMongoDBService(suite.MockCtrl)
+	suite.embeddedDatabase = mocks.NewMockEmbeddedDatabase(suite.MockCtrl)
+	suite.recipeRepository = mocks.NewMockSourdoughRecipeRepository(suite.MockCtrl)
+	suite.recipeService = mocks.NewMockSourdoughRecipeService(suite.MockCtrl)
+	suite.flourRepository = mocks.NewMockFlourRepository(suite.MockCtrl)
+	suite.flourService = mocks.NewMockFlourService(suite.MockCtrl)
+	suite.transactionRunner = mocks.NewMockTransactionRunner(suite.MockCtrl)
+	suite.embeddedTransactionRunner = mocks.NewMockTransactionRunner(suite.MockCtrl)
+	suite.service = mocks.NewMockRecipeBundleService(suite.MockCtrl)
+	suite.handler = mocks.NewMockRecipeBundleHandler(suite.MockCtrl)
+
+	suite.target = newRecipeBundleDependencyService(
+		func(_ domain.MongoDBService) (domain.TransactionRunner, error) {
+			return suite.transactionRunner, nil
+		},
+		func(_ domain.EmbeddedDatabase) (domain.TransactionRunner, error) {
+			return suite.embeddedTransactionRunner, nil
+		},
+		func(_ domain.TransactionRunner, _ domain.SourdoughRecipeRepository, _ domain.SourdoughRecipeService, _ domain.FlourRepository, _ domain.FlourService) (domain.RecipeBundleService, error) {
+			return suite.service, nil
+		},
+		func(_ domain.RecipeBundleService) (domain.RecipeBundleHandler, error) {
+			return suite.handler, nil
+		},
+	)
+}
+
+// context holds every dependency of the recipe bundle dependency service
+// except the ones listed in without.
+func (suite *RecipeBundleDependencyServiceTestSuite) context(without ...string) context.Context {
+	values := map[string]any{
+		"mongoDBService":            suite.mongoDBService,
+		"sourdoughRecipeRepository": suite.recipeRepository,
+		"sourdoughRecipeService":    suite.recipeService,
+		"flourRepository":           suite.flourRepository,
+		"flourService":              suite.flourService,
+	}
+	for _, key := range without {
+		delete(values, key)
+	}
+
+	ctx := context.Background()
+	for key, value := range values {
+		ctx = context.WithValue(ctx, key, value)
+	}
+	return ctx
+}
+
+func (suite *RecipeBundleDependencyServiceTestSuite) TestInitialize() {
+	err := suite.target.Initialize(suite.context())
+
+	suite.NoError(err)
+	suite.Equal(suite.service, suite.target.Service())
+	suite.Equal(suite.handler, suite.target.Router())
+}
+
+func (suite *RecipeBundleDependencyServiceTestSuite) TestInitialize_WithEmbeddedDatabase() {
+	target := *suite.target.(*recipeBundleDependencyService)
+	target.serviceCreator = func(transactionRunner domain.TransactionRunner, _ domain.SourdoughRecipeRepository, _ domain.SourdoughRecipeService, _ domain.FlourRepository, _ domain.FlourService) (domain.RecipeBundleService, error) {
+		suite.Equal(suite.embeddedTransactionRunner, transactionRunner)
+		return suite.service, nil
+	}
+
+	ctx := context.WithValue(suite.context("mongoDBService"), "embeddedDatabase", suite.embeddedDatabase)
+
+	err := target.Initialize(ctx)
+
+	suite.NoError(err)
+	suite.Equal(suite.service, target.Service())
+}
+
+func (suite *RecipeBundleDependencyServiceTestSuite) TestInitialize_WithMissingContextValues() {
+	for _, key := range []string{"mongoDBService", "sourdoughRecipeRepository", "sourdoughRecipeService", "flourRepository", "flourService"} {
+		suite.Run(key+" is nil", func() {
+			err := suite.target.Initialize(suite.context(key))
+
+			suite.ErrorContains(err, "failed to get "+key+" from context")
+			suite.Nil(suite.target.Service())
+			suite.Nil(suite.target.Router())
+		})
+	}
+}
+
+func (suite *RecipeBundleDependencyServiceTestSuite) TestInitialize_WithError() {
+	baseService := recipeBundleDependencyService{
+		transactionRunnerCreator: func(_ domain.MongoDBService) (domain.TransactionRunner, error) {
+			return suite.transactionRunner, nil
+		},
+		serviceCreator: func(_ domain.TransactionRunner, _ domain.SourdoughRecipeRepository, _ domain.SourdoughRecipeService, _ domain.FlourRepository, _ domain.FlourService) (domain.RecipeBundleService, error) {
+			return suite.service, nil
+		},
+		handlerCreator: func(_ domain.RecipeBundleService) (domain.RecipeBundleHandler, error) {
+			return suite.handler, nil
+		},
+	}
+
+	tests := []struct {
+		name             string
+		serviceCreator   func(service recipeBundleDependencyService) domain.RecipeBundleDependencyService
+		expectedErrorMsg string
+	}{
+		{
+			name: "transactionRunnerCreator",
+			serviceCreator: func(service recipeBundleDependencyService) domain.RecipeBundleDependencyService {
+				service.transactionRunnerCreator = func(_ domain.MongoDBService) (domain.TransactionRunner, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create transaction runner",
+		},
+		{
+			name: "serviceCreator",
+			serviceCreator: func(service recipeBundleDependencyService) domain.RecipeBundleDependencyService {
+				service.serviceCreator = func(_ domain.TransactionRunner, _ domain.SourdoughRecipeRepository, _ domain.SourdoughRecipeService, _ domain.FlourRepository, _ domain.FlourService) (domain.RecipeBundleService, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create service",
+		},
+		{
+			name: "handlerCreator",
+			serviceCreator: func(service recipeBundleDependencyService) domain.RecipeBundleDependencyService {
+				service.handlerCreator = func(_ domain.RecipeBundleService) (domain.RecipeBundleHandler, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create handler",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			service := tt.serviceCreator(baseService)
+
+			err := service.Initialize(suite.context())
+
+			suite.ErrorContains(err, tt.expectedErrorMsg)
+			suite.Nil(service.Service())
+			suite.Nil(service.Router())
+		})
+	}
+}
+
+func (suite *RecipeBundleDependencyServiceTestSuite) TestNewRecipeBundleDependencyService() {
+	target := NewRecipeBundleDependencyService().(*recipeBundleDependencyService)
+
+	suite.NotNil(target)
+	suite.NotNil(target.transactionRunnerCreator)
+	suite.NotNil(target.embeddedTransactionRunnerCreator)
+	suite.NotNil(target.serviceCreator)
+	suite.NotNil(target.handlerCreator)
+	suite.Nil(target.service)
+	suite.Nil(target.handler)
+}
+
+func TestRecipeBundleDependencyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(RecipeBundleDependencyServiceTestSuite))
+}
@@ -0,0 +1,122 @@
+package dependency
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/config"
+	"dough-calculator/internal/controller/rest"
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/service"
+)
+
+type recipeJsonLdDependencyService struct {
+	serviceCreator func(
+		sourdoughRecipeService domain.SourdoughRecipeService,
+		parseService domain.SourdoughRecipeParseService,
+		bakeLogService domain.BakeLogService,
+		imageService domain.ImageService,
+		rest config.Rest,
+	) (domain.RecipeJsonLdService, error)
+	service domain.RecipeJsonLdService
+
+	handlerCreator func(service domain.RecipeJsonLdService) (domain.RecipeJsonLdHandler, error)
+	handler        domain.RecipeJsonLdHandler
+}
+
+func (dependencyService *recipeJsonLdDependencyService) Initialize(ctx context.Context) error {
+	configManager, err := getFromContext[domain.ConfigManager](ctx, "configManager")
+	if err != nil {
+		return errors.Wrap(err, "failed to get configManager from context")
+	}
+
+	sourdoughRecipeService, err := getFromContext[domain.SourdoughRecipeService](ctx, "sourdoughRecipeService")
+	if err != nil {
+		return errors.Wrap(err, "failed to get sourdoughRecipeService from context")
+	}
+
+	parseService, err := getFromContext[domain.SourdoughRecipeParseService](ctx, "sourdoughRecipeParseService")
+	if err != nil {
+		return errors.Wrap(err, "failed to get sourdoughRecipeParseService from context")
+	}
+
+	bakeLogService, err := getFromContext[domain.BakeLogService](ctx, "bakeLogService")
+	if err != nil {
+		return errors.Wrap(err, "failed to get bakeLogService from context")
+	}
+
+	imageService, err := getFromContext[domain.ImageService](ctx, "imageService")
+	if err != nil {
+		return errors.Wrap(err, "failed to get imageService from context")
+	}
+
+	jsonLdService, err := dependencyService.serviceCreator(
+		sourdoughRecipeService,
+		parseService,
+		bakeLogService,
+		imageService,
+		configManager.GetConfig().Application.Rest,
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create service")
+	}
+
+	jsonLdHandler, err := dependencyService.handlerCreator(jsonLdService)
+	if err != nil {
+		return errors.Wrap(err, "failed to create handler")
+	}
+
+	dependencyService.service = jsonLdService
+	dependencyService.handler = jsonLdHandler
+
+	return nil
+}
+
+func (dependencyService *recipeJsonLdDependencyService) Service() domain.RecipeJsonLdService {
+	return dependencyService.service
+}
+
+func (dependencyService *recipeJsonLdDependencyService) Router() domain.RecipeJsonLdHandler {
+	return dependencyService.handler
+}
+
+// recipeJsonLdServiceProxy hands the recipe JSON-LD service to the recipe
+// handlers, which are created before it since the service is built on the
+// recipe, bake log and image services.
+type recipeJsonLdServiceProxy struct {
+	dependencyService domain.RecipeJsonLdDependencyService
+}
+
+func (proxy recipeJsonLdServiceProxy) Export(ctx context.Context, id uuid.UUID) (domain.SchemaOrgRecipeDto, error) {
+	return proxy.dependencyService.Service().Export(ctx, id)
+}
+
+func (proxy recipeJsonLdServiceProxy) ExportRecipe(ctx context.Context, recipe domain.SourdoughRecipeDto) (domain.SchemaOrgRecipeDto, error) {
+	return proxy.dependencyService.Service().ExportRecipe(ctx, recipe)
+}
+
+func (proxy recipeJsonLdServiceProxy) Import(ctx context.Context, content []byte) (domain.RecipeJsonLdImportDto, error) {
+	return proxy.dependencyService.Service().Import(ctx, content)
+}
+
+func NewRecipeJsonLdDependencyService() domain.RecipeJsonLdDependencyService {
+	return newRecipeJsonLdDependencyService(service.NewRecipeJsonLdService, rest.NewRecipeJsonLdHandler)
+}
+
+func newRecipeJsonLdDependencyService(
+	serviceCreator func(
+		sourdoughRecipeService domain.SourdoughRecipeService,
+		parseService domain.SourdoughRecipeParseService,
+		bakeLogService domain.BakeLogService,
+		imageService domain.ImageService,
+		rest config.Rest,
+	) (domain.RecipeJsonLdService, error),
+	handlerCreator func(service domain.RecipeJsonLdService) (domain.RecipeJsonLdHandler, error),
+) domain.RecipeJsonLdDependencyService {
+	return &recipeJsonLdDependencyService{
+		serviceCreator: serviceCreator,
+		handlerCreator: handlerCreator,
+	}
+}
@@ -0,0 +1,213 @@
+package dependency
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"dough-calculator/internal/config"
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	"dough-calculator/internal/test"
+)
+
+type RecipeJsonLdDependencyServiceTestSuite struct {
+	test.GoMockTestSuite
+
+	configManager          *mocks.MockConfigManager
+	sourdoughRecipeService *mocks.MockSourdoughRecipeService
+	parseService           *mocks.MockSourdoughRecipeParseService
+	bakeLogService         *mocks.MockBakeLogService
+	imageService           *mocks.MockImageService
+	service                *mocks.MockRecipeJsonLdService
+	handler                *mocks.MockRecipeJsonLdHandler
+
+	rest config.Rest
+
+	target domain.RecipeJsonLdDependencyService
+}
+
+func (suite *RecipeJsonLdDependencyServiceTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.configManager = mocks.NewMockConfigManager(suite.MockCtrl)
+	suite.sourdoughRecipeService = mocks.NewMockSourdoughRecipeService(suite.MockCtrl)
+	suite.parseService = mocks.NewMockSourdoughRecipeParseService(suite.MockCtrl)
+	suite.bakeLogService = mocks.NewMockBakeLogService(suite.MockCtrl)
+	suite.imageService = mocks.NewMockImageService(suite.MockCtrl)
+	suite.service = mocks.NewMockRecipeJsonLdService(suite.MockCtrl)
+	suite.handler = mocks.NewMockRecipeJsonLdHandler(suite.MockCtrl)
+
+	suite.rest = config.Rest{ContextPath: "/v1", PublicUrl: "https://bakery.example"}
+	suite.configManager.EXPECT().GetConfig().
+		Return(config.Config{Application: config.Application{Rest: suite.rest}}).AnyTimes()
+
+	suite.target = &recipeJsonLdDependencyService{
+		serviceCreator: func(
+			_ domain.SourdoughRecipeService,
+			_ domain.SourdoughRecipeParseService,
+			_ domain.BakeLogService,
+			_ domain.ImageService,
+			rest config.Rest,
+		) (domain.RecipeJsonLdService, error) {
+			suite.Equal(suite.rest, rest)
+			return suite.service, nil
+		},
+		handlerCreator: func(_ domain.RecipeJsonLdService) (domain.RecipeJsonLdHandler, error) {
+			return suite.handler, nil
+		},
+	}
+}
+
+func (suite *RecipeJsonLdDependencyServiceTestSuite) context() context.Context {
+	ctx := context.WithValue(context.Background(), "configManager", suite.configManager)
+	ctx = context.WithValue(ctx, "sourdoughRecipeService", suite.sourdoughRecipeService)
+	ctx = context.WithValue(ctx, "sourdoughRecipeParseService", suite.parseService)
+	ctx = context.WithValue(ctx, "bakeLogService", suite.bakeLogService)
+	return context.WithValue(ctx, "imageService", suite.imageService)
+}
+
+func (suite *RecipeJsonLdDependencyServiceTestSuite) TestInitialize() {
+	err := suite.target.Initialize(suite.context())
+
+	suite.NoError(err)
+	suite.Equal(suite.service, suite.target.Service())
+	suite.Equal(suite.handler, suite.target.Router())
+}
+
+func (suite *RecipeJsonLdDependencyServiceTestSuite) TestInitialize_WithMissingContextValues() {
+	tests := []struct {
+		name             string
+		missingKey       string
+		expectedErrorMsg string
+	}{
+		{
+			name:             "configManager is nil",
+			missingKey:       "configManager",
+			expectedErrorMsg: "failed to get configManager from context",
+		},
+		{
+			name:             "sourdoughRecipeService is nil",
+			missingKey:       "sourdoughRecipeService",
+			expectedErrorMsg: "failed to get sourdoughRecipeService from context",
+		},
+		{
+			name:             "sourdoughRecipeParseService is nil",
+			missingKey:       "sourdoughRecipeParseService",
+			expectedErrorMsg: "failed to get sourdoughRecipeParseService from context",
+		},
+		{
+			name:             "bakeLogService is nil",
+			missingKey:       "bakeLogService",
+			expectedErrorMsg: "failed to get bakeLogService from context",
+		},
+		{
+			name:             "imageService is nil",
+			missingKey:       "imageService",
+			expectedErrorMsg: "failed to get imageService from context",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			ctx := context.WithValue(suite.context(), tt.missingKey, nil)
+
+			err := suite.target.Initialize(ctx)
+
+			suite.ErrorContains(err, tt.expectedErrorMsg)
+			suite.Nil(suite.target.Service())
+			suite.Nil(suite.target.Router())
+		})
+	}
+}
+
+func (suite *RecipeJsonLdDependencyServiceTestSuite) TestInitialize_WithError() {
+	baseService := *suite.target.(*recipeJsonLdDependencyService)
+
+	tests := []struct {
+		name             string
+		serviceCreator   func(service recipeJsonLdDependencyService) domain.RecipeJsonLdDependencyService
+		expectedErrorMsg string
+	}{
+		{
+			name: "serviceCreator",
+			serviceCreator: func(service recipeJsonLdDependencyService) domain.RecipeJsonLdDependencyService {
+				service.serviceCreator = func(
+					_ domain.SourdoughRecipeService,
+					_ domain.SourdoughRecipeParseService,
+					_ domain.BakeLogService,
+					_ domain.ImageService,
+					_ config.Rest,
+				) (domain.RecipeJsonLdService, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create service",
+		},
+		{
+			name: "handlerCreator",
+			serviceCreator: func(service recipeJsonLdDependencyService) domain.RecipeJsonLdDependencyService {
+				service.handlerCreator = func(_ domain.RecipeJsonLdService) (domain.RecipeJsonLdHandler, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create handler",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			service := tt.serviceCreator(baseService)
+
+			err := service.Initialize(suite.context())
+
+			suite.ErrorContains(err, tt.expectedErrorMsg)
+			suite.Nil(service.Service())
+			suite.Nil(service.Router())
+		})
+	}
+}
+
+func (suite *RecipeJsonLdDependencyServiceTestSuite) TestRecipeJsonLdServiceProxy() {
+	ctx := context.Background()
+	proxy := recipeJsonLdServiceProxy{suite.target}
+
+	suite.Require().NoError(suite.target.Initialize(suite.context()))
+
+	recipe := domain.SourdoughRecipeDto{RecipeDto: domain.RecipeDto{Id: test.FirstId}}
+	jsonLd := domain.SchemaOrgRecipeDto{Identifier: test.FirstId.String()}
+	suite.service.EXPECT().Export(ctx, test.FirstId).Return(jsonLd, nil)
+	suite.service.EXPECT().ExportRecipe(ctx, recipe).Return(jsonLd, nil)
+	suite.service.EXPECT().Import(ctx, []byte("{}")).Return(domain.RecipeJsonLdImportDto{}, assert.AnError)
+
+	actual, err := proxy.Export(ctx, test.FirstId)
+	suite.NoError(err)
+	suite.Equal(jsonLd, actual)
+
+	actual, err = proxy.ExportRecipe(ctx, recipe)
+	suite.NoError(err)
+	suite.Equal(jsonLd, actual)
+
+	_, err = proxy.Import(ctx, []byte("{}"))
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func (suite *RecipeJsonLdDependencyServiceTestSuite) TestNewRecipeJsonLdDependencyService() {
+	target := NewRecipeJsonLdDependencyService().(*recipeJsonLdDependencyService)
+
+	suite.NotNil(target)
+	suite.NotNil(target.serviceCreator)
+	suite.NotNil(target.handlerCreator)
+	suite.Nil(target.service)
+	suite.Nil(target.handler)
+}
+
+func TestRecipeJsonLdDependencyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(RecipeJsonLdDependencyServiceTestSuite))
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"dough-calculator/internal/config"
 	"dough-calculator/internal/controller/rest"
 	"dough-calculator/internal/domain"
 	"dough-calculator/internal/repository"
@@ -12,38 +13,88 @@ import (
 )
 
 type sourdoughRecipeDependencyService struct {
-	repositoryCreator func(mongoDBService domain.MongoDBService) (domain.SourdoughRecipeRepository, error)
-	repository        domain.SourdoughRecipeRepository
-
-	serviceCreator func(repository domain.SourdoughRecipeRepository) (domain.SourdoughRecipeService, error)
-	service        domain.SourdoughRecipeService
-
-	handlerCreator func(service domain.SourdoughRecipeService) (domain.SourdoughRecipeHandler, error)
-	handler        domain.SourdoughRecipeHandler
+	transactionRunnerCreator         func(mongoDBService domain.MongoDBService) (domain.TransactionRunner, error)
+	embeddedTransactionRunnerCreator func(database domain.EmbeddedDatabase) (domain.TransactionRunner, error)
+
+	repositoryCreator         func(mongoDBService domain.MongoDBService) (domain.SourdoughRecipeRepository, error)
+	embeddedRepositoryCreator func(database domain.EmbeddedDatabase) (domain.SourdoughRecipeRepository, error)
+	repository                domain.SourdoughRecipeRepository
+
+	revisionRepositoryCreator         func(mongoDBService domain.MongoDBService) (domain.SourdoughRecipeRevisionRepository, error)
+	embeddedRevisionRepositoryCreator func(database domain.EmbeddedDatabase) (domain.SourdoughRecipeRevisionRepository, error)
+	revisionRepository                domain.SourdoughRecipeRevisionRepository
+
+	bakeSheetRendererCreator func(templates config.Templates) (domain.BakeSheetRenderer, error)
+	bakeSheetRenderer        domain.BakeSheetRenderer
+
+	serviceCreator func(
+		transactionRunner domain.TransactionRunner,
+		repository domain.SourdoughRecipeRepository,
+		revisionRepository domain.SourdoughRecipeRevisionRepository,
+		flourRepository domain.FlourRepository,
+	) (domain.SourdoughRecipeService, error)
+	service domain.SourdoughRecipeService
+
+	handlerCreator func(
+		service domain.SourdoughRecipeService,
+		renderer domain.BakeSheetRenderer,
+		jsonLdService domain.RecipeJsonLdService,
+	) (domain.SourdoughRecipeHandler, error)
+	handler domain.SourdoughRecipeHandler
 }
 
 func (dependencyService *sourdoughRecipeDependencyService) Initialize(ctx context.Context) error {
-	mongoDBService, err := getFromContext[domain.MongoDBService](ctx, "mongoDBService")
+	configManager, err := getFromContext[domain.ConfigManager](ctx, "configManager")
+	if err != nil {
+		return errors.Wrap(err, "failed to get configManager from context")
+	}
+
+	jsonLdService, err := getFromContext[domain.RecipeJsonLdService](ctx, "recipeJsonLdService")
 	if err != nil {
-		return errors.Wrap(err, "failed to get mongoDBService from context")
+		return errors.Wrap(err, "failed to get recipeJsonLdService from context")
 	}
 
-	sourdoughRecipeRepository, err := dependencyService.repositoryCreator(mongoDBService)
+	flourRepository, err := getFromContext[domain.FlourRepository](ctx, "flourRepository")
+	if err != nil {
+		return errors.Wrap(err, "failed to get flourRepository from context")
+	}
+
+	sourdoughRecipeRepository, err := createOnBackend(ctx, dependencyService.repositoryCreator, dependencyService.embeddedRepositoryCreator)
 	if err != nil {
 		return errors.Wrap(err, "failed to create repository")
 	}
 
-	sourdoughRecipeService, err := dependencyService.serviceCreator(sourdoughRecipeRepository)
+	sourdoughRecipeRevisionRepository, err := createOnBackend(ctx,
+		dependencyService.revisionRepositoryCreator, dependencyService.embeddedRevisionRepositoryCreator)
+	if err != nil {
+		return errors.Wrap(err, "failed to create revision repository")
+	}
+
+	bakeSheetRenderer, err := dependencyService.bakeSheetRendererCreator(configManager.GetConfig().Templates)
+	if err != nil {
+		return errors.Wrap(err, "failed to create bake sheet renderer")
+	}
+
+	transactionRunner, err := createOnBackend(ctx,
+		dependencyService.transactionRunnerCreator, dependencyService.embeddedTransactionRunnerCreator)
+	if err != nil {
+		return errors.Wrap(err, "failed to create transaction runner")
+	}
+
+	sourdoughRecipeService, err := dependencyService.serviceCreator(transactionRunner, sourdoughRecipeRepository,
+		sourdoughRecipeRevisionRepository, flourRepository)
 	if err != nil {
 		return errors.Wrap(err, "failed to create service")
 	}
 
-	sourdoughRecipeHandler, err := dependencyService.handlerCreator(sourdoughRecipeService)
+	sourdoughRecipeHandler, err := dependencyService.handlerCreator(sourdoughRecipeService, bakeSheetRenderer, jsonLdService)
 	if err != nil {
 		return errors.Wrap(err, "failed to create handler")
 	}
 
 	dependencyService.repository = sourdoughRecipeRepository
+	dependencyService.revisionRepository = sourdoughRecipeRevisionRepository
+	dependencyService.bakeSheetRenderer = bakeSheetRenderer
 	dependencyService.service = sourdoughRecipeService
 	dependencyService.handler = sourdoughRecipeHandler
 
@@ -54,6 +105,14 @@ func (dependencyService *sourdoughRecipeDependencyService) Repository() domain.S
 	return dependencyService.repository
 }
 
+func (dependencyService *sourdoughRecipeDependencyService) RevisionRepository() domain.SourdoughRecipeRevisionRepository {
+	return dependencyService.revisionRepository
+}
+
+func (dependencyService *sourdoughRecipeDependencyService) BakeSheetRenderer() domain.BakeSheetRenderer {
+	return dependencyService.bakeSheetRenderer
+}
+
 func (dependencyService *sourdoughRecipeDependencyService) Service() domain.SourdoughRecipeService {
 	return dependencyService.service
 }
@@ -63,17 +122,48 @@ func (dependencyService *sourdoughRecipeDependencyService) Router() domain.Sourd
 }
 
 func NewSourdoughRecipeDependencyService() domain.SourdoughRecipeDependencyService {
-	return newSourdoughRecipeDependencyService(repository.NewSourdoughRecipeRepository, service.NewSourdoughRecipeService, rest.NewSourdoughRecipeHandler)
+	return newSourdoughRecipeDependencyService(
+		repository.NewTransactionRunner,
+		repository.NewEmbeddedTransactionRunner,
+		repository.NewSourdoughRecipeRepository,
+		repository.NewEmbeddedSourdoughRecipeRepository,
+		repository.NewSourdoughRecipeRevisionRepository,
+		repository.NewEmbeddedSourdoughRecipeRevisionRepository,
+		service.NewBakeSheetRenderer,
+		service.NewSourdoughRecipeService,
+		rest.NewSourdoughRecipeHandler,
+	)
 }
 
 func newSourdoughRecipeDependencyService(
+	transactionRunnerCreator func(mongoDBService domain.MongoDBService) (domain.TransactionRunner, error),
+	embeddedTransactionRunnerCreator func(database domain.EmbeddedDatabase) (domain.TransactionRunner, error),
 	repositoryCreator func(mongoDBService domain.MongoDBService) (domain.SourdoughRecipeRepository, error),
-	serviceCreator func(repository domain.SourdoughRecipeRepository) (domain.SourdoughRecipeService, error),
-	handlerCreator func(service domain.SourdoughRecipeService) (domain.SourdoughRecipeHandler, error),
+	embeddedRepositoryCreator func(database domain.EmbeddedDatabase) (domain.SourdoughRecipeRepository, error),
+	revisionRepositoryCreator func(mongoDBService domain.MongoDBService) (domain.SourdoughRecipeRevisionRepository, error),
+	embeddedRevisionRepositoryCreator func(database domain.EmbeddedDatabase) (domain.SourdoughRecipeRevisionRepository, error),
+	bakeSheetRendererCreator func(templates config.Templates) (domain.BakeSheetRenderer, error),
+	serviceCreator func(
+		transactionRunner domain.TransactionRunner,
+		repository domain.SourdoughRecipeRepository,
+		revisionRepository domain.SourdoughRecipeRevisionRepository,
+		flourRepository domain.FlourRepository,
+	) (domain.SourdoughRecipeService, error),
+	handlerCreator func(
+		service domain.SourdoughRecipeService,
+		renderer domain.BakeSheetRenderer,
+		jsonLdService domain.RecipeJsonLdService,
+	) (domain.SourdoughRecipeHandler, error),
 ) domain.SourdoughRecipeDependencyService {
 	return &sourdoughRecipeDependencyService{
-		repositoryCreator: repositoryCreator,
-		serviceCreator:    serviceCreator,
-		handlerCreator:    handlerCreator,
+		transactionRunnerCreator:          transactionRunnerCreator,
+		embeddedTransactionRunnerCreator:  embeddedTransactionRunnerCreator,
+		repositoryCreator:                 repositoryCreator,
+		embeddedRepositoryCreator:         embeddedRepositoryCreator,
+		revisionRepositoryCreator:         revisionRepositoryCreator,
+		embeddedRevisionRepositoryCreator: embeddedRevisionRepositoryCreator,
+		bakeSheetRendererCreator:          bakeSheetRendererCreator,
+		serviceCreator:                    serviceCreator,
+		handlerCreator:                    handlerCreator,
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
+	"dough-calculator/internal/config"
 	"dough-calculator/internal/domain"
 	"dough-calculator/internal/domain/mocks"
 	"dough-calculator/internal/test"
@@ -15,11 +16,20 @@ import (
 type SourdoughRecipeDependencyServiceTestSuite struct {
 	test.GoMockTestSuite
 
-	configManager  *mocks.MockConfigManager
-	mongoDBService *mocks.MockMongoDBService
-	repository     *mocks.MockSourdoughRecipeRepository
-	service        *mocks.MockSourdoughRecipeService
-	handler        *mocks.MockSourdoughRecipeHandler
+	configManager              *mocks.MockConfigManager
+	mongoDBService             *mocks.MockMongoDBService
+	embeddedDatabase           *mocks.MockEmbeddedDatabase
+	transactionRunner          *mocks.MockTransactionRunner
+	embeddedTransactionRunner  *mocks.MockTransactionRunner
+	repository                 *mocks.MockSourdoughRecipeRepository
+	embeddedRepository         *mocks.MockSourdoughRecipeRepository
+	revisionRepository         *mocks.MockSourdoughRecipeRevisionRepository
+	embeddedRevisionRepository *mocks.MockSourdoughRecipeRevisionRepository
+	flourRepository            *mocks.MockFlourRepository
+	bakeSheetRenderer          *mocks.MockBakeSheetRenderer
+	jsonLdService              *mocks.MockRecipeJsonLdService
+	service                    *mocks.MockSourdoughRecipeService
+	handler                    *mocks.MockSourdoughRecipeHandler
 
 	target domain.SourdoughRecipeDependencyService
 }
@@ -28,55 +38,160 @@ func (suite *SourdoughRecipeDependencyServiceTestSuite) SetupTest() {
 	suite.GoMockTestSuite.SetupTest()
 
 	suite.mongoDBService = mocks.NewMockMongoDBService(suite.MockCtrl)
+	suite.embeddedDatabase = mocks.NewMockEmbeddedDatabase(suite.MockCtrl)
 	suite.configManager = mocks.NewMockConfigManager(suite.MockCtrl)
+	suite.transactionRunner = mocks.NewMockTransactionRunner(suite.MockCtrl)
+	suite.embeddedTransactionRunner = mocks.NewMockTransactionRunner(suite.MockCtrl)
 	suite.repository = mocks.NewMockSourdoughRecipeRepository(suite.MockCtrl)
+	suite.embeddedRepository = mocks.NewMockSourdoughRecipeRepository(suite.MockCtrl)
+	suite.revisionRepository = mocks.NewMockSourdoughRecipeRevisionRepository(suite.MockCtrl)
+	suite.embeddedRevisionRepository = mocks.NewMockSourdoughRecipeRevisionRepository(suite.MockCtrl)
+	suite.flourRepository = mocks.NewMockFlourRepository(suite.MockCtrl)
+	suite.bakeSheetRenderer = mocks.NewMockBakeSheetRenderer(suite.MockCtrl)
+	suite.jsonLdService = mocks.NewMockRecipeJsonLdService(suite.MockCtrl)
 	suite.service = mocks.NewMockSourdoughRecipeService(suite.MockCtrl)
 	suite.handler = mocks.NewMockSourdoughRecipeHandler(suite.MockCtrl)
 
+	suite.configManager.EXPECT().GetConfig().Return(config.Config{Templates: config.Templates{Path: "templates"}}).AnyTimes()
+
 	suite.target = newSourdoughRecipeDependencyService(
+		func(_ domain.MongoDBService) (domain.TransactionRunner, error) {
+			return suite.transactionRunner, nil
+		},
+		func(_ domain.EmbeddedDatabase) (domain.TransactionRunner, error) {
+			return suite.embeddedTransactionRunner, nil
+		},
 		func(_ domain.MongoDBService) (domain.SourdoughRecipeRepository, error) {
 			return suite.repository, nil
 		},
-		func(_ domain.SourdoughRecipeRepository) (domain.SourdoughRecipeService, error) {
+		func(_ domain.EmbeddedDatabase) (domain.SourdoughRecipeRepository, error) {
+			return suite.embeddedRepository, nil
+		},
+		func(_ domain.MongoDBService) (domain.SourdoughRecipeRevisionRepository, error) {
+			return suite.revisionRepository, nil
+		},
+		func(_ domain.EmbeddedDatabase) (domain.SourdoughRecipeRevisionRepository, error) {
+			return suite.embeddedRevisionRepository, nil
+		},
+		func(templates config.Templates) (domain.BakeSheetRenderer, error) {
+			suite.Equal(config.Templates{Path: "templates"}, templates)
+			return suite.bakeSheetRenderer, nil
+		},
+		func(_ domain.TransactionRunner, _ domain.SourdoughRecipeRepository, _ domain.SourdoughRecipeRevisionRepository, _ domain.FlourRepository) (domain.SourdoughRecipeService, error) {
 			return suite.service, nil
 		},
-		func(_ domain.SourdoughRecipeService) (domain.SourdoughRecipeHandler, error) {
+		func(_ domain.SourdoughRecipeService, _ domain.BakeSheetRenderer, jsonLdService domain.RecipeJsonLdService) (domain.SourdoughRecipeHandler, error) {
+			suite.Equal(suite.jsonLdService, jsonLdService)
 			return suite.handler, nil
 		},
 	)
 }
 
-func (suite *SourdoughRecipeDependencyServiceTestSuite) TestInitialize() {
-	ctx := context.WithValue(context.Background(), "mongoDBService", suite.mongoDBService)
+func (suite *SourdoughRecipeDependencyServiceTestSuite) context() context.Context {
+	ctx := context.WithValue(context.Background(), "configManager", suite.configManager)
+	ctx = context.WithValue(ctx, "recipeJsonLdService", suite.jsonLdService)
+	ctx = context.WithValue(ctx, "flourRepository", suite.flourRepository)
+	return context.WithValue(ctx, "mongoDBService", suite.mongoDBService)
+}
 
-	err := suite.target.Initialize(ctx)
+func (suite *SourdoughRecipeDependencyServiceTestSuite) TestInitialize() {
+	err := suite.target.Initialize(suite.context())
 
 	suite.NoError(err)
 	suite.Equal(suite.repository, suite.target.Repository())
+	suite.Equal(suite.revisionRepository, suite.target.RevisionRepository())
+	suite.Equal(suite.bakeSheetRenderer, suite.target.BakeSheetRenderer())
 	suite.Equal(suite.service, suite.target.Service())
 	suite.Equal(suite.handler, suite.target.Router())
 }
 
+func (suite *SourdoughRecipeDependencyServiceTestSuite) TestInitialize_WithEmbeddedDatabase() {
+	ctx := context.WithValue(context.Background(), "configManager", suite.configManager)
+	ctx = context.WithValue(ctx, "recipeJsonLdService", suite.jsonLdService)
+	ctx = context.WithValue(ctx, "flourRepository", suite.flourRepository)
+	ctx = context.WithValue(ctx, "embeddedDatabase", suite.embeddedDatabase)
+	target := suite.target.(*sourdoughRecipeDependencyService)
+	target.serviceCreator = func(transactionRunner domain.TransactionRunner, _ domain.SourdoughRecipeRepository, _ domain.SourdoughRecipeRevisionRepository, _ domain.FlourRepository) (domain.SourdoughRecipeService, error) {
+		suite.Equal(suite.embeddedTransactionRunner, transactionRunner)
+		return suite.service, nil
+	}
+
+	err := suite.target.Initialize(ctx)
+
+	suite.NoError(err)
+	suite.Equal(suite.embeddedRepository, suite.target.Repository())
+	suite.Equal(suite.embeddedRevisionRepository, suite.target.RevisionRepository())
+}
+
+func (suite *SourdoughRecipeDependencyServiceTestSuite) TestInitialize_ConfigManagerNil() {
+	ctx := context.WithValue(context.Background(), "mongoDBService", suite.mongoDBService)
+
+	err := suite.target.Initialize(ctx)
+
+	suite.ErrorContains(err, "failed to get configManager from context")
+	suite.Nil(suite.target.Repository())
+	suite.Nil(suite.target.BakeSheetRenderer())
+	suite.Nil(suite.target.Service())
+	suite.Nil(suite.target.Router())
+}
+
+func (suite *SourdoughRecipeDependencyServiceTestSuite) TestInitialize_RecipeJsonLdServiceNil() {
+	ctx := context.WithValue(context.Background(), "configManager", suite.configManager)
+	ctx = context.WithValue(ctx, "mongoDBService", suite.mongoDBService)
+
+	err := suite.target.Initialize(ctx)
+
+	suite.ErrorContains(err, "failed to get recipeJsonLdService from context")
+	suite.Nil(suite.target.Repository())
+	suite.Nil(suite.target.Service())
+	suite.Nil(suite.target.Router())
+}
+
+func (suite *SourdoughRecipeDependencyServiceTestSuite) TestInitialize_FlourRepositoryNil() {
+	ctx := context.WithValue(context.Background(), "configManager", suite.configManager)
+	ctx = context.WithValue(ctx, "recipeJsonLdService", suite.jsonLdService)
+	ctx = context.WithValue(ctx, "mongoDBService", suite.mongoDBService)
+
+	err := suite.target.Initialize(ctx)
+
+	suite.ErrorContains(err, "failed to get flourRepository from context")
+	suite.Nil(suite.target.Repository())
+	suite.Nil(suite.target.Service())
+	suite.Nil(suite.target.Router())
+}
+
 func (suite *SourdoughRecipeDependencyServiceTestSuite) TestInitialize_MongoDBServiceNil() {
-	ctx := context.Background()
+	ctx := context.WithValue(context.Background(), "configManager", suite.configManager)
+	ctx = context.WithValue(ctx, "recipeJsonLdService", suite.jsonLdService)
+	ctx = context.WithValue(ctx, "flourRepository", suite.flourRepository)
 
 	err := suite.target.Initialize(ctx)
 
 	suite.ErrorContains(err, "failed to get mongoDBService from context")
 	suite.Nil(suite.target.Repository())
+	suite.Nil(suite.target.RevisionRepository())
 	suite.Nil(suite.target.Service())
 	suite.Nil(suite.target.Router())
 }
 
 func (suite *SourdoughRecipeDependencyServiceTestSuite) TestInitialize_WithError() {
 	baseService := sourdoughRecipeDependencyService{
+		transactionRunnerCreator: func(_ domain.MongoDBService) (domain.TransactionRunner, error) {
+			return suite.transactionRunner, nil
+		},
 		repositoryCreator: func(_ domain.MongoDBService) (domain.SourdoughRecipeRepository, error) {
 			return suite.repository, nil
 		},
-		serviceCreator: func(_ domain.SourdoughRecipeRepository) (domain.SourdoughRecipeService, error) {
+		revisionRepositoryCreator: func(_ domain.MongoDBService) (domain.SourdoughRecipeRevisionRepository, error) {
+			return suite.revisionRepository, nil
+		},
+		bakeSheetRendererCreator: func(_ config.Templates) (domain.BakeSheetRenderer, error) {
+			return suite.bakeSheetRenderer, nil
+		},
+		serviceCreator: func(_ domain.TransactionRunner, _ domain.SourdoughRecipeRepository, _ domain.SourdoughRecipeRevisionRepository, _ domain.FlourRepository) (domain.SourdoughRecipeService, error) {
 			return suite.service, nil
 		},
-		handlerCreator: func(_ domain.SourdoughRecipeService) (domain.SourdoughRecipeHandler, error) {
+		handlerCreator: func(_ domain.SourdoughRecipeService, _ domain.BakeSheetRenderer, _ domain.RecipeJsonLdService) (domain.SourdoughRecipeHandler, error) {
 			return suite.handler, nil
 		},
 	}
@@ -97,10 +212,43 @@ func (suite *SourdoughRecipeDependencyServiceTestSuite) TestInitialize_WithError
 			},
 			expectedErrorMsg: "failed to create repository",
 		},
+		{
+			name: "transactionRunnerCreator",
+			serviceCreator: func(service sourdoughRecipeDependencyService) domain.SourdoughRecipeDependencyService {
+				service.transactionRunnerCreator = func(_ domain.MongoDBService) (domain.TransactionRunner, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create transaction runner",
+		},
+		{
+			name: "revisionRepositoryCreator",
+			serviceCreator: func(service sourdoughRecipeDependencyService) domain.SourdoughRecipeDependencyService {
+				service.revisionRepositoryCreator = func(_ domain.MongoDBService) (domain.SourdoughRecipeRevisionRepository, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create revision repository",
+		},
+		{
+			name: "bakeSheetRendererCreator",
+			serviceCreator: func(service sourdoughRecipeDependencyService) domain.SourdoughRecipeDependencyService {
+				service.bakeSheetRendererCreator = func(_ config.Templates) (domain.BakeSheetRenderer, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create bake sheet renderer",
+		},
 		{
 			name: "serviceCreator",
 			serviceCreator: func(service sourdoughRecipeDependencyService) domain.SourdoughRecipeDependencyService {
-				service.serviceCreator = func(_ domain.SourdoughRecipeRepository) (domain.SourdoughRecipeService, error) {
+				service.serviceCreator = func(_ domain.TransactionRunner, _ domain.SourdoughRecipeRepository, _ domain.SourdoughRecipeRevisionRepository, _ domain.FlourRepository) (domain.SourdoughRecipeService, error) {
 					return nil, assert.AnError
 				}
 
@@ -111,7 +259,7 @@ func (suite *SourdoughRecipeDependencyServiceTestSuite) TestInitialize_WithError
 		{
 			name: "handlerCreator",
 			serviceCreator: func(service sourdoughRecipeDependencyService) domain.SourdoughRecipeDependencyService {
-				service.handlerCreator = func(_ domain.SourdoughRecipeService) (domain.SourdoughRecipeHandler, error) {
+				service.handlerCreator = func(_ domain.SourdoughRecipeService, _ domain.BakeSheetRenderer, _ domain.RecipeJsonLdService) (domain.SourdoughRecipeHandler, error) {
 					return nil, assert.AnError
 				}
 
@@ -123,14 +271,14 @@ func (suite *SourdoughRecipeDependencyServiceTestSuite) TestInitialize_WithError
 
 	for _, tt := range tests {
 		suite.Run(tt.name, func() {
-			ctx := context.WithValue(context.Background(), "mongoDBService", suite.mongoDBService)
-
 			service := tt.serviceCreator(baseService)
 
-			err := service.Initialize(ctx)
+			err := service.Initialize(suite.context())
 
 			suite.ErrorContains(err, tt.expectedErrorMsg)
 			suite.Nil(service.Repository())
+			suite.Nil(service.RevisionRepository())
+			suite.Nil(service.BakeSheetRenderer())
 			suite.Nil(service.Service())
 			suite.Nil(service.Router())
 		})
@@ -145,6 +293,22 @@ func (suite *SourdoughRecipeDependencyServiceTestSuite) TestRepository() {
 	suite.Equal(suite.repository, target.Repository())
 }
 
+func (suite *SourdoughRecipeDependencyServiceTestSuite) TestRevisionRepository() {
+	target := &sourdoughRecipeDependencyService{
+		revisionRepository: suite.revisionRepository,
+	}
+
+	suite.Equal(suite.revisionRepository, target.RevisionRepository())
+}
+
+func (suite *SourdoughRecipeDependencyServiceTestSuite) TestBakeSheetRenderer() {
+	target := &sourdoughRecipeDependencyService{
+		bakeSheetRenderer: suite.bakeSheetRenderer,
+	}
+
+	suite.Equal(suite.bakeSheetRenderer, target.BakeSheetRenderer())
+}
+
 func (suite *SourdoughRecipeDependencyServiceTestSuite) TestService() {
 	target := &sourdoughRecipeDependencyService{
 		service: suite.service,
@@ -165,10 +329,18 @@ func (suite *SourdoughRecipeDependencyServiceTestSuite) TestNewSourdoughRecipeDe
 	target := NewSourdoughRecipeDependencyService().(*sourdoughRecipeDependencyService)
 
 	suite.NotNil(target)
+	suite.NotNil(target.transactionRunnerCreator)
+	suite.NotNil(target.embeddedTransactionRunnerCreator)
 	suite.NotNil(target.repositoryCreator)
+	suite.NotNil(target.embeddedRepositoryCreator)
+	suite.NotNil(target.revisionRepositoryCreator)
+	suite.NotNil(target.embeddedRevisionRepositoryCreator)
+	suite.NotNil(target.bakeSheetRendererCreator)
 	suite.NotNil(target.serviceCreator)
 	suite.NotNil(target.handlerCreator)
 	suite.Nil(target.repository)
+	suite.Nil(target.revisionRepository)
+	suite.Nil(target.bakeSheetRenderer)
 	suite.Nil(target.service)
 	suite.Nil(target.handler)
 }
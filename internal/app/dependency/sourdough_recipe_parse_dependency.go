@@ -0,0 +1,63 @@
+package dependency
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/controller/rest"
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/service"
+)
+
+type sourdoughRecipeParseDependencyService struct {
+	serviceCreator func(flourRepository domain.FlourRepository) (domain.SourdoughRecipeParseService, error)
+	service        domain.SourdoughRecipeParseService
+
+	handlerCreator func(service domain.SourdoughRecipeParseService) (domain.SourdoughRecipeParseHandler, error)
+	handler        domain.SourdoughRecipeParseHandler
+}
+
+func (dependencyService *sourdoughRecipeParseDependencyService) Initialize(ctx context.Context) error {
+	flourRepository, err := getFromContext[domain.FlourRepository](ctx, "flourRepository")
+	if err != nil {
+		return errors.Wrap(err, "failed to get flourRepository from context")
+	}
+
+	parseService, err := dependencyService.serviceCreator(flourRepository)
+	if err != nil {
+		return errors.Wrap(err, "failed to create service")
+	}
+
+	parseHandler, err := dependencyService.handlerCreator(parseService)
+	if err != nil {
+		return errors.Wrap(err, "failed to create handler")
+	}
+
+	dependencyService.service = parseService
+	dependencyService.handler = parseHandler
+
+	return nil
+}
+
+func (dependencyService *sourdoughRecipeParseDependencyService) Service() domain.SourdoughRecipeParseService {
+	return dependencyService.service
+}
+
+func (dependencyService *sourdoughRecipeParseDependencyService) Router() domain.SourdoughRecipeParseHandler {
+	return dependencyService.handler
+}
+
+func NewSourdoughRecipeParseDependencyService() domain.SourdoughRecipeParseDependencyService {
+	return newSourdoughRecipeParseDependencyService(service.NewSourdoughRecipeParseService, rest.NewSourdoughRecipeParseHandler)
+}
+
+func newSourdoughRecipeParseDependencyService(
+	serviceCreator func(flourRepository domain.FlourRepository) (domain.SourdoughRecipeParseService, error),
+	handlerCreator func(service domain.SourdoughRecipeParseService) (domain.SourdoughRecipeParseHandler, error),
+) domain.SourdoughRecipeParseDependencyService {
+	return &sourdoughRecipeParseDependencyService{
+		serviceCreator: serviceCreator,
+		handlerCreator: handlerCreator,
+	}
+}
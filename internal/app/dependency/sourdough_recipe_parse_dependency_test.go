@@ -0,0 +1,142 @@
+package dependency
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	"dough-calculator/internal/test"
+)
+
+type SourdoughRecipeParseDependencyServiceTestSuite struct {
+	test.GoMockTestSuite
+
+	flourRepository *mocks.MockFlourRepository
+	service         *mocks.MockSourdoughRecipeParseService
+	handler         *mocks.MockSourdoughRecipeParseHandler
+
+	target domain.SourdoughRecipeParseDependencyService
+}
+
+func (suite *SourdoughRecipeParseDependencyServiceTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.flourRepository = mocks.NewMockFlourRepository(suite.MockCtrl)
+	suite.service = mocks.NewMockSourdoughRecipeParseService(suite.MockCtrl)
+	suite.handler = mocks.NewMockSourdoughRecipeParseHandler(suite.MockCtrl)
+
+	suite.target = newSourdoughRecipeParseDependencyService(
+		func(_ domain.FlourRepository) (domain.SourdoughRecipeParseService, error) {
+			return suite.service, nil
+		},
+		func(_ domain.SourdoughRecipeParseService) (domain.SourdoughRecipeParseHandler, error) {
+			return suite.handler, nil
+		},
+	)
+}
+
+func (suite *SourdoughRecipeParseDependencyServiceTestSuite) context() context.Context {
+	return context.WithValue(context.Background(), "flourRepository", suite.flourRepository)
+}
+
+func (suite *SourdoughRecipeParseDependencyServiceTestSuite) TestInitialize() {
+	err := suite.target.Initialize(suite.context())
+
+	suite.NoError(err)
+	suite.Equal(suite.service, suite.target.Service())
+	suite.Equal(suite.handler, suite.target.Router())
+}
+
+func (suite *SourdoughRecipeParseDependencyServiceTestSuite) TestInitialize_WithMissingDependency() {
+	tests := []struct {
+		name             string
+		ctx              context.Context
+		expectedErrorMsg string
+	}{
+		{
+			name:             "flourRepository",
+			ctx:              context.Background(),
+			expectedErrorMsg: "failed to get flourRepository from context",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			err := suite.target.Initialize(tt.ctx)
+
+			suite.ErrorContains(err, tt.expectedErrorMsg)
+			suite.Nil(suite.target.Service())
+			suite.Nil(suite.target.Router())
+		})
+	}
+}
+
+func (suite *SourdoughRecipeParseDependencyServiceTestSuite) TestInitialize_WithError() {
+	baseService := sourdoughRecipeParseDependencyService{
+		serviceCreator: func(_ domain.FlourRepository) (domain.SourdoughRecipeParseService, error) {
+			return suite.service, nil
+		},
+		handlerCreator: func(_ domain.SourdoughRecipeParseService) (domain.SourdoughRecipeParseHandler, error) {
+			return suite.handler, nil
+		},
+	}
+
+	tests := []struct {
+		name             string
+		serviceCreator   func(service sourdoughRecipeParseDependencyService) domain.SourdoughRecipeParseDependencyService
+		expectedErrorMsg string
+	}{
+		{
+			name: "serviceCreator",
+			serviceCreator: func(service sourdoughRecipeParseDependencyService) domain.SourdoughRecipeParseDependencyService {
+				service.serviceCreator = func(_ domain.FlourRepository) (domain.SourdoughRecipeParseService, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create service",
+		},
+		{
+			name: "handlerCreator",
+			serviceCreator: func(service sourdoughRecipeParseDependencyService) domain.SourdoughRecipeParseDependencyService {
+				service.handlerCreator = func(_ domain.SourdoughRecipeParseService) (domain.SourdoughRecipeParseHandler, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create handler",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			service := tt.serviceCreator(baseService)
+
+			err := service.Initialize(suite.context())
+
+			suite.ErrorContains(err, tt.expectedErrorMsg)
+			suite.Nil(service.Service())
+			suite.Nil(service.Router())
+		})
+	}
+}
+
+func (suite *SourdoughRecipeParseDependencyServiceTestSuite) TestNewSourdoughRecipeParseDependencyService() {
+	target := NewSourdoughRecipeParseDependencyService().(*sourdoughRecipeParseDependencyService)
+
+	suite.NotNil(target)
+	suite.NotNil(target.serviceCreator)
+	suite.NotNil(target.handlerCreator)
+	suite.Nil(target.service)
+	suite.Nil(target.handler)
+}
+
+func TestSourdoughRecipeParseDependencyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SourdoughRecipeParseDependencyServiceTestSuite))
+}
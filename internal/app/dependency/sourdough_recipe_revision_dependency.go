@@ -0,0 +1,68 @@
+package dependency
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/controller/rest"
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/service"
+)
+
+type sourdoughRecipeRevisionDependencyService struct {
+	serviceCreator func(repository domain.SourdoughRecipeRevisionRepository, sourdoughRecipeService domain.SourdoughRecipeService) (domain.SourdoughRecipeRevisionService, error)
+	service        domain.SourdoughRecipeRevisionService
+
+	handlerCreator func(service domain.SourdoughRecipeRevisionService) (domain.SourdoughRecipeRevisionHandler, error)
+	handler        domain.SourdoughRecipeRevisionHandler
+}
+
+func (dependencyService *sourdoughRecipeRevisionDependencyService) Initialize(ctx context.Context) error {
+	revisionRepository, err := getFromContext[domain.SourdoughRecipeRevisionRepository](ctx, "sourdoughRecipeRevisionRepository")
+	if err != nil {
+		return errors.Wrap(err, "failed to get sourdoughRecipeRevisionRepository from context")
+	}
+
+	sourdoughRecipeService, err := getFromContext[domain.SourdoughRecipeService](ctx, "sourdoughRecipeService")
+	if err != nil {
+		return errors.Wrap(err, "failed to get sourdoughRecipeService from context")
+	}
+
+	sourdoughRecipeRevisionService, err := dependencyService.serviceCreator(revisionRepository, sourdoughRecipeService)
+	if err != nil {
+		return errors.Wrap(err, "failed to create service")
+	}
+
+	sourdoughRecipeRevisionHandler, err := dependencyService.handlerCreator(sourdoughRecipeRevisionService)
+	if err != nil {
+		return errors.Wrap(err, "failed to create handler")
+	}
+
+	dependencyService.service = sourdoughRecipeRevisionService
+	dependencyService.handler = sourdoughRecipeRevisionHandler
+
+	return nil
+}
+
+func (dependencyService *sourdoughRecipeRevisionDependencyService) Service() domain.SourdoughRecipeRevisionService {
+	return dependencyService.service
+}
+
+func (dependencyService *sourdoughRecipeRevisionDependencyService) Router() domain.SourdoughRecipeRevisionHandler {
+	return dependencyService.handler
+}
+
+func NewSourdoughRecipeRevisionDependencyService() domain.SourdoughRecipeRevisionDependencyService {
+	return newSourdoughRecipeRevisionDependencyService(service.NewSourdoughRecipeRevisionService, rest.NewSourdoughRecipeRevisionHandler)
+}
+
+func newSourdoughRecipeRevisionDependencyService(
+	serviceCreator func(repository domain.SourdoughRecipeRevisionRepository, sourdoughRecipeService domain.SourdoughRecipeService) (domain.SourdoughRecipeRevisionService, error),
+	handlerCreator func(service domain.SourdoughRecipeRevisionService) (domain.SourdoughRecipeRevisionHandler, error),
+) domain.SourdoughRecipeRevisionDependencyService {
+	return &sourdoughRecipeRevisionDependencyService{
+		serviceCreator: serviceCreator,
+		handlerCreator: handlerCreator,
+	}
+}
@@ -0,0 +1,166 @@
+package dependency
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	"dough-calculator/internal/test"
+)
+
+type SourdoughRecipeRevisionDependencyServiceTestSuite struct {
+	test.GoMockTestSuite
+
+	revisionRepository     *mocks.MockSourdoughRecipeRevisionRepository
+	sourdoughRecipeService *mocks.MockSourdoughRecipeService
+	service                *mocks.MockSourdoughRecipeRevisionService
+	handler                *mocks.MockSourdoughRecipeRevisionHandler
+
+	target domain.SourdoughRecipeRevisionDependencyService
+}
+
+func (suite *SourdoughRecipeRevisionDependencyServiceTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.revisionRepository = mocks.NewMockSourdoughRecipeRevisionRepository(suite.MockCtrl)
+	suite.sourdoughRecipeService = mocks.NewMockSourdoughRecipeService(suite.MockCtrl)
+	suite.service = mocks.NewMockSourdoughRecipeRevisionService(suite.MockCtrl)
+	suite.handler = mocks.NewMockSourdoughRecipeRevisionHandler(suite.MockCtrl)
+
+	suite.target = newSourdoughRecipeRevisionDependencyService(
+		func(_ domain.SourdoughRecipeRevisionRepository, _ domain.SourdoughRecipeService) (domain.SourdoughRecipeRevisionService, error) {
+			return suite.service, nil
+		},
+		func(_ domain.SourdoughRecipeRevisionService) (domain.SourdoughRecipeRevisionHandler, error) {
+			return suite.handler, nil
+		},
+	)
+}
+
+func (suite *SourdoughRecipeRevisionDependencyServiceTestSuite) context() context.Context {
+	ctx := context.WithValue(context.Background(), "sourdoughRecipeRevisionRepository", suite.revisionRepository)
+	return context.WithValue(ctx, "sourdoughRecipeService", suite.sourdoughRecipeService)
+}
+
+func (suite *SourdoughRecipeRevisionDependencyServiceTestSuite) TestInitialize() {
+	err := suite.target.Initialize(suite.context())
+
+	suite.NoError(err)
+	suite.Equal(suite.service, suite.target.Service())
+	suite.Equal(suite.handler, suite.target.Router())
+}
+
+func (suite *SourdoughRecipeRevisionDependencyServiceTestSuite) TestInitialize_WithMissingContextValues() {
+	tests := []struct {
+		name             string
+		ctx              context.Context
+		expectedErrorMsg string
+	}{
+		{
+			name:             "sourdoughRecipeRevisionRepository is nil",
+			ctx:              context.WithValue(context.Background(), "sourdoughRecipeService", suite.sourdoughRecipeService),
+			expectedErrorMsg: "failed to get sourdoughRecipeRevisionRepository from context",
+		},
+		{
+			name:             "sourdoughRecipeService is nil",
+			ctx:              context.WithValue(context.Background(), "sourdoughRecipeRevisionRepository", suite.revisionRepository),
+			expectedErrorMsg: "failed to get sourdoughRecipeService from context",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			err := suite.target.Initialize(tt.ctx)
+
+			suite.ErrorContains(err, tt.expectedErrorMsg)
+			suite.Nil(suite.target.Service())
+			suite.Nil(suite.target.Router())
+		})
+	}
+}
+
+func (suite *SourdoughRecipeRevisionDependencyServiceTestSuite) TestInitialize_WithError() {
+	baseService := sourdoughRecipeRevisionDependencyService{
+		serviceCreator: func(_ domain.SourdoughRecipeRevisionRepository, _ domain.SourdoughRecipeService) (domain.SourdoughRecipeRevisionService, error) {
+			return suite.service, nil
+		},
+		handlerCreator: func(_ domain.SourdoughRecipeRevisionService) (domain.SourdoughRecipeRevisionHandler, error) {
+			return suite.handler, nil
+		},
+	}
+
+	tests := []struct {
+		name             string
+		serviceCreator   func(service sourdoughRecipeRevisionDependencyService) domain.SourdoughRecipeRevisionDependencyService
+		expectedErrorMsg string
+	}{
+		{
+			name: "serviceCreator",
+			serviceCreator: func(service sourdoughRecipeRevisionDependencyService) domain.SourdoughRecipeRevisionDependencyService {
+				service.serviceCreator = func(_ domain.SourdoughRecipeRevisionRepository, _ domain.SourdoughRecipeService) (domain.SourdoughRecipeRevisionService, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create service",
+		},
+		{
+			name: "handlerCreator",
+			serviceCreator: func(service sourdoughRecipeRevisionDependencyService) domain.SourdoughRecipeRevisionDependencyService {
+				service.handlerCreator = func(_ domain.SourdoughRecipeRevisionService) (domain.SourdoughRecipeRevisionHandler, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create handler",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			service := tt.serviceCreator(baseService)
+
+			err := service.Initialize(suite.context())
+
+			suite.ErrorContains(err, tt.expectedErrorMsg)
+			suite.Nil(service.Service())
+			suite.Nil(service.Router())
+		})
+	}
+}
+
+func (suite *SourdoughRecipeRevisionDependencyServiceTestSuite) TestService() {
+	target := &sourdoughRecipeRevisionDependencyService{
+		service: suite.service,
+	}
+
+	suite.Equal(suite.service, target.Service())
+}
+
+func (suite *SourdoughRecipeRevisionDependencyServiceTestSuite) TestRouter() {
+	target := &sourdoughRecipeRevisionDependencyService{
+		handler: suite.handler,
+	}
+
+	suite.Equal(suite.handler, target.Router())
+}
+
+func (suite *SourdoughRecipeRevisionDependencyServiceTestSuite) TestNewSourdoughRecipeRevisionDependencyService() {
+	target := NewSourdoughRecipeRevisionDependencyService().(*sourdoughRecipeRevisionDependencyService)
+
+	suite.NotNil(target)
+	suite.NotNil(target.serviceCreator)
+	suite.NotNil(target.handlerCreator)
+	suite.Nil(target.service)
+	suite.Nil(target.handler)
+}
+
+func TestSourdoughRecipeRevisionDependencyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SourdoughRecipeRevisionDependencyServiceTestSuite))
+}
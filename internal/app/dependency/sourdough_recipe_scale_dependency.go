@@ -14,8 +14,12 @@ type sourdoughRecipeScaleDependencyService struct {
 	serviceCreator func(repository domain.SourdoughRecipeService) (domain.SourdoughRecipeScaleService, error)
 	service        domain.SourdoughRecipeScaleService
 
-	handlerCreator func(service domain.SourdoughRecipeScaleService) (domain.SourdoughRecipeScaleHandler, error)
-	handler        domain.SourdoughRecipeScaleHandler
+	handlerCreator func(
+		service domain.SourdoughRecipeScaleService,
+		renderer domain.BakeSheetRenderer,
+		jsonLdService domain.RecipeJsonLdService,
+	) (domain.SourdoughRecipeScaleHandler, error)
+	handler domain.SourdoughRecipeScaleHandler
 }
 
 func (dependencyService *sourdoughRecipeScaleDependencyService) Initialize(ctx context.Context) error {
@@ -24,12 +28,22 @@ func (dependencyService *sourdoughRecipeScaleDependencyService) Initialize(ctx c
 		return errors.Wrap(err, "failed to get sourdoughRecipeService from context")
 	}
 
+	bakeSheetRenderer, err := getFromContext[domain.BakeSheetRenderer](ctx, "bakeSheetRenderer")
+	if err != nil {
+		return errors.Wrap(err, "failed to get bakeSheetRenderer from context")
+	}
+
+	jsonLdService, err := getFromContext[domain.RecipeJsonLdService](ctx, "recipeJsonLdService")
+	if err != nil {
+		return errors.Wrap(err, "failed to get recipeJsonLdService from context")
+	}
+
 	sourdoughRecipeScaleService, err := dependencyService.serviceCreator(sourdoughRecipeService)
 	if err != nil {
 		return errors.Wrap(err, "failed to create service")
 	}
 
-	sourdoughRecipeScaleHandler, err := dependencyService.handlerCreator(sourdoughRecipeScaleService)
+	sourdoughRecipeScaleHandler, err := dependencyService.handlerCreator(sourdoughRecipeScaleService, bakeSheetRenderer, jsonLdService)
 	if err != nil {
 		return errors.Wrap(err, "failed to create handler")
 	}
@@ -54,7 +68,11 @@ func NewSourdoughRecipeScaleDependencyService() domain.SourdoughRecipeScaleDepen
 
 func newSourdoughRecipeScaleDependencyService(
 	serviceCreator func(repository domain.SourdoughRecipeService) (domain.SourdoughRecipeScaleService, error),
-	handlerCreator func(service domain.SourdoughRecipeScaleService) (domain.SourdoughRecipeScaleHandler, error),
+	handlerCreator func(
+		service domain.SourdoughRecipeScaleService,
+		renderer domain.BakeSheetRenderer,
+		jsonLdService domain.RecipeJsonLdService,
+	) (domain.SourdoughRecipeScaleHandler, error),
 ) domain.SourdoughRecipeScaleDependencyService {
 	return &sourdoughRecipeScaleDependencyService{
 		serviceCreator: serviceCreator,
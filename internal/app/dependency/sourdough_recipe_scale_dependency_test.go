@@ -16,6 +16,8 @@ type SourdoughRecipeScaleDependencyServiceTestSuite struct {
 	test.GoMockTestSuite
 
 	sourdoughRecipeService *mocks.MockSourdoughRecipeService
+	bakeSheetRenderer      *mocks.MockBakeSheetRenderer
+	jsonLdService          *mocks.MockRecipeJsonLdService
 	service                *mocks.MockSourdoughRecipeScaleService
 	handler                *mocks.MockSourdoughRecipeScaleHandler
 
@@ -26,6 +28,8 @@ func (suite *SourdoughRecipeScaleDependencyServiceTestSuite) SetupTest() {
 	suite.GoMockTestSuite.SetupTest()
 
 	suite.sourdoughRecipeService = mocks.NewMockSourdoughRecipeService(suite.MockCtrl)
+	suite.bakeSheetRenderer = mocks.NewMockBakeSheetRenderer(suite.MockCtrl)
+	suite.jsonLdService = mocks.NewMockRecipeJsonLdService(suite.MockCtrl)
 	suite.service = mocks.NewMockSourdoughRecipeScaleService(suite.MockCtrl)
 	suite.handler = mocks.NewMockSourdoughRecipeScaleHandler(suite.MockCtrl)
 
@@ -33,16 +37,21 @@ func (suite *SourdoughRecipeScaleDependencyServiceTestSuite) SetupTest() {
 		func(_ domain.SourdoughRecipeService) (domain.SourdoughRecipeScaleService, error) {
 			return suite.service, nil
 		},
-		func(_ domain.SourdoughRecipeScaleService) (domain.SourdoughRecipeScaleHandler, error) {
+		func(_ domain.SourdoughRecipeScaleService, _ domain.BakeSheetRenderer, jsonLdService domain.RecipeJsonLdService) (domain.SourdoughRecipeScaleHandler, error) {
+			suite.Equal(suite.jsonLdService, jsonLdService)
 			return suite.handler, nil
 		},
 	)
 }
 
-func (suite *SourdoughRecipeScaleDependencyServiceTestSuite) TestInitialize() {
+func (suite *SourdoughRecipeScaleDependencyServiceTestSuite) context() context.Context {
 	ctx := context.WithValue(context.Background(), "sourdoughRecipeService", suite.sourdoughRecipeService)
+	ctx = context.WithValue(ctx, "bakeSheetRenderer", suite.bakeSheetRenderer)
+	return context.WithValue(ctx, "recipeJsonLdService", suite.jsonLdService)
+}
 
-	err := suite.target.Initialize(ctx)
+func (suite *SourdoughRecipeScaleDependencyServiceTestSuite) TestInitialize() {
+	err := suite.target.Initialize(suite.context())
 
 	suite.NoError(err)
 	suite.Equal(suite.service, suite.target.Service())
@@ -59,12 +68,33 @@ func (suite *SourdoughRecipeScaleDependencyServiceTestSuite) TestInitialize_Sour
 	suite.Nil(suite.target.Router())
 }
 
+func (suite *SourdoughRecipeScaleDependencyServiceTestSuite) TestInitialize_BakeSheetRendererNil() {
+	ctx := context.WithValue(context.Background(), "sourdoughRecipeService", suite.sourdoughRecipeService)
+
+	err := suite.target.Initialize(ctx)
+
+	suite.ErrorContains(err, "failed to get bakeSheetRenderer from context")
+	suite.Nil(suite.target.Service())
+	suite.Nil(suite.target.Router())
+}
+
+func (suite *SourdoughRecipeScaleDependencyServiceTestSuite) TestInitialize_RecipeJsonLdServiceNil() {
+	ctx := context.WithValue(context.Background(), "sourdoughRecipeService", suite.sourdoughRecipeService)
+	ctx = context.WithValue(ctx, "bakeSheetRenderer", suite.bakeSheetRenderer)
+
+	err := suite.target.Initialize(ctx)
+
+	suite.ErrorContains(err, "failed to get recipeJsonLdService from context")
+	suite.Nil(suite.target.Service())
+	suite.Nil(suite.target.Router())
+}
+
 func (suite *SourdoughRecipeScaleDependencyServiceTestSuite) TestInitialize_WithError() {
 	baseService := sourdoughRecipeScaleDependencyService{
 		serviceCreator: func(_ domain.SourdoughRecipeService) (domain.SourdoughRecipeScaleService, error) {
 			return suite.service, nil
 		},
-		handlerCreator: func(_ domain.SourdoughRecipeScaleService) (domain.SourdoughRecipeScaleHandler, error) {
+		handlerCreator: func(_ domain.SourdoughRecipeScaleService, _ domain.BakeSheetRenderer, _ domain.RecipeJsonLdService) (domain.SourdoughRecipeScaleHandler, error) {
 			return suite.handler, nil
 		},
 	}
@@ -88,7 +118,7 @@ func (suite *SourdoughRecipeScaleDependencyServiceTestSuite) TestInitialize_With
 		{
 			name: "handlerCreator",
 			serviceCreator: func(service sourdoughRecipeScaleDependencyService) domain.SourdoughRecipeScaleDependencyService {
-				service.handlerCreator = func(_ domain.SourdoughRecipeScaleService) (domain.SourdoughRecipeScaleHandler, error) {
+				service.handlerCreator = func(_ domain.SourdoughRecipeScaleService, _ domain.BakeSheetRenderer, _ domain.RecipeJsonLdService) (domain.SourdoughRecipeScaleHandler, error) {
 					return nil, assert.AnError
 				}
 
@@ -100,11 +130,9 @@ func (suite *SourdoughRecipeScaleDependencyServiceTestSuite) TestInitialize_With
 
 	for _, tt := range tests {
 		suite.Run(tt.name, func() {
-			ctx := context.WithValue(context.Background(), "sourdoughRecipeService", suite.sourdoughRecipeService)
-
 			service := tt.serviceCreator(baseService)
 
-			err := service.Initialize(ctx)
+			err := service.Initialize(suite.context())
 
 			suite.ErrorContains(err, tt.expectedErrorMsg)
 			suite.Nil(service.Service())
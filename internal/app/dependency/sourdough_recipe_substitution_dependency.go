@@ -0,0 +1,68 @@
+package dependency
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/controller/rest"
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/service"
+)
+
+type sourdoughRecipeSubstitutionDependencyService struct {
+	serviceCreator func(flourRepository domain.FlourRepository, sourdoughRecipeService domain.SourdoughRecipeService) (domain.SourdoughRecipeSubstitutionService, error)
+	service        domain.SourdoughRecipeSubstitutionService
+
+	handlerCreator func(service domain.SourdoughRecipeSubstitutionService) (domain.SourdoughRecipeSubstitutionHandler, error)
+	handler        domain.SourdoughRecipeSubstitutionHandler
+}
+
+func (dependencyService *sourdoughRecipeSubstitutionDependencyService) Initialize(ctx context.Context) error {
+	flourRepository, err := getFromContext[domain.FlourRepository](ctx, "flourRepository")
+	if err != nil {
+		return errors.Wrap(err, "failed to get flourRepository from context")
+	}
+
+	sourdoughRecipeService, err := getFromContext[domain.SourdoughRecipeService](ctx, "sourdoughRecipeService")
+	if err != nil {
+		return errors.Wrap(err, "failed to get sourdoughRecipeService from context")
+	}
+
+	substitutionService, err := dependencyService.serviceCreator(flourRepository, sourdoughRecipeService)
+	if err != nil {
+		return errors.Wrap(err, "failed to create service")
+	}
+
+	substitutionHandler, err := dependencyService.handlerCreator(substitutionService)
+	if err != nil {
+		return errors.Wrap(err, "failed to create handler")
+	}
+
+	dependencyService.service = substitutionService
+	dependencyService.handler = substitutionHandler
+
+	return nil
+}
+
+func (dependencyService *sourdoughRecipeSubstitutionDependencyService) Service() domain.SourdoughRecipeSubstitutionService {
+	return dependencyService.service
+}
+
+func (dependencyService *sourdoughRecipeSubstitutionDependencyService) Router() domain.SourdoughRecipeSubstitutionHandler {
+	return dependencyService.handler
+}
+
+func NewSourdoughRecipeSubstitutionDependencyService() domain.SourdoughRecipeSubstitutionDependencyService {
+	return newSourdoughRecipeSubstitutionDependencyService(service.NewSourdoughRecipeSubstitutionService, rest.NewSourdoughRecipeSubstitutionHandler)
+}
+
+func newSourdoughRecipeSubstitutionDependencyService(
+	serviceCreator func(flourRepository domain.FlourRepository, sourdoughRecipeService domain.SourdoughRecipeService) (domain.SourdoughRecipeSubstitutionService, error),
+	handlerCreator func(service domain.SourdoughRecipeSubstitutionService) (domain.SourdoughRecipeSubstitutionHandler, error),
+) domain.SourdoughRecipeSubstitutionDependencyService {
+	return &sourdoughRecipeSubstitutionDependencyService{
+		serviceCreator: serviceCreator,
+		handlerCreator: handlerCreator,
+	}
+}
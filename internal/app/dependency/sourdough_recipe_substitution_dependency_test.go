@@ -0,0 +1,150 @@
+package dependency
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	"dough-calculator/internal/test"
+)
+
+type SourdoughRecipeSubstitutionDependencyServiceTestSuite struct {
+	test.GoMockTestSuite
+
+	flourRepository        *mocks.MockFlourRepository
+	sourdoughRecipeService *mocks.MockSourdoughRecipeService
+	service                *mocks.MockSourdoughRecipeSubstitutionService
+	handler                *mocks.MockSourdoughRecipeSubstitutionHandler
+
+	target domain.SourdoughRecipeSubstitutionDependencyService
+}
+
+func (suite *SourdoughRecipeSubstitutionDependencyServiceTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.flourRepository = mocks.NewMockFlourRepository(suite.MockCtrl)
+	suite.sourdoughRecipeService = mocks.NewMockSourdoughRecipeService(suite.MockCtrl)
+	suite.service = mocks.NewMockSourdoughRecipeSubstitutionService(suite.MockCtrl)
+	suite.handler = mocks.NewMockSourdoughRecipeSubstitutionHandler(suite.MockCtrl)
+
+	suite.target = newSourdoughRecipeSubstitutionDependencyService(
+		func(_ domain.FlourRepository, _ domain.SourdoughRecipeService) (domain.SourdoughRecipeSubstitutionService, error) {
+			return suite.service, nil
+		},
+		func(_ domain.SourdoughRecipeSubstitutionService) (domain.SourdoughRecipeSubstitutionHandler, error) {
+			return suite.handler, nil
+		},
+	)
+}
+
+func (suite *SourdoughRecipeSubstitutionDependencyServiceTestSuite) context() context.Context {
+	ctx := context.WithValue(context.Background(), "flourRepository", suite.flourRepository)
+	return context.WithValue(ctx, "sourdoughRecipeService", suite.sourdoughRecipeService)
+}
+
+func (suite *SourdoughRecipeSubstitutionDependencyServiceTestSuite) TestInitialize() {
+	err := suite.target.Initialize(suite.context())
+
+	suite.NoError(err)
+	suite.Equal(suite.service, suite.target.Service())
+	suite.Equal(suite.handler, suite.target.Router())
+}
+
+func (suite *SourdoughRecipeSubstitutionDependencyServiceTestSuite) TestInitialize_WithMissingDependency() {
+	tests := []struct {
+		name             string
+		ctx              context.Context
+		expectedErrorMsg string
+	}{
+		{
+			name:             "flourRepository",
+			ctx:              context.WithValue(context.Background(), "sourdoughRecipeService", suite.sourdoughRecipeService),
+			expectedErrorMsg: "failed to get flourRepository from context",
+		},
+		{
+			name:             "sourdoughRecipeService",
+			ctx:              context.WithValue(context.Background(), "flourRepository", suite.flourRepository),
+			expectedErrorMsg: "failed to get sourdoughRecipeService from context",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			err := suite.target.Initialize(tt.ctx)
+
+			suite.ErrorContains(err, tt.expectedErrorMsg)
+			suite.Nil(suite.target.Service())
+			suite.Nil(suite.target.Router())
+		})
+	}
+}
+
+func (suite *SourdoughRecipeSubstitutionDependencyServiceTestSuite) TestInitialize_WithError() {
+	baseService := sourdoughRecipeSubstitutionDependencyService{
+		serviceCreator: func(_ domain.FlourRepository, _ domain.SourdoughRecipeService) (domain.SourdoughRecipeSubstitutionService, error) {
+			return suite.service, nil
+		},
+		handlerCreator: func(_ domain.SourdoughRecipeSubstitutionService) (domain.SourdoughRecipeSubstitutionHandler, error) {
+			return suite.handler, nil
+		},
+	}
+
+	tests := []struct {
+		name             string
+		serviceCreator   func(service sourdoughRecipeSubstitutionDependencyService) domain.SourdoughRecipeSubstitutionDependencyService
+		expectedErrorMsg string
+	}{
+		{
+			name: "serviceCreator",
+			serviceCreator: func(service sourdoughRecipeSubstitutionDependencyService) domain.SourdoughRecipeSubstitutionDependencyService {
+				service.serviceCreator = func(_ domain.FlourRepository, _ domain.SourdoughRecipeService) (domain.SourdoughRecipeSubstitutionService, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create service",
+		},
+		{
+			name: "handlerCreator",
+			serviceCreator: func(service sourdoughRecipeSubstitutionDependencyService) domain.SourdoughRecipeSubstitutionDependencyService {
+				service.handlerCreator = func(_ domain.SourdoughRecipeSubstitutionService) (domain.SourdoughRecipeSubstitutionHandler, error) {
+					return nil, assert.AnError
+				}
+
+				return &service
+			},
+			expectedErrorMsg: "failed to create handler",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			service := tt.serviceCreator(baseService)
+
+			err := service.Initialize(suite.context())
+
+			suite.ErrorContains(err, tt.expectedErrorMsg)
+			suite.Nil(service.Service())
+			suite.Nil(service.Router())
+		})
+	}
+}
+
+func (suite *SourdoughRecipeSubstitutionDependencyServiceTestSuite) TestNewSourdoughRecipeSubstitutionDependencyService() {
+	target := NewSourdoughRecipeSubstitutionDependencyService().(*sourdoughRecipeSubstitutionDependencyService)
+
+	suite.NotNil(target)
+	suite.NotNil(target.serviceCreator)
+	suite.NotNil(target.handlerCreator)
+	suite.Nil(target.service)
+	suite.Nil(target.handler)
+}
+
+func TestSourdoughRecipeSubstitutionDependencyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SourdoughRecipeSubstitutionDependencyServiceTestSuite))
+}
@@ -3,13 +3,16 @@
 package integration_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net"
 	"net/http"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -76,7 +79,13 @@ func (suite *ApplicationTestSuite) SetupSuite() {
 }
 
 func (suite *ApplicationTestSuite) TearDownTest() {
-	err := suite.Drop("dough-calculator", "sourdough-recipes")
+	err := suite.Drop("sourdough-recipes")
+	suite.Require().NoError(err)
+
+	err = suite.Drop("production-plans")
+	suite.Require().NoError(err)
+
+	err = suite.Drop("inventory")
 	suite.Require().NoError(err)
 }
 
@@ -210,6 +219,7 @@ func (suite *ApplicationTestSuite) TestApplication_CreateSourdoughRecipe() {
 				Unit:   "loaf",
 			},
 			CreatedAt: actualResponse.CreatedAt,
+			Version:   1,
 		},
 		Levain: domain.SourdoughLevainAgentDto{
 			Amount: domain.BakerAmountDto{
@@ -277,6 +287,28 @@ func (suite *ApplicationTestSuite) TestApplication_FindSourdoughRecipeById() {
 	suite.Equal(expectedResponse, actualResponse)
 }
 
+func (suite *ApplicationTestSuite) TestApplication_FindSourdoughRecipeById_AsBakeSheet() {
+	recipe, err := suite.createSourdoughRecipe()
+	suite.Require().NoError(err)
+
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v1/recipe/sourdough/%s", suite.client.Server, recipe.Id), nil)
+	suite.Require().NoError(err)
+	req.Header.Set("Accept", "text/markdown")
+
+	response, err := http.DefaultClient.Do(req)
+	suite.Require().NoError(err)
+	defer response.Body.Close()
+
+	suite.Equal(http.StatusOK, response.StatusCode)
+	suite.Equal("text/markdown; charset=utf-8", response.Header.Get("Content-Type"))
+
+	body, err := io.ReadAll(response.Body)
+	suite.Require().NoError(err)
+	suite.Contains(string(body), "# test recipe")
+	suite.Contains(string(body), "| test first flour name | 900 | 90.0 |")
+	suite.Contains(string(body), "| Salt | 20 | 2.0 |")
+}
+
 func (suite *ApplicationTestSuite) TestApplication_FindSourdoughRecipe() {
 	expectedResponse, err := suite.createSourdoughRecipe()
 	suite.Require().NoError(err)
@@ -289,13 +321,15 @@ func (suite *ApplicationTestSuite) TestApplication_FindSourdoughRecipe() {
 	suite.Require().NoError(err)
 	suite.Equal(http.StatusOK, sourdoughRecipeResponse.StatusCode)
 
-	var actualResponse []domain.SourdoughRecipeDto
+	var actualResponse domain.SourdoughRecipeSearchResultDto
 	err = json.NewDecoder(sourdoughRecipeResponse.Body).Decode(&actualResponse)
 	suite.Require().NoError(err)
+	suite.Require().Len(actualResponse.Items, 1)
 
-	expectedResponse.CreatedAt = actualResponse[0].CreatedAt
+	expectedResponse.CreatedAt = actualResponse.Items[0].CreatedAt
 
-	suite.Equal([]domain.SourdoughRecipeDto{expectedResponse}, actualResponse)
+	suite.Equal([]domain.SourdoughRecipeDto{expectedResponse}, actualResponse.Items)
+	suite.Equal(domain.PageDto{Total: 1}, actualResponse.PageDto)
 }
 
 func (suite *ApplicationTestSuite) TestApplication_FindSourdoughRecipe_WithDefaultParameters() {
@@ -306,13 +340,14 @@ func (suite *ApplicationTestSuite) TestApplication_FindSourdoughRecipe_WithDefau
 	suite.Require().NoError(err)
 	suite.Equal(http.StatusOK, sourdoughRecipeResponse.StatusCode)
 
-	actualResponse := make([]domain.SourdoughRecipeDto, 1)
+	var actualResponse domain.SourdoughRecipeSearchResultDto
 	err = json.NewDecoder(sourdoughRecipeResponse.Body).Decode(&actualResponse)
 	suite.Require().NoError(err)
+	suite.Require().Len(actualResponse.Items, 1)
 
-	expectedResponse.CreatedAt = actualResponse[0].CreatedAt
+	expectedResponse.CreatedAt = actualResponse.Items[0].CreatedAt
 
-	suite.Equal([]domain.SourdoughRecipeDto{expectedResponse}, actualResponse)
+	suite.Equal([]domain.SourdoughRecipeDto{expectedResponse}, actualResponse.Items)
 }
 
 func (suite *ApplicationTestSuite) TestApplication_SearchSourdoughRecipe() {
@@ -471,6 +506,7 @@ func (suite *ApplicationTestSuite) TestApplication_ScaleSourdoughRecipe() {
 				},
 			},
 			CreatedAt: actualResponse.CreatedAt,
+			Version:   1,
 		},
 		Levain: domain.SourdoughLevainAgentDto{
 			Amount: domain.BakerAmountDto{
@@ -521,6 +557,251 @@ func (suite *ApplicationTestSuite) TestApplication_ScaleSourdoughRecipe() {
 	}, actualResponse)
 }
 
+func (suite *ApplicationTestSuite) TestApplication_ScaleSourdoughRecipeBatch() {
+	recipe, err := suite.createSourdoughRecipe()
+	suite.Require().NoError(err)
+
+	requestBody := fmt.Sprintf(`{"items": [{"recipe_id": "%s", "pieces": 2, "piece_weight": 985}]}`, recipe.Id)
+	response, err := http.Post(suite.client.Server+"/v1/recipe/sourdough/scale/batch", "application/json", strings.NewReader(requestBody))
+	suite.Require().NoError(err)
+	defer response.Body.Close()
+
+	suite.Equal(http.StatusOK, response.StatusCode)
+
+	var batch domain.SourdoughRecipeBatchScaleDto
+	err = json.NewDecoder(response.Body).Decode(&batch)
+	suite.Require().NoError(err)
+
+	suite.Len(batch.Sheets, 1)
+	suite.Equal(1970, batch.Sheets[0].Recipe.Details.TotalWeight)
+	suite.Equal(1970, batch.PickList.TotalWeight)
+	suite.Equal(domain.FlourAmountDto{FlourDto: recipe.Flour[0].FlourDto, Amount: 945}, batch.PickList.Flour[0])
+	suite.Equal(domain.FlourAmountDto{FlourDto: recipe.Flour[1].FlourDto, Amount: 145}, batch.PickList.Flour[1])
+	suite.Equal(840.0, batch.PickList.Water)
+}
+
+func (suite *ApplicationTestSuite) TestApplication_SubstituteSourdoughRecipeFlour() {
+	recipe, err := suite.createSourdoughRecipe()
+	suite.Require().NoError(err)
+	flour, err := suite.createFlour()
+	suite.Require().NoError(err)
+
+	requestBody := fmt.Sprintf(`{"from_flour_id": "1126e515-b2e9-47e5-990d-ad3d8c0f7c98", "to_flour_id": "%s"}`, flour.Id)
+	response, err := http.Post(
+		fmt.Sprintf("%s/v1/recipe/sourdough/%s/substitute", suite.client.Server, recipe.Id),
+		"application/json",
+		strings.NewReader(requestBody),
+	)
+	suite.Require().NoError(err)
+	defer response.Body.Close()
+
+	suite.Equal(http.StatusOK, response.StatusCode)
+
+	var substitution domain.SourdoughRecipeSubstitutionDto
+	err = json.NewDecoder(response.Body).Decode(&substitution)
+	suite.Require().NoError(err)
+
+	suite.False(substitution.Applied)
+	suite.Equal(100.0, substitution.SubstitutedAmount)
+	suite.InDelta(15, substitution.WaterAdjustment, 0.001)
+	suite.Equal(flour.Id, substitution.Recipe.Flour[1].Id)
+	suite.InDelta(recipe.Details.Water.Amount+15, substitution.Recipe.Details.Water.Amount, 0.001)
+
+	stored, err := suite.client.FindSourdoughRecipeById(context.Background(), recipe.Id)
+	suite.Require().NoError(err)
+	defer stored.Body.Close()
+
+	var storedRecipe domain.SourdoughRecipeDto
+	err = json.NewDecoder(stored.Body).Decode(&storedRecipe)
+	suite.Require().NoError(err)
+	suite.Equal(recipe.Version, storedRecipe.Version)
+}
+
+func (suite *ApplicationTestSuite) TestApplication_CalculateSourdoughRecipe() {
+	requestFile, err := os.OpenFile("testdata/sourdough_recipe_create_request.json", os.O_RDONLY, 0644)
+	suite.Require().NoError(err)
+	defer requestFile.Close()
+
+	response, err := http.Post(suite.client.Server+"/v1/calculate/sourdough", "application/json", requestFile)
+	suite.Require().NoError(err)
+	defer response.Body.Close()
+
+	suite.Equal(http.StatusOK, response.StatusCode)
+
+	var recipe domain.SourdoughRecipeDto
+	err = json.NewDecoder(response.Body).Decode(&recipe)
+	suite.Require().NoError(err)
+
+	suite.Equal(uuid.Nil, recipe.Id)
+	suite.Equal(1970, recipe.Details.TotalWeight)
+	suite.Equal(75.0, recipe.Details.Water.BakerPercentage)
+
+	searchResponse, err := http.Get(suite.client.Server + "/v1/recipe/sourdough")
+	suite.Require().NoError(err)
+	defer searchResponse.Body.Close()
+
+	var recipes domain.SourdoughRecipeSearchResultDto
+	err = json.NewDecoder(searchResponse.Body).Decode(&recipes)
+	suite.Require().NoError(err)
+	suite.Empty(recipes.Items)
+}
+
+func (suite *ApplicationTestSuite) TestApplication_CreateSourdoughRecipeFromFormula() {
+	requestBody := `{
+		"name": "formula recipe",
+		"flour": [{"id": "4487b1c1-672e-425c-bacb-1deb377f0c65", "baker_percentage": 100}],
+		"water": [{"name": "Water", "baker_percentage": 75}],
+		"levain": {"baker_percentage": 20, "hydration": 100},
+		"additional_ingredients": [{"name": "Salt", "baker_percentage": 2}],
+		"dough_weight": 1970
+	}`
+	response, err := http.Post(suite.client.Server+"/v1/recipe/sourdough/formula", "application/json", strings.NewReader(requestBody))
+	suite.Require().NoError(err)
+	defer response.Body.Close()
+
+	suite.Equal(http.StatusCreated, response.StatusCode)
+
+	var recipe domain.SourdoughRecipeDto
+	err = json.NewDecoder(response.Body).Decode(&recipe)
+	suite.Require().NoError(err)
+
+	suite.Equal(1000.0, recipe.Details.Flour.Amount)
+	suite.Equal(100.0, recipe.Levain.Flour[0].Amount)
+	suite.Equal(100.0, recipe.Levain.Water.Amount)
+	suite.Equal(1970, recipe.Details.TotalWeight)
+
+	stored, err := suite.client.FindSourdoughRecipeById(context.Background(), recipe.Id)
+	suite.Require().NoError(err)
+	defer stored.Body.Close()
+
+	suite.Equal(http.StatusOK, stored.StatusCode)
+}
+
+func (suite *ApplicationTestSuite) TestApplication_ProductionPlan() {
+	recipe, err := suite.createSourdoughRecipe()
+	suite.Require().NoError(err)
+
+	requestBody := fmt.Sprintf(`{
+		"name": "Saturday market",
+		"date": "2024-03-02T04:00:00Z",
+		"mixer_capacity": 2,
+		"items": [{"recipe_id": "%s", "pieces": 3, "piece_weight": 985, "fermentation_minutes": 240}]
+	}`, recipe.Id)
+	response, err := http.Post(suite.client.Server+"/v1/production-plans", "application/json", strings.NewReader(requestBody))
+	suite.Require().NoError(err)
+	defer response.Body.Close()
+
+	suite.Equal(http.StatusCreated, response.StatusCode)
+
+	var plan domain.ProductionPlanDto
+	err = json.NewDecoder(response.Body).Decode(&plan)
+	suite.Require().NoError(err)
+	suite.Equal(20, plan.MixMinutes)
+
+	scheduleResponse, err := http.Get(fmt.Sprintf("%s/v1/production-plans/%s/schedule", suite.client.Server, plan.Id))
+	suite.Require().NoError(err)
+	defer scheduleResponse.Body.Close()
+
+	suite.Equal(http.StatusOK, scheduleResponse.StatusCode)
+
+	var schedule domain.ProductionScheduleDto
+	err = json.NewDecoder(scheduleResponse.Body).Decode(&schedule)
+	suite.Require().NoError(err)
+
+	suite.Len(schedule.Mixes, 2)
+	suite.Equal(1970, schedule.Mixes[0].Recipe.Details.TotalWeight)
+	suite.Equal(985, schedule.Mixes[1].Recipe.Details.TotalWeight)
+	suite.Equal(plan.Date.Add(20*time.Minute), schedule.Mixes[1].MixAt)
+	suite.Equal(plan.Date.Add(280*time.Minute), schedule.Mixes[1].ReadyAt)
+
+	req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/v1/production-plans/%s", suite.client.Server, plan.Id), nil)
+	suite.Require().NoError(err)
+	deleteResponse, err := http.DefaultClient.Do(req)
+	suite.Require().NoError(err)
+	defer deleteResponse.Body.Close()
+
+	suite.Equal(http.StatusNoContent, deleteResponse.StatusCode)
+
+	findResponse, err := http.Get(fmt.Sprintf("%s/v1/production-plans/%s", suite.client.Server, plan.Id))
+	suite.Require().NoError(err)
+	defer findResponse.Body.Close()
+
+	suite.Equal(http.StatusBadRequest, findResponse.StatusCode)
+}
+
+func (suite *ApplicationTestSuite) TestApplication_Inventory() {
+	recipe, err := suite.createSourdoughRecipe()
+	suite.Require().NoError(err)
+
+	req, err := http.NewRequest(http.MethodPut, suite.client.Server+"/v1/inventory",
+		strings.NewReader(`{"name": "Salt", "quantity": 15, "low_stock_threshold": 8}`))
+	suite.Require().NoError(err)
+	req.Header.Set("Content-Type", "application/json")
+	upsertResponse, err := http.DefaultClient.Do(req)
+	suite.Require().NoError(err)
+	defer upsertResponse.Body.Close()
+
+	suite.Equal(http.StatusOK, upsertResponse.StatusCode)
+
+	var item domain.InventoryItemDto
+	err = json.NewDecoder(upsertResponse.Body).Decode(&item)
+	suite.Require().NoError(err)
+	suite.Equal(15.0, item.Quantity)
+	suite.False(item.LowStock)
+
+	requestBody := fmt.Sprintf(`{"items": [{"recipe_id": "%s", "pieces": 2, "piece_weight": 985}]}`, recipe.Id)
+	shortagesResponse, err := http.Post(suite.client.Server+"/v1/inventory/shortages", "application/json", strings.NewReader(requestBody))
+	suite.Require().NoError(err)
+	defer shortagesResponse.Body.Close()
+
+	suite.Equal(http.StatusOK, shortagesResponse.StatusCode)
+
+	var shortages domain.InventoryShortagesDto
+	err = json.NewDecoder(shortagesResponse.Body).Decode(&shortages)
+	suite.Require().NoError(err)
+	suite.Require().Len(shortages.Shortages, 3)
+	suite.Equal(domain.InventoryShortageDto{
+		Kind:     domain.InventoryKindIngredient,
+		Name:     "Salt",
+		Required: 20,
+		OnHand:   15,
+		Missing:  5,
+		Tracked:  true,
+	}, shortages.Shortages[2])
+
+	requestBody = fmt.Sprintf(`{
+		"name": "Saturday market",
+		"date": "2024-03-02T04:00:00Z",
+		"mixer_capacity": 2,
+		"items": [{"recipe_id": "%s", "pieces": 1, "piece_weight": 985, "fermentation_minutes": 240}]
+	}`, recipe.Id)
+	planResponse, err := http.Post(suite.client.Server+"/v1/production-plans", "application/json", strings.NewReader(requestBody))
+	suite.Require().NoError(err)
+	defer planResponse.Body.Close()
+
+	var plan domain.ProductionPlanDto
+	err = json.NewDecoder(planResponse.Body).Decode(&plan)
+	suite.Require().NoError(err)
+
+	completeResponse, err := http.Post(fmt.Sprintf("%s/v1/production-plans/%s/complete", suite.client.Server, plan.Id), "application/json", nil)
+	suite.Require().NoError(err)
+	defer completeResponse.Body.Close()
+
+	suite.Equal(http.StatusOK, completeResponse.StatusCode)
+
+	lowStockResponse, err := http.Get(suite.client.Server + "/v1/inventory?low_stock=true")
+	suite.Require().NoError(err)
+	defer lowStockResponse.Body.Close()
+
+	var lowStock []domain.InventoryItemDto
+	err = json.NewDecoder(lowStockResponse.Body).Decode(&lowStock)
+	suite.Require().NoError(err)
+	suite.Require().Len(lowStock, 1)
+	suite.Equal(item.Id, lowStock[0].Id)
+	suite.Equal(5.0, lowStock[0].Quantity)
+	suite.True(lowStock[0].LowStock)
+}
+
 func (suite *ApplicationTestSuite) TestApplication_CreateFlour() {
 	requestFile, err := os.OpenFile("testdata/flour_create_request.json", os.O_RDONLY, 0644)
 	suite.Require().NoError(err)
@@ -541,7 +822,7 @@ func (suite *ApplicationTestSuite) TestApplication_CreateFlour() {
 
 	suite.Equal(domain.FlourDto{
 		Id:          actualResponse.Id,
-		FlourType:   "Wheat",
+		FlourType:   "whole_wheat",
 		Name:        "Whole Wheat Flour",
 		Description: "Whole wheat flour made from 100% whole wheat grains.",
 		NutritionFacts: domain.NutritionFactsDto{
@@ -551,7 +832,304 @@ func (suite *ApplicationTestSuite) TestApplication_CreateFlour() {
 			Protein:  16.4,
 			Fiber:    12.2,
 		},
+		ProteinContent:      13.2,
+		ExtractionRate:      100,
+		SuggestedAbsorption: 80,
+		CreatedAt:           actualResponse.CreatedAt,
 	}, actualResponse)
+	suite.NotNil(actualResponse.CreatedAt)
+}
+
+func (suite *ApplicationTestSuite) TestApplication_CreateFlour_WithUnknownFlourType() {
+	response, err := suite.client.CreateFlourWithBody(context.Background(), "application/json",
+		strings.NewReader(`{"flour_type": "emmer", "name": "Emmer Flour"}`))
+	suite.Require().NoError(err)
+
+	suite.Equal(http.StatusBadRequest, response.StatusCode)
+}
+
+func (suite *ApplicationTestSuite) TestApplication_FindFlourTypes_ShouldReturnDefaults() {
+	response, err := http.Get(suite.client.Server + "/v1/flour/types")
+	suite.Require().NoError(err)
+	defer response.Body.Close()
+
+	suite.Equal(http.StatusOK, response.StatusCode)
+
+	var flourTypes []domain.FlourTypeDto
+	err = json.NewDecoder(response.Body).Decode(&flourTypes)
+	suite.Require().NoError(err)
+
+	codes := make([]string, 0, len(flourTypes))
+	for _, flourType := range flourTypes {
+		codes = append(codes, flourType.Code)
+	}
+	suite.Subset(codes, []string{"bread", "whole_wheat", "rye", "spelt", "einkorn"})
+}
+
+func (suite *ApplicationTestSuite) TestApplication_ImportAndExportFlourCsv() {
+	name := "Imported rye " + uuid.NewString()
+	csv := "name,flour_type,nutrition_facts.calories,protein_content\n" +
+		name + ",rye,335,9\n" +
+		",rye,,\n"
+
+	importCsv := func(query string) domain.FlourImportResultDto {
+		var body bytes.Buffer
+		writer := multipart.NewWriter(&body)
+		part, err := writer.CreateFormFile("file", "flours.csv")
+		suite.Require().NoError(err)
+		_, err = part.Write([]byte(csv))
+		suite.Require().NoError(err)
+		suite.Require().NoError(writer.Close())
+
+		response, err := http.Post(suite.client.Server+"/v1/flour/import"+query, writer.FormDataContentType(), &body)
+		suite.Require().NoError(err)
+		defer response.Body.Close()
+
+		suite.Require().Equal(http.StatusOK, response.StatusCode)
+
+		var result domain.FlourImportResultDto
+		suite.Require().NoError(json.NewDecoder(response.Body).Decode(&result))
+
+		return result
+	}
+
+	dryRun := importCsv("?dry_run=true")
+
+	suite.True(dryRun.DryRun)
+	suite.Equal(1, dryRun.Created)
+	suite.Equal(1, dryRun.Failed)
+	suite.Equal([]string{"name is required"}, dryRun.Rows[1].Errors)
+
+	created := importCsv("")
+
+	suite.Equal(1, created.Created)
+	suite.Require().NotNil(created.Rows[0].FlourId)
+
+	updated := importCsv("")
+
+	suite.Equal(1, updated.Updated)
+	suite.Equal(created.Rows[0].FlourId, updated.Rows[0].FlourId)
+
+	response, err := http.Get(suite.client.Server + "/v1/flour/export.csv")
+	suite.Require().NoError(err)
+	defer response.Body.Close()
+
+	suite.Equal(http.StatusOK, response.StatusCode)
+	suite.Equal("text/csv; charset=utf-8", response.Header.Get("Content-Type"))
+
+	body, err := io.ReadAll(response.Body)
+	suite.Require().NoError(err)
+
+	suite.Contains(string(body), created.Rows[0].FlourId.String()+",rye,"+name+",,335,0,0,0,0,9,0,0,0\n")
+}
+
+func (suite *ApplicationTestSuite) TestApplication_ExportAndImportRecipeBundle() {
+	// imported flours are validated against the flour type catalogue, so the
+	// recipe only uses a catalogued flour
+	flour, err := suite.createFlour()
+	suite.Require().NoError(err)
+
+	requestBytes, err := os.ReadFile("testdata/sourdough_recipe_create_request.json")
+	suite.Require().NoError(err)
+
+	var request domain.CreateSourdoughRecipeRequest
+	suite.Require().NoError(json.Unmarshal(requestBytes, &request))
+	request.Name = "Bundle " + uuid.NewString()
+	request.Flour = []domain.FlourAmountDto{{FlourDto: flour, Amount: 1000}}
+	request.Levain.Flour = []domain.FlourAmountDto{{FlourDto: flour, Amount: 90}}
+
+	requestBody, err := json.Marshal(request)
+	suite.Require().NoError(err)
+
+	createResponse, err := http.Post(suite.client.Server+"/v1/recipe/sourdough", "application/json", bytes.NewReader(requestBody))
+	suite.Require().NoError(err)
+	defer createResponse.Body.Close()
+
+	suite.Require().Equal(http.StatusCreated, createResponse.StatusCode)
+
+	var recipe domain.SourdoughRecipeDto
+	suite.Require().NoError(json.NewDecoder(createResponse.Body).Decode(&recipe))
+
+	response, err := http.Get(suite.client.Server + "/v1/recipe/sourdough/export?id=" + recipe.Id.String())
+	suite.Require().NoError(err)
+	defer response.Body.Close()
+
+	suite.Require().Equal(http.StatusOK, response.StatusCode)
+
+	var bundle domain.RecipeBundleDto
+	suite.Require().NoError(json.NewDecoder(response.Body).Decode(&bundle))
+
+	suite.Equal(domain.RecipeBundleSchemaVersion, bundle.SchemaVersion)
+	suite.Require().Len(bundle.Recipes, 1)
+	suite.Equal(recipe.Id, bundle.Recipes[0].Id)
+	suite.Equal([]domain.FlourDto{flour}, bundle.Flours)
+
+	yamlRequest, err := http.NewRequest(http.MethodGet, suite.client.Server+"/v1/recipe/sourdough/export?id="+recipe.Id.String(), nil)
+	suite.Require().NoError(err)
+	yamlRequest.Header.Set("Accept", "application/yaml")
+
+	yamlResponse, err := http.DefaultClient.Do(yamlRequest)
+	suite.Require().NoError(err)
+	defer yamlResponse.Body.Close()
+
+	suite.Require().Equal(http.StatusOK, yamlResponse.StatusCode)
+	suite.Equal("application/yaml; charset=utf-8", yamlResponse.Header.Get("Content-Type"))
+
+	yamlBundle, err := io.ReadAll(yamlResponse.Body)
+	suite.Require().NoError(err)
+
+	importBundle := func(query string) domain.RecipeBundleImportResultDto {
+		response, err := http.Post(suite.client.Server+"/v1/recipe/sourdough/import"+query, "application/yaml", bytes.NewReader(yamlBundle))
+		suite.Require().NoError(err)
+		defer response.Body.Close()
+
+		suite.Require().Equal(http.StatusOK, response.StatusCode)
+
+		var result domain.RecipeBundleImportResultDto
+		suite.Require().NoError(json.NewDecoder(response.Body).Decode(&result))
+
+		return result
+	}
+
+	renamed := importBundle("")
+
+	suite.Equal([]domain.RecipeBundleFlourImportDto{
+		{SourceId: flour.Id, Id: flour.Id, Name: flour.Name, Action: domain.RecipeBundleFlourMatched},
+	}, renamed.Flours)
+	suite.Require().Len(renamed.Recipes, 1)
+	suite.Equal(domain.RecipeBundleRecipeRenamed, renamed.Recipes[0].Action)
+	suite.Equal(recipe.Name+" (imported)", renamed.Recipes[0].Name)
+	suite.NotEqual(recipe.Id, renamed.Recipes[0].Id)
+
+	skipped := importBundle("?on_conflict=skip")
+
+	suite.Require().Len(skipped.Recipes, 1)
+	suite.Equal(domain.RecipeBundleRecipeSkipped, skipped.Recipes[0].Action)
+	suite.Equal(recipe.Id, skipped.Recipes[0].Id)
+}
+
+func (suite *ApplicationTestSuite) TestApplication_ExportAndImportRecipeJsonLd() {
+	flour, err := suite.createFlour()
+	suite.Require().NoError(err)
+
+	requestBytes, err := os.ReadFile("testdata/sourdough_recipe_create_request.json")
+	suite.Require().NoError(err)
+
+	var request domain.CreateSourdoughRecipeRequest
+	suite.Require().NoError(json.Unmarshal(requestBytes, &request))
+	request.Name = "JSON-LD " + uuid.NewString()
+	request.Flour = []domain.FlourAmountDto{{FlourDto: flour, Amount: 1000}}
+	request.Levain.Flour = []domain.FlourAmountDto{{FlourDto: flour, Amount: 90}}
+
+	requestBody, err := json.Marshal(request)
+	suite.Require().NoError(err)
+
+	createResponse, err := http.Post(suite.client.Server+"/v1/recipe/sourdough", "application/json", bytes.NewReader(requestBody))
+	suite.Require().NoError(err)
+	defer createResponse.Body.Close()
+
+	suite.Require().Equal(http.StatusCreated, createResponse.StatusCode)
+
+	var recipe domain.SourdoughRecipeDto
+	suite.Require().NoError(json.NewDecoder(createResponse.Body).Decode(&recipe))
+
+	response, err := http.Get(suite.client.Server + "/v1/recipe/sourdough/" + recipe.Id.String() + "/jsonld")
+	suite.Require().NoError(err)
+	defer response.Body.Close()
+
+	suite.Require().Equal(http.StatusOK, response.StatusCode)
+	suite.Equal("application/ld+json; charset=utf-8", response.Header.Get("Content-Type"))
+
+	jsonLd, err := io.ReadAll(response.Body)
+	suite.Require().NoError(err)
+
+	var exported domain.SchemaOrgRecipeDto
+	suite.Require().NoError(json.Unmarshal(jsonLd, &exported))
+
+	suite.Equal(domain.SchemaOrgContext, exported.Context)
+	suite.Equal(domain.SchemaOrgRecipeType, exported.Type)
+	suite.Equal(recipe.Name, exported.Name)
+	suite.Contains(exported.RecipeIngredient, "1000 g "+flour.Name)
+	suite.Equal("2 loaf", exported.RecipeYield)
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", "recipe.jsonld")
+	suite.Require().NoError(err)
+	_, err = part.Write(jsonLd)
+	suite.Require().NoError(err)
+	suite.Require().NoError(writer.Close())
+
+	importResponse, err := http.Post(suite.client.Server+"/v1/recipe/sourdough/import/jsonld", writer.FormDataContentType(), &body)
+	suite.Require().NoError(err)
+	defer importResponse.Body.Close()
+
+	suite.Require().Equal(http.StatusCreated, importResponse.StatusCode)
+
+	var imported domain.RecipeJsonLdImportDto
+	suite.Require().NoError(json.NewDecoder(importResponse.Body).Decode(&imported))
+
+	suite.NotEqual(recipe.Id, imported.Recipe.Id)
+	suite.Equal(recipe.Name, imported.Recipe.Name)
+	suite.Equal(recipe.Yield, imported.Recipe.Yield)
+	suite.Require().Len(imported.Recipe.Flour, 1)
+	suite.Equal(flour.Name, imported.Recipe.Flour[0].Name)
+	suite.Equal(1000.0, imported.Recipe.Flour[0].Amount)
+	suite.Empty(imported.Unrecognised)
+}
+
+func (suite *ApplicationTestSuite) TestApplication_ParseSourdoughRecipe() {
+	flour, err := suite.createFlour()
+	suite.Require().NoError(err)
+
+	requestBody, err := json.Marshal(domain.SourdoughRecipeParseRequest{
+		Text: "Country loaf\n500g whole wheat flour\n375g water\n100g levain\n10g salt\n1 egg",
+	})
+	suite.Require().NoError(err)
+
+	response, err := http.Post(suite.client.Server+"/v1/recipe/sourdough/parse", "application/json", bytes.NewReader(requestBody))
+	suite.Require().NoError(err)
+	defer response.Body.Close()
+
+	suite.Require().Equal(http.StatusOK, response.StatusCode)
+
+	var result domain.SourdoughRecipeParseDto
+	suite.Require().NoError(json.NewDecoder(response.Body).Decode(&result))
+
+	suite.Equal("Country loaf", result.Recipe.Name)
+	suite.Require().Len(result.Recipe.Flour, 1)
+	suite.Equal(flour.Name, result.Recipe.Flour[0].Name)
+	suite.Equal(500.0, result.Recipe.Flour[0].Amount)
+	suite.Require().Len(result.Recipe.Water, 1)
+	suite.Equal(375.0, result.Recipe.Water[0].Amount)
+	suite.Equal(100.0, result.Recipe.Levain.Amount.Amount)
+	suite.Require().Len(result.Recipe.AdditionalIngredients, 1)
+	suite.Equal("salt", result.Recipe.AdditionalIngredients[0].Name)
+	suite.Require().Len(result.FlourMatches, 1)
+	suite.Equal(1.0, result.FlourMatches[0].Score)
+	suite.Equal([]domain.UnrecognisedLineDto{
+		{Line: 6, Text: "1 egg", Reason: "no amount in grams or percent found"},
+	}, result.Unrecognised)
+}
+
+func (suite *ApplicationTestSuite) TestApplication_SuggestHydration() {
+	flour, err := suite.createFlour()
+	suite.Require().NoError(err)
+
+	requestBody := fmt.Sprintf(`{"flour": [{"id": "%s", "amount": 1000}], "style": "country"}`, flour.Id)
+	response, err := http.Post(suite.client.Server+"/v1/flour/hydration", "application/json", strings.NewReader(requestBody))
+	suite.Require().NoError(err)
+	defer response.Body.Close()
+
+	suite.Equal(http.StatusOK, response.StatusCode)
+
+	var suggestion domain.HydrationSuggestionDto
+	err = json.NewDecoder(response.Body).Decode(&suggestion)
+	suite.Require().NoError(err)
+
+	suite.Equal(80.0, suggestion.Absorption)
+	suite.Equal(82.5, suggestion.Hydration)
+	suite.Equal(825.0, suggestion.Water)
 }
 
 func (suite *ApplicationTestSuite) TestApplication_FindFlourById() {
@@ -581,11 +1159,14 @@ func (suite *ApplicationTestSuite) TestApplication_FindFlour() {
 	suite.Require().NoError(err)
 	suite.Equal(http.StatusOK, clientResponse.StatusCode)
 
-	var actualResponse []domain.FlourDto
+	var actualResponse domain.FlourPageDto
 	err = json.NewDecoder(clientResponse.Body).Decode(&actualResponse)
 	suite.Require().NoError(err)
 
-	suite.Equal([]domain.FlourDto{expectedResponse}, actualResponse)
+	suite.Equal(domain.FlourPageDto{
+		Items:   []domain.FlourDto{expectedResponse},
+		PageDto: domain.PageDto{Total: 1},
+	}, actualResponse)
 }
 
 func (suite *ApplicationTestSuite) TestApplication_FindFlour_WithDefaultParameters() {
@@ -596,11 +1177,12 @@ func (suite *ApplicationTestSuite) TestApplication_FindFlour_WithDefaultParamete
 	suite.Require().NoError(err)
 	suite.Equal(http.StatusOK, clientResponse.StatusCode)
 
-	actualResponse := make([]domain.FlourDto, 1)
+	var actualResponse domain.FlourPageDto
 	err = json.NewDecoder(clientResponse.Body).Decode(&actualResponse)
 	suite.Require().NoError(err)
 
-	suite.Equal([]domain.FlourDto{expectedResponse}, actualResponse)
+	suite.Equal([]domain.FlourDto{expectedResponse}, actualResponse.Items)
+	suite.Equal(int64(1), actualResponse.Total)
 }
 
 func (suite *ApplicationTestSuite) TestApplication_SearchFlour() {
@@ -710,6 +1292,10 @@ func (suite *ApplicationTestSuite) createConfigFile() string {
 			},
 		},
 		Database: dbConfig,
+		Storage: config.Storage{
+			Type:  config.StorageTypeLocal,
+			Local: config.LocalStorage{Path: tempDir + "/images"},
+		},
 	}
 
 	cfgBytes, err := yaml.Marshal(suite.config)
@@ -73,6 +73,10 @@ func (suite *DependencyTestSuite) createConfigFile() string {
 			},
 		},
 		Database: databaseConfig,
+		Storage: config.Storage{
+			Type:  config.StorageTypeLocal,
+			Local: config.LocalStorage{Path: tempDir + "/images"},
+		},
 	}
 
 	cfgBytes, err := yaml.Marshal(cfg)
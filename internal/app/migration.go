@@ -0,0 +1,104 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"dough-calculator/internal/app/dependency"
+	"dough-calculator/internal/domain"
+)
+
+const (
+	MigrationCommandUp     = "up"
+	MigrationCommandDown   = "down"
+	MigrationCommandStatus = "status"
+)
+
+type migrationRunner struct {
+	commonDependencyService    domain.CommonDependencyService
+	migrationDependencyService domain.MigrationDependencyService
+}
+
+func (runner *migrationRunner) Run(ctx context.Context, command string, steps int, out io.Writer) error {
+	switch command {
+	case MigrationCommandUp, MigrationCommandDown, MigrationCommandStatus:
+	default:
+		return errors.Errorf("unknown migration command '%s', use %s, %s or %s",
+			command, MigrationCommandUp, MigrationCommandDown, MigrationCommandStatus)
+	}
+
+	if err := runner.commonDependencyService.Initialize(ctx); err != nil {
+		return errors.Wrap(err, "failed to initialize common dependency service")
+	}
+
+	if embeddedDatabase := runner.commonDependencyService.EmbeddedDatabase(); embeddedDatabase != nil {
+		if err := embeddedDatabase.Close(); err != nil {
+			log.Error().Err(err).Msg("failed to close embedded database")
+		}
+		return errors.New("migrations need MongoDB, the embedded databases have no schema")
+	}
+
+	mongoDBService := runner.commonDependencyService.MongoDBService()
+	defer func() {
+		if err := mongoDBService.Disconnect(); err != nil {
+			log.Error().Err(err).Msg("failed to disconnect from mongodb")
+		}
+	}()
+
+	ctx = context.WithValue(ctx, "configManager", runner.commonDependencyService.ConfigManager())
+	ctx = context.WithValue(ctx, "mongoDBService", mongoDBService)
+
+	if err := runner.migrationDependencyService.Initialize(ctx); err != nil {
+		return errors.Wrap(err, "failed to initialize migration dependency service")
+	}
+	migrationService := runner.migrationDependencyService.Service()
+
+	switch command {
+	case MigrationCommandUp:
+		applied, err := migrationService.Up(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(out, "applied %d migration(s)\n", applied)
+		return err
+	case MigrationCommandDown:
+		reverted, err := migrationService.Down(ctx, steps)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(out, "reverted %d migration(s)\n", reverted)
+		return err
+	default:
+		statuses, err := migrationService.Status(ctx)
+		if err != nil {
+			return err
+		}
+		return printMigrationStatus(out, statuses)
+	}
+}
+
+func printMigrationStatus(out io.Writer, statuses []domain.MigrationStatus) error {
+	for _, status := range statuses {
+		appliedAt := "pending"
+		if status.AppliedAt != nil {
+			appliedAt = status.AppliedAt.Format(time.RFC3339)
+		}
+
+		if _, err := fmt.Fprintf(out, "%4d  %-25s  %s\n", status.Version, appliedAt, status.Description); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func NewMigrationRunner() domain.MigrationRunner {
+	return &migrationRunner{
+		commonDependencyService:    dependency.NewCommonDependencyService(),
+		migrationDependencyService: dependency.NewMigrationDependencyService(),
+	}
+}
@@ -0,0 +1,158 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	"dough-calculator/internal/test"
+)
+
+func TestMigrationRunnerTestSuite(t *testing.T) {
+	suite.Run(t, new(MigrationRunnerTestSuite))
+}
+
+type MigrationRunnerTestSuite struct {
+	test.GoMockTestSuite
+
+	ctx                        context.Context
+	configManager              *mocks.MockConfigManager
+	mongoDBService             *mocks.MockMongoDBService
+	embeddedDatabase           *mocks.MockEmbeddedDatabase
+	commonDependencyService    *mocks.MockCommonDependencyService
+	migrationService           *mocks.MockMigrationService
+	migrationDependencyService *mocks.MockMigrationDependencyService
+	out                        *bytes.Buffer
+
+	target domain.MigrationRunner
+}
+
+func (suite *MigrationRunnerTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.ctx = context.Background()
+	suite.configManager = mocks.NewMockConfigManager(suite.MockCtrl)
+	suite.mongoDBService = mocks.NewMockMongoDBService(suite.MockCtrl)
+	suite.embeddedDatabase = mocks.NewMockEmbeddedDatabase(suite.MockCtrl)
+	suite.commonDependencyService = mocks.NewMockCommonDependencyService(suite.MockCtrl)
+	suite.migrationService = mocks.NewMockMigrationService(suite.MockCtrl)
+	suite.migrationDependencyService = mocks.NewMockMigrationDependencyService(suite.MockCtrl)
+	suite.out = &bytes.Buffer{}
+
+	suite.target = &migrationRunner{
+		commonDependencyService:    suite.commonDependencyService,
+		migrationDependencyService: suite.migrationDependencyService,
+	}
+}
+
+func (suite *MigrationRunnerTestSuite) expectInitialize() {
+	suite.commonDependencyService.EXPECT().Initialize(suite.ctx).Return(nil)
+	suite.commonDependencyService.EXPECT().EmbeddedDatabase().Return(nil)
+	suite.commonDependencyService.EXPECT().MongoDBService().Return(suite.mongoDBService)
+	suite.commonDependencyService.EXPECT().ConfigManager().Return(suite.configManager)
+	suite.migrationDependencyService.EXPECT().Initialize(gomock.Any()).
+		DoAndReturn(func(ctx context.Context) error {
+			suite.Equal(suite.configManager, ctx.Value("configManager"))
+			suite.Equal(suite.mongoDBService, ctx.Value("mongoDBService"))
+			return nil
+		})
+	suite.migrationDependencyService.EXPECT().Service().Return(suite.migrationService)
+	suite.mongoDBService.EXPECT().Disconnect().Return(nil)
+}
+
+func (suite *MigrationRunnerTestSuite) TestRun_Up() {
+	suite.expectInitialize()
+	suite.migrationService.EXPECT().Up(gomock.Any()).Return(2, nil)
+
+	err := suite.target.Run(suite.ctx, MigrationCommandUp, 1, suite.out)
+
+	suite.NoError(err)
+	suite.Equal("applied 2 migration(s)\n", suite.out.String())
+}
+
+func (suite *MigrationRunnerTestSuite) TestRun_Down() {
+	suite.expectInitialize()
+	suite.migrationService.EXPECT().Down(gomock.Any(), 3).Return(1, nil)
+
+	err := suite.target.Run(suite.ctx, MigrationCommandDown, 3, suite.out)
+
+	suite.NoError(err)
+	suite.Equal("reverted 1 migration(s)\n", suite.out.String())
+}
+
+func (suite *MigrationRunnerTestSuite) TestRun_Status() {
+	appliedAt := test.Date
+	suite.expectInitialize()
+	suite.migrationService.EXPECT().Status(gomock.Any()).Return([]domain.MigrationStatus{
+		{Version: 1, Description: "create the indexes of every collection", AppliedAt: &appliedAt},
+		{Version: 2, Description: "next"},
+	}, nil)
+
+	err := suite.target.Run(suite.ctx, MigrationCommandStatus, 1, suite.out)
+
+	suite.NoError(err)
+	suite.Equal(
+		"   1  2020-01-25T01:01:01Z       create the indexes of every collection\n"+
+			"   2  pending                    next\n",
+		suite.out.String())
+}
+
+func (suite *MigrationRunnerTestSuite) TestRun_WithErrorOnMigration() {
+	suite.expectInitialize()
+	suite.migrationService.EXPECT().Up(gomock.Any()).Return(0, assert.AnError)
+
+	err := suite.target.Run(suite.ctx, MigrationCommandUp, 1, suite.out)
+
+	suite.ErrorIs(err, assert.AnError)
+	suite.Empty(suite.out.String())
+}
+
+func (suite *MigrationRunnerTestSuite) TestRun_WithUnknownCommand() {
+	err := suite.target.Run(suite.ctx, "sideways", 1, suite.out)
+
+	suite.ErrorContains(err, "unknown migration command 'sideways'")
+}
+
+func (suite *MigrationRunnerTestSuite) TestRun_WithEmbeddedDatabase() {
+	suite.commonDependencyService.EXPECT().Initialize(suite.ctx).Return(nil)
+	suite.commonDependencyService.EXPECT().EmbeddedDatabase().Return(suite.embeddedDatabase)
+	suite.embeddedDatabase.EXPECT().Close().Return(nil)
+
+	err := suite.target.Run(suite.ctx, MigrationCommandStatus, 1, suite.out)
+
+	suite.ErrorContains(err, "migrations need MongoDB")
+}
+
+func (suite *MigrationRunnerTestSuite) TestRun_WithErrorOnInitialize() {
+	suite.commonDependencyService.EXPECT().Initialize(suite.ctx).Return(assert.AnError)
+
+	err := suite.target.Run(suite.ctx, MigrationCommandUp, 1, suite.out)
+
+	suite.ErrorContains(err, "failed to initialize common dependency service")
+}
+
+func (suite *MigrationRunnerTestSuite) TestRun_WithErrorOnMigrationInitialize() {
+	suite.commonDependencyService.EXPECT().Initialize(suite.ctx).Return(nil)
+	suite.commonDependencyService.EXPECT().EmbeddedDatabase().Return(nil)
+	suite.commonDependencyService.EXPECT().MongoDBService().Return(suite.mongoDBService)
+	suite.commonDependencyService.EXPECT().ConfigManager().Return(suite.configManager)
+	suite.migrationDependencyService.EXPECT().Initialize(gomock.Any()).Return(assert.AnError)
+	suite.mongoDBService.EXPECT().Disconnect().Return(nil)
+
+	err := suite.target.Run(suite.ctx, MigrationCommandUp, 1, suite.out)
+
+	suite.ErrorContains(err, "failed to initialize migration dependency service")
+}
+
+func (suite *MigrationRunnerTestSuite) TestNewMigrationRunner() {
+	target := NewMigrationRunner().(*migrationRunner)
+
+	suite.NotNil(target.commonDependencyService)
+	suite.NotNil(target.migrationDependencyService)
+}
@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/domain"
+)
+
+// calculate runs the calculator service in process, so a recipe draft can
+// be checked without a server.
+func (cli *Cli) calculate(ctx context.Context, args []string) error {
+	flagSet := cli.newFlagSet("calculate")
+	file := flagSet.String("f", "", "JSON or YAML file of the recipe draft, - for standard input")
+	weight := flagSet.Int("weight", 0, "final dough weight in grams to scale the recipe to")
+	if _, err := cli.parse(flagSet, args); err != nil {
+		return err
+	}
+
+	var request domain.CalculateSourdoughRecipeRequest
+	if err := cli.decodeInput(*file, &request); err != nil {
+		return err
+	}
+	if *weight < 0 {
+		return errors.Wrap(errUsage, "--weight must not be negative")
+	}
+	if *weight > 0 {
+		request.FinalDoughWeight = weight
+	}
+
+	calculator, err := cli.calculatorCreator()
+	if err != nil {
+		return errors.Wrap(err, "failed to create calculator")
+	}
+
+	recipe, err := calculator.CalculateSourdough(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	return cli.print(recipe, func() table { return recipeTable(recipe) })
+}
@@ -0,0 +1,295 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/invopop/yaml"
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/client"
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/service"
+)
+
+const usage = `doughctl talks to a dough calculator server.
+
+Usage:
+  doughctl [flags] <command> <subcommand> [arguments] [flags]
+
+Commands:
+  flour list|get|create              list, show or create flours
+  flour export|import                move the flour catalogue as a CSV file
+  recipe list|get|create             list, show or create sourdough recipes
+  recipe scale <id> --weight <g>     scale a recipe to a final dough weight
+  recipe export|import               move recipes and their flours as a bundle
+  calculate -f <file> [--weight <g>] calculate a recipe draft locally, without a server
+  profile list|use|delete            manage the servers doughctl talks to
+  profile set <name> --server <url>  add a profile or change its server
+
+Flags:
+  --profile <name>  profile to use instead of the current one
+  --url <url>       server url, overrides the profile
+  -o <format>       output format: table (default), json or yaml
+  --config <path>   profiles file, defaults to doughctl/config.yaml in the user config directory
+
+Files given with -f are read as JSON or YAML, as CSV by flour import, - reads standard input.
+`
+
+// errUsage reports a command line that names no command or misses
+// arguments; the usage is printed with it.
+var errUsage = errors.New("invalid usage")
+
+// options are the flags accepted by every command.
+type options struct {
+	profile string
+	url     string
+	output  string
+	config  string
+}
+
+// Cli runs doughctl commands. Commands talking to a server use the client
+// created for the selected profile, calculate runs the calculator service
+// in process.
+type Cli struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+
+	clientCreator     func(baseUrl string) (domain.DoughClient, error)
+	calculatorCreator func() (domain.CalculatorService, error)
+
+	options options
+}
+
+type commandFunc func(ctx context.Context, args []string) error
+
+// Run executes the command line args, without the program name, and
+// returns the process exit code.
+func (cli *Cli) Run(ctx context.Context, args []string) int {
+	err := cli.run(ctx, args)
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, flag.ErrHelp):
+		return 0
+	case errors.Is(err, errUsage):
+		_, _ = fmt.Fprintf(cli.stderr, "error: %v\n\n%s", err, usage)
+		return 2
+	default:
+		_, _ = fmt.Fprintf(cli.stderr, "error: %v\n", err)
+		return 1
+	}
+}
+
+func (cli *Cli) run(ctx context.Context, args []string) error {
+	flagSet := cli.newFlagSet("doughctl")
+	flagSet.Usage = func() { _, _ = fmt.Fprint(cli.stderr, usage) }
+	if err := flagSet.Parse(args); err != nil {
+		return err
+	}
+
+	commands := map[string]map[string]commandFunc{
+		"flour": {
+			"list":   cli.flourList,
+			"get":    cli.flourGet,
+			"create": cli.flourCreate,
+			"export": cli.flourExport,
+			"import": cli.flourImport,
+		},
+		"recipe": {
+			"list":   cli.recipeList,
+			"get":    cli.recipeGet,
+			"create": cli.recipeCreate,
+			"scale":  cli.recipeScale,
+			"export": cli.recipeExport,
+			"import": cli.recipeImport,
+		},
+		"profile": {
+			"list":   cli.profileList,
+			"use":    cli.profileUse,
+			"set":    cli.profileSet,
+			"delete": cli.profileDelete,
+		},
+	}
+
+	rest := flagSet.Args()
+	if len(rest) == 0 {
+		return errors.Wrap(errUsage, "command is required")
+	}
+	if rest[0] == "calculate" {
+		return cli.calculate(ctx, rest[1:])
+	}
+
+	subcommands, ok := commands[rest[0]]
+	if !ok {
+		return errors.Wrapf(errUsage, "unknown command %q", rest[0])
+	}
+	if len(rest) < 2 {
+		return errors.Wrapf(errUsage, "%s needs one of %s", rest[0], strings.Join(sortedKeys(subcommands), ", "))
+	}
+	command, ok := subcommands[rest[1]]
+	if !ok {
+		return errors.Wrapf(errUsage, "unknown command %q %q", rest[0], rest[1])
+	}
+
+	return command(ctx, rest[2:])
+}
+
+// newFlagSet creates the flag set of a command with the common flags bound
+// to cli.options, keeping the values given before the command name.
+func (cli *Cli) newFlagSet(name string) *flag.FlagSet {
+	flagSet := flag.NewFlagSet(name, flag.ContinueOnError)
+	flagSet.SetOutput(cli.stderr)
+	flagSet.StringVar(&cli.options.profile, "profile", cli.options.profile, "profile to use instead of the current one")
+	flagSet.StringVar(&cli.options.url, "url", cli.options.url, "server url, overrides the profile")
+	flagSet.StringVar(&cli.options.output, "o", cli.options.output, "output format: table, json or yaml")
+	flagSet.StringVar(&cli.options.config, "config", cli.options.config, "profiles file")
+	return flagSet
+}
+
+// parse parses flags placed before, between and after the positional
+// arguments, which it returns. Exactly expected positional arguments are
+// required.
+func (cli *Cli) parse(flagSet *flag.FlagSet, args []string, expected ...string) ([]string, error) {
+	var positional []string
+	for {
+		if err := flagSet.Parse(args); err != nil {
+			return nil, err
+		}
+		args = flagSet.Args()
+		if len(args) == 0 {
+			break
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+
+	if err := validateOutput(cli.options.output); err != nil {
+		return nil, err
+	}
+	if len(positional) != len(expected) {
+		names := make([]string, len(expected))
+		for i, name := range expected {
+			names[i] = "<" + name + ">"
+		}
+		return nil, errors.Wrapf(errUsage, "%s expects arguments [%s], got %d",
+			flagSet.Name(), strings.Join(names, " "), len(positional))
+	}
+
+	return positional, nil
+}
+
+func (cli *Cli) client() (domain.DoughClient, error) {
+	baseUrl := cli.options.url
+	if baseUrl == "" {
+		profiles, _, err := cli.loadProfiles()
+		if err != nil {
+			return nil, err
+		}
+		profile, err := profiles.Resolve(cli.options.profile)
+		if err != nil {
+			return nil, err
+		}
+		baseUrl = profile.Url
+	}
+
+	return cli.clientCreator(baseUrl)
+}
+
+func (cli *Cli) loadProfiles() (Profiles, string, error) {
+	path := cli.options.config
+	if path == "" {
+		defaultPath, err := defaultProfilesPath()
+		if err != nil {
+			return Profiles{}, "", err
+		}
+		path = defaultPath
+	}
+
+	profiles, err := loadProfiles(path)
+	return profiles, path, err
+}
+
+func (cli *Cli) print(value any, toTable func() table) error {
+	return printResult(cli.stdout, cli.options.output, value, toTable)
+}
+
+// readInput reads the file at path, standard input for -.
+func (cli *Cli) readInput(path string) ([]byte, error) {
+	if path == "" {
+		return nil, errors.Wrap(errUsage, "-f <file> is required")
+	}
+	if path == "-" {
+		content, err := io.ReadAll(cli.stdin)
+		return content, errors.Wrap(err, "failed to read standard input")
+	}
+
+	content, err := os.ReadFile(path)
+	return content, errors.Wrapf(err, "failed to read %s", path)
+}
+
+// decodeInput reads the JSON or YAML file at path into value, using the
+// field names of the API.
+func (cli *Cli) decodeInput(path string, value any) error {
+	content, err := cli.readInput(path)
+	if err != nil {
+		return err
+	}
+	if err := yaml.Unmarshal(content, value); err != nil {
+		return errors.Wrapf(err, "failed to parse %s", path)
+	}
+	return nil
+}
+
+func isJson(content []byte) bool {
+	trimmed := bytes.TrimSpace(content)
+	return len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[')
+}
+
+func sortedKeys[T any](values map[string]T) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// stringList is a flag that may be repeated, each occurrence adds a value.
+type stringList []string
+
+func (list *stringList) String() string {
+	return strings.Join(*list, ",")
+}
+
+func (list *stringList) Set(value string) error {
+	*list = append(*list, value)
+	return nil
+}
+
+func NewCli(stdin io.Reader, stdout, stderr io.Writer) *Cli {
+	return newCli(stdin, stdout, stderr, client.NewDoughClient, service.NewCalculatorService)
+}
+
+func newCli(
+	stdin io.Reader,
+	stdout, stderr io.Writer,
+	clientCreator func(baseUrl string) (domain.DoughClient, error),
+	calculatorCreator func() (domain.CalculatorService, error),
+) *Cli {
+	return &Cli{
+		stdin:             stdin,
+		stdout:            stdout,
+		stderr:            stderr,
+		clientCreator:     clientCreator,
+		calculatorCreator: calculatorCreator,
+		options:           options{output: outputTable},
+	}
+}
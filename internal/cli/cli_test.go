@@ -0,0 +1,346 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/service"
+	"dough-calculator/internal/test"
+)
+
+func TestCliTestSuite(t *testing.T) {
+	suite.Run(t, new(CliTestSuite))
+}
+
+type CliTestSuite struct {
+	test.GoMockTestSuite
+
+	client *mocks.MockDoughClient
+
+	dir     string
+	config  string
+	baseUrl string
+	stdin   *bytes.Buffer
+	stdout  *bytes.Buffer
+	stderr  *bytes.Buffer
+}
+
+func (suite *CliTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.client = mocks.NewMockDoughClient(suite.MockCtrl)
+
+	suite.dir = suite.T().TempDir()
+	suite.config = filepath.Join(suite.dir, "config.yaml")
+	suite.baseUrl = ""
+	suite.stdin = &bytes.Buffer{}
+	suite.stdout = &bytes.Buffer{}
+	suite.stderr = &bytes.Buffer{}
+}
+
+func (suite *CliTestSuite) run(args ...string) int {
+	target := newCli(suite.stdin, suite.stdout, suite.stderr,
+		func(baseUrl string) (domain.DoughClient, error) {
+			suite.baseUrl = baseUrl
+			return suite.client, nil
+		},
+		service.NewCalculatorService,
+	)
+
+	return target.Run(context.Background(), append([]string{"--config", suite.config}, args...))
+}
+
+func (suite *CliTestSuite) writeFile(name, content string) string {
+	path := filepath.Join(suite.dir, name)
+	suite.Require().NoError(os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func (suite *CliTestSuite) TestFlourList() {
+	suite.client.EXPECT().
+		FindFlours(gomock.Any(), url.Values{"flour_type": {"wheat", "rye"}, "limit": {"2"}}).
+		Return(domain.FlourPageDto{
+			Items: []domain.FlourDto{
+				{Id: test.FirstId, Name: "Bread flour", FlourType: "wheat", ProteinContent: 12.5, AshContent: 0.55},
+				{Id: test.SecondId, Name: "Dark rye", FlourType: "rye", ProteinContent: 9},
+			},
+			PageDto: domain.PageDto{Total: 5, NextCursor: "abc"},
+		}, nil)
+
+	code := suite.run("flour", "list", "--type", "wheat", "--limit", "2", "--type", "rye")
+
+	suite.Equal(0, code, suite.stderr.String())
+	suite.Equal(defaultProfileUrl, suite.baseUrl)
+	suite.Equal(strings.Join([]string{
+		"ID                                    NAME         TYPE   PROTEIN %  ASH %",
+		test.FirstId.String() + "  Bread flour  wheat  12.5       0.6",
+		test.SecondId.String() + "  Dark rye     rye    9          0",
+		"",
+		"5 in total, next page: --cursor abc",
+		"",
+	}, "\n"), suite.stdout.String())
+}
+
+func (suite *CliTestSuite) TestRecipeGet() {
+	recipe := domain.SourdoughRecipeDto{
+		RecipeDto: domain.RecipeDto{Id: test.FirstId, Name: "Country loaf", Version: 2},
+	}
+	suite.client.EXPECT().FindRecipeById(gomock.Any(), test.FirstId).Return(recipe, nil).Times(2)
+
+	suite.Run("json", func() {
+		suite.stdout.Reset()
+
+		code := suite.run("recipe", "get", test.FirstId.String(), "-o", "json")
+
+		suite.Equal(0, code, suite.stderr.String())
+		var actual domain.SourdoughRecipeDto
+		suite.Require().NoError(json.Unmarshal(suite.stdout.Bytes(), &actual))
+		suite.Equal(recipe.Id, actual.Id)
+		suite.Equal(recipe.Version, actual.Version)
+	})
+
+	suite.Run("yaml", func() {
+		suite.stdout.Reset()
+
+		code := suite.run("-o", "yaml", "recipe", "get", test.FirstId.String())
+
+		suite.Equal(0, code, suite.stderr.String())
+		suite.Contains(suite.stdout.String(), "name: Country loaf\n")
+		suite.Contains(suite.stdout.String(), "version: 2\n")
+	})
+}
+
+func (suite *CliTestSuite) TestRecipeCreate() {
+	file := suite.writeFile("recipe.yaml", "name: Country loaf\nflour:\n  - name: Bread flour\n    amount: 1000\n")
+
+	suite.client.EXPECT().CreateRecipe(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request domain.CreateSourdoughRecipeRequest) (domain.SourdoughRecipeDto, error) {
+			suite.Equal("Country loaf", request.Name)
+			suite.Equal(1000.0, request.Flour[0].Amount)
+			return domain.SourdoughRecipeDto{RecipeDto: domain.RecipeDto{Id: test.FirstId, Name: request.Name}}, nil
+		})
+
+	code := suite.run("recipe", "create", "-f", file, "-o", "json")
+
+	suite.Equal(0, code, suite.stderr.String())
+	suite.Contains(suite.stdout.String(), test.FirstId.String())
+}
+
+func (suite *CliTestSuite) TestRecipeScale() {
+	suite.client.EXPECT().
+		ScaleRecipe(gomock.Any(), test.FirstId, domain.SourdoughRecipeScaleRequestDto{FinalDoughWeight: 1800}).
+		Return(domain.SourdoughRecipeDto{}, internalErrors.SourdoughRecipeNotFound("sourdough recipe not found"))
+
+	code := suite.run("recipe", "scale", test.FirstId.String(), "--weight", "1800")
+
+	suite.Equal(1, code)
+	suite.Equal("error: error code: 10001, error message: sourdough not found, "+
+		"error details: sourdough recipe not found\n", suite.stderr.String())
+}
+
+func (suite *CliTestSuite) TestRecipeExportAndImport() {
+	bundle := "schema_version: 1\nflours: []\nrecipes: []\n"
+	output := filepath.Join(suite.dir, "bundle.yaml")
+
+	suite.client.EXPECT().
+		ExportRecipes(gomock.Any(), url.Values{"id": {test.FirstId.String()}, "tag": {"rye"}}, true).
+		Return([]byte(bundle), nil)
+	suite.client.EXPECT().ImportRecipes(gomock.Any(), []byte(bundle), true, "skip").
+		Return(domain.RecipeBundleImportResultDto{
+			Recipes: []domain.RecipeBundleRecipeImportDto{
+				{SourceId: test.FirstId, Id: test.SecondId, Name: "Country loaf", Action: "created"},
+			},
+		}, nil)
+
+	code := suite.run("recipe", "export", "--id", test.FirstId.String(), "--tag", "rye", "-o", "yaml", "-f", output)
+	suite.Equal(0, code, suite.stderr.String())
+
+	content, err := os.ReadFile(output)
+	suite.Require().NoError(err)
+	suite.Equal(bundle, string(content))
+
+	code = suite.run("--profile", "default", "recipe", "import", "-f", output, "--on-conflict", "skip")
+	suite.Equal(0, code, suite.stderr.String())
+	suite.Contains(suite.stdout.String(), "recipe  "+test.FirstId.String()+"  "+test.SecondId.String()+"  Country loaf  created")
+}
+
+func (suite *CliTestSuite) TestFlourExportAndImport() {
+	csv := "id,name\n" + test.FirstId.String() + ",Bread flour\n"
+	output := filepath.Join(suite.dir, "flours.csv")
+	flourId := test.FirstId
+
+	suite.client.EXPECT().ExportFlours(gomock.Any()).Return([]byte(csv), nil)
+	suite.client.EXPECT().ImportFlours(gomock.Any(), []byte(csv), true).
+		Return(domain.FlourImportResultDto{
+			DryRun:  true,
+			Updated: 1,
+			Rows: []domain.FlourImportRowDto{
+				{Row: 2, Name: "Bread flour", Action: domain.FlourImportActionUpdated, FlourId: &flourId},
+			},
+		}, nil)
+
+	code := suite.run("flour", "export", "-f", output)
+	suite.Equal(0, code, suite.stderr.String())
+
+	content, err := os.ReadFile(output)
+	suite.Require().NoError(err)
+	suite.Equal(csv, string(content))
+
+	code = suite.run("flour", "import", "-f", output, "--dry-run")
+	suite.Equal(0, code, suite.stderr.String())
+	suite.Contains(suite.stdout.String(), "2    "+test.FirstId.String()+"  Bread flour  updated")
+}
+
+func (suite *CliTestSuite) TestCalculate() {
+	suite.stdin.WriteString(`{
+		"name": "Country loaf",
+		"flour": [{"name": "Bread flour", "amount": 900}, {"name": "Whole wheat", "amount": 100}],
+		"water": [{"amount": 750}],
+		"levain": {"amount": {"amount": 200}},
+		"additional_ingredients": [{"name": "salt", "amount": 20}],
+		"yield": {"unit": "loaf", "amount": 2}
+	}`)
+
+	code := suite.run("calculate", "-f", "-")
+
+	suite.Equal(0, code, suite.stderr.String())
+	suite.Equal(strings.Join([]string{
+		"Country loaf",
+		"hydration 75 %, yield 2 loaf",
+		"",
+		"INGREDIENT   GRAMS  BAKER %",
+		"Bread flour  900    90",
+		"Whole wheat  100    10",
+		"water        750    75",
+		"levain       200    20",
+		"salt         20     2",
+		"total        1970   ",
+		"",
+	}, "\n"), suite.stdout.String())
+}
+
+func (suite *CliTestSuite) TestCalculate_WithInvalidDraft() {
+	file := suite.writeFile("draft.yaml", "name: Empty\nflour: []\n")
+
+	code := suite.run("calculate", "-f", file)
+
+	suite.Equal(1, code)
+	suite.Contains(suite.stderr.String(), "flour amount must be greater than 0")
+}
+
+func (suite *CliTestSuite) TestProfiles() {
+	suite.Equal(0, suite.run("profile", "set", "prod", "--server", "https://bakery.example/v1"), suite.stderr.String())
+	suite.Equal(0, suite.run("profile", "use", "prod"), suite.stderr.String())
+
+	suite.client.EXPECT().FindFlourById(gomock.Any(), test.FirstId).Return(domain.FlourDto{Id: test.FirstId}, nil).Times(3)
+
+	suite.Equal(0, suite.run("flour", "get", test.FirstId.String()), suite.stderr.String())
+	suite.Equal("https://bakery.example/v1", suite.baseUrl)
+
+	suite.Equal(0, suite.run("flour", "get", test.FirstId.String(), "--profile", "default"), suite.stderr.String())
+	suite.Equal(defaultProfileUrl, suite.baseUrl)
+
+	suite.Equal(0, suite.run("--url", "http://localhost:9090/v1", "flour", "get", test.FirstId.String()))
+	suite.Equal("http://localhost:9090/v1", suite.baseUrl)
+
+	suite.Equal(1, suite.run("profile", "delete", "prod"))
+	suite.Contains(suite.stderr.String(), `profile "prod" is the current profile`)
+
+	suite.stdout.Reset()
+	suite.Equal(0, suite.run("profile", "list"), suite.stderr.String())
+	suite.Equal(strings.Join([]string{
+		"CURRENT  NAME     URL",
+		"         default  " + defaultProfileUrl,
+		"*        prod     https://bakery.example/v1",
+		"",
+	}, "\n"), suite.stdout.String())
+
+	suite.Equal(0, suite.run("profile", "use", "default"), suite.stderr.String())
+	suite.Equal(0, suite.run("profile", "delete", "prod"), suite.stderr.String())
+
+	profiles, err := loadProfiles(suite.config)
+	suite.Require().NoError(err)
+	suite.Equal(defaultProfiles(), profiles)
+}
+
+func (suite *CliTestSuite) TestRun_WithInvalidUsage() {
+	tests := []struct {
+		name          string
+		args          []string
+		expectedCode  int
+		expectedError string
+	}{
+		{name: "no command", args: nil, expectedCode: 2, expectedError: "command is required"},
+		{name: "unknown command", args: []string{"bake"}, expectedCode: 2, expectedError: `unknown command "bake"`},
+		{
+			name:          "missing subcommand",
+			args:          []string{"flour"},
+			expectedCode:  2,
+			expectedError: "flour needs one of create, export, get, import, list",
+		},
+		{
+			name:          "missing argument",
+			args:          []string{"recipe", "get"},
+			expectedCode:  2,
+			expectedError: "recipe get expects arguments [<id>], got 0",
+		},
+		{
+			name:          "missing weight",
+			args:          []string{"recipe", "scale", test.FirstId.String()},
+			expectedCode:  2,
+			expectedError: "--weight must be greater than 0",
+		},
+		{
+			name:          "missing file",
+			args:          []string{"recipe", "create"},
+			expectedCode:  2,
+			expectedError: "-f <file> is required",
+		},
+		{name: "invalid id", args: []string{"flour", "get", "abc"}, expectedCode: 1, expectedError: "id abc is not valid"},
+		{
+			name:          "invalid output",
+			args:          []string{"flour", "list", "-o", "xml"},
+			expectedCode:  1,
+			expectedError: `output "xml" is not one of table, json, yaml`,
+		},
+		{
+			name:          "unknown profile",
+			args:          []string{"--profile", "staging", "flour", "list"},
+			expectedCode:  1,
+			expectedError: `profile "staging" does not exist`,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.stderr.Reset()
+
+			code := suite.run(tt.args...)
+
+			suite.Equal(tt.expectedCode, code)
+			suite.Contains(suite.stderr.String(), "error: "+tt.expectedError)
+		})
+	}
+}
+
+func TestLoadProfiles_WithInvalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte("profiles: ["), 0o600))
+
+	_, err := loadProfiles(path)
+
+	assert.ErrorContains(t, err, "failed to parse profiles from "+path)
+}
@@ -0,0 +1,177 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/domain"
+)
+
+func (cli *Cli) flourList(ctx context.Context, args []string) error {
+	flagSet := cli.newFlagSet("flour list")
+	var flourTypes stringList
+	flagSet.Var(&flourTypes, "type", "flour type code, may be repeated")
+	sort := flagSet.String("sort", "", "sort field: created, name or protein")
+	order := flagSet.String("order", "", "sort direction: asc or desc")
+	cursor := flagSet.String("cursor", "", "cursor of the page to show, printed below a table")
+	limit := flagSet.Int("limit", 0, "number of flours per page")
+	if _, err := cli.parse(flagSet, args); err != nil {
+		return err
+	}
+
+	query := url.Values{}
+	for _, flourType := range flourTypes {
+		query.Add("flour_type", flourType)
+	}
+	setQuery(query, "sort", *sort)
+	setQuery(query, "order", *order)
+	setQuery(query, "cursor", *cursor)
+	if *limit > 0 {
+		query.Set("limit", strconv.Itoa(*limit))
+	}
+
+	doughClient, err := cli.client()
+	if err != nil {
+		return err
+	}
+
+	page, err := doughClient.FindFlours(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	if err := cli.print(page, func() table { return flourTable(page.Items...) }); err != nil {
+		return err
+	}
+	return cli.printNextCursor(page.PageDto)
+}
+
+func (cli *Cli) flourGet(ctx context.Context, args []string) error {
+	positional, err := cli.parse(cli.newFlagSet("flour get"), args, "id")
+	if err != nil {
+		return err
+	}
+	id, err := parseId(positional[0])
+	if err != nil {
+		return err
+	}
+
+	doughClient, err := cli.client()
+	if err != nil {
+		return err
+	}
+
+	flour, err := doughClient.FindFlourById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return cli.print(flour, func() table { return flourTable(flour) })
+}
+
+func (cli *Cli) flourCreate(ctx context.Context, args []string) error {
+	flagSet := cli.newFlagSet("flour create")
+	file := flagSet.String("f", "", "JSON or YAML file of the flour, - for standard input")
+	if _, err := cli.parse(flagSet, args); err != nil {
+		return err
+	}
+
+	var request domain.CreateFlourRequest
+	if err := cli.decodeInput(*file, &request); err != nil {
+		return err
+	}
+
+	doughClient, err := cli.client()
+	if err != nil {
+		return err
+	}
+
+	flour, err := doughClient.CreateFlour(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	return cli.print(flour, func() table { return flourTable(flour) })
+}
+
+// flourExport writes the catalogue CSV as the server sends it, to standard
+// output or to the file given with -f.
+func (cli *Cli) flourExport(ctx context.Context, args []string) error {
+	flagSet := cli.newFlagSet("flour export")
+	file := flagSet.String("f", "", "file to write the CSV to instead of standard output")
+	if _, err := cli.parse(flagSet, args); err != nil {
+		return err
+	}
+
+	doughClient, err := cli.client()
+	if err != nil {
+		return err
+	}
+
+	content, err := doughClient.ExportFlours(ctx)
+	if err != nil {
+		return err
+	}
+
+	if *file == "" || *file == "-" {
+		_, err = cli.stdout.Write(content)
+		return err
+	}
+	return errors.Wrapf(os.WriteFile(*file, content, 0o644), "failed to write %s", *file)
+}
+
+func (cli *Cli) flourImport(ctx context.Context, args []string) error {
+	flagSet := cli.newFlagSet("flour import")
+	file := flagSet.String("f", "", "CSV file of flours, - for standard input")
+	dryRun := flagSet.Bool("dry-run", false, "report the outcome of each row without saving anything")
+	if _, err := cli.parse(flagSet, args); err != nil {
+		return err
+	}
+
+	content, err := cli.readInput(*file)
+	if err != nil {
+		return err
+	}
+
+	doughClient, err := cli.client()
+	if err != nil {
+		return err
+	}
+
+	result, err := doughClient.ImportFlours(ctx, content, *dryRun)
+	if err != nil {
+		return err
+	}
+
+	return cli.print(result, func() table { return flourImportTable(result) })
+}
+
+// printNextCursor tells how to fetch the next page below a table, JSON and
+// YAML output carry the cursor themselves.
+func (cli *Cli) printNextCursor(page domain.PageDto) error {
+	if cli.options.output != outputTable || page.NextCursor == "" {
+		return nil
+	}
+	_, err := fmt.Fprintf(cli.stdout, "\n%d in total, next page: --cursor %s\n", page.Total, page.NextCursor)
+	return err
+}
+
+func parseId(value string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, errors.Errorf("id %s is not valid", value)
+	}
+	return id, nil
+}
+
+func setQuery(query url.Values, key, value string) {
+	if value != "" {
+		query.Set(key, value)
+	}
+}
@@ -0,0 +1,204 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/google/uuid"
+	"github.com/invopop/yaml"
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/domain"
+)
+
+const (
+	outputTable = "table"
+	outputJson  = "json"
+	outputYaml  = "yaml"
+)
+
+// table is the human readable rendering of a result: optional title lines
+// followed by aligned columns.
+type table struct {
+	title   []string
+	headers []string
+	rows    [][]string
+}
+
+func validateOutput(output string) error {
+	switch output {
+	case outputTable, outputJson, outputYaml:
+		return nil
+	default:
+		return errors.Errorf("output %q is not one of table, json, yaml", output)
+	}
+}
+
+// printResult writes value as indented JSON or as YAML with the field names
+// of the API, or as the table built by toTable.
+func printResult(writer io.Writer, output string, value any, toTable func() table) error {
+	switch output {
+	case outputJson:
+		content, err := json.MarshalIndent(value, "", "  ")
+		if err != nil {
+			return errors.Wrap(err, "failed to encode result as JSON")
+		}
+		_, err = fmt.Fprintln(writer, string(content))
+		return err
+	case outputYaml:
+		content, err := yaml.Marshal(value)
+		if err != nil {
+			return errors.Wrap(err, "failed to encode result as YAML")
+		}
+		_, err = writer.Write(content)
+		return err
+	default:
+		return writeTable(writer, toTable())
+	}
+}
+
+func writeTable(writer io.Writer, result table) error {
+	for _, line := range result.title {
+		if _, err := fmt.Fprintln(writer, line); err != nil {
+			return err
+		}
+	}
+	if len(result.title) > 0 {
+		if _, err := fmt.Fprintln(writer); err != nil {
+			return err
+		}
+	}
+
+	tabWriter := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(tabWriter, strings.Join(result.headers, "\t")); err != nil {
+		return err
+	}
+	for _, row := range result.rows {
+		if _, err := fmt.Fprintln(tabWriter, strings.Join(row, "\t")); err != nil {
+			return err
+		}
+	}
+
+	return tabWriter.Flush()
+}
+
+func flourTable(flours ...domain.FlourDto) table {
+	result := table{headers: []string{"ID", "NAME", "TYPE", "PROTEIN %", "ASH %"}}
+	for _, flour := range flours {
+		result.rows = append(result.rows, []string{
+			flour.Id.String(),
+			flour.Name,
+			flour.FlourType,
+			formatNumber(flour.ProteinContent),
+			formatNumber(flour.AshContent),
+		})
+	}
+	return result
+}
+
+func recipeListTable(recipes []domain.SourdoughRecipeDto) table {
+	result := table{headers: []string{"ID", "NAME", "CATEGORY", "HYDRATION %", "WEIGHT G", "VERSION"}}
+	for _, recipe := range recipes {
+		result.rows = append(result.rows, []string{
+			recipe.Id.String(),
+			recipe.Name,
+			recipe.Category,
+			formatNumber(recipe.Details.Water.BakerPercentage),
+			strconv.Itoa(recipe.Details.TotalWeight),
+			strconv.Itoa(recipe.Version),
+		})
+	}
+	return result
+}
+
+// recipeTable lists every ingredient of the recipe with its weight and
+// baker percentage, followed by the total dough weight.
+func recipeTable(recipe domain.SourdoughRecipeDto) table {
+	title := []string{recipe.Name}
+	if recipe.Id != uuid.Nil {
+		title[0] = fmt.Sprintf("%s (%s)", recipe.Name, recipe.Id)
+	}
+	title = append(title, fmt.Sprintf("hydration %s %%, yield %d %s",
+		formatNumber(recipe.Details.Water.BakerPercentage), recipe.Yield.Amount, recipe.Yield.Unit))
+
+	result := table{title: title, headers: []string{"INGREDIENT", "GRAMS", "BAKER %"}}
+	addRow := func(name string, amount, percentage float64) {
+		result.rows = append(result.rows, []string{name, formatNumber(amount), formatNumber(percentage)})
+	}
+
+	flourAmount := recipe.Details.Flour.Amount
+	for _, flour := range recipe.Flour {
+		var percentage float64
+		if flourAmount > 0 {
+			percentage = flour.Amount / flourAmount * 100
+		}
+		addRow(flour.Name, flour.Amount, percentage)
+	}
+	for _, water := range recipe.Water {
+		addRow(ingredientName(water.Name, "water"), water.Amount, water.BakerPercentage)
+	}
+	if recipe.Levain.Amount.Amount > 0 {
+		addRow("levain", recipe.Levain.Amount.Amount, recipe.Levain.Amount.BakerPercentage)
+	}
+	for _, ingredient := range recipe.AdditionalIngredients {
+		addRow(ingredient.Name, ingredient.Amount, ingredient.BakerPercentage)
+	}
+	result.rows = append(result.rows, []string{"total", strconv.Itoa(recipe.Details.TotalWeight), ""})
+
+	return result
+}
+
+func recipeImportTable(result domain.RecipeBundleImportResultDto) table {
+	importTable := table{headers: []string{"KIND", "SOURCE ID", "ID", "NAME", "ACTION"}}
+	for _, flour := range result.Flours {
+		importTable.rows = append(importTable.rows,
+			[]string{"flour", flour.SourceId.String(), flour.Id.String(), flour.Name, flour.Action})
+	}
+	for _, recipe := range result.Recipes {
+		importTable.rows = append(importTable.rows,
+			[]string{"recipe", recipe.SourceId.String(), recipe.Id.String(), recipe.Name, recipe.Action})
+	}
+	return importTable
+}
+
+func flourImportTable(result domain.FlourImportResultDto) table {
+	importTable := table{headers: []string{"ROW", "ID", "NAME", "ACTION", "ERRORS"}}
+	for _, row := range result.Rows {
+		id := ""
+		if row.FlourId != nil {
+			id = row.FlourId.String()
+		}
+		importTable.rows = append(importTable.rows,
+			[]string{strconv.Itoa(row.Row), id, row.Name, row.Action, strings.Join(row.Errors, "; ")})
+	}
+	return importTable
+}
+
+func profileTable(profiles Profiles) table {
+	result := table{headers: []string{"CURRENT", "NAME", "URL"}}
+	for _, name := range profiles.Names() {
+		current := ""
+		if name == profiles.Current {
+			current = "*"
+		}
+		result.rows = append(result.rows, []string{current, name, profiles.Profiles[name].Url})
+	}
+	return result
+}
+
+func ingredientName(name, fallback string) string {
+	if strings.TrimSpace(name) == "" {
+		return fallback
+	}
+	return name
+}
+
+// formatNumber prints up to one decimal, dropping a trailing .0.
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(math.Round(value*10)/10, 'f', -1, 64)
+}
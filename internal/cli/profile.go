@@ -0,0 +1,192 @@
+package cli
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/invopop/yaml"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultProfileName = "default"
+	defaultProfileUrl  = "http://localhost:8080/v1"
+)
+
+// Profile points the CLI at one dough calculator server. Url is the server
+// address followed by the context path.
+type Profile struct {
+	Url string `json:"url"`
+}
+
+// Profiles is the CLI configuration file: the named servers and the one
+// used when no profile is given on the command line.
+type Profiles struct {
+	Current  string             `json:"current"`
+	Profiles map[string]Profile `json:"profiles"`
+}
+
+// Resolve returns the profile of the given name, the current profile when
+// name is empty.
+func (profiles Profiles) Resolve(name string) (Profile, error) {
+	if name == "" {
+		name = profiles.Current
+	}
+
+	profile, ok := profiles.Profiles[name]
+	if !ok {
+		return Profile{}, errors.Errorf("profile %q does not exist", name)
+	}
+
+	return profile, nil
+}
+
+// Names returns the profile names in alphabetical order.
+func (profiles Profiles) Names() []string {
+	names := make([]string, 0, len(profiles.Profiles))
+	for name := range profiles.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func defaultProfiles() Profiles {
+	return Profiles{
+		Current:  defaultProfileName,
+		Profiles: map[string]Profile{defaultProfileName: {Url: defaultProfileUrl}},
+	}
+}
+
+// defaultProfilesPath is doughctl/config.yaml in the user configuration
+// directory, e.g. ~/.config/doughctl/config.yaml on Linux.
+func defaultProfilesPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to find user configuration directory")
+	}
+	return filepath.Join(dir, "doughctl", "config.yaml"), nil
+}
+
+// loadProfiles reads the profiles file at path. A missing file yields the
+// default profile pointing at a local server.
+func loadProfiles(path string) (Profiles, error) {
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return defaultProfiles(), nil
+	}
+	if err != nil {
+		return Profiles{}, errors.Wrapf(err, "failed to read profiles from %s", path)
+	}
+
+	var profiles Profiles
+	if err := yaml.Unmarshal(content, &profiles); err != nil {
+		return Profiles{}, errors.Wrapf(err, "failed to parse profiles from %s", path)
+	}
+	if profiles.Profiles == nil {
+		profiles.Profiles = map[string]Profile{}
+	}
+
+	return profiles, nil
+}
+
+func saveProfiles(path string, profiles Profiles) error {
+	content, err := yaml.Marshal(profiles)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode profiles")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return errors.Wrapf(err, "failed to create directory of %s", path)
+	}
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		return errors.Wrapf(err, "failed to write profiles to %s", path)
+	}
+
+	return nil
+}
+
+func (cli *Cli) profileList(_ context.Context, args []string) error {
+	if _, err := cli.parse(cli.newFlagSet("profile list"), args); err != nil {
+		return err
+	}
+
+	profiles, _, err := cli.loadProfiles()
+	if err != nil {
+		return err
+	}
+
+	return cli.print(profiles, func() table { return profileTable(profiles) })
+}
+
+func (cli *Cli) profileUse(_ context.Context, args []string) error {
+	positional, err := cli.parse(cli.newFlagSet("profile use"), args, "name")
+	if err != nil {
+		return err
+	}
+
+	profiles, path, err := cli.loadProfiles()
+	if err != nil {
+		return err
+	}
+	if _, err := profiles.Resolve(positional[0]); err != nil {
+		return err
+	}
+	profiles.Current = positional[0]
+
+	return saveProfiles(path, profiles)
+}
+
+// profileSet creates or updates a profile. The first profile of an empty
+// file becomes the current one.
+func (cli *Cli) profileSet(_ context.Context, args []string) error {
+	flagSet := cli.newFlagSet("profile set")
+	profileUrl := flagSet.String("server", "", "server url followed by the context path, e.g. "+defaultProfileUrl)
+	positional, err := cli.parse(flagSet, args, "name")
+	if err != nil {
+		return err
+	}
+	if *profileUrl == "" {
+		return errors.Wrap(errUsage, "--server is required")
+	}
+	parsed, err := url.Parse(*profileUrl)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return errors.Errorf("server url %q must be an http or https url", *profileUrl)
+	}
+
+	profiles, path, err := cli.loadProfiles()
+	if err != nil {
+		return err
+	}
+	profiles.Profiles[positional[0]] = Profile{Url: *profileUrl}
+	if profiles.Current == "" {
+		profiles.Current = positional[0]
+	}
+
+	return saveProfiles(path, profiles)
+}
+
+// profileDelete removes a profile, the current profile cannot be deleted.
+func (cli *Cli) profileDelete(_ context.Context, args []string) error {
+	positional, err := cli.parse(cli.newFlagSet("profile delete"), args, "name")
+	if err != nil {
+		return err
+	}
+
+	profiles, path, err := cli.loadProfiles()
+	if err != nil {
+		return err
+	}
+	if _, err := profiles.Resolve(positional[0]); err != nil {
+		return err
+	}
+	if positional[0] == profiles.Current {
+		return errors.Errorf("profile %q is the current profile, switch to another one first", positional[0])
+	}
+	delete(profiles.Profiles, positional[0])
+
+	return saveProfiles(path, profiles)
+}
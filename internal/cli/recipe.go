@@ -0,0 +1,202 @@
+package cli
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/domain"
+)
+
+func (cli *Cli) recipeList(ctx context.Context, args []string) error {
+	flagSet := cli.newFlagSet("recipe list")
+	var tags, categories stringList
+	flagSet.Var(&tags, "tag", "tag every recipe must carry, may be repeated")
+	flagSet.Var(&categories, "category", "category of the recipes, may be repeated")
+	sort := flagSet.String("sort", "", "sort field: created, updated, name, hydration or total_weight")
+	order := flagSet.String("order", "", "sort direction: asc or desc")
+	cursor := flagSet.String("cursor", "", "cursor of the page to show, printed below a table")
+	limit := flagSet.Int("limit", 0, "number of recipes per page")
+	if _, err := cli.parse(flagSet, args); err != nil {
+		return err
+	}
+
+	query := recipeFilterQuery(tags, categories)
+	setQuery(query, "sort", *sort)
+	setQuery(query, "order", *order)
+	setQuery(query, "cursor", *cursor)
+	if *limit > 0 {
+		query.Set("limit", strconv.Itoa(*limit))
+	}
+
+	doughClient, err := cli.client()
+	if err != nil {
+		return err
+	}
+
+	result, err := doughClient.FindRecipes(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	if err := cli.print(result, func() table { return recipeListTable(result.Items) }); err != nil {
+		return err
+	}
+	return cli.printNextCursor(result.PageDto)
+}
+
+func (cli *Cli) recipeGet(ctx context.Context, args []string) error {
+	positional, err := cli.parse(cli.newFlagSet("recipe get"), args, "id")
+	if err != nil {
+		return err
+	}
+	id, err := parseId(positional[0])
+	if err != nil {
+		return err
+	}
+
+	doughClient, err := cli.client()
+	if err != nil {
+		return err
+	}
+
+	recipe, err := doughClient.FindRecipeById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return cli.print(recipe, func() table { return recipeTable(recipe) })
+}
+
+func (cli *Cli) recipeCreate(ctx context.Context, args []string) error {
+	flagSet := cli.newFlagSet("recipe create")
+	file := flagSet.String("f", "", "JSON or YAML file of the recipe, - for standard input")
+	if _, err := cli.parse(flagSet, args); err != nil {
+		return err
+	}
+
+	var request domain.CreateSourdoughRecipeRequest
+	if err := cli.decodeInput(*file, &request); err != nil {
+		return err
+	}
+
+	doughClient, err := cli.client()
+	if err != nil {
+		return err
+	}
+
+	recipe, err := doughClient.CreateRecipe(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	return cli.print(recipe, func() table { return recipeTable(recipe) })
+}
+
+func (cli *Cli) recipeScale(ctx context.Context, args []string) error {
+	flagSet := cli.newFlagSet("recipe scale")
+	weight := flagSet.Int("weight", 0, "final dough weight in grams")
+	positional, err := cli.parse(flagSet, args, "id")
+	if err != nil {
+		return err
+	}
+	id, err := parseId(positional[0])
+	if err != nil {
+		return err
+	}
+	if *weight <= 0 {
+		return errors.Wrap(errUsage, "--weight must be greater than 0")
+	}
+
+	doughClient, err := cli.client()
+	if err != nil {
+		return err
+	}
+
+	recipe, err := doughClient.ScaleRecipe(ctx, id, domain.SourdoughRecipeScaleRequestDto{FinalDoughWeight: *weight})
+	if err != nil {
+		return err
+	}
+
+	return cli.print(recipe, func() table { return recipeTable(recipe) })
+}
+
+// recipeExport writes the bundle as the server sends it, YAML with -o yaml
+// and JSON otherwise, to standard output or to the file given with -f.
+func (cli *Cli) recipeExport(ctx context.Context, args []string) error {
+	flagSet := cli.newFlagSet("recipe export")
+	var ids, tags, categories stringList
+	flagSet.Var(&ids, "id", "id of a recipe to export, may be repeated")
+	flagSet.Var(&tags, "tag", "tag every exported recipe must carry, may be repeated")
+	flagSet.Var(&categories, "category", "category of the exported recipes, may be repeated")
+	file := flagSet.String("f", "", "file to write the bundle to instead of standard output")
+	if _, err := cli.parse(flagSet, args); err != nil {
+		return err
+	}
+
+	query := recipeFilterQuery(tags, categories)
+	for _, id := range ids {
+		if _, err := parseId(id); err != nil {
+			return err
+		}
+		query.Add("id", id)
+	}
+
+	doughClient, err := cli.client()
+	if err != nil {
+		return err
+	}
+
+	bundle, err := doughClient.ExportRecipes(ctx, query, cli.options.output == outputYaml)
+	if err != nil {
+		return err
+	}
+
+	if *file == "" || *file == "-" {
+		_, err = cli.stdout.Write(bundle)
+		return err
+	}
+	return errors.Wrapf(os.WriteFile(*file, bundle, 0o644), "failed to write %s", *file)
+}
+
+// recipeImport sends the bundle file as it is, as JSON when it looks like a
+// JSON document and as YAML otherwise.
+func (cli *Cli) recipeImport(ctx context.Context, args []string) error {
+	flagSet := cli.newFlagSet("recipe import")
+	file := flagSet.String("f", "", "JSON or YAML bundle file, - for standard input")
+	onConflict := flagSet.String("on-conflict", "", "what to do with a recipe whose name is taken: rename, skip or replace")
+	if _, err := cli.parse(flagSet, args); err != nil {
+		return err
+	}
+
+	bundle, err := cli.readInput(*file)
+	if err != nil {
+		return err
+	}
+
+	doughClient, err := cli.client()
+	if err != nil {
+		return err
+	}
+
+	result, err := doughClient.ImportRecipes(ctx, bundle, !isJson(bundle), *onConflict)
+	if err != nil {
+		return err
+	}
+
+	return cli.print(result, func() table { return recipeImportTable(result) })
+}
+
+func recipeFilterQuery(tags, categories []string) url.Values {
+	query := url.Values{}
+	for _, tag := range tags {
+		query.Add("tag", tag)
+	}
+	for _, category := range categories {
+		query.Add("category", category)
+	}
+	return query
+}
@@ -0,0 +1,293 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+)
+
+const (
+	jsonContentType = "application/json"
+	yamlContentType = "application/yaml"
+	csvContentType  = "text/csv"
+)
+
+// defaultTimeout bounds a single API call, a bundle export of the whole
+// catalogue is the slowest of them.
+const defaultTimeout = 30 * time.Second
+
+type doughClient struct {
+	baseUrl    string
+	httpClient *http.Client
+}
+
+func (client *doughClient) FindFlours(ctx context.Context, query url.Values) (domain.FlourPageDto, error) {
+	var page domain.FlourPageDto
+	err := client.doJson(ctx, http.MethodGet, "/flour", query, nil, &page)
+	return page, err
+}
+
+func (client *doughClient) FindFlourById(ctx context.Context, id uuid.UUID) (domain.FlourDto, error) {
+	var flour domain.FlourDto
+	err := client.doJson(ctx, http.MethodGet, "/flour/"+id.String(), nil, nil, &flour)
+	return flour, err
+}
+
+func (client *doughClient) CreateFlour(ctx context.Context, request domain.CreateFlourRequest) (domain.FlourDto, error) {
+	var flour domain.FlourDto
+	err := client.doJson(ctx, http.MethodPost, "/flour", nil, request, &flour)
+	return flour, err
+}
+
+func (client *doughClient) ExportFlours(ctx context.Context) ([]byte, error) {
+	req, err := client.newRequest(ctx, http.MethodGet, "/flour/export.csv", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", csvContentType)
+
+	return client.do(req)
+}
+
+// ImportFlours uploads csv as the file field of a multipart form, the way
+// the import endpoint expects it.
+func (client *doughClient) ImportFlours(
+	ctx context.Context,
+	csv []byte,
+	dryRun bool,
+) (domain.FlourImportResultDto, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", "flours.csv")
+	if err != nil {
+		return domain.FlourImportResultDto{}, errors.Wrap(err, "failed to encode flour file")
+	}
+	if _, err := part.Write(csv); err != nil {
+		return domain.FlourImportResultDto{}, errors.Wrap(err, "failed to encode flour file")
+	}
+	if err := writer.Close(); err != nil {
+		return domain.FlourImportResultDto{}, errors.Wrap(err, "failed to encode flour file")
+	}
+
+	query := url.Values{}
+	if dryRun {
+		query.Set("dry_run", "true")
+	}
+
+	req, err := client.newRequest(ctx, http.MethodPost, "/flour/import", query, &body)
+	if err != nil {
+		return domain.FlourImportResultDto{}, err
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("Accept", jsonContentType)
+
+	content, err := client.do(req)
+	if err != nil {
+		return domain.FlourImportResultDto{}, err
+	}
+
+	var result domain.FlourImportResultDto
+	if err := json.Unmarshal(content, &result); err != nil {
+		return domain.FlourImportResultDto{}, errors.Wrap(err, "failed to decode import result")
+	}
+
+	return result, nil
+}
+
+func (client *doughClient) FindRecipes(
+	ctx context.Context,
+	query url.Values,
+) (domain.SourdoughRecipeSearchResultDto, error) {
+	var result domain.SourdoughRecipeSearchResultDto
+	err := client.doJson(ctx, http.MethodGet, "/recipe/sourdough", query, nil, &result)
+	return result, err
+}
+
+func (client *doughClient) FindRecipeById(ctx context.Context, id uuid.UUID) (domain.SourdoughRecipeDto, error) {
+	var recipe domain.SourdoughRecipeDto
+	err := client.doJson(ctx, http.MethodGet, "/recipe/sourdough/"+id.String(), nil, nil, &recipe)
+	return recipe, err
+}
+
+func (client *doughClient) CreateRecipe(
+	ctx context.Context,
+	request domain.CreateSourdoughRecipeRequest,
+) (domain.SourdoughRecipeDto, error) {
+	var recipe domain.SourdoughRecipeDto
+	err := client.doJson(ctx, http.MethodPost, "/recipe/sourdough", nil, request, &recipe)
+	return recipe, err
+}
+
+func (client *doughClient) ScaleRecipe(
+	ctx context.Context,
+	id uuid.UUID,
+	request domain.SourdoughRecipeScaleRequestDto,
+) (domain.SourdoughRecipeDto, error) {
+	var recipe domain.SourdoughRecipeDto
+	err := client.doJson(ctx, http.MethodPost, "/recipe/sourdough/"+id.String()+"/scale", nil, request, &recipe)
+	return recipe, err
+}
+
+func (client *doughClient) ExportRecipes(ctx context.Context, query url.Values, asYaml bool) ([]byte, error) {
+	req, err := client.newRequest(ctx, http.MethodGet, "/recipe/sourdough/export", query, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", contentType(asYaml))
+
+	return client.do(req)
+}
+
+func (client *doughClient) ImportRecipes(
+	ctx context.Context,
+	bundle []byte,
+	asYaml bool,
+	onConflict string,
+) (domain.RecipeBundleImportResultDto, error) {
+	query := url.Values{}
+	if onConflict != "" {
+		query.Set("on_conflict", onConflict)
+	}
+
+	req, err := client.newRequest(ctx, http.MethodPost, "/recipe/sourdough/import", query, bytes.NewReader(bundle))
+	if err != nil {
+		return domain.RecipeBundleImportResultDto{}, err
+	}
+	req.Header.Set("Content-Type", contentType(asYaml))
+	req.Header.Set("Accept", jsonContentType)
+
+	content, err := client.do(req)
+	if err != nil {
+		return domain.RecipeBundleImportResultDto{}, err
+	}
+
+	var result domain.RecipeBundleImportResultDto
+	if err := json.Unmarshal(content, &result); err != nil {
+		return domain.RecipeBundleImportResultDto{}, errors.Wrap(err, "failed to decode import result")
+	}
+
+	return result, nil
+}
+
+func (client *doughClient) doJson(
+	ctx context.Context,
+	method, path string,
+	query url.Values,
+	request, response any,
+) error {
+	var body io.Reader
+	if request != nil {
+		content, err := json.Marshal(request)
+		if err != nil {
+			return errors.Wrap(err, "failed to encode request")
+		}
+		body = bytes.NewReader(content)
+	}
+
+	req, err := client.newRequest(ctx, method, path, query, body)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", jsonContentType)
+	}
+	req.Header.Set("Accept", jsonContentType)
+
+	content, err := client.do(req)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(content, response); err != nil {
+		return errors.Wrapf(err, "failed to decode response of %s %s", method, path)
+	}
+
+	return nil
+}
+
+func (client *doughClient) newRequest(
+	ctx context.Context,
+	method, path string,
+	query url.Values,
+	body io.Reader,
+) (*http.Request, error) {
+	target := client.baseUrl + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, target, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create request %s %s", method, path)
+	}
+
+	return req, nil
+}
+
+// do sends the request and returns the response body. A response with an
+// error status is turned into the service error it carries.
+func (client *doughClient) do(req *http.Request) ([]byte, error) {
+	resp, err := client.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to call %s %s", req.Method, req.URL.Path)
+	}
+	defer resp.Body.Close()
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read response of %s %s", req.Method, req.URL.Path)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, responseError(resp.StatusCode, content)
+	}
+
+	return content, nil
+}
+
+func responseError(statusCode int, content []byte) error {
+	var serviceError internalErrors.ServiceError
+	if err := json.Unmarshal(content, &serviceError); err != nil || serviceError.Message == "" {
+		return internalErrors.NewServiceError(
+			statusCode, -1, http.StatusText(statusCode), strings.TrimSpace(string(content)))
+	}
+	serviceError.ResponseCode = statusCode
+
+	return &serviceError
+}
+
+func contentType(asYaml bool) string {
+	if asYaml {
+		return yamlContentType
+	}
+	return jsonContentType
+}
+
+// NewDoughClient creates a client of the API served at baseUrl, the server
+// address followed by the context path, e.g. http://localhost:8080/v1.
+func NewDoughClient(baseUrl string) (domain.DoughClient, error) {
+	parsed, err := url.Parse(baseUrl)
+	if err != nil {
+		return nil, errors.Wrap(err, "base url is not valid")
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return nil, errors.Errorf("base url %q must be an http or https url", baseUrl)
+	}
+
+	return &doughClient{
+		baseUrl:    strings.TrimRight(baseUrl, "/"),
+		httpClient: &http.Client{Timeout: defaultTimeout},
+	}, nil
+}
@@ -0,0 +1,250 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func TestDoughClientTestSuite(t *testing.T) {
+	suite.Run(t, new(DoughClientTestSuite))
+}
+
+type DoughClientTestSuite struct {
+	suite.Suite
+
+	handler http.HandlerFunc
+	server  *httptest.Server
+
+	target domain.DoughClient
+}
+
+func (suite *DoughClientTestSuite) SetupTest() {
+	suite.server = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		suite.handler(res, req)
+	}))
+
+	suite.target = test.Must(func() (domain.DoughClient, error) {
+		return NewDoughClient(suite.server.URL + "/v1/")
+	})
+}
+
+func (suite *DoughClientTestSuite) TearDownTest() {
+	suite.server.Close()
+}
+
+func (suite *DoughClientTestSuite) TestFindFlours() {
+	suite.handler = func(res http.ResponseWriter, req *http.Request) {
+		suite.Equal(http.MethodGet, req.Method)
+		suite.Equal("/v1/flour", req.URL.Path)
+		suite.Equal(url.Values{"flour_type": {"wheat", "rye"}, "limit": {"5"}}, req.URL.Query())
+		suite.Equal("application/json", req.Header.Get("Accept"))
+
+		writeJson(res, http.StatusOK, domain.FlourPageDto{
+			Items:   []domain.FlourDto{{Id: test.FirstId, Name: "Bread flour"}},
+			PageDto: domain.PageDto{Total: 1},
+		})
+	}
+
+	page, err := suite.target.FindFlours(context.Background(),
+		url.Values{"flour_type": {"wheat", "rye"}, "limit": {"5"}})
+
+	suite.NoError(err)
+	suite.Equal(int64(1), page.Total)
+	suite.Equal([]domain.FlourDto{{Id: test.FirstId, Name: "Bread flour"}}, page.Items)
+}
+
+func (suite *DoughClientTestSuite) TestExportFlours() {
+	suite.handler = func(res http.ResponseWriter, req *http.Request) {
+		suite.Equal(http.MethodGet, req.Method)
+		suite.Equal("/v1/flour/export.csv", req.URL.Path)
+		suite.Equal("text/csv", req.Header.Get("Accept"))
+
+		_, _ = res.Write([]byte("id,name\n" + test.FirstId.String() + ",Bread flour\n"))
+	}
+
+	content, err := suite.target.ExportFlours(context.Background())
+
+	suite.NoError(err)
+	suite.Equal("id,name\n"+test.FirstId.String()+",Bread flour\n", string(content))
+}
+
+func (suite *DoughClientTestSuite) TestImportFlours() {
+	suite.handler = func(res http.ResponseWriter, req *http.Request) {
+		suite.Equal(http.MethodPost, req.Method)
+		suite.Equal("/v1/flour/import", req.URL.Path)
+		suite.Equal("true", req.URL.Query().Get("dry_run"))
+
+		file, _, err := req.FormFile("file")
+		suite.Require().NoError(err)
+		body, err := io.ReadAll(file)
+		suite.Require().NoError(err)
+		suite.Equal("name\nBread flour\n", string(body))
+
+		writeJson(res, http.StatusOK, domain.FlourImportResultDto{
+			DryRun:  true,
+			Created: 1,
+			Rows:    []domain.FlourImportRowDto{{Row: 2, Name: "Bread flour", Action: domain.FlourImportActionCreated}},
+		})
+	}
+
+	result, err := suite.target.ImportFlours(context.Background(), []byte("name\nBread flour\n"), true)
+
+	suite.NoError(err)
+	suite.True(result.DryRun)
+	suite.Equal(1, result.Created)
+	suite.Equal([]domain.FlourImportRowDto{{Row: 2, Name: "Bread flour", Action: domain.FlourImportActionCreated}},
+		result.Rows)
+}
+
+func (suite *DoughClientTestSuite) TestCreateRecipe() {
+	request := domain.CreateSourdoughRecipeRequest{Name: "Country loaf"}
+
+	suite.handler = func(res http.ResponseWriter, req *http.Request) {
+		suite.Equal(http.MethodPost, req.Method)
+		suite.Equal("/v1/recipe/sourdough", req.URL.Path)
+		suite.Equal("application/json", req.Header.Get("Content-Type"))
+
+		var actual domain.CreateSourdoughRecipeRequest
+		suite.Require().NoError(json.NewDecoder(req.Body).Decode(&actual))
+		suite.Equal(request.Name, actual.Name)
+
+		writeJson(res, http.StatusCreated, domain.SourdoughRecipeDto{
+			RecipeDto: domain.RecipeDto{Id: test.FirstId, Name: actual.Name},
+		})
+	}
+
+	recipe, err := suite.target.CreateRecipe(context.Background(), request)
+
+	suite.NoError(err)
+	suite.Equal(test.FirstId, recipe.Id)
+	suite.Equal("Country loaf", recipe.Name)
+}
+
+func (suite *DoughClientTestSuite) TestScaleRecipe() {
+	suite.handler = func(res http.ResponseWriter, req *http.Request) {
+		suite.Equal(http.MethodPost, req.Method)
+		suite.Equal("/v1/recipe/sourdough/"+test.FirstId.String()+"/scale", req.URL.Path)
+
+		var actual domain.SourdoughRecipeScaleRequestDto
+		suite.Require().NoError(json.NewDecoder(req.Body).Decode(&actual))
+		suite.Equal(1800, actual.FinalDoughWeight)
+
+		writeJson(res, http.StatusOK, domain.SourdoughRecipeDto{
+			RecipeDto: domain.RecipeDto{Id: test.FirstId, Details: domain.RecipeDetailsDto{TotalWeight: 1800}},
+		})
+	}
+
+	recipe, err := suite.target.ScaleRecipe(context.Background(), test.FirstId,
+		domain.SourdoughRecipeScaleRequestDto{FinalDoughWeight: 1800})
+
+	suite.NoError(err)
+	suite.Equal(1800, recipe.Details.TotalWeight)
+}
+
+func (suite *DoughClientTestSuite) TestExportRecipes() {
+	suite.handler = func(res http.ResponseWriter, req *http.Request) {
+		suite.Equal("/v1/recipe/sourdough/export", req.URL.Path)
+		suite.Equal("application/yaml", req.Header.Get("Accept"))
+		suite.Equal(url.Values{"id": {test.FirstId.String()}}, req.URL.Query())
+
+		_, _ = res.Write([]byte("schema_version: 1\n"))
+	}
+
+	content, err := suite.target.ExportRecipes(context.Background(), url.Values{"id": {test.FirstId.String()}}, true)
+
+	suite.NoError(err)
+	suite.Equal("schema_version: 1\n", string(content))
+}
+
+func (suite *DoughClientTestSuite) TestImportRecipes() {
+	suite.handler = func(res http.ResponseWriter, req *http.Request) {
+		suite.Equal(http.MethodPost, req.Method)
+		suite.Equal("/v1/recipe/sourdough/import", req.URL.Path)
+		suite.Equal("skip", req.URL.Query().Get("on_conflict"))
+		suite.Equal("application/json", req.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(req.Body)
+		suite.Require().NoError(err)
+		suite.Equal(`{"schema_version": 1}`, string(body))
+
+		writeJson(res, http.StatusOK, domain.RecipeBundleImportResultDto{
+			Recipes: []domain.RecipeBundleRecipeImportDto{{Id: test.FirstId, Name: "Country loaf", Action: "skipped"}},
+		})
+	}
+
+	result, err := suite.target.ImportRecipes(context.Background(), []byte(`{"schema_version": 1}`), false, "skip")
+
+	suite.NoError(err)
+	suite.Equal([]domain.RecipeBundleRecipeImportDto{{Id: test.FirstId, Name: "Country loaf", Action: "skipped"}},
+		result.Recipes)
+}
+
+func (suite *DoughClientTestSuite) TestFindRecipeById_WithError() {
+	tests := []struct {
+		name          string
+		handler       http.HandlerFunc
+		expectedError *internalErrors.ServiceError
+	}{
+		{
+			name: "service error",
+			handler: func(res http.ResponseWriter, _ *http.Request) {
+				writeJson(res, http.StatusBadRequest, internalErrors.SourdoughRecipeNotFound("sourdough recipe not found"))
+			},
+			expectedError: &internalErrors.ServiceError{
+				ResponseCode: http.StatusBadRequest,
+				Code:         10001,
+				Message:      "sourdough not found",
+				Details:      "sourdough recipe not found",
+			},
+		},
+		{
+			name: "plain error",
+			handler: func(res http.ResponseWriter, _ *http.Request) {
+				http.Error(res, "upstream unavailable", http.StatusBadGateway)
+			},
+			expectedError: &internalErrors.ServiceError{
+				ResponseCode: http.StatusBadGateway,
+				Code:         -1,
+				Message:      "Bad Gateway",
+				Details:      "upstream unavailable",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.handler = tt.handler
+
+			_, err := suite.target.FindRecipeById(context.Background(), test.FirstId)
+
+			suite.Equal(tt.expectedError, err)
+		})
+	}
+}
+
+func TestNewDoughClient_WithInvalidUrl(t *testing.T) {
+	for _, baseUrl := range []string{"localhost:8080", "ftp://localhost/v1", "http://[::1"} {
+		client, err := NewDoughClient(baseUrl)
+
+		assert.Error(t, err, baseUrl)
+		assert.Nil(t, client, baseUrl)
+	}
+}
+
+func writeJson(res http.ResponseWriter, status int, body any) {
+	res.Header().Set("Content-Type", "application/json")
+	res.WriteHeader(status)
+	_ = json.NewEncoder(res).Encode(body)
+}
@@ -3,4 +3,6 @@ package config
 type Config struct {
 	Application Application
 	Database    Database
+	Storage     Storage
+	Templates   Templates
 }
@@ -1,8 +1,121 @@
 package config
 
-import "time"
+import (
+	"net/url"
+	"strings"
+	"time"
+)
 
+const (
+	DatabaseTypeMongoDB = "mongodb"
+	DatabaseTypeBolt    = "bolt"
+	DatabaseTypeMemory  = "memory"
+
+	// DefaultDatabaseName is the MongoDB database used when neither Name nor
+	// the Uri names one.
+	DefaultDatabaseName = "dough-calculator"
+
+	defaultBoltPath = "./data/dough-calculator.db"
+
+	defaultMigrationLockTimeout = 5 * time.Minute
+)
+
+// Database selects the storage backend. Type is mongodb, the default, bolt
+// for an embedded database kept in the file at Path, or memory for an
+// embedded database that is lost on shutdown. Everything but Path applies
+// to MongoDB only.
+//
+// Name overrides the database of the Uri path, and CollectionPrefix goes
+// in front of every collection name, so several environments can share a
+// cluster or a database. The client options override the same options of
+// the Uri when set.
 type Database struct {
-	Uri               string
-	ConnectionTimeout time.Duration
+	Type                   string
+	Uri                    string
+	ConnectionTimeout      time.Duration
+	Name                   string
+	CollectionPrefix       string
+	Pool                   Pool
+	SocketTimeout          time.Duration
+	ServerSelectionTimeout time.Duration
+	ReadConcern            string
+	WriteConcern           WriteConcern
+	ReadPreference         string
+	TLS                    TLS
+	Auth                   Auth
+	Path                   string
+	Migrations             Migrations
+}
+
+// DatabaseName returns the database every repository uses, the configured
+// Name or else the database of the Uri path, DefaultDatabaseName when
+// neither names one.
+func (database Database) DatabaseName() string {
+	if database.Name != "" {
+		return database.Name
+	}
+	if name := uriDatabase(database.Uri); name != "" {
+		return name
+	}
+	return DefaultDatabaseName
+}
+
+// CollectionName returns the name a collection has in the database, the
+// collection prefix followed by name.
+func (database Database) CollectionName(name string) string {
+	return database.CollectionPrefix + name
+}
+
+// Embedded reports whether the data is kept by the application itself
+// instead of a MongoDB server.
+func (database Database) Embedded() bool {
+	return database.Type == DatabaseTypeBolt || database.Type == DatabaseTypeMemory
+}
+
+// BoltPath returns the file of the bolt database, ./data/dough-calculator.db
+// when not configured.
+func (database Database) BoltPath() string {
+	if database.Path == "" {
+		return defaultBoltPath
+	}
+	return database.Path
+}
+
+// uriDatabase returns the database of a mongodb:// or mongodb+srv:// URI,
+// the path between the hosts and the options. The URI is not parsed by the
+// driver here, which resolves the hosts of mongodb+srv URIs.
+func uriDatabase(uri string) string {
+	_, rest, ok := strings.Cut(uri, "://")
+	if !ok {
+		return ""
+	}
+
+	_, path, ok := strings.Cut(rest, "/")
+	if !ok {
+		return ""
+	}
+	path, _, _ = strings.Cut(path, "?")
+
+	name, err := url.PathUnescape(path)
+	if err != nil {
+		return ""
+	}
+	return name
+}
+
+// Migrations controls the MongoDB schema migrations, which run on startup
+// unless SkipOnStartup is set. A replica waits for another replica migrating
+// while that one renews its lock, and gives up when the lock was not renewed
+// for LockTimeout. The lock of a replica that died frees after LockTimeout.
+type Migrations struct {
+	SkipOnStartup bool
+	LockTimeout   time.Duration
+}
+
+// Lock returns the lock timeout, 5 minutes when not configured.
+func (migrations Migrations) Lock() time.Duration {
+	if migrations.LockTimeout <= 0 {
+		return defaultMigrationLockTimeout
+	}
+	return migrations.LockTimeout
 }
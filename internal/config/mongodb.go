@@ -0,0 +1,58 @@
+package config
+
+import "time"
+
+// Pool sizes the connection pool of the MongoDB client. Zero values keep the
+// options of the Uri or the driver defaults.
+type Pool struct {
+	MinSize     uint64
+	MaxSize     uint64
+	MaxIdleTime time.Duration
+}
+
+// WriteConcern sets how writes are acknowledged. W is "majority", a number
+// of members or the name of a tag set, Journal waits for the on-disk
+// journal and Timeout bounds the wait for the acknowledgement.
+type WriteConcern struct {
+	W       string
+	Journal bool
+	Timeout time.Duration
+}
+
+// Configured reports whether any write concern option is set.
+func (writeConcern WriteConcern) Configured() bool {
+	return writeConcern != WriteConcern{}
+}
+
+// TLS encrypts the connection to MongoDB. CAFile verifies the server
+// certificate and CertificateKeyFile, a PEM file holding the client
+// certificate and its key, authenticates the client. Setting either file
+// enables TLS.
+type TLS struct {
+	Enabled            bool
+	CAFile             string
+	CertificateKeyFile string
+	InsecureSkipVerify bool
+}
+
+// Configured reports whether this config enables TLS. Its files are added
+// to the TLS options of the Uri, replacing only the ones it sets.
+func (tls TLS) Configured() bool {
+	return tls.Enabled || tls.CAFile != "" || tls.CertificateKeyFile != ""
+}
+
+// Auth holds the credentials of MongoDB outside the Uri. UsernameFile and
+// PasswordFile are read on startup, so the secrets can be mounted files;
+// UsernameFile wins over Username.
+type Auth struct {
+	Mechanism    string
+	Source       string
+	Username     string
+	UsernameFile string
+	PasswordFile string
+}
+
+// Configured reports whether any credential option is set.
+func (auth Auth) Configured() bool {
+	return auth != Auth{}
+}
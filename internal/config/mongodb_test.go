@@ -0,0 +1,29 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteConcern_Configured(t *testing.T) {
+	assert.False(t, WriteConcern{}.Configured())
+	assert.True(t, WriteConcern{W: "majority"}.Configured())
+	assert.True(t, WriteConcern{Journal: true}.Configured())
+	assert.True(t, WriteConcern{Timeout: time.Second}.Configured())
+}
+
+func TestTLS_Configured(t *testing.T) {
+	assert.False(t, TLS{}.Configured())
+	assert.False(t, TLS{InsecureSkipVerify: true}.Configured())
+	assert.True(t, TLS{Enabled: true}.Configured())
+	assert.True(t, TLS{CAFile: "/etc/mongodb/ca.pem"}.Configured())
+	assert.True(t, TLS{CertificateKeyFile: "/etc/mongodb/client.pem"}.Configured())
+}
+
+func TestAuth_Configured(t *testing.T) {
+	assert.False(t, Auth{}.Configured())
+	assert.True(t, Auth{Source: "admin"}.Configured())
+	assert.True(t, Auth{PasswordFile: "/run/secrets/mongodb-password"}.Configured())
+}
@@ -2,9 +2,13 @@ package config
 
 import "time"
 
+// Rest configures the HTTP server. PublicUrl is the scheme and host the API
+// is reached at from outside, used for absolute links such as the images of
+// published recipes.
 type Rest struct {
 	Server               string
 	ContextPath          string
+	PublicUrl            string
 	ReadTimeout          int
 	WriteTimeout         int
 	IdleTimeout          int
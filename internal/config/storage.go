@@ -0,0 +1,41 @@
+package config
+
+const (
+	StorageTypeLocal  = "local"
+	StorageTypeGridFS = "gridfs"
+
+	defaultMaxImageSize  = 10 << 20
+	defaultThumbnailSize = 320
+)
+
+type Storage struct {
+	Type          string
+	Local         LocalStorage
+	GridFS        GridFSStorage
+	MaxImageSize  int64
+	ThumbnailSize int
+}
+
+type LocalStorage struct {
+	Path string
+}
+
+type GridFSStorage struct {
+	Bucket string
+}
+
+// MaxImageSizeBytes returns the upload limit for a single image, 10 MiB when not configured.
+func (storage Storage) MaxImageSizeBytes() int64 {
+	if storage.MaxImageSize <= 0 {
+		return defaultMaxImageSize
+	}
+	return storage.MaxImageSize
+}
+
+// ThumbnailSizePixels returns the longest edge of generated thumbnails, 320 px when not configured.
+func (storage Storage) ThumbnailSizePixels() int {
+	if storage.ThumbnailSize <= 0 {
+		return defaultThumbnailSize
+	}
+	return storage.ThumbnailSize
+}
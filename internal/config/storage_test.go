@@ -0,0 +1,23 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorage_MaxImageSizeBytes(t *testing.T) {
+	assert.Equal(t, int64(1024), Storage{MaxImageSize: 1024}.MaxImageSizeBytes())
+}
+
+func TestStorage_MaxImageSizeBytes_WithDefault(t *testing.T) {
+	assert.Equal(t, int64(10<<20), Storage{}.MaxImageSizeBytes())
+}
+
+func TestStorage_ThumbnailSizePixels(t *testing.T) {
+	assert.Equal(t, 128, Storage{ThumbnailSize: 128}.ThumbnailSizePixels())
+}
+
+func TestStorage_ThumbnailSizePixels_WithDefault(t *testing.T) {
+	assert.Equal(t, 320, Storage{}.ThumbnailSizePixels())
+}
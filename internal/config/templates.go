@@ -0,0 +1,8 @@
+package config
+
+// Templates points at a directory whose bake sheet templates replace the ones
+// embedded in the binary. Templates missing from the directory keep the
+// embedded version.
+type Templates struct {
+	Path string
+}
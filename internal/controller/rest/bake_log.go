@@ -0,0 +1,142 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/ggicci/httpin"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+)
+
+const (
+	bakeLogIdNotFound = 13101
+	bakeLogIdNotValid = 13102
+)
+
+type bakeLogHandler struct {
+	service domain.BakeLogService
+}
+
+func (handler *bakeLogHandler) Create() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		recipeId := handler.getRecipeIdParam(res, req)
+		if recipeId == nil {
+			return
+		}
+
+		var request domain.CreateBakeLogRequest
+
+		if err := render.DecodeJSON(req.Body, &request); err != nil {
+			HandlerError(res, req, errors.Wrap(err, "error while decoding request body"))
+			return
+		}
+
+		bakeLog, err := handler.service.Create(req.Context(), *recipeId, request)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.Status(req, http.StatusCreated)
+		render.JSON(res, req, bakeLog)
+	}
+}
+
+func (handler *bakeLogHandler) FindById() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		recipeId := handler.getRecipeIdParam(res, req)
+		if recipeId == nil {
+			return
+		}
+
+		bakeLogId := handler.getBakeLogIdParam(res, req)
+		if bakeLogId == nil {
+			return
+		}
+
+		bakeLog, err := handler.service.FindById(req.Context(), *recipeId, *bakeLogId)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, bakeLog)
+	}
+}
+
+func (handler *bakeLogHandler) FindByRecipeId() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		recipeId := handler.getRecipeIdParam(res, req)
+		if recipeId == nil {
+			return
+		}
+
+		page := req.Context().Value(httpin.Input).(*PageInput)
+
+		bakeLogs, err := handler.service.FindByRecipeId(req.Context(), *recipeId, page.Offset, page.Limit)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, bakeLogs)
+	}
+}
+
+func (handler *bakeLogHandler) Summary() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		recipeId := handler.getRecipeIdParam(res, req)
+		if recipeId == nil {
+			return
+		}
+
+		summary, err := handler.service.Summary(req.Context(), *recipeId)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, summary)
+	}
+}
+
+func (handler *bakeLogHandler) getRecipeIdParam(res http.ResponseWriter, req *http.Request) *uuid.UUID {
+	param := chi.URLParam(req, "id")
+	if param == "" {
+		HandlerError(res, req, internalErrors.NewBadRequestError(recipeIdNotFound, "id is required", "id is required"))
+		return nil
+	}
+	id, err := uuid.Parse(param)
+	if err != nil {
+		HandlerError(res, req, internalErrors.NewBadRequestError(recipeIdNotValid, "id is not valid", "id is not valid"))
+		return nil
+	}
+	return &id
+}
+
+func (handler *bakeLogHandler) getBakeLogIdParam(res http.ResponseWriter, req *http.Request) *uuid.UUID {
+	param := chi.URLParam(req, "bakeId")
+	if param == "" {
+		HandlerError(res, req, internalErrors.NewBadRequestError(bakeLogIdNotFound, "bake id is required", "bake id is required"))
+		return nil
+	}
+	id, err := uuid.Parse(param)
+	if err != nil {
+		HandlerError(res, req, internalErrors.NewBadRequestError(bakeLogIdNotValid, "bake id is not valid", "bake id is not valid"))
+		return nil
+	}
+	return &id
+}
+
+func NewBakeLogHandler(service domain.BakeLogService) (domain.BakeLogHandler, error) {
+	if service == nil {
+		return nil, errors.New("service cannot be nil")
+	}
+
+	return &bakeLogHandler{service: service}, nil
+}
@@ -0,0 +1,317 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ggicci/httpin"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func TestBakeLogHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(BakeLogHandlerTestSuite))
+}
+
+type BakeLogHandlerTestSuite struct {
+	test.GoMockTestSuite
+
+	service *mocks.MockBakeLogService
+
+	target domain.BakeLogHandler
+}
+
+func (suite *BakeLogHandlerTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.service = mocks.NewMockBakeLogService(suite.MockCtrl)
+
+	suite.target = test.Must(func() (domain.BakeLogHandler, error) {
+		return NewBakeLogHandler(suite.service)
+	})
+}
+
+func (suite *BakeLogHandlerTestSuite) TestCreate() {
+	request := domain.CreateBakeLogRequest{
+		RecipeVersion: 2,
+		ScaledWeight:  1800,
+		Temperatures:  domain.BakeTemperaturesDto{Room: 22.5, Dough: 25, Oven: 250},
+		Timings:       domain.BakeTimingsDto{BulkFermentationMinutes: 300, ProofMinutes: 720, BakeMinutes: 45},
+		Notes:         "great oven spring",
+		Rating:        4,
+		BakedAt:       &test.Date,
+	}
+
+	suite.service.EXPECT().Create(gomock.Any(), test.ThirdId, request).
+		Return(createBakeLog(), nil)
+
+	router := chi.NewRouter()
+	router.Post("/recipe/{id}/bakes", suite.target.Create())
+
+	body, err := json.Marshal(request)
+	suite.Require().NoError(err)
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("/recipe/%s/bakes", test.ThirdId), bytes.NewReader(body))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusCreated, "testdata/bake_log_response.json")
+}
+
+func (suite *BakeLogHandlerTestSuite) TestCreate_WithInvalidBody() {
+	router := chi.NewRouter()
+	router.Post("/recipe/{id}/bakes", suite.target.Create())
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("/recipe/%s/bakes", test.ThirdId), bytes.NewReader([]byte("{")))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusInternalServerError, resp.Code)
+}
+
+func (suite *BakeLogHandlerTestSuite) TestCreate_WithErrorOnCreate() {
+	suite.service.EXPECT().Create(gomock.Any(), test.ThirdId, domain.CreateBakeLogRequest{Rating: 9}).
+		Return(domain.BakeLogDto{}, internalErrors.BakeLogInvalidRating(9))
+
+	router := chi.NewRouter()
+	router.Post("/recipe/{id}/bakes", suite.target.Create())
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("/recipe/%s/bakes", test.ThirdId), bytes.NewReader([]byte(`{"rating": 9}`)))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 13002,
+			"error_details": "rating 9 must be between 1 and 5",
+			"error_message": "invalid rating"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *BakeLogHandlerTestSuite) TestFindById() {
+	suite.service.EXPECT().FindById(gomock.Any(), test.ThirdId, test.FirstId).
+		Return(createBakeLog(), nil)
+
+	router := chi.NewRouter()
+	router.Get("/recipe/{id}/bakes/{bakeId}", suite.target.FindById())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/recipe/%s/bakes/%s", test.ThirdId, test.FirstId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/bake_log_response.json")
+}
+
+func (suite *BakeLogHandlerTestSuite) TestFindById_WithInvalidParams() {
+	tests := []struct {
+		name             string
+		path             string
+		expectedBodyJson string
+	}{
+		{
+			name: "invalid id",
+			path: fmt.Sprintf("/recipe/invalid/bakes/%s", test.FirstId),
+			expectedBodyJson: `{
+				"error_code": 10002,
+				"error_details": "id is not valid",
+				"error_message": "id is not valid"
+			}`,
+		},
+		{
+			name: "invalid bake id",
+			path: fmt.Sprintf("/recipe/%s/bakes/invalid", test.ThirdId),
+			expectedBodyJson: `{
+				"error_code": 13102,
+				"error_details": "bake id is not valid",
+				"error_message": "bake id is not valid"
+			}`,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			router := chi.NewRouter()
+			router.Get("/recipe/{id}/bakes/{bakeId}", suite.target.FindById())
+
+			req, err := http.NewRequest("GET", tt.path, nil)
+			suite.Require().NoError(err)
+
+			resp := httptest.NewRecorder()
+
+			router.ServeHTTP(resp, req)
+
+			test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, tt.expectedBodyJson)
+		})
+	}
+}
+
+func (suite *BakeLogHandlerTestSuite) TestFindById_WithErrorOnFind() {
+	suite.service.EXPECT().FindById(gomock.Any(), test.ThirdId, test.FirstId).
+		Return(domain.BakeLogDto{}, internalErrors.NewBadRequestErrorf(123, "error", "error %s", "'test'"))
+
+	router := chi.NewRouter()
+	router.Get("/recipe/{id}/bakes/{bakeId}", suite.target.FindById())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/recipe/%s/bakes/%s", test.ThirdId, test.FirstId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 123,
+			"error_details": "error 'test'",
+			"error_message": "error"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *BakeLogHandlerTestSuite) TestFindByRecipeId() {
+	bakeLogs := []domain.BakeLogDto{createBakeLog()}
+
+	suite.service.EXPECT().FindByRecipeId(gomock.Any(), test.ThirdId, 1, 10).
+		Return(bakeLogs, nil)
+
+	router := chi.NewRouter()
+	router.
+		With(httpin.NewInput(PageInput{})).
+		Get("/recipe/{id}/bakes", suite.target.FindByRecipeId())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/recipe/%s/bakes?offset=1&limit=10", test.ThirdId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusOK, resp.Code)
+
+	var actual []domain.BakeLogDto
+	suite.Require().NoError(json.NewDecoder(resp.Body).Decode(&actual))
+	suite.Equal(bakeLogs, actual)
+}
+
+func (suite *BakeLogHandlerTestSuite) TestFindByRecipeId_WithErrorOnFind() {
+	suite.service.EXPECT().FindByRecipeId(gomock.Any(), test.ThirdId, 0, 25).
+		Return(nil, internalErrors.NewBadRequestErrorf(123, "error", "error %s", "'test'"))
+
+	router := chi.NewRouter()
+	router.
+		With(httpin.NewInput(PageInput{})).
+		Get("/recipe/{id}/bakes", suite.target.FindByRecipeId())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/recipe/%s/bakes", test.ThirdId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 123,
+			"error_details": "error 'test'",
+			"error_message": "error"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *BakeLogHandlerTestSuite) TestSummary() {
+	suite.service.EXPECT().Summary(gomock.Any(), test.ThirdId).
+		Return(domain.BakeLogSummaryDto{
+			RecipeId:      test.ThirdId,
+			BakeCount:     3,
+			AverageRating: 4.5,
+			LastBakedAt:   &test.Date,
+		}, nil)
+
+	router := chi.NewRouter()
+	router.Get("/recipe/{id}/bakes/summary", suite.target.Summary())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/recipe/%s/bakes/summary", test.ThirdId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"recipe_id": "45bdca7a-f8d8-42e5-9ad8-706a216647ab",
+			"bake_count": 3,
+			"average_rating": 4.5,
+			"last_baked_at": "2020-01-25T01:01:01.000000001Z"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusOK, expectedBodyJson)
+}
+
+func (suite *BakeLogHandlerTestSuite) TestSummary_WithErrorOnSummary() {
+	suite.service.EXPECT().Summary(gomock.Any(), test.ThirdId).
+		Return(domain.BakeLogSummaryDto{}, internalErrors.NewBadRequestErrorf(123, "error", "error %s", "'test'"))
+
+	router := chi.NewRouter()
+	router.Get("/recipe/{id}/bakes/summary", suite.target.Summary())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/recipe/%s/bakes/summary", test.ThirdId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 123,
+			"error_details": "error 'test'",
+			"error_message": "error"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func TestNewBakeLogHandler_WithNilService(t *testing.T) {
+	handler, err := NewBakeLogHandler(nil)
+
+	assert.ErrorContains(t, err, "service cannot be nil")
+	assert.Nil(t, handler)
+}
+
+func createBakeLog() domain.BakeLogDto {
+	return domain.BakeLogDto{
+		Id:            test.FirstId,
+		RecipeId:      test.ThirdId,
+		RecipeVersion: 2,
+		ScaledWeight:  1800,
+		Temperatures:  domain.BakeTemperaturesDto{Room: 22.5, Dough: 25, Oven: 250},
+		Timings:       domain.BakeTimingsDto{BulkFermentationMinutes: 300, ProofMinutes: 720, BakeMinutes: 45},
+		Notes:         "great oven spring",
+		Rating:        4,
+		BakedAt:       test.Date,
+		CreatedAt:     test.Date,
+	}
+}
@@ -0,0 +1,141 @@
+package rest
+
+import (
+	"bytes"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+)
+
+const recipeMediaTypeNotAcceptable = 10101
+
+var bakeSheetContentTypes = map[string]string{
+	"text/markdown": domain.BakeSheetFormatMarkdown,
+	"text/html":     domain.BakeSheetFormatHtml,
+}
+
+// recipeMediaTypes are the media types a recipe can be rendered as, in the
+// order preferred when the client accepts several of them equally.
+var recipeMediaTypes = []string{"application/json", recipeJsonLdMediaType, "text/markdown", "text/html"}
+
+// negotiateMediaType returns the offer with the highest quality in the Accept
+// header. Each offer takes the quality of the most specific range matching it;
+// ties go to the more specific range, then to the range listed first, then to
+// the offer listed first. It returns the first offer when the header is empty
+// and an empty string when no offer is acceptable.
+func negotiateMediaType(accept string, offers []string) string {
+	if strings.TrimSpace(accept) == "" {
+		return offers[0]
+	}
+
+	type mediaRange struct {
+		mediaType string
+		quality   float64
+	}
+
+	var ranges []mediaRange
+	for _, accepted := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(accepted))
+		if err != nil {
+			continue
+		}
+
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			if quality, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+
+		ranges = append(ranges, mediaRange{mediaType: mediaType, quality: quality})
+	}
+
+	best, bestQuality, bestSpecificity, bestPosition := "", 0.0, 0, 0
+	for _, offer := range offers {
+		quality, specificity, position := 0.0, -1, 0
+		for i, accepted := range ranges {
+			rangeSpecificity := mediaRangeSpecificity(accepted.mediaType, offer)
+			if rangeSpecificity > specificity {
+				quality, specificity, position = accepted.quality, rangeSpecificity, i
+			}
+		}
+
+		if quality <= 0 {
+			continue
+		}
+		if best == "" || quality > bestQuality ||
+			quality == bestQuality && (specificity > bestSpecificity ||
+				specificity == bestSpecificity && position < bestPosition) {
+			best, bestQuality, bestSpecificity, bestPosition = offer, quality, specificity, position
+		}
+	}
+
+	return best
+}
+
+// mediaRangeSpecificity returns 2 when mediaRange is mediaType itself, 1 when
+// it is its type/* wildcard, 0 for */* and -1 when it does not match.
+func mediaRangeSpecificity(mediaRange string, mediaType string) int {
+	switch {
+	case mediaRange == mediaType:
+		return 2
+	case strings.HasSuffix(mediaRange, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(mediaRange, "*")):
+		return 1
+	case mediaRange == "*/*":
+		return 0
+	default:
+		return -1
+	}
+}
+
+// renderRecipe writes the recipe in the media type the Accept header prefers:
+// a schema.org JSON-LD document, a Markdown or HTML bake sheet, or JSON. It
+// responds 406 Not Acceptable when the header accepts none of them.
+func renderRecipe(
+	res http.ResponseWriter,
+	req *http.Request,
+	renderer domain.BakeSheetRenderer,
+	jsonLdService domain.RecipeJsonLdService,
+	recipe domain.SourdoughRecipeDto,
+) {
+	res.Header().Add("Vary", "Accept")
+
+	mediaType := negotiateMediaType(req.Header.Get("Accept"), recipeMediaTypes)
+	switch mediaType {
+	case "":
+		HandlerError(res, req, internalErrors.NewServiceError(http.StatusNotAcceptable, recipeMediaTypeNotAcceptable,
+			"media type not acceptable", "recipe is available as "+strings.Join(recipeMediaTypes, ", ")))
+		return
+	case recipeJsonLdMediaType:
+		jsonLd, err := jsonLdService.ExportRecipe(req.Context(), recipe)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		writeRecipeJsonLd(res, req, jsonLd)
+		return
+	}
+
+	format, ok := bakeSheetContentTypes[mediaType]
+	if !ok {
+		render.JSON(res, req, recipe)
+		return
+	}
+
+	var sheet bytes.Buffer
+	if err := renderer.Render(&sheet, format, recipe); err != nil {
+		HandlerError(res, req, errors.Wrap(err, "failed to render bake sheet"))
+		return
+	}
+
+	res.Header().Set("Content-Type", mediaType+"; charset=utf-8")
+	_, _ = res.Write(sheet.Bytes())
+}
@@ -0,0 +1,40 @@
+package rest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiateMediaType(t *testing.T) {
+	tests := []struct {
+		name     string
+		accept   string
+		expected string
+	}{
+		{name: "empty", accept: "", expected: "application/json"},
+		{name: "any", accept: "*/*", expected: "application/json"},
+		{name: "exact", accept: "text/markdown", expected: "text/markdown"},
+		{name: "json-ld", accept: "application/ld+json", expected: "application/ld+json"},
+		{name: "application wildcard", accept: "application/*", expected: "application/json"},
+		{name: "listed first", accept: "application/json, text/html", expected: "application/json"},
+		{name: "higher quality", accept: "text/html;q=0.1, application/json", expected: "application/json"},
+		{name: "higher quality listed later", accept: "application/json;q=0.5, text/markdown", expected: "text/markdown"},
+		{name: "exact before wildcard", accept: "*/*, text/html", expected: "text/html"},
+		{name: "type wildcard", accept: "text/*, application/json;q=0.5", expected: "text/markdown"},
+		{name: "most specific range", accept: "text/*, text/markdown;q=0", expected: "text/html"},
+		{
+			name:     "browser",
+			accept:   "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8",
+			expected: "text/html",
+		},
+		{name: "invalid quality", accept: "text/html;q=high, text/markdown", expected: "text/markdown"},
+		{name: "not acceptable", accept: "image/png", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, negotiateMediaType(tt.accept, recipeMediaTypes))
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/domain"
+)
+
+type calculatorHandler struct {
+	service domain.CalculatorService
+}
+
+func (handler *calculatorHandler) CalculateSourdough() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		var request domain.CalculateSourdoughRecipeRequest
+
+		if err := render.DecodeJSON(req.Body, &request); err != nil {
+			HandlerError(res, req, errors.Wrap(err, "error while decoding request body"))
+			return
+		}
+
+		recipeDto, err := handler.service.CalculateSourdough(req.Context(), request)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, recipeDto)
+	}
+}
+
+func NewCalculatorHandler(service domain.CalculatorService) (domain.CalculatorHandler, error) {
+	if service == nil {
+		return nil, errors.New("service cannot be nil")
+	}
+
+	return &calculatorHandler{service: service}, nil
+}
@@ -0,0 +1,120 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func TestCalculatorHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(CalculatorHandlerTestSuite))
+}
+
+type CalculatorHandlerTestSuite struct {
+	test.GoMockTestSuite
+
+	service *mocks.MockCalculatorService
+
+	target domain.CalculatorHandler
+}
+
+func (suite *CalculatorHandlerTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.service = mocks.NewMockCalculatorService(suite.MockCtrl)
+
+	suite.target = test.Must(func() (domain.CalculatorHandler, error) {
+		return NewCalculatorHandler(suite.service)
+	})
+}
+
+func (suite *CalculatorHandlerTestSuite) TestCalculateSourdough() {
+	finalDoughWeight := 1000
+	request := domain.CalculateSourdoughRecipeRequest{
+		CreateSourdoughRecipeRequest: createSourdoughRecipe().ToCreateRequest(),
+		FinalDoughWeight:             &finalDoughWeight,
+	}
+
+	suite.service.EXPECT().
+		CalculateSourdough(gomock.Any(), request).
+		Return(createSourdoughRecipe(), nil)
+
+	buffer := bytes.NewBuffer([]byte{})
+	err := json.NewEncoder(buffer).Encode(request)
+	suite.Require().NoError(err)
+
+	router := chi.NewRouter()
+	router.Post("/calculate/sourdough", suite.target.CalculateSourdough())
+
+	req, err := http.NewRequest("POST", "/calculate/sourdough", buffer)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/sourdough_recipe_response.json")
+}
+
+func (suite *CalculatorHandlerTestSuite) TestCalculateSourdough_WithErrorOnCalculate() {
+	suite.service.EXPECT().
+		CalculateSourdough(gomock.Any(), domain.CalculateSourdoughRecipeRequest{}).
+		Return(domain.SourdoughRecipeDto{}, internalErrors.CalculationRequestInvalid("flour amount must be greater than 0"))
+
+	router := chi.NewRouter()
+	router.Post("/calculate/sourdough", suite.target.CalculateSourdough())
+
+	req, err := http.NewRequest("POST", "/calculate/sourdough", bytes.NewReader([]byte(`{}`)))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 18001,
+			"error_details": "flour amount must be greater than 0",
+			"error_message": "invalid calculation request"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *CalculatorHandlerTestSuite) TestCalculateSourdough_WithInvalidBody() {
+	router := chi.NewRouter()
+	router.Post("/calculate/sourdough", suite.target.CalculateSourdough())
+
+	req, err := http.NewRequest("POST", "/calculate/sourdough", bytes.NewReader([]byte(`"invalid"`)))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": -1,
+			"error_details": "error while decoding request body: json: cannot unmarshal string into Go value of type domain.CalculateSourdoughRecipeRequest",
+			"error_message": "internal server error"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusInternalServerError, expectedBodyJson)
+}
+
+func TestNewCalculatorHandler_WithNilService(t *testing.T) {
+	handler, err := NewCalculatorHandler(nil)
+
+	assert.ErrorContains(t, err, "service cannot be nil")
+	assert.Nil(t, handler)
+}
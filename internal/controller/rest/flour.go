@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"bytes"
 	"net/http"
 
 	"github.com/ggicci/httpin"
@@ -14,14 +15,55 @@ import (
 )
 
 const (
-	flourIdNotFound = 20001
-	flourIdNotValid = 20002
+	flourIdNotFound        = 20001
+	flourIdNotValid        = 20002
+	flourImportFileMissing = 20101
+	flourImportFormInvalid = 20102
 )
 
+// flourImportMaxSize caps the uploaded CSV file, a catalogue of thousands of
+// flours stays well below it.
+const flourImportMaxSize = 10 << 20
+
+type FindFlourInput struct {
+	FlourTypes []string `in:"query=flour_type"`
+	MinProtein *float64 `in:"query=min_protein"`
+	MaxProtein *float64 `in:"query=max_protein"`
+	Sort       string   `in:"query=sort"`
+	Order      string   `in:"query=order"`
+	Cursor     string   `in:"query=cursor"`
+	Offset     int      `in:"query=offset;default=0"`
+	Limit      int      `in:"query=limit;default=25"`
+}
+
+func (input FindFlourInput) ToFilter() domain.FlourFilter {
+	return domain.FlourFilter{
+		FlourTypes: input.FlourTypes,
+		MinProtein: input.MinProtein,
+		MaxProtein: input.MaxProtein,
+	}
+}
+
+func (input FindFlourInput) ToPageParams() domain.PageParams {
+	return domain.PageParams{
+		Sort:   input.Sort,
+		Order:  input.Order,
+		Cursor: input.Cursor,
+		Offset: input.Offset,
+		Limit:  input.Limit,
+	}
+}
+
 type SearchFlourInput struct {
 	Name string `in:"query=name"`
 }
 
+// ImportFlourInput only validates the file and reports what an import would
+// do when DryRun is set.
+type ImportFlourInput struct {
+	DryRun bool `in:"query=dry_run"`
+}
+
 type flourHandler struct {
 	service domain.FlourService
 }
@@ -79,15 +121,15 @@ func (handler *flourHandler) getIdParam(res http.ResponseWriter, req *http.Reque
 
 func (handler *flourHandler) Find() http.HandlerFunc {
 	return func(res http.ResponseWriter, req *http.Request) {
-		page := req.Context().Value(httpin.Input).(*PageInput)
+		input := req.Context().Value(httpin.Input).(*FindFlourInput)
 
-		flourDtos, err := handler.service.Find(req.Context(), page.Offset, page.Limit)
+		flourPage, err := handler.service.Find(req.Context(), input.ToFilter(), input.ToPageParams())
 		if err != nil {
 			HandlerError(res, req, err)
 			return
 		}
 
-		render.JSON(res, req, flourDtos)
+		render.JSON(res, req, flourPage)
 	}
 }
 
@@ -105,6 +147,66 @@ func (handler *flourHandler) Search() http.HandlerFunc {
 	}
 }
 
+func (handler *flourHandler) TextSearch() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		search := req.Context().Value(httpin.Input).(*TextSearchInput)
+
+		flourDtos, err := handler.service.TextSearch(req.Context(), search.Query, search.Offset, search.Limit)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, flourDtos)
+	}
+}
+
+func (handler *flourHandler) ExportCsv() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		var content bytes.Buffer
+
+		if err := handler.service.ExportCsv(req.Context(), &content); err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		res.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		res.Header().Set("Content-Disposition", `attachment; filename="flours.csv"`)
+		_, _ = res.Write(content.Bytes())
+	}
+}
+
+func (handler *flourHandler) ImportCsv() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		input := req.Context().Value(httpin.Input).(*ImportFlourInput)
+
+		req.Body = http.MaxBytesReader(res, req.Body, flourImportMaxSize+multipartOverhead)
+
+		if err := req.ParseMultipartForm(multipartFormMemory); err != nil {
+			HandlerError(res, req, internalErrors.NewBadRequestError(flourImportFormInvalid, "multipart form is not valid", err.Error()))
+			return
+		}
+		defer func() {
+			_ = req.MultipartForm.RemoveAll()
+		}()
+
+		file, _, err := req.FormFile("file")
+		if err != nil {
+			HandlerError(res, req, internalErrors.NewBadRequestError(flourImportFileMissing, "file is required", "multipart field 'file' is required"))
+			return
+		}
+		defer file.Close()
+
+		result, err := handler.service.ImportCsv(req.Context(), file, input.DryRun)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, result)
+	}
+}
+
 func NewFlourHandler(service domain.FlourService) (domain.FlourHandler, error) {
 	if service == nil {
 		return nil, errors.New("service is nil")
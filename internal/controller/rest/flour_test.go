@@ -2,10 +2,14 @@ package rest
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/ggicci/httpin"
@@ -202,33 +206,31 @@ func (suite *FlourHandlerTestSuite) TestFindFlourById_WithErrorOnFindById() {
 }
 
 func (suite *FlourHandlerTestSuite) FindFlour() {
-	flours := []domain.FlourDto{createFlour()}
-
-	suite.service.EXPECT().Find(gomock.Any(), 1, 10).Return(flours, nil)
+	suite.service.EXPECT().
+		Find(gomock.Any(), domain.FlourFilter{}, domain.PageParams{Sort: "name", Order: "asc", Cursor: "next", Offset: 1, Limit: 10}).
+		Return(createFlourPage(), nil)
 
 	router := chi.NewRouter()
 	router.
-		With(httpin.NewInput(PageInput{})).
+		With(httpin.NewInput(FindFlourInput{})).
 		Get("/find", suite.target.Find())
 
-	req, err := http.NewRequest("GET", "/find?offset=1&limit=10", nil)
+	req, err := http.NewRequest("GET", "/find?offset=1&limit=10&sort=name&order=asc&cursor=next", nil)
 	suite.Require().NoError(err)
 
 	resp := httptest.NewRecorder()
 
 	router.ServeHTTP(resp, req)
 
-	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/flours_response.json")
+	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/flour_page_response.json")
 }
 
 func (suite *FlourHandlerTestSuite) TestFindFlour_WithDefaultParameters() {
-	flours := []domain.FlourDto{createFlour()}
-
-	suite.service.EXPECT().Find(gomock.Any(), 0, 25).Return(flours, nil)
+	suite.service.EXPECT().Find(gomock.Any(), domain.FlourFilter{}, domain.PageParams{Limit: 25}).Return(createFlourPage(), nil)
 
 	router := chi.NewRouter()
 	router.
-		With(httpin.NewInput(PageInput{})).
+		With(httpin.NewInput(FindFlourInput{})).
 		Get("/", suite.target.Find())
 
 	req, err := http.NewRequest("GET", "/", nil)
@@ -238,16 +240,43 @@ func (suite *FlourHandlerTestSuite) TestFindFlour_WithDefaultParameters() {
 
 	router.ServeHTTP(resp, req)
 
-	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/flours_response.json")
+	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/flour_page_response.json")
+}
+
+func (suite *FlourHandlerTestSuite) TestFindFlour_WithFilter() {
+	minProtein := 11.5
+	maxProtein := 14.0
+
+	suite.service.EXPECT().
+		Find(gomock.Any(), domain.FlourFilter{
+			FlourTypes: []string{"bread", "spelt"},
+			MinProtein: &minProtein,
+			MaxProtein: &maxProtein,
+		}, domain.PageParams{Sort: "protein", Limit: 25}).
+		Return(createFlourPage(), nil)
+
+	router := chi.NewRouter()
+	router.
+		With(httpin.NewInput(FindFlourInput{})).
+		Get("/", suite.target.Find())
+
+	req, err := http.NewRequest("GET", "/?flour_type=bread&flour_type=spelt&min_protein=11.5&max_protein=14&sort=protein", nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/flour_page_response.json")
 }
 
 func (suite *FlourHandlerTestSuite) TestFindFlour_WithErrorOnFind() {
-	suite.service.EXPECT().Find(gomock.Any(), 0, 25).
-		Return(nil, internalErrors.NewBadRequestErrorf(123, "error", "error %s", "'test'"))
+	suite.service.EXPECT().Find(gomock.Any(), domain.FlourFilter{}, domain.PageParams{Limit: 25}).
+		Return(domain.FlourPageDto{}, internalErrors.NewBadRequestErrorf(123, "error", "error %s", "'test'"))
 
 	router := chi.NewRouter()
 	router.
-		With(httpin.NewInput(PageInput{})).
+		With(httpin.NewInput(FindFlourInput{})).
 		Get("/", suite.target.Find())
 
 	req, err := http.NewRequest("GET", "/", nil)
@@ -312,12 +341,239 @@ func (suite *FlourHandlerTestSuite) TestSearchFlourByName_WithErrorOnSearch() {
 	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
 }
 
+func (suite *FlourHandlerTestSuite) TestTextSearch() {
+	suite.service.EXPECT().TextSearch(gomock.Any(), "whole wheat", 0, 25).
+		Return(domain.FlourTextSearchResultDto{
+			Items: []domain.FlourTextSearchHitDto{
+				{
+					Flour:      createFlour(),
+					Score:      2.25,
+					Highlights: map[string][]string{"name": {"<em>Whole</em> <em>Wheat</em> Flour"}},
+				},
+			},
+			Total: 1,
+		}, nil)
+
+	router := chi.NewRouter()
+	router.
+		With(httpin.NewInput(TextSearchInput{})).
+		Get("/search/text", suite.target.TextSearch())
+
+	req, err := http.NewRequest("GET", "/search/text?q=whole%20wheat", nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+        "items": [
+          {
+            "flour": {
+              "id": "74c65c5a-44e7-44a2-8cdd-cd7c49cfcb42",
+              "flour_type": "Whole Wheat",
+              "name": "Whole Wheat Flour",
+              "description": "Whole grain flour milled from red wheat berries",
+              "nutrition_facts": {
+                "calories": 100,
+                "fat": 1,
+                "carbs": 21,
+                "protein": 4,
+                "fiber": 3
+              }
+            },
+            "score": 2.25,
+            "highlights": {
+              "name": ["<em>Whole</em> <em>Wheat</em> Flour"]
+            }
+          }
+        ],
+        "total": 1
+        }`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusOK, expectedBodyJson)
+}
+
+func (suite *FlourHandlerTestSuite) TestTextSearch_WithErrorOnSearch() {
+	suite.service.EXPECT().TextSearch(gomock.Any(), "rye", 0, 25).
+		Return(domain.FlourTextSearchResultDto{}, internalErrors.NewBadRequestErrorf(123, "error", "error %s", "'test'"))
+
+	router := chi.NewRouter()
+	router.
+		With(httpin.NewInput(TextSearchInput{})).
+		Get("/search/text", suite.target.TextSearch())
+
+	req, err := http.NewRequest("GET", "/search/text?q=rye", nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+        "error_code": 123,
+        "error_details": "error 'test'",
+        "error_message": "error"
+        }`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *FlourHandlerTestSuite) TestExportCsv() {
+	suite.service.EXPECT().ExportCsv(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, writer io.Writer) error {
+			_, err := io.WriteString(writer, "id,name\n"+test.FirstId.String()+",Rye flour\n")
+			return err
+		})
+
+	req := httptest.NewRequest("GET", "/export.csv", nil)
+	resp := httptest.NewRecorder()
+
+	suite.target.ExportCsv().ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusOK, resp.Code)
+	suite.Equal("text/csv; charset=utf-8", resp.Header().Get("Content-Type"))
+	suite.Equal(`attachment; filename="flours.csv"`, resp.Header().Get("Content-Disposition"))
+	suite.Equal("id,name\n"+test.FirstId.String()+",Rye flour\n", resp.Body.String())
+}
+
+func (suite *FlourHandlerTestSuite) TestExportCsv_WithErrorOnExport() {
+	suite.service.EXPECT().ExportCsv(gomock.Any(), gomock.Any()).
+		Return(internalErrors.NewInternalServerError("failed to export flours", "error"))
+
+	req := httptest.NewRequest("GET", "/export.csv", nil)
+	resp := httptest.NewRecorder()
+
+	suite.target.ExportCsv().ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusInternalServerError, resp.Code)
+	suite.NotEqual("text/csv; charset=utf-8", resp.Header().Get("Content-Type"))
+}
+
+func (suite *FlourHandlerTestSuite) TestImportCsv() {
+	flourId := test.FirstId
+	result := domain.FlourImportResultDto{
+		DryRun:  true,
+		Created: 1,
+		Failed:  1,
+		Rows: []domain.FlourImportRowDto{
+			{Row: 2, Name: "Rye flour", Action: domain.FlourImportActionCreated, FlourId: &flourId},
+			{Row: 3, Action: domain.FlourImportActionFailed, Errors: []string{"name is required"}},
+		},
+	}
+
+	suite.service.EXPECT().ImportCsv(gomock.Any(), gomock.Any(), true).
+		DoAndReturn(func(_ context.Context, reader io.Reader, _ bool) (domain.FlourImportResultDto, error) {
+			content, err := io.ReadAll(reader)
+			suite.Require().NoError(err)
+			suite.Equal("name\nRye flour\n\n", string(content))
+			return result, nil
+		})
+
+	body, contentType := suite.multipartBody("flours.csv", []byte("name\nRye flour\n\n"))
+
+	resp := suite.serveImport("/import?dry_run=true", body, contentType)
+
+	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/flour_import_response.json")
+}
+
+func (suite *FlourHandlerTestSuite) TestImportCsv_WithInvalidRequest() {
+	tests := []struct {
+		name             string
+		body             func() (io.Reader, string)
+		expectedBodyJson string
+	}{
+		{
+			name: "missing file",
+			body: func() (io.Reader, string) {
+				return suite.multipartBody("", nil)
+			},
+			expectedBodyJson: `{
+				"error_code": 20101,
+				"error_details": "multipart field 'file' is required",
+				"error_message": "file is required"
+			}`,
+		},
+		{
+			name: "not a multipart form",
+			body: func() (io.Reader, string) {
+				return strings.NewReader("name\nRye flour\n"), "text/csv"
+			},
+			expectedBodyJson: `{
+				"error_code": 20102,
+				"error_details": "request Content-Type isn't multipart/form-data",
+				"error_message": "multipart form is not valid"
+			}`,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			body, contentType := tt.body()
+
+			resp := suite.serveImport("/import", body, contentType)
+
+			test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, tt.expectedBodyJson)
+		})
+	}
+}
+
+func (suite *FlourHandlerTestSuite) TestImportCsv_WithErrorOnImport() {
+	suite.service.EXPECT().ImportCsv(gomock.Any(), gomock.Any(), false).
+		Return(domain.FlourImportResultDto{}, internalErrors.FlourImportInvalid("column name is required"))
+
+	body, contentType := suite.multipartBody("flours.csv", []byte("flour_type\nrye\n"))
+
+	resp := suite.serveImport("/import", body, contentType)
+
+	expectedBodyJson :=
+		`{
+        "error_code": 20005,
+        "error_details": "column name is required",
+        "error_message": "invalid flour import"
+        }`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
 func TestNewFlourHandler_WithNilService(t *testing.T) {
 	_, err := NewFlourHandler(nil)
 
 	assert.ErrorContains(t, err, "service is nil")
 }
 
+func (suite *FlourHandlerTestSuite) serveImport(url string, body io.Reader, contentType string) *httptest.ResponseRecorder {
+	router := chi.NewRouter()
+	router.
+		With(httpin.NewInput(ImportFlourInput{})).
+		Post("/import", suite.target.ImportCsv())
+
+	req, err := http.NewRequest("POST", url, body)
+	suite.Require().NoError(err)
+	req.Header.Set("Content-Type", contentType)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func (suite *FlourHandlerTestSuite) multipartBody(fileName string, content []byte) (io.Reader, string) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	if fileName != "" {
+		part, err := writer.CreateFormFile("file", fileName)
+		suite.Require().NoError(err)
+		_, err = part.Write(content)
+		suite.Require().NoError(err)
+	}
+
+	suite.Require().NoError(writer.Close())
+
+	return &body, writer.FormDataContentType()
+}
+
 func generateCreateFlourRequest() domain.CreateFlourRequest {
 	return domain.CreateFlourRequest{
 		FlourType:   "Whole Wheat",
@@ -333,6 +589,13 @@ func generateCreateFlourRequest() domain.CreateFlourRequest {
 	}
 }
 
+func createFlourPage() domain.FlourPageDto {
+	return domain.FlourPageDto{
+		Items:   []domain.FlourDto{createFlour()},
+		PageDto: domain.PageDto{Total: 1, NextCursor: "next"},
+	}
+}
+
 func createFlour() domain.FlourDto {
 	return domain.FlourDto{
 		Id:          test.FirstId,
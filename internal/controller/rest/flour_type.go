@@ -0,0 +1,133 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+)
+
+const (
+	flourTypeIdNotFound = 21101
+	flourTypeIdNotValid = 21102
+)
+
+type flourTypeHandler struct {
+	service domain.FlourTypeService
+}
+
+func (handler *flourTypeHandler) Create() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		var request domain.CreateFlourTypeRequest
+
+		if err := render.DecodeJSON(req.Body, &request); err != nil {
+			HandlerError(res, req, errors.Wrap(err, "error while decoding request body"))
+			return
+		}
+
+		flourType, err := handler.service.Create(req.Context(), request)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.Status(req, http.StatusCreated)
+		render.JSON(res, req, flourType)
+	}
+}
+
+func (handler *flourTypeHandler) FindById() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		flourTypeId := handler.getIdParam(res, req)
+		if flourTypeId == nil {
+			return
+		}
+
+		flourType, err := handler.service.FindById(req.Context(), *flourTypeId)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, flourType)
+	}
+}
+
+func (handler *flourTypeHandler) FindAll() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		flourTypes, err := handler.service.FindAll(req.Context())
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, flourTypes)
+	}
+}
+
+func (handler *flourTypeHandler) Update() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		flourTypeId := handler.getIdParam(res, req)
+		if flourTypeId == nil {
+			return
+		}
+
+		var request domain.UpdateFlourTypeRequest
+
+		if err := render.DecodeJSON(req.Body, &request); err != nil {
+			HandlerError(res, req, errors.Wrap(err, "error while decoding request body"))
+			return
+		}
+
+		flourType, err := handler.service.Update(req.Context(), *flourTypeId, request)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, flourType)
+	}
+}
+
+func (handler *flourTypeHandler) Delete() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		flourTypeId := handler.getIdParam(res, req)
+		if flourTypeId == nil {
+			return
+		}
+
+		if err := handler.service.Delete(req.Context(), *flourTypeId); err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		res.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (handler *flourTypeHandler) getIdParam(res http.ResponseWriter, req *http.Request) *uuid.UUID {
+	param := chi.URLParam(req, "id")
+	if param == "" {
+		HandlerError(res, req, internalErrors.NewBadRequestError(flourTypeIdNotFound, "id is required", "id is required"))
+		return nil
+	}
+	id, err := uuid.Parse(param)
+	if err != nil {
+		HandlerError(res, req, internalErrors.NewBadRequestError(flourTypeIdNotValid, "id is not valid", "id is not valid"))
+		return nil
+	}
+	return &id
+}
+
+func NewFlourTypeHandler(service domain.FlourTypeService) (domain.FlourTypeHandler, error) {
+	if service == nil {
+		return nil, errors.New("service cannot be nil")
+	}
+
+	return &flourTypeHandler{service: service}, nil
+}
@@ -0,0 +1,356 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func TestFlourTypeHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(FlourTypeHandlerTestSuite))
+}
+
+type FlourTypeHandlerTestSuite struct {
+	test.GoMockTestSuite
+
+	service *mocks.MockFlourTypeService
+
+	target domain.FlourTypeHandler
+}
+
+func (suite *FlourTypeHandlerTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.service = mocks.NewMockFlourTypeService(suite.MockCtrl)
+
+	suite.target = test.Must(func() (domain.FlourTypeHandler, error) {
+		return NewFlourTypeHandler(suite.service)
+	})
+}
+
+func (suite *FlourTypeHandlerTestSuite) TestCreate() {
+	request := domain.CreateFlourTypeRequest{
+		Code:        "Whole Wheat",
+		Name:        "Whole wheat",
+		Description: "Wheat flour milled from the whole kernel",
+	}
+
+	suite.service.EXPECT().Create(gomock.Any(), request).
+		Return(createFlourType(), nil)
+
+	router := chi.NewRouter()
+	router.Post("/flour/types", suite.target.Create())
+
+	body, err := json.Marshal(request)
+	suite.Require().NoError(err)
+
+	req, err := http.NewRequest("POST", "/flour/types", bytes.NewReader(body))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusCreated, "testdata/flour_type_response.json")
+}
+
+func (suite *FlourTypeHandlerTestSuite) TestCreate_WithInvalidBody() {
+	router := chi.NewRouter()
+	router.Post("/flour/types", suite.target.Create())
+
+	req, err := http.NewRequest("POST", "/flour/types", bytes.NewReader([]byte("{")))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusInternalServerError, resp.Code)
+}
+
+func (suite *FlourTypeHandlerTestSuite) TestCreate_WithErrorOnCreate() {
+	suite.service.EXPECT().Create(gomock.Any(), domain.CreateFlourTypeRequest{Code: "rye", Name: "Rye"}).
+		Return(domain.FlourTypeDto{}, internalErrors.FlourTypeAlreadyExists("rye"))
+
+	router := chi.NewRouter()
+	router.Post("/flour/types", suite.target.Create())
+
+	req, err := http.NewRequest("POST", "/flour/types", bytes.NewReader([]byte(`{"code": "rye", "name": "Rye"}`)))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 21003,
+			"error_details": "flour type with code rye already exists",
+			"error_message": "flour type already exists"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *FlourTypeHandlerTestSuite) TestFindById() {
+	suite.service.EXPECT().FindById(gomock.Any(), test.FirstId).
+		Return(createFlourType(), nil)
+
+	router := chi.NewRouter()
+	router.Get("/flour/types/{id}", suite.target.FindById())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/flour/types/%s", test.FirstId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/flour_type_response.json")
+}
+
+func (suite *FlourTypeHandlerTestSuite) TestFindById_WithInvalidParams() {
+	tests := []struct {
+		name             string
+		route            string
+		path             string
+		expectedBodyJson string
+	}{
+		{
+			name:  "missing id",
+			route: "/flour/types",
+			path:  "/flour/types",
+			expectedBodyJson: `{
+				"error_code": 21101,
+				"error_details": "id is required",
+				"error_message": "id is required"
+			}`,
+		},
+		{
+			name:  "invalid id",
+			route: "/flour/types/{id}",
+			path:  "/flour/types/invalid",
+			expectedBodyJson: `{
+				"error_code": 21102,
+				"error_details": "id is not valid",
+				"error_message": "id is not valid"
+			}`,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			router := chi.NewRouter()
+			router.Get(tt.route, suite.target.FindById())
+
+			req, err := http.NewRequest("GET", tt.path, nil)
+			suite.Require().NoError(err)
+
+			resp := httptest.NewRecorder()
+
+			router.ServeHTTP(resp, req)
+
+			test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, tt.expectedBodyJson)
+		})
+	}
+}
+
+func (suite *FlourTypeHandlerTestSuite) TestFindById_WithErrorOnFind() {
+	suite.service.EXPECT().FindById(gomock.Any(), test.FirstId).
+		Return(domain.FlourTypeDto{}, internalErrors.NewBadRequestErrorf(123, "error", "error %s", "'test'"))
+
+	router := chi.NewRouter()
+	router.Get("/flour/types/{id}", suite.target.FindById())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/flour/types/%s", test.FirstId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 123,
+			"error_details": "error 'test'",
+			"error_message": "error"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *FlourTypeHandlerTestSuite) TestFindAll() {
+	flourTypes := []domain.FlourTypeDto{createFlourType()}
+
+	suite.service.EXPECT().FindAll(gomock.Any()).
+		Return(flourTypes, nil)
+
+	router := chi.NewRouter()
+	router.Get("/flour/types", suite.target.FindAll())
+
+	req, err := http.NewRequest("GET", "/flour/types", nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusOK, resp.Code)
+
+	var actual []domain.FlourTypeDto
+	suite.Require().NoError(json.NewDecoder(resp.Body).Decode(&actual))
+	suite.Equal(flourTypes, actual)
+}
+
+func (suite *FlourTypeHandlerTestSuite) TestFindAll_WithErrorOnFind() {
+	suite.service.EXPECT().FindAll(gomock.Any()).
+		Return(nil, internalErrors.NewBadRequestErrorf(123, "error", "error %s", "'test'"))
+
+	router := chi.NewRouter()
+	router.Get("/flour/types", suite.target.FindAll())
+
+	req, err := http.NewRequest("GET", "/flour/types", nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 123,
+			"error_details": "error 'test'",
+			"error_message": "error"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *FlourTypeHandlerTestSuite) TestUpdate() {
+	request := domain.UpdateFlourTypeRequest{
+		Name:        "Whole wheat",
+		Description: "Wheat flour milled from the whole kernel",
+	}
+
+	suite.service.EXPECT().Update(gomock.Any(), test.FirstId, request).
+		Return(createFlourType(), nil)
+
+	router := chi.NewRouter()
+	router.Put("/flour/types/{id}", suite.target.Update())
+
+	body, err := json.Marshal(request)
+	suite.Require().NoError(err)
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("/flour/types/%s", test.FirstId), bytes.NewReader(body))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/flour_type_response.json")
+}
+
+func (suite *FlourTypeHandlerTestSuite) TestUpdate_WithInvalidBody() {
+	router := chi.NewRouter()
+	router.Put("/flour/types/{id}", suite.target.Update())
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("/flour/types/%s", test.FirstId), bytes.NewReader([]byte("{")))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusInternalServerError, resp.Code)
+}
+
+func (suite *FlourTypeHandlerTestSuite) TestUpdate_WithErrorOnUpdate() {
+	suite.service.EXPECT().Update(gomock.Any(), test.FirstId, domain.UpdateFlourTypeRequest{}).
+		Return(domain.FlourTypeDto{}, internalErrors.FlourTypeInvalid("name is required"))
+
+	router := chi.NewRouter()
+	router.Put("/flour/types/{id}", suite.target.Update())
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("/flour/types/%s", test.FirstId), bytes.NewReader([]byte(`{}`)))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 21002,
+			"error_details": "name is required",
+			"error_message": "invalid flour type"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *FlourTypeHandlerTestSuite) TestDelete() {
+	suite.service.EXPECT().Delete(gomock.Any(), test.FirstId).Return(nil)
+
+	router := chi.NewRouter()
+	router.Delete("/flour/types/{id}", suite.target.Delete())
+
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("/flour/types/%s", test.FirstId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusNoContent, resp.Code)
+	suite.Empty(resp.Body.String())
+}
+
+func (suite *FlourTypeHandlerTestSuite) TestDelete_WithErrorOnDelete() {
+	suite.service.EXPECT().Delete(gomock.Any(), test.FirstId).
+		Return(internalErrors.FlourTypeInUse("rye", 2))
+
+	router := chi.NewRouter()
+	router.Delete("/flour/types/{id}", suite.target.Delete())
+
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("/flour/types/%s", test.FirstId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 21004,
+			"error_details": "flour type rye is used by 2 flours",
+			"error_message": "flour type is in use"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func TestNewFlourTypeHandler_WithNilService(t *testing.T) {
+	handler, err := NewFlourTypeHandler(nil)
+
+	assert.ErrorContains(t, err, "service cannot be nil")
+	assert.Nil(t, handler)
+}
+
+func createFlourType() domain.FlourTypeDto {
+	return domain.FlourTypeDto{
+		Id:          test.FirstId,
+		Code:        "whole_wheat",
+		Name:        "Whole wheat",
+		Description: "Wheat flour milled from the whole kernel",
+		CreatedAt:   test.Date,
+	}
+}
@@ -0,0 +1,41 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/domain"
+)
+
+type hydrationHandler struct {
+	service domain.HydrationService
+}
+
+func (handler *hydrationHandler) Suggest() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		var request domain.HydrationSuggestionRequest
+
+		if err := render.DecodeJSON(req.Body, &request); err != nil {
+			HandlerError(res, req, errors.Wrap(err, "error while decoding request body"))
+			return
+		}
+
+		suggestion, err := handler.service.Suggest(req.Context(), request)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, suggestion)
+	}
+}
+
+func NewHydrationHandler(service domain.HydrationService) (domain.HydrationHandler, error) {
+	if service == nil {
+		return nil, errors.New("service cannot be nil")
+	}
+
+	return &hydrationHandler{service: service}, nil
+}
@@ -0,0 +1,123 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func TestHydrationHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HydrationHandlerTestSuite))
+}
+
+type HydrationHandlerTestSuite struct {
+	test.GoMockTestSuite
+
+	service *mocks.MockHydrationService
+
+	target domain.HydrationHandler
+}
+
+func (suite *HydrationHandlerTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.service = mocks.NewMockHydrationService(suite.MockCtrl)
+
+	suite.target = test.Must(func() (domain.HydrationHandler, error) {
+		return NewHydrationHandler(suite.service)
+	})
+}
+
+func (suite *HydrationHandlerTestSuite) TestSuggest() {
+	request := domain.HydrationSuggestionRequest{
+		Flour: []domain.FlourAmountDto{{FlourDto: domain.FlourDto{Id: test.FirstId}, Amount: 1000}},
+		Style: "country",
+	}
+
+	suite.service.EXPECT().Suggest(gomock.Any(), request).
+		Return(domain.HydrationSuggestionDto{
+			Style:        "country",
+			FlourWeight:  1000,
+			Absorption:   75,
+			MinHydration: 75,
+			MaxHydration: 80,
+			Hydration:    77.5,
+			MinWater:     750,
+			MaxWater:     800,
+			Water:        775,
+			Flours: []domain.FlourAbsorptionDto{
+				{FlourId: test.FirstId, Name: "Whole wheat", FlourType: "whole_wheat", Amount: 1000, Absorption: 75, Estimated: true},
+			},
+		}, nil)
+
+	router := chi.NewRouter()
+	router.Post("/flour/hydration", suite.target.Suggest())
+
+	body, err := json.Marshal(request)
+	suite.Require().NoError(err)
+
+	req, err := http.NewRequest("POST", "/flour/hydration", bytes.NewReader(body))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/hydration_suggestion_response.json")
+}
+
+func (suite *HydrationHandlerTestSuite) TestSuggest_WithInvalidBody() {
+	router := chi.NewRouter()
+	router.Post("/flour/hydration", suite.target.Suggest())
+
+	req, err := http.NewRequest("POST", "/flour/hydration", bytes.NewReader([]byte("{")))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusInternalServerError, resp.Code)
+}
+
+func (suite *HydrationHandlerTestSuite) TestSuggest_WithErrorOnSuggest() {
+	suite.service.EXPECT().Suggest(gomock.Any(), domain.HydrationSuggestionRequest{Style: "focaccia"}).
+		Return(domain.HydrationSuggestionDto{}, internalErrors.HydrationRequestInvalid("flour or recipe_id is required"))
+
+	router := chi.NewRouter()
+	router.Post("/flour/hydration", suite.target.Suggest())
+
+	req, err := http.NewRequest("POST", "/flour/hydration", bytes.NewReader([]byte(`{"style": "focaccia"}`)))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 22001,
+			"error_details": "flour or recipe_id is required",
+			"error_message": "invalid hydration request"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func TestNewHydrationHandler_WithNilService(t *testing.T) {
+	handler, err := NewHydrationHandler(nil)
+
+	assert.ErrorContains(t, err, "service cannot be nil")
+	assert.Nil(t, handler)
+}
@@ -0,0 +1,228 @@
+package rest
+
+import (
+	"io"
+	"net/http"
+	"path/filepath"
+
+	"github.com/ggicci/httpin"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"dough-calculator/internal/config"
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+)
+
+const (
+	imageIdNotFound   = 14101
+	imageIdNotValid   = 14102
+	imageFileRequired = 14103
+	imageFormNotValid = 14104
+)
+
+const (
+	multipartFormMemory = 1 << 20
+	// multipartOverhead leaves room for boundaries and the other form fields next to the file
+	multipartOverhead = 64 << 10
+)
+
+type ImageSearchInput struct {
+	BakeId string `in:"query=bake_id"`
+	Offset int    `in:"query=offset;default=0"`
+	Limit  int    `in:"query=limit;default=25"`
+}
+
+type imageHandler struct {
+	service      domain.ImageService
+	maxImageSize int64
+}
+
+func (handler *imageHandler) Upload() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		recipeId := handler.getRecipeIdParam(res, req)
+		if recipeId == nil {
+			return
+		}
+
+		req.Body = http.MaxBytesReader(res, req.Body, handler.maxImageSize+multipartOverhead)
+
+		if err := req.ParseMultipartForm(multipartFormMemory); err != nil {
+			var maxBytesError *http.MaxBytesError
+			if errors.As(err, &maxBytesError) {
+				HandlerError(res, req, internalErrors.ImageTooLarge(req.ContentLength, handler.maxImageSize))
+				return
+			}
+			HandlerError(res, req, internalErrors.NewBadRequestError(imageFormNotValid, "multipart form is not valid", err.Error()))
+			return
+		}
+		defer func() {
+			_ = req.MultipartForm.RemoveAll()
+		}()
+
+		request := domain.UploadImageRequest{}
+
+		if bakeId := req.FormValue("bake_id"); bakeId != "" {
+			id, err := uuid.Parse(bakeId)
+			if err != nil {
+				HandlerError(res, req, internalErrors.NewBadRequestError(bakeLogIdNotValid, "bake id is not valid", "bake id is not valid"))
+				return
+			}
+			request.BakeLogId = &id
+		}
+
+		file, header, err := req.FormFile("file")
+		if err != nil {
+			HandlerError(res, req, internalErrors.NewBadRequestError(imageFileRequired, "file is required", "multipart field 'file' is required"))
+			return
+		}
+		defer file.Close()
+
+		// one byte over the limit is enough for the service to reject the upload
+		content, err := io.ReadAll(io.LimitReader(file, handler.maxImageSize+1))
+		if err != nil {
+			HandlerError(res, req, errors.Wrap(err, "error while reading uploaded file"))
+			return
+		}
+
+		request.FileName = filepath.Base(header.Filename)
+		request.Content = content
+
+		image, err := handler.service.Upload(req.Context(), *recipeId, request)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.Status(req, http.StatusCreated)
+		render.JSON(res, req, image)
+	}
+}
+
+func (handler *imageHandler) FindByRecipeId() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		recipeId := handler.getRecipeIdParam(res, req)
+		if recipeId == nil {
+			return
+		}
+
+		input := req.Context().Value(httpin.Input).(*ImageSearchInput)
+
+		var bakeLogId *uuid.UUID
+		if input.BakeId != "" {
+			id, err := uuid.Parse(input.BakeId)
+			if err != nil {
+				HandlerError(res, req, internalErrors.NewBadRequestError(bakeLogIdNotValid, "bake id is not valid", "bake id is not valid"))
+				return
+			}
+			bakeLogId = &id
+		}
+
+		images, err := handler.service.FindByRecipeId(req.Context(), *recipeId, bakeLogId, input.Offset, input.Limit)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, images)
+	}
+}
+
+func (handler *imageHandler) Download() http.HandlerFunc {
+	return handler.serve(false)
+}
+
+func (handler *imageHandler) Thumbnail() http.HandlerFunc {
+	return handler.serve(true)
+}
+
+func (handler *imageHandler) Delete() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		recipeId := handler.getRecipeIdParam(res, req)
+		if recipeId == nil {
+			return
+		}
+
+		imageId := handler.getImageIdParam(res, req)
+		if imageId == nil {
+			return
+		}
+
+		if err := handler.service.Delete(req.Context(), *recipeId, *imageId); err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		res.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (handler *imageHandler) serve(thumbnail bool) http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		recipeId := handler.getRecipeIdParam(res, req)
+		if recipeId == nil {
+			return
+		}
+
+		imageId := handler.getImageIdParam(res, req)
+		if imageId == nil {
+			return
+		}
+
+		image, err := handler.service.Open(req.Context(), *recipeId, *imageId, thumbnail)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+		defer image.Content.Close()
+
+		res.Header().Set("Content-Type", image.ContentType)
+		res.Header().Set("Cache-Control", "private, max-age=86400")
+		res.WriteHeader(http.StatusOK)
+
+		if _, err = io.Copy(res, image.Content); err != nil {
+			log.Err(err).
+				Str("id", imageId.String()).
+				Msg("failed to write image")
+		}
+	}
+}
+
+func (handler *imageHandler) getRecipeIdParam(res http.ResponseWriter, req *http.Request) *uuid.UUID {
+	param := chi.URLParam(req, "id")
+	if param == "" {
+		HandlerError(res, req, internalErrors.NewBadRequestError(recipeIdNotFound, "id is required", "id is required"))
+		return nil
+	}
+	id, err := uuid.Parse(param)
+	if err != nil {
+		HandlerError(res, req, internalErrors.NewBadRequestError(recipeIdNotValid, "id is not valid", "id is not valid"))
+		return nil
+	}
+	return &id
+}
+
+func (handler *imageHandler) getImageIdParam(res http.ResponseWriter, req *http.Request) *uuid.UUID {
+	param := chi.URLParam(req, "imageId")
+	if param == "" {
+		HandlerError(res, req, internalErrors.NewBadRequestError(imageIdNotFound, "image id is required", "image id is required"))
+		return nil
+	}
+	id, err := uuid.Parse(param)
+	if err != nil {
+		HandlerError(res, req, internalErrors.NewBadRequestError(imageIdNotValid, "image id is not valid", "image id is not valid"))
+		return nil
+	}
+	return &id
+}
+
+func NewImageHandler(service domain.ImageService, storage config.Storage) (domain.ImageHandler, error) {
+	if service == nil {
+		return nil, errors.New("service cannot be nil")
+	}
+
+	return &imageHandler{service: service, maxImageSize: storage.MaxImageSizeBytes()}, nil
+}
@@ -0,0 +1,433 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ggicci/httpin"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/config"
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func TestImageHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ImageHandlerTestSuite))
+}
+
+type ImageHandlerTestSuite struct {
+	test.GoMockTestSuite
+
+	service *mocks.MockImageService
+
+	target domain.ImageHandler
+}
+
+func (suite *ImageHandlerTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.service = mocks.NewMockImageService(suite.MockCtrl)
+
+	suite.target = test.Must(func() (domain.ImageHandler, error) {
+		return NewImageHandler(suite.service, config.Storage{MaxImageSize: 1024})
+	})
+}
+
+func (suite *ImageHandlerTestSuite) TestUpload() {
+	bakeLogId := test.FirstId
+	image := createImage()
+	image.BakeLogId = &bakeLogId
+
+	suite.service.EXPECT().Upload(gomock.Any(), test.ThirdId, domain.UploadImageRequest{
+		BakeLogId: &bakeLogId,
+		FileName:  "crumb.png",
+		Content:   []byte("image content"),
+	}).Return(image, nil)
+
+	body, contentType := suite.multipartBody(map[string]string{"bake_id": bakeLogId.String()}, "../crumb.png", []byte("image content"))
+
+	resp := suite.serveUpload(body, contentType)
+
+	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusCreated, "testdata/image_response.json")
+}
+
+func (suite *ImageHandlerTestSuite) TestUpload_WithInvalidRequest() {
+	tests := []struct {
+		name             string
+		body             func() (io.Reader, string)
+		expectedCode     int
+		expectedBodyJson string
+	}{
+		{
+			name: "missing file",
+			body: func() (io.Reader, string) {
+				return suite.multipartBody(map[string]string{}, "", nil)
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedBodyJson: `{
+				"error_code": 14103,
+				"error_details": "multipart field 'file' is required",
+				"error_message": "file is required"
+			}`,
+		},
+		{
+			name: "invalid bake id",
+			body: func() (io.Reader, string) {
+				return suite.multipartBody(map[string]string{"bake_id": "invalid"}, "crumb.png", []byte("content"))
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedBodyJson: `{
+				"error_code": 13102,
+				"error_details": "bake id is not valid",
+				"error_message": "bake id is not valid"
+			}`,
+		},
+		{
+			name: "not a multipart form",
+			body: func() (io.Reader, string) {
+				return bytes.NewReader([]byte("{}")), "application/json"
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedBodyJson: `{
+				"error_code": 14104,
+				"error_details": "request Content-Type isn't multipart/form-data",
+				"error_message": "multipart form is not valid"
+			}`,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			body, contentType := tt.body()
+
+			resp := suite.serveUpload(body, contentType)
+
+			test.VerifyRestResponse(suite.T(), resp, tt.expectedCode, tt.expectedBodyJson)
+		})
+	}
+}
+
+func (suite *ImageHandlerTestSuite) TestUpload_WithBodyExceedingLimit() {
+	body, contentType := suite.multipartBody(map[string]string{}, "crumb.png", make([]byte, 128<<10))
+
+	resp := suite.serveUpload(body, contentType)
+
+	suite.Equal(http.StatusBadRequest, resp.Code)
+
+	var actual map[string]any
+	suite.Require().NoError(json.NewDecoder(resp.Body).Decode(&actual))
+	suite.Equal(float64(14002), actual["error_code"])
+}
+
+func (suite *ImageHandlerTestSuite) TestUpload_WithErrorOnUpload() {
+	suite.service.EXPECT().Upload(gomock.Any(), test.ThirdId, gomock.Any()).
+		Return(domain.ImageDto{}, internalErrors.ImageUnsupportedContentType("text/plain"))
+
+	body, contentType := suite.multipartBody(map[string]string{}, "notes.txt", []byte("text"))
+
+	resp := suite.serveUpload(body, contentType)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 14003,
+			"error_details": "content type text/plain is not supported, use image/jpeg, image/png or image/gif",
+			"error_message": "unsupported image type"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *ImageHandlerTestSuite) TestFindByRecipeId() {
+	bakeLogId := test.FirstId
+	images := []domain.ImageDto{createImage()}
+
+	suite.service.EXPECT().FindByRecipeId(gomock.Any(), test.ThirdId, &bakeLogId, 1, 10).
+		Return(images, nil)
+
+	router := chi.NewRouter()
+	router.
+		With(httpin.NewInput(ImageSearchInput{})).
+		Get("/recipe/{id}/images", suite.target.FindByRecipeId())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/recipe/%s/images?bake_id=%s&offset=1&limit=10", test.ThirdId, bakeLogId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusOK, resp.Code)
+
+	var actual []domain.ImageDto
+	suite.Require().NoError(json.NewDecoder(resp.Body).Decode(&actual))
+	suite.Equal(images, actual)
+}
+
+func (suite *ImageHandlerTestSuite) TestFindByRecipeId_WithInvalidBakeId() {
+	router := chi.NewRouter()
+	router.
+		With(httpin.NewInput(ImageSearchInput{})).
+		Get("/recipe/{id}/images", suite.target.FindByRecipeId())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/recipe/%s/images?bake_id=invalid", test.ThirdId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 13102,
+			"error_details": "bake id is not valid",
+			"error_message": "bake id is not valid"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *ImageHandlerTestSuite) TestFindByRecipeId_WithErrorOnFind() {
+	suite.service.EXPECT().FindByRecipeId(gomock.Any(), test.ThirdId, nil, 0, 25).
+		Return(nil, internalErrors.NewBadRequestErrorf(123, "error", "error %s", "'test'"))
+
+	router := chi.NewRouter()
+	router.
+		With(httpin.NewInput(ImageSearchInput{})).
+		Get("/recipe/{id}/images", suite.target.FindByRecipeId())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/recipe/%s/images", test.ThirdId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 123,
+			"error_details": "error 'test'",
+			"error_message": "error"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *ImageHandlerTestSuite) TestDownload() {
+	tests := []struct {
+		name      string
+		path      string
+		thumbnail bool
+		handler   func() http.HandlerFunc
+	}{
+		{
+			name:    "image",
+			path:    "/recipe/{id}/images/{imageId}",
+			handler: suite.target.Download,
+		},
+		{
+			name:      "thumbnail",
+			path:      "/recipe/{id}/images/{imageId}/thumbnail",
+			thumbnail: true,
+			handler:   suite.target.Thumbnail,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.service.EXPECT().Open(gomock.Any(), test.ThirdId, test.SecondId, tt.thumbnail).
+				Return(domain.ImageContent{
+					ContentType: "image/png",
+					Content:     io.NopCloser(bytes.NewReader([]byte("image content"))),
+				}, nil)
+
+			router := chi.NewRouter()
+			router.Get(tt.path, tt.handler())
+
+			path := fmt.Sprintf("/recipe/%s/images/%s", test.ThirdId, test.SecondId)
+			if tt.thumbnail {
+				path += "/thumbnail"
+			}
+
+			req, err := http.NewRequest("GET", path, nil)
+			suite.Require().NoError(err)
+
+			resp := httptest.NewRecorder()
+
+			router.ServeHTTP(resp, req)
+
+			suite.Equal(http.StatusOK, resp.Code)
+			suite.Equal("image/png", resp.Header().Get("Content-Type"))
+			suite.Equal("image content", resp.Body.String())
+		})
+	}
+}
+
+func (suite *ImageHandlerTestSuite) TestDownload_WithInvalidParams() {
+	tests := []struct {
+		name             string
+		path             string
+		expectedBodyJson string
+	}{
+		{
+			name: "invalid id",
+			path: fmt.Sprintf("/recipe/invalid/images/%s", test.SecondId),
+			expectedBodyJson: `{
+				"error_code": 10002,
+				"error_details": "id is not valid",
+				"error_message": "id is not valid"
+			}`,
+		},
+		{
+			name: "invalid image id",
+			path: fmt.Sprintf("/recipe/%s/images/invalid", test.ThirdId),
+			expectedBodyJson: `{
+				"error_code": 14102,
+				"error_details": "image id is not valid",
+				"error_message": "image id is not valid"
+			}`,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			router := chi.NewRouter()
+			router.Get("/recipe/{id}/images/{imageId}", suite.target.Download())
+
+			req, err := http.NewRequest("GET", tt.path, nil)
+			suite.Require().NoError(err)
+
+			resp := httptest.NewRecorder()
+
+			router.ServeHTTP(resp, req)
+
+			test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, tt.expectedBodyJson)
+		})
+	}
+}
+
+func (suite *ImageHandlerTestSuite) TestDownload_WithErrorOnOpen() {
+	suite.service.EXPECT().Open(gomock.Any(), test.ThirdId, test.SecondId, false).
+		Return(domain.ImageContent{}, internalErrors.ImageNotFound(test.SecondId))
+
+	router := chi.NewRouter()
+	router.Get("/recipe/{id}/images/{imageId}", suite.target.Download())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/recipe/%s/images/%s", test.ThirdId, test.SecondId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 14001,
+			"error_details": "image with id a7670bf9-f4b0-4e5c-8edc-140812dbf719 not found",
+			"error_message": "image not found"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *ImageHandlerTestSuite) TestDelete() {
+	suite.service.EXPECT().Delete(gomock.Any(), test.ThirdId, test.SecondId).Return(nil)
+
+	router := chi.NewRouter()
+	router.Delete("/recipe/{id}/images/{imageId}", suite.target.Delete())
+
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("/recipe/%s/images/%s", test.ThirdId, test.SecondId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusNoContent, resp.Code)
+	suite.Empty(resp.Body.String())
+}
+
+func (suite *ImageHandlerTestSuite) TestDelete_WithErrorOnDelete() {
+	suite.service.EXPECT().Delete(gomock.Any(), test.ThirdId, test.SecondId).
+		Return(internalErrors.ImageNotFound(test.SecondId))
+
+	router := chi.NewRouter()
+	router.Delete("/recipe/{id}/images/{imageId}", suite.target.Delete())
+
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("/recipe/%s/images/%s", test.ThirdId, test.SecondId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 14001,
+			"error_details": "image with id a7670bf9-f4b0-4e5c-8edc-140812dbf719 not found",
+			"error_message": "image not found"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func TestNewImageHandler_WithNilService(t *testing.T) {
+	handler, err := NewImageHandler(nil, config.Storage{})
+
+	assert.ErrorContains(t, err, "service cannot be nil")
+	assert.Nil(t, handler)
+}
+
+func (suite *ImageHandlerTestSuite) serveUpload(body io.Reader, contentType string) *httptest.ResponseRecorder {
+	router := chi.NewRouter()
+	router.Post("/recipe/{id}/images", suite.target.Upload())
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("/recipe/%s/images", test.ThirdId), body)
+	suite.Require().NoError(err)
+	req.Header.Set("Content-Type", contentType)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func (suite *ImageHandlerTestSuite) multipartBody(fields map[string]string, fileName string, content []byte) (io.Reader, string) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	for name, value := range fields {
+		suite.Require().NoError(writer.WriteField(name, value))
+	}
+
+	if fileName != "" {
+		part, err := writer.CreateFormFile("file", fileName)
+		suite.Require().NoError(err)
+		_, err = part.Write(content)
+		suite.Require().NoError(err)
+	}
+
+	suite.Require().NoError(writer.Close())
+
+	return &body, writer.FormDataContentType()
+}
+
+func createImage() domain.ImageDto {
+	return domain.ImageDto{
+		Id:          test.SecondId,
+		RecipeId:    test.ThirdId,
+		FileName:    "crumb.png",
+		ContentType: "image/png",
+		Size:        13,
+		Width:       640,
+		Height:      480,
+		CreatedAt:   test.Date,
+	}
+}
@@ -0,0 +1,136 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/ggicci/httpin"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+)
+
+const (
+	inventoryItemIdNotFound = 24101
+	inventoryItemIdNotValid = 24102
+)
+
+// FindInventoryInput restricts the listing to the items at or below their
+// low stock threshold.
+type FindInventoryInput struct {
+	LowStock bool `in:"query=low_stock"`
+}
+
+type inventoryHandler struct {
+	service domain.InventoryService
+}
+
+func (handler *inventoryHandler) Upsert() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		var request domain.UpsertInventoryItemRequest
+
+		if err := render.DecodeJSON(req.Body, &request); err != nil {
+			HandlerError(res, req, errors.Wrap(err, "error while decoding request body"))
+			return
+		}
+
+		item, err := handler.service.Upsert(req.Context(), request)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, item)
+	}
+}
+
+func (handler *inventoryHandler) FindById() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		itemId := handler.getIdParam(res, req)
+		if itemId == nil {
+			return
+		}
+
+		item, err := handler.service.FindById(req.Context(), *itemId)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, item)
+	}
+}
+
+func (handler *inventoryHandler) Find() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		input := req.Context().Value(httpin.Input).(*FindInventoryInput)
+
+		items, err := handler.service.Find(req.Context(), input.LowStock)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, items)
+	}
+}
+
+func (handler *inventoryHandler) Delete() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		itemId := handler.getIdParam(res, req)
+		if itemId == nil {
+			return
+		}
+
+		if err := handler.service.Delete(req.Context(), *itemId); err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		res.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (handler *inventoryHandler) Shortages() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		var request domain.SourdoughRecipeBatchScaleRequest
+
+		if err := render.DecodeJSON(req.Body, &request); err != nil {
+			HandlerError(res, req, errors.Wrap(err, "error while decoding request body"))
+			return
+		}
+
+		shortages, err := handler.service.Shortages(req.Context(), request)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, shortages)
+	}
+}
+
+func (handler *inventoryHandler) getIdParam(res http.ResponseWriter, req *http.Request) *uuid.UUID {
+	param := chi.URLParam(req, "id")
+	if param == "" {
+		HandlerError(res, req, internalErrors.NewBadRequestError(inventoryItemIdNotFound, "id is required", "id is required"))
+		return nil
+	}
+	id, err := uuid.Parse(param)
+	if err != nil {
+		HandlerError(res, req, internalErrors.NewBadRequestError(inventoryItemIdNotValid, "id is not valid", "id is not valid"))
+		return nil
+	}
+	return &id
+}
+
+func NewInventoryHandler(service domain.InventoryService) (domain.InventoryHandler, error) {
+	if service == nil {
+		return nil, errors.New("service cannot be nil")
+	}
+
+	return &inventoryHandler{service: service}, nil
+}
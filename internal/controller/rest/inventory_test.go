@@ -0,0 +1,376 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ggicci/httpin"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func TestInventoryHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(InventoryHandlerTestSuite))
+}
+
+type InventoryHandlerTestSuite struct {
+	test.GoMockTestSuite
+
+	service *mocks.MockInventoryService
+
+	target domain.InventoryHandler
+}
+
+func (suite *InventoryHandlerTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.service = mocks.NewMockInventoryService(suite.MockCtrl)
+
+	suite.target = test.Must(func() (domain.InventoryHandler, error) {
+		return NewInventoryHandler(suite.service)
+	})
+}
+
+func (suite *InventoryHandlerTestSuite) TestUpsert() {
+	flourId := test.SecondId
+	request := domain.UpsertInventoryItemRequest{FlourId: &flourId, Quantity: 800, LowStockThreshold: 1000}
+
+	suite.service.EXPECT().Upsert(gomock.Any(), request).
+		Return(createInventoryItem(), nil)
+
+	router := chi.NewRouter()
+	router.Put("/inventory", suite.target.Upsert())
+
+	body, err := json.Marshal(request)
+	suite.Require().NoError(err)
+
+	req, err := http.NewRequest("PUT", "/inventory", bytes.NewReader(body))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/inventory_item_response.json")
+}
+
+func (suite *InventoryHandlerTestSuite) TestUpsert_WithInvalidBody() {
+	router := chi.NewRouter()
+	router.Put("/inventory", suite.target.Upsert())
+
+	req, err := http.NewRequest("PUT", "/inventory", bytes.NewReader([]byte("{")))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusInternalServerError, resp.Code)
+}
+
+func (suite *InventoryHandlerTestSuite) TestUpsert_WithErrorOnUpsert() {
+	suite.service.EXPECT().Upsert(gomock.Any(), domain.UpsertInventoryItemRequest{Quantity: 5}).
+		Return(domain.InventoryItemDto{}, internalErrors.InventoryItemInvalid("flour id or name is required"))
+
+	router := chi.NewRouter()
+	router.Put("/inventory", suite.target.Upsert())
+
+	req, err := http.NewRequest("PUT", "/inventory", bytes.NewReader([]byte(`{"quantity": 5}`)))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 24002,
+			"error_details": "flour id or name is required",
+			"error_message": "invalid inventory item"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *InventoryHandlerTestSuite) TestFindById() {
+	suite.service.EXPECT().FindById(gomock.Any(), test.FirstId).
+		Return(createInventoryItem(), nil)
+
+	router := chi.NewRouter()
+	router.Get("/inventory/{id}", suite.target.FindById())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/inventory/%s", test.FirstId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/inventory_item_response.json")
+}
+
+func (suite *InventoryHandlerTestSuite) TestFindById_WithInvalidParams() {
+	tests := []struct {
+		name             string
+		route            string
+		path             string
+		expectedBodyJson string
+	}{
+		{
+			name:  "missing id",
+			route: "/inventory",
+			path:  "/inventory",
+			expectedBodyJson: `{
+				"error_code": 24101,
+				"error_details": "id is required",
+				"error_message": "id is required"
+			}`,
+		},
+		{
+			name:  "invalid id",
+			route: "/inventory/{id}",
+			path:  "/inventory/invalid",
+			expectedBodyJson: `{
+				"error_code": 24102,
+				"error_details": "id is not valid",
+				"error_message": "id is not valid"
+			}`,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			router := chi.NewRouter()
+			router.Get(tt.route, suite.target.FindById())
+
+			req, err := http.NewRequest("GET", tt.path, nil)
+			suite.Require().NoError(err)
+
+			resp := httptest.NewRecorder()
+
+			router.ServeHTTP(resp, req)
+
+			test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, tt.expectedBodyJson)
+		})
+	}
+}
+
+func (suite *InventoryHandlerTestSuite) TestFindById_WithErrorOnFind() {
+	suite.service.EXPECT().FindById(gomock.Any(), test.FirstId).
+		Return(domain.InventoryItemDto{}, internalErrors.InventoryItemNotFound(test.FirstId))
+
+	router := chi.NewRouter()
+	router.Get("/inventory/{id}", suite.target.FindById())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/inventory/%s", test.FirstId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 24001,
+			"error_details": "inventory item with id 74c65c5a-44e7-44a2-8cdd-cd7c49cfcb42 not found",
+			"error_message": "inventory item not found"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *InventoryHandlerTestSuite) TestFind() {
+	items := []domain.InventoryItemDto{createInventoryItem()}
+
+	suite.service.EXPECT().Find(gomock.Any(), true).
+		Return(items, nil)
+
+	router := chi.NewRouter()
+	router.
+		With(httpin.NewInput(FindInventoryInput{})).
+		Get("/inventory", suite.target.Find())
+
+	req, err := http.NewRequest("GET", "/inventory?low_stock=true", nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusOK, resp.Code)
+
+	var actual []domain.InventoryItemDto
+	suite.Require().NoError(json.NewDecoder(resp.Body).Decode(&actual))
+	suite.Equal(items, actual)
+}
+
+func (suite *InventoryHandlerTestSuite) TestFind_WithErrorOnFind() {
+	suite.service.EXPECT().Find(gomock.Any(), false).
+		Return(nil, internalErrors.NewBadRequestErrorf(123, "error", "error %s", "'test'"))
+
+	router := chi.NewRouter()
+	router.
+		With(httpin.NewInput(FindInventoryInput{})).
+		Get("/inventory", suite.target.Find())
+
+	req, err := http.NewRequest("GET", "/inventory", nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 123,
+			"error_details": "error 'test'",
+			"error_message": "error"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *InventoryHandlerTestSuite) TestDelete() {
+	suite.service.EXPECT().Delete(gomock.Any(), test.FirstId).Return(nil)
+
+	router := chi.NewRouter()
+	router.Delete("/inventory/{id}", suite.target.Delete())
+
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("/inventory/%s", test.FirstId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusNoContent, resp.Code)
+	suite.Empty(resp.Body.String())
+}
+
+func (suite *InventoryHandlerTestSuite) TestDelete_WithErrorOnDelete() {
+	suite.service.EXPECT().Delete(gomock.Any(), test.FirstId).
+		Return(internalErrors.InventoryItemNotFound(test.FirstId))
+
+	router := chi.NewRouter()
+	router.Delete("/inventory/{id}", suite.target.Delete())
+
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("/inventory/%s", test.FirstId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 24001,
+			"error_details": "inventory item with id 74c65c5a-44e7-44a2-8cdd-cd7c49cfcb42 not found",
+			"error_message": "inventory item not found"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *InventoryHandlerTestSuite) TestShortages() {
+	request := domain.SourdoughRecipeBatchScaleRequest{
+		Items: []domain.SourdoughRecipeBatchScaleItemDto{{RecipeId: test.ThirdId, Pieces: 2, PieceWeight: 935}},
+	}
+	flourId := test.SecondId
+
+	suite.service.EXPECT().Shortages(gomock.Any(), request).
+		Return(domain.InventoryShortagesDto{
+			PickList: domain.PickListDto{
+				Flour:                 []domain.FlourAmountDto{{FlourDto: domain.FlourDto{Id: flourId, Name: "Rye flour"}, Amount: 1000}},
+				Water:                 750,
+				Starter:               100,
+				AdditionalIngredients: []domain.BakerAmountDto{{Name: "Salt", Amount: 20}},
+				TotalWeight:           1870,
+			},
+			Shortages: []domain.InventoryShortageDto{
+				{
+					Kind:     domain.InventoryKindFlour,
+					FlourId:  &flourId,
+					Name:     "Rye flour",
+					Required: 1000,
+					OnHand:   800,
+					Missing:  200,
+					Tracked:  true,
+				},
+				{Kind: domain.InventoryKindIngredient, Name: "Salt", Required: 20, Missing: 20},
+			},
+		}, nil)
+
+	router := chi.NewRouter()
+	router.Post("/inventory/shortages", suite.target.Shortages())
+
+	body, err := json.Marshal(request)
+	suite.Require().NoError(err)
+
+	req, err := http.NewRequest("POST", "/inventory/shortages", bytes.NewReader(body))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/inventory_shortages_response.json")
+}
+
+func (suite *InventoryHandlerTestSuite) TestShortages_WithInvalidBody() {
+	router := chi.NewRouter()
+	router.Post("/inventory/shortages", suite.target.Shortages())
+
+	req, err := http.NewRequest("POST", "/inventory/shortages", bytes.NewReader([]byte("{")))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusInternalServerError, resp.Code)
+}
+
+func (suite *InventoryHandlerTestSuite) TestShortages_WithErrorOnShortages() {
+	suite.service.EXPECT().Shortages(gomock.Any(), domain.SourdoughRecipeBatchScaleRequest{}).
+		Return(domain.InventoryShortagesDto{}, internalErrors.SourdoughRecipeBatchScaleInvalid("items are required"))
+
+	router := chi.NewRouter()
+	router.Post("/inventory/shortages", suite.target.Shortages())
+
+	req, err := http.NewRequest("POST", "/inventory/shortages", bytes.NewReader([]byte(`{}`)))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusBadRequest, resp.Code)
+}
+
+func TestNewInventoryHandler_WithNilService(t *testing.T) {
+	handler, err := NewInventoryHandler(nil)
+
+	assert.ErrorContains(t, err, "service cannot be nil")
+	assert.Nil(t, handler)
+}
+
+func createInventoryItem() domain.InventoryItemDto {
+	flourId := test.SecondId
+	return domain.InventoryItemDto{
+		Id:                test.FirstId,
+		Kind:              domain.InventoryKindFlour,
+		FlourId:           &flourId,
+		Name:              "Rye flour",
+		Quantity:          800,
+		LowStockThreshold: 1000,
+		LowStock:          true,
+		CreatedAt:         test.Date,
+	}
+}
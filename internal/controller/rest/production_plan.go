@@ -0,0 +1,222 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"text/tabwriter"
+
+	"github.com/ggicci/httpin"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+)
+
+const (
+	productionPlanIdNotFound         = 23101
+	productionPlanIdNotValid         = 23102
+	productionScheduleFormatNotValid = 23103
+)
+
+const (
+	productionScheduleFormatJson = "json"
+	productionScheduleFormatText = "text"
+)
+
+// ProductionScheduleInput selects how a schedule is rendered, as JSON or as
+// a printable plain text sheet.
+type ProductionScheduleInput struct {
+	Format string `in:"query=format;default=json"`
+}
+
+type productionPlanHandler struct {
+	service domain.ProductionPlanService
+}
+
+func (handler *productionPlanHandler) Create() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		var request domain.CreateProductionPlanRequest
+
+		if err := render.DecodeJSON(req.Body, &request); err != nil {
+			HandlerError(res, req, errors.Wrap(err, "error while decoding request body"))
+			return
+		}
+
+		plan, err := handler.service.Create(req.Context(), request)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.Status(req, http.StatusCreated)
+		render.JSON(res, req, plan)
+	}
+}
+
+func (handler *productionPlanHandler) FindById() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		planId := handler.getIdParam(res, req)
+		if planId == nil {
+			return
+		}
+
+		plan, err := handler.service.FindById(req.Context(), *planId)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, plan)
+	}
+}
+
+func (handler *productionPlanHandler) Find() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		page := req.Context().Value(httpin.Input).(*PageInput)
+
+		plans, err := handler.service.Find(req.Context(), page.Offset, page.Limit)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, plans)
+	}
+}
+
+func (handler *productionPlanHandler) Update() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		planId := handler.getIdParam(res, req)
+		if planId == nil {
+			return
+		}
+
+		var request domain.CreateProductionPlanRequest
+
+		if err := render.DecodeJSON(req.Body, &request); err != nil {
+			HandlerError(res, req, errors.Wrap(err, "error while decoding request body"))
+			return
+		}
+
+		plan, err := handler.service.Update(req.Context(), *planId, request)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, plan)
+	}
+}
+
+func (handler *productionPlanHandler) Delete() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		planId := handler.getIdParam(res, req)
+		if planId == nil {
+			return
+		}
+
+		if err := handler.service.Delete(req.Context(), *planId); err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		res.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (handler *productionPlanHandler) Complete() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		planId := handler.getIdParam(res, req)
+		if planId == nil {
+			return
+		}
+
+		plan, err := handler.service.Complete(req.Context(), *planId)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, plan)
+	}
+}
+
+func (handler *productionPlanHandler) Schedule() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		planId := handler.getIdParam(res, req)
+		if planId == nil {
+			return
+		}
+
+		input := req.Context().Value(httpin.Input).(*ProductionScheduleInput)
+		if input.Format != productionScheduleFormatJson && input.Format != productionScheduleFormatText {
+			HandlerError(res, req, internalErrors.NewBadRequestErrorf(productionScheduleFormatNotValid,
+				"format is not valid", "format %s is not valid, use json or text", input.Format))
+			return
+		}
+
+		schedule, err := handler.service.Schedule(req.Context(), *planId)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		if input.Format == productionScheduleFormatText {
+			res.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			writeProductionSchedule(res, schedule)
+			return
+		}
+
+		render.JSON(res, req, schedule)
+	}
+}
+
+// writeProductionSchedule prints the schedule as a table with one line per
+// mix, to be hung next to the mixer.
+func writeProductionSchedule(res http.ResponseWriter, schedule domain.ProductionScheduleDto) {
+	_, _ = fmt.Fprintf(res, "%s - %s\n", schedule.Name, schedule.Date.Format("Mon 02 Jan 2006"))
+	_, _ = fmt.Fprintf(res, "Mixer capacity: %.2f kg\n\n", schedule.MixerCapacity)
+
+	writer := tabwriter.NewWriter(res, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(writer, "#\tMix at\tRecipe\tBatch\tPieces\tDough (g)\tFermentation (min)\tReady at")
+	for _, mix := range schedule.Mixes {
+		_, _ = fmt.Fprintf(writer, "%d\t%s\t%s\t%d/%d\t%d x %d g\t%d\t%d\t%s\n",
+			mix.Sequence,
+			mix.MixAt.Format("15:04"),
+			mix.RecipeName,
+			mix.Batch,
+			mix.Batches,
+			mix.Pieces,
+			mix.PieceWeight,
+			mix.DoughWeight,
+			mix.FermentationMinutes,
+			mix.ReadyAt.Format("15:04"),
+		)
+	}
+	_ = writer.Flush()
+}
+
+func (handler *productionPlanHandler) getIdParam(res http.ResponseWriter, req *http.Request) *uuid.UUID {
+	param := chi.URLParam(req, "id")
+	if param == "" {
+		HandlerError(res, req, internalErrors.NewBadRequestError(productionPlanIdNotFound, "id is required", "id is required"))
+		return nil
+	}
+	id, err := uuid.Parse(param)
+	if err != nil {
+		HandlerError(res, req, internalErrors.NewBadRequestError(productionPlanIdNotValid, "id is not valid", "id is not valid"))
+		return nil
+	}
+	return &id
+}
+
+func NewProductionPlanHandler(service domain.ProductionPlanService) (domain.ProductionPlanHandler, error) {
+	if service == nil {
+		return nil, errors.New("service cannot be nil")
+	}
+
+	return &productionPlanHandler{service: service}, nil
+}
@@ -0,0 +1,558 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ggicci/httpin"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func TestProductionPlanHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ProductionPlanHandlerTestSuite))
+}
+
+type ProductionPlanHandlerTestSuite struct {
+	test.GoMockTestSuite
+
+	service *mocks.MockProductionPlanService
+
+	target domain.ProductionPlanHandler
+}
+
+func (suite *ProductionPlanHandlerTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.service = mocks.NewMockProductionPlanService(suite.MockCtrl)
+
+	suite.target = test.Must(func() (domain.ProductionPlanHandler, error) {
+		return NewProductionPlanHandler(suite.service)
+	})
+}
+
+func (suite *ProductionPlanHandlerTestSuite) TestCreate() {
+	request := createProductionPlanRequest()
+
+	suite.service.EXPECT().Create(gomock.Any(), request).
+		Return(createProductionPlan(), nil)
+
+	router := chi.NewRouter()
+	router.Post("/production-plans", suite.target.Create())
+
+	body, err := json.Marshal(request)
+	suite.Require().NoError(err)
+
+	req, err := http.NewRequest("POST", "/production-plans", bytes.NewReader(body))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusCreated, "testdata/production_plan_response.json")
+}
+
+func (suite *ProductionPlanHandlerTestSuite) TestCreate_WithInvalidBody() {
+	router := chi.NewRouter()
+	router.Post("/production-plans", suite.target.Create())
+
+	req, err := http.NewRequest("POST", "/production-plans", bytes.NewReader([]byte("{")))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusInternalServerError, resp.Code)
+}
+
+func (suite *ProductionPlanHandlerTestSuite) TestCreate_WithErrorOnCreate() {
+	suite.service.EXPECT().Create(gomock.Any(), domain.CreateProductionPlanRequest{Name: "Saturday market"}).
+		Return(domain.ProductionPlanDto{}, internalErrors.ProductionPlanInvalid("date is required"))
+
+	router := chi.NewRouter()
+	router.Post("/production-plans", suite.target.Create())
+
+	req, err := http.NewRequest("POST", "/production-plans", bytes.NewReader([]byte(`{"name": "Saturday market"}`)))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 23002,
+			"error_details": "date is required",
+			"error_message": "invalid production plan"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *ProductionPlanHandlerTestSuite) TestFindById() {
+	suite.service.EXPECT().FindById(gomock.Any(), test.FirstId).
+		Return(createProductionPlan(), nil)
+
+	router := chi.NewRouter()
+	router.Get("/production-plans/{id}", suite.target.FindById())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/production-plans/%s", test.FirstId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/production_plan_response.json")
+}
+
+func (suite *ProductionPlanHandlerTestSuite) TestFindById_WithInvalidParams() {
+	tests := []struct {
+		name             string
+		route            string
+		path             string
+		expectedBodyJson string
+	}{
+		{
+			name:  "missing id",
+			route: "/production-plans",
+			path:  "/production-plans",
+			expectedBodyJson: `{
+				"error_code": 23101,
+				"error_details": "id is required",
+				"error_message": "id is required"
+			}`,
+		},
+		{
+			name:  "invalid id",
+			route: "/production-plans/{id}",
+			path:  "/production-plans/invalid",
+			expectedBodyJson: `{
+				"error_code": 23102,
+				"error_details": "id is not valid",
+				"error_message": "id is not valid"
+			}`,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			router := chi.NewRouter()
+			router.Get(tt.route, suite.target.FindById())
+
+			req, err := http.NewRequest("GET", tt.path, nil)
+			suite.Require().NoError(err)
+
+			resp := httptest.NewRecorder()
+
+			router.ServeHTTP(resp, req)
+
+			test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, tt.expectedBodyJson)
+		})
+	}
+}
+
+func (suite *ProductionPlanHandlerTestSuite) TestFindById_WithErrorOnFind() {
+	suite.service.EXPECT().FindById(gomock.Any(), test.FirstId).
+		Return(domain.ProductionPlanDto{}, internalErrors.ProductionPlanNotFound(test.FirstId))
+
+	router := chi.NewRouter()
+	router.Get("/production-plans/{id}", suite.target.FindById())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/production-plans/%s", test.FirstId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 23001,
+			"error_details": "production plan with id 74c65c5a-44e7-44a2-8cdd-cd7c49cfcb42 not found",
+			"error_message": "production plan not found"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *ProductionPlanHandlerTestSuite) TestFind() {
+	plans := []domain.ProductionPlanDto{createProductionPlan()}
+
+	suite.service.EXPECT().Find(gomock.Any(), 1, 10).
+		Return(plans, nil)
+
+	router := chi.NewRouter()
+	router.
+		With(httpin.NewInput(PageInput{})).
+		Get("/production-plans", suite.target.Find())
+
+	req, err := http.NewRequest("GET", "/production-plans?offset=1&limit=10", nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusOK, resp.Code)
+
+	var actual []domain.ProductionPlanDto
+	suite.Require().NoError(json.NewDecoder(resp.Body).Decode(&actual))
+	suite.Equal(plans, actual)
+}
+
+func (suite *ProductionPlanHandlerTestSuite) TestFind_WithErrorOnFind() {
+	suite.service.EXPECT().Find(gomock.Any(), 0, 25).
+		Return(nil, internalErrors.NewBadRequestErrorf(123, "error", "error %s", "'test'"))
+
+	router := chi.NewRouter()
+	router.
+		With(httpin.NewInput(PageInput{})).
+		Get("/production-plans", suite.target.Find())
+
+	req, err := http.NewRequest("GET", "/production-plans", nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 123,
+			"error_details": "error 'test'",
+			"error_message": "error"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *ProductionPlanHandlerTestSuite) TestUpdate() {
+	request := createProductionPlanRequest()
+
+	suite.service.EXPECT().Update(gomock.Any(), test.FirstId, request).
+		Return(createProductionPlan(), nil)
+
+	router := chi.NewRouter()
+	router.Put("/production-plans/{id}", suite.target.Update())
+
+	body, err := json.Marshal(request)
+	suite.Require().NoError(err)
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("/production-plans/%s", test.FirstId), bytes.NewReader(body))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/production_plan_response.json")
+}
+
+func (suite *ProductionPlanHandlerTestSuite) TestUpdate_WithInvalidBody() {
+	router := chi.NewRouter()
+	router.Put("/production-plans/{id}", suite.target.Update())
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("/production-plans/%s", test.FirstId), bytes.NewReader([]byte("{")))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusInternalServerError, resp.Code)
+}
+
+func (suite *ProductionPlanHandlerTestSuite) TestUpdate_WithErrorOnUpdate() {
+	suite.service.EXPECT().Update(gomock.Any(), test.FirstId, domain.CreateProductionPlanRequest{}).
+		Return(domain.ProductionPlanDto{}, internalErrors.ProductionPlanInvalid("name is required"))
+
+	router := chi.NewRouter()
+	router.Put("/production-plans/{id}", suite.target.Update())
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("/production-plans/%s", test.FirstId), bytes.NewReader([]byte(`{}`)))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 23002,
+			"error_details": "name is required",
+			"error_message": "invalid production plan"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *ProductionPlanHandlerTestSuite) TestDelete() {
+	suite.service.EXPECT().Delete(gomock.Any(), test.FirstId).Return(nil)
+
+	router := chi.NewRouter()
+	router.Delete("/production-plans/{id}", suite.target.Delete())
+
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("/production-plans/%s", test.FirstId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusNoContent, resp.Code)
+	suite.Empty(resp.Body.String())
+}
+
+func (suite *ProductionPlanHandlerTestSuite) TestDelete_WithErrorOnDelete() {
+	suite.service.EXPECT().Delete(gomock.Any(), test.FirstId).
+		Return(internalErrors.ProductionPlanNotFound(test.FirstId))
+
+	router := chi.NewRouter()
+	router.Delete("/production-plans/{id}", suite.target.Delete())
+
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("/production-plans/%s", test.FirstId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 23001,
+			"error_details": "production plan with id 74c65c5a-44e7-44a2-8cdd-cd7c49cfcb42 not found",
+			"error_message": "production plan not found"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *ProductionPlanHandlerTestSuite) TestComplete() {
+	plan := createProductionPlan()
+	plan.CompletedAt = &test.Date
+
+	suite.service.EXPECT().Complete(gomock.Any(), test.FirstId).
+		Return(plan, nil)
+
+	router := chi.NewRouter()
+	router.Post("/production-plans/{id}/complete", suite.target.Complete())
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("/production-plans/%s/complete", test.FirstId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusOK, resp.Code)
+
+	var actual domain.ProductionPlanDto
+	suite.Require().NoError(json.NewDecoder(resp.Body).Decode(&actual))
+	suite.Equal(plan, actual)
+}
+
+func (suite *ProductionPlanHandlerTestSuite) TestComplete_WithErrorOnComplete() {
+	suite.service.EXPECT().Complete(gomock.Any(), test.FirstId).
+		Return(domain.ProductionPlanDto{}, internalErrors.ProductionPlanAlreadyCompleted(test.FirstId))
+
+	router := chi.NewRouter()
+	router.Post("/production-plans/{id}/complete", suite.target.Complete())
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("/production-plans/%s/complete", test.FirstId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 23003,
+			"error_details": "production plan with id 74c65c5a-44e7-44a2-8cdd-cd7c49cfcb42 is already completed",
+			"error_message": "production plan already completed"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusConflict, expectedBodyJson)
+}
+
+func (suite *ProductionPlanHandlerTestSuite) TestSchedule() {
+	schedule := createProductionSchedule()
+
+	suite.service.EXPECT().Schedule(gomock.Any(), test.FirstId).
+		Return(schedule, nil)
+
+	router := chi.NewRouter()
+	router.
+		With(httpin.NewInput(ProductionScheduleInput{})).
+		Get("/production-plans/{id}/schedule", suite.target.Schedule())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/production-plans/%s/schedule", test.FirstId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusOK, resp.Code)
+
+	var actual domain.ProductionScheduleDto
+	suite.Require().NoError(json.NewDecoder(resp.Body).Decode(&actual))
+	suite.Equal(schedule, actual)
+}
+
+func (suite *ProductionPlanHandlerTestSuite) TestSchedule_AsText() {
+	suite.service.EXPECT().Schedule(gomock.Any(), test.FirstId).
+		Return(createProductionSchedule(), nil)
+
+	router := chi.NewRouter()
+	router.
+		With(httpin.NewInput(ProductionScheduleInput{})).
+		Get("/production-plans/{id}/schedule", suite.target.Schedule())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/production-plans/%s/schedule?format=text", test.FirstId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusOK, resp.Code)
+	suite.Equal("text/plain; charset=utf-8", resp.Header().Get("Content-Type"))
+	suite.Equal(
+		"Saturday market - Sat 25 Jan 2020\n"+
+			"Mixer capacity: 5.00 kg\n"+
+			"\n"+
+			"#  Mix at  Recipe    Batch  Pieces      Dough (g)  Fermentation (min)  Ready at\n"+
+			"1  04:00   Rye       1/1    3 x 1000 g  3000       390                 10:50\n"+
+			"2  04:20   Baguette  1/3    4 x 900 g   3600       240                 08:40\n",
+		resp.Body.String())
+}
+
+func (suite *ProductionPlanHandlerTestSuite) TestSchedule_WithInvalidFormat() {
+	router := chi.NewRouter()
+	router.
+		With(httpin.NewInput(ProductionScheduleInput{})).
+		Get("/production-plans/{id}/schedule", suite.target.Schedule())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/production-plans/%s/schedule?format=pdf", test.FirstId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 23103,
+			"error_details": "format pdf is not valid, use json or text",
+			"error_message": "format is not valid"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *ProductionPlanHandlerTestSuite) TestSchedule_WithErrorOnSchedule() {
+	suite.service.EXPECT().Schedule(gomock.Any(), test.FirstId).
+		Return(domain.ProductionScheduleDto{}, internalErrors.ProductionPlanNotFound(test.FirstId))
+
+	router := chi.NewRouter()
+	router.
+		With(httpin.NewInput(ProductionScheduleInput{})).
+		Get("/production-plans/{id}/schedule", suite.target.Schedule())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/production-plans/%s/schedule?format=text", test.FirstId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 23001,
+			"error_details": "production plan with id 74c65c5a-44e7-44a2-8cdd-cd7c49cfcb42 not found",
+			"error_message": "production plan not found"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func TestNewProductionPlanHandler_WithNilService(t *testing.T) {
+	handler, err := NewProductionPlanHandler(nil)
+
+	assert.ErrorContains(t, err, "service cannot be nil")
+	assert.Nil(t, handler)
+}
+
+func createProductionPlanRequest() domain.CreateProductionPlanRequest {
+	return domain.CreateProductionPlanRequest{
+		Name:          "Saturday market",
+		Date:          test.Date,
+		MixerCapacity: 5,
+		Items: []domain.ProductionPlanItemDto{
+			{RecipeId: test.SecondId, Pieces: 12, PieceWeight: 900, FermentationMinutes: 240},
+			{RecipeId: test.ThirdId, Pieces: 3, PieceWeight: 1000},
+		},
+	}
+}
+
+func createProductionPlan() domain.ProductionPlanDto {
+	return domain.ProductionPlanDto{
+		Id:            test.FirstId,
+		Name:          "Saturday market",
+		Date:          test.Date,
+		MixerCapacity: 5,
+		MixMinutes:    20,
+		Items: []domain.ProductionPlanItemDto{
+			{RecipeId: test.SecondId, Pieces: 12, PieceWeight: 900, FermentationMinutes: 240},
+			{RecipeId: test.ThirdId, Pieces: 3, PieceWeight: 1000},
+		},
+		CreatedAt: test.Date,
+	}
+}
+
+func createProductionSchedule() domain.ProductionScheduleDto {
+	date := time.Date(2020, 1, 25, 4, 0, 0, 0, time.UTC)
+
+	return domain.ProductionScheduleDto{
+		PlanId:        test.FirstId,
+		Name:          "Saturday market",
+		Date:          date,
+		MixerCapacity: 5,
+		Mixes: []domain.ProductionMixDto{
+			{
+				Sequence:            1,
+				RecipeId:            test.ThirdId,
+				RecipeName:          "Rye",
+				Batch:               1,
+				Batches:             1,
+				Pieces:              3,
+				PieceWeight:         1000,
+				DoughWeight:         3000,
+				FermentationMinutes: 390,
+				MixAt:               date,
+				ReadyAt:             date.Add(410 * time.Minute),
+			},
+			{
+				Sequence:            2,
+				RecipeId:            test.SecondId,
+				RecipeName:          "Baguette",
+				Batch:               1,
+				Batches:             3,
+				Pieces:              4,
+				PieceWeight:         900,
+				DoughWeight:         3600,
+				FermentationMinutes: 240,
+				MixAt:               date.Add(20 * time.Minute),
+				ReadyAt:             date.Add(280 * time.Minute),
+			},
+		},
+	}
+}
@@ -0,0 +1,161 @@
+package rest
+
+import (
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/ggicci/httpin"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/invopop/yaml"
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+)
+
+const (
+	recipeBundleIdNotValid   = 25101
+	recipeBundleBodyNotValid = 25102
+)
+
+// recipeBundleMaxSize caps the uploaded bundle, hundreds of recipes with
+// their flours stay well below it.
+const recipeBundleMaxSize = 10 << 20
+
+var recipeBundleYamlTypes = map[string]bool{
+	"application/yaml":   true,
+	"application/x-yaml": true,
+	"text/yaml":          true,
+}
+
+// ExportRecipeBundleInput selects the exported recipes, either explicitly by
+// id or with the same filters as the recipe listing. Without any parameter
+// every recipe is exported.
+type ExportRecipeBundleInput struct {
+	Ids []string `in:"query=id"`
+	FindRecipeInput
+}
+
+func (input ExportRecipeBundleInput) ToFilter() (domain.SourdoughRecipeFilter, error) {
+	filter := input.FindRecipeInput.ToFilter()
+	for _, param := range input.Ids {
+		id, err := uuid.Parse(param)
+		if err != nil {
+			return domain.SourdoughRecipeFilter{}, errors.Errorf("id %s is not valid", param)
+		}
+		filter.Ids = append(filter.Ids, id)
+	}
+	return filter, nil
+}
+
+// ImportRecipeBundleInput decides what happens to a recipe whose name is
+// already taken: rename (default), skip or replace.
+type ImportRecipeBundleInput struct {
+	OnConflict string `in:"query=on_conflict"`
+}
+
+type recipeBundleHandler struct {
+	service domain.RecipeBundleService
+}
+
+func (handler *recipeBundleHandler) Export() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		input := req.Context().Value(httpin.Input).(*ExportRecipeBundleInput)
+
+		filter, err := input.ToFilter()
+		if err != nil {
+			HandlerError(res, req, internalErrors.NewBadRequestError(recipeBundleIdNotValid, "id is not valid", err.Error()))
+			return
+		}
+
+		bundle, err := handler.service.Export(req.Context(), filter)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		res.Header().Add("Vary", "Accept")
+		if !acceptsRecipeBundleYaml(req) {
+			res.Header().Set("Content-Disposition", `attachment; filename="recipes.json"`)
+			render.JSON(res, req, bundle)
+			return
+		}
+
+		content, err := yaml.Marshal(bundle)
+		if err != nil {
+			HandlerError(res, req, errors.Wrap(err, "failed to encode recipe bundle"))
+			return
+		}
+
+		res.Header().Set("Content-Type", "application/yaml; charset=utf-8")
+		res.Header().Set("Content-Disposition", `attachment; filename="recipes.yaml"`)
+		_, _ = res.Write(content)
+	}
+}
+
+func (handler *recipeBundleHandler) Import() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		input := req.Context().Value(httpin.Input).(*ImportRecipeBundleInput)
+
+		content, err := io.ReadAll(http.MaxBytesReader(res, req.Body, recipeBundleMaxSize))
+		if err != nil {
+			HandlerError(res, req, internalErrors.NewBadRequestError(recipeBundleBodyNotValid, "recipe bundle is not valid", err.Error()))
+			return
+		}
+
+		var bundle domain.RecipeBundleDto
+		if isRecipeBundleYaml(req.Header.Get("Content-Type")) {
+			err = yaml.Unmarshal(content, &bundle)
+		} else {
+			err = json.Unmarshal(content, &bundle)
+		}
+		if err != nil {
+			HandlerError(res, req, internalErrors.NewBadRequestError(recipeBundleBodyNotValid, "recipe bundle is not valid", err.Error()))
+			return
+		}
+
+		result, err := handler.service.Import(req.Context(), bundle, input.OnConflict)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, result)
+	}
+}
+
+// acceptsRecipeBundleYaml reports whether the Accept header asks for YAML
+// before JSON, JSON stays the default.
+func acceptsRecipeBundleYaml(req *http.Request) bool {
+	for _, accepted := range strings.Split(req.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accepted))
+		if err != nil {
+			continue
+		}
+		if mediaType == "application/json" {
+			return false
+		}
+		if recipeBundleYamlTypes[mediaType] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isRecipeBundleYaml(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && recipeBundleYamlTypes[mediaType]
+}
+
+func NewRecipeBundleHandler(service domain.RecipeBundleService) (domain.RecipeBundleHandler, error) {
+	if service == nil {
+		return nil, errors.New("service is nil")
+	}
+
+	return &recipeBundleHandler{service: service}, nil
+}
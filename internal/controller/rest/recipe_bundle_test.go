@@ -0,0 +1,243 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ggicci/httpin"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/invopop/yaml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func TestRecipeBundleHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(RecipeBundleHandlerTestSuite))
+}
+
+type RecipeBundleHandlerTestSuite struct {
+	test.GoMockTestSuite
+
+	service *mocks.MockRecipeBundleService
+
+	target domain.RecipeBundleHandler
+}
+
+func (suite *RecipeBundleHandlerTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.service = mocks.NewMockRecipeBundleService(suite.MockCtrl)
+
+	suite.target = test.Must(func() (domain.RecipeBundleHandler, error) {
+		return NewRecipeBundleHandler(suite.service)
+	})
+}
+
+func (suite *RecipeBundleHandlerTestSuite) TestExport() {
+	bundle := createRecipeBundle()
+
+	suite.service.EXPECT().
+		Export(gomock.Any(), domain.SourdoughRecipeFilter{
+			Ids:  []uuid.UUID{test.FirstId, test.SecondId},
+			Tags: []string{"rye"},
+		}).
+		Return(bundle, nil)
+
+	resp := suite.serveExport("/export?id="+test.FirstId.String()+"&id="+test.SecondId.String()+"&tag=rye", "")
+
+	suite.Equal(http.StatusOK, resp.Code)
+	suite.Equal(`attachment; filename="recipes.json"`, resp.Header().Get("Content-Disposition"))
+	suite.Equal("Accept", resp.Header().Get("Vary"))
+
+	var actual domain.RecipeBundleDto
+	suite.Require().NoError(json.NewDecoder(resp.Body).Decode(&actual))
+	suite.Equal(bundle, actual)
+}
+
+func (suite *RecipeBundleHandlerTestSuite) TestExport_AsYaml() {
+	bundle := createRecipeBundle()
+
+	suite.service.EXPECT().Export(gomock.Any(), domain.SourdoughRecipeFilter{}).Return(bundle, nil)
+
+	resp := suite.serveExport("/export", "application/x-yaml")
+
+	suite.Equal(http.StatusOK, resp.Code)
+	suite.Equal("application/yaml; charset=utf-8", resp.Header().Get("Content-Type"))
+	suite.Equal(`attachment; filename="recipes.yaml"`, resp.Header().Get("Content-Disposition"))
+	suite.Contains(resp.Body.String(), "schema_version: 1")
+
+	var actual domain.RecipeBundleDto
+	suite.Require().NoError(yaml.Unmarshal(resp.Body.Bytes(), &actual))
+	suite.Equal(bundle, actual)
+}
+
+func (suite *RecipeBundleHandlerTestSuite) TestExport_WithInvalidId() {
+	resp := suite.serveExport("/export?id=invalid", "")
+
+	expectedBodyJson :=
+		`{
+			"error_code": 25101,
+			"error_details": "id invalid is not valid",
+			"error_message": "id is not valid"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *RecipeBundleHandlerTestSuite) TestExport_WithErrorOnExport() {
+	suite.service.EXPECT().Export(gomock.Any(), gomock.Any()).
+		Return(domain.RecipeBundleDto{}, internalErrors.SourdoughRecipeFilterInvalid("min flour 2.00 must not be greater than max flour 1.00"))
+
+	resp := suite.serveExport("/export?min_flour=2&max_flour=1", "")
+
+	suite.Equal(http.StatusBadRequest, resp.Code)
+}
+
+func (suite *RecipeBundleHandlerTestSuite) TestImport() {
+	bundle := createRecipeBundle()
+	result := domain.RecipeBundleImportResultDto{
+		Flours: []domain.RecipeBundleFlourImportDto{
+			{SourceId: test.FirstId, Id: test.FirstId, Name: "Bread flour", Action: domain.RecipeBundleFlourMatched},
+		},
+		Recipes: []domain.RecipeBundleRecipeImportDto{
+			{SourceId: test.SecondId, Id: test.ThirdId, Name: "Rye (imported)", Action: domain.RecipeBundleRecipeRenamed},
+		},
+	}
+
+	jsonBody, err := json.Marshal(bundle)
+	suite.Require().NoError(err)
+	yamlBody, err := yaml.Marshal(bundle)
+	suite.Require().NoError(err)
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        []byte
+	}{
+		{name: "json", contentType: "application/json", body: jsonBody},
+		{name: "yaml", contentType: "application/yaml; charset=utf-8", body: yamlBody},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.service.EXPECT().Import(gomock.Any(), bundle, domain.RecipeBundleConflictSkip).Return(result, nil)
+
+			resp := suite.serveImport("/import?on_conflict=skip", tt.contentType, tt.body)
+
+			suite.Equal(http.StatusOK, resp.Code)
+
+			var actual domain.RecipeBundleImportResultDto
+			suite.Require().NoError(json.NewDecoder(resp.Body).Decode(&actual))
+			suite.Equal(result, actual)
+		})
+	}
+}
+
+func (suite *RecipeBundleHandlerTestSuite) TestImport_WithInvalidBody() {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+	}{
+		{name: "json", contentType: "application/json", body: "{"},
+		{name: "yaml", contentType: "text/yaml", body: "recipes: ["},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			resp := suite.serveImport("/import", tt.contentType, []byte(tt.body))
+
+			suite.Equal(http.StatusBadRequest, resp.Code)
+			suite.Contains(resp.Body.String(), `"error_code":25102`)
+		})
+	}
+}
+
+func (suite *RecipeBundleHandlerTestSuite) TestImport_WithErrorOnImport() {
+	suite.service.EXPECT().Import(gomock.Any(), gomock.Any(), "").
+		Return(domain.RecipeBundleImportResultDto{}, internalErrors.RecipeBundleInvalid("schema version 2 is not supported, expected 1"))
+
+	resp := suite.serveImport("/import", "application/json", []byte(`{"schema_version": 2}`))
+
+	expectedBodyJson :=
+		`{
+			"error_code": 25001,
+			"error_details": "schema version 2 is not supported, expected 1",
+			"error_message": "invalid recipe bundle"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *RecipeBundleHandlerTestSuite) serveExport(path string, accept string) *httptest.ResponseRecorder {
+	router := chi.NewRouter()
+	router.
+		With(httpin.NewInput(ExportRecipeBundleInput{})).
+		Get("/export", suite.target.Export())
+
+	req, err := http.NewRequest("GET", path, nil)
+	suite.Require().NoError(err)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func (suite *RecipeBundleHandlerTestSuite) serveImport(path string, contentType string, body []byte) *httptest.ResponseRecorder {
+	router := chi.NewRouter()
+	router.
+		With(httpin.NewInput(ImportRecipeBundleInput{})).
+		Post("/import", suite.target.Import())
+
+	req, err := http.NewRequest("POST", path, bytes.NewReader(body))
+	suite.Require().NoError(err)
+	req.Header.Set("Content-Type", contentType)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func createRecipeBundle() domain.RecipeBundleDto {
+	flour := domain.FlourDto{Id: test.FirstId, Name: "Bread flour", FlourType: "bread", ProteinContent: 12.5}
+
+	return domain.RecipeBundleDto{
+		SchemaVersion: domain.RecipeBundleSchemaVersion,
+		ExportedAt:    test.Date,
+		Flours:        []domain.FlourDto{flour},
+		Recipes: []domain.SourdoughRecipeDto{
+			{
+				RecipeDto: domain.RecipeDto{
+					Id:   test.SecondId,
+					Name: "Rye",
+					Flour: []domain.FlourAmountDto{
+						{FlourDto: flour, Amount: 500},
+					},
+					Water:     []domain.BakerAmountDto{{Amount: 350}},
+					CreatedAt: test.Date,
+					Version:   1,
+				},
+			},
+		},
+	}
+}
+
+func TestNewRecipeBundleHandler_WithNilService(t *testing.T) {
+	_, err := NewRecipeBundleHandler(nil)
+	assert.EqualError(t, err, "service is nil")
+}
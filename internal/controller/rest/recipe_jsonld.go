@@ -0,0 +1,116 @@
+package rest
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+)
+
+const (
+	recipeJsonLdFileRequired = 27101
+	recipeJsonLdFormNotValid = 27102
+)
+
+// recipeJsonLdMaxSize caps the uploaded JSON-LD document, a recipe page
+// exported by a website is a few kilobytes.
+const recipeJsonLdMaxSize = 1 << 20
+
+const recipeJsonLdMediaType = "application/ld+json"
+
+type recipeJsonLdHandler struct {
+	service domain.RecipeJsonLdService
+}
+
+func (handler *recipeJsonLdHandler) Export() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		recipeId := handler.getIdParam(res, req)
+		if recipeId == nil {
+			return
+		}
+
+		jsonLd, err := handler.service.Export(req.Context(), *recipeId)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		writeRecipeJsonLd(res, req, jsonLd)
+	}
+}
+
+func (handler *recipeJsonLdHandler) Import() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		req.Body = http.MaxBytesReader(res, req.Body, recipeJsonLdMaxSize+multipartOverhead)
+
+		if err := req.ParseMultipartForm(multipartFormMemory); err != nil {
+			HandlerError(res, req, internalErrors.NewBadRequestError(recipeJsonLdFormNotValid, "multipart form is not valid", err.Error()))
+			return
+		}
+		defer func() {
+			_ = req.MultipartForm.RemoveAll()
+		}()
+
+		file, _, err := req.FormFile("file")
+		if err != nil {
+			HandlerError(res, req, internalErrors.NewBadRequestError(recipeJsonLdFileRequired, "file is required", "multipart field 'file' is required"))
+			return
+		}
+		defer file.Close()
+
+		content, err := io.ReadAll(io.LimitReader(file, recipeJsonLdMaxSize))
+		if err != nil {
+			HandlerError(res, req, errors.Wrap(err, "error while reading uploaded file"))
+			return
+		}
+
+		result, err := handler.service.Import(req.Context(), content)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.Status(req, http.StatusCreated)
+		render.JSON(res, req, result)
+	}
+}
+
+func (handler *recipeJsonLdHandler) getIdParam(res http.ResponseWriter, req *http.Request) *uuid.UUID {
+	param := chi.URLParam(req, "id")
+	if param == "" {
+		HandlerError(res, req, internalErrors.NewBadRequestError(recipeIdNotFound, "id is required", "id is required"))
+		return nil
+	}
+	id, err := uuid.Parse(param)
+	if err != nil {
+		HandlerError(res, req, internalErrors.NewBadRequestError(recipeIdNotValid, "id is not valid", "id is not valid"))
+		return nil
+	}
+	return &id
+}
+
+func writeRecipeJsonLd(res http.ResponseWriter, req *http.Request, jsonLd domain.SchemaOrgRecipeDto) {
+	content, err := json.Marshal(jsonLd)
+	if err != nil {
+		HandlerError(res, req, errors.Wrap(err, "failed to encode recipe JSON-LD"))
+		return
+	}
+
+	res.Header().Set("Content-Type", recipeJsonLdMediaType+"; charset=utf-8")
+	_, _ = res.Write(content)
+}
+
+func NewRecipeJsonLdHandler(service domain.RecipeJsonLdService) (domain.RecipeJsonLdHandler, error) {
+	if service == nil {
+		return nil, errors.New("service cannot be nil")
+	}
+
+	return &recipeJsonLdHandler{service: service}, nil
+}
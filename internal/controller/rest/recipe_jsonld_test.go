@@ -0,0 +1,232 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func TestRecipeJsonLdHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(RecipeJsonLdHandlerTestSuite))
+}
+
+type RecipeJsonLdHandlerTestSuite struct {
+	test.GoMockTestSuite
+
+	service *mocks.MockRecipeJsonLdService
+
+	target domain.RecipeJsonLdHandler
+}
+
+func (suite *RecipeJsonLdHandlerTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.service = mocks.NewMockRecipeJsonLdService(suite.MockCtrl)
+
+	suite.target = test.Must(func() (domain.RecipeJsonLdHandler, error) {
+		return NewRecipeJsonLdHandler(suite.service)
+	})
+}
+
+func (suite *RecipeJsonLdHandlerTestSuite) TestExport() {
+	suite.service.EXPECT().Export(gomock.Any(), test.FirstId).
+		Return(domain.SchemaOrgRecipeDto{
+			Context:          domain.SchemaOrgContext,
+			Type:             domain.SchemaOrgRecipeType,
+			Identifier:       test.FirstId.String(),
+			Name:             "Country loaf",
+			RecipeIngredient: []string{"500 g Bread flour", "375 g water"},
+			RecipeYield:      "2 loaf",
+			PrepTime:         "PT4H",
+		}, nil)
+
+	resp := suite.serveExport(test.FirstId.String())
+
+	expectedBodyJson := fmt.Sprintf(`{
+		"@context": "https://schema.org",
+		"@type": "Recipe",
+		"identifier": "%s",
+		"name": "Country loaf",
+		"recipeIngredient": ["500 g Bread flour", "375 g water"],
+		"recipeYield": "2 loaf",
+		"prepTime": "PT4H"
+	}`, test.FirstId.String())
+	test.VerifyRestResponse(suite.T(), resp, http.StatusOK, expectedBodyJson)
+	suite.Equal("application/ld+json; charset=utf-8", resp.Header().Get("Content-Type"))
+}
+
+func (suite *RecipeJsonLdHandlerTestSuite) TestExport_WithInvalidId() {
+	resp := suite.serveExport("invalid")
+
+	expectedBodyJson :=
+		`{
+			"error_code": 10002,
+			"error_details": "id is not valid",
+			"error_message": "id is not valid"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *RecipeJsonLdHandlerTestSuite) TestExport_WithErrorOnExport() {
+	suite.service.EXPECT().Export(gomock.Any(), test.FirstId).
+		Return(domain.SchemaOrgRecipeDto{}, internalErrors.SourdoughRecipeNotFound("sourdough recipe not found"))
+
+	resp := suite.serveExport(test.FirstId.String())
+
+	expectedBodyJson :=
+		`{
+			"error_code": 10001,
+			"error_details": "sourdough recipe not found",
+			"error_message": "sourdough not found"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *RecipeJsonLdHandlerTestSuite) TestImport() {
+	content := []byte(`{"@context": "https://schema.org", "@type": "Recipe", "name": "Country loaf"}`)
+	expected := domain.RecipeJsonLdImportDto{
+		Recipe:       createSourdoughRecipe(),
+		FlourMatches: []domain.ParsedFlourMatchDto{{Text: "bread flour", FlourId: test.FirstId, Name: "Bread flour", Score: 1}},
+		Unrecognised: []domain.UnrecognisedLineDto{{Line: 3, Text: "1 egg", Reason: "no amount in grams or percent found"}},
+	}
+
+	suite.service.EXPECT().Import(gomock.Any(), content).Return(expected, nil)
+
+	body, contentType := suite.multipartBody("recipe.jsonld", content)
+
+	resp := suite.serveImport(body, contentType)
+
+	suite.Equal(http.StatusCreated, resp.Code)
+
+	var actual domain.RecipeJsonLdImportDto
+	suite.Require().NoError(json.NewDecoder(resp.Body).Decode(&actual))
+	suite.Equal(expected.FlourMatches, actual.FlourMatches)
+	suite.Equal(expected.Unrecognised, actual.Unrecognised)
+	suite.Equal(expected.Recipe.Id, actual.Recipe.Id)
+	suite.Equal(expected.Recipe.Name, actual.Recipe.Name)
+}
+
+func (suite *RecipeJsonLdHandlerTestSuite) TestImport_WithInvalidRequest() {
+	tests := []struct {
+		name             string
+		body             func() (io.Reader, string)
+		expectedBodyJson string
+	}{
+		{
+			name: "missing file",
+			body: func() (io.Reader, string) {
+				return suite.multipartBody("", nil)
+			},
+			expectedBodyJson: `{
+				"error_code": 27101,
+				"error_details": "multipart field 'file' is required",
+				"error_message": "file is required"
+			}`,
+		},
+		{
+			name: "not a multipart form",
+			body: func() (io.Reader, string) {
+				return bytes.NewReader([]byte("{}")), "application/ld+json"
+			},
+			expectedBodyJson: `{
+				"error_code": 27102,
+				"error_details": "request Content-Type isn't multipart/form-data",
+				"error_message": "multipart form is not valid"
+			}`,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			body, contentType := tt.body()
+
+			resp := suite.serveImport(body, contentType)
+
+			test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, tt.expectedBodyJson)
+		})
+	}
+}
+
+func (suite *RecipeJsonLdHandlerTestSuite) TestImport_WithErrorOnImport() {
+	suite.service.EXPECT().Import(gomock.Any(), []byte("{}")).
+		Return(domain.RecipeJsonLdImportDto{}, internalErrors.RecipeJsonLdInvalid("document has no schema.org Recipe"))
+
+	body, contentType := suite.multipartBody("recipe.jsonld", []byte("{}"))
+
+	resp := suite.serveImport(body, contentType)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 27001,
+			"error_details": "document has no schema.org Recipe",
+			"error_message": "invalid schema.org recipe"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *RecipeJsonLdHandlerTestSuite) serveExport(id string) *httptest.ResponseRecorder {
+	router := chi.NewRouter()
+	router.Get("/recipe/{id}/jsonld", suite.target.Export())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/recipe/%s/jsonld", id), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func (suite *RecipeJsonLdHandlerTestSuite) serveImport(body io.Reader, contentType string) *httptest.ResponseRecorder {
+	router := chi.NewRouter()
+	router.Post("/import/jsonld", suite.target.Import())
+
+	req, err := http.NewRequest("POST", "/import/jsonld", body)
+	suite.Require().NoError(err)
+	req.Header.Set("Content-Type", contentType)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func (suite *RecipeJsonLdHandlerTestSuite) multipartBody(fileName string, content []byte) (io.Reader, string) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	if fileName != "" {
+		part, err := writer.CreateFormFile("file", fileName)
+		suite.Require().NoError(err)
+		_, err = part.Write(content)
+		suite.Require().NoError(err)
+	}
+
+	suite.Require().NoError(writer.Close())
+
+	return &body, writer.FormDataContentType()
+}
+
+func TestNewRecipeJsonLdHandler_WithNilService(t *testing.T) {
+	handler, err := NewRecipeJsonLdHandler(nil)
+
+	assert.ErrorContains(t, err, "service cannot be nil")
+	assert.Nil(t, handler)
+}
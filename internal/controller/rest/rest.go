@@ -13,6 +13,12 @@ type PageInput struct {
 	Limit  int `in:"query=limit;default=25"`
 }
 
+type TextSearchInput struct {
+	Query  string `in:"query=q"`
+	Offset int    `in:"query=offset;default=0"`
+	Limit  int    `in:"query=limit;default=25"`
+}
+
 func HandlerError(w http.ResponseWriter, r *http.Request, err error) {
 	if err == nil {
 		return
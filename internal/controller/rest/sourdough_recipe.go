@@ -2,6 +2,7 @@ package rest
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/ggicci/httpin"
 	"github.com/go-chi/chi/v5"
@@ -22,8 +23,51 @@ type SearchRecipeInput struct {
 	Name string `in:"query=name"`
 }
 
+type FindRecipeInput struct {
+	Tags          []string   `in:"query=tag"`
+	Categories    []string   `in:"query=category"`
+	FlourTypes    []string   `in:"query=flour_type"`
+	MinHydration  *float64   `in:"query=min_hydration"`
+	MaxHydration  *float64   `in:"query=max_hydration"`
+	MinFlour      *float64   `in:"query=min_flour"`
+	MaxFlour      *float64   `in:"query=max_flour"`
+	CreatedAfter  *time.Time `in:"query=created_after"`
+	CreatedBefore *time.Time `in:"query=created_before"`
+	Sort          string     `in:"query=sort"`
+	Order         string     `in:"query=order"`
+	Cursor        string     `in:"query=cursor"`
+	Offset        int        `in:"query=offset;default=0"`
+	Limit         int        `in:"query=limit;default=25"`
+}
+
+func (input FindRecipeInput) ToFilter() domain.SourdoughRecipeFilter {
+	return domain.SourdoughRecipeFilter{
+		Tags:          input.Tags,
+		Categories:    input.Categories,
+		FlourTypes:    input.FlourTypes,
+		MinHydration:  input.MinHydration,
+		MaxHydration:  input.MaxHydration,
+		MinFlour:      input.MinFlour,
+		MaxFlour:      input.MaxFlour,
+		CreatedAfter:  input.CreatedAfter,
+		CreatedBefore: input.CreatedBefore,
+	}
+}
+
+func (input FindRecipeInput) ToPageParams() domain.PageParams {
+	return domain.PageParams{
+		Sort:   input.Sort,
+		Order:  input.Order,
+		Cursor: input.Cursor,
+		Offset: input.Offset,
+		Limit:  input.Limit,
+	}
+}
+
 type sourdoughRecipeHandler struct {
-	service domain.SourdoughRecipeService
+	service       domain.SourdoughRecipeService
+	renderer      domain.BakeSheetRenderer
+	jsonLdService domain.RecipeJsonLdService
 }
 
 func (handler *sourdoughRecipeHandler) Create() http.HandlerFunc {
@@ -59,6 +103,30 @@ func (handler *sourdoughRecipeHandler) FindById() http.HandlerFunc {
 			return
 		}
 
+		renderRecipe(res, req, handler.renderer, handler.jsonLdService, recipeDto)
+	}
+}
+
+func (handler *sourdoughRecipeHandler) Update() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		recipeId := handler.getIdParam(res, req)
+		if recipeId == nil {
+			return
+		}
+
+		var request domain.CreateSourdoughRecipeRequest
+
+		if err := render.DecodeJSON(req.Body, &request); err != nil {
+			HandlerError(res, req, errors.Wrap(err, "error while decoding request body"))
+			return
+		}
+
+		recipeDto, err := handler.service.Update(req.Context(), *recipeId, request)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
 		render.JSON(res, req, recipeDto)
 	}
 }
@@ -79,9 +147,9 @@ func (handler *sourdoughRecipeHandler) getIdParam(res http.ResponseWriter, req *
 
 func (handler *sourdoughRecipeHandler) Find() http.HandlerFunc {
 	return func(res http.ResponseWriter, req *http.Request) {
-		page := req.Context().Value(httpin.Input).(*PageInput)
+		input := req.Context().Value(httpin.Input).(*FindRecipeInput)
 
-		recipes, err := handler.service.Find(req.Context(), page.Offset, page.Limit)
+		recipes, err := handler.service.Find(req.Context(), input.ToFilter(), input.ToPageParams())
 		if err != nil {
 			HandlerError(res, req, err)
 			return
@@ -105,10 +173,96 @@ func (handler *sourdoughRecipeHandler) Search() http.HandlerFunc {
 	}
 }
 
-func NewSourdoughRecipeHandler(sourdoughRecipeService domain.SourdoughRecipeService) (domain.SourdoughRecipeHandler, error) {
+func (handler *sourdoughRecipeHandler) Fork() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		recipeId := handler.getIdParam(res, req)
+		if recipeId == nil {
+			return
+		}
+
+		var request domain.ForkSourdoughRecipeRequest
+
+		if err := render.DecodeJSON(req.Body, &request); err != nil {
+			HandlerError(res, req, errors.Wrap(err, "error while decoding request body"))
+			return
+		}
+
+		recipeDto, err := handler.service.Fork(req.Context(), *recipeId, request)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.Status(req, http.StatusCreated)
+		render.JSON(res, req, recipeDto)
+	}
+}
+
+func (handler *sourdoughRecipeHandler) FamilyTree() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		recipeId := handler.getIdParam(res, req)
+		if recipeId == nil {
+			return
+		}
+
+		familyTree, err := handler.service.FamilyTree(req.Context(), *recipeId)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, familyTree)
+	}
+}
+
+func (handler *sourdoughRecipeHandler) TextSearch() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		search := req.Context().Value(httpin.Input).(*TextSearchInput)
+
+		recipes, err := handler.service.TextSearch(req.Context(), search.Query, search.Offset, search.Limit)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, recipes)
+	}
+}
+
+func (handler *sourdoughRecipeHandler) CreateFromFormula() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		var request domain.CreateSourdoughRecipeFormulaRequest
+
+		if err := render.DecodeJSON(req.Body, &request); err != nil {
+			HandlerError(res, req, errors.Wrap(err, "error while decoding request body"))
+			return
+		}
+
+		recipeDto, err := handler.service.CreateFromFormula(req.Context(), request)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.Status(req, http.StatusCreated)
+		render.JSON(res, req, recipeDto)
+	}
+}
+
+func NewSourdoughRecipeHandler(
+	sourdoughRecipeService domain.SourdoughRecipeService,
+	renderer domain.BakeSheetRenderer,
+	jsonLdService domain.RecipeJsonLdService,
+) (domain.SourdoughRecipeHandler, error) {
 	if sourdoughRecipeService == nil {
 		return nil, errors.New("service cannot be nil")
 	}
+	if renderer == nil {
+		return nil, errors.New("renderer cannot be nil")
+	}
+	if jsonLdService == nil {
+		return nil, errors.New("jsonLdService cannot be nil")
+	}
 
-	return &sourdoughRecipeHandler{service: sourdoughRecipeService}, nil
+	return &sourdoughRecipeHandler{service: sourdoughRecipeService, renderer: renderer, jsonLdService: jsonLdService}, nil
 }
@@ -0,0 +1,43 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/domain"
+)
+
+type sourdoughRecipeParseHandler struct {
+	service domain.SourdoughRecipeParseService
+}
+
+func (handler *sourdoughRecipeParseHandler) Parse() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		var request domain.SourdoughRecipeParseRequest
+
+		if err := render.DecodeJSON(req.Body, &request); err != nil {
+			HandlerError(res, req, errors.Wrap(err, "error while decoding request body"))
+			return
+		}
+
+		parsed, err := handler.service.Parse(req.Context(), request)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, parsed)
+	}
+}
+
+func NewSourdoughRecipeParseHandler(service domain.SourdoughRecipeParseService) (domain.SourdoughRecipeParseHandler, error) {
+	if service == nil {
+		return nil, errors.New("service cannot be nil")
+	}
+
+	return &sourdoughRecipeParseHandler{
+		service: service,
+	}, nil
+}
@@ -0,0 +1,117 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func TestSourdoughRecipeParseHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(SourdoughRecipeParseHandlerTestSuite))
+}
+
+type SourdoughRecipeParseHandlerTestSuite struct {
+	test.GoMockTestSuite
+
+	service *mocks.MockSourdoughRecipeParseService
+
+	target domain.SourdoughRecipeParseHandler
+}
+
+func (suite *SourdoughRecipeParseHandlerTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.service = mocks.NewMockSourdoughRecipeParseService(suite.MockCtrl)
+
+	suite.target = test.Must(func() (domain.SourdoughRecipeParseHandler, error) {
+		return NewSourdoughRecipeParseHandler(suite.service)
+	})
+}
+
+func (suite *SourdoughRecipeParseHandlerTestSuite) TestParse() {
+	request := domain.SourdoughRecipeParseRequest{Text: "500g bread flour, 375g water, 1 egg"}
+	flour := domain.FlourDto{Id: test.FirstId, Name: "Bread flour", FlourType: "bread"}
+
+	suite.service.EXPECT().
+		Parse(gomock.Any(), request).
+		Return(domain.SourdoughRecipeParseDto{
+			Recipe: domain.CreateSourdoughRecipeRequest{
+				Flour:                 []domain.FlourAmountDto{{FlourDto: flour, Amount: 500}},
+				Water:                 []domain.BakerAmountDto{{Name: "water", Amount: 375, BakerPercentage: 75}},
+				AdditionalIngredients: []domain.BakerAmountDto{},
+			},
+			FlourMatches: []domain.ParsedFlourMatchDto{{Text: "bread flour", FlourId: flour.Id, Name: flour.Name, Score: 1}},
+			Unrecognised: []domain.UnrecognisedLineDto{{Line: 1, Text: "1 egg", Reason: "no amount in grams or percent found"}},
+		}, nil)
+
+	resp := suite.serveParse(request)
+
+	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/sourdough_recipe_parse_response.json")
+}
+
+func (suite *SourdoughRecipeParseHandlerTestSuite) TestParse_WithErrorOnParse() {
+	request := domain.SourdoughRecipeParseRequest{}
+
+	suite.service.EXPECT().
+		Parse(gomock.Any(), request).
+		Return(domain.SourdoughRecipeParseDto{}, internalErrors.SourdoughRecipeParseInvalid("text is required"))
+
+	resp := suite.serveParse(request)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 26001,
+			"error_details": "text is required",
+			"error_message": "invalid recipe text"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *SourdoughRecipeParseHandlerTestSuite) TestParse_WithInvalidBody() {
+	resp := suite.serveParse("invalid")
+
+	expectedBodyJson :=
+		`{
+			"error_code": -1,
+			"error_details": "error while decoding request body: json: cannot unmarshal string into Go value of type domain.SourdoughRecipeParseRequest",
+			"error_message": "internal server error"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusInternalServerError, expectedBodyJson)
+}
+
+func (suite *SourdoughRecipeParseHandlerTestSuite) serveParse(body any) *httptest.ResponseRecorder {
+	buffer := bytes.NewBuffer([]byte{})
+	err := json.NewEncoder(buffer).Encode(body)
+	suite.Require().NoError(err)
+
+	router := chi.NewRouter()
+	router.Post("/parse", suite.target.Parse())
+
+	req, err := http.NewRequest("POST", "/parse", buffer)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewSourdoughRecipeParseHandler_WithNilService(t *testing.T) {
+	handler, err := NewSourdoughRecipeParseHandler(nil)
+
+	assert.ErrorContains(t, err, "service cannot be nil")
+	assert.Nil(t, handler)
+}
@@ -0,0 +1,149 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/ggicci/httpin"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+)
+
+const (
+	revisionVersionNotFound = 11002
+	revisionVersionNotValid = 11003
+)
+
+type RevisionDiffInput struct {
+	From int `in:"query=from"`
+	To   int `in:"query=to"`
+}
+
+type sourdoughRecipeRevisionHandler struct {
+	service domain.SourdoughRecipeRevisionService
+}
+
+func (handler *sourdoughRecipeRevisionHandler) FindByRecipeId() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		recipeId := handler.getIdParam(res, req)
+		if recipeId == nil {
+			return
+		}
+
+		page := req.Context().Value(httpin.Input).(*PageInput)
+
+		revisions, err := handler.service.FindByRecipeId(req.Context(), *recipeId, page.Offset, page.Limit)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, revisions)
+	}
+}
+
+func (handler *sourdoughRecipeRevisionHandler) FindByVersion() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		recipeId := handler.getIdParam(res, req)
+		if recipeId == nil {
+			return
+		}
+
+		version := handler.getVersionParam(res, req)
+		if version == nil {
+			return
+		}
+
+		revision, err := handler.service.FindByVersion(req.Context(), *recipeId, *version)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, revision)
+	}
+}
+
+func (handler *sourdoughRecipeRevisionHandler) Diff() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		recipeId := handler.getIdParam(res, req)
+		if recipeId == nil {
+			return
+		}
+
+		diffInput := req.Context().Value(httpin.Input).(*RevisionDiffInput)
+
+		diff, err := handler.service.Diff(req.Context(), *recipeId, diffInput.From, diffInput.To)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, diff)
+	}
+}
+
+func (handler *sourdoughRecipeRevisionHandler) Restore() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		recipeId := handler.getIdParam(res, req)
+		if recipeId == nil {
+			return
+		}
+
+		version := handler.getVersionParam(res, req)
+		if version == nil {
+			return
+		}
+
+		recipeDto, err := handler.service.Restore(req.Context(), *recipeId, *version)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, recipeDto)
+	}
+}
+
+func (handler *sourdoughRecipeRevisionHandler) getIdParam(res http.ResponseWriter, req *http.Request) *uuid.UUID {
+	param := chi.URLParam(req, "id")
+	if param == "" {
+		HandlerError(res, req, internalErrors.NewBadRequestError(recipeIdNotFound, "id is required", "id is required"))
+		return nil
+	}
+	id, err := uuid.Parse(param)
+	if err != nil {
+		HandlerError(res, req, internalErrors.NewBadRequestError(recipeIdNotValid, "id is not valid", "id is not valid"))
+		return nil
+	}
+	return &id
+}
+
+func (handler *sourdoughRecipeRevisionHandler) getVersionParam(res http.ResponseWriter, req *http.Request) *int {
+	param := chi.URLParam(req, "version")
+	if param == "" {
+		HandlerError(res, req, internalErrors.NewBadRequestError(revisionVersionNotFound, "version is required", "version is required"))
+		return nil
+	}
+	version, err := strconv.Atoi(param)
+	if err != nil || version < 1 {
+		HandlerError(res, req, internalErrors.NewBadRequestError(revisionVersionNotValid, "version is not valid", "version is not valid"))
+		return nil
+	}
+	return &version
+}
+
+func NewSourdoughRecipeRevisionHandler(service domain.SourdoughRecipeRevisionService) (domain.SourdoughRecipeRevisionHandler, error) {
+	if service == nil {
+		return nil, errors.New("service is nil")
+	}
+
+	return &sourdoughRecipeRevisionHandler{
+		service: service,
+	}, nil
+}
@@ -0,0 +1,294 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ggicci/httpin"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func TestSourdoughRecipeRevisionHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(SourdoughRecipeRevisionHandlerTestSuite))
+}
+
+type SourdoughRecipeRevisionHandlerTestSuite struct {
+	test.GoMockTestSuite
+
+	service *mocks.MockSourdoughRecipeRevisionService
+
+	target domain.SourdoughRecipeRevisionHandler
+}
+
+func (suite *SourdoughRecipeRevisionHandlerTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.service = mocks.NewMockSourdoughRecipeRevisionService(suite.MockCtrl)
+
+	suite.target = test.Must(func() (domain.SourdoughRecipeRevisionHandler, error) {
+		return NewSourdoughRecipeRevisionHandler(suite.service)
+	})
+}
+
+func (suite *SourdoughRecipeRevisionHandlerTestSuite) TestFindByRecipeId() {
+	revisions := []domain.SourdoughRecipeRevisionDto{createSourdoughRecipeRevision()}
+
+	suite.service.EXPECT().FindByRecipeId(gomock.Any(), test.ThirdId, 1, 10).
+		Return(revisions, nil)
+
+	router := chi.NewRouter()
+	router.
+		With(httpin.NewInput(PageInput{})).
+		Get("/recipe/{id}/revisions", suite.target.FindByRecipeId())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/recipe/%s/revisions?offset=1&limit=10", test.ThirdId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusOK, resp.Code)
+
+	var actual []domain.SourdoughRecipeRevisionDto
+	suite.Require().NoError(json.NewDecoder(resp.Body).Decode(&actual))
+	suite.Equal(revisions, actual)
+}
+
+func (suite *SourdoughRecipeRevisionHandlerTestSuite) TestFindByRecipeId_WithErrorOnFind() {
+	suite.service.EXPECT().FindByRecipeId(gomock.Any(), test.ThirdId, 0, 25).
+		Return(nil, internalErrors.NewBadRequestErrorf(123, "error", "error %s", "'test'"))
+
+	router := chi.NewRouter()
+	router.
+		With(httpin.NewInput(PageInput{})).
+		Get("/recipe/{id}/revisions", suite.target.FindByRecipeId())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/recipe/%s/revisions", test.ThirdId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 123,
+			"error_details": "error 'test'",
+			"error_message": "error"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *SourdoughRecipeRevisionHandlerTestSuite) TestFindByVersion() {
+	revision := createSourdoughRecipeRevision()
+
+	suite.service.EXPECT().FindByVersion(gomock.Any(), test.ThirdId, 2).
+		Return(revision, nil)
+
+	router := chi.NewRouter()
+	router.
+		Get("/recipe/{id}/revisions/{version}", suite.target.FindByVersion())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/recipe/%s/revisions/2", test.ThirdId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusOK, resp.Code)
+
+	var actual domain.SourdoughRecipeRevisionDto
+	suite.Require().NoError(json.NewDecoder(resp.Body).Decode(&actual))
+	suite.Equal(revision, actual)
+}
+
+func (suite *SourdoughRecipeRevisionHandlerTestSuite) TestFindByVersion_WithInvalidParams() {
+	tests := []struct {
+		name             string
+		path             string
+		expectedBodyJson string
+	}{
+		{
+			name: "invalid id",
+			path: "/recipe/invalid/revisions/2",
+			expectedBodyJson: `{
+				"error_code": 10002,
+				"error_details": "id is not valid",
+				"error_message": "id is not valid"
+			}`,
+		},
+		{
+			name: "invalid version",
+			path: fmt.Sprintf("/recipe/%s/revisions/invalid", test.ThirdId),
+			expectedBodyJson: `{
+				"error_code": 11003,
+				"error_details": "version is not valid",
+				"error_message": "version is not valid"
+			}`,
+		},
+		{
+			name: "version lower than one",
+			path: fmt.Sprintf("/recipe/%s/revisions/0", test.ThirdId),
+			expectedBodyJson: `{
+				"error_code": 11003,
+				"error_details": "version is not valid",
+				"error_message": "version is not valid"
+			}`,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			router := chi.NewRouter()
+			router.
+				Get("/recipe/{id}/revisions/{version}", suite.target.FindByVersion())
+
+			req, err := http.NewRequest("GET", tt.path, nil)
+			suite.Require().NoError(err)
+
+			resp := httptest.NewRecorder()
+
+			router.ServeHTTP(resp, req)
+
+			test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, tt.expectedBodyJson)
+		})
+	}
+}
+
+func (suite *SourdoughRecipeRevisionHandlerTestSuite) TestDiff() {
+	diff := domain.SourdoughRecipeRevisionDiffDto{
+		RecipeId:    test.ThirdId,
+		FromVersion: 1,
+		ToVersion:   2,
+		Flour: []domain.IngredientDiffDto{
+			{
+				Name:                "test first flour name",
+				Change:              domain.IngredientChanged,
+				FromAmount:          900,
+				ToAmount:            800,
+				FromBakerPercentage: 90,
+				ToBakerPercentage:   88.88888888888889,
+			},
+		},
+		Water:                 []domain.IngredientDiffDto{},
+		AdditionalIngredients: []domain.IngredientDiffDto{},
+		Levain:                []domain.IngredientDiffDto{},
+		Details:               []domain.IngredientDiffDto{},
+	}
+
+	suite.service.EXPECT().Diff(gomock.Any(), test.ThirdId, 1, 2).
+		Return(diff, nil)
+
+	router := chi.NewRouter()
+	router.
+		With(httpin.NewInput(RevisionDiffInput{})).
+		Get("/recipe/{id}/revisions/diff", suite.target.Diff())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/recipe/%s/revisions/diff?from=1&to=2", test.ThirdId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/sourdough_recipe_revision_diff_response.json")
+}
+
+func (suite *SourdoughRecipeRevisionHandlerTestSuite) TestDiff_WithErrorOnDiff() {
+	suite.service.EXPECT().Diff(gomock.Any(), test.ThirdId, 1, 5).
+		Return(domain.SourdoughRecipeRevisionDiffDto{}, internalErrors.NewBadRequestErrorf(123, "error", "error %s", "'test'"))
+
+	router := chi.NewRouter()
+	router.
+		With(httpin.NewInput(RevisionDiffInput{})).
+		Get("/recipe/{id}/revisions/diff", suite.target.Diff())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/recipe/%s/revisions/diff?from=1&to=5", test.ThirdId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 123,
+			"error_details": "error 'test'",
+			"error_message": "error"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *SourdoughRecipeRevisionHandlerTestSuite) TestRestore() {
+	recipe := createSourdoughRecipe()
+
+	suite.service.EXPECT().Restore(gomock.Any(), recipe.Id, 1).
+		Return(recipe, nil)
+
+	router := chi.NewRouter()
+	router.
+		Post("/recipe/{id}/revisions/{version}/restore", suite.target.Restore())
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("/recipe/%s/revisions/1/restore", recipe.Id), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/sourdough_recipe_response.json")
+}
+
+func (suite *SourdoughRecipeRevisionHandlerTestSuite) TestRestore_WithErrorOnRestore() {
+	suite.service.EXPECT().Restore(gomock.Any(), test.ThirdId, 1).
+		Return(domain.SourdoughRecipeDto{}, internalErrors.NewBadRequestErrorf(123, "error", "error %s", "'test'"))
+
+	router := chi.NewRouter()
+	router.
+		Post("/recipe/{id}/revisions/{version}/restore", suite.target.Restore())
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("/recipe/%s/revisions/1/restore", test.ThirdId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 123,
+			"error_details": "error 'test'",
+			"error_message": "error"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func TestNewSourdoughRecipeRevisionHandler_WithNilService(t *testing.T) {
+	handler, err := NewSourdoughRecipeRevisionHandler(nil)
+
+	assert.ErrorContains(t, err, "service is nil")
+	assert.Nil(t, handler)
+}
+
+func createSourdoughRecipeRevision() domain.SourdoughRecipeRevisionDto {
+	return domain.SourdoughRecipeRevisionDto{
+		Id:        test.FirstId,
+		RecipeId:  test.ThirdId,
+		Version:   1,
+		Recipe:    createSourdoughRecipe(),
+		CreatedAt: test.Date,
+	}
+}
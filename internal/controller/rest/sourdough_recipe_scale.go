@@ -13,7 +13,9 @@ import (
 )
 
 type sourdoughRecipeScaleHandler struct {
-	service domain.SourdoughRecipeScaleService
+	service       domain.SourdoughRecipeScaleService
+	renderer      domain.BakeSheetRenderer
+	jsonLdService domain.RecipeJsonLdService
 }
 
 func (handler *sourdoughRecipeScaleHandler) Scale() http.HandlerFunc {
@@ -36,7 +38,26 @@ func (handler *sourdoughRecipeScaleHandler) Scale() http.HandlerFunc {
 			return
 		}
 
-		render.JSON(res, req, recipeDto)
+		renderRecipe(res, req, handler.renderer, handler.jsonLdService, recipeDto)
+	}
+}
+
+func (handler *sourdoughRecipeScaleHandler) ScaleBatch() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		var request domain.SourdoughRecipeBatchScaleRequest
+
+		if err := render.DecodeJSON(req.Body, &request); err != nil {
+			HandlerError(res, req, errors.Wrap(err, "error while decoding request body"))
+			return
+		}
+
+		batch, err := handler.service.ScaleBatch(req.Context(), request)
+		if err != nil {
+			HandlerError(res, req, err)
+			return
+		}
+
+		render.JSON(res, req, batch)
 	}
 }
 
@@ -54,12 +75,24 @@ func (handler *sourdoughRecipeScaleHandler) getIdParam(res http.ResponseWriter,
 	return &id
 }
 
-func NewSourdoughRecipeScaleHandler(service domain.SourdoughRecipeScaleService) (domain.SourdoughRecipeScaleHandler, error) {
+func NewSourdoughRecipeScaleHandler(
+	service domain.SourdoughRecipeScaleService,
+	renderer domain.BakeSheetRenderer,
+	jsonLdService domain.RecipeJsonLdService,
+) (domain.SourdoughRecipeScaleHandler, error) {
 	if service == nil {
 		return nil, errors.New("service is nil")
 	}
+	if renderer == nil {
+		return nil, errors.New("renderer is nil")
+	}
+	if jsonLdService == nil {
+		return nil, errors.New("jsonLdService is nil")
+	}
 
 	return &sourdoughRecipeScaleHandler{
-		service: service,
+		service:       service,
+		renderer:      renderer,
+		jsonLdService: jsonLdService,
 	}, nil
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -27,7 +28,9 @@ func TestSourdoughRecipeScaleHandlerTestSuite(t *testing.T) {
 type SourdoughRecipeScaleHandlerTestSuite struct {
 	test.GoMockTestSuite
 
-	service *mocks.MockSourdoughRecipeScaleService
+	service       *mocks.MockSourdoughRecipeScaleService
+	renderer      *mocks.MockBakeSheetRenderer
+	jsonLdService *mocks.MockRecipeJsonLdService
 
 	target domain.SourdoughRecipeScaleHandler
 }
@@ -36,9 +39,11 @@ func (suite *SourdoughRecipeScaleHandlerTestSuite) SetupTest() {
 	suite.GoMockTestSuite.SetupTest()
 
 	suite.service = mocks.NewMockSourdoughRecipeScaleService(suite.MockCtrl)
+	suite.renderer = mocks.NewMockBakeSheetRenderer(suite.MockCtrl)
+	suite.jsonLdService = mocks.NewMockRecipeJsonLdService(suite.MockCtrl)
 
 	suite.target = test.Must(func() (domain.SourdoughRecipeScaleHandler, error) {
-		return NewSourdoughRecipeScaleHandler(suite.service)
+		return NewSourdoughRecipeScaleHandler(suite.service, suite.renderer, suite.jsonLdService)
 	})
 }
 
@@ -70,6 +75,80 @@ func (suite *SourdoughRecipeScaleHandlerTestSuite) TestScale() {
 	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/sourdough_recipe_response.json")
 }
 
+func (suite *SourdoughRecipeScaleHandlerTestSuite) TestScale_WithMarkdownAccept() {
+	id := uuid.New()
+	recipe := createSourdoughRecipe()
+	request := domain.SourdoughRecipeScaleRequestDto{
+		FinalDoughWeight: 500,
+	}
+
+	suite.service.EXPECT().
+		Scale(gomock.Any(), id, request).
+		Return(recipe, nil)
+	suite.renderer.EXPECT().
+		Render(gomock.Any(), domain.BakeSheetFormatMarkdown, recipe).
+		DoAndReturn(func(writer io.Writer, _ string, _ domain.SourdoughRecipeDto) error {
+			_, err := writer.Write([]byte("# test recipe"))
+			return err
+		})
+
+	buffer := bytes.NewBuffer([]byte{})
+	err := json.NewEncoder(buffer).Encode(request)
+	suite.Require().NoError(err)
+
+	router := chi.NewRouter()
+	router.
+		Post("/scale/{id}", suite.target.Scale())
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("/scale/%s", id), buffer)
+	suite.Require().NoError(err)
+	req.Header.Set("Accept", "text/markdown")
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusOK, resp.Code)
+	suite.Equal("text/markdown; charset=utf-8", resp.Header().Get("Content-Type"))
+	suite.Equal("# test recipe", resp.Body.String())
+}
+
+func (suite *SourdoughRecipeScaleHandlerTestSuite) TestScale_WithJsonLdAccept() {
+	id := uuid.New()
+	recipe := createSourdoughRecipe()
+	jsonLd := domain.SchemaOrgRecipeDto{Context: domain.SchemaOrgContext, Type: domain.SchemaOrgRecipeType, Name: recipe.Name}
+	request := domain.SourdoughRecipeScaleRequestDto{
+		FinalDoughWeight: 500,
+	}
+
+	suite.service.EXPECT().
+		Scale(gomock.Any(), id, request).
+		Return(recipe, nil)
+	suite.jsonLdService.EXPECT().
+		ExportRecipe(gomock.Any(), recipe).
+		Return(jsonLd, nil)
+
+	buffer := bytes.NewBuffer([]byte{})
+	err := json.NewEncoder(buffer).Encode(request)
+	suite.Require().NoError(err)
+
+	router := chi.NewRouter()
+	router.
+		Post("/scale/{id}", suite.target.Scale())
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("/scale/%s", id), buffer)
+	suite.Require().NoError(err)
+	req.Header.Set("Accept", "application/ld+json")
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusOK, resp.Code)
+	suite.Equal("application/ld+json; charset=utf-8", resp.Header().Get("Content-Type"))
+	test.VerifyRestBodyAsObject[domain.SchemaOrgRecipeDto](suite.T(), resp, `{"@context":"https://schema.org","@type":"Recipe","name":"test recipe"}`)
+}
+
 func (suite *SourdoughRecipeScaleHandlerTestSuite) TestScale_WithErrorOnScale() {
 	id := uuid.New()
 	request := domain.SourdoughRecipeScaleRequestDto{
@@ -189,8 +268,107 @@ func (suite *SourdoughRecipeScaleHandlerTestSuite) TestScale_WithoutRequiredIdPa
 	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
 }
 
+func (suite *SourdoughRecipeScaleHandlerTestSuite) TestScaleBatch() {
+	request := domain.SourdoughRecipeBatchScaleRequest{
+		Items: []domain.SourdoughRecipeBatchScaleItemDto{{RecipeId: test.ThirdId, Pieces: 2, PieceWeight: 985}},
+	}
+
+	suite.service.EXPECT().
+		ScaleBatch(gomock.Any(), request).
+		Return(domain.SourdoughRecipeBatchScaleDto{
+			Sheets: []domain.SourdoughRecipeBatchScaleSheetDto{
+				{SourdoughRecipeBatchScaleItemDto: request.Items[0], Recipe: createSourdoughRecipe()},
+			},
+			PickList: domain.PickListDto{
+				Flour:                 []domain.FlourAmountDto{{FlourDto: domain.FlourDto{Id: test.FirstId, Name: "Bread flour"}, Amount: 1000}},
+				Water:                 750,
+				Starter:               20,
+				AdditionalIngredients: []domain.BakerAmountDto{{Name: "Salt", Amount: 20}},
+				TotalWeight:           1970,
+			},
+		}, nil)
+
+	buffer := bytes.NewBuffer([]byte{})
+	err := json.NewEncoder(buffer).Encode(request)
+	suite.Require().NoError(err)
+
+	router := chi.NewRouter()
+	router.
+		Post("/scale/batch", suite.target.ScaleBatch())
+
+	req, err := http.NewRequest("POST", "/scale/batch", buffer)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/sourdough_recipe_batch_scale_response.json")
+}
+
+func (suite *SourdoughRecipeScaleHandlerTestSuite) TestScaleBatch_WithErrorOnScaleBatch() {
+	suite.service.EXPECT().
+		ScaleBatch(gomock.Any(), domain.SourdoughRecipeBatchScaleRequest{}).
+		Return(domain.SourdoughRecipeBatchScaleDto{}, internalErrors.SourdoughRecipeBatchScaleInvalid("items are required"))
+
+	router := chi.NewRouter()
+	router.
+		Post("/scale/batch", suite.target.ScaleBatch())
+
+	req, err := http.NewRequest("POST", "/scale/batch", bytes.NewReader([]byte(`{}`)))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 19001,
+			"error_details": "items are required",
+			"error_message": "invalid batch scale request"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *SourdoughRecipeScaleHandlerTestSuite) TestScaleBatch_WithInvalidBody() {
+	router := chi.NewRouter()
+	router.
+		Post("/scale/batch", suite.target.ScaleBatch())
+
+	req, err := http.NewRequest("POST", "/scale/batch", bytes.NewReader([]byte(`"invalid"`)))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": -1,
+			"error_details": "error while decoding request body: json: cannot unmarshal string into Go value of type domain.SourdoughRecipeBatchScaleRequest",
+			"error_message": "internal server error"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusInternalServerError, expectedBodyJson)
+}
+
 func TestNewSourdoughRecipeScaleHandler_WithNilService(t *testing.T) {
-	_, err := NewSourdoughRecipeScaleHandler(nil)
+	ctrl := gomock.NewController(t)
+	_, err := NewSourdoughRecipeScaleHandler(nil, mocks.NewMockBakeSheetRenderer(ctrl), mocks.NewMockRecipeJsonLdService(ctrl))
 
 	assert.ErrorContains(t, err, "service is nil")
 }
+
+func TestNewSourdoughRecipeScaleHandler_WithNilRenderer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	_, err := NewSourdoughRecipeScaleHandler(mocks.NewMockSourdoughRecipeScaleService(ctrl), nil, mocks.NewMockRecipeJsonLdService(ctrl))
+
+	assert.ErrorContains(t, err, "renderer is nil")
+}
+
+func TestNewSourdoughRecipeScaleHandler_WithNilJsonLdService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	_, err := NewSourdoughRecipeScaleHandler(mocks.NewMockSourdoughRecipeScaleService(ctrl), mocks.NewMockBakeSheetRenderer(ctrl), nil)
+
+	assert.ErrorContains(t, err, "jsonLdService is nil")
+}
@@ -0,0 +1,196 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func TestSourdoughRecipeSubstitutionHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(SourdoughRecipeSubstitutionHandlerTestSuite))
+}
+
+type SourdoughRecipeSubstitutionHandlerTestSuite struct {
+	test.GoMockTestSuite
+
+	service *mocks.MockSourdoughRecipeSubstitutionService
+
+	target domain.SourdoughRecipeSubstitutionHandler
+}
+
+func (suite *SourdoughRecipeSubstitutionHandlerTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.service = mocks.NewMockSourdoughRecipeSubstitutionService(suite.MockCtrl)
+
+	suite.target = test.Must(func() (domain.SourdoughRecipeSubstitutionHandler, error) {
+		return NewSourdoughRecipeSubstitutionHandler(suite.service)
+	})
+}
+
+func (suite *SourdoughRecipeSubstitutionHandlerTestSuite) TestSubstitute() {
+	id := uuid.New()
+	percentage := 50.0
+	request := domain.SourdoughRecipeSubstitutionRequest{
+		FromFlourId: test.FirstId,
+		ToFlourId:   test.SecondId,
+		Percentage:  &percentage,
+		Target:      "dough",
+	}
+
+	suite.service.EXPECT().
+		Substitute(gomock.Any(), id, request).
+		Return(domain.SourdoughRecipeSubstitutionDto{
+			Recipe:            createSourdoughRecipe(),
+			SubstitutedAmount: 300,
+			FromAbsorption:    65,
+			ToAbsorption:      75,
+			WaterAdjustment:   30,
+		}, nil)
+
+	buffer := bytes.NewBuffer([]byte{})
+	err := json.NewEncoder(buffer).Encode(request)
+	suite.Require().NoError(err)
+
+	router := chi.NewRouter()
+	router.
+		Post("/substitute/{id}", suite.target.Substitute())
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("/substitute/%s", id), buffer)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/sourdough_recipe_substitution_response.json")
+}
+
+func (suite *SourdoughRecipeSubstitutionHandlerTestSuite) TestSubstitute_WithErrorOnSubstitute() {
+	id := uuid.New()
+	request := domain.SourdoughRecipeSubstitutionRequest{
+		FromFlourId: test.FirstId,
+		ToFlourId:   test.SecondId,
+	}
+
+	suite.service.EXPECT().
+		Substitute(gomock.Any(), id, request).
+		Return(domain.SourdoughRecipeSubstitutionDto{}, internalErrors.SourdoughRecipeSubstitutionFlourNotFound(test.FirstId))
+
+	buffer := bytes.NewBuffer([]byte{})
+	err := json.NewEncoder(buffer).Encode(request)
+	suite.Require().NoError(err)
+
+	router := chi.NewRouter()
+	router.
+		Post("/substitute/{id}", suite.target.Substitute())
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("/substitute/%s", id), buffer)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 17002,
+			"error_details": "flour with id 74c65c5a-44e7-44a2-8cdd-cd7c49cfcb42 is not part of the recipe",
+			"error_message": "flour to substitute not found"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *SourdoughRecipeSubstitutionHandlerTestSuite) TestSubstitute_WithInvalidBody() {
+	id := uuid.New()
+
+	buffer := bytes.NewBuffer([]byte{})
+	err := json.NewEncoder(buffer).Encode("invalid")
+	suite.Require().NoError(err)
+
+	router := chi.NewRouter()
+	router.
+		Post("/substitute/{id}", suite.target.Substitute())
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("/substitute/%s", id), buffer)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": -1,
+			"error_details": "error while decoding request body: json: cannot unmarshal string into Go value of type domain.SourdoughRecipeSubstitutionRequest",
+			"error_message": "internal server error"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusInternalServerError, expectedBodyJson)
+}
+
+func (suite *SourdoughRecipeSubstitutionHandlerTestSuite) TestSubstitute_WithInvalidParams() {
+	tests := []struct {
+		name             string
+		route            string
+		path             string
+		expectedBodyJson string
+	}{
+		{
+			name:  "missing id",
+			route: "/substitute",
+			path:  "/substitute",
+			expectedBodyJson: `{
+				"error_code": 10001,
+				"error_details": "id is required",
+				"error_message": "id is required"
+			}`,
+		},
+		{
+			name:  "invalid id",
+			route: "/substitute/{id}",
+			path:  "/substitute/invalid",
+			expectedBodyJson: `{
+				"error_code": 10002,
+				"error_details": "id is not valid",
+				"error_message": "id is not valid"
+			}`,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			router := chi.NewRouter()
+			router.Post(tt.route, suite.target.Substitute())
+
+			req, err := http.NewRequest("POST", tt.path, bytes.NewReader([]byte("{}")))
+			suite.Require().NoError(err)
+
+			resp := httptest.NewRecorder()
+
+			router.ServeHTTP(resp, req)
+
+			test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, tt.expectedBodyJson)
+		})
+	}
+}
+
+func TestNewSourdoughRecipeSubstitutionHandler_WithNilService(t *testing.T) {
+	handler, err := NewSourdoughRecipeSubstitutionHandler(nil)
+
+	assert.ErrorContains(t, err, "service cannot be nil")
+	assert.Nil(t, handler)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -27,7 +28,9 @@ func TestSourdoughRecipeHandlerTestSuite(t *testing.T) {
 type SourdoughRecipeHandlerTestSuite struct {
 	test.GoMockTestSuite
 
-	service *mocks.MockSourdoughRecipeService
+	service       *mocks.MockSourdoughRecipeService
+	renderer      *mocks.MockBakeSheetRenderer
+	jsonLdService *mocks.MockRecipeJsonLdService
 
 	target domain.SourdoughRecipeHandler
 }
@@ -36,9 +39,11 @@ func (suite *SourdoughRecipeHandlerTestSuite) SetupTest() {
 	suite.GoMockTestSuite.SetupTest()
 
 	suite.service = mocks.NewMockSourdoughRecipeService(suite.MockCtrl)
+	suite.renderer = mocks.NewMockBakeSheetRenderer(suite.MockCtrl)
+	suite.jsonLdService = mocks.NewMockRecipeJsonLdService(suite.MockCtrl)
 
 	suite.target = test.Must(func() (domain.SourdoughRecipeHandler, error) {
-		return NewSourdoughRecipeHandler(suite.service)
+		return NewSourdoughRecipeHandler(suite.service, suite.renderer, suite.jsonLdService)
 	})
 }
 
@@ -131,6 +136,201 @@ func (suite *SourdoughRecipeHandlerTestSuite) TestFindById() {
 	router.ServeHTTP(resp, req)
 
 	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/sourdough_recipe_response.json")
+	suite.Equal("Accept", resp.Header().Get("Vary"))
+}
+
+func (suite *SourdoughRecipeHandlerTestSuite) TestFindById_WithBakeSheetAccept() {
+	recipe := createSourdoughRecipe()
+
+	tests := []struct {
+		name                string
+		accept              string
+		format              string
+		expectedContentType string
+	}{
+		{
+			name:                "markdown",
+			accept:              "text/markdown",
+			format:              domain.BakeSheetFormatMarkdown,
+			expectedContentType: "text/markdown; charset=utf-8",
+		},
+		{
+			name:                "html from browser",
+			accept:              "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8",
+			format:              domain.BakeSheetFormatHtml,
+			expectedContentType: "text/html; charset=utf-8",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.service.EXPECT().FindById(gomock.Any(), recipe.Id).
+				Return(recipe, nil)
+			suite.renderer.EXPECT().Render(gomock.Any(), tt.format, recipe).
+				DoAndReturn(func(writer io.Writer, _ string, _ domain.SourdoughRecipeDto) error {
+					_, err := writer.Write([]byte("sheet"))
+					return err
+				})
+
+			router := chi.NewRouter()
+			router.
+				Get("/recipe/{id}", suite.target.FindById())
+
+			req, err := http.NewRequest("GET", fmt.Sprintf("/recipe/%s", recipe.Id), nil)
+			suite.Require().NoError(err)
+			req.Header.Set("Accept", tt.accept)
+
+			resp := httptest.NewRecorder()
+
+			router.ServeHTTP(resp, req)
+
+			suite.Equal(http.StatusOK, resp.Code)
+			suite.Equal(tt.expectedContentType, resp.Header().Get("Content-Type"))
+			suite.Equal("Accept", resp.Header().Get("Vary"))
+			suite.Equal("sheet", resp.Body.String())
+		})
+	}
+}
+
+func (suite *SourdoughRecipeHandlerTestSuite) TestFindById_WithJsonPreferredAccept() {
+	recipe := createSourdoughRecipe()
+
+	tests := []struct {
+		name   string
+		accept string
+	}{
+		{
+			name:   "listed first",
+			accept: "application/json, text/html",
+		},
+		{
+			name:   "higher quality",
+			accept: "text/html;q=0.1, application/json",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.service.EXPECT().FindById(gomock.Any(), recipe.Id).
+				Return(recipe, nil)
+
+			router := chi.NewRouter()
+			router.
+				Get("/recipe/{id}", suite.target.FindById())
+
+			req, err := http.NewRequest("GET", fmt.Sprintf("/recipe/%s", recipe.Id), nil)
+			suite.Require().NoError(err)
+			req.Header.Set("Accept", tt.accept)
+
+			resp := httptest.NewRecorder()
+
+			router.ServeHTTP(resp, req)
+
+			test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/sourdough_recipe_response.json")
+			suite.Equal("Accept", resp.Header().Get("Vary"))
+		})
+	}
+}
+
+func (suite *SourdoughRecipeHandlerTestSuite) TestFindById_WithJsonLdAccept() {
+	recipe := createSourdoughRecipe()
+	jsonLd := domain.SchemaOrgRecipeDto{Context: domain.SchemaOrgContext, Type: domain.SchemaOrgRecipeType, Name: recipe.Name}
+
+	suite.service.EXPECT().FindById(gomock.Any(), recipe.Id).
+		Return(recipe, nil)
+	suite.jsonLdService.EXPECT().ExportRecipe(gomock.Any(), recipe).
+		Return(jsonLd, nil)
+
+	router := chi.NewRouter()
+	router.
+		Get("/recipe/{id}", suite.target.FindById())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/recipe/%s", recipe.Id), nil)
+	suite.Require().NoError(err)
+	req.Header.Set("Accept", "application/ld+json")
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusOK, resp.Code)
+	suite.Equal("application/ld+json; charset=utf-8", resp.Header().Get("Content-Type"))
+	suite.Equal("Accept", resp.Header().Get("Vary"))
+	test.VerifyRestBodyAsObject[domain.SchemaOrgRecipeDto](suite.T(), resp, `{"@context":"https://schema.org","@type":"Recipe","name":"test recipe"}`)
+}
+
+func (suite *SourdoughRecipeHandlerTestSuite) TestFindById_WithErrorOnJsonLdExport() {
+	recipe := createSourdoughRecipe()
+
+	suite.service.EXPECT().FindById(gomock.Any(), recipe.Id).
+		Return(recipe, nil)
+	suite.jsonLdService.EXPECT().ExportRecipe(gomock.Any(), recipe).
+		Return(domain.SchemaOrgRecipeDto{}, assert.AnError)
+
+	router := chi.NewRouter()
+	router.
+		Get("/recipe/{id}", suite.target.FindById())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/recipe/%s", recipe.Id), nil)
+	suite.Require().NoError(err)
+	req.Header.Set("Accept", "application/ld+json")
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusInternalServerError, resp.Code)
+}
+
+func (suite *SourdoughRecipeHandlerTestSuite) TestFindById_WithErrorOnRender() {
+	recipe := createSourdoughRecipe()
+
+	suite.service.EXPECT().FindById(gomock.Any(), recipe.Id).
+		Return(recipe, nil)
+	suite.renderer.EXPECT().Render(gomock.Any(), domain.BakeSheetFormatMarkdown, recipe).
+		Return(assert.AnError)
+
+	router := chi.NewRouter()
+	router.
+		Get("/recipe/{id}", suite.target.FindById())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/recipe/%s", recipe.Id), nil)
+	suite.Require().NoError(err)
+	req.Header.Set("Accept", "text/markdown")
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	suite.Equal(http.StatusInternalServerError, resp.Code)
+}
+
+func (suite *SourdoughRecipeHandlerTestSuite) TestFindById_WithNotAcceptableMediaType() {
+	recipe := createSourdoughRecipe()
+
+	suite.service.EXPECT().FindById(gomock.Any(), recipe.Id).
+		Return(recipe, nil)
+
+	router := chi.NewRouter()
+	router.
+		Get("/recipe/{id}", suite.target.FindById())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/recipe/%s", recipe.Id), nil)
+	suite.Require().NoError(err)
+	req.Header.Set("Accept", "image/png")
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 10101,
+			"error_details": "recipe is available as application/json, application/ld+json, text/markdown, text/html",
+			"error_message": "media type not acceptable"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusNotAcceptable, expectedBodyJson)
+	suite.Equal("Accept", resp.Header().Get("Vary"))
 }
 
 func (suite *SourdoughRecipeHandlerTestSuite) TestFindById_WithoutParam() {
@@ -201,34 +401,152 @@ func (suite *SourdoughRecipeHandlerTestSuite) TestFindById_WithErrorOnFindById()
 	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
 }
 
+func (suite *SourdoughRecipeHandlerTestSuite) TestUpdate() {
+	recipe := createSourdoughRecipe()
+	request := generateCreateRequest()
+
+	suite.service.EXPECT().
+		Update(gomock.Any(), recipe.Id, request).
+		Return(recipe, nil)
+
+	buffer := bytes.NewBuffer([]byte{})
+	err := json.NewEncoder(buffer).Encode(request)
+	suite.Require().NoError(err)
+
+	router := chi.NewRouter()
+	router.
+		Put("/recipe/{id}", suite.target.Update())
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("/recipe/%s", recipe.Id), buffer)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	test.VerifyRestResponseWithTestFileAsObject[domain.SourdoughRecipeDto](suite.T(), resp, http.StatusOK, "testdata/sourdough_recipe_response.json")
+}
+
+func (suite *SourdoughRecipeHandlerTestSuite) TestUpdate_WithInvalidIdParam() {
+	router := chi.NewRouter()
+	router.
+		Put("/recipe/{id}", suite.target.Update())
+
+	req, err := http.NewRequest("PUT", "/recipe/invalid", bytes.NewBuffer([]byte("{}")))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 10002,
+			"error_details": "id is not valid",
+			"error_message": "id is not valid"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *SourdoughRecipeHandlerTestSuite) TestUpdate_WithInvalidRequest() {
+	router := chi.NewRouter()
+	router.
+		Put("/recipe/{id}", suite.target.Update())
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("/recipe/%s", test.ThirdId), bytes.NewBuffer([]byte("invalid body")))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": -1,
+			"error_details": "error while decoding request body: invalid character 'i' looking for beginning of value",
+			"error_message": "internal server error"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusInternalServerError, expectedBodyJson)
+}
+
+func (suite *SourdoughRecipeHandlerTestSuite) TestUpdate_WithErrorOnUpdate() {
+	request := generateCreateRequest()
+
+	suite.service.EXPECT().
+		Update(gomock.Any(), test.ThirdId, request).
+		Return(domain.SourdoughRecipeDto{}, internalErrors.NewBadRequestErrorf(123, "error", "error %s", "'test'"))
+
+	buffer := bytes.NewBuffer([]byte{})
+	err := json.NewEncoder(buffer).Encode(request)
+	suite.Require().NoError(err)
+
+	router := chi.NewRouter()
+	router.
+		Put("/recipe/{id}", suite.target.Update())
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("/recipe/%s", test.ThirdId), buffer)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 123,
+			"error_details": "error 'test'",
+			"error_message": "error"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
 func (suite *SourdoughRecipeHandlerTestSuite) TestFind() {
-	recipes := []domain.SourdoughRecipeDto{createSourdoughRecipe()}
+	recipe := createSourdoughRecipe()
+	recipe.Tags = []string{"rye"}
+	recipe.Category = "bread"
+	maxHydration := 80.0
+	minFlour := 500.0
+	createdAfter := test.Date
 
-	suite.service.EXPECT().Find(gomock.Any(), 1, 10).Return(recipes, nil)
+	suite.service.EXPECT().
+		Find(gomock.Any(), domain.SourdoughRecipeFilter{
+			Tags:         []string{"rye", "enriched"},
+			Categories:   []string{"bread"},
+			FlourTypes:   []string{"whole grain"},
+			MaxHydration: &maxHydration,
+			MinFlour:     &minFlour,
+			CreatedAfter: &createdAfter,
+		}, domain.PageParams{Sort: "hydration", Order: "asc", Cursor: "next", Offset: 1, Limit: 10}).
+		Return(createSourdoughRecipeSearchResult(recipe), nil)
 
 	router := chi.NewRouter()
 	router.
-		With(httpin.NewInput(PageInput{})).
+		With(httpin.NewInput(FindRecipeInput{})).
 		Get("/find", suite.target.Find())
 
-	req, err := http.NewRequest("GET", "/find?offset=1&limit=10", nil)
+	req, err := http.NewRequest("GET", "/find?offset=1&limit=10&sort=hydration&order=asc&cursor=next&tag=rye&tag=enriched&category=bread"+
+		"&flour_type=whole+grain&max_hydration=80&min_flour=500&created_after=2020-01-25T01:01:01.000000001Z", nil)
 	suite.Require().NoError(err)
 
 	resp := httptest.NewRecorder()
 
 	router.ServeHTTP(resp, req)
 
-	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/sourdough_recipes_response.json")
+	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/sourdough_recipe_search_result_response.json")
 }
 
 func (suite *SourdoughRecipeHandlerTestSuite) TestFind_WithDefaultParameters() {
-	recipes := []domain.SourdoughRecipeDto{createSourdoughRecipe()}
+	recipe := createSourdoughRecipe()
+	recipe.Tags = []string{"rye"}
+	recipe.Category = "bread"
 
-	suite.service.EXPECT().Find(gomock.Any(), 0, 25).Return(recipes, nil)
+	suite.service.EXPECT().
+		Find(gomock.Any(), domain.SourdoughRecipeFilter{}, domain.PageParams{Limit: 25}).
+		Return(createSourdoughRecipeSearchResult(recipe), nil)
 
 	router := chi.NewRouter()
 	router.
-		With(httpin.NewInput(PageInput{})).
+		With(httpin.NewInput(FindRecipeInput{})).
 		Get("/", suite.target.Find())
 
 	req, err := http.NewRequest("GET", "/", nil)
@@ -238,16 +556,16 @@ func (suite *SourdoughRecipeHandlerTestSuite) TestFind_WithDefaultParameters() {
 
 	router.ServeHTTP(resp, req)
 
-	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/sourdough_recipes_response.json")
+	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/sourdough_recipe_search_result_response.json")
 }
 
 func (suite *SourdoughRecipeHandlerTestSuite) TestFind_WithErrorOnFind() {
-	suite.service.EXPECT().Find(gomock.Any(), 0, 25).
-		Return(nil, internalErrors.NewBadRequestErrorf(123, "error", "error %s", "'test'"))
+	suite.service.EXPECT().Find(gomock.Any(), domain.SourdoughRecipeFilter{}, domain.PageParams{Limit: 25}).
+		Return(domain.SourdoughRecipeSearchResultDto{}, internalErrors.NewBadRequestErrorf(123, "error", "error %s", "'test'"))
 
 	router := chi.NewRouter()
 	router.
-		With(httpin.NewInput(PageInput{})).
+		With(httpin.NewInput(FindRecipeInput{})).
 		Get("/", suite.target.Find())
 
 	req, err := http.NewRequest("GET", "/", nil)
@@ -287,6 +605,59 @@ func (suite *SourdoughRecipeHandlerTestSuite) TestSearch() {
 	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/sourdough_recipes_response.json")
 }
 
+func (suite *SourdoughRecipeHandlerTestSuite) TestTextSearch() {
+	suite.service.EXPECT().TextSearch(gomock.Any(), "recipe", 5, 10).
+		Return(domain.SourdoughRecipeTextSearchResultDto{
+			Items: []domain.SourdoughRecipeTextSearchHitDto{
+				{
+					Recipe:     createSourdoughRecipe(),
+					Score:      12.5,
+					Highlights: map[string][]string{"name": {"test <em>recipe</em>"}},
+				},
+			},
+			Total: 1,
+		}, nil)
+
+	router := chi.NewRouter()
+	router.
+		With(httpin.NewInput(TextSearchInput{})).
+		Get("/search/text", suite.target.TextSearch())
+
+	req, err := http.NewRequest("GET", "/search/text?q=recipe&offset=5&limit=10", nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/sourdough_recipe_text_search_response.json")
+}
+
+func (suite *SourdoughRecipeHandlerTestSuite) TestTextSearch_WithErrorOnSearch() {
+	suite.service.EXPECT().TextSearch(gomock.Any(), "", 0, 25).
+		Return(domain.SourdoughRecipeTextSearchResultDto{}, internalErrors.SearchQueryInvalid("search query is required"))
+
+	router := chi.NewRouter()
+	router.
+		With(httpin.NewInput(TextSearchInput{})).
+		Get("/search/text", suite.target.TextSearch())
+
+	req, err := http.NewRequest("GET", "/search/text", nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 15002,
+			"error_details": "search query is required",
+			"error_message": "invalid search query"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
 func (suite *SourdoughRecipeHandlerTestSuite) TestSearch_WithErrorOnSearch() {
 	suite.service.EXPECT().SearchByName(gomock.Any(), "test name").
 		Return(nil, internalErrors.NewBadRequestErrorf(123, "error", "error %s", "'test'"))
@@ -312,13 +683,245 @@ func (suite *SourdoughRecipeHandlerTestSuite) TestSearch_WithErrorOnSearch() {
 	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
 }
 
+func (suite *SourdoughRecipeHandlerTestSuite) TestFork() {
+	recipe := createSourdoughRecipe()
+	hydration := 78.0
+	request := domain.ForkSourdoughRecipeRequest{
+		Name: "test recipe with rye",
+		FlourSubstitutions: []domain.FlourSubstitutionDto{
+			{
+				FromFlourId: test.FirstId,
+				ToFlourId:   test.SecondId,
+				Percentage:  20,
+			},
+		},
+		Hydration: &hydration,
+	}
+
+	suite.service.EXPECT().
+		Fork(gomock.Any(), recipe.Id, request).
+		Return(recipe, nil)
+
+	buffer := bytes.NewBuffer([]byte{})
+	err := json.NewEncoder(buffer).Encode(request)
+	suite.Require().NoError(err)
+
+	router := chi.NewRouter()
+	router.
+		Post("/recipe/{id}/fork", suite.target.Fork())
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("/recipe/%s/fork", recipe.Id), buffer)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	test.VerifyRestResponseWithTestFileAsObject[domain.SourdoughRecipeDto](suite.T(), resp, http.StatusCreated, "testdata/sourdough_recipe_response.json")
+}
+
+func (suite *SourdoughRecipeHandlerTestSuite) TestFork_WithInvalidRequest() {
+	router := chi.NewRouter()
+	router.
+		Post("/recipe/{id}/fork", suite.target.Fork())
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("/recipe/%s/fork", test.ThirdId), bytes.NewBuffer([]byte("invalid body")))
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": -1,
+			"error_details": "error while decoding request body: invalid character 'i' looking for beginning of value",
+			"error_message": "internal server error"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusInternalServerError, expectedBodyJson)
+}
+
+func (suite *SourdoughRecipeHandlerTestSuite) TestFork_WithErrorOnFork() {
+	request := domain.ForkSourdoughRecipeRequest{Name: "test recipe with rye"}
+
+	suite.service.EXPECT().
+		Fork(gomock.Any(), test.ThirdId, request).
+		Return(domain.SourdoughRecipeDto{}, internalErrors.NewBadRequestErrorf(123, "error", "error %s", "'test'"))
+
+	buffer := bytes.NewBuffer([]byte{})
+	err := json.NewEncoder(buffer).Encode(request)
+	suite.Require().NoError(err)
+
+	router := chi.NewRouter()
+	router.
+		Post("/recipe/{id}/fork", suite.target.Fork())
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("/recipe/%s/fork", test.ThirdId), buffer)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 123,
+			"error_details": "error 'test'",
+			"error_message": "error"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *SourdoughRecipeHandlerTestSuite) TestFamilyTree() {
+	familyTree := domain.SourdoughRecipeFamilyTreeDto{
+		Id:        test.FirstId,
+		Name:      "country loaf",
+		Version:   3,
+		CreatedAt: test.Date,
+		Children: []domain.SourdoughRecipeFamilyTreeDto{
+			{
+				Id:        test.ThirdId,
+				Name:      "country loaf with rye",
+				Version:   1,
+				CreatedAt: test.Date,
+				Children:  []domain.SourdoughRecipeFamilyTreeDto{},
+			},
+		},
+	}
+
+	suite.service.EXPECT().FamilyTree(gomock.Any(), test.ThirdId).
+		Return(familyTree, nil)
+
+	router := chi.NewRouter()
+	router.
+		Get("/recipe/{id}/family-tree", suite.target.FamilyTree())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/recipe/%s/family-tree", test.ThirdId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	test.VerifyRestResponseWithTestFile(suite.T(), resp, http.StatusOK, "testdata/sourdough_recipe_family_tree_response.json")
+}
+
+func (suite *SourdoughRecipeHandlerTestSuite) TestFamilyTree_WithErrorOnFamilyTree() {
+	suite.service.EXPECT().FamilyTree(gomock.Any(), test.ThirdId).
+		Return(domain.SourdoughRecipeFamilyTreeDto{}, internalErrors.NewBadRequestErrorf(123, "error", "error %s", "'test'"))
+
+	router := chi.NewRouter()
+	router.
+		Get("/recipe/{id}/family-tree", suite.target.FamilyTree())
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("/recipe/%s/family-tree", test.ThirdId), nil)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 123,
+			"error_details": "error 'test'",
+			"error_message": "error"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
+func (suite *SourdoughRecipeHandlerTestSuite) TestCreateFromFormula() {
+	doughWeight := 1970.0
+	request := domain.CreateSourdoughRecipeFormulaRequest{
+		Name: "test recipe",
+		Flour: []domain.FlourPercentageDto{
+			{FlourDto: domain.FlourDto{Id: test.FirstId}, BakerPercentage: 100},
+		},
+		Water:       []domain.BakerAmountDto{{Name: "Water", BakerPercentage: 75}},
+		Levain:      domain.SourdoughLevainFormulaDto{BakerPercentage: 20, Hydration: 100},
+		DoughWeight: &doughWeight,
+	}
+
+	suite.service.EXPECT().
+		CreateFromFormula(gomock.Any(), request).
+		Return(createSourdoughRecipe(), nil)
+
+	buffer := bytes.NewBuffer([]byte{})
+	err := json.NewEncoder(buffer).Encode(request)
+	suite.Require().NoError(err)
+
+	router := chi.NewRouter()
+	router.
+		Post("/formula", suite.target.CreateFromFormula())
+
+	req, err := http.NewRequest("POST", "/formula", buffer)
+	suite.Require().NoError(err)
+
+	resp := httptest.NewRecorder()
+
+	router.ServeHTTP(resp, req)
+
+	test.VerifyRestResponseWithTestFileAsObject[domain.SourdoughRecipeDto](suite.T(), resp, http.StatusCreated, "testdata/sourdough_recipe_response.json")
+}
+
+func (suite *SourdoughRecipeHandlerTestSuite) TestCreateFromFormula_WithInvalidRequest() {
+	req := httptest.NewRequest("POST", "http://testing", bytes.NewBuffer([]byte("invalid body")))
+	resp := httptest.NewRecorder()
+
+	suite.target.CreateFromFormula().ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": -1,
+			"error_details": "error while decoding request body: invalid character 'i' looking for beginning of value",
+			"error_message": "internal server error"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusInternalServerError, expectedBodyJson)
+}
+
+func (suite *SourdoughRecipeHandlerTestSuite) TestCreateFromFormula_WithErrorOnCreate() {
+	suite.service.EXPECT().
+		CreateFromFormula(gomock.Any(), domain.CreateSourdoughRecipeFormulaRequest{}).
+		Return(domain.SourdoughRecipeDto{}, internalErrors.SourdoughRecipeFormulaInvalid("flour is required"))
+
+	req := httptest.NewRequest("POST", "http://testing", bytes.NewBuffer([]byte(`{}`)))
+	resp := httptest.NewRecorder()
+
+	suite.target.CreateFromFormula().ServeHTTP(resp, req)
+
+	expectedBodyJson :=
+		`{
+			"error_code": 18002,
+			"error_details": "flour is required",
+			"error_message": "invalid recipe formula"
+		}`
+	test.VerifyRestResponse(suite.T(), resp, http.StatusBadRequest, expectedBodyJson)
+}
+
 func TestNewSourdoughRecipeHandler_WithNilService(t *testing.T) {
-	handler, err := NewSourdoughRecipeHandler(nil)
+	ctrl := gomock.NewController(t)
+	handler, err := NewSourdoughRecipeHandler(nil, mocks.NewMockBakeSheetRenderer(ctrl), mocks.NewMockRecipeJsonLdService(ctrl))
 
 	assert.ErrorContains(t, err, "service cannot be nil")
 	assert.Nil(t, handler)
 }
 
+func TestNewSourdoughRecipeHandler_WithNilRenderer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	handler, err := NewSourdoughRecipeHandler(mocks.NewMockSourdoughRecipeService(ctrl), nil, mocks.NewMockRecipeJsonLdService(ctrl))
+
+	assert.ErrorContains(t, err, "renderer cannot be nil")
+	assert.Nil(t, handler)
+}
+
+func TestNewSourdoughRecipeHandler_WithNilJsonLdService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	handler, err := NewSourdoughRecipeHandler(mocks.NewMockSourdoughRecipeService(ctrl), mocks.NewMockBakeSheetRenderer(ctrl), nil)
+
+	assert.ErrorContains(t, err, "jsonLdService cannot be nil")
+	assert.Nil(t, handler)
+}
+
 func generateCreateRequest() domain.CreateSourdoughRecipeRequest {
 	return domain.CreateSourdoughRecipeRequest{
 		Name:        "test recipe",
@@ -438,6 +1041,21 @@ func generateCreateRequest() domain.CreateSourdoughRecipeRequest {
 	}
 }
 
+func createSourdoughRecipeSearchResult(recipe domain.SourdoughRecipeDto) domain.SourdoughRecipeSearchResultDto {
+	maxHydration := 80.0
+
+	return domain.SourdoughRecipeSearchResultDto{
+		Items:   []domain.SourdoughRecipeDto{recipe},
+		PageDto: domain.PageDto{Total: 31, NextCursor: "next", PrevCursor: "prev"},
+		Facets: domain.SourdoughRecipeFacetsDto{
+			Tags:       []domain.FacetCountDto{{Value: "rye", Count: 1}},
+			Categories: []domain.FacetCountDto{{Value: "bread", Count: 1}},
+			FlourTypes: []domain.FacetCountDto{{Value: "whole grain", Count: 1}},
+			Hydration:  []domain.HydrationBucketDto{{Min: 75, Max: &maxHydration, Count: 1}},
+		},
+	}
+}
+
 func createSourdoughRecipe() domain.SourdoughRecipeDto {
 	return domain.SourdoughRecipeDto{
 		RecipeDto: domain.RecipeDto{
@@ -526,6 +1144,7 @@ func createSourdoughRecipe() domain.SourdoughRecipeDto {
 				},
 			},
 			CreatedAt: test.Date,
+			Version:   1,
 			UpdatedAt: nil,
 			Yield: domain.RecipeYieldDto{
 				Unit:   "loaf",
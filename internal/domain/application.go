@@ -3,6 +3,8 @@
 package domain
 
 import (
+	"context"
+	"io"
 	"net/http"
 
 	"dough-calculator/internal/config"
@@ -16,3 +18,9 @@ type Application interface {
 	Server() *http.Server
 	Config() config.Config
 }
+
+// MigrationRunner runs a migration command of the command line, up, down
+// or status, without starting the application.
+type MigrationRunner interface {
+	Run(ctx context.Context, command string, steps int, out io.Writer) error
+}
@@ -0,0 +1,162 @@
+//go:generate mockgen -source=bake_log.go -destination=mocks/bake_log.go -package mocks
+
+package domain
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MinBakeRating = 1
+	MaxBakeRating = 5
+)
+
+type BakeTemperatures struct {
+	Room  float64
+	Dough float64
+	Oven  float64
+}
+
+func (temperatures BakeTemperatures) ToDto() BakeTemperaturesDto {
+	return BakeTemperaturesDto{
+		Room:  temperatures.Room,
+		Dough: temperatures.Dough,
+		Oven:  temperatures.Oven,
+	}
+}
+
+type BakeTimings struct {
+	BulkFermentationMinutes int `bson:"bulk_fermentation_minutes"`
+	ProofMinutes            int `bson:"proof_minutes"`
+	BakeMinutes             int `bson:"bake_minutes"`
+}
+
+func (timings BakeTimings) ToDto() BakeTimingsDto {
+	return BakeTimingsDto{
+		BulkFermentationMinutes: timings.BulkFermentationMinutes,
+		ProofMinutes:            timings.ProofMinutes,
+		BakeMinutes:             timings.BakeMinutes,
+	}
+}
+
+type BakeLogEntity struct {
+	Id            uuid.UUID `bson:"_id"`
+	RecipeId      uuid.UUID `bson:"recipe_id"`
+	RecipeVersion int       `bson:"recipe_version"`
+	ScaledWeight  int       `bson:"scaled_weight"`
+	Temperatures  BakeTemperatures
+	Timings       BakeTimings
+	Notes         string
+	Rating        int
+	BakedAt       time.Time `bson:"baked_at"`
+	CreatedAt     time.Time `bson:"created_at"`
+}
+
+func (entity BakeLogEntity) ToDto() BakeLogDto {
+	return BakeLogDto{
+		Id:            entity.Id,
+		RecipeId:      entity.RecipeId,
+		RecipeVersion: entity.RecipeVersion,
+		ScaledWeight:  entity.ScaledWeight,
+		Temperatures:  entity.Temperatures.ToDto(),
+		Timings:       entity.Timings.ToDto(),
+		Notes:         entity.Notes,
+		Rating:        entity.Rating,
+		BakedAt:       entity.BakedAt,
+		CreatedAt:     entity.CreatedAt,
+	}
+}
+
+type BakeLogSummaryEntity struct {
+	BakeCount     int        `bson:"bake_count"`
+	AverageRating float64    `bson:"average_rating"`
+	LastBakedAt   *time.Time `bson:"last_baked_at"`
+}
+
+// BakeTemperaturesDto holds temperatures in degrees Celsius.
+type BakeTemperaturesDto struct {
+	Room  float64 `json:"room"`
+	Dough float64 `json:"dough"`
+	Oven  float64 `json:"oven"`
+}
+
+func (dto BakeTemperaturesDto) ToEntity() BakeTemperatures {
+	return BakeTemperatures{
+		Room:  dto.Room,
+		Dough: dto.Dough,
+		Oven:  dto.Oven,
+	}
+}
+
+type BakeTimingsDto struct {
+	BulkFermentationMinutes int `json:"bulk_fermentation_minutes"`
+	ProofMinutes            int `json:"proof_minutes"`
+	BakeMinutes             int `json:"bake_minutes"`
+}
+
+func (dto BakeTimingsDto) ToEntity() BakeTimings {
+	return BakeTimings{
+		BulkFermentationMinutes: dto.BulkFermentationMinutes,
+		ProofMinutes:            dto.ProofMinutes,
+		BakeMinutes:             dto.BakeMinutes,
+	}
+}
+
+type BakeLogDto struct {
+	Id            uuid.UUID           `json:"id"`
+	RecipeId      uuid.UUID           `json:"recipe_id"`
+	RecipeVersion int                 `json:"recipe_version"`
+	ScaledWeight  int                 `json:"scaled_weight"`
+	Temperatures  BakeTemperaturesDto `json:"temperatures"`
+	Timings       BakeTimingsDto      `json:"timings"`
+	Notes         string              `json:"notes"`
+	Rating        int                 `json:"rating"`
+	BakedAt       time.Time           `json:"baked_at"`
+	CreatedAt     time.Time           `json:"created_at"`
+}
+
+// CreateBakeLogRequest records a bake. RecipeVersion and ScaledWeight default
+// to the current recipe version and the total weight of the baked version,
+// BakedAt defaults to now. The ingredients of the baked version are deducted
+// from the inventory.
+type CreateBakeLogRequest struct {
+	RecipeVersion int                 `json:"recipe_version"`
+	ScaledWeight  int                 `json:"scaled_weight"`
+	Temperatures  BakeTemperaturesDto `json:"temperatures"`
+	Timings       BakeTimingsDto      `json:"timings"`
+	Notes         string              `json:"notes"`
+	Rating        int                 `json:"rating"`
+	BakedAt       *time.Time          `json:"baked_at"`
+}
+
+type BakeLogSummaryDto struct {
+	RecipeId      uuid.UUID  `json:"recipe_id"`
+	BakeCount     int        `json:"bake_count"`
+	AverageRating float64    `json:"average_rating"`
+	LastBakedAt   *time.Time `json:"last_baked_at,omitempty"`
+}
+
+type BakeLogRepository interface {
+	Create(ctx context.Context, bakeLog BakeLogEntity) (BakeLogEntity, error)
+	GetById(ctx context.Context, id uuid.UUID) (BakeLogEntity, error)
+	FindByRecipeId(ctx context.Context, recipeId uuid.UUID, offset, limit int) ([]BakeLogEntity, error)
+	Summarize(ctx context.Context, recipeId uuid.UUID) (BakeLogSummaryEntity, error)
+}
+
+type BakeLogService interface {
+	Create(ctx context.Context, recipeId uuid.UUID, request CreateBakeLogRequest) (BakeLogDto, error)
+	FindById(ctx context.Context, recipeId, id uuid.UUID) (BakeLogDto, error)
+	FindByRecipeId(ctx context.Context, recipeId uuid.UUID, offset, limit int) ([]BakeLogDto, error)
+	Summary(ctx context.Context, recipeId uuid.UUID) (BakeLogSummaryDto, error)
+}
+
+type BakeLogHandler interface {
+	Create() http.HandlerFunc
+	FindById() http.HandlerFunc
+	FindByRecipeId() http.HandlerFunc
+	Summary() http.HandlerFunc
+}
@@ -0,0 +1,16 @@
+//go:generate mockgen -source=bake_sheet.go -destination=mocks/bake_sheet.go -package mocks
+
+package domain
+
+import "io"
+
+const (
+	BakeSheetFormatMarkdown = "markdown"
+	BakeSheetFormatHtml     = "html"
+)
+
+// BakeSheetRenderer prints a recipe as a sheet for the bakery floor, with the
+// ingredient table, the levain build and the totals.
+type BakeSheetRenderer interface {
+	Render(writer io.Writer, format string, recipe SourdoughRecipeDto) error
+}
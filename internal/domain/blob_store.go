@@ -0,0 +1,19 @@
+//go:generate mockgen -source=blob_store.go -destination=mocks/blob_store.go -package mocks
+
+package domain
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore keeps binary content addressed by a slash separated key.
+type BlobStore interface {
+	Put(ctx context.Context, key string, content io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,24 @@
+//go:generate mockgen -source=calculator.go -destination=mocks/calculator.go -package mocks
+
+package domain
+
+import (
+	"context"
+	"net/http"
+)
+
+// CalculateSourdoughRecipeRequest is a recipe draft to calculate without
+// saving it. When FinalDoughWeight is set the calculated recipe is scaled to
+// that weight.
+type CalculateSourdoughRecipeRequest struct {
+	CreateSourdoughRecipeRequest
+	FinalDoughWeight *int `json:"final_dough_weight,omitempty"`
+}
+
+type CalculatorService interface {
+	CalculateSourdough(ctx context.Context, request CalculateSourdoughRecipeRequest) (SourdoughRecipeDto, error)
+}
+
+type CalculatorHandler interface {
+	CalculateSourdough() http.HandlerFunc
+}
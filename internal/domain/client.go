@@ -0,0 +1,33 @@
+//go:generate mockgen -source=client.go -destination=mocks/client.go -package mocks
+
+package domain
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/google/uuid"
+)
+
+// DoughClient calls the REST API of a running dough calculator. Query holds
+// the listing filters and paging parameters as the API names them. Failed
+// calls return the *errors.ServiceError sent by the server.
+type DoughClient interface {
+	FindFlours(ctx context.Context, query url.Values) (FlourPageDto, error)
+	FindFlourById(ctx context.Context, id uuid.UUID) (FlourDto, error)
+	CreateFlour(ctx context.Context, request CreateFlourRequest) (FlourDto, error)
+	// ExportFlours returns the flour catalogue as the CSV file sent by the
+	// server.
+	ExportFlours(ctx context.Context) ([]byte, error)
+	ImportFlours(ctx context.Context, csv []byte, dryRun bool) (FlourImportResultDto, error)
+
+	FindRecipes(ctx context.Context, query url.Values) (SourdoughRecipeSearchResultDto, error)
+	FindRecipeById(ctx context.Context, id uuid.UUID) (SourdoughRecipeDto, error)
+	CreateRecipe(ctx context.Context, request CreateSourdoughRecipeRequest) (SourdoughRecipeDto, error)
+	ScaleRecipe(ctx context.Context, id uuid.UUID, request SourdoughRecipeScaleRequestDto) (SourdoughRecipeDto, error)
+
+	// ExportRecipes returns the recipe bundle as sent by the server, YAML
+	// when asYaml is set and JSON otherwise.
+	ExportRecipes(ctx context.Context, query url.Values, asYaml bool) ([]byte, error)
+	ImportRecipes(ctx context.Context, bundle []byte, asYaml bool, onConflict string) (RecipeBundleImportResultDto, error)
+}
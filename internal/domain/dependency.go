@@ -11,14 +11,28 @@ type DependencyInitializer interface {
 type DependencyManager interface {
 	DependencyInitializer
 	Common() CommonDependencyService
+	Migration() MigrationDependencyService
 	SourdoughRecipe() SourdoughRecipeDependencyService
 	SourdoughRecipeScale() SourdoughRecipeScaleDependencyService
+	SourdoughRecipeSubstitution() SourdoughRecipeSubstitutionDependencyService
+	SourdoughRecipeRevision() SourdoughRecipeRevisionDependencyService
+	BakeLog() BakeLogDependencyService
+	Image() ImageDependencyService
 	Flour() FlourDependencyService
+	Hydration() HydrationDependencyService
+	Calculator() CalculatorDependencyService
+	ProductionPlan() ProductionPlanDependencyService
+	Inventory() InventoryDependencyService
+	RecipeBundle() RecipeBundleDependencyService
+	SourdoughRecipeParse() SourdoughRecipeParseDependencyService
+	RecipeJsonLd() RecipeJsonLdDependencyService
 }
 
 type SourdoughRecipeDependencyService interface {
 	DependencyInitializer
 	Repository() SourdoughRecipeRepository
+	RevisionRepository() SourdoughRecipeRevisionRepository
+	BakeSheetRenderer() BakeSheetRenderer
 	Service() SourdoughRecipeService
 	Router() SourdoughRecipeHandler
 }
@@ -29,16 +43,97 @@ type SourdoughRecipeScaleDependencyService interface {
 	Router() SourdoughRecipeScaleHandler
 }
 
+type SourdoughRecipeSubstitutionDependencyService interface {
+	DependencyInitializer
+	Service() SourdoughRecipeSubstitutionService
+	Router() SourdoughRecipeSubstitutionHandler
+}
+
+type SourdoughRecipeParseDependencyService interface {
+	DependencyInitializer
+	Service() SourdoughRecipeParseService
+	Router() SourdoughRecipeParseHandler
+}
+
+type RecipeJsonLdDependencyService interface {
+	DependencyInitializer
+	Service() RecipeJsonLdService
+	Router() RecipeJsonLdHandler
+}
+
+type SourdoughRecipeRevisionDependencyService interface {
+	DependencyInitializer
+	Service() SourdoughRecipeRevisionService
+	Router() SourdoughRecipeRevisionHandler
+}
+
+type BakeLogDependencyService interface {
+	DependencyInitializer
+	Repository() BakeLogRepository
+	Service() BakeLogService
+	Router() BakeLogHandler
+}
+
+type ImageDependencyService interface {
+	DependencyInitializer
+	BlobStore() BlobStore
+	Repository() ImageRepository
+	Service() ImageService
+	Router() ImageHandler
+}
+
 type CommonDependencyService interface {
 	DependencyInitializer
 	Actuator() ActuatorHandler
 	MongoDBService() MongoDBService
+	EmbeddedDatabase() EmbeddedDatabase
 	ConfigManager() ConfigManager
 }
 
+type MigrationDependencyService interface {
+	DependencyInitializer
+	Repository() MigrationRepository
+	Service() MigrationService
+}
+
 type FlourDependencyService interface {
 	DependencyInitializer
 	Repository() FlourRepository
 	Service() FlourService
 	Router() FlourHandler
+	TypeRepository() FlourTypeRepository
+	TypeService() FlourTypeService
+	TypeRouter() FlourTypeHandler
+}
+
+type HydrationDependencyService interface {
+	DependencyInitializer
+	Service() HydrationService
+	Router() HydrationHandler
+}
+
+type CalculatorDependencyService interface {
+	DependencyInitializer
+	Service() CalculatorService
+	Router() CalculatorHandler
+}
+
+type ProductionPlanDependencyService interface {
+	DependencyInitializer
+	Repository() ProductionPlanRepository
+	Service() ProductionPlanService
+	Router() ProductionPlanHandler
+}
+
+type InventoryDependencyService interface {
+	DependencyInitializer
+	Repository() InventoryRepository
+	Service() InventoryService
+	Router() InventoryHandler
+}
+
+type RecipeBundleDependencyService interface {
+	DependencyInitializer
+	Service() RecipeBundleService
+	Router() RecipeBundleHandler
 }
@@ -0,0 +1,33 @@
+//go:generate mockgen -destination=./mocks/embedded_db.go -package=mocks -source=embedded_db.go
+
+package domain
+
+import (
+	"github.com/google/uuid"
+)
+
+// EmbeddedDatabase keeps the documents of the embedded storage backends,
+// BSON encoded and grouped in named collections. It is used instead of
+// MongoDB when config.Database selects an embedded backend.
+type EmbeddedDatabase interface {
+	// Load returns every document of the collection by id, an empty map
+	// for a collection that was never written.
+	Load(collection string) (map[uuid.UUID][]byte, error)
+	// Put creates or replaces the given documents at once, either all of
+	// them are stored or none is.
+	Put(collection string, documents map[uuid.UUID][]byte) error
+	Delete(collection string, id uuid.UUID) error
+	// Begin starts a read-write transaction. Only one transaction is open
+	// at a time, Begin, Put and Delete wait for the open one to end.
+	Begin() (EmbeddedTransaction, error)
+	Close() error
+}
+
+// EmbeddedTransaction collects writes to an EmbeddedDatabase, which stores
+// all of them on Commit or none of them on Rollback.
+type EmbeddedTransaction interface {
+	Put(collection string, documents map[uuid.UUID][]byte) error
+	Delete(collection string, id uuid.UUID) error
+	Commit() error
+	Rollback() error
+}
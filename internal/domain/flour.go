@@ -4,66 +4,196 @@ package domain
 
 import (
 	"context"
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+// FlourEntity is a flour of the catalogue. FlourType holds the code of a
+// managed flour type. Protein and ash content, extraction rate and the
+// suggested absorption are percentages, zero when unknown.
 type FlourEntity struct {
-	Id             uuid.UUID `bson:"_id"`
-	FlourType      string
-	Name           string
-	Description    string
-	NutritionFacts NutritionFacts
+	Id                  uuid.UUID `bson:"_id"`
+	FlourType           string
+	Name                string
+	Description         string
+	NutritionFacts      NutritionFacts
+	ProteinContent      float64   `bson:"protein_content,omitempty"`
+	AshContent          float64   `bson:"ash_content,omitempty"`
+	ExtractionRate      float64   `bson:"extraction_rate,omitempty"`
+	SuggestedAbsorption float64   `bson:"suggested_absorption,omitempty"`
+	CreatedAt           time.Time `bson:"created_at,omitempty"`
 }
 
 func (entity FlourEntity) ToDto() FlourDto {
+	var createdAt *time.Time
+	if !entity.CreatedAt.IsZero() {
+		createdAt = &entity.CreatedAt
+	}
+
 	return FlourDto{
-		Id:             entity.Id,
-		FlourType:      entity.FlourType,
-		Name:           entity.Name,
-		Description:    entity.Description,
-		NutritionFacts: entity.NutritionFacts.ToDto(),
+		Id:                  entity.Id,
+		FlourType:           entity.FlourType,
+		Name:                entity.Name,
+		Description:         entity.Description,
+		NutritionFacts:      entity.NutritionFacts.ToDto(),
+		ProteinContent:      entity.ProteinContent,
+		AshContent:          entity.AshContent,
+		ExtractionRate:      entity.ExtractionRate,
+		SuggestedAbsorption: entity.SuggestedAbsorption,
+		CreatedAt:           createdAt,
 	}
 }
 
 type FlourRepository interface {
 	Create(ctx context.Context, flour FlourEntity) (FlourEntity, error)
+	Update(ctx context.Context, flour FlourEntity) (FlourEntity, error)
 	FindById(ctx context.Context, id uuid.UUID) (FlourEntity, error)
-	Find(ctx context.Context, offset, limit int) ([]FlourEntity, error)
+	GetByName(ctx context.Context, name string) (FlourEntity, error)
+	FindAll(ctx context.Context) ([]FlourEntity, error)
+	Find(ctx context.Context, filter FlourFilter, page PageRequest) (FlourPage, error)
 	SearchByName(ctx context.Context, name string) ([]FlourEntity, error)
+	TextSearch(ctx context.Context, query string, offset, limit int) (FlourTextSearchResult, error)
+	CountByType(ctx context.Context, flourType string) (int64, error)
+}
+
+// FlourFilter narrows the flour list down. Flours match any of FlourTypes
+// and the inclusive protein content range; unset criteria match everything.
+type FlourFilter struct {
+	FlourTypes []string
+	MinProtein *float64
+	MaxProtein *float64
+}
+
+type FlourPage struct {
+	Flours []FlourEntity
+	Page   PageInfo
+}
+
+type FlourTextSearchHit struct {
+	Flour FlourEntity `bson:",inline"`
+	Score float64     `bson:"score"`
+}
+
+// FlourTextSearchResult holds a page of flours matching a full-text query,
+// most relevant first, and the number of all matching flours.
+type FlourTextSearchResult struct {
+	Hits  []FlourTextSearchHit
+	Total int64
 }
 
 type FlourDto struct {
-	Id             uuid.UUID         `json:"id"`
-	FlourType      string            `json:"flour_type"`
-	Name           string            `json:"name"`
-	Description    string            `json:"description"`
-	NutritionFacts NutritionFactsDto `json:"nutrition_facts"`
+	Id                  uuid.UUID         `json:"id"`
+	FlourType           string            `json:"flour_type"`
+	Name                string            `json:"name"`
+	Description         string            `json:"description"`
+	NutritionFacts      NutritionFactsDto `json:"nutrition_facts"`
+	ProteinContent      float64           `json:"protein_content,omitempty"`
+	AshContent          float64           `json:"ash_content,omitempty"`
+	ExtractionRate      float64           `json:"extraction_rate,omitempty"`
+	SuggestedAbsorption float64           `json:"suggested_absorption,omitempty"`
+	CreatedAt           *time.Time        `json:"created_at,omitempty"`
 }
 
 func (dto FlourDto) ToEntity() FlourEntity {
+	var createdAt time.Time
+	if dto.CreatedAt != nil {
+		createdAt = *dto.CreatedAt
+	}
+
 	return FlourEntity{
-		Id:             dto.Id,
-		FlourType:      dto.FlourType,
-		Name:           dto.Name,
-		Description:    dto.Description,
-		NutritionFacts: dto.NutritionFacts.ToEntity(),
+		Id:                  dto.Id,
+		FlourType:           dto.FlourType,
+		Name:                dto.Name,
+		Description:         dto.Description,
+		NutritionFacts:      dto.NutritionFacts.ToEntity(),
+		ProteinContent:      dto.ProteinContent,
+		AshContent:          dto.AshContent,
+		ExtractionRate:      dto.ExtractionRate,
+		SuggestedAbsorption: dto.SuggestedAbsorption,
+		CreatedAt:           createdAt,
+	}
+}
+
+func (dto FlourDto) ToCreateRequest() CreateFlourRequest {
+	return CreateFlourRequest{
+		FlourType:           dto.FlourType,
+		Name:                dto.Name,
+		Description:         dto.Description,
+		NutritionFacts:      dto.NutritionFacts,
+		ProteinContent:      dto.ProteinContent,
+		AshContent:          dto.AshContent,
+		ExtractionRate:      dto.ExtractionRate,
+		SuggestedAbsorption: dto.SuggestedAbsorption,
 	}
 }
 
 type FlourService interface {
 	Create(ctx context.Context, request CreateFlourRequest) (FlourDto, error)
 	FindById(ctx context.Context, id uuid.UUID) (FlourDto, error)
-	Find(ctx context.Context, offset, limit int) ([]FlourDto, error)
+	Find(ctx context.Context, filter FlourFilter, params PageParams) (FlourPageDto, error)
 	SearchByName(ctx context.Context, name string) ([]FlourDto, error)
+	TextSearch(ctx context.Context, query string, offset, limit int) (FlourTextSearchResultDto, error)
+	ExportCsv(ctx context.Context, writer io.Writer) error
+	ImportCsv(ctx context.Context, reader io.Reader, dryRun bool) (FlourImportResultDto, error)
+}
+
+type FlourPageDto struct {
+	Items []FlourDto `json:"items"`
+	PageDto
+}
+
+// FlourTextSearchHitDto is a flour matching a full-text query. Highlights
+// maps the matching fields to fragments in which the matched words are
+// wrapped in <em> tags.
+type FlourTextSearchHitDto struct {
+	Flour      FlourDto            `json:"flour"`
+	Score      float64             `json:"score"`
+	Highlights map[string][]string `json:"highlights"`
+}
+
+type FlourTextSearchResultDto struct {
+	Items []FlourTextSearchHitDto `json:"items"`
+	Total int64                   `json:"total"`
 }
 
 type CreateFlourRequest struct {
-	FlourType      string            `json:"flour_type"`
-	Name           string            `json:"name"`
-	Description    string            `json:"description"`
-	NutritionFacts NutritionFactsDto `json:"nutrition_facts"`
+	FlourType           string            `json:"flour_type"`
+	Name                string            `json:"name"`
+	Description         string            `json:"description"`
+	NutritionFacts      NutritionFactsDto `json:"nutrition_facts"`
+	ProteinContent      float64           `json:"protein_content"`
+	AshContent          float64           `json:"ash_content"`
+	ExtractionRate      float64           `json:"extraction_rate"`
+	SuggestedAbsorption float64           `json:"suggested_absorption"`
+}
+
+const (
+	FlourImportActionCreated = "created"
+	FlourImportActionUpdated = "updated"
+	FlourImportActionFailed  = "failed"
+)
+
+// FlourImportRowDto is the outcome of one CSV row. Row is the line number in
+// the file, the header being line 1.
+type FlourImportRowDto struct {
+	Row     int        `json:"row"`
+	Name    string     `json:"name"`
+	Action  string     `json:"action"`
+	FlourId *uuid.UUID `json:"flour_id,omitempty"`
+	Errors  []string   `json:"errors,omitempty"`
+}
+
+// FlourImportResultDto summarises a CSV import. With DryRun nothing is
+// stored and the actions tell what an import would do.
+type FlourImportResultDto struct {
+	DryRun  bool                `json:"dry_run"`
+	Created int                 `json:"created"`
+	Updated int                 `json:"updated"`
+	Failed  int                 `json:"failed"`
+	Rows    []FlourImportRowDto `json:"rows"`
 }
 
 type FlourHandler interface {
@@ -71,4 +201,7 @@ type FlourHandler interface {
 	FindById() http.HandlerFunc
 	Find() http.HandlerFunc
 	Search() http.HandlerFunc
+	TextSearch() http.HandlerFunc
+	ExportCsv() http.HandlerFunc
+	ImportCsv() http.HandlerFunc
 }
@@ -0,0 +1,84 @@
+//go:generate mockgen -source=flour_type.go -destination=mocks/flour_type.go -package mocks
+
+package domain
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FlourTypeEntity is an entry of the managed list of flour types. Flours
+// refer to their type by Code, which never changes once created.
+type FlourTypeEntity struct {
+	Id          uuid.UUID  `bson:"_id"`
+	Code        string     `bson:"code"`
+	Name        string     `bson:"name"`
+	Description string     `bson:"description,omitempty"`
+	CreatedAt   time.Time  `bson:"created_at"`
+	UpdatedAt   *time.Time `bson:"updated_at,omitempty"`
+}
+
+func (entity FlourTypeEntity) ToDto() FlourTypeDto {
+	return FlourTypeDto{
+		Id:          entity.Id,
+		Code:        entity.Code,
+		Name:        entity.Name,
+		Description: entity.Description,
+		CreatedAt:   entity.CreatedAt,
+		UpdatedAt:   entity.UpdatedAt,
+	}
+}
+
+type FlourTypeRepository interface {
+	Create(ctx context.Context, flourType FlourTypeEntity) (FlourTypeEntity, error)
+	GetById(ctx context.Context, id uuid.UUID) (FlourTypeEntity, error)
+	GetByCode(ctx context.Context, code string) (FlourTypeEntity, error)
+	FindAll(ctx context.Context) ([]FlourTypeEntity, error)
+	Count(ctx context.Context) (int64, error)
+	Update(ctx context.Context, flourType FlourTypeEntity) (FlourTypeEntity, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type FlourTypeDto struct {
+	Id          uuid.UUID  `json:"id"`
+	Code        string     `json:"code"`
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// CreateFlourTypeRequest adds a flour type. Code is normalized to lower
+// case words joined by underscores, e.g. "Whole Wheat" becomes
+// "whole_wheat".
+type CreateFlourTypeRequest struct {
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type UpdateFlourTypeRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type FlourTypeService interface {
+	Create(ctx context.Context, request CreateFlourTypeRequest) (FlourTypeDto, error)
+	FindById(ctx context.Context, id uuid.UUID) (FlourTypeDto, error)
+	FindAll(ctx context.Context) ([]FlourTypeDto, error)
+	Update(ctx context.Context, id uuid.UUID, request UpdateFlourTypeRequest) (FlourTypeDto, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	// SeedDefaults adds the built-in flour types when none exist yet.
+	SeedDefaults(ctx context.Context) error
+}
+
+type FlourTypeHandler interface {
+	Create() http.HandlerFunc
+	FindById() http.HandlerFunc
+	FindAll() http.HandlerFunc
+	Update() http.HandlerFunc
+	Delete() http.HandlerFunc
+}
@@ -0,0 +1,59 @@
+//go:generate mockgen -source=hydration.go -destination=mocks/hydration.go -package mocks
+
+package domain
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// HydrationSuggestionRequest asks for the water of a flour blend in the
+// given dough style. Flour refers to catalogue flours by id; it may be left
+// empty when RecipeId is set, in which case the recipe's flour is used.
+// With RecipeId the recipe's water is adjusted to the suggested hydration,
+// and Apply saves the adjusted recipe as its next version.
+type HydrationSuggestionRequest struct {
+	Flour    []FlourAmountDto `json:"flour"`
+	Style    string           `json:"style"`
+	RecipeId *uuid.UUID       `json:"recipe_id,omitempty"`
+	Apply    bool             `json:"apply,omitempty"`
+}
+
+// FlourAbsorptionDto is the water absorption used for a flour of the blend.
+// Estimated is set when the flour has no suggested absorption and a default
+// for its flour type was used instead.
+type FlourAbsorptionDto struct {
+	FlourId    uuid.UUID `json:"flour_id"`
+	Name       string    `json:"name"`
+	FlourType  string    `json:"flour_type"`
+	Amount     float64   `json:"amount"`
+	Absorption float64   `json:"absorption"`
+	Estimated  bool      `json:"estimated,omitempty"`
+}
+
+// HydrationSuggestionDto holds the suggested hydration range of a blend as
+// baker percentages together with the matching water amounts in grams.
+// Absorption is the weighted absorption of the blend.
+type HydrationSuggestionDto struct {
+	Style        string               `json:"style"`
+	FlourWeight  float64              `json:"flour_weight"`
+	Absorption   float64              `json:"absorption"`
+	MinHydration float64              `json:"min_hydration"`
+	MaxHydration float64              `json:"max_hydration"`
+	Hydration    float64              `json:"hydration"`
+	MinWater     float64              `json:"min_water"`
+	MaxWater     float64              `json:"max_water"`
+	Water        float64              `json:"water"`
+	Flours       []FlourAbsorptionDto `json:"flours"`
+	Recipe       *SourdoughRecipeDto  `json:"recipe,omitempty"`
+}
+
+type HydrationService interface {
+	Suggest(ctx context.Context, request HydrationSuggestionRequest) (HydrationSuggestionDto, error)
+}
+
+type HydrationHandler interface {
+	Suggest() http.HandlerFunc
+}
@@ -0,0 +1,87 @@
+//go:generate mockgen -source=image.go -destination=mocks/image.go -package mocks
+
+package domain
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ImageEntity struct {
+	Id           uuid.UUID  `bson:"_id"`
+	RecipeId     uuid.UUID  `bson:"recipe_id"`
+	BakeLogId    *uuid.UUID `bson:"bake_log_id,omitempty"`
+	FileName     string     `bson:"file_name"`
+	ContentType  string     `bson:"content_type"`
+	Size         int64
+	Width        int
+	Height       int
+	BlobKey      string    `bson:"blob_key"`
+	ThumbnailKey string    `bson:"thumbnail_key"`
+	CreatedAt    time.Time `bson:"created_at"`
+}
+
+func (entity ImageEntity) ToDto() ImageDto {
+	return ImageDto{
+		Id:          entity.Id,
+		RecipeId:    entity.RecipeId,
+		BakeLogId:   entity.BakeLogId,
+		FileName:    entity.FileName,
+		ContentType: entity.ContentType,
+		Size:        entity.Size,
+		Width:       entity.Width,
+		Height:      entity.Height,
+		CreatedAt:   entity.CreatedAt,
+	}
+}
+
+type ImageDto struct {
+	Id          uuid.UUID  `json:"id"`
+	RecipeId    uuid.UUID  `json:"recipe_id"`
+	BakeLogId   *uuid.UUID `json:"bake_log_id,omitempty"`
+	FileName    string     `json:"file_name"`
+	ContentType string     `json:"content_type"`
+	Size        int64      `json:"size"`
+	Width       int        `json:"width"`
+	Height      int        `json:"height"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// UploadImageRequest carries an uploaded file. BakeLogId attaches the image to a bake of the recipe.
+type UploadImageRequest struct {
+	BakeLogId *uuid.UUID
+	FileName  string
+	Content   []byte
+}
+
+// ImageContent is a stream of image or thumbnail bytes, the caller must close Content.
+type ImageContent struct {
+	ContentType string
+	Content     io.ReadCloser
+}
+
+type ImageRepository interface {
+	Create(ctx context.Context, image ImageEntity) (ImageEntity, error)
+	GetById(ctx context.Context, id uuid.UUID) (ImageEntity, error)
+	FindByRecipeId(ctx context.Context, recipeId uuid.UUID, bakeLogId *uuid.UUID, offset, limit int) ([]ImageEntity, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type ImageService interface {
+	Upload(ctx context.Context, recipeId uuid.UUID, request UploadImageRequest) (ImageDto, error)
+	FindByRecipeId(ctx context.Context, recipeId uuid.UUID, bakeLogId *uuid.UUID, offset, limit int) ([]ImageDto, error)
+	Open(ctx context.Context, recipeId, id uuid.UUID, thumbnail bool) (ImageContent, error)
+	Delete(ctx context.Context, recipeId, id uuid.UUID) error
+}
+
+type ImageHandler interface {
+	Upload() http.HandlerFunc
+	FindByRecipeId() http.HandlerFunc
+	Download() http.HandlerFunc
+	Thumbnail() http.HandlerFunc
+	Delete() http.HandlerFunc
+}
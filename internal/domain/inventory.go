@@ -0,0 +1,122 @@
+//go:generate mockgen -source=inventory.go -destination=mocks/inventory.go -package mocks
+
+package domain
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	InventoryKindFlour      = "flour"
+	InventoryKindIngredient = "ingredient"
+)
+
+// InventoryItemEntity is the on-hand quantity in grams of a flour of the
+// catalogue or of another ingredient. Key identifies the item across
+// recipes: the flour id for flours, the lower-cased name otherwise.
+type InventoryItemEntity struct {
+	Id                uuid.UUID `bson:"_id"`
+	Key               string
+	Kind              string
+	FlourId           *uuid.UUID `bson:"flour_id,omitempty"`
+	Name              string
+	Quantity          float64
+	LowStockThreshold float64    `bson:"low_stock_threshold"`
+	CreatedAt         time.Time  `bson:"created_at"`
+	UpdatedAt         *time.Time `bson:"updated_at,omitempty"`
+}
+
+// LowStock reports whether the quantity fell to the threshold. Items
+// without a threshold are never low on stock.
+func (entity InventoryItemEntity) LowStock() bool {
+	return entity.LowStockThreshold > 0 && entity.Quantity <= entity.LowStockThreshold
+}
+
+func (entity InventoryItemEntity) ToDto() InventoryItemDto {
+	return InventoryItemDto{
+		Id:                entity.Id,
+		Kind:              entity.Kind,
+		FlourId:           entity.FlourId,
+		Name:              entity.Name,
+		Quantity:          entity.Quantity,
+		LowStockThreshold: entity.LowStockThreshold,
+		LowStock:          entity.LowStock(),
+		CreatedAt:         entity.CreatedAt,
+		UpdatedAt:         entity.UpdatedAt,
+	}
+}
+
+// InventoryDeduction takes Amount grams off the item with the key Key.
+type InventoryDeduction struct {
+	Key    string
+	Amount float64
+}
+
+type InventoryItemDto struct {
+	Id                uuid.UUID  `json:"id"`
+	Kind              string     `json:"kind"`
+	FlourId           *uuid.UUID `json:"flour_id,omitempty"`
+	Name              string     `json:"name"`
+	Quantity          float64    `json:"quantity"`
+	LowStockThreshold float64    `json:"low_stock_threshold"`
+	LowStock          bool       `json:"low_stock"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         *time.Time `json:"updated_at,omitempty"`
+}
+
+// UpsertInventoryItemRequest sets the on-hand quantity in grams of either a
+// flour of the catalogue, by FlourId, or another ingredient, by Name.
+type UpsertInventoryItemRequest struct {
+	FlourId           *uuid.UUID `json:"flour_id,omitempty"`
+	Name              string     `json:"name,omitempty"`
+	Quantity          float64    `json:"quantity"`
+	LowStockThreshold float64    `json:"low_stock_threshold"`
+}
+
+// InventoryShortageDto is an ingredient of a batch the pantry does not hold
+// enough of. Untracked ingredients count as not on hand.
+type InventoryShortageDto struct {
+	Kind     string     `json:"kind"`
+	FlourId  *uuid.UUID `json:"flour_id,omitempty"`
+	Name     string     `json:"name"`
+	Required float64    `json:"required"`
+	OnHand   float64    `json:"on_hand"`
+	Missing  float64    `json:"missing"`
+	Tracked  bool       `json:"tracked"`
+}
+
+type InventoryShortagesDto struct {
+	PickList  PickListDto            `json:"pick_list"`
+	Shortages []InventoryShortageDto `json:"shortages"`
+}
+
+type InventoryRepository interface {
+	Upsert(ctx context.Context, item InventoryItemEntity) (InventoryItemEntity, error)
+	GetById(ctx context.Context, id uuid.UUID) (InventoryItemEntity, error)
+	GetByKey(ctx context.Context, key string) (InventoryItemEntity, error)
+	Find(ctx context.Context, lowStock bool) ([]InventoryItemEntity, error)
+	FindByKeys(ctx context.Context, keys []string) ([]InventoryItemEntity, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Deduct(ctx context.Context, deductions []InventoryDeduction) error
+}
+
+type InventoryService interface {
+	Upsert(ctx context.Context, request UpsertInventoryItemRequest) (InventoryItemDto, error)
+	FindById(ctx context.Context, id uuid.UUID) (InventoryItemDto, error)
+	Find(ctx context.Context, lowStock bool) ([]InventoryItemDto, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Deduct(ctx context.Context, recipes ...SourdoughRecipeDto) error
+	Shortages(ctx context.Context, request SourdoughRecipeBatchScaleRequest) (InventoryShortagesDto, error)
+}
+
+type InventoryHandler interface {
+	Upsert() http.HandlerFunc
+	FindById() http.HandlerFunc
+	Find() http.HandlerFunc
+	Delete() http.HandlerFunc
+	Shortages() http.HandlerFunc
+}
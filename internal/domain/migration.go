@@ -0,0 +1,60 @@
+//go:generate mockgen -source=migration.go -destination=mocks/migration.go -package mocks
+
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Migration moves the MongoDB schema from the previous version to Version
+// and Down moves it back. Migrations run in ascending Version order, each
+// at most once per database.
+type Migration struct {
+	Version     int
+	Description string
+	Up          func(ctx context.Context) error
+	Down        func(ctx context.Context) error
+}
+
+// MigrationEntity records an applied migration in the schema_migrations
+// collection.
+type MigrationEntity struct {
+	Version     int       `bson:"_id"`
+	Description string    `bson:"description"`
+	AppliedAt   time.Time `bson:"applied_at"`
+}
+
+// MigrationStatus tells whether a migration known to the application is
+// applied, AppliedAt is nil for pending migrations.
+type MigrationStatus struct {
+	Version     int
+	Description string
+	AppliedAt   *time.Time
+}
+
+type MigrationRepository interface {
+	// FindApplied returns the applied migrations in ascending version order.
+	FindApplied(ctx context.Context) ([]MigrationEntity, error)
+	Save(ctx context.Context, migration MigrationEntity) error
+	Delete(ctx context.Context, version int) error
+	// Lock takes the migration lock for owner until expiresAt. It returns
+	// false when another owner holds a lock that has not expired.
+	Lock(ctx context.Context, owner string, expiresAt time.Time) (bool, error)
+	// LockExpiresAt returns when the lock held now expires, the zero time
+	// when nobody holds it.
+	LockExpiresAt(ctx context.Context) (time.Time, error)
+	// Renew extends the lock of owner until expiresAt. It returns false when
+	// owner no longer holds the lock.
+	Renew(ctx context.Context, owner string, expiresAt time.Time) (bool, error)
+	Unlock(ctx context.Context, owner string) error
+}
+
+type MigrationService interface {
+	// Up applies every pending migration and returns how many it applied.
+	Up(ctx context.Context) (int, error)
+	// Down reverts up to steps of the latest applied migrations and returns
+	// how many it reverted.
+	Down(ctx context.Context, steps int) (int, error)
+	Status(ctx context.Context) ([]MigrationStatus, error)
+}
@@ -9,8 +9,10 @@
 package mocks
 
 import (
+	context "context"
 	config "dough-calculator/internal/config"
 	domain "dough-calculator/internal/domain"
+	io "io"
 	http "net/http"
 	reflect "reflect"
 
@@ -105,3 +107,40 @@ func (mr *MockApplicationMockRecorder) Server() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Server", reflect.TypeOf((*MockApplication)(nil).Server))
 }
+
+// MockMigrationRunner is a mock of MigrationRunner interface.
+type MockMigrationRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockMigrationRunnerMockRecorder
+}
+
+// MockMigrationRunnerMockRecorder is the mock recorder for MockMigrationRunner.
+type MockMigrationRunnerMockRecorder struct {
+	mock *MockMigrationRunner
+}
+
+// NewMockMigrationRunner creates a new mock instance.
+func NewMockMigrationRunner(ctrl *gomock.Controller) *MockMigrationRunner {
+	mock := &MockMigrationRunner{ctrl: ctrl}
+	mock.recorder = &MockMigrationRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMigrationRunner) EXPECT() *MockMigrationRunnerMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockMigrationRunner) Run(ctx context.Context, command string, steps int, out io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, command, steps, out)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockMigrationRunnerMockRecorder) Run(ctx, command, steps, out any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockMigrationRunner)(nil).Run), ctx, command, steps, out)
+}
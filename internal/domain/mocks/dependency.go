@@ -76,6 +76,34 @@ func (m *MockDependencyManager) EXPECT() *MockDependencyManagerMockRecorder {
 	return m.recorder
 }
 
+// BakeLog mocks base method.
+func (m *MockDependencyManager) BakeLog() domain.BakeLogDependencyService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BakeLog")
+	ret0, _ := ret[0].(domain.BakeLogDependencyService)
+	return ret0
+}
+
+// BakeLog indicates an expected call of BakeLog.
+func (mr *MockDependencyManagerMockRecorder) BakeLog() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BakeLog", reflect.TypeOf((*MockDependencyManager)(nil).BakeLog))
+}
+
+// Calculator mocks base method.
+func (m *MockDependencyManager) Calculator() domain.CalculatorDependencyService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Calculator")
+	ret0, _ := ret[0].(domain.CalculatorDependencyService)
+	return ret0
+}
+
+// Calculator indicates an expected call of Calculator.
+func (mr *MockDependencyManagerMockRecorder) Calculator() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Calculator", reflect.TypeOf((*MockDependencyManager)(nil).Calculator))
+}
+
 // Common mocks base method.
 func (m *MockDependencyManager) Common() domain.CommonDependencyService {
 	m.ctrl.T.Helper()
@@ -104,6 +132,34 @@ func (mr *MockDependencyManagerMockRecorder) Flour() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Flour", reflect.TypeOf((*MockDependencyManager)(nil).Flour))
 }
 
+// Hydration mocks base method.
+func (m *MockDependencyManager) Hydration() domain.HydrationDependencyService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hydration")
+	ret0, _ := ret[0].(domain.HydrationDependencyService)
+	return ret0
+}
+
+// Hydration indicates an expected call of Hydration.
+func (mr *MockDependencyManagerMockRecorder) Hydration() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hydration", reflect.TypeOf((*MockDependencyManager)(nil).Hydration))
+}
+
+// Image mocks base method.
+func (m *MockDependencyManager) Image() domain.ImageDependencyService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Image")
+	ret0, _ := ret[0].(domain.ImageDependencyService)
+	return ret0
+}
+
+// Image indicates an expected call of Image.
+func (mr *MockDependencyManagerMockRecorder) Image() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Image", reflect.TypeOf((*MockDependencyManager)(nil).Image))
+}
+
 // Initialize mocks base method.
 func (m *MockDependencyManager) Initialize(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -118,6 +174,76 @@ func (mr *MockDependencyManagerMockRecorder) Initialize(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*MockDependencyManager)(nil).Initialize), ctx)
 }
 
+// Inventory mocks base method.
+func (m *MockDependencyManager) Inventory() domain.InventoryDependencyService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Inventory")
+	ret0, _ := ret[0].(domain.InventoryDependencyService)
+	return ret0
+}
+
+// Inventory indicates an expected call of Inventory.
+func (mr *MockDependencyManagerMockRecorder) Inventory() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Inventory", reflect.TypeOf((*MockDependencyManager)(nil).Inventory))
+}
+
+// Migration mocks base method.
+func (m *MockDependencyManager) Migration() domain.MigrationDependencyService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Migration")
+	ret0, _ := ret[0].(domain.MigrationDependencyService)
+	return ret0
+}
+
+// Migration indicates an expected call of Migration.
+func (mr *MockDependencyManagerMockRecorder) Migration() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Migration", reflect.TypeOf((*MockDependencyManager)(nil).Migration))
+}
+
+// ProductionPlan mocks base method.
+func (m *MockDependencyManager) ProductionPlan() domain.ProductionPlanDependencyService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProductionPlan")
+	ret0, _ := ret[0].(domain.ProductionPlanDependencyService)
+	return ret0
+}
+
+// ProductionPlan indicates an expected call of ProductionPlan.
+func (mr *MockDependencyManagerMockRecorder) ProductionPlan() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductionPlan", reflect.TypeOf((*MockDependencyManager)(nil).ProductionPlan))
+}
+
+// RecipeBundle mocks base method.
+func (m *MockDependencyManager) RecipeBundle() domain.RecipeBundleDependencyService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecipeBundle")
+	ret0, _ := ret[0].(domain.RecipeBundleDependencyService)
+	return ret0
+}
+
+// RecipeBundle indicates an expected call of RecipeBundle.
+func (mr *MockDependencyManagerMockRecorder) RecipeBundle() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecipeBundle", reflect.TypeOf((*MockDependencyManager)(nil).RecipeBundle))
+}
+
+// RecipeJsonLd mocks base method.
+func (m *MockDependencyManager) RecipeJsonLd() domain.RecipeJsonLdDependencyService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecipeJsonLd")
+	ret0, _ := ret[0].(domain.RecipeJsonLdDependencyService)
+	return ret0
+}
+
+// RecipeJsonLd indicates an expected call of RecipeJsonLd.
+func (mr *MockDependencyManagerMockRecorder) RecipeJsonLd() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecipeJsonLd", reflect.TypeOf((*MockDependencyManager)(nil).RecipeJsonLd))
+}
+
 // SourdoughRecipe mocks base method.
 func (m *MockDependencyManager) SourdoughRecipe() domain.SourdoughRecipeDependencyService {
 	m.ctrl.T.Helper()
@@ -132,6 +258,34 @@ func (mr *MockDependencyManagerMockRecorder) SourdoughRecipe() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SourdoughRecipe", reflect.TypeOf((*MockDependencyManager)(nil).SourdoughRecipe))
 }
 
+// SourdoughRecipeParse mocks base method.
+func (m *MockDependencyManager) SourdoughRecipeParse() domain.SourdoughRecipeParseDependencyService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SourdoughRecipeParse")
+	ret0, _ := ret[0].(domain.SourdoughRecipeParseDependencyService)
+	return ret0
+}
+
+// SourdoughRecipeParse indicates an expected call of SourdoughRecipeParse.
+func (mr *MockDependencyManagerMockRecorder) SourdoughRecipeParse() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SourdoughRecipeParse", reflect.TypeOf((*MockDependencyManager)(nil).SourdoughRecipeParse))
+}
+
+// SourdoughRecipeRevision mocks base method.
+func (m *MockDependencyManager) SourdoughRecipeRevision() domain.SourdoughRecipeRevisionDependencyService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SourdoughRecipeRevision")
+	ret0, _ := ret[0].(domain.SourdoughRecipeRevisionDependencyService)
+	return ret0
+}
+
+// SourdoughRecipeRevision indicates an expected call of SourdoughRecipeRevision.
+func (mr *MockDependencyManagerMockRecorder) SourdoughRecipeRevision() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SourdoughRecipeRevision", reflect.TypeOf((*MockDependencyManager)(nil).SourdoughRecipeRevision))
+}
+
 // SourdoughRecipeScale mocks base method.
 func (m *MockDependencyManager) SourdoughRecipeScale() domain.SourdoughRecipeScaleDependencyService {
 	m.ctrl.T.Helper()
@@ -146,6 +300,20 @@ func (mr *MockDependencyManagerMockRecorder) SourdoughRecipeScale() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SourdoughRecipeScale", reflect.TypeOf((*MockDependencyManager)(nil).SourdoughRecipeScale))
 }
 
+// SourdoughRecipeSubstitution mocks base method.
+func (m *MockDependencyManager) SourdoughRecipeSubstitution() domain.SourdoughRecipeSubstitutionDependencyService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SourdoughRecipeSubstitution")
+	ret0, _ := ret[0].(domain.SourdoughRecipeSubstitutionDependencyService)
+	return ret0
+}
+
+// SourdoughRecipeSubstitution indicates an expected call of SourdoughRecipeSubstitution.
+func (mr *MockDependencyManagerMockRecorder) SourdoughRecipeSubstitution() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SourdoughRecipeSubstitution", reflect.TypeOf((*MockDependencyManager)(nil).SourdoughRecipeSubstitution))
+}
+
 // MockSourdoughRecipeDependencyService is a mock of SourdoughRecipeDependencyService interface.
 type MockSourdoughRecipeDependencyService struct {
 	ctrl     *gomock.Controller
@@ -169,6 +337,20 @@ func (m *MockSourdoughRecipeDependencyService) EXPECT() *MockSourdoughRecipeDepe
 	return m.recorder
 }
 
+// BakeSheetRenderer mocks base method.
+func (m *MockSourdoughRecipeDependencyService) BakeSheetRenderer() domain.BakeSheetRenderer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BakeSheetRenderer")
+	ret0, _ := ret[0].(domain.BakeSheetRenderer)
+	return ret0
+}
+
+// BakeSheetRenderer indicates an expected call of BakeSheetRenderer.
+func (mr *MockSourdoughRecipeDependencyServiceMockRecorder) BakeSheetRenderer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BakeSheetRenderer", reflect.TypeOf((*MockSourdoughRecipeDependencyService)(nil).BakeSheetRenderer))
+}
+
 // Initialize mocks base method.
 func (m *MockSourdoughRecipeDependencyService) Initialize(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -197,6 +379,20 @@ func (mr *MockSourdoughRecipeDependencyServiceMockRecorder) Repository() *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Repository", reflect.TypeOf((*MockSourdoughRecipeDependencyService)(nil).Repository))
 }
 
+// RevisionRepository mocks base method.
+func (m *MockSourdoughRecipeDependencyService) RevisionRepository() domain.SourdoughRecipeRevisionRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevisionRepository")
+	ret0, _ := ret[0].(domain.SourdoughRecipeRevisionRepository)
+	return ret0
+}
+
+// RevisionRepository indicates an expected call of RevisionRepository.
+func (mr *MockSourdoughRecipeDependencyServiceMockRecorder) RevisionRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevisionRepository", reflect.TypeOf((*MockSourdoughRecipeDependencyService)(nil).RevisionRepository))
+}
+
 // Router mocks base method.
 func (m *MockSourdoughRecipeDependencyService) Router() domain.SourdoughRecipeHandler {
 	m.ctrl.T.Helper()
@@ -290,59 +486,96 @@ func (mr *MockSourdoughRecipeScaleDependencyServiceMockRecorder) Service() *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockSourdoughRecipeScaleDependencyService)(nil).Service))
 }
 
-// MockCommonDependencyService is a mock of CommonDependencyService interface.
-type MockCommonDependencyService struct {
+// MockSourdoughRecipeSubstitutionDependencyService is a mock of SourdoughRecipeSubstitutionDependencyService interface.
+type MockSourdoughRecipeSubstitutionDependencyService struct {
 	ctrl     *gomock.Controller
-	recorder *MockCommonDependencyServiceMockRecorder
+	recorder *MockSourdoughRecipeSubstitutionDependencyServiceMockRecorder
 }
 
-// MockCommonDependencyServiceMockRecorder is the mock recorder for MockCommonDependencyService.
-type MockCommonDependencyServiceMockRecorder struct {
-	mock *MockCommonDependencyService
+// MockSourdoughRecipeSubstitutionDependencyServiceMockRecorder is the mock recorder for MockSourdoughRecipeSubstitutionDependencyService.
+type MockSourdoughRecipeSubstitutionDependencyServiceMockRecorder struct {
+	mock *MockSourdoughRecipeSubstitutionDependencyService
 }
 
-// NewMockCommonDependencyService creates a new mock instance.
-func NewMockCommonDependencyService(ctrl *gomock.Controller) *MockCommonDependencyService {
-	mock := &MockCommonDependencyService{ctrl: ctrl}
-	mock.recorder = &MockCommonDependencyServiceMockRecorder{mock}
+// NewMockSourdoughRecipeSubstitutionDependencyService creates a new mock instance.
+func NewMockSourdoughRecipeSubstitutionDependencyService(ctrl *gomock.Controller) *MockSourdoughRecipeSubstitutionDependencyService {
+	mock := &MockSourdoughRecipeSubstitutionDependencyService{ctrl: ctrl}
+	mock.recorder = &MockSourdoughRecipeSubstitutionDependencyServiceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockCommonDependencyService) EXPECT() *MockCommonDependencyServiceMockRecorder {
+func (m *MockSourdoughRecipeSubstitutionDependencyService) EXPECT() *MockSourdoughRecipeSubstitutionDependencyServiceMockRecorder {
 	return m.recorder
 }
 
-// Actuator mocks base method.
-func (m *MockCommonDependencyService) Actuator() domain.ActuatorHandler {
+// Initialize mocks base method.
+func (m *MockSourdoughRecipeSubstitutionDependencyService) Initialize(ctx context.Context) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Actuator")
-	ret0, _ := ret[0].(domain.ActuatorHandler)
+	ret := m.ctrl.Call(m, "Initialize", ctx)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Actuator indicates an expected call of Actuator.
-func (mr *MockCommonDependencyServiceMockRecorder) Actuator() *gomock.Call {
+// Initialize indicates an expected call of Initialize.
+func (mr *MockSourdoughRecipeSubstitutionDependencyServiceMockRecorder) Initialize(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Actuator", reflect.TypeOf((*MockCommonDependencyService)(nil).Actuator))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*MockSourdoughRecipeSubstitutionDependencyService)(nil).Initialize), ctx)
 }
 
-// ConfigManager mocks base method.
-func (m *MockCommonDependencyService) ConfigManager() domain.ConfigManager {
+// Router mocks base method.
+func (m *MockSourdoughRecipeSubstitutionDependencyService) Router() domain.SourdoughRecipeSubstitutionHandler {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ConfigManager")
-	ret0, _ := ret[0].(domain.ConfigManager)
+	ret := m.ctrl.Call(m, "Router")
+	ret0, _ := ret[0].(domain.SourdoughRecipeSubstitutionHandler)
 	return ret0
 }
 
-// ConfigManager indicates an expected call of ConfigManager.
-func (mr *MockCommonDependencyServiceMockRecorder) ConfigManager() *gomock.Call {
+// Router indicates an expected call of Router.
+func (mr *MockSourdoughRecipeSubstitutionDependencyServiceMockRecorder) Router() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigManager", reflect.TypeOf((*MockCommonDependencyService)(nil).ConfigManager))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Router", reflect.TypeOf((*MockSourdoughRecipeSubstitutionDependencyService)(nil).Router))
+}
+
+// Service mocks base method.
+func (m *MockSourdoughRecipeSubstitutionDependencyService) Service() domain.SourdoughRecipeSubstitutionService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service")
+	ret0, _ := ret[0].(domain.SourdoughRecipeSubstitutionService)
+	return ret0
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockSourdoughRecipeSubstitutionDependencyServiceMockRecorder) Service() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockSourdoughRecipeSubstitutionDependencyService)(nil).Service))
+}
+
+// MockSourdoughRecipeParseDependencyService is a mock of SourdoughRecipeParseDependencyService interface.
+type MockSourdoughRecipeParseDependencyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourdoughRecipeParseDependencyServiceMockRecorder
+}
+
+// MockSourdoughRecipeParseDependencyServiceMockRecorder is the mock recorder for MockSourdoughRecipeParseDependencyService.
+type MockSourdoughRecipeParseDependencyServiceMockRecorder struct {
+	mock *MockSourdoughRecipeParseDependencyService
+}
+
+// NewMockSourdoughRecipeParseDependencyService creates a new mock instance.
+func NewMockSourdoughRecipeParseDependencyService(ctrl *gomock.Controller) *MockSourdoughRecipeParseDependencyService {
+	mock := &MockSourdoughRecipeParseDependencyService{ctrl: ctrl}
+	mock.recorder = &MockSourdoughRecipeParseDependencyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSourdoughRecipeParseDependencyService) EXPECT() *MockSourdoughRecipeParseDependencyServiceMockRecorder {
+	return m.recorder
 }
 
 // Initialize mocks base method.
-func (m *MockCommonDependencyService) Initialize(ctx context.Context) error {
+func (m *MockSourdoughRecipeParseDependencyService) Initialize(ctx context.Context) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Initialize", ctx)
 	ret0, _ := ret[0].(error)
@@ -350,50 +583,64 @@ func (m *MockCommonDependencyService) Initialize(ctx context.Context) error {
 }
 
 // Initialize indicates an expected call of Initialize.
-func (mr *MockCommonDependencyServiceMockRecorder) Initialize(ctx any) *gomock.Call {
+func (mr *MockSourdoughRecipeParseDependencyServiceMockRecorder) Initialize(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*MockCommonDependencyService)(nil).Initialize), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*MockSourdoughRecipeParseDependencyService)(nil).Initialize), ctx)
 }
 
-// MongoDBService mocks base method.
-func (m *MockCommonDependencyService) MongoDBService() domain.MongoDBService {
+// Router mocks base method.
+func (m *MockSourdoughRecipeParseDependencyService) Router() domain.SourdoughRecipeParseHandler {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MongoDBService")
-	ret0, _ := ret[0].(domain.MongoDBService)
+	ret := m.ctrl.Call(m, "Router")
+	ret0, _ := ret[0].(domain.SourdoughRecipeParseHandler)
 	return ret0
 }
 
-// MongoDBService indicates an expected call of MongoDBService.
-func (mr *MockCommonDependencyServiceMockRecorder) MongoDBService() *gomock.Call {
+// Router indicates an expected call of Router.
+func (mr *MockSourdoughRecipeParseDependencyServiceMockRecorder) Router() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MongoDBService", reflect.TypeOf((*MockCommonDependencyService)(nil).MongoDBService))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Router", reflect.TypeOf((*MockSourdoughRecipeParseDependencyService)(nil).Router))
 }
 
-// MockFlourDependencyService is a mock of FlourDependencyService interface.
-type MockFlourDependencyService struct {
+// Service mocks base method.
+func (m *MockSourdoughRecipeParseDependencyService) Service() domain.SourdoughRecipeParseService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service")
+	ret0, _ := ret[0].(domain.SourdoughRecipeParseService)
+	return ret0
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockSourdoughRecipeParseDependencyServiceMockRecorder) Service() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockSourdoughRecipeParseDependencyService)(nil).Service))
+}
+
+// MockRecipeJsonLdDependencyService is a mock of RecipeJsonLdDependencyService interface.
+type MockRecipeJsonLdDependencyService struct {
 	ctrl     *gomock.Controller
-	recorder *MockFlourDependencyServiceMockRecorder
+	recorder *MockRecipeJsonLdDependencyServiceMockRecorder
 }
 
-// MockFlourDependencyServiceMockRecorder is the mock recorder for MockFlourDependencyService.
-type MockFlourDependencyServiceMockRecorder struct {
-	mock *MockFlourDependencyService
+// MockRecipeJsonLdDependencyServiceMockRecorder is the mock recorder for MockRecipeJsonLdDependencyService.
+type MockRecipeJsonLdDependencyServiceMockRecorder struct {
+	mock *MockRecipeJsonLdDependencyService
 }
 
-// NewMockFlourDependencyService creates a new mock instance.
-func NewMockFlourDependencyService(ctrl *gomock.Controller) *MockFlourDependencyService {
-	mock := &MockFlourDependencyService{ctrl: ctrl}
-	mock.recorder = &MockFlourDependencyServiceMockRecorder{mock}
+// NewMockRecipeJsonLdDependencyService creates a new mock instance.
+func NewMockRecipeJsonLdDependencyService(ctrl *gomock.Controller) *MockRecipeJsonLdDependencyService {
+	mock := &MockRecipeJsonLdDependencyService{ctrl: ctrl}
+	mock.recorder = &MockRecipeJsonLdDependencyServiceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockFlourDependencyService) EXPECT() *MockFlourDependencyServiceMockRecorder {
+func (m *MockRecipeJsonLdDependencyService) EXPECT() *MockRecipeJsonLdDependencyServiceMockRecorder {
 	return m.recorder
 }
 
 // Initialize mocks base method.
-func (m *MockFlourDependencyService) Initialize(ctx context.Context) error {
+func (m *MockRecipeJsonLdDependencyService) Initialize(ctx context.Context) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Initialize", ctx)
 	ret0, _ := ret[0].(error)
@@ -401,49 +648,904 @@ func (m *MockFlourDependencyService) Initialize(ctx context.Context) error {
 }
 
 // Initialize indicates an expected call of Initialize.
-func (mr *MockFlourDependencyServiceMockRecorder) Initialize(ctx any) *gomock.Call {
+func (mr *MockRecipeJsonLdDependencyServiceMockRecorder) Initialize(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*MockFlourDependencyService)(nil).Initialize), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*MockRecipeJsonLdDependencyService)(nil).Initialize), ctx)
 }
 
-// Repository mocks base method.
-func (m *MockFlourDependencyService) Repository() domain.FlourRepository {
+// Router mocks base method.
+func (m *MockRecipeJsonLdDependencyService) Router() domain.RecipeJsonLdHandler {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Repository")
-	ret0, _ := ret[0].(domain.FlourRepository)
+	ret := m.ctrl.Call(m, "Router")
+	ret0, _ := ret[0].(domain.RecipeJsonLdHandler)
 	return ret0
 }
 
-// Repository indicates an expected call of Repository.
-func (mr *MockFlourDependencyServiceMockRecorder) Repository() *gomock.Call {
+// Router indicates an expected call of Router.
+func (mr *MockRecipeJsonLdDependencyServiceMockRecorder) Router() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Repository", reflect.TypeOf((*MockFlourDependencyService)(nil).Repository))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Router", reflect.TypeOf((*MockRecipeJsonLdDependencyService)(nil).Router))
+}
+
+// Service mocks base method.
+func (m *MockRecipeJsonLdDependencyService) Service() domain.RecipeJsonLdService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service")
+	ret0, _ := ret[0].(domain.RecipeJsonLdService)
+	return ret0
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockRecipeJsonLdDependencyServiceMockRecorder) Service() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockRecipeJsonLdDependencyService)(nil).Service))
+}
+
+// MockSourdoughRecipeRevisionDependencyService is a mock of SourdoughRecipeRevisionDependencyService interface.
+type MockSourdoughRecipeRevisionDependencyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourdoughRecipeRevisionDependencyServiceMockRecorder
+}
+
+// MockSourdoughRecipeRevisionDependencyServiceMockRecorder is the mock recorder for MockSourdoughRecipeRevisionDependencyService.
+type MockSourdoughRecipeRevisionDependencyServiceMockRecorder struct {
+	mock *MockSourdoughRecipeRevisionDependencyService
+}
+
+// NewMockSourdoughRecipeRevisionDependencyService creates a new mock instance.
+func NewMockSourdoughRecipeRevisionDependencyService(ctrl *gomock.Controller) *MockSourdoughRecipeRevisionDependencyService {
+	mock := &MockSourdoughRecipeRevisionDependencyService{ctrl: ctrl}
+	mock.recorder = &MockSourdoughRecipeRevisionDependencyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSourdoughRecipeRevisionDependencyService) EXPECT() *MockSourdoughRecipeRevisionDependencyServiceMockRecorder {
+	return m.recorder
+}
+
+// Initialize mocks base method.
+func (m *MockSourdoughRecipeRevisionDependencyService) Initialize(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Initialize", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Initialize indicates an expected call of Initialize.
+func (mr *MockSourdoughRecipeRevisionDependencyServiceMockRecorder) Initialize(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*MockSourdoughRecipeRevisionDependencyService)(nil).Initialize), ctx)
 }
 
 // Router mocks base method.
-func (m *MockFlourDependencyService) Router() domain.FlourHandler {
+func (m *MockSourdoughRecipeRevisionDependencyService) Router() domain.SourdoughRecipeRevisionHandler {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Router")
-	ret0, _ := ret[0].(domain.FlourHandler)
+	ret0, _ := ret[0].(domain.SourdoughRecipeRevisionHandler)
 	return ret0
 }
 
 // Router indicates an expected call of Router.
-func (mr *MockFlourDependencyServiceMockRecorder) Router() *gomock.Call {
+func (mr *MockSourdoughRecipeRevisionDependencyServiceMockRecorder) Router() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Router", reflect.TypeOf((*MockFlourDependencyService)(nil).Router))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Router", reflect.TypeOf((*MockSourdoughRecipeRevisionDependencyService)(nil).Router))
 }
 
 // Service mocks base method.
-func (m *MockFlourDependencyService) Service() domain.FlourService {
+func (m *MockSourdoughRecipeRevisionDependencyService) Service() domain.SourdoughRecipeRevisionService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Service")
-	ret0, _ := ret[0].(domain.FlourService)
+	ret0, _ := ret[0].(domain.SourdoughRecipeRevisionService)
 	return ret0
 }
 
 // Service indicates an expected call of Service.
-func (mr *MockFlourDependencyServiceMockRecorder) Service() *gomock.Call {
+func (mr *MockSourdoughRecipeRevisionDependencyServiceMockRecorder) Service() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockFlourDependencyService)(nil).Service))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockSourdoughRecipeRevisionDependencyService)(nil).Service))
+}
+
+// MockBakeLogDependencyService is a mock of BakeLogDependencyService interface.
+type MockBakeLogDependencyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockBakeLogDependencyServiceMockRecorder
+}
+
+// MockBakeLogDependencyServiceMockRecorder is the mock recorder for MockBakeLogDependencyService.
+type MockBakeLogDependencyServiceMockRecorder struct {
+	mock *MockBakeLogDependencyService
+}
+
+// NewMockBakeLogDependencyService creates a new mock instance.
+func NewMockBakeLogDependencyService(ctrl *gomock.Controller) *MockBakeLogDependencyService {
+	mock := &MockBakeLogDependencyService{ctrl: ctrl}
+	mock.recorder = &MockBakeLogDependencyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBakeLogDependencyService) EXPECT() *MockBakeLogDependencyServiceMockRecorder {
+	return m.recorder
+}
+
+// Initialize mocks base method.
+func (m *MockBakeLogDependencyService) Initialize(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Initialize", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Initialize indicates an expected call of Initialize.
+func (mr *MockBakeLogDependencyServiceMockRecorder) Initialize(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*MockBakeLogDependencyService)(nil).Initialize), ctx)
+}
+
+// Repository mocks base method.
+func (m *MockBakeLogDependencyService) Repository() domain.BakeLogRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Repository")
+	ret0, _ := ret[0].(domain.BakeLogRepository)
+	return ret0
+}
+
+// Repository indicates an expected call of Repository.
+func (mr *MockBakeLogDependencyServiceMockRecorder) Repository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Repository", reflect.TypeOf((*MockBakeLogDependencyService)(nil).Repository))
+}
+
+// Router mocks base method.
+func (m *MockBakeLogDependencyService) Router() domain.BakeLogHandler {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Router")
+	ret0, _ := ret[0].(domain.BakeLogHandler)
+	return ret0
+}
+
+// Router indicates an expected call of Router.
+func (mr *MockBakeLogDependencyServiceMockRecorder) Router() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Router", reflect.TypeOf((*MockBakeLogDependencyService)(nil).Router))
+}
+
+// Service mocks base method.
+func (m *MockBakeLogDependencyService) Service() domain.BakeLogService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service")
+	ret0, _ := ret[0].(domain.BakeLogService)
+	return ret0
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockBakeLogDependencyServiceMockRecorder) Service() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockBakeLogDependencyService)(nil).Service))
+}
+
+// MockImageDependencyService is a mock of ImageDependencyService interface.
+type MockImageDependencyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockImageDependencyServiceMockRecorder
+}
+
+// MockImageDependencyServiceMockRecorder is the mock recorder for MockImageDependencyService.
+type MockImageDependencyServiceMockRecorder struct {
+	mock *MockImageDependencyService
+}
+
+// NewMockImageDependencyService creates a new mock instance.
+func NewMockImageDependencyService(ctrl *gomock.Controller) *MockImageDependencyService {
+	mock := &MockImageDependencyService{ctrl: ctrl}
+	mock.recorder = &MockImageDependencyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImageDependencyService) EXPECT() *MockImageDependencyServiceMockRecorder {
+	return m.recorder
+}
+
+// BlobStore mocks base method.
+func (m *MockImageDependencyService) BlobStore() domain.BlobStore {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlobStore")
+	ret0, _ := ret[0].(domain.BlobStore)
+	return ret0
+}
+
+// BlobStore indicates an expected call of BlobStore.
+func (mr *MockImageDependencyServiceMockRecorder) BlobStore() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlobStore", reflect.TypeOf((*MockImageDependencyService)(nil).BlobStore))
+}
+
+// Initialize mocks base method.
+func (m *MockImageDependencyService) Initialize(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Initialize", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Initialize indicates an expected call of Initialize.
+func (mr *MockImageDependencyServiceMockRecorder) Initialize(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*MockImageDependencyService)(nil).Initialize), ctx)
+}
+
+// Repository mocks base method.
+func (m *MockImageDependencyService) Repository() domain.ImageRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Repository")
+	ret0, _ := ret[0].(domain.ImageRepository)
+	return ret0
+}
+
+// Repository indicates an expected call of Repository.
+func (mr *MockImageDependencyServiceMockRecorder) Repository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Repository", reflect.TypeOf((*MockImageDependencyService)(nil).Repository))
+}
+
+// Router mocks base method.
+func (m *MockImageDependencyService) Router() domain.ImageHandler {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Router")
+	ret0, _ := ret[0].(domain.ImageHandler)
+	return ret0
+}
+
+// Router indicates an expected call of Router.
+func (mr *MockImageDependencyServiceMockRecorder) Router() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Router", reflect.TypeOf((*MockImageDependencyService)(nil).Router))
+}
+
+// Service mocks base method.
+func (m *MockImageDependencyService) Service() domain.ImageService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service")
+	ret0, _ := ret[0].(domain.ImageService)
+	return ret0
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockImageDependencyServiceMockRecorder) Service() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockImageDependencyService)(nil).Service))
+}
+
+// MockCommonDependencyService is a mock of CommonDependencyService interface.
+type MockCommonDependencyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommonDependencyServiceMockRecorder
+}
+
+// MockCommonDependencyServiceMockRecorder is the mock recorder for MockCommonDependencyService.
+type MockCommonDependencyServiceMockRecorder struct {
+	mock *MockCommonDependencyService
+}
+
+// NewMockCommonDependencyService creates a new mock instance.
+func NewMockCommonDependencyService(ctrl *gomock.Controller) *MockCommonDependencyService {
+	mock := &MockCommonDependencyService{ctrl: ctrl}
+	mock.recorder = &MockCommonDependencyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommonDependencyService) EXPECT() *MockCommonDependencyServiceMockRecorder {
+	return m.recorder
+}
+
+// Actuator mocks base method.
+func (m *MockCommonDependencyService) Actuator() domain.ActuatorHandler {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Actuator")
+	ret0, _ := ret[0].(domain.ActuatorHandler)
+	return ret0
+}
+
+// Actuator indicates an expected call of Actuator.
+func (mr *MockCommonDependencyServiceMockRecorder) Actuator() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Actuator", reflect.TypeOf((*MockCommonDependencyService)(nil).Actuator))
+}
+
+// ConfigManager mocks base method.
+func (m *MockCommonDependencyService) ConfigManager() domain.ConfigManager {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigManager")
+	ret0, _ := ret[0].(domain.ConfigManager)
+	return ret0
+}
+
+// ConfigManager indicates an expected call of ConfigManager.
+func (mr *MockCommonDependencyServiceMockRecorder) ConfigManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigManager", reflect.TypeOf((*MockCommonDependencyService)(nil).ConfigManager))
+}
+
+// EmbeddedDatabase mocks base method.
+func (m *MockCommonDependencyService) EmbeddedDatabase() domain.EmbeddedDatabase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EmbeddedDatabase")
+	ret0, _ := ret[0].(domain.EmbeddedDatabase)
+	return ret0
+}
+
+// EmbeddedDatabase indicates an expected call of EmbeddedDatabase.
+func (mr *MockCommonDependencyServiceMockRecorder) EmbeddedDatabase() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmbeddedDatabase", reflect.TypeOf((*MockCommonDependencyService)(nil).EmbeddedDatabase))
+}
+
+// Initialize mocks base method.
+func (m *MockCommonDependencyService) Initialize(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Initialize", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Initialize indicates an expected call of Initialize.
+func (mr *MockCommonDependencyServiceMockRecorder) Initialize(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*MockCommonDependencyService)(nil).Initialize), ctx)
+}
+
+// MongoDBService mocks base method.
+func (m *MockCommonDependencyService) MongoDBService() domain.MongoDBService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MongoDBService")
+	ret0, _ := ret[0].(domain.MongoDBService)
+	return ret0
+}
+
+// MongoDBService indicates an expected call of MongoDBService.
+func (mr *MockCommonDependencyServiceMockRecorder) MongoDBService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MongoDBService", reflect.TypeOf((*MockCommonDependencyService)(nil).MongoDBService))
+}
+
+// MockMigrationDependencyService is a mock of MigrationDependencyService interface.
+type MockMigrationDependencyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMigrationDependencyServiceMockRecorder
+}
+
+// MockMigrationDependencyServiceMockRecorder is the mock recorder for MockMigrationDependencyService.
+type MockMigrationDependencyServiceMockRecorder struct {
+	mock *MockMigrationDependencyService
+}
+
+// NewMockMigrationDependencyService creates a new mock instance.
+func NewMockMigrationDependencyService(ctrl *gomock.Controller) *MockMigrationDependencyService {
+	mock := &MockMigrationDependencyService{ctrl: ctrl}
+	mock.recorder = &MockMigrationDependencyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMigrationDependencyService) EXPECT() *MockMigrationDependencyServiceMockRecorder {
+	return m.recorder
+}
+
+// Initialize mocks base method.
+func (m *MockMigrationDependencyService) Initialize(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Initialize", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Initialize indicates an expected call of Initialize.
+func (mr *MockMigrationDependencyServiceMockRecorder) Initialize(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*MockMigrationDependencyService)(nil).Initialize), ctx)
+}
+
+// Repository mocks base method.
+func (m *MockMigrationDependencyService) Repository() domain.MigrationRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Repository")
+	ret0, _ := ret[0].(domain.MigrationRepository)
+	return ret0
+}
+
+// Repository indicates an expected call of Repository.
+func (mr *MockMigrationDependencyServiceMockRecorder) Repository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Repository", reflect.TypeOf((*MockMigrationDependencyService)(nil).Repository))
+}
+
+// Service mocks base method.
+func (m *MockMigrationDependencyService) Service() domain.MigrationService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service")
+	ret0, _ := ret[0].(domain.MigrationService)
+	return ret0
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockMigrationDependencyServiceMockRecorder) Service() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockMigrationDependencyService)(nil).Service))
+}
+
+// MockFlourDependencyService is a mock of FlourDependencyService interface.
+type MockFlourDependencyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFlourDependencyServiceMockRecorder
+}
+
+// MockFlourDependencyServiceMockRecorder is the mock recorder for MockFlourDependencyService.
+type MockFlourDependencyServiceMockRecorder struct {
+	mock *MockFlourDependencyService
+}
+
+// NewMockFlourDependencyService creates a new mock instance.
+func NewMockFlourDependencyService(ctrl *gomock.Controller) *MockFlourDependencyService {
+	mock := &MockFlourDependencyService{ctrl: ctrl}
+	mock.recorder = &MockFlourDependencyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFlourDependencyService) EXPECT() *MockFlourDependencyServiceMockRecorder {
+	return m.recorder
+}
+
+// Initialize mocks base method.
+func (m *MockFlourDependencyService) Initialize(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Initialize", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Initialize indicates an expected call of Initialize.
+func (mr *MockFlourDependencyServiceMockRecorder) Initialize(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*MockFlourDependencyService)(nil).Initialize), ctx)
+}
+
+// Repository mocks base method.
+func (m *MockFlourDependencyService) Repository() domain.FlourRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Repository")
+	ret0, _ := ret[0].(domain.FlourRepository)
+	return ret0
+}
+
+// Repository indicates an expected call of Repository.
+func (mr *MockFlourDependencyServiceMockRecorder) Repository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Repository", reflect.TypeOf((*MockFlourDependencyService)(nil).Repository))
+}
+
+// Router mocks base method.
+func (m *MockFlourDependencyService) Router() domain.FlourHandler {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Router")
+	ret0, _ := ret[0].(domain.FlourHandler)
+	return ret0
+}
+
+// Router indicates an expected call of Router.
+func (mr *MockFlourDependencyServiceMockRecorder) Router() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Router", reflect.TypeOf((*MockFlourDependencyService)(nil).Router))
+}
+
+// Service mocks base method.
+func (m *MockFlourDependencyService) Service() domain.FlourService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service")
+	ret0, _ := ret[0].(domain.FlourService)
+	return ret0
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockFlourDependencyServiceMockRecorder) Service() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockFlourDependencyService)(nil).Service))
+}
+
+// TypeRepository mocks base method.
+func (m *MockFlourDependencyService) TypeRepository() domain.FlourTypeRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TypeRepository")
+	ret0, _ := ret[0].(domain.FlourTypeRepository)
+	return ret0
+}
+
+// TypeRepository indicates an expected call of TypeRepository.
+func (mr *MockFlourDependencyServiceMockRecorder) TypeRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TypeRepository", reflect.TypeOf((*MockFlourDependencyService)(nil).TypeRepository))
+}
+
+// TypeRouter mocks base method.
+func (m *MockFlourDependencyService) TypeRouter() domain.FlourTypeHandler {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TypeRouter")
+	ret0, _ := ret[0].(domain.FlourTypeHandler)
+	return ret0
+}
+
+// TypeRouter indicates an expected call of TypeRouter.
+func (mr *MockFlourDependencyServiceMockRecorder) TypeRouter() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TypeRouter", reflect.TypeOf((*MockFlourDependencyService)(nil).TypeRouter))
+}
+
+// TypeService mocks base method.
+func (m *MockFlourDependencyService) TypeService() domain.FlourTypeService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TypeService")
+	ret0, _ := ret[0].(domain.FlourTypeService)
+	return ret0
+}
+
+// TypeService indicates an expected call of TypeService.
+func (mr *MockFlourDependencyServiceMockRecorder) TypeService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TypeService", reflect.TypeOf((*MockFlourDependencyService)(nil).TypeService))
+}
+
+// MockHydrationDependencyService is a mock of HydrationDependencyService interface.
+type MockHydrationDependencyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockHydrationDependencyServiceMockRecorder
+}
+
+// MockHydrationDependencyServiceMockRecorder is the mock recorder for MockHydrationDependencyService.
+type MockHydrationDependencyServiceMockRecorder struct {
+	mock *MockHydrationDependencyService
+}
+
+// NewMockHydrationDependencyService creates a new mock instance.
+func NewMockHydrationDependencyService(ctrl *gomock.Controller) *MockHydrationDependencyService {
+	mock := &MockHydrationDependencyService{ctrl: ctrl}
+	mock.recorder = &MockHydrationDependencyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHydrationDependencyService) EXPECT() *MockHydrationDependencyServiceMockRecorder {
+	return m.recorder
+}
+
+// Initialize mocks base method.
+func (m *MockHydrationDependencyService) Initialize(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Initialize", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Initialize indicates an expected call of Initialize.
+func (mr *MockHydrationDependencyServiceMockRecorder) Initialize(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*MockHydrationDependencyService)(nil).Initialize), ctx)
+}
+
+// Router mocks base method.
+func (m *MockHydrationDependencyService) Router() domain.HydrationHandler {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Router")
+	ret0, _ := ret[0].(domain.HydrationHandler)
+	return ret0
+}
+
+// Router indicates an expected call of Router.
+func (mr *MockHydrationDependencyServiceMockRecorder) Router() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Router", reflect.TypeOf((*MockHydrationDependencyService)(nil).Router))
+}
+
+// Service mocks base method.
+func (m *MockHydrationDependencyService) Service() domain.HydrationService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service")
+	ret0, _ := ret[0].(domain.HydrationService)
+	return ret0
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockHydrationDependencyServiceMockRecorder) Service() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockHydrationDependencyService)(nil).Service))
+}
+
+// MockCalculatorDependencyService is a mock of CalculatorDependencyService interface.
+type MockCalculatorDependencyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalculatorDependencyServiceMockRecorder
+}
+
+// MockCalculatorDependencyServiceMockRecorder is the mock recorder for MockCalculatorDependencyService.
+type MockCalculatorDependencyServiceMockRecorder struct {
+	mock *MockCalculatorDependencyService
+}
+
+// NewMockCalculatorDependencyService creates a new mock instance.
+func NewMockCalculatorDependencyService(ctrl *gomock.Controller) *MockCalculatorDependencyService {
+	mock := &MockCalculatorDependencyService{ctrl: ctrl}
+	mock.recorder = &MockCalculatorDependencyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalculatorDependencyService) EXPECT() *MockCalculatorDependencyServiceMockRecorder {
+	return m.recorder
+}
+
+// Initialize mocks base method.
+func (m *MockCalculatorDependencyService) Initialize(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Initialize", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Initialize indicates an expected call of Initialize.
+func (mr *MockCalculatorDependencyServiceMockRecorder) Initialize(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*MockCalculatorDependencyService)(nil).Initialize), ctx)
+}
+
+// Router mocks base method.
+func (m *MockCalculatorDependencyService) Router() domain.CalculatorHandler {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Router")
+	ret0, _ := ret[0].(domain.CalculatorHandler)
+	return ret0
+}
+
+// Router indicates an expected call of Router.
+func (mr *MockCalculatorDependencyServiceMockRecorder) Router() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Router", reflect.TypeOf((*MockCalculatorDependencyService)(nil).Router))
+}
+
+// Service mocks base method.
+func (m *MockCalculatorDependencyService) Service() domain.CalculatorService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service")
+	ret0, _ := ret[0].(domain.CalculatorService)
+	return ret0
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockCalculatorDependencyServiceMockRecorder) Service() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockCalculatorDependencyService)(nil).Service))
+}
+
+// MockProductionPlanDependencyService is a mock of ProductionPlanDependencyService interface.
+type MockProductionPlanDependencyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductionPlanDependencyServiceMockRecorder
+}
+
+// MockProductionPlanDependencyServiceMockRecorder is the mock recorder for MockProductionPlanDependencyService.
+type MockProductionPlanDependencyServiceMockRecorder struct {
+	mock *MockProductionPlanDependencyService
+}
+
+// NewMockProductionPlanDependencyService creates a new mock instance.
+func NewMockProductionPlanDependencyService(ctrl *gomock.Controller) *MockProductionPlanDependencyService {
+	mock := &MockProductionPlanDependencyService{ctrl: ctrl}
+	mock.recorder = &MockProductionPlanDependencyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductionPlanDependencyService) EXPECT() *MockProductionPlanDependencyServiceMockRecorder {
+	return m.recorder
+}
+
+// Initialize mocks base method.
+func (m *MockProductionPlanDependencyService) Initialize(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Initialize", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Initialize indicates an expected call of Initialize.
+func (mr *MockProductionPlanDependencyServiceMockRecorder) Initialize(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*MockProductionPlanDependencyService)(nil).Initialize), ctx)
+}
+
+// Repository mocks base method.
+func (m *MockProductionPlanDependencyService) Repository() domain.ProductionPlanRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Repository")
+	ret0, _ := ret[0].(domain.ProductionPlanRepository)
+	return ret0
+}
+
+// Repository indicates an expected call of Repository.
+func (mr *MockProductionPlanDependencyServiceMockRecorder) Repository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Repository", reflect.TypeOf((*MockProductionPlanDependencyService)(nil).Repository))
+}
+
+// Router mocks base method.
+func (m *MockProductionPlanDependencyService) Router() domain.ProductionPlanHandler {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Router")
+	ret0, _ := ret[0].(domain.ProductionPlanHandler)
+	return ret0
+}
+
+// Router indicates an expected call of Router.
+func (mr *MockProductionPlanDependencyServiceMockRecorder) Router() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Router", reflect.TypeOf((*MockProductionPlanDependencyService)(nil).Router))
+}
+
+// Service mocks base method.
+func (m *MockProductionPlanDependencyService) Service() domain.ProductionPlanService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service")
+	ret0, _ := ret[0].(domain.ProductionPlanService)
+	return ret0
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockProductionPlanDependencyServiceMockRecorder) Service() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockProductionPlanDependencyService)(nil).Service))
+}
+
+// MockInventoryDependencyService is a mock of InventoryDependencyService interface.
+type MockInventoryDependencyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockInventoryDependencyServiceMockRecorder
+}
+
+// MockInventoryDependencyServiceMockRecorder is the mock recorder for MockInventoryDependencyService.
+type MockInventoryDependencyServiceMockRecorder struct {
+	mock *MockInventoryDependencyService
+}
+
+// NewMockInventoryDependencyService creates a new mock instance.
+func NewMockInventoryDependencyService(ctrl *gomock.Controller) *MockInventoryDependencyService {
+	mock := &MockInventoryDependencyService{ctrl: ctrl}
+	mock.recorder = &MockInventoryDependencyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInventoryDependencyService) EXPECT() *MockInventoryDependencyServiceMockRecorder {
+	return m.recorder
+}
+
+// Initialize mocks base method.
+func (m *MockInventoryDependencyService) Initialize(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Initialize", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Initialize indicates an expected call of Initialize.
+func (mr *MockInventoryDependencyServiceMockRecorder) Initialize(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*MockInventoryDependencyService)(nil).Initialize), ctx)
+}
+
+// Repository mocks base method.
+func (m *MockInventoryDependencyService) Repository() domain.InventoryRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Repository")
+	ret0, _ := ret[0].(domain.InventoryRepository)
+	return ret0
+}
+
+// Repository indicates an expected call of Repository.
+func (mr *MockInventoryDependencyServiceMockRecorder) Repository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Repository", reflect.TypeOf((*MockInventoryDependencyService)(nil).Repository))
+}
+
+// Router mocks base method.
+func (m *MockInventoryDependencyService) Router() domain.InventoryHandler {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Router")
+	ret0, _ := ret[0].(domain.InventoryHandler)
+	return ret0
+}
+
+// Router indicates an expected call of Router.
+func (mr *MockInventoryDependencyServiceMockRecorder) Router() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Router", reflect.TypeOf((*MockInventoryDependencyService)(nil).Router))
+}
+
+// Service mocks base method.
+func (m *MockInventoryDependencyService) Service() domain.InventoryService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service")
+	ret0, _ := ret[0].(domain.InventoryService)
+	return ret0
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockInventoryDependencyServiceMockRecorder) Service() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockInventoryDependencyService)(nil).Service))
+}
+
+// MockRecipeBundleDependencyService is a mock of RecipeBundleDependencyService interface.
+type MockRecipeBundleDependencyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecipeBundleDependencyServiceMockRecorder
+}
+
+// MockRecipeBundleDependencyServiceMockRecorder is the mock recorder for MockRecipeBundleDependencyService.
+type MockRecipeBundleDependencyServiceMockRecorder struct {
+	mock *MockRecipeBundleDependencyService
+}
+
+// NewMockRecipeBundleDependencyService creates a new mock instance.
+func NewMockRecipeBundleDependencyService(ctrl *gomock.Controller) *MockRecipeBundleDependencyService {
+	mock := &MockRecipeBundleDependencyService{ctrl: ctrl}
+	mock.recorder = &MockRecipeBundleDependencyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecipeBundleDependencyService) EXPECT() *MockRecipeBundleDependencyServiceMockRecorder {
+	return m.recorder
+}
+
+// Initialize mocks base method.
+func (m *MockRecipeBundleDependencyService) Initialize(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Initialize", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Initialize indicates an expected call of Initialize.
+func (mr *MockRecipeBundleDependencyServiceMockRecorder) Initialize(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*MockRecipeBundleDependencyService)(nil).Initialize), ctx)
+}
+
+// Router mocks base method.
+func (m *MockRecipeBundleDependencyService) Router() domain.RecipeBundleHandler {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Router")
+	ret0, _ := ret[0].(domain.RecipeBundleHandler)
+	return ret0
+}
+
+// Router indicates an expected call of Router.
+func (mr *MockRecipeBundleDependencyServiceMockRecorder) Router() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Router", reflect.TypeOf((*MockRecipeBundleDependencyService)(nil).Router))
+}
+
+// Service mocks base method.
+func (m *MockRecipeBundleDependencyService) Service() domain.RecipeBundleService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service")
+	ret0, _ := ret[0].(domain.RecipeBundleService)
+	return ret0
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockRecipeBundleDependencyServiceMockRecorder) Service() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockRecipeBundleDependencyService)(nil).Service))
 }
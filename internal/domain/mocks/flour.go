@@ -11,6 +11,7 @@ package mocks
 import (
 	context "context"
 	domain "dough-calculator/internal/domain"
+	io "io"
 	http "net/http"
 	reflect "reflect"
 
@@ -41,6 +42,21 @@ func (m *MockFlourRepository) EXPECT() *MockFlourRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CountByType mocks base method.
+func (m *MockFlourRepository) CountByType(ctx context.Context, flourType string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByType", ctx, flourType)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByType indicates an expected call of CountByType.
+func (mr *MockFlourRepositoryMockRecorder) CountByType(ctx, flourType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByType", reflect.TypeOf((*MockFlourRepository)(nil).CountByType), ctx, flourType)
+}
+
 // Create mocks base method.
 func (m *MockFlourRepository) Create(ctx context.Context, flour domain.FlourEntity) (domain.FlourEntity, error) {
 	m.ctrl.T.Helper()
@@ -57,18 +73,33 @@ func (mr *MockFlourRepositoryMockRecorder) Create(ctx, flour any) *gomock.Call {
 }
 
 // Find mocks base method.
-func (m *MockFlourRepository) Find(ctx context.Context, offset, limit int) ([]domain.FlourEntity, error) {
+func (m *MockFlourRepository) Find(ctx context.Context, filter domain.FlourFilter, page domain.PageRequest) (domain.FlourPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Find", ctx, offset, limit)
-	ret0, _ := ret[0].([]domain.FlourEntity)
+	ret := m.ctrl.Call(m, "Find", ctx, filter, page)
+	ret0, _ := ret[0].(domain.FlourPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Find indicates an expected call of Find.
-func (mr *MockFlourRepositoryMockRecorder) Find(ctx, offset, limit any) *gomock.Call {
+func (mr *MockFlourRepositoryMockRecorder) Find(ctx, filter, page any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockFlourRepository)(nil).Find), ctx, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockFlourRepository)(nil).Find), ctx, filter, page)
+}
+
+// FindAll mocks base method.
+func (m *MockFlourRepository) FindAll(ctx context.Context) ([]domain.FlourEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]domain.FlourEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockFlourRepositoryMockRecorder) FindAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockFlourRepository)(nil).FindAll), ctx)
 }
 
 // FindById mocks base method.
@@ -86,6 +117,21 @@ func (mr *MockFlourRepositoryMockRecorder) FindById(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockFlourRepository)(nil).FindById), ctx, id)
 }
 
+// GetByName mocks base method.
+func (m *MockFlourRepository) GetByName(ctx context.Context, name string) (domain.FlourEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByName", ctx, name)
+	ret0, _ := ret[0].(domain.FlourEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByName indicates an expected call of GetByName.
+func (mr *MockFlourRepositoryMockRecorder) GetByName(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockFlourRepository)(nil).GetByName), ctx, name)
+}
+
 // SearchByName mocks base method.
 func (m *MockFlourRepository) SearchByName(ctx context.Context, name string) ([]domain.FlourEntity, error) {
 	m.ctrl.T.Helper()
@@ -101,6 +147,36 @@ func (mr *MockFlourRepositoryMockRecorder) SearchByName(ctx, name any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchByName", reflect.TypeOf((*MockFlourRepository)(nil).SearchByName), ctx, name)
 }
 
+// TextSearch mocks base method.
+func (m *MockFlourRepository) TextSearch(ctx context.Context, query string, offset, limit int) (domain.FlourTextSearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TextSearch", ctx, query, offset, limit)
+	ret0, _ := ret[0].(domain.FlourTextSearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TextSearch indicates an expected call of TextSearch.
+func (mr *MockFlourRepositoryMockRecorder) TextSearch(ctx, query, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TextSearch", reflect.TypeOf((*MockFlourRepository)(nil).TextSearch), ctx, query, offset, limit)
+}
+
+// Update mocks base method.
+func (m *MockFlourRepository) Update(ctx context.Context, flour domain.FlourEntity) (domain.FlourEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, flour)
+	ret0, _ := ret[0].(domain.FlourEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockFlourRepositoryMockRecorder) Update(ctx, flour any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockFlourRepository)(nil).Update), ctx, flour)
+}
+
 // MockFlourService is a mock of FlourService interface.
 type MockFlourService struct {
 	ctrl     *gomock.Controller
@@ -139,19 +215,33 @@ func (mr *MockFlourServiceMockRecorder) Create(ctx, request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFlourService)(nil).Create), ctx, request)
 }
 
+// ExportCsv mocks base method.
+func (m *MockFlourService) ExportCsv(ctx context.Context, writer io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportCsv", ctx, writer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportCsv indicates an expected call of ExportCsv.
+func (mr *MockFlourServiceMockRecorder) ExportCsv(ctx, writer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCsv", reflect.TypeOf((*MockFlourService)(nil).ExportCsv), ctx, writer)
+}
+
 // Find mocks base method.
-func (m *MockFlourService) Find(ctx context.Context, offset, limit int) ([]domain.FlourDto, error) {
+func (m *MockFlourService) Find(ctx context.Context, filter domain.FlourFilter, params domain.PageParams) (domain.FlourPageDto, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Find", ctx, offset, limit)
-	ret0, _ := ret[0].([]domain.FlourDto)
+	ret := m.ctrl.Call(m, "Find", ctx, filter, params)
+	ret0, _ := ret[0].(domain.FlourPageDto)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Find indicates an expected call of Find.
-func (mr *MockFlourServiceMockRecorder) Find(ctx, offset, limit any) *gomock.Call {
+func (mr *MockFlourServiceMockRecorder) Find(ctx, filter, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockFlourService)(nil).Find), ctx, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockFlourService)(nil).Find), ctx, filter, params)
 }
 
 // FindById mocks base method.
@@ -169,6 +259,21 @@ func (mr *MockFlourServiceMockRecorder) FindById(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockFlourService)(nil).FindById), ctx, id)
 }
 
+// ImportCsv mocks base method.
+func (m *MockFlourService) ImportCsv(ctx context.Context, reader io.Reader, dryRun bool) (domain.FlourImportResultDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportCsv", ctx, reader, dryRun)
+	ret0, _ := ret[0].(domain.FlourImportResultDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportCsv indicates an expected call of ImportCsv.
+func (mr *MockFlourServiceMockRecorder) ImportCsv(ctx, reader, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportCsv", reflect.TypeOf((*MockFlourService)(nil).ImportCsv), ctx, reader, dryRun)
+}
+
 // SearchByName mocks base method.
 func (m *MockFlourService) SearchByName(ctx context.Context, name string) ([]domain.FlourDto, error) {
 	m.ctrl.T.Helper()
@@ -184,6 +289,21 @@ func (mr *MockFlourServiceMockRecorder) SearchByName(ctx, name any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchByName", reflect.TypeOf((*MockFlourService)(nil).SearchByName), ctx, name)
 }
 
+// TextSearch mocks base method.
+func (m *MockFlourService) TextSearch(ctx context.Context, query string, offset, limit int) (domain.FlourTextSearchResultDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TextSearch", ctx, query, offset, limit)
+	ret0, _ := ret[0].(domain.FlourTextSearchResultDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TextSearch indicates an expected call of TextSearch.
+func (mr *MockFlourServiceMockRecorder) TextSearch(ctx, query, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TextSearch", reflect.TypeOf((*MockFlourService)(nil).TextSearch), ctx, query, offset, limit)
+}
+
 // MockFlourHandler is a mock of FlourHandler interface.
 type MockFlourHandler struct {
 	ctrl     *gomock.Controller
@@ -221,6 +341,20 @@ func (mr *MockFlourHandlerMockRecorder) Create() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFlourHandler)(nil).Create))
 }
 
+// ExportCsv mocks base method.
+func (m *MockFlourHandler) ExportCsv() http.HandlerFunc {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportCsv")
+	ret0, _ := ret[0].(http.HandlerFunc)
+	return ret0
+}
+
+// ExportCsv indicates an expected call of ExportCsv.
+func (mr *MockFlourHandlerMockRecorder) ExportCsv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCsv", reflect.TypeOf((*MockFlourHandler)(nil).ExportCsv))
+}
+
 // Find mocks base method.
 func (m *MockFlourHandler) Find() http.HandlerFunc {
 	m.ctrl.T.Helper()
@@ -249,6 +383,20 @@ func (mr *MockFlourHandlerMockRecorder) FindById() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockFlourHandler)(nil).FindById))
 }
 
+// ImportCsv mocks base method.
+func (m *MockFlourHandler) ImportCsv() http.HandlerFunc {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportCsv")
+	ret0, _ := ret[0].(http.HandlerFunc)
+	return ret0
+}
+
+// ImportCsv indicates an expected call of ImportCsv.
+func (mr *MockFlourHandlerMockRecorder) ImportCsv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportCsv", reflect.TypeOf((*MockFlourHandler)(nil).ImportCsv))
+}
+
 // Search mocks base method.
 func (m *MockFlourHandler) Search() http.HandlerFunc {
 	m.ctrl.T.Helper()
@@ -262,3 +410,17 @@ func (mr *MockFlourHandlerMockRecorder) Search() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockFlourHandler)(nil).Search))
 }
+
+// TextSearch mocks base method.
+func (m *MockFlourHandler) TextSearch() http.HandlerFunc {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TextSearch")
+	ret0, _ := ret[0].(http.HandlerFunc)
+	return ret0
+}
+
+// TextSearch indicates an expected call of TextSearch.
+func (mr *MockFlourHandlerMockRecorder) TextSearch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TextSearch", reflect.TypeOf((*MockFlourHandler)(nil).TextSearch))
+}
@@ -9,6 +9,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	mongo "go.mongodb.org/mongo-driver/mongo"
@@ -38,6 +39,20 @@ func (m *MockMongoDBService) EXPECT() *MockMongoDBServiceMockRecorder {
 	return m.recorder
 }
 
+// CollectionName mocks base method.
+func (m *MockMongoDBService) CollectionName(name string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CollectionName", name)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// CollectionName indicates an expected call of CollectionName.
+func (mr *MockMongoDBServiceMockRecorder) CollectionName(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectionName", reflect.TypeOf((*MockMongoDBService)(nil).CollectionName), name)
+}
+
 // Disconnect mocks base method.
 func (m *MockMongoDBService) Disconnect() error {
 	m.ctrl.T.Helper()
@@ -68,31 +83,68 @@ func (mr *MockMongoDBServiceMockRecorder) GetClient() *gomock.Call {
 }
 
 // GetCollection mocks base method.
-func (m *MockMongoDBService) GetCollection(databaseName, collectionName string) (*mongo.Collection, error) {
+func (m *MockMongoDBService) GetCollection(name string) (*mongo.Collection, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCollection", databaseName, collectionName)
+	ret := m.ctrl.Call(m, "GetCollection", name)
 	ret0, _ := ret[0].(*mongo.Collection)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCollection indicates an expected call of GetCollection.
-func (mr *MockMongoDBServiceMockRecorder) GetCollection(databaseName, collectionName any) *gomock.Call {
+func (mr *MockMongoDBServiceMockRecorder) GetCollection(name any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollection", reflect.TypeOf((*MockMongoDBService)(nil).GetCollection), databaseName, collectionName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollection", reflect.TypeOf((*MockMongoDBService)(nil).GetCollection), name)
 }
 
 // GetDatabase mocks base method.
-func (m *MockMongoDBService) GetDatabase(name string) (*mongo.Database, error) {
+func (m *MockMongoDBService) GetDatabase() (*mongo.Database, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDatabase", name)
+	ret := m.ctrl.Call(m, "GetDatabase")
 	ret0, _ := ret[0].(*mongo.Database)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetDatabase indicates an expected call of GetDatabase.
-func (mr *MockMongoDBServiceMockRecorder) GetDatabase(name any) *gomock.Call {
+func (mr *MockMongoDBServiceMockRecorder) GetDatabase() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabase", reflect.TypeOf((*MockMongoDBService)(nil).GetDatabase))
+}
+
+// MockTransactionRunner is a mock of TransactionRunner interface.
+type MockTransactionRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionRunnerMockRecorder
+}
+
+// MockTransactionRunnerMockRecorder is the mock recorder for MockTransactionRunner.
+type MockTransactionRunnerMockRecorder struct {
+	mock *MockTransactionRunner
+}
+
+// NewMockTransactionRunner creates a new mock instance.
+func NewMockTransactionRunner(ctrl *gomock.Controller) *MockTransactionRunner {
+	mock := &MockTransactionRunner{ctrl: ctrl}
+	mock.recorder = &MockTransactionRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionRunner) EXPECT() *MockTransactionRunnerMockRecorder {
+	return m.recorder
+}
+
+// WithTransaction mocks base method.
+func (m *MockTransactionRunner) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTransaction", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithTransaction indicates an expected call of WithTransaction.
+func (mr *MockTransactionRunnerMockRecorder) WithTransaction(ctx, fn any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabase", reflect.TypeOf((*MockMongoDBService)(nil).GetDatabase), name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTransaction", reflect.TypeOf((*MockTransactionRunner)(nil).WithTransaction), ctx, fn)
 }
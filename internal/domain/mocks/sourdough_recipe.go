@@ -57,18 +57,48 @@ func (mr *MockSourdoughRecipeRepositoryMockRecorder) Create(ctx, recipe any) *go
 }
 
 // Find mocks base method.
-func (m *MockSourdoughRecipeRepository) Find(ctx context.Context, offset, limit int) ([]domain.SourdoughRecipeEntity, error) {
+func (m *MockSourdoughRecipeRepository) Find(ctx context.Context, filter domain.SourdoughRecipeFilter, page domain.PageRequest) (domain.SourdoughRecipeSearchResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Find", ctx, offset, limit)
-	ret0, _ := ret[0].([]domain.SourdoughRecipeEntity)
+	ret := m.ctrl.Call(m, "Find", ctx, filter, page)
+	ret0, _ := ret[0].(domain.SourdoughRecipeSearchResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Find indicates an expected call of Find.
-func (mr *MockSourdoughRecipeRepositoryMockRecorder) Find(ctx, offset, limit any) *gomock.Call {
+func (mr *MockSourdoughRecipeRepositoryMockRecorder) Find(ctx, filter, page any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockSourdoughRecipeRepository)(nil).Find), ctx, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockSourdoughRecipeRepository)(nil).Find), ctx, filter, page)
+}
+
+// FindAll mocks base method.
+func (m *MockSourdoughRecipeRepository) FindAll(ctx context.Context, filter domain.SourdoughRecipeFilter) ([]domain.SourdoughRecipeEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, filter)
+	ret0, _ := ret[0].([]domain.SourdoughRecipeEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockSourdoughRecipeRepositoryMockRecorder) FindAll(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockSourdoughRecipeRepository)(nil).FindAll), ctx, filter)
+}
+
+// FindFamily mocks base method.
+func (m *MockSourdoughRecipeRepository) FindFamily(ctx context.Context, rootId uuid.UUID) ([]domain.SourdoughRecipeEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFamily", ctx, rootId)
+	ret0, _ := ret[0].([]domain.SourdoughRecipeEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFamily indicates an expected call of FindFamily.
+func (mr *MockSourdoughRecipeRepositoryMockRecorder) FindFamily(ctx, rootId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFamily", reflect.TypeOf((*MockSourdoughRecipeRepository)(nil).FindFamily), ctx, rootId)
 }
 
 // GetById mocks base method.
@@ -86,6 +116,21 @@ func (mr *MockSourdoughRecipeRepositoryMockRecorder) GetById(ctx, id any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockSourdoughRecipeRepository)(nil).GetById), ctx, id)
 }
 
+// GetByName mocks base method.
+func (m *MockSourdoughRecipeRepository) GetByName(ctx context.Context, name string) (domain.SourdoughRecipeEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByName", ctx, name)
+	ret0, _ := ret[0].(domain.SourdoughRecipeEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByName indicates an expected call of GetByName.
+func (mr *MockSourdoughRecipeRepositoryMockRecorder) GetByName(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockSourdoughRecipeRepository)(nil).GetByName), ctx, name)
+}
+
 // SearchByName mocks base method.
 func (m *MockSourdoughRecipeRepository) SearchByName(ctx context.Context, name string) ([]domain.SourdoughRecipeEntity, error) {
 	m.ctrl.T.Helper()
@@ -101,6 +146,36 @@ func (mr *MockSourdoughRecipeRepositoryMockRecorder) SearchByName(ctx, name any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchByName", reflect.TypeOf((*MockSourdoughRecipeRepository)(nil).SearchByName), ctx, name)
 }
 
+// TextSearch mocks base method.
+func (m *MockSourdoughRecipeRepository) TextSearch(ctx context.Context, query string, offset, limit int) (domain.SourdoughRecipeTextSearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TextSearch", ctx, query, offset, limit)
+	ret0, _ := ret[0].(domain.SourdoughRecipeTextSearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TextSearch indicates an expected call of TextSearch.
+func (mr *MockSourdoughRecipeRepositoryMockRecorder) TextSearch(ctx, query, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TextSearch", reflect.TypeOf((*MockSourdoughRecipeRepository)(nil).TextSearch), ctx, query, offset, limit)
+}
+
+// Update mocks base method.
+func (m *MockSourdoughRecipeRepository) Update(ctx context.Context, recipe domain.SourdoughRecipeEntity, version int) (domain.SourdoughRecipeEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, recipe, version)
+	ret0, _ := ret[0].(domain.SourdoughRecipeEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSourdoughRecipeRepositoryMockRecorder) Update(ctx, recipe, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSourdoughRecipeRepository)(nil).Update), ctx, recipe, version)
+}
+
 // MockSourdoughRecipeService is a mock of SourdoughRecipeService interface.
 type MockSourdoughRecipeService struct {
 	ctrl     *gomock.Controller
@@ -139,19 +214,49 @@ func (mr *MockSourdoughRecipeServiceMockRecorder) Create(ctx, request any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSourdoughRecipeService)(nil).Create), ctx, request)
 }
 
+// CreateFromFormula mocks base method.
+func (m *MockSourdoughRecipeService) CreateFromFormula(ctx context.Context, request domain.CreateSourdoughRecipeFormulaRequest) (domain.SourdoughRecipeDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFromFormula", ctx, request)
+	ret0, _ := ret[0].(domain.SourdoughRecipeDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFromFormula indicates an expected call of CreateFromFormula.
+func (mr *MockSourdoughRecipeServiceMockRecorder) CreateFromFormula(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFromFormula", reflect.TypeOf((*MockSourdoughRecipeService)(nil).CreateFromFormula), ctx, request)
+}
+
+// FamilyTree mocks base method.
+func (m *MockSourdoughRecipeService) FamilyTree(ctx context.Context, id uuid.UUID) (domain.SourdoughRecipeFamilyTreeDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FamilyTree", ctx, id)
+	ret0, _ := ret[0].(domain.SourdoughRecipeFamilyTreeDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FamilyTree indicates an expected call of FamilyTree.
+func (mr *MockSourdoughRecipeServiceMockRecorder) FamilyTree(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FamilyTree", reflect.TypeOf((*MockSourdoughRecipeService)(nil).FamilyTree), ctx, id)
+}
+
 // Find mocks base method.
-func (m *MockSourdoughRecipeService) Find(ctx context.Context, offset, limit int) ([]domain.SourdoughRecipeDto, error) {
+func (m *MockSourdoughRecipeService) Find(ctx context.Context, filter domain.SourdoughRecipeFilter, params domain.PageParams) (domain.SourdoughRecipeSearchResultDto, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Find", ctx, offset, limit)
-	ret0, _ := ret[0].([]domain.SourdoughRecipeDto)
+	ret := m.ctrl.Call(m, "Find", ctx, filter, params)
+	ret0, _ := ret[0].(domain.SourdoughRecipeSearchResultDto)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Find indicates an expected call of Find.
-func (mr *MockSourdoughRecipeServiceMockRecorder) Find(ctx, offset, limit any) *gomock.Call {
+func (mr *MockSourdoughRecipeServiceMockRecorder) Find(ctx, filter, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockSourdoughRecipeService)(nil).Find), ctx, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockSourdoughRecipeService)(nil).Find), ctx, filter, params)
 }
 
 // FindById mocks base method.
@@ -169,6 +274,21 @@ func (mr *MockSourdoughRecipeServiceMockRecorder) FindById(ctx, id any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockSourdoughRecipeService)(nil).FindById), ctx, id)
 }
 
+// Fork mocks base method.
+func (m *MockSourdoughRecipeService) Fork(ctx context.Context, id uuid.UUID, request domain.ForkSourdoughRecipeRequest) (domain.SourdoughRecipeDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fork", ctx, id, request)
+	ret0, _ := ret[0].(domain.SourdoughRecipeDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fork indicates an expected call of Fork.
+func (mr *MockSourdoughRecipeServiceMockRecorder) Fork(ctx, id, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fork", reflect.TypeOf((*MockSourdoughRecipeService)(nil).Fork), ctx, id, request)
+}
+
 // SearchByName mocks base method.
 func (m *MockSourdoughRecipeService) SearchByName(ctx context.Context, name string) ([]domain.SourdoughRecipeDto, error) {
 	m.ctrl.T.Helper()
@@ -184,6 +304,36 @@ func (mr *MockSourdoughRecipeServiceMockRecorder) SearchByName(ctx, name any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchByName", reflect.TypeOf((*MockSourdoughRecipeService)(nil).SearchByName), ctx, name)
 }
 
+// TextSearch mocks base method.
+func (m *MockSourdoughRecipeService) TextSearch(ctx context.Context, query string, offset, limit int) (domain.SourdoughRecipeTextSearchResultDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TextSearch", ctx, query, offset, limit)
+	ret0, _ := ret[0].(domain.SourdoughRecipeTextSearchResultDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TextSearch indicates an expected call of TextSearch.
+func (mr *MockSourdoughRecipeServiceMockRecorder) TextSearch(ctx, query, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TextSearch", reflect.TypeOf((*MockSourdoughRecipeService)(nil).TextSearch), ctx, query, offset, limit)
+}
+
+// Update mocks base method.
+func (m *MockSourdoughRecipeService) Update(ctx context.Context, id uuid.UUID, request domain.CreateSourdoughRecipeRequest) (domain.SourdoughRecipeDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, request)
+	ret0, _ := ret[0].(domain.SourdoughRecipeDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSourdoughRecipeServiceMockRecorder) Update(ctx, id, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSourdoughRecipeService)(nil).Update), ctx, id, request)
+}
+
 // MockSourdoughRecipeScaleService is a mock of SourdoughRecipeScaleService interface.
 type MockSourdoughRecipeScaleService struct {
 	ctrl     *gomock.Controller
@@ -222,6 +372,97 @@ func (mr *MockSourdoughRecipeScaleServiceMockRecorder) Scale(ctx, id, request an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scale", reflect.TypeOf((*MockSourdoughRecipeScaleService)(nil).Scale), ctx, id, request)
 }
 
+// ScaleBatch mocks base method.
+func (m *MockSourdoughRecipeScaleService) ScaleBatch(ctx context.Context, request domain.SourdoughRecipeBatchScaleRequest) (domain.SourdoughRecipeBatchScaleDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScaleBatch", ctx, request)
+	ret0, _ := ret[0].(domain.SourdoughRecipeBatchScaleDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScaleBatch indicates an expected call of ScaleBatch.
+func (mr *MockSourdoughRecipeScaleServiceMockRecorder) ScaleBatch(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleBatch", reflect.TypeOf((*MockSourdoughRecipeScaleService)(nil).ScaleBatch), ctx, request)
+}
+
+// MockSourdoughRecipeSubstitutionService is a mock of SourdoughRecipeSubstitutionService interface.
+type MockSourdoughRecipeSubstitutionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourdoughRecipeSubstitutionServiceMockRecorder
+}
+
+// MockSourdoughRecipeSubstitutionServiceMockRecorder is the mock recorder for MockSourdoughRecipeSubstitutionService.
+type MockSourdoughRecipeSubstitutionServiceMockRecorder struct {
+	mock *MockSourdoughRecipeSubstitutionService
+}
+
+// NewMockSourdoughRecipeSubstitutionService creates a new mock instance.
+func NewMockSourdoughRecipeSubstitutionService(ctrl *gomock.Controller) *MockSourdoughRecipeSubstitutionService {
+	mock := &MockSourdoughRecipeSubstitutionService{ctrl: ctrl}
+	mock.recorder = &MockSourdoughRecipeSubstitutionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSourdoughRecipeSubstitutionService) EXPECT() *MockSourdoughRecipeSubstitutionServiceMockRecorder {
+	return m.recorder
+}
+
+// Substitute mocks base method.
+func (m *MockSourdoughRecipeSubstitutionService) Substitute(ctx context.Context, id uuid.UUID, request domain.SourdoughRecipeSubstitutionRequest) (domain.SourdoughRecipeSubstitutionDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Substitute", ctx, id, request)
+	ret0, _ := ret[0].(domain.SourdoughRecipeSubstitutionDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Substitute indicates an expected call of Substitute.
+func (mr *MockSourdoughRecipeSubstitutionServiceMockRecorder) Substitute(ctx, id, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Substitute", reflect.TypeOf((*MockSourdoughRecipeSubstitutionService)(nil).Substitute), ctx, id, request)
+}
+
+// MockSourdoughRecipeParseService is a mock of SourdoughRecipeParseService interface.
+type MockSourdoughRecipeParseService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourdoughRecipeParseServiceMockRecorder
+}
+
+// MockSourdoughRecipeParseServiceMockRecorder is the mock recorder for MockSourdoughRecipeParseService.
+type MockSourdoughRecipeParseServiceMockRecorder struct {
+	mock *MockSourdoughRecipeParseService
+}
+
+// NewMockSourdoughRecipeParseService creates a new mock instance.
+func NewMockSourdoughRecipeParseService(ctrl *gomock.Controller) *MockSourdoughRecipeParseService {
+	mock := &MockSourdoughRecipeParseService{ctrl: ctrl}
+	mock.recorder = &MockSourdoughRecipeParseServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSourdoughRecipeParseService) EXPECT() *MockSourdoughRecipeParseServiceMockRecorder {
+	return m.recorder
+}
+
+// Parse mocks base method.
+func (m *MockSourdoughRecipeParseService) Parse(ctx context.Context, request domain.SourdoughRecipeParseRequest) (domain.SourdoughRecipeParseDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Parse", ctx, request)
+	ret0, _ := ret[0].(domain.SourdoughRecipeParseDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Parse indicates an expected call of Parse.
+func (mr *MockSourdoughRecipeParseServiceMockRecorder) Parse(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MockSourdoughRecipeParseService)(nil).Parse), ctx, request)
+}
+
 // MockSourdoughRecipeHandler is a mock of SourdoughRecipeHandler interface.
 type MockSourdoughRecipeHandler struct {
 	ctrl     *gomock.Controller
@@ -259,6 +500,34 @@ func (mr *MockSourdoughRecipeHandlerMockRecorder) Create() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSourdoughRecipeHandler)(nil).Create))
 }
 
+// CreateFromFormula mocks base method.
+func (m *MockSourdoughRecipeHandler) CreateFromFormula() http.HandlerFunc {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFromFormula")
+	ret0, _ := ret[0].(http.HandlerFunc)
+	return ret0
+}
+
+// CreateFromFormula indicates an expected call of CreateFromFormula.
+func (mr *MockSourdoughRecipeHandlerMockRecorder) CreateFromFormula() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFromFormula", reflect.TypeOf((*MockSourdoughRecipeHandler)(nil).CreateFromFormula))
+}
+
+// FamilyTree mocks base method.
+func (m *MockSourdoughRecipeHandler) FamilyTree() http.HandlerFunc {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FamilyTree")
+	ret0, _ := ret[0].(http.HandlerFunc)
+	return ret0
+}
+
+// FamilyTree indicates an expected call of FamilyTree.
+func (mr *MockSourdoughRecipeHandlerMockRecorder) FamilyTree() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FamilyTree", reflect.TypeOf((*MockSourdoughRecipeHandler)(nil).FamilyTree))
+}
+
 // Find mocks base method.
 func (m *MockSourdoughRecipeHandler) Find() http.HandlerFunc {
 	m.ctrl.T.Helper()
@@ -287,6 +556,20 @@ func (mr *MockSourdoughRecipeHandlerMockRecorder) FindById() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockSourdoughRecipeHandler)(nil).FindById))
 }
 
+// Fork mocks base method.
+func (m *MockSourdoughRecipeHandler) Fork() http.HandlerFunc {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fork")
+	ret0, _ := ret[0].(http.HandlerFunc)
+	return ret0
+}
+
+// Fork indicates an expected call of Fork.
+func (mr *MockSourdoughRecipeHandlerMockRecorder) Fork() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fork", reflect.TypeOf((*MockSourdoughRecipeHandler)(nil).Fork))
+}
+
 // Search mocks base method.
 func (m *MockSourdoughRecipeHandler) Search() http.HandlerFunc {
 	m.ctrl.T.Helper()
@@ -301,6 +584,34 @@ func (mr *MockSourdoughRecipeHandlerMockRecorder) Search() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSourdoughRecipeHandler)(nil).Search))
 }
 
+// TextSearch mocks base method.
+func (m *MockSourdoughRecipeHandler) TextSearch() http.HandlerFunc {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TextSearch")
+	ret0, _ := ret[0].(http.HandlerFunc)
+	return ret0
+}
+
+// TextSearch indicates an expected call of TextSearch.
+func (mr *MockSourdoughRecipeHandlerMockRecorder) TextSearch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TextSearch", reflect.TypeOf((*MockSourdoughRecipeHandler)(nil).TextSearch))
+}
+
+// Update mocks base method.
+func (m *MockSourdoughRecipeHandler) Update() http.HandlerFunc {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update")
+	ret0, _ := ret[0].(http.HandlerFunc)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSourdoughRecipeHandlerMockRecorder) Update() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSourdoughRecipeHandler)(nil).Update))
+}
+
 // MockSourdoughRecipeScaleHandler is a mock of SourdoughRecipeScaleHandler interface.
 type MockSourdoughRecipeScaleHandler struct {
 	ctrl     *gomock.Controller
@@ -337,3 +648,91 @@ func (mr *MockSourdoughRecipeScaleHandlerMockRecorder) Scale() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scale", reflect.TypeOf((*MockSourdoughRecipeScaleHandler)(nil).Scale))
 }
+
+// ScaleBatch mocks base method.
+func (m *MockSourdoughRecipeScaleHandler) ScaleBatch() http.HandlerFunc {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScaleBatch")
+	ret0, _ := ret[0].(http.HandlerFunc)
+	return ret0
+}
+
+// ScaleBatch indicates an expected call of ScaleBatch.
+func (mr *MockSourdoughRecipeScaleHandlerMockRecorder) ScaleBatch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleBatch", reflect.TypeOf((*MockSourdoughRecipeScaleHandler)(nil).ScaleBatch))
+}
+
+// MockSourdoughRecipeSubstitutionHandler is a mock of SourdoughRecipeSubstitutionHandler interface.
+type MockSourdoughRecipeSubstitutionHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourdoughRecipeSubstitutionHandlerMockRecorder
+}
+
+// MockSourdoughRecipeSubstitutionHandlerMockRecorder is the mock recorder for MockSourdoughRecipeSubstitutionHandler.
+type MockSourdoughRecipeSubstitutionHandlerMockRecorder struct {
+	mock *MockSourdoughRecipeSubstitutionHandler
+}
+
+// NewMockSourdoughRecipeSubstitutionHandler creates a new mock instance.
+func NewMockSourdoughRecipeSubstitutionHandler(ctrl *gomock.Controller) *MockSourdoughRecipeSubstitutionHandler {
+	mock := &MockSourdoughRecipeSubstitutionHandler{ctrl: ctrl}
+	mock.recorder = &MockSourdoughRecipeSubstitutionHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSourdoughRecipeSubstitutionHandler) EXPECT() *MockSourdoughRecipeSubstitutionHandlerMockRecorder {
+	return m.recorder
+}
+
+// Substitute mocks base method.
+func (m *MockSourdoughRecipeSubstitutionHandler) Substitute() http.HandlerFunc {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Substitute")
+	ret0, _ := ret[0].(http.HandlerFunc)
+	return ret0
+}
+
+// Substitute indicates an expected call of Substitute.
+func (mr *MockSourdoughRecipeSubstitutionHandlerMockRecorder) Substitute() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Substitute", reflect.TypeOf((*MockSourdoughRecipeSubstitutionHandler)(nil).Substitute))
+}
+
+// MockSourdoughRecipeParseHandler is a mock of SourdoughRecipeParseHandler interface.
+type MockSourdoughRecipeParseHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourdoughRecipeParseHandlerMockRecorder
+}
+
+// MockSourdoughRecipeParseHandlerMockRecorder is the mock recorder for MockSourdoughRecipeParseHandler.
+type MockSourdoughRecipeParseHandlerMockRecorder struct {
+	mock *MockSourdoughRecipeParseHandler
+}
+
+// NewMockSourdoughRecipeParseHandler creates a new mock instance.
+func NewMockSourdoughRecipeParseHandler(ctrl *gomock.Controller) *MockSourdoughRecipeParseHandler {
+	mock := &MockSourdoughRecipeParseHandler{ctrl: ctrl}
+	mock.recorder = &MockSourdoughRecipeParseHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSourdoughRecipeParseHandler) EXPECT() *MockSourdoughRecipeParseHandlerMockRecorder {
+	return m.recorder
+}
+
+// Parse mocks base method.
+func (m *MockSourdoughRecipeParseHandler) Parse() http.HandlerFunc {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Parse")
+	ret0, _ := ret[0].(http.HandlerFunc)
+	return ret0
+}
+
+// Parse indicates an expected call of Parse.
+func (mr *MockSourdoughRecipeParseHandlerMockRecorder) Parse() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MockSourdoughRecipeParseHandler)(nil).Parse))
+}
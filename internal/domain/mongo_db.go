@@ -2,11 +2,30 @@
 
 package domain
 
-import "go.mongodb.org/mongo-driver/mongo"
+import (
+	"context"
 
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// MongoDBService hands out the configured MongoDB database and its
+// collections. The configured collection prefix goes in front of the
+// collection names the repositories pass.
 type MongoDBService interface {
 	GetClient() (*mongo.Client, error)
-	GetDatabase(name string) (*mongo.Database, error)
-	GetCollection(databaseName, collectionName string) (*mongo.Collection, error)
+	GetDatabase() (*mongo.Database, error)
+	GetCollection(name string) (*mongo.Collection, error)
+	// CollectionName returns the name a collection has in the database, for
+	// collections the driver names itself such as GridFS buckets.
+	CollectionName(name string) string
 	Disconnect() error
 }
+
+// TransactionRunner runs fn in a multi-document transaction. Repository calls
+// made with the context passed to fn take part in the transaction, which is
+// committed when fn returns nil and aborted otherwise. fn may be retried on
+// transient errors. Called with the context of a running transaction, fn
+// joins that transaction instead of starting its own.
+type TransactionRunner interface {
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
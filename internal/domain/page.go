@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+)
+
+// PageParams are the paging parameters of a list request as sent by
+// clients. Cursor is an opaque value taken from a previous page and takes
+// precedence over Offset.
+type PageParams struct {
+	Sort   string
+	Order  string
+	Cursor string
+	Offset int
+	Limit  int
+}
+
+// PageKey identifies a position in a sorted list by the sort key value and
+// the id of the item at that position. A nil Value stands for a missing key.
+type PageKey struct {
+	Value any
+	Id    uuid.UUID
+}
+
+// PageRequest asks for up to Limit items ordered by SortField and then by
+// id. After and Before are exclusive keyset boundaries; at most one of them
+// is set.
+type PageRequest struct {
+	SortField  string
+	Descending bool
+	After      *PageKey
+	Before     *PageKey
+	Offset     int
+	Limit      int
+}
+
+type PageInfo struct {
+	Total   int64
+	HasNext bool
+	HasPrev bool
+}
+
+type PageDto struct {
+	Total      int64  `json:"total"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
+}
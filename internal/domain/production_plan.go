@@ -0,0 +1,158 @@
+//go:generate mockgen -source=production_plan.go -destination=mocks/production_plan.go -package mocks
+
+package domain
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"dough-calculator/internal/utils"
+)
+
+type ProductionPlanItem struct {
+	RecipeId            uuid.UUID `bson:"recipe_id"`
+	Pieces              int
+	PieceWeight         int `bson:"piece_weight"`
+	FermentationMinutes int `bson:"fermentation_minutes,omitempty"`
+}
+
+func (item ProductionPlanItem) ToDto() ProductionPlanItemDto {
+	return ProductionPlanItemDto{
+		RecipeId:            item.RecipeId,
+		Pieces:              item.Pieces,
+		PieceWeight:         item.PieceWeight,
+		FermentationMinutes: item.FermentationMinutes,
+	}
+}
+
+// ProductionPlanEntity is a day of production. MixerCapacity is the dough
+// weight in kilograms the mixer takes at once. CompletedAt is set once the
+// plan was produced and its ingredients were deducted from the inventory.
+type ProductionPlanEntity struct {
+	Id            uuid.UUID `bson:"_id"`
+	Name          string
+	Date          time.Time
+	MixerCapacity float64 `bson:"mixer_capacity"`
+	MixMinutes    int     `bson:"mix_minutes"`
+	Items         []ProductionPlanItem
+	CreatedAt     time.Time  `bson:"created_at"`
+	UpdatedAt     *time.Time `bson:"updated_at,omitempty"`
+	CompletedAt   *time.Time `bson:"completed_at,omitempty"`
+}
+
+func (entity ProductionPlanEntity) ToDto() ProductionPlanDto {
+	return ProductionPlanDto{
+		Id:            entity.Id,
+		Name:          entity.Name,
+		Date:          entity.Date,
+		MixerCapacity: entity.MixerCapacity,
+		MixMinutes:    entity.MixMinutes,
+		Items:         utils.Map(entity.Items, func(item ProductionPlanItem) ProductionPlanItemDto { return item.ToDto() }),
+		CreatedAt:     entity.CreatedAt,
+		UpdatedAt:     entity.UpdatedAt,
+		CompletedAt:   entity.CompletedAt,
+	}
+}
+
+// ProductionPlanItemDto asks for Pieces pieces of PieceWeight grams each of
+// the recipe RecipeId. Without FermentationMinutes the bulk fermentation and
+// proof of the latest bake of the recipe are used.
+type ProductionPlanItemDto struct {
+	RecipeId            uuid.UUID `json:"recipe_id"`
+	Pieces              int       `json:"pieces"`
+	PieceWeight         int       `json:"piece_weight"`
+	FermentationMinutes int       `json:"fermentation_minutes,omitempty"`
+}
+
+func (dto ProductionPlanItemDto) ToEntity() ProductionPlanItem {
+	return ProductionPlanItem{
+		RecipeId:            dto.RecipeId,
+		Pieces:              dto.Pieces,
+		PieceWeight:         dto.PieceWeight,
+		FermentationMinutes: dto.FermentationMinutes,
+	}
+}
+
+type ProductionPlanDto struct {
+	Id            uuid.UUID               `json:"id"`
+	Name          string                  `json:"name"`
+	Date          time.Time               `json:"date"`
+	MixerCapacity float64                 `json:"mixer_capacity"`
+	MixMinutes    int                     `json:"mix_minutes"`
+	Items         []ProductionPlanItemDto `json:"items"`
+	CreatedAt     time.Time               `json:"created_at"`
+	UpdatedAt     *time.Time              `json:"updated_at,omitempty"`
+	CompletedAt   *time.Time              `json:"completed_at,omitempty"`
+}
+
+// CreateProductionPlanRequest describes a production plan. Date is when the
+// first mix starts and MixMinutes, the time the mixer is busy with one mix,
+// defaults to 20.
+type CreateProductionPlanRequest struct {
+	Name          string                  `json:"name"`
+	Date          time.Time               `json:"date"`
+	MixerCapacity float64                 `json:"mixer_capacity"`
+	MixMinutes    int                     `json:"mix_minutes,omitempty"`
+	Items         []ProductionPlanItemDto `json:"items"`
+}
+
+// ProductionMixDto is one mix of a production plan item, scaled to its dough
+// weight. Items exceeding the mixer capacity are split into Batches mixes.
+type ProductionMixDto struct {
+	Sequence            int                `json:"sequence"`
+	RecipeId            uuid.UUID          `json:"recipe_id"`
+	RecipeName          string             `json:"recipe_name"`
+	Batch               int                `json:"batch"`
+	Batches             int                `json:"batches"`
+	Pieces              int                `json:"pieces"`
+	PieceWeight         int                `json:"piece_weight"`
+	DoughWeight         int                `json:"dough_weight"`
+	FermentationMinutes int                `json:"fermentation_minutes"`
+	MixAt               time.Time          `json:"mix_at"`
+	ReadyAt             time.Time          `json:"ready_at"`
+	Recipe              SourdoughRecipeDto `json:"recipe"`
+}
+
+// ProductionScheduleDto lists the mixes of a production plan in the order
+// they are mixed, longest fermentation first.
+type ProductionScheduleDto struct {
+	PlanId        uuid.UUID          `json:"plan_id"`
+	Name          string             `json:"name"`
+	Date          time.Time          `json:"date"`
+	MixerCapacity float64            `json:"mixer_capacity"`
+	Mixes         []ProductionMixDto `json:"mixes"`
+}
+
+type ProductionPlanRepository interface {
+	Create(ctx context.Context, plan ProductionPlanEntity) (ProductionPlanEntity, error)
+	GetById(ctx context.Context, id uuid.UUID) (ProductionPlanEntity, error)
+	Find(ctx context.Context, offset, limit int) ([]ProductionPlanEntity, error)
+	Update(ctx context.Context, plan ProductionPlanEntity) (ProductionPlanEntity, error)
+	// Complete sets CompletedAt of a plan that is not completed yet and
+	// fails with mongo.ErrNoDocuments otherwise.
+	Complete(ctx context.Context, id uuid.UUID, completedAt time.Time) (ProductionPlanEntity, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type ProductionPlanService interface {
+	Create(ctx context.Context, request CreateProductionPlanRequest) (ProductionPlanDto, error)
+	FindById(ctx context.Context, id uuid.UUID) (ProductionPlanDto, error)
+	Find(ctx context.Context, offset, limit int) ([]ProductionPlanDto, error)
+	Update(ctx context.Context, id uuid.UUID, request CreateProductionPlanRequest) (ProductionPlanDto, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Schedule(ctx context.Context, id uuid.UUID) (ProductionScheduleDto, error)
+	Complete(ctx context.Context, id uuid.UUID) (ProductionPlanDto, error)
+}
+
+type ProductionPlanHandler interface {
+	Create() http.HandlerFunc
+	FindById() http.HandlerFunc
+	Find() http.HandlerFunc
+	Update() http.HandlerFunc
+	Delete() http.HandlerFunc
+	Schedule() http.HandlerFunc
+	Complete() http.HandlerFunc
+}
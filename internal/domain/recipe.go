@@ -40,6 +40,11 @@ type RecipeEntity struct {
 	CreatedAt             time.Time                 `bson:"created_at"`
 	UpdatedAt             *time.Time                `bson:"updated_at,omitempty"`
 	Yield                 RecipeYield
+	Version               int
+	ParentId              *uuid.UUID  `bson:"parent_id,omitempty"`
+	Ancestors             []uuid.UUID `bson:"ancestors,omitempty"`
+	Tags                  []string    `bson:"tags,omitempty"`
+	Category              string      `bson:"category,omitempty"`
 }
 
 func (entity RecipeEntity) ToDto() RecipeDto {
@@ -62,6 +67,11 @@ func (entity RecipeEntity) ToDto() RecipeDto {
 		CreatedAt:             entity.CreatedAt,
 		UpdatedAt:             entity.UpdatedAt,
 		Yield:                 entity.Yield.ToDto(),
+		Version:               entity.Version,
+		ParentId:              entity.ParentId,
+		Ancestors:             entity.Ancestors,
+		Tags:                  entity.Tags,
+		Category:              entity.Category,
 	}
 }
 
@@ -145,6 +155,11 @@ type RecipeDto struct {
 	CreatedAt             time.Time                    `json:"created_at"`
 	UpdatedAt             *time.Time                   `json:"updated_at,omitempty"`
 	Yield                 RecipeYieldDto               `json:"yield"`
+	Version               int                          `json:"version"`
+	ParentId              *uuid.UUID                   `json:"parent_id,omitempty"`
+	Ancestors             []uuid.UUID                  `json:"ancestors,omitempty"`
+	Tags                  []string                     `json:"tags,omitempty"`
+	Category              string                       `json:"category,omitempty"`
 }
 
 func (dto RecipeDto) ToEntity() RecipeEntity {
@@ -168,6 +183,11 @@ func (dto RecipeDto) ToEntity() RecipeEntity {
 		CreatedAt:             dto.CreatedAt,
 		UpdatedAt:             dto.UpdatedAt,
 		Yield:                 dto.Yield.ToEntity(),
+		Version:               dto.Version,
+		ParentId:              dto.ParentId,
+		Ancestors:             dto.Ancestors,
+		Tags:                  dto.Tags,
+		Category:              dto.Category,
 	}
 }
 
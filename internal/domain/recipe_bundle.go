@@ -0,0 +1,81 @@
+//go:generate mockgen -source=recipe_bundle.go -destination=mocks/recipe_bundle.go -package mocks
+
+package domain
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RecipeBundleSchemaVersion is the version of the bundle format written by
+// the export and the only one the import accepts.
+const RecipeBundleSchemaVersion = 1
+
+// Conflict strategies for an imported recipe whose name is already taken.
+const (
+	RecipeBundleConflictRename  = "rename"
+	RecipeBundleConflictSkip    = "skip"
+	RecipeBundleConflictReplace = "replace"
+)
+
+// Outcomes of an imported flour. A matched flour has the same id in the
+// catalogue, a remapped flour the same name under another id.
+const (
+	RecipeBundleFlourMatched  = "matched"
+	RecipeBundleFlourRemapped = "remapped"
+	RecipeBundleFlourCreated  = "created"
+)
+
+// Outcomes of an imported recipe.
+const (
+	RecipeBundleRecipeCreated  = "created"
+	RecipeBundleRecipeRenamed  = "renamed"
+	RecipeBundleRecipeReplaced = "replaced"
+	RecipeBundleRecipeSkipped  = "skipped"
+)
+
+// RecipeBundleDto moves recipes between installations. Flours holds every
+// flour the recipes and their levains reference, by the id used in Recipes.
+type RecipeBundleDto struct {
+	SchemaVersion int                  `json:"schema_version"`
+	ExportedAt    time.Time            `json:"exported_at"`
+	Flours        []FlourDto           `json:"flours"`
+	Recipes       []SourdoughRecipeDto `json:"recipes"`
+}
+
+// RecipeBundleFlourImportDto maps the bundle flour SourceId to the catalogue
+// flour Id the imported recipes use.
+type RecipeBundleFlourImportDto struct {
+	SourceId uuid.UUID `json:"source_id"`
+	Id       uuid.UUID `json:"id"`
+	Name     string    `json:"name"`
+	Action   string    `json:"action"`
+}
+
+// RecipeBundleRecipeImportDto maps the bundle recipe SourceId to the recipe
+// Id it was imported as, or for a skipped recipe to the recipe of the same
+// name that was kept.
+type RecipeBundleRecipeImportDto struct {
+	SourceId uuid.UUID `json:"source_id"`
+	Id       uuid.UUID `json:"id"`
+	Name     string    `json:"name"`
+	Action   string    `json:"action"`
+}
+
+type RecipeBundleImportResultDto struct {
+	Flours  []RecipeBundleFlourImportDto  `json:"flours"`
+	Recipes []RecipeBundleRecipeImportDto `json:"recipes"`
+}
+
+type RecipeBundleService interface {
+	Export(ctx context.Context, filter SourdoughRecipeFilter) (RecipeBundleDto, error)
+	Import(ctx context.Context, bundle RecipeBundleDto, onConflict string) (RecipeBundleImportResultDto, error)
+}
+
+type RecipeBundleHandler interface {
+	Export() http.HandlerFunc
+	Import() http.HandlerFunc
+}
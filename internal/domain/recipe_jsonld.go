@@ -0,0 +1,69 @@
+//go:generate mockgen -source=recipe_jsonld.go -destination=mocks/recipe_jsonld.go -package mocks
+
+package domain
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+const (
+	SchemaOrgContext    = "https://schema.org"
+	SchemaOrgRecipeType = "Recipe"
+)
+
+// SchemaOrgNutritionDto is a schema.org NutritionInformation, the values are
+// texts with their unit as search engines expect them, e.g. "250 kcal".
+type SchemaOrgNutritionDto struct {
+	Type                string `json:"@type"`
+	ServingSize         string `json:"servingSize,omitempty"`
+	Calories            string `json:"calories,omitempty"`
+	FatContent          string `json:"fatContent,omitempty"`
+	CarbohydrateContent string `json:"carbohydrateContent,omitempty"`
+	ProteinContent      string `json:"proteinContent,omitempty"`
+	FiberContent        string `json:"fiberContent,omitempty"`
+}
+
+// SchemaOrgRecipeDto is a schema.org Recipe for publishing a recipe as
+// JSON-LD. Times are ISO 8601 durations taken from the latest bake of the
+// recipe: PrepTime covers bulk fermentation and proof, CookTime the bake.
+type SchemaOrgRecipeDto struct {
+	Context          string                 `json:"@context"`
+	Type             string                 `json:"@type"`
+	Identifier       string                 `json:"identifier,omitempty"`
+	Name             string                 `json:"name"`
+	Description      string                 `json:"description,omitempty"`
+	Image            []string               `json:"image,omitempty"`
+	RecipeIngredient []string               `json:"recipeIngredient"`
+	RecipeYield      string                 `json:"recipeYield,omitempty"`
+	Nutrition        *SchemaOrgNutritionDto `json:"nutrition,omitempty"`
+	PrepTime         string                 `json:"prepTime,omitempty"`
+	CookTime         string                 `json:"cookTime,omitempty"`
+	TotalTime        string                 `json:"totalTime,omitempty"`
+	RecipeCategory   string                 `json:"recipeCategory,omitempty"`
+	Keywords         string                 `json:"keywords,omitempty"`
+	DatePublished    string                 `json:"datePublished,omitempty"`
+	DateModified     string                 `json:"dateModified,omitempty"`
+}
+
+// RecipeJsonLdImportDto is the recipe created from a schema.org Recipe with
+// the flours its ingredients were matched to and the ingredients that were
+// left out.
+type RecipeJsonLdImportDto struct {
+	Recipe       SourdoughRecipeDto    `json:"recipe"`
+	FlourMatches []ParsedFlourMatchDto `json:"flour_matches"`
+	Unrecognised []UnrecognisedLineDto `json:"unrecognised"`
+}
+
+type RecipeJsonLdService interface {
+	Export(ctx context.Context, id uuid.UUID) (SchemaOrgRecipeDto, error)
+	ExportRecipe(ctx context.Context, recipe SourdoughRecipeDto) (SchemaOrgRecipeDto, error)
+	Import(ctx context.Context, content []byte) (RecipeJsonLdImportDto, error)
+}
+
+type RecipeJsonLdHandler interface {
+	Export() http.HandlerFunc
+	Import() http.HandlerFunc
+}
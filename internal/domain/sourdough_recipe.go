@@ -5,6 +5,7 @@ package domain
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -42,8 +43,98 @@ func (agent SourdoughLevainAgent) ToDto() SourdoughLevainAgentDto {
 type SourdoughRecipeRepository interface {
 	Create(ctx context.Context, recipe SourdoughRecipeEntity) (SourdoughRecipeEntity, error)
 	GetById(ctx context.Context, id uuid.UUID) (SourdoughRecipeEntity, error)
-	Find(ctx context.Context, offset, limit int) ([]SourdoughRecipeEntity, error)
+	// Update replaces the recipe if it is still at the given version and
+	// fails with mongo.ErrNoDocuments otherwise.
+	Update(ctx context.Context, recipe SourdoughRecipeEntity, version int) (SourdoughRecipeEntity, error)
+	GetByName(ctx context.Context, name string) (SourdoughRecipeEntity, error)
+	Find(ctx context.Context, filter SourdoughRecipeFilter, page PageRequest) (SourdoughRecipeSearchResult, error)
+	FindAll(ctx context.Context, filter SourdoughRecipeFilter) ([]SourdoughRecipeEntity, error)
 	SearchByName(ctx context.Context, name string) ([]SourdoughRecipeEntity, error)
+	TextSearch(ctx context.Context, query string, offset, limit int) (SourdoughRecipeTextSearchResult, error)
+	FindFamily(ctx context.Context, rootId uuid.UUID) ([]SourdoughRecipeEntity, error)
+}
+
+// SourdoughRecipeFilter narrows down the recipes returned by Find. Empty
+// slices and nil bounds leave the corresponding criterion unrestricted.
+// A recipe must be one of Ids, carry all Tags, one of Categories and at
+// least one flour of FlourTypes. Hydration and flour bounds are inclusive.
+type SourdoughRecipeFilter struct {
+	Ids           []uuid.UUID
+	Tags          []string
+	Categories    []string
+	FlourTypes    []string
+	MinHydration  *float64
+	MaxHydration  *float64
+	MinFlour      *float64
+	MaxFlour      *float64
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+}
+
+type FacetCount struct {
+	Value string `bson:"_id"`
+	Count int    `bson:"count"`
+}
+
+func (facet FacetCount) ToDto() FacetCountDto {
+	return FacetCountDto{
+		Value: facet.Value,
+		Count: facet.Count,
+	}
+}
+
+// HydrationBucket counts the recipes whose hydration is at least Min and
+// below Max. The last bucket has no upper bound.
+type HydrationBucket struct {
+	Min   float64  `bson:"_id"`
+	Max   *float64 `bson:"-"`
+	Count int      `bson:"count"`
+}
+
+func (bucket HydrationBucket) ToDto() HydrationBucketDto {
+	return HydrationBucketDto{
+		Min:   bucket.Min,
+		Max:   bucket.Max,
+		Count: bucket.Count,
+	}
+}
+
+type SourdoughRecipeFacets struct {
+	Tags       []FacetCount      `bson:"tags"`
+	Categories []FacetCount      `bson:"categories"`
+	FlourTypes []FacetCount      `bson:"flour_types"`
+	Hydration  []HydrationBucket `bson:"hydration"`
+}
+
+func (facets SourdoughRecipeFacets) ToDto() SourdoughRecipeFacetsDto {
+	toFacetCountDto := func(facet FacetCount) FacetCountDto { return facet.ToDto() }
+
+	return SourdoughRecipeFacetsDto{
+		Tags:       utils.Map(facets.Tags, toFacetCountDto),
+		Categories: utils.Map(facets.Categories, toFacetCountDto),
+		FlourTypes: utils.Map(facets.FlourTypes, toFacetCountDto),
+		Hydration:  utils.Map(facets.Hydration, func(bucket HydrationBucket) HydrationBucketDto { return bucket.ToDto() }),
+	}
+}
+
+// SourdoughRecipeSearchResult holds a page of recipes matching a filter
+// together with the facet counts over every matching recipe.
+type SourdoughRecipeSearchResult struct {
+	Recipes []SourdoughRecipeEntity
+	Facets  SourdoughRecipeFacets
+	Page    PageInfo
+}
+
+type SourdoughRecipeTextSearchHit struct {
+	Recipe SourdoughRecipeEntity `bson:",inline"`
+	Score  float64               `bson:"score"`
+}
+
+// SourdoughRecipeTextSearchResult holds a page of recipes matching a full-text
+// query, most relevant first, and the number of all matching recipes.
+type SourdoughRecipeTextSearchResult struct {
+	Hits  []SourdoughRecipeTextSearchHit
+	Total int64
 }
 
 type SourdoughLevainAgentDto struct {
@@ -74,15 +165,44 @@ func (dto SourdoughRecipeDto) ToEntity() SourdoughRecipeEntity {
 	}
 }
 
+func (dto SourdoughRecipeDto) ToCreateRequest() CreateSourdoughRecipeRequest {
+	return CreateSourdoughRecipeRequest{
+		Name:                  dto.Name,
+		Description:           dto.Description,
+		Flour:                 dto.Flour,
+		Water:                 dto.Water,
+		Levain:                dto.Levain,
+		AdditionalIngredients: dto.AdditionalIngredients,
+		NutritionFacts:        dto.NutritionFacts,
+		Yield:                 dto.Yield,
+		Tags:                  dto.Tags,
+		Category:              dto.Category,
+	}
+}
+
 type SourdoughRecipeService interface {
 	Create(ctx context.Context, request CreateSourdoughRecipeRequest) (SourdoughRecipeDto, error)
 	FindById(ctx context.Context, id uuid.UUID) (SourdoughRecipeDto, error)
-	Find(ctx context.Context, offset, limit int) ([]SourdoughRecipeDto, error)
+	Update(ctx context.Context, id uuid.UUID, request CreateSourdoughRecipeRequest) (SourdoughRecipeDto, error)
+	Find(ctx context.Context, filter SourdoughRecipeFilter, params PageParams) (SourdoughRecipeSearchResultDto, error)
 	SearchByName(ctx context.Context, name string) ([]SourdoughRecipeDto, error)
+	TextSearch(ctx context.Context, query string, offset, limit int) (SourdoughRecipeTextSearchResultDto, error)
+	Fork(ctx context.Context, id uuid.UUID, request ForkSourdoughRecipeRequest) (SourdoughRecipeDto, error)
+	FamilyTree(ctx context.Context, id uuid.UUID) (SourdoughRecipeFamilyTreeDto, error)
+	CreateFromFormula(ctx context.Context, request CreateSourdoughRecipeFormulaRequest) (SourdoughRecipeDto, error)
 }
 
 type SourdoughRecipeScaleService interface {
 	Scale(ctx context.Context, id uuid.UUID, request SourdoughRecipeScaleRequestDto) (SourdoughRecipeDto, error)
+	ScaleBatch(ctx context.Context, request SourdoughRecipeBatchScaleRequest) (SourdoughRecipeBatchScaleDto, error)
+}
+
+type SourdoughRecipeSubstitutionService interface {
+	Substitute(ctx context.Context, id uuid.UUID, request SourdoughRecipeSubstitutionRequest) (SourdoughRecipeSubstitutionDto, error)
+}
+
+type SourdoughRecipeParseService interface {
+	Parse(ctx context.Context, request SourdoughRecipeParseRequest) (SourdoughRecipeParseDto, error)
 }
 
 type CreateSourdoughRecipeRequest struct {
@@ -94,19 +214,229 @@ type CreateSourdoughRecipeRequest struct {
 	AdditionalIngredients []BakerAmountDto             `json:"additional_ingredients"`
 	NutritionFacts        map[string]NutritionFactsDto `json:"nutrition_facts"`
 	Yield                 RecipeYieldDto               `json:"yield"`
+	Tags                  []string                     `json:"tags,omitempty"`
+	Category              string                       `json:"category,omitempty"`
+}
+
+// FlourSubstitutionDto moves Percentage percent of the flour identified by
+// FromFlourId, in the main dough and the levain, to the catalogue flour
+// identified by ToFlourId.
+type FlourSubstitutionDto struct {
+	FromFlourId uuid.UUID `json:"from_flour_id"`
+	ToFlourId   uuid.UUID `json:"to_flour_id"`
+	Percentage  float64   `json:"percentage"`
+}
+
+// ForkSourdoughRecipeRequest describes a new variation of an existing recipe.
+// Hydration, when set, is the target dough water baker percentage.
+type ForkSourdoughRecipeRequest struct {
+	Name               string                 `json:"name"`
+	Description        string                 `json:"description,omitempty"`
+	FlourSubstitutions []FlourSubstitutionDto `json:"flour_substitutions,omitempty"`
+	Hydration          *float64               `json:"hydration,omitempty"`
+}
+
+// FlourPercentageDto is a flour of a formula with its share of the flour
+// blend in percent.
+type FlourPercentageDto struct {
+	FlourDto
+	BakerPercentage float64 `json:"baker_percentage"`
+}
+
+// SourdoughLevainFormulaDto describes the levain of a formula. BakerPercentage
+// is the levain weight relative to the dough flour, Hydration and
+// StarterPercentage are relative to the levain flour. Without Flour the levain
+// is built from the dough flour blend.
+type SourdoughLevainFormulaDto struct {
+	BakerPercentage   float64              `json:"baker_percentage"`
+	Hydration         float64              `json:"hydration"`
+	StarterPercentage float64              `json:"starter_percentage,omitempty"`
+	Flour             []FlourPercentageDto `json:"flour,omitempty"`
+}
+
+// CreateSourdoughRecipeFormulaRequest describes a recipe in baker percentages
+// of the dough flour. The flour percentages add up to 100 and exactly one of
+// DoughWeight and FlourWeight sets the size the gram amounts are solved for.
+type CreateSourdoughRecipeFormulaRequest struct {
+	Name                  string                       `json:"name"`
+	Description           string                       `json:"description"`
+	Flour                 []FlourPercentageDto         `json:"flour"`
+	Water                 []BakerAmountDto             `json:"water"`
+	Levain                SourdoughLevainFormulaDto    `json:"levain"`
+	AdditionalIngredients []BakerAmountDto             `json:"additional_ingredients"`
+	NutritionFacts        map[string]NutritionFactsDto `json:"nutrition_facts"`
+	Yield                 RecipeYieldDto               `json:"yield"`
+	Tags                  []string                     `json:"tags,omitempty"`
+	Category              string                       `json:"category,omitempty"`
+	DoughWeight           *float64                     `json:"dough_weight,omitempty"`
+	FlourWeight           *float64                     `json:"flour_weight,omitempty"`
+}
+
+type SourdoughRecipeFamilyTreeDto struct {
+	Id        uuid.UUID                      `json:"id"`
+	Name      string                         `json:"name"`
+	Version   int                            `json:"version"`
+	CreatedAt time.Time                      `json:"created_at"`
+	Children  []SourdoughRecipeFamilyTreeDto `json:"children"`
+}
+
+type FacetCountDto struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+type HydrationBucketDto struct {
+	Min   float64  `json:"min"`
+	Max   *float64 `json:"max,omitempty"`
+	Count int      `json:"count"`
+}
+
+type SourdoughRecipeFacetsDto struct {
+	Tags       []FacetCountDto      `json:"tags"`
+	Categories []FacetCountDto      `json:"categories"`
+	FlourTypes []FacetCountDto      `json:"flour_types"`
+	Hydration  []HydrationBucketDto `json:"hydration"`
+}
+
+type SourdoughRecipeSearchResultDto struct {
+	Items []SourdoughRecipeDto `json:"items"`
+	PageDto
+	Facets SourdoughRecipeFacetsDto `json:"facets"`
+}
+
+// SourdoughRecipeTextSearchHitDto is a recipe matching a full-text query.
+// Highlights maps the matching fields to fragments in which the matched
+// words are wrapped in <em> tags.
+type SourdoughRecipeTextSearchHitDto struct {
+	Recipe     SourdoughRecipeDto  `json:"recipe"`
+	Score      float64             `json:"score"`
+	Highlights map[string][]string `json:"highlights"`
+}
+
+type SourdoughRecipeTextSearchResultDto struct {
+	Items []SourdoughRecipeTextSearchHitDto `json:"items"`
+	Total int64                             `json:"total"`
 }
 
 type SourdoughRecipeScaleRequestDto struct {
 	FinalDoughWeight int `json:"final_dough_weight"`
 }
 
+// SourdoughRecipeBatchScaleItemDto asks for Pieces pieces of PieceWeight
+// grams each of the recipe RecipeId.
+type SourdoughRecipeBatchScaleItemDto struct {
+	RecipeId    uuid.UUID `json:"recipe_id"`
+	Pieces      int       `json:"pieces"`
+	PieceWeight int       `json:"piece_weight"`
+}
+
+type SourdoughRecipeBatchScaleRequest struct {
+	Items []SourdoughRecipeBatchScaleItemDto `json:"items"`
+}
+
+// SourdoughRecipeBatchScaleSheetDto is a recipe scaled to the dough weight of
+// all pieces of a batch item.
+type SourdoughRecipeBatchScaleSheetDto struct {
+	SourdoughRecipeBatchScaleItemDto
+	Recipe SourdoughRecipeDto `json:"recipe"`
+}
+
+// PickListDto sums up the ingredients of several scaled recipes. Flour of the
+// same id is summed across the doughs and levains, additional ingredients by
+// name, and the water of the doughs and levains is a single amount.
+type PickListDto struct {
+	Flour                 []FlourAmountDto `json:"flour"`
+	Water                 float64          `json:"water"`
+	Starter               float64          `json:"starter"`
+	AdditionalIngredients []BakerAmountDto `json:"additional_ingredients"`
+	TotalWeight           int              `json:"total_weight"`
+}
+
+type SourdoughRecipeBatchScaleDto struct {
+	Sheets   []SourdoughRecipeBatchScaleSheetDto `json:"sheets"`
+	PickList PickListDto                         `json:"pick_list"`
+}
+
+// SourdoughRecipeSubstitutionRequest replaces Percentage percent of the flour
+// FromFlourId with the catalogue flour ToFlourId. Target selects the main
+// dough, the levain or both and Percentage defaults to 100. Unless Apply is
+// set the substituted recipe is only returned as a preview.
+type SourdoughRecipeSubstitutionRequest struct {
+	FromFlourId uuid.UUID `json:"from_flour_id"`
+	ToFlourId   uuid.UUID `json:"to_flour_id"`
+	Percentage  *float64  `json:"percentage,omitempty"`
+	Target      string    `json:"target,omitempty"`
+	Apply       bool      `json:"apply,omitempty"`
+}
+
+// SourdoughRecipeSubstitutionDto is the substituted recipe together with the
+// substituted flour weight and the water added to (or, when negative,
+// removed from) the main dough to account for the absorption difference.
+type SourdoughRecipeSubstitutionDto struct {
+	Recipe            SourdoughRecipeDto `json:"recipe"`
+	SubstitutedAmount float64            `json:"substituted_amount"`
+	FromAbsorption    float64            `json:"from_absorption"`
+	ToAbsorption      float64            `json:"to_absorption"`
+	WaterAdjustment   float64            `json:"water_adjustment"`
+	Applied           bool               `json:"applied"`
+}
+
+// SourdoughRecipeParseRequest holds a pasted ingredient list, one ingredient
+// per line or separated by commas, in grams or baker percentages. FlourWeight
+// is the flour the percentages refer to when no flour is given in grams.
+type SourdoughRecipeParseRequest struct {
+	Name        string   `json:"name,omitempty"`
+	Text        string   `json:"text"`
+	FlourWeight *float64 `json:"flour_weight,omitempty"`
+}
+
+// ParsedFlourMatchDto shows which catalogue flour a flour ingredient was
+// matched to and how close the names are, 1 being an exact match.
+type ParsedFlourMatchDto struct {
+	Text    string    `json:"text"`
+	FlourId uuid.UUID `json:"flour_id"`
+	Name    string    `json:"name"`
+	Score   float64   `json:"score"`
+}
+
+// UnrecognisedLineDto is an ingredient that could not be parsed or whose
+// flour is not in the catalogue. Line is the line of the pasted text.
+type UnrecognisedLineDto struct {
+	Line   int    `json:"line"`
+	Text   string `json:"text"`
+	Reason string `json:"reason"`
+}
+
+// SourdoughRecipeParseDto is the create request built from the recognised
+// ingredients. Nothing is stored, the request can be reviewed and posted to
+// create the recipe.
+type SourdoughRecipeParseDto struct {
+	Recipe       CreateSourdoughRecipeRequest `json:"recipe"`
+	FlourMatches []ParsedFlourMatchDto        `json:"flour_matches"`
+	Unrecognised []UnrecognisedLineDto        `json:"unrecognised"`
+}
+
 type SourdoughRecipeHandler interface {
 	Create() http.HandlerFunc
 	FindById() http.HandlerFunc
+	Update() http.HandlerFunc
 	Find() http.HandlerFunc
 	Search() http.HandlerFunc
+	TextSearch() http.HandlerFunc
+	Fork() http.HandlerFunc
+	FamilyTree() http.HandlerFunc
+	CreateFromFormula() http.HandlerFunc
 }
 
 type SourdoughRecipeScaleHandler interface {
 	Scale() http.HandlerFunc
+	ScaleBatch() http.HandlerFunc
+}
+
+type SourdoughRecipeSubstitutionHandler interface {
+	Substitute() http.HandlerFunc
+}
+
+type SourdoughRecipeParseHandler interface {
+	Parse() http.HandlerFunc
 }
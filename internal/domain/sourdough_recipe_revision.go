@@ -0,0 +1,83 @@
+//go:generate mockgen -source=sourdough_recipe_revision.go -destination=mocks/sourdough_recipe_revision.go -package mocks
+
+package domain
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	IngredientAdded   = "added"
+	IngredientRemoved = "removed"
+	IngredientChanged = "changed"
+)
+
+type SourdoughRecipeRevisionEntity struct {
+	Id        uuid.UUID `bson:"_id"`
+	RecipeId  uuid.UUID `bson:"recipe_id"`
+	Version   int
+	Recipe    SourdoughRecipeEntity
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+func (entity SourdoughRecipeRevisionEntity) ToDto() SourdoughRecipeRevisionDto {
+	return SourdoughRecipeRevisionDto{
+		Id:        entity.Id,
+		RecipeId:  entity.RecipeId,
+		Version:   entity.Version,
+		Recipe:    entity.Recipe.ToDto(),
+		CreatedAt: entity.CreatedAt,
+	}
+}
+
+type SourdoughRecipeRevisionDto struct {
+	Id        uuid.UUID          `json:"id"`
+	RecipeId  uuid.UUID          `json:"recipe_id"`
+	Version   int                `json:"version"`
+	Recipe    SourdoughRecipeDto `json:"recipe"`
+	CreatedAt time.Time          `json:"created_at"`
+}
+
+type IngredientDiffDto struct {
+	Name                string  `json:"name"`
+	Change              string  `json:"change"`
+	FromAmount          float64 `json:"from_amount"`
+	ToAmount            float64 `json:"to_amount"`
+	FromBakerPercentage float64 `json:"from_baker_percentage"`
+	ToBakerPercentage   float64 `json:"to_baker_percentage"`
+}
+
+type SourdoughRecipeRevisionDiffDto struct {
+	RecipeId              uuid.UUID           `json:"recipe_id"`
+	FromVersion           int                 `json:"from_version"`
+	ToVersion             int                 `json:"to_version"`
+	Flour                 []IngredientDiffDto `json:"flour"`
+	Water                 []IngredientDiffDto `json:"water"`
+	AdditionalIngredients []IngredientDiffDto `json:"additional_ingredients"`
+	Levain                []IngredientDiffDto `json:"levain"`
+	Details               []IngredientDiffDto `json:"recipe_details"`
+}
+
+type SourdoughRecipeRevisionRepository interface {
+	Create(ctx context.Context, revision SourdoughRecipeRevisionEntity) (SourdoughRecipeRevisionEntity, error)
+	GetByRecipeIdAndVersion(ctx context.Context, recipeId uuid.UUID, version int) (SourdoughRecipeRevisionEntity, error)
+	FindByRecipeId(ctx context.Context, recipeId uuid.UUID, offset, limit int) ([]SourdoughRecipeRevisionEntity, error)
+}
+
+type SourdoughRecipeRevisionService interface {
+	FindByRecipeId(ctx context.Context, recipeId uuid.UUID, offset, limit int) ([]SourdoughRecipeRevisionDto, error)
+	FindByVersion(ctx context.Context, recipeId uuid.UUID, version int) (SourdoughRecipeRevisionDto, error)
+	Diff(ctx context.Context, recipeId uuid.UUID, fromVersion, toVersion int) (SourdoughRecipeRevisionDiffDto, error)
+	Restore(ctx context.Context, recipeId uuid.UUID, version int) (SourdoughRecipeDto, error)
+}
+
+type SourdoughRecipeRevisionHandler interface {
+	FindByRecipeId() http.HandlerFunc
+	FindByVersion() http.HandlerFunc
+	Diff() http.HandlerFunc
+	Restore() http.HandlerFunc
+}
@@ -1,11 +1,24 @@
 package errors
 
-import "github.com/google/uuid"
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+)
 
 var (
 	SourdoughRecipeNotFound = func(details string) error {
 		return NewBadRequestError(10001, "sourdough not found", details)
 	}
+	SourdoughRecipeVersionConflict = func(id uuid.UUID, version int) error {
+		return NewServiceError(http.StatusConflict, 10002, "sourdough recipe was changed concurrently",
+			fmt.Sprintf("recipe with id %s is no longer at version %d", id.String(), version))
+	}
+	SourdoughRecipeAlreadyExists = func(name string) error {
+		return NewServiceError(http.StatusConflict, 10003, "sourdough recipe already exists",
+			fmt.Sprintf("recipe with name %s already exists", name))
+	}
 )
 var (
 	FlourByIdNotFound = func(id uuid.UUID) error {
@@ -14,4 +27,157 @@ var (
 	FlourNotFound = func(details string) error {
 		return NewBadRequestError(20001, "flour not found", details)
 	}
+	FlourInvalid = func(details string) error {
+		return NewBadRequestError(20003, "invalid flour", details)
+	}
+	FlourFilterInvalid = func(details string) error {
+		return NewBadRequestError(20004, "invalid flour filter", details)
+	}
+	FlourImportInvalid = func(details string) error {
+		return NewBadRequestError(20005, "invalid flour import", details)
+	}
+)
+var (
+	FlourTypeNotFound = func(details string) error {
+		return NewBadRequestError(21001, "flour type not found", details)
+	}
+	FlourTypeInvalid = func(details string) error {
+		return NewBadRequestError(21002, "invalid flour type", details)
+	}
+	FlourTypeAlreadyExists = func(code string) error {
+		return NewBadRequestErrorf(21003, "flour type already exists", "flour type with code %s already exists", code)
+	}
+	FlourTypeInUse = func(code string, flours int64) error {
+		return NewBadRequestErrorf(21004, "flour type is in use", "flour type %s is used by %d flours", code, flours)
+	}
+)
+var (
+	SourdoughRecipeRevisionNotFound = func(recipeId uuid.UUID, version int) error {
+		return NewBadRequestErrorf(11001, "sourdough recipe revision not found", "revision %d of recipe with id %s not found", version, recipeId.String())
+	}
+)
+var (
+	SourdoughRecipeForkNameRequired = func() error {
+		return NewBadRequestError(12001, "fork name is required", "name of the forked recipe is required")
+	}
+	SourdoughRecipeForkFlourNotFound = func(flourId uuid.UUID) error {
+		return NewBadRequestErrorf(12002, "flour to substitute not found", "flour with id %s is not part of the recipe", flourId.String())
+	}
+	SourdoughRecipeForkInvalidSubstitution = func(details string) error {
+		return NewBadRequestError(12003, "invalid flour substitution", details)
+	}
+	SourdoughRecipeForkInvalidHydration = func(hydration float64) error {
+		return NewBadRequestErrorf(12004, "invalid hydration", "hydration %.2f must be greater than zero", hydration)
+	}
+)
+var (
+	BakeLogNotFound = func(id uuid.UUID) error {
+		return NewBadRequestErrorf(13001, "bake log not found", "bake log with id %s not found", id.String())
+	}
+	BakeLogInvalidRating = func(rating int) error {
+		return NewBadRequestErrorf(13002, "invalid rating", "rating %d must be between 1 and 5", rating)
+	}
+	BakeLogInvalidRecipeVersion = func(version, currentVersion int) error {
+		return NewBadRequestErrorf(13003, "invalid recipe version", "recipe version %d must be between 1 and %d", version, currentVersion)
+	}
+	BakeLogInvalidScaledWeight = func(weight int) error {
+		return NewBadRequestErrorf(13004, "invalid scaled weight", "scaled weight %d must not be negative", weight)
+	}
+	BakeLogRecipeWithoutWeight = func(version int) error {
+		return NewBadRequestErrorf(13005, "recipe has no weight", "recipe version %d has a total weight of zero and cannot be scaled", version)
+	}
+)
+var (
+	ImageNotFound = func(id uuid.UUID) error {
+		return NewBadRequestErrorf(14001, "image not found", "image with id %s not found", id.String())
+	}
+	ImageTooLarge = func(size, maxSize int64) error {
+		return NewBadRequestErrorf(14002, "image is too large", "image size %d exceeds the limit of %d bytes", size, maxSize)
+	}
+	ImageUnsupportedContentType = func(contentType string) error {
+		return NewBadRequestErrorf(14003, "unsupported image type", "content type %s is not supported, use image/jpeg, image/png or image/gif", contentType)
+	}
+	ImageInvalid = func(details string) error {
+		return NewBadRequestError(14004, "invalid image", details)
+	}
+)
+var (
+	SourdoughRecipeFilterInvalid = func(details string) error {
+		return NewBadRequestError(15001, "invalid recipe filter", details)
+	}
+	SearchQueryInvalid = func(details string) error {
+		return NewBadRequestError(15002, "invalid search query", details)
+	}
+	InvalidPageRequest = func(details string) error {
+		return NewBadRequestError(16001, "invalid page request", details)
+	}
+)
+var (
+	SourdoughRecipeSubstitutionInvalid = func(details string) error {
+		return NewBadRequestError(17001, "invalid flour substitution", details)
+	}
+	SourdoughRecipeSubstitutionFlourNotFound = func(flourId uuid.UUID) error {
+		return NewBadRequestErrorf(17002, "flour to substitute not found", "flour with id %s is not part of the recipe", flourId.String())
+	}
+)
+var (
+	CalculationRequestInvalid = func(details string) error {
+		return NewBadRequestError(18001, "invalid calculation request", details)
+	}
+	SourdoughRecipeFormulaInvalid = func(details string) error {
+		return NewBadRequestError(18002, "invalid recipe formula", details)
+	}
+)
+var (
+	SourdoughRecipeBatchScaleInvalid = func(details string) error {
+		return NewBadRequestError(19001, "invalid batch scale request", details)
+	}
+)
+var (
+	HydrationRequestInvalid = func(details string) error {
+		return NewBadRequestError(22001, "invalid hydration request", details)
+	}
+	HydrationFlourNotFound = func(id uuid.UUID) error {
+		return NewBadRequestErrorf(22002, "flour not found", "flour with id %s not found", id.String())
+	}
+)
+
+var (
+	ProductionPlanNotFound = func(id uuid.UUID) error {
+		return NewBadRequestErrorf(23001, "production plan not found", "production plan with id %s not found", id.String())
+	}
+	ProductionPlanInvalid = func(details string) error {
+		return NewBadRequestError(23002, "invalid production plan", details)
+	}
+	ProductionPlanAlreadyCompleted = func(id uuid.UUID) error {
+		return NewServiceError(http.StatusConflict, 23003, "production plan already completed",
+			fmt.Sprintf("production plan with id %s is already completed", id.String()))
+	}
+)
+
+var (
+	InventoryItemNotFound = func(id uuid.UUID) error {
+		return NewBadRequestErrorf(24001, "inventory item not found", "inventory item with id %s not found", id.String())
+	}
+	InventoryItemInvalid = func(details string) error {
+		return NewBadRequestError(24002, "invalid inventory item", details)
+	}
+)
+
+var (
+	RecipeBundleInvalid = func(details string) error {
+		return NewBadRequestError(25001, "invalid recipe bundle", details)
+	}
+)
+
+var (
+	SourdoughRecipeParseInvalid = func(details string) error {
+		return NewBadRequestError(26001, "invalid recipe text", details)
+	}
+)
+
+var (
+	RecipeJsonLdInvalid = func(details string) error {
+		return NewBadRequestError(27001, "invalid schema.org recipe", details)
+	}
 )
@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"dough-calculator/internal/domain"
+)
+
+const (
+	BakeLogCollection = "bake-logs"
+)
+
+type bakeLogRepository struct {
+	mongoDBService domain.MongoDBService
+}
+
+func (repository *bakeLogRepository) Create(ctx context.Context, bakeLog domain.BakeLogEntity) (entity domain.BakeLogEntity, err error) {
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	result, err := collection.InsertOne(ctx, bakeLog)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Stringer("recipe_id", bakeLog.RecipeId).
+			Msg("failed to insert bake log")
+		return domain.BakeLogEntity{}, errors.Wrap(err, "failed to insert bake log")
+	}
+
+	log.Debug().Msgf("Inserted a single document: %s", result.InsertedID)
+
+	return bakeLog, nil
+}
+
+func (repository *bakeLogRepository) GetById(ctx context.Context, id uuid.UUID) (entity domain.BakeLogEntity, err error) {
+	defer func() {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Stringer("id", id).
+				Msg("failed to get bake log by id")
+		}
+	}()
+
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	err = collection.
+		FindOne(ctx, bson.D{{"_id", id}}).
+		Decode(&entity)
+	if err != nil {
+		return domain.BakeLogEntity{}, errors.Wrap(err, "failed to find bake log")
+	}
+
+	return
+}
+
+func (repository *bakeLogRepository) FindByRecipeId(ctx context.Context, recipeId uuid.UUID, offset, limit int) (result []domain.BakeLogEntity, err error) {
+	defer func() {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Stringer("recipe_id", recipeId).
+				Msg("failed to find bake logs")
+		}
+	}()
+
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	cursor, err := collection.Find(ctx, bson.D{{"recipe_id", recipeId}}, options.Find().
+		SetLimit(int64(limit)).
+		SetSkip(int64(offset)).
+		SetSort(bson.D{{"baked_at", -1}}))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find bake logs")
+	}
+
+	if err = cursor.All(ctx, &result); err != nil {
+		return nil, errors.Wrap(err, "failed to decode bake logs")
+	}
+
+	return
+}
+
+func (repository *bakeLogRepository) Summarize(ctx context.Context, recipeId uuid.UUID) (summary domain.BakeLogSummaryEntity, err error) {
+	defer func() {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Stringer("recipe_id", recipeId).
+				Msg("failed to summarize bake logs")
+		}
+	}()
+
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	cursor, err := collection.Aggregate(ctx, mongo.Pipeline{
+		{{"$match", bson.D{{"recipe_id", recipeId}}}},
+		{{"$group", bson.D{
+			{"_id", "$recipe_id"},
+			{"bake_count", bson.D{{"$sum", 1}}},
+			{"average_rating", bson.D{{"$avg", "$rating"}}},
+			{"last_baked_at", bson.D{{"$max", "$baked_at"}}},
+		}}},
+	})
+	if err != nil {
+		return domain.BakeLogSummaryEntity{}, errors.Wrap(err, "failed to aggregate bake logs")
+	}
+
+	var summaries []domain.BakeLogSummaryEntity
+	if err = cursor.All(ctx, &summaries); err != nil {
+		return domain.BakeLogSummaryEntity{}, errors.Wrap(err, "failed to decode bake log summary")
+	}
+
+	if len(summaries) == 0 {
+		return domain.BakeLogSummaryEntity{}, nil
+	}
+
+	return summaries[0], nil
+}
+
+func (repository *bakeLogRepository) getCollection() (*mongo.Collection, error) {
+	collection, err := repository.mongoDBService.GetCollection(BakeLogCollection)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("collection", BakeLogCollection).
+			Msg("failed to get collection")
+		return nil, errors.Wrap(err, "failed to get collection")
+	}
+	return collection, nil
+}
+
+func NewBakeLogRepository(service domain.MongoDBService) (domain.BakeLogRepository, error) {
+	if service == nil {
+		return nil, errors.New("service cannot be nil")
+	}
+
+	return &bakeLogRepository{mongoDBService: service}, nil
+}
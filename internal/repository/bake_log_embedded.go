@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/domain"
+)
+
+type embeddedBakeLogRepository struct {
+	collection *embeddedCollection[domain.BakeLogEntity]
+}
+
+func (repository *embeddedBakeLogRepository) Create(ctx context.Context, bakeLog domain.BakeLogEntity) (domain.BakeLogEntity, error) {
+	if err := repository.collection.insert(ctx, bakeLog); err != nil {
+		return domain.BakeLogEntity{}, errors.Wrap(err, "failed to insert bake log")
+	}
+	return bakeLog, nil
+}
+
+func (repository *embeddedBakeLogRepository) GetById(ctx context.Context, id uuid.UUID) (domain.BakeLogEntity, error) {
+	bakeLog, err := repository.collection.get(ctx, id)
+	return bakeLog, errors.Wrap(err, "failed to get bake log by id")
+}
+
+// FindByRecipeId returns the bake logs of a recipe, latest bake first.
+func (repository *embeddedBakeLogRepository) FindByRecipeId(ctx context.Context, recipeId uuid.UUID, offset, limit int) ([]domain.BakeLogEntity, error) {
+	bakeLogs, err := repository.find(ctx, recipeId)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(bakeLogs, func(i, j int) bool { return bakeLogs[i].BakedAt.After(bakeLogs[j].BakedAt) })
+
+	return window(bakeLogs, offset, limit), nil
+}
+
+// Summarize counts the bakes of a recipe, averages their ratings and finds
+// the latest bake. A recipe never baked yields an empty summary.
+func (repository *embeddedBakeLogRepository) Summarize(ctx context.Context, recipeId uuid.UUID) (domain.BakeLogSummaryEntity, error) {
+	bakeLogs, err := repository.find(ctx, recipeId)
+	if err != nil {
+		return domain.BakeLogSummaryEntity{}, err
+	}
+
+	summary := domain.BakeLogSummaryEntity{}
+	if len(bakeLogs) == 0 {
+		return summary, nil
+	}
+
+	ratings := 0
+	for _, bakeLog := range bakeLogs {
+		ratings += bakeLog.Rating
+		if summary.LastBakedAt == nil || bakeLog.BakedAt.After(*summary.LastBakedAt) {
+			bakedAt := bakeLog.BakedAt
+			summary.LastBakedAt = &bakedAt
+		}
+	}
+	summary.BakeCount = len(bakeLogs)
+	summary.AverageRating = float64(ratings) / float64(len(bakeLogs))
+
+	return summary, nil
+}
+
+func (repository *embeddedBakeLogRepository) find(ctx context.Context, recipeId uuid.UUID) ([]domain.BakeLogEntity, error) {
+	bakeLogs, err := repository.collection.find(ctx, func(bakeLog domain.BakeLogEntity) bool {
+		return bakeLog.RecipeId == recipeId
+	})
+	return bakeLogs, errors.Wrap(err, "failed to find bake logs")
+}
+
+func NewEmbeddedBakeLogRepository(database domain.EmbeddedDatabase) (domain.BakeLogRepository, error) {
+	collection, err := newEmbeddedCollection(database, BakeLogCollection,
+		func(bakeLog domain.BakeLogEntity) uuid.UUID { return bakeLog.Id })
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create collection")
+	}
+
+	return &embeddedBakeLogRepository{collection: collection}, nil
+}
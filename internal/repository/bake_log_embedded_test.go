@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/test"
+)
+
+func TestEmbeddedBakeLogRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(EmbeddedBakeLogRepositoryTestSuite))
+}
+
+type EmbeddedBakeLogRepositoryTestSuite struct {
+	test.GoMockTestSuite
+
+	target domain.BakeLogRepository
+}
+
+func (suite *EmbeddedBakeLogRepositoryTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.target = test.Must(func() (domain.BakeLogRepository, error) {
+		return NewEmbeddedBakeLogRepository(newEmptyEmbeddedDatabase(suite.MockCtrl))
+	})
+
+	bakedAt := test.Date.Truncate(time.Millisecond)
+	for _, bakeLog := range []domain.BakeLogEntity{
+		{Id: test.FirstId, RecipeId: test.FirstId, Rating: 4, BakedAt: bakedAt},
+		{Id: test.SecondId, RecipeId: test.FirstId, Rating: 5, BakedAt: bakedAt.Add(time.Hour)},
+		{Id: test.ThirdId, RecipeId: test.SecondId, Rating: 1, BakedAt: bakedAt},
+	} {
+		_, err := suite.target.Create(context.Background(), bakeLog)
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *EmbeddedBakeLogRepositoryTestSuite) TestFindByRecipeId() {
+	actual, err := suite.target.FindByRecipeId(context.Background(), test.FirstId, 0, 10)
+
+	suite.NoError(err)
+	suite.Equal([]uuid.UUID{test.SecondId, test.FirstId}, []uuid.UUID{actual[0].Id, actual[1].Id})
+
+	actual, err = suite.target.FindByRecipeId(context.Background(), test.FirstId, 1, 10)
+
+	suite.NoError(err)
+	suite.Len(actual, 1)
+	suite.Equal(test.FirstId, actual[0].Id)
+}
+
+func (suite *EmbeddedBakeLogRepositoryTestSuite) TestSummarize() {
+	actual, err := suite.target.Summarize(context.Background(), test.FirstId)
+
+	suite.NoError(err)
+	suite.Equal(2, actual.BakeCount)
+	suite.Equal(4.5, actual.AverageRating)
+	suite.Equal(test.Date.Truncate(time.Millisecond).Add(time.Hour), *actual.LastBakedAt)
+}
+
+func (suite *EmbeddedBakeLogRepositoryTestSuite) TestSummarize_WithoutBakes() {
+	actual, err := suite.target.Summarize(context.Background(), test.ThirdId)
+
+	suite.NoError(err)
+	suite.Equal(domain.BakeLogSummaryEntity{}, actual)
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	"dough-calculator/internal/test"
+)
+
+func TestBakeLogRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(BakeLogRepositoryTestSuite))
+}
+
+type BakeLogRepositoryTestSuite struct {
+	test.GoMockTestSuite
+
+	mongoDBService *mocks.MockMongoDBService
+
+	target *bakeLogRepository
+}
+
+func (suite *BakeLogRepositoryTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.mongoDBService = mocks.NewMockMongoDBService(suite.MockCtrl)
+
+	suite.target = &bakeLogRepository{
+		mongoDBService: suite.mongoDBService,
+	}
+}
+
+func (suite *BakeLogRepositoryTestSuite) TestNewBakeLogRepository_WithError() {
+	tests := []struct {
+		name           string
+		mongoDBService domain.MongoDBService
+		errorMsg       string
+	}{
+		{
+			name:           "mongoDBService is nil",
+			mongoDBService: nil,
+			errorMsg:       "service cannot be nil",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			repository, err := NewBakeLogRepository(tt.mongoDBService)
+
+			suite.ErrorContains(err, tt.errorMsg)
+			suite.Nil(repository)
+		})
+	}
+}
+
+func (suite *BakeLogRepositoryTestSuite) TestCreate_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(BakeLogCollection).
+		Return(nil, assert.AnError)
+
+	entity, err := suite.target.Create(context.Background(), domain.BakeLogEntity{})
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Equal(domain.BakeLogEntity{}, entity)
+}
+
+func (suite *BakeLogRepositoryTestSuite) TestGetById_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(BakeLogCollection).
+		Return(nil, assert.AnError)
+
+	entity, err := suite.target.GetById(context.Background(), uuid.UUID{})
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Equal(domain.BakeLogEntity{}, entity)
+}
+
+func (suite *BakeLogRepositoryTestSuite) TestFindByRecipeId_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(BakeLogCollection).
+		Return(nil, assert.AnError)
+
+	entities, err := suite.target.FindByRecipeId(context.Background(), uuid.UUID{}, 0, 1)
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Nil(entities)
+}
+
+func (suite *BakeLogRepositoryTestSuite) TestSummarize_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(BakeLogCollection).
+		Return(nil, assert.AnError)
+
+	summary, err := suite.target.Summarize(context.Background(), uuid.UUID{})
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Equal(domain.BakeLogSummaryEntity{}, summary)
+}
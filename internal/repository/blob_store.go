@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/config"
+	"dough-calculator/internal/domain"
+)
+
+// NewBlobStore creates the blob store selected by the storage type of the configuration.
+func NewBlobStore(storage config.Storage, mongoDBService domain.MongoDBService) (domain.BlobStore, error) {
+	switch storage.Type {
+	case config.StorageTypeLocal:
+		return NewLocalBlobStore(storage.Local)
+	case config.StorageTypeGridFS:
+		return NewGridFSBlobStore(storage.GridFS, mongoDBService)
+	default:
+		return nil, errors.Errorf("unsupported storage type '%s'", storage.Type)
+	}
+}
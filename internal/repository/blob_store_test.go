@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/config"
+	"dough-calculator/internal/domain/mocks"
+)
+
+func TestNewBlobStore(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mongoDBService := mocks.NewMockMongoDBService(mockCtrl)
+
+	local, err := NewBlobStore(config.Storage{
+		Type:  config.StorageTypeLocal,
+		Local: config.LocalStorage{Path: t.TempDir()},
+	}, mongoDBService)
+	assert.NoError(t, err)
+	assert.IsType(t, &localBlobStore{}, local)
+
+	gridFS, err := NewBlobStore(config.Storage{
+		Type:   config.StorageTypeGridFS,
+		GridFS: config.GridFSStorage{Bucket: "photos"},
+	}, mongoDBService)
+	assert.NoError(t, err)
+	assert.Equal(t, &gridFSBlobStore{mongoDBService: mongoDBService, bucketName: "photos"}, gridFS)
+}
+
+func TestNewBlobStore_WithUnsupportedType(t *testing.T) {
+	store, err := NewBlobStore(config.Storage{Type: "s3"}, nil)
+
+	assert.ErrorContains(t, err, "unsupported storage type 's3'")
+	assert.Nil(t, store)
+}
+
+func TestNewGridFSBlobStore_WithDefaultBucket(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mongoDBService := mocks.NewMockMongoDBService(mockCtrl)
+
+	store, err := NewGridFSBlobStore(config.GridFSStorage{}, mongoDBService)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &gridFSBlobStore{mongoDBService: mongoDBService, bucketName: GridFSDefaultBucket}, store)
+}
+
+func TestNewGridFSBlobStore_WithNilService(t *testing.T) {
+	store, err := NewGridFSBlobStore(config.GridFSStorage{}, nil)
+
+	assert.ErrorContains(t, err, "service cannot be nil")
+	assert.Nil(t, store)
+}
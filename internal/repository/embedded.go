@@ -0,0 +1,582 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"dough-calculator/internal/domain"
+)
+
+// duplicateKeyCode is the MongoDB error code of a unique index violation,
+// embedded collections report the same error so services handle both
+// backends alike.
+const duplicateKeyCode = 11000
+
+// uniqueIndex rejects a document whose key is already taken by another
+// document of the collection.
+type uniqueIndex[T any] struct {
+	name string
+	key  func(document T) string
+}
+
+// embeddedLocks holds the lock of every embedded database, shared by all
+// of its collections. A transaction holds it for writing until it ends, so
+// it runs alone and nobody reads its writes before they are committed.
+var embeddedLocks sync.Map
+
+func embeddedLock(database domain.EmbeddedDatabase) *sync.RWMutex {
+	lock, _ := embeddedLocks.LoadOrStore(database, &sync.RWMutex{})
+	return lock.(*sync.RWMutex)
+}
+
+// embeddedTransaction is the transaction of an embedded database carried in
+// the context passed to the fn of WithTransaction. It collects how to undo
+// the changes of the collections, which see the writes of the transaction
+// right away.
+type embeddedTransaction struct {
+	domain.EmbeddedTransaction
+	undo []func()
+}
+
+type embeddedTransactionKey struct{}
+
+func embeddedTransactionFromContext(ctx context.Context) *embeddedTransaction {
+	transaction, _ := ctx.Value(embeddedTransactionKey{}).(*embeddedTransaction)
+	return transaction
+}
+
+// rollback undoes the changes of the collections in reverse order.
+func (transaction *embeddedTransaction) rollback() {
+	for i := len(transaction.undo) - 1; i >= 0; i-- {
+		transaction.undo[i]()
+	}
+	transaction.undo = nil
+}
+
+// embeddedCollection keeps the documents of one collection of an embedded
+// database in memory. Documents are held BSON encoded, so reads return
+// copies with the precision MongoDB would store, and every write goes
+// through to the database, or to the transaction of the context, before it
+// becomes visible.
+type embeddedCollection[T any] struct {
+	database domain.EmbeddedDatabase
+	name     string
+	id       func(document T) uuid.UUID
+	indexes  []uniqueIndex[T]
+
+	mutex     *sync.RWMutex
+	documents map[uuid.UUID][]byte
+	keys      []map[string]uuid.UUID
+}
+
+func newEmbeddedCollection[T any](
+	database domain.EmbeddedDatabase,
+	name string,
+	id func(document T) uuid.UUID,
+	indexes ...uniqueIndex[T],
+) (*embeddedCollection[T], error) {
+	if database == nil {
+		return nil, errors.New("database cannot be nil")
+	}
+
+	documents, err := database.Load(name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load collection %s", name)
+	}
+
+	collection := &embeddedCollection[T]{
+		database:  database,
+		name:      name,
+		id:        id,
+		indexes:   indexes,
+		mutex:     embeddedLock(database),
+		documents: documents,
+		keys:      make([]map[string]uuid.UUID, len(indexes)),
+	}
+
+	for i := range indexes {
+		collection.keys[i] = map[string]uuid.UUID{}
+	}
+	for documentId, raw := range documents {
+		var document T
+		if err := bson.Unmarshal(raw, &document); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode document %s of collection %s", documentId, name)
+		}
+		collection.index(documentId, document)
+	}
+
+	return collection, nil
+}
+
+// lock takes the database lock for writing, unless ctx carries a
+// transaction holding it already. It returns the transaction and the
+// function releasing the lock.
+func (collection *embeddedCollection[T]) lock(ctx context.Context) (*embeddedTransaction, func()) {
+	if transaction := embeddedTransactionFromContext(ctx); transaction != nil {
+		return transaction, func() {}
+	}
+
+	collection.mutex.Lock()
+	return nil, collection.mutex.Unlock
+}
+
+// readLock takes the database lock for reading, unless ctx carries a
+// transaction holding it already. It returns the function releasing it.
+func (collection *embeddedCollection[T]) readLock(ctx context.Context) func() {
+	if embeddedTransactionFromContext(ctx) != nil {
+		return func() {}
+	}
+
+	collection.mutex.RLock()
+	return collection.mutex.RUnlock
+}
+
+// insert adds a new document, failing with a duplicate key error when its
+// id or a unique key is taken.
+func (collection *embeddedCollection[T]) insert(ctx context.Context, document T) error {
+	transaction, unlock := collection.lock(ctx)
+	defer unlock()
+
+	id := collection.id(document)
+	if _, ok := collection.documents[id]; ok {
+		return collection.duplicateKeyError("_id_", id.String())
+	}
+
+	return collection.write(transaction, []T{document})
+}
+
+// replace replaces the document of the same id, failing with
+// mongo.ErrNoDocuments when there is none.
+func (collection *embeddedCollection[T]) replace(ctx context.Context, document T) error {
+	transaction, unlock := collection.lock(ctx)
+	defer unlock()
+
+	if _, ok := collection.documents[collection.id(document)]; !ok {
+		return mongo.ErrNoDocuments
+	}
+
+	return collection.write(transaction, []T{document})
+}
+
+// save inserts the document or replaces the one of the same id.
+func (collection *embeddedCollection[T]) save(ctx context.Context, document T) error {
+	transaction, unlock := collection.lock(ctx)
+	defer unlock()
+
+	return collection.write(transaction, []T{document})
+}
+
+// update applies fn to every document matching and stores the changed
+// documents at once, it returns the number of matched documents.
+func (collection *embeddedCollection[T]) update(ctx context.Context, match func(document T) bool, fn func(document *T)) (int, error) {
+	transaction, unlock := collection.lock(ctx)
+	defer unlock()
+
+	documents, err := collection.decode(match)
+	if err != nil {
+		return 0, err
+	}
+	if len(documents) == 0 {
+		return 0, nil
+	}
+
+	for i := range documents {
+		fn(&documents[i])
+	}
+
+	if err := collection.write(transaction, documents); err != nil {
+		return 0, err
+	}
+
+	return len(documents), nil
+}
+
+// delete removes the document of the given id, failing with
+// mongo.ErrNoDocuments when there is none.
+func (collection *embeddedCollection[T]) delete(ctx context.Context, id uuid.UUID) error {
+	transaction, unlock := collection.lock(ctx)
+	defer unlock()
+
+	if _, ok := collection.documents[id]; !ok {
+		return mongo.ErrNoDocuments
+	}
+
+	if transaction == nil {
+		if err := collection.database.Delete(collection.name, id); err != nil {
+			return err
+		}
+	} else {
+		if err := transaction.Delete(collection.name, id); err != nil {
+			return err
+		}
+		transaction.undo = append(transaction.undo, collection.restore([]uuid.UUID{id}))
+	}
+
+	collection.unindex(id)
+	delete(collection.documents, id)
+
+	return nil
+}
+
+// get returns the document of the given id, mongo.ErrNoDocuments when
+// there is none.
+func (collection *embeddedCollection[T]) get(ctx context.Context, id uuid.UUID) (document T, err error) {
+	defer collection.readLock(ctx)()
+
+	raw, ok := collection.documents[id]
+	if !ok {
+		return document, mongo.ErrNoDocuments
+	}
+
+	err = bson.Unmarshal(raw, &document)
+	return document, errors.Wrap(err, "failed to decode document")
+}
+
+// findOne returns the first document matching in id order,
+// mongo.ErrNoDocuments when none does.
+func (collection *embeddedCollection[T]) findOne(ctx context.Context, match func(document T) bool) (document T, err error) {
+	documents, err := collection.find(ctx, match)
+	if err != nil {
+		return document, err
+	}
+	if len(documents) == 0 {
+		return document, mongo.ErrNoDocuments
+	}
+	return documents[0], nil
+}
+
+// find returns every document matching, ordered by id.
+func (collection *embeddedCollection[T]) find(ctx context.Context, match func(document T) bool) ([]T, error) {
+	defer collection.readLock(ctx)()
+
+	return collection.decode(match)
+}
+
+func (collection *embeddedCollection[T]) count(ctx context.Context, match func(document T) bool) (int64, error) {
+	documents, err := collection.find(ctx, match)
+	return int64(len(documents)), err
+}
+
+// decode returns the matching documents ordered by id, the caller holds
+// the lock.
+func (collection *embeddedCollection[T]) decode(match func(document T) bool) ([]T, error) {
+	ids := make([]uuid.UUID, 0, len(collection.documents))
+	for id := range collection.documents {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return bytes.Compare(ids[i][:], ids[j][:]) < 0 })
+
+	documents := []T{}
+	for _, id := range ids {
+		var document T
+		if err := bson.Unmarshal(collection.documents[id], &document); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode document %s", id)
+		}
+		if match == nil || match(document) {
+			documents = append(documents, document)
+		}
+	}
+
+	return documents, nil
+}
+
+// write stores the documents at once, in the transaction when there is one,
+// the caller holds the lock.
+func (collection *embeddedCollection[T]) write(transaction *embeddedTransaction, documents []T) error {
+	encoded := make(map[uuid.UUID][]byte, len(documents))
+	ids := make([]uuid.UUID, 0, len(documents))
+	for _, document := range documents {
+		id := collection.id(document)
+		if err := collection.checkKeys(id, document); err != nil {
+			return err
+		}
+
+		raw, err := bson.Marshal(document)
+		if err != nil {
+			return errors.Wrap(err, "failed to encode document")
+		}
+		encoded[id] = raw
+		ids = append(ids, id)
+	}
+
+	if transaction == nil {
+		if err := collection.database.Put(collection.name, encoded); err != nil {
+			return err
+		}
+	} else {
+		if err := transaction.Put(collection.name, encoded); err != nil {
+			return err
+		}
+		transaction.undo = append(transaction.undo, collection.restore(ids))
+	}
+
+	for i, document := range documents {
+		collection.unindex(ids[i])
+		collection.documents[ids[i]] = encoded[ids[i]]
+		collection.index(ids[i], document)
+	}
+
+	return nil
+}
+
+// restore returns a function putting the documents of the given ids back
+// in the state they have now, removing the ones not existing yet.
+func (collection *embeddedCollection[T]) restore(ids []uuid.UUID) func() {
+	previous := make(map[uuid.UUID][]byte, len(ids))
+	for _, id := range ids {
+		previous[id] = collection.documents[id]
+	}
+
+	return func() {
+		for id, raw := range previous {
+			collection.unindex(id)
+			if raw == nil {
+				delete(collection.documents, id)
+				continue
+			}
+
+			collection.documents[id] = raw
+			var document T
+			if err := bson.Unmarshal(raw, &document); err == nil {
+				collection.index(id, document)
+			}
+		}
+	}
+}
+
+func (collection *embeddedCollection[T]) checkKeys(id uuid.UUID, document T) error {
+	for i, index := range collection.indexes {
+		key := index.key(document)
+		if owner, ok := collection.keys[i][key]; ok && owner != id {
+			return collection.duplicateKeyError(index.name, key)
+		}
+	}
+	return nil
+}
+
+func (collection *embeddedCollection[T]) index(id uuid.UUID, document T) {
+	for i, index := range collection.indexes {
+		collection.keys[i][index.key(document)] = id
+	}
+}
+
+func (collection *embeddedCollection[T]) unindex(id uuid.UUID) {
+	for i := range collection.indexes {
+		for key, owner := range collection.keys[i] {
+			if owner == id {
+				delete(collection.keys[i], key)
+			}
+		}
+	}
+}
+
+func (collection *embeddedCollection[T]) duplicateKeyError(index, key string) error {
+	return mongo.WriteException{WriteErrors: mongo.WriteErrors{{
+		Code:    duplicateKeyCode,
+		Message: fmt.Sprintf("E11000 duplicate key error collection: %s index: %s dup key: %q", collection.name, index, key),
+	}}}
+}
+
+// embeddedPage picks the items of the requested page from all items
+// matching a filter. Items are ordered like pageQuery orders them in
+// MongoDB, by the sort key with missing keys first and then by id.
+func embeddedPage[T any](
+	items []T,
+	page domain.PageRequest,
+	sortKey func(item T) any,
+	id func(item T) uuid.UUID,
+) ([]T, domain.PageInfo) {
+	descending, key := page.Descending, page.After
+	if page.Before != nil {
+		descending, key = !descending, page.Before
+	}
+
+	compare := func(value any, valueId uuid.UUID, other any, otherId uuid.UUID) int {
+		result := compareValues(value, other)
+		if result == 0 {
+			result = bytes.Compare(valueId[:], otherId[:])
+		}
+		if descending {
+			return -result
+		}
+		return result
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return compare(sortKey(items[i]), id(items[i]), sortKey(items[j]), id(items[j])) < 0
+	})
+
+	selected := make([]T, 0, min(len(items), page.Limit+1))
+	skip := 0
+	if key == nil {
+		skip = page.Offset
+	}
+	for _, item := range items {
+		if key != nil && compare(sortKey(item), id(item), key.Value, key.Id) <= 0 {
+			continue
+		}
+		if skip > 0 {
+			skip--
+			continue
+		}
+		if len(selected) > page.Limit {
+			break
+		}
+		selected = append(selected, item)
+	}
+
+	return pageResult(selected, page)
+}
+
+// compareValues orders sort keys of the same kind, nil stands for a
+// missing key and comes first.
+func compareValues(value, other any) int {
+	switch {
+	case value == nil && other == nil:
+		return 0
+	case value == nil:
+		return -1
+	case other == nil:
+		return 1
+	}
+
+	switch value := value.(type) {
+	case float64:
+		other, _ := other.(float64)
+		switch {
+		case value < other:
+			return -1
+		case value > other:
+			return 1
+		}
+		return 0
+	case string:
+		other, _ := other.(string)
+		return strings.Compare(value, other)
+	case time.Time:
+		other, _ := other.(time.Time)
+		return value.Compare(other)
+	default:
+		return 0
+	}
+}
+
+// textField is a field of a text search weighted like in the MongoDB text
+// index of the collection.
+type textField struct {
+	weight float64
+	values []string
+}
+
+// textScore scores a document for a text search query in the manner of
+// MongoDB: a term found in a field adds the field weight, more for terms
+// making up a larger part of a short field. Terms are matched ignoring case
+// and plural endings, common English stop words are ignored. A document
+// matching no term scores 0.
+func textScore(query string, fields ...textField) float64 {
+	terms := map[string]bool{}
+	for _, term := range textTerms(query) {
+		terms[term] = true
+	}
+
+	score := 0.0
+	for _, field := range fields {
+		for _, value := range field.values {
+			tokens := textTerms(value)
+			counts := map[string]int{}
+			for _, token := range tokens {
+				if terms[token] {
+					counts[token]++
+				}
+			}
+			for _, count := range counts {
+				score += field.weight * (0.5*float64(count)/float64(len(tokens)) + 0.5)
+			}
+		}
+	}
+
+	return score
+}
+
+var textStopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "at": true, "by": true, "for": true, "from": true,
+	"in": true, "is": true, "of": true, "on": true, "or": true, "the": true, "to": true, "with": true,
+}
+
+func textTerms(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		if textStopWords[word] {
+			continue
+		}
+		if len(word) > 3 && strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss") {
+			word = strings.TrimSuffix(word, "s")
+		}
+		terms = append(terms, word)
+	}
+
+	return terms
+}
+
+// embeddedTransactionRunner runs fn in a transaction of the embedded
+// database. It holds the database lock for writing meanwhile, so
+// transactions run one after the other and nobody reads their writes
+// before they are committed.
+type embeddedTransactionRunner struct {
+	database domain.EmbeddedDatabase
+}
+
+func (runner embeddedTransactionRunner) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if embeddedTransactionFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	lock := embeddedLock(runner.database)
+	lock.Lock()
+	defer lock.Unlock()
+
+	databaseTransaction, err := runner.database.Begin()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+
+	transaction := &embeddedTransaction{EmbeddedTransaction: databaseTransaction}
+	if err := fn(context.WithValue(ctx, embeddedTransactionKey{}, transaction)); err != nil {
+		transaction.rollback()
+		if rollbackErr := databaseTransaction.Rollback(); rollbackErr != nil {
+			log.Err(rollbackErr).Msg("failed to roll back embedded transaction")
+		}
+		return err
+	}
+
+	if err := databaseTransaction.Commit(); err != nil {
+		transaction.rollback()
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+
+	return nil
+}
+
+func NewEmbeddedTransactionRunner(database domain.EmbeddedDatabase) (domain.TransactionRunner, error) {
+	if database == nil {
+		return nil, errors.New("database cannot be nil")
+	}
+
+	return embeddedTransactionRunner{database: database}, nil
+}
@@ -0,0 +1,437 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	"dough-calculator/internal/test"
+)
+
+// newEmptyEmbeddedDatabase returns a database without documents accepting
+// every write, the collections keep the written documents themselves.
+func newEmptyEmbeddedDatabase(ctrl *gomock.Controller) *mocks.MockEmbeddedDatabase {
+	database := mocks.NewMockEmbeddedDatabase(ctrl)
+	database.EXPECT().Load(gomock.Any()).Return(map[uuid.UUID][]byte{}, nil).AnyTimes()
+	database.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	database.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return database
+}
+
+func TestEmbeddedCollectionTestSuite(t *testing.T) {
+	suite.Run(t, new(EmbeddedCollectionTestSuite))
+}
+
+type EmbeddedCollectionTestSuite struct {
+	test.GoMockTestSuite
+
+	database *mocks.MockEmbeddedDatabase
+
+	target *embeddedCollection[domain.FlourEntity]
+}
+
+func (suite *EmbeddedCollectionTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.database = mocks.NewMockEmbeddedDatabase(suite.MockCtrl)
+	suite.database.EXPECT().Load(FlourCollection).Return(map[uuid.UUID][]byte{}, nil)
+
+	suite.target = test.Must(func() (*embeddedCollection[domain.FlourEntity], error) {
+		return newEmbeddedCollection(suite.database, FlourCollection, flourId,
+			uniqueIndex[domain.FlourEntity]{name: "name_1", key: func(flour domain.FlourEntity) string { return flour.Name }},
+		)
+	})
+}
+
+func (suite *EmbeddedCollectionTestSuite) TestNewEmbeddedCollection_WithStoredDocuments() {
+	flour := domain.FlourEntity{Id: test.FirstId, Name: "Rye"}
+	raw, err := bson.Marshal(flour)
+	suite.Require().NoError(err)
+
+	database := mocks.NewMockEmbeddedDatabase(suite.MockCtrl)
+	database.EXPECT().Load(FlourCollection).Return(map[uuid.UUID][]byte{test.FirstId: raw}, nil)
+
+	target, err := newEmbeddedCollection(database, FlourCollection, flourId,
+		uniqueIndex[domain.FlourEntity]{name: "name_1", key: func(flour domain.FlourEntity) string { return flour.Name }},
+	)
+
+	suite.NoError(err)
+	actual, err := target.get(context.Background(), test.FirstId)
+	suite.NoError(err)
+	suite.Equal(flour, actual)
+	suite.True(mongo.IsDuplicateKeyError(target.insert(context.Background(), domain.FlourEntity{Id: test.SecondId, Name: "Rye"})))
+}
+
+func (suite *EmbeddedCollectionTestSuite) TestNewEmbeddedCollection_WithError() {
+	database := mocks.NewMockEmbeddedDatabase(suite.MockCtrl)
+	database.EXPECT().Load(FlourCollection).Return(nil, assert.AnError)
+
+	target, err := newEmbeddedCollection(database, FlourCollection, flourId)
+
+	suite.ErrorContains(err, "failed to load collection flour")
+	suite.Nil(target)
+}
+
+func (suite *EmbeddedCollectionTestSuite) TestNewEmbeddedCollection_WithNilDatabase() {
+	target, err := newEmbeddedCollection[domain.FlourEntity](nil, FlourCollection, flourId)
+
+	suite.ErrorContains(err, "database cannot be nil")
+	suite.Nil(target)
+}
+
+func (suite *EmbeddedCollectionTestSuite) TestInsert() {
+	flour := domain.FlourEntity{Id: test.FirstId, Name: "Rye"}
+	suite.database.EXPECT().Put(FlourCollection, gomock.Len(1)).Return(nil)
+
+	err := suite.target.insert(context.Background(), flour)
+
+	suite.NoError(err)
+	actual, err := suite.target.get(context.Background(), test.FirstId)
+	suite.NoError(err)
+	suite.Equal(flour, actual)
+}
+
+func (suite *EmbeddedCollectionTestSuite) TestInsert_WithDuplicateKey() {
+	suite.database.EXPECT().Put(FlourCollection, gomock.Any()).Return(nil)
+	suite.Require().NoError(suite.target.insert(context.Background(), domain.FlourEntity{Id: test.FirstId, Name: "Rye"}))
+
+	suite.True(mongo.IsDuplicateKeyError(suite.target.insert(context.Background(), domain.FlourEntity{Id: test.FirstId, Name: "Spelt"})))
+	suite.True(mongo.IsDuplicateKeyError(suite.target.insert(context.Background(), domain.FlourEntity{Id: test.SecondId, Name: "Rye"})))
+}
+
+func (suite *EmbeddedCollectionTestSuite) TestInsert_WithError() {
+	suite.database.EXPECT().Put(FlourCollection, gomock.Any()).Return(assert.AnError)
+
+	err := suite.target.insert(context.Background(), domain.FlourEntity{Id: test.FirstId, Name: "Rye"})
+
+	suite.ErrorIs(err, assert.AnError)
+	_, err = suite.target.get(context.Background(), test.FirstId)
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+}
+
+func (suite *EmbeddedCollectionTestSuite) TestReplace() {
+	suite.database.EXPECT().Put(FlourCollection, gomock.Any()).Return(nil).Times(2)
+	suite.Require().NoError(suite.target.insert(context.Background(), domain.FlourEntity{Id: test.FirstId, Name: "Rye"}))
+
+	err := suite.target.replace(context.Background(), domain.FlourEntity{Id: test.FirstId, Name: "Spelt"})
+
+	suite.NoError(err)
+	// the old name is free again
+	suite.database.EXPECT().Put(FlourCollection, gomock.Any()).Return(nil)
+	suite.NoError(suite.target.insert(context.Background(), domain.FlourEntity{Id: test.SecondId, Name: "Rye"}))
+}
+
+func (suite *EmbeddedCollectionTestSuite) TestReplace_WithMissingDocument() {
+	err := suite.target.replace(context.Background(), domain.FlourEntity{Id: test.FirstId, Name: "Rye"})
+
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+}
+
+func (suite *EmbeddedCollectionTestSuite) TestUpdate() {
+	suite.database.EXPECT().Put(FlourCollection, gomock.Any()).Return(nil).Times(2)
+	suite.Require().NoError(suite.target.insert(context.Background(), domain.FlourEntity{Id: test.FirstId, Name: "Rye", FlourType: "rye"}))
+	suite.Require().NoError(suite.target.insert(context.Background(), domain.FlourEntity{Id: test.SecondId, Name: "Spelt", FlourType: "spelt"}))
+	suite.database.EXPECT().Put(FlourCollection, gomock.Len(1)).Return(nil)
+
+	count, err := suite.target.update(context.Background(), func(flour domain.FlourEntity) bool {
+		return flour.FlourType == "rye"
+	}, func(flour *domain.FlourEntity) {
+		flour.Description = "dark"
+	})
+
+	suite.NoError(err)
+	suite.Equal(1, count)
+	actual, err := suite.target.get(context.Background(), test.FirstId)
+	suite.NoError(err)
+	suite.Equal("dark", actual.Description)
+}
+
+func (suite *EmbeddedCollectionTestSuite) TestDelete() {
+	suite.database.EXPECT().Put(FlourCollection, gomock.Any()).Return(nil)
+	suite.database.EXPECT().Delete(FlourCollection, test.FirstId).Return(nil)
+	suite.Require().NoError(suite.target.insert(context.Background(), domain.FlourEntity{Id: test.FirstId, Name: "Rye"}))
+
+	suite.NoError(suite.target.delete(context.Background(), test.FirstId))
+
+	suite.ErrorIs(suite.target.delete(context.Background(), test.FirstId), mongo.ErrNoDocuments)
+	_, err := suite.target.get(context.Background(), test.FirstId)
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+}
+
+func (suite *EmbeddedCollectionTestSuite) TestFind() {
+	suite.database.EXPECT().Put(FlourCollection, gomock.Any()).Return(nil).Times(3)
+	for _, id := range []uuid.UUID{test.FirstId, test.SecondId, test.ThirdId} {
+		suite.Require().NoError(suite.target.insert(context.Background(), domain.FlourEntity{Id: id, Name: id.String()}))
+	}
+
+	actual, err := suite.target.find(context.Background(), func(flour domain.FlourEntity) bool { return flour.Id != test.SecondId })
+
+	suite.NoError(err)
+	suite.Equal([]uuid.UUID{test.ThirdId, test.FirstId}, []uuid.UUID{actual[0].Id, actual[1].Id})
+
+	_, err = suite.target.findOne(context.Background(), func(flour domain.FlourEntity) bool { return false })
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+}
+
+func TestEmbeddedPage(t *testing.T) {
+	// ThirdId < FirstId < SecondId in byte order
+	items := []domain.FlourEntity{
+		{Id: test.FirstId, Name: "b"},
+		{Id: test.SecondId, Name: "b"},
+		{Id: test.ThirdId, Name: "a"},
+	}
+	ids := func(flours []domain.FlourEntity) []uuid.UUID {
+		result := make([]uuid.UUID, len(flours))
+		for i, flour := range flours {
+			result[i] = flour.Id
+		}
+		return result
+	}
+
+	tests := []struct {
+		name     string
+		page     domain.PageRequest
+		expected []uuid.UUID
+		info     domain.PageInfo
+	}{
+		{
+			name:     "first page",
+			page:     domain.PageRequest{SortField: "name", Limit: 2},
+			expected: []uuid.UUID{test.ThirdId, test.FirstId},
+			info:     domain.PageInfo{HasNext: true},
+		},
+		{
+			name:     "after",
+			page:     domain.PageRequest{SortField: "name", Limit: 2, After: &domain.PageKey{Value: "b", Id: test.FirstId}},
+			expected: []uuid.UUID{test.SecondId},
+			info:     domain.PageInfo{HasPrev: true},
+		},
+		{
+			name:     "before",
+			page:     domain.PageRequest{SortField: "name", Limit: 1, Before: &domain.PageKey{Value: "b", Id: test.SecondId}},
+			expected: []uuid.UUID{test.FirstId},
+			info:     domain.PageInfo{HasNext: true, HasPrev: true},
+		},
+		{
+			name:     "descending with offset",
+			page:     domain.PageRequest{SortField: "name", Descending: true, Offset: 1, Limit: 5},
+			expected: []uuid.UUID{test.FirstId, test.ThirdId},
+			info:     domain.PageInfo{HasPrev: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, info := embeddedPage(append([]domain.FlourEntity(nil), items...), tt.page, flourSortKeys["name"], flourId)
+
+			assert.Equal(t, tt.expected, ids(actual))
+			assert.Equal(t, tt.info, info)
+		})
+	}
+}
+
+func TestCompareValues(t *testing.T) {
+	assert.Equal(t, 0, compareValues(nil, nil))
+	assert.Equal(t, -1, compareValues(nil, 1.0))
+	assert.Equal(t, 1, compareValues("a", nil))
+	assert.Equal(t, -1, compareValues(1.0, 2.0))
+	assert.Equal(t, 1, compareValues("b", "a"))
+	assert.Equal(t, -1, compareValues(test.Date, test.Date.Add(1)))
+}
+
+func TestTextScore(t *testing.T) {
+	assert.Equal(t, 0.0, textScore("spelt", textField{weight: 10, values: []string{"Whole Rye"}}))
+	assert.Equal(t, 10.0, textScore("the loaves", textField{weight: 10, values: []string{"Loaves"}}))
+	assert.Greater(t,
+		textScore("rye", textField{weight: 10, values: []string{"Rye"}}),
+		textScore("rye", textField{weight: 10, values: []string{"Light rye blend"}}),
+	)
+	assert.Equal(t, []string{"whole", "wheat", "flour", "mill"}, textTerms("Whole-wheat flours of the mill"))
+}
+
+func TestNewEmbeddedTransactionRunner(t *testing.T) {
+	runner, err := NewEmbeddedTransactionRunner(mocks.NewMockEmbeddedDatabase(gomock.NewController(t)))
+	assert.NoError(t, err)
+	assert.NotNil(t, runner)
+
+	_, err = NewEmbeddedTransactionRunner(nil)
+	assert.ErrorContains(t, err, "database cannot be nil")
+}
+
+func TestEmbeddedTransactionRunnerTestSuite(t *testing.T) {
+	suite.Run(t, new(EmbeddedTransactionRunnerTestSuite))
+}
+
+type EmbeddedTransactionRunnerTestSuite struct {
+	test.GoMockTestSuite
+
+	database    *mocks.MockEmbeddedDatabase
+	transaction *mocks.MockEmbeddedTransaction
+	collection  *embeddedCollection[domain.FlourEntity]
+
+	target domain.TransactionRunner
+}
+
+func (suite *EmbeddedTransactionRunnerTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.database = mocks.NewMockEmbeddedDatabase(suite.MockCtrl)
+	suite.database.EXPECT().Load(FlourCollection).Return(map[uuid.UUID][]byte{}, nil)
+	suite.transaction = mocks.NewMockEmbeddedTransaction(suite.MockCtrl)
+
+	suite.collection = test.Must(func() (*embeddedCollection[domain.FlourEntity], error) {
+		return newEmbeddedCollection(suite.database, FlourCollection, flourId,
+			uniqueIndex[domain.FlourEntity]{name: "name_1", key: func(flour domain.FlourEntity) string { return flour.Name }},
+		)
+	})
+	suite.target = test.Must(func() (domain.TransactionRunner, error) {
+		return NewEmbeddedTransactionRunner(suite.database)
+	})
+}
+
+func (suite *EmbeddedTransactionRunnerTestSuite) TestWithTransaction() {
+	suite.database.EXPECT().Begin().Return(suite.transaction, nil)
+	suite.transaction.EXPECT().Put(FlourCollection, gomock.Len(1)).Return(nil).Times(2)
+	suite.transaction.EXPECT().Delete(FlourCollection, test.FirstId).Return(nil)
+	suite.transaction.EXPECT().Commit().Return(nil)
+
+	err := suite.target.WithTransaction(context.Background(), func(ctx context.Context) error {
+		suite.Require().NoError(suite.collection.insert(ctx, domain.FlourEntity{Id: test.FirstId, Name: "Rye"}))
+		suite.Require().NoError(suite.collection.insert(ctx, domain.FlourEntity{Id: test.SecondId, Name: "Spelt"}))
+		// the transaction reads its own writes
+		return suite.collection.delete(ctx, test.FirstId)
+	})
+
+	suite.NoError(err)
+	_, err = suite.collection.get(context.Background(), test.FirstId)
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+	actual, err := suite.collection.get(context.Background(), test.SecondId)
+	suite.NoError(err)
+	suite.Equal("Spelt", actual.Name)
+}
+
+func (suite *EmbeddedTransactionRunnerTestSuite) TestWithTransaction_WithErrorOnFn() {
+	suite.database.EXPECT().Put(FlourCollection, gomock.Any()).Return(nil)
+	suite.Require().NoError(suite.collection.insert(context.Background(), domain.FlourEntity{Id: test.FirstId, Name: "Rye"}))
+
+	suite.database.EXPECT().Begin().Return(suite.transaction, nil)
+	suite.transaction.EXPECT().Put(FlourCollection, gomock.Any()).Return(nil).Times(2)
+	suite.transaction.EXPECT().Delete(FlourCollection, test.FirstId).Return(nil)
+	suite.transaction.EXPECT().Rollback().Return(nil)
+
+	err := suite.target.WithTransaction(context.Background(), func(ctx context.Context) error {
+		suite.Require().NoError(suite.collection.replace(ctx, domain.FlourEntity{Id: test.FirstId, Name: "Dark rye"}))
+		suite.Require().NoError(suite.collection.insert(ctx, domain.FlourEntity{Id: test.SecondId, Name: "Rye"}))
+		suite.Require().NoError(suite.collection.delete(ctx, test.FirstId))
+		return assert.AnError
+	})
+
+	suite.ErrorIs(err, assert.AnError)
+	actual, err := suite.collection.find(context.Background(), nil)
+	suite.NoError(err)
+	suite.Equal([]domain.FlourEntity{{Id: test.FirstId, Name: "Rye"}}, actual)
+	// the name taken by the rolled back insert is free again
+	suite.True(mongo.IsDuplicateKeyError(
+		suite.collection.insert(context.Background(), domain.FlourEntity{Id: test.SecondId, Name: "Rye"})))
+}
+
+func (suite *EmbeddedTransactionRunnerTestSuite) TestWithTransaction_WithErrorOnCommit() {
+	suite.database.EXPECT().Begin().Return(suite.transaction, nil)
+	suite.transaction.EXPECT().Put(FlourCollection, gomock.Any()).Return(nil)
+	suite.transaction.EXPECT().Commit().Return(assert.AnError)
+
+	err := suite.target.WithTransaction(context.Background(), func(ctx context.Context) error {
+		return suite.collection.insert(ctx, domain.FlourEntity{Id: test.FirstId, Name: "Rye"})
+	})
+
+	suite.ErrorIs(err, assert.AnError)
+	_, err = suite.collection.get(context.Background(), test.FirstId)
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+}
+
+func (suite *EmbeddedTransactionRunnerTestSuite) TestWithTransaction_WithErrorOnBegin() {
+	suite.database.EXPECT().Begin().Return(nil, assert.AnError)
+
+	err := suite.target.WithTransaction(context.Background(), func(context.Context) error {
+		suite.Fail("fn must not run without a transaction")
+		return nil
+	})
+
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func (suite *EmbeddedTransactionRunnerTestSuite) TestWithTransaction_WithRunningTransaction() {
+	suite.database.EXPECT().Begin().Return(suite.transaction, nil)
+	suite.transaction.EXPECT().Commit().Return(nil)
+
+	err := suite.target.WithTransaction(context.Background(), func(ctx context.Context) error {
+		return suite.target.WithTransaction(ctx, func(actual context.Context) error {
+			suite.Equal(ctx, actual)
+			return nil
+		})
+	})
+
+	suite.NoError(err)
+}
+
+// TestWithTransaction_WithConcurrentCreates creates two recipes of the same
+// name, each after checking that the name is free. The transactions run one
+// after the other, so the second one sees the recipe of the first one.
+func (suite *EmbeddedTransactionRunnerTestSuite) TestWithTransaction_WithConcurrentCreates() {
+	database := newEmptyEmbeddedDatabase(suite.MockCtrl)
+	database.EXPECT().Begin().Return(suite.transaction, nil).Times(2)
+	suite.transaction.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	suite.transaction.EXPECT().Commit().Return(nil).AnyTimes()
+	suite.transaction.EXPECT().Rollback().Return(nil).AnyTimes()
+
+	repository := test.Must(func() (domain.SourdoughRecipeRepository, error) {
+		return NewEmbeddedSourdoughRecipeRepository(database)
+	})
+	target := test.Must(func() (domain.TransactionRunner, error) {
+		return NewEmbeddedTransactionRunner(database)
+	})
+	errNameTaken := errors.New("name taken")
+
+	var waitGroup sync.WaitGroup
+	results := make(chan error, 2)
+	for _, id := range []uuid.UUID{test.FirstId, test.SecondId} {
+		waitGroup.Add(1)
+		go func(id uuid.UUID) {
+			defer waitGroup.Done()
+			results <- target.WithTransaction(context.Background(), func(ctx context.Context) error {
+				if _, err := repository.GetByName(ctx, "Country loaf"); !errors.Is(err, mongo.ErrNoDocuments) {
+					return errNameTaken
+				}
+				// give the other transaction time to check the name as well
+				time.Sleep(10 * time.Millisecond)
+				_, err := repository.Create(ctx, domain.SourdoughRecipeEntity{
+					RecipeEntity: domain.RecipeEntity{Id: id, Name: "Country loaf"},
+				})
+				return err
+			})
+		}(id)
+	}
+	waitGroup.Wait()
+	close(results)
+
+	var errs []error
+	for err := range results {
+		errs = append(errs, err)
+	}
+	suite.ElementsMatch([]error{nil, errNameTaken}, errs)
+	recipes, err := repository.Find(context.Background(), domain.SourdoughRecipeFilter{},
+		domain.PageRequest{SortField: "name", Limit: 10})
+	suite.NoError(err)
+	suite.Len(recipes.Recipes, 1)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"regexp"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
@@ -15,7 +16,6 @@ import (
 )
 
 const (
-	FlourDatabase   = "dough-calculator"
 	FlourCollection = "flour"
 )
 
@@ -42,6 +42,28 @@ func (repository *flourRepository) Create(ctx context.Context, flour domain.Flou
 	return flour, nil
 }
 
+// Update replaces the stored flour of the same id.
+func (repository *flourRepository) Update(ctx context.Context, flour domain.FlourEntity) (entity domain.FlourEntity, err error) {
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	result, err := collection.ReplaceOne(ctx, bson.D{{"_id", flour.Id}}, flour)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Stringer("id", flour.Id).
+			Msg("failed to update flour")
+		return entity, errors.Wrap(err, "failed to update flour")
+	}
+	if result.MatchedCount == 0 {
+		return entity, mongo.ErrNoDocuments
+	}
+
+	return flour, nil
+}
+
 func (repository *flourRepository) FindById(ctx context.Context, id uuid.UUID) (entity domain.FlourEntity, err error) {
 	collection, err := repository.getCollection()
 	if err != nil {
@@ -62,12 +84,62 @@ func (repository *flourRepository) FindById(ctx context.Context, id uuid.UUID) (
 	return entity, nil
 }
 
-func (repository *flourRepository) Find(ctx context.Context, offset, limit int) (result []domain.FlourEntity, err error) {
+// GetByName returns the flour whose name matches ignoring case.
+func (repository *flourRepository) GetByName(ctx context.Context, name string) (entity domain.FlourEntity, err error) {
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	err = collection.
+		FindOne(ctx, bson.D{{
+			"name", bson.D{{
+				"$regex", primitive.Regex{Pattern: "^" + regexp.QuoteMeta(name) + "$", Options: "i"},
+			}},
+		}}).
+		Decode(&entity)
+	if err != nil {
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			log.Error().
+				Err(err).
+				Str("name", name).
+				Msg("failed to get flour by name")
+		}
+		return entity, errors.Wrap(err, "failed to get flour by name")
+	}
+
+	return entity, nil
+}
+
+// FindAll returns the whole catalogue ordered by name.
+func (repository *flourRepository) FindAll(ctx context.Context) (result []domain.FlourEntity, err error) {
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	cursor, err := collection.Find(ctx, bson.D{}, options.Find().SetSort(bson.D{{"name", 1}, {"_id", 1}}))
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("failed to find all flours")
+		return nil, errors.Wrap(err, "failed to find flours")
+	}
+
+	result = []domain.FlourEntity{}
+	if err = cursor.All(ctx, &result); err != nil {
+		return nil, errors.Wrap(err, "failed to decode flours")
+	}
+
+	return result, nil
+}
+
+func (repository *flourRepository) Find(ctx context.Context, filter domain.FlourFilter, page domain.PageRequest) (result domain.FlourPage, err error) {
 	defer func() {
 		if err != nil {
 			log.Error().
 				Err(err).
-				Msg("failed to find all recipes")
+				Msg("failed to find all flours")
 		}
 	}()
 
@@ -76,16 +148,55 @@ func (repository *flourRepository) Find(ctx context.Context, offset, limit int)
 		return
 	}
 
-	cursor, err := collection.Find(ctx, bson.D{}, options.Find().
-		SetLimit(int64(limit)).
-		SetSkip(int64(offset)).
-		SetSort(bson.D{{"created_at", -1}}))
+	query := flourFilterQuery(filter)
+
+	pageFilter, opts := pageQuery(query, page)
+	cursor, err := collection.Find(ctx, pageFilter, opts)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to find recipes")
+		return domain.FlourPage{}, errors.Wrap(err, "failed to find flours")
 	}
 
-	if err = cursor.All(ctx, &result); err != nil {
-		return nil, errors.Wrap(err, "failed to decode recipes")
+	var flours []domain.FlourEntity
+	if err = cursor.All(ctx, &flours); err != nil {
+		return domain.FlourPage{}, errors.Wrap(err, "failed to decode flours")
+	}
+	result.Flours, result.Page = pageResult(flours, page)
+
+	result.Page.Total, err = collection.CountDocuments(ctx, query)
+	if err != nil {
+		return domain.FlourPage{}, errors.Wrap(err, "failed to count flours")
+	}
+
+	return
+}
+
+// flourFilterQuery translates a flour filter into a MongoDB query document.
+func flourFilterQuery(filter domain.FlourFilter) bson.D {
+	query := bson.D{}
+
+	if len(filter.FlourTypes) > 0 {
+		query = append(query, bson.E{Key: "flourtype", Value: bson.D{{"$in", filter.FlourTypes}}})
+	}
+	if bounds := rangeQuery(filter.MinProtein, filter.MaxProtein); len(bounds) > 0 {
+		query = append(query, bson.E{Key: "protein_content", Value: bounds})
+	}
+
+	return query
+}
+
+func (repository *flourRepository) CountByType(ctx context.Context, flourType string) (count int64, err error) {
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	count, err = collection.CountDocuments(ctx, bson.D{{"flourtype", flourType}})
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("flour_type", flourType).
+			Msg("failed to count flours by type")
+		return 0, errors.Wrap(err, "failed to count flours")
 	}
 
 	return
@@ -108,7 +219,7 @@ func (repository *flourRepository) SearchByName(ctx context.Context, name string
 
 	cur, err := collection.Find(ctx, bson.D{{
 		"name", bson.D{{
-			"$regex", primitive.Regex{Pattern: name, Options: "i"},
+			"$regex", primitive.Regex{Pattern: "^" + regexp.QuoteMeta(name), Options: "i"},
 		}},
 	}})
 
@@ -125,12 +236,50 @@ func (repository *flourRepository) SearchByName(ctx context.Context, name string
 	return
 }
 
+func (repository *flourRepository) TextSearch(ctx context.Context, query string, offset, limit int) (result domain.FlourTextSearchResult, err error) {
+	defer func() {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("query", query).
+				Msg("failed to search flours by text")
+		}
+	}()
+
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	filter := bson.D{{"$text", bson.D{{"$search", query}}}}
+	score := bson.D{{"$meta", "textScore"}}
+
+	cursor, err := collection.Find(ctx, filter, options.Find().
+		SetProjection(bson.D{{"score", score}}).
+		SetSort(bson.D{{"score", score}, {"_id", 1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit)))
+	if err != nil {
+		return domain.FlourTextSearchResult{}, errors.Wrap(err, "failed to search flours")
+	}
+
+	if err = cursor.All(ctx, &result.Hits); err != nil {
+		return domain.FlourTextSearchResult{}, errors.Wrap(err, "failed to decode flours")
+	}
+
+	result.Total, err = collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return domain.FlourTextSearchResult{}, errors.Wrap(err, "failed to count flours")
+	}
+
+	return
+}
+
 func (repository *flourRepository) getCollection() (*mongo.Collection, error) {
-	collection, err := repository.mongoDBService.GetCollection(FlourDatabase, FlourCollection)
+	collection, err := repository.mongoDBService.GetCollection(FlourCollection)
 	if err != nil {
 		log.Error().
 			Err(err).
-			Str("database", FlourDatabase).
 			Str("collection", FlourCollection).
 			Msg("failed to get collection")
 		return nil, errors.Wrap(err, "failed to get collection")
@@ -143,21 +292,6 @@ func NewFlourRepository(mongoDBService domain.MongoDBService) (domain.FlourRepos
 		return nil, errors.New("service cannot be nil")
 	}
 
-	collection, err := mongoDBService.GetCollection(FlourDatabase, FlourCollection)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get collection")
-	}
-
-	_, err = collection.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
-		{
-			Keys:    bson.D{{"name", 1}},
-			Options: options.Index().SetUnique(true),
-		},
-	})
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to create index")
-	}
-
 	return &flourRepository{
 		mongoDBService: mongoDBService,
 	}, nil
@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/domain"
+)
+
+// flourSortKeys give the values flours are paged by, nil where MongoDB
+// would find the field missing.
+var flourSortKeys = map[string]func(flour domain.FlourEntity) any{
+	"created_at": func(flour domain.FlourEntity) any {
+		if flour.CreatedAt.IsZero() {
+			return nil
+		}
+		return flour.CreatedAt
+	},
+	"name": func(flour domain.FlourEntity) any {
+		return flour.Name
+	},
+	"protein_content": func(flour domain.FlourEntity) any {
+		if flour.ProteinContent == 0 {
+			return nil
+		}
+		return flour.ProteinContent
+	},
+}
+
+// embeddedFlourRepository keeps the flour catalogue in an embedded
+// database, with the same unique flour names as the MongoDB repository.
+type embeddedFlourRepository struct {
+	collection *embeddedCollection[domain.FlourEntity]
+}
+
+func (repository *embeddedFlourRepository) Create(ctx context.Context, flour domain.FlourEntity) (domain.FlourEntity, error) {
+	if err := repository.collection.insert(ctx, flour); err != nil {
+		return domain.FlourEntity{}, errors.Wrap(err, "failed to insert flour")
+	}
+	return flour, nil
+}
+
+func (repository *embeddedFlourRepository) Update(ctx context.Context, flour domain.FlourEntity) (domain.FlourEntity, error) {
+	if err := repository.collection.replace(ctx, flour); err != nil {
+		return domain.FlourEntity{}, errors.Wrap(err, "failed to update flour")
+	}
+	return flour, nil
+}
+
+func (repository *embeddedFlourRepository) FindById(ctx context.Context, id uuid.UUID) (domain.FlourEntity, error) {
+	flour, err := repository.collection.get(ctx, id)
+	return flour, errors.Wrap(err, "failed to get flour by id")
+}
+
+// GetByName returns the flour whose name matches ignoring case.
+func (repository *embeddedFlourRepository) GetByName(ctx context.Context, name string) (domain.FlourEntity, error) {
+	flour, err := repository.collection.findOne(ctx, func(flour domain.FlourEntity) bool {
+		return strings.EqualFold(flour.Name, name)
+	})
+	return flour, errors.Wrap(err, "failed to get flour by name")
+}
+
+// FindAll returns the whole catalogue ordered by name.
+func (repository *embeddedFlourRepository) FindAll(ctx context.Context) ([]domain.FlourEntity, error) {
+	flours, err := repository.collection.find(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find flours")
+	}
+
+	sortByName(flours, func(flour domain.FlourEntity) string { return flour.Name })
+
+	return flours, nil
+}
+
+func (repository *embeddedFlourRepository) Find(ctx context.Context, filter domain.FlourFilter, page domain.PageRequest) (domain.FlourPage, error) {
+	sortKey, ok := flourSortKeys[page.SortField]
+	if !ok {
+		return domain.FlourPage{}, errors.Errorf("unsupported sort field '%s'", page.SortField)
+	}
+
+	flours, err := repository.collection.find(ctx, flourFilterMatch(filter))
+	if err != nil {
+		return domain.FlourPage{}, errors.Wrap(err, "failed to find flours")
+	}
+
+	var result domain.FlourPage
+	result.Flours, result.Page = embeddedPage(flours, page, sortKey, flourId)
+	result.Page.Total = int64(len(flours))
+
+	return result, nil
+}
+
+// flourFilterMatch matches the flours flourFilterQuery selects in MongoDB,
+// where a range leaves out flours without a protein content.
+func flourFilterMatch(filter domain.FlourFilter) func(flour domain.FlourEntity) bool {
+	return func(flour domain.FlourEntity) bool {
+		if len(filter.FlourTypes) > 0 && !slices.Contains(filter.FlourTypes, flour.FlourType) {
+			return false
+		}
+		if filter.MinProtein != nil || filter.MaxProtein != nil {
+			return flour.ProteinContent != 0 && inRange(flour.ProteinContent, filter.MinProtein, filter.MaxProtein)
+		}
+		return true
+	}
+}
+
+func (repository *embeddedFlourRepository) SearchByName(ctx context.Context, name string) ([]domain.FlourEntity, error) {
+	prefix := strings.ToLower(name)
+
+	flours, err := repository.collection.find(ctx, func(flour domain.FlourEntity) bool {
+		return strings.HasPrefix(strings.ToLower(flour.Name), prefix)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find flour")
+	}
+
+	sortByName(flours, func(flour domain.FlourEntity) string { return flour.Name })
+
+	return flours, nil
+}
+
+func (repository *embeddedFlourRepository) TextSearch(ctx context.Context, query string, offset, limit int) (domain.FlourTextSearchResult, error) {
+	flours, err := repository.collection.find(ctx, nil)
+	if err != nil {
+		return domain.FlourTextSearchResult{}, errors.Wrap(err, "failed to search flours")
+	}
+
+	hits := []domain.FlourTextSearchHit{}
+	for _, flour := range flours {
+		score := textScore(query,
+			textField{weight: 10, values: []string{flour.Name}},
+			textField{weight: 5, values: []string{flour.FlourType}},
+			textField{weight: 1, values: []string{flour.Description}},
+		)
+		if score > 0 {
+			hits = append(hits, domain.FlourTextSearchHit{Flour: flour, Score: score})
+		}
+	}
+
+	// flours come in id order, a stable sort keeps it for equal scores
+	sort.SliceStable(hits, func(i, j int) bool { return hits[i].Score > hits[j].Score })
+
+	return domain.FlourTextSearchResult{
+		Hits:  window(hits, offset, limit),
+		Total: int64(len(hits)),
+	}, nil
+}
+
+func (repository *embeddedFlourRepository) CountByType(ctx context.Context, flourType string) (int64, error) {
+	count, err := repository.collection.count(ctx, func(flour domain.FlourEntity) bool {
+		return flour.FlourType == flourType
+	})
+	return count, errors.Wrap(err, "failed to count flours")
+}
+
+func flourId(flour domain.FlourEntity) uuid.UUID {
+	return flour.Id
+}
+
+// sortByName orders items by name and then by id, like the MongoDB
+// repositories sort their lists; items are expected in id order.
+func sortByName[T any](items []T, name func(item T) string) {
+	sort.SliceStable(items, func(i, j int) bool { return name(items[i]) < name(items[j]) })
+}
+
+// inRange reports whether value lies within the inclusive bounds, nil
+// bounds are open.
+func inRange[T float64 | int](value T, lower, upper *T) bool {
+	return (lower == nil || value >= *lower) && (upper == nil || value <= *upper)
+}
+
+// window returns up to limit items after skipping offset, a limit of 0 or
+// less returns every remaining item.
+func window[T any](items []T, offset, limit int) []T {
+	if offset >= len(items) {
+		return []T{}
+	}
+	items = items[max(offset, 0):]
+	if limit > 0 && limit < len(items) {
+		items = items[:limit]
+	}
+	return items
+}
+
+func NewEmbeddedFlourRepository(database domain.EmbeddedDatabase) (domain.FlourRepository, error) {
+	collection, err := newEmbeddedCollection(database, FlourCollection, flourId,
+		uniqueIndex[domain.FlourEntity]{name: "name_1", key: func(flour domain.FlourEntity) string { return flour.Name }},
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create collection")
+	}
+
+	return &embeddedFlourRepository{collection: collection}, nil
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/test"
+	"dough-calculator/internal/utils"
+)
+
+func TestEmbeddedFlourRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(EmbeddedFlourRepositoryTestSuite))
+}
+
+type EmbeddedFlourRepositoryTestSuite struct {
+	test.GoMockTestSuite
+
+	target domain.FlourRepository
+}
+
+func (suite *EmbeddedFlourRepositoryTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.target = test.Must(func() (domain.FlourRepository, error) {
+		return NewEmbeddedFlourRepository(newEmptyEmbeddedDatabase(suite.MockCtrl))
+	})
+
+	for _, flour := range []domain.FlourEntity{
+		{Id: test.FirstId, Name: "Whole Rye", FlourType: "rye", ProteinContent: 9},
+		{Id: test.SecondId, Name: "Bread Flour", FlourType: "wheat", ProteinContent: 13, Description: "strong rye-free flour"},
+		{Id: test.ThirdId, Name: "Spelt", FlourType: "spelt"},
+	} {
+		_, err := suite.target.Create(context.Background(), flour)
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *EmbeddedFlourRepositoryTestSuite) TestCreate_WithDuplicateName() {
+	_, err := suite.target.Create(context.Background(), domain.FlourEntity{Id: uuid.New(), Name: "Spelt"})
+
+	suite.True(mongo.IsDuplicateKeyError(err))
+}
+
+func (suite *EmbeddedFlourRepositoryTestSuite) TestUpdate_WithMissingFlour() {
+	_, err := suite.target.Update(context.Background(), domain.FlourEntity{Id: uuid.New(), Name: "Emmer"})
+
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+}
+
+func (suite *EmbeddedFlourRepositoryTestSuite) TestGetByName() {
+	actual, err := suite.target.GetByName(context.Background(), "whole rye")
+
+	suite.NoError(err)
+	suite.Equal(test.FirstId, actual.Id)
+}
+
+func (suite *EmbeddedFlourRepositoryTestSuite) TestFindAll() {
+	actual, err := suite.target.FindAll(context.Background())
+
+	suite.NoError(err)
+	suite.Equal([]string{"Bread Flour", "Spelt", "Whole Rye"}, utils.Map(actual, func(flour domain.FlourEntity) string { return flour.Name }))
+}
+
+func (suite *EmbeddedFlourRepositoryTestSuite) TestFind() {
+	minProtein := 5.0
+
+	actual, err := suite.target.Find(context.Background(),
+		domain.FlourFilter{MinProtein: &minProtein},
+		domain.PageRequest{SortField: "protein_content", Descending: true, Limit: 1},
+	)
+
+	suite.NoError(err)
+	suite.Equal([]uuid.UUID{test.SecondId}, utils.Map(actual.Flours, flourId))
+	suite.Equal(domain.PageInfo{Total: 2, HasNext: true}, actual.Page)
+
+	actual, err = suite.target.Find(context.Background(),
+		domain.FlourFilter{MinProtein: &minProtein},
+		domain.PageRequest{SortField: "protein_content", Descending: true, Limit: 1, After: &domain.PageKey{Value: 13.0, Id: test.SecondId}},
+	)
+
+	suite.NoError(err)
+	suite.Equal([]uuid.UUID{test.FirstId}, utils.Map(actual.Flours, flourId))
+	suite.Equal(domain.PageInfo{Total: 2, HasPrev: true}, actual.Page)
+}
+
+func (suite *EmbeddedFlourRepositoryTestSuite) TestFind_WithUnsupportedSortField() {
+	_, err := suite.target.Find(context.Background(), domain.FlourFilter{}, domain.PageRequest{SortField: "ash_content"})
+
+	suite.ErrorContains(err, "unsupported sort field 'ash_content'")
+}
+
+func (suite *EmbeddedFlourRepositoryTestSuite) TestSearchByName() {
+	actual, err := suite.target.SearchByName(context.Background(), "SPE")
+
+	suite.NoError(err)
+	suite.Equal([]uuid.UUID{test.ThirdId}, utils.Map(actual, flourId))
+}
+
+func (suite *EmbeddedFlourRepositoryTestSuite) TestTextSearch() {
+	actual, err := suite.target.TextSearch(context.Background(), "rye", 0, 10)
+
+	suite.NoError(err)
+	suite.Equal(int64(2), actual.Total)
+	// a name match outweighs a description match
+	suite.Equal(test.FirstId, actual.Hits[0].Flour.Id)
+	suite.Equal(test.SecondId, actual.Hits[1].Flour.Id)
+}
+
+func (suite *EmbeddedFlourRepositoryTestSuite) TestCountByType() {
+	actual, err := suite.target.CountByType(context.Background(), "rye")
+
+	suite.NoError(err)
+	suite.Equal(int64(1), actual)
+}
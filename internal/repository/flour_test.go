@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
 
 	"dough-calculator/internal/domain"
 	"dough-calculator/internal/domain/mocks"
@@ -22,7 +23,7 @@ type FlourRepositoryTestSuite struct {
 
 	mongoDBService *mocks.MockMongoDBService
 
-	target *sourdoughRecipeRepository
+	target *flourRepository
 }
 
 func (suite *FlourRepositoryTestSuite) SetupTest() {
@@ -30,12 +31,12 @@ func (suite *FlourRepositoryTestSuite) SetupTest() {
 
 	suite.mongoDBService = mocks.NewMockMongoDBService(suite.MockCtrl)
 
-	suite.target = &sourdoughRecipeRepository{
+	suite.target = &flourRepository{
 		mongoDBService: suite.mongoDBService,
 	}
 }
 
-func (suite *FlourRepositoryTestSuite) TestNewSourdoughRecipeRepository_WithError() {
+func (suite *FlourRepositoryTestSuite) TestNewFlourRepository_WithError() {
 	tests := []struct {
 		name           string
 		mongoDBService domain.MongoDBService
@@ -46,21 +47,11 @@ func (suite *FlourRepositoryTestSuite) TestNewSourdoughRecipeRepository_WithErro
 			mongoDBService: nil,
 			errorMsg:       "service cannot be nil",
 		},
-		{
-			name: "mongoDBService.GetCollection returns error",
-			mongoDBService: func() domain.MongoDBService {
-				suite.mongoDBService.EXPECT().GetCollection(FlourDatabase, FlourCollection).
-					Return(nil, assert.AnError)
-
-				return suite.mongoDBService
-			}(),
-			errorMsg: "failed to get collection",
-		},
 	}
 
 	for _, tt := range tests {
 		suite.Run(tt.name, func() {
-			repository, err := NewSourdoughRecipeRepository(tt.mongoDBService)
+			repository, err := NewFlourRepository(tt.mongoDBService)
 
 			suite.ErrorContains(err, tt.errorMsg)
 			suite.Nil(repository)
@@ -69,7 +60,7 @@ func (suite *FlourRepositoryTestSuite) TestNewSourdoughRecipeRepository_WithErro
 }
 
 func (suite *FlourRepositoryTestSuite) TestGetCollection_WithError() {
-	suite.mongoDBService.EXPECT().GetCollection(FlourDatabase, FlourCollection).
+	suite.mongoDBService.EXPECT().GetCollection(FlourCollection).
 		Return(nil, assert.AnError)
 
 	collection, err := suite.target.getCollection()
@@ -79,39 +70,79 @@ func (suite *FlourRepositoryTestSuite) TestGetCollection_WithError() {
 }
 
 func (suite *FlourRepositoryTestSuite) TestCreate_WithErrorOnGetCollection() {
-	suite.mongoDBService.EXPECT().GetCollection(FlourDatabase, FlourCollection).
+	suite.mongoDBService.EXPECT().GetCollection(FlourCollection).
 		Return(nil, assert.AnError)
 
-	recipe := domain.SourdoughRecipeEntity{}
+	flour := domain.FlourEntity{}
 
-	entity, err := suite.target.Create(context.Background(), recipe)
+	entity, err := suite.target.Create(context.Background(), flour)
 
 	suite.ErrorContains(err, "failed to get collection")
-	suite.Equal(domain.SourdoughRecipeEntity{}, entity)
+	suite.Equal(domain.FlourEntity{}, entity)
 }
 
 func (suite *FlourRepositoryTestSuite) TestGetById_WithErrorOnGetCollection() {
-	suite.mongoDBService.EXPECT().GetCollection(FlourDatabase, FlourCollection).
+	suite.mongoDBService.EXPECT().GetCollection(FlourCollection).
 		Return(nil, assert.AnError)
 
-	entity, err := suite.target.GetById(context.Background(), uuid.UUID{})
+	entity, err := suite.target.FindById(context.Background(), uuid.UUID{})
 
 	suite.ErrorContains(err, "failed to get collection")
-	suite.Equal(domain.SourdoughRecipeEntity{}, entity)
+	suite.Equal(domain.FlourEntity{}, entity)
 }
 
-func (suite *FlourRepositoryTestSuite) TestFind_WithErrorOnGetCollection() {
-	suite.mongoDBService.EXPECT().GetCollection(FlourDatabase, FlourCollection).
+func (suite *FlourRepositoryTestSuite) TestUpdate_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(FlourCollection).
+		Return(nil, assert.AnError)
+
+	entity, err := suite.target.Update(context.Background(), domain.FlourEntity{})
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Equal(domain.FlourEntity{}, entity)
+}
+
+func (suite *FlourRepositoryTestSuite) TestGetByName_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(FlourCollection).
+		Return(nil, assert.AnError)
+
+	entity, err := suite.target.GetByName(context.Background(), "rye")
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Equal(domain.FlourEntity{}, entity)
+}
+
+func (suite *FlourRepositoryTestSuite) TestFindAll_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(FlourCollection).
 		Return(nil, assert.AnError)
 
-	entities, err := suite.target.Find(context.Background(), 0, 1)
+	entities, err := suite.target.FindAll(context.Background())
 
 	suite.ErrorContains(err, "failed to get collection")
 	suite.Nil(entities)
 }
 
+func (suite *FlourRepositoryTestSuite) TestFind_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(FlourCollection).
+		Return(nil, assert.AnError)
+
+	page, err := suite.target.Find(context.Background(), domain.FlourFilter{}, domain.PageRequest{SortField: "created_at", Limit: 1})
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Equal(domain.FlourPage{}, page)
+}
+
+func (suite *FlourRepositoryTestSuite) TestCountByType_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(FlourCollection).
+		Return(nil, assert.AnError)
+
+	count, err := suite.target.CountByType(context.Background(), "rye")
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Zero(count)
+}
+
 func (suite *FlourRepositoryTestSuite) TestSearchByName_WithErrorOnGetCollection() {
-	suite.mongoDBService.EXPECT().GetCollection(FlourDatabase, FlourCollection).
+	suite.mongoDBService.EXPECT().GetCollection(FlourCollection).
 		Return(nil, assert.AnError)
 
 	entities, err := suite.target.SearchByName(context.Background(), "")
@@ -119,3 +150,58 @@ func (suite *FlourRepositoryTestSuite) TestSearchByName_WithErrorOnGetCollection
 	suite.ErrorContains(err, "failed to get collection")
 	suite.Nil(entities)
 }
+
+func (suite *FlourRepositoryTestSuite) TestTextSearch_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(FlourCollection).
+		Return(nil, assert.AnError)
+
+	result, err := suite.target.TextSearch(context.Background(), "rye", 0, 10)
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Equal(domain.FlourTextSearchResult{}, result)
+}
+
+func TestFlourFilterQuery(t *testing.T) {
+	minProtein := 11.5
+	maxProtein := 14.0
+
+	tests := []struct {
+		name     string
+		filter   domain.FlourFilter
+		expected bson.D
+	}{
+		{
+			name:     "empty filter",
+			filter:   domain.FlourFilter{},
+			expected: bson.D{},
+		},
+		{
+			name:   "flour types",
+			filter: domain.FlourFilter{FlourTypes: []string{"bread", "rye"}},
+			expected: bson.D{
+				{"flourtype", bson.D{{"$in", []string{"bread", "rye"}}}},
+			},
+		},
+		{
+			name:   "protein range",
+			filter: domain.FlourFilter{MinProtein: &minProtein, MaxProtein: &maxProtein},
+			expected: bson.D{
+				{"protein_content", bson.D{{"$gte", 11.5}, {"$lte", 14.0}}},
+			},
+		},
+		{
+			name:   "flour type and minimum protein",
+			filter: domain.FlourFilter{FlourTypes: []string{"spelt"}, MinProtein: &minProtein},
+			expected: bson.D{
+				{"flourtype", bson.D{{"$in", []string{"spelt"}}}},
+				{"protein_content", bson.D{{"$gte", 11.5}}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, flourFilterQuery(tt.filter))
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"dough-calculator/internal/domain"
+)
+
+const (
+	FlourTypeCollection = "flour-types"
+)
+
+type flourTypeRepository struct {
+	mongoDBService domain.MongoDBService
+}
+
+func (repository *flourTypeRepository) Create(ctx context.Context, flourType domain.FlourTypeEntity) (entity domain.FlourTypeEntity, err error) {
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	result, err := collection.InsertOne(ctx, flourType)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("code", flourType.Code).
+			Msg("failed to insert flour type")
+		return domain.FlourTypeEntity{}, errors.Wrap(err, "failed to insert flour type")
+	}
+
+	log.Debug().Msgf("Inserted a single document: %s", result.InsertedID)
+
+	return flourType, nil
+}
+
+func (repository *flourTypeRepository) GetById(ctx context.Context, id uuid.UUID) (entity domain.FlourTypeEntity, err error) {
+	defer func() {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Stringer("id", id).
+				Msg("failed to get flour type by id")
+		}
+	}()
+
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	err = collection.
+		FindOne(ctx, bson.D{{"_id", id}}).
+		Decode(&entity)
+	if err != nil {
+		return domain.FlourTypeEntity{}, errors.Wrap(err, "failed to find flour type")
+	}
+
+	return
+}
+
+func (repository *flourTypeRepository) GetByCode(ctx context.Context, code string) (entity domain.FlourTypeEntity, err error) {
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	err = collection.
+		FindOne(ctx, bson.D{{"code", code}}).
+		Decode(&entity)
+	if err != nil {
+		return domain.FlourTypeEntity{}, errors.Wrap(err, "failed to find flour type")
+	}
+
+	return
+}
+
+func (repository *flourTypeRepository) FindAll(ctx context.Context) (result []domain.FlourTypeEntity, err error) {
+	defer func() {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Msg("failed to find flour types")
+		}
+	}()
+
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	cursor, err := collection.Find(ctx, bson.D{}, options.Find().SetSort(bson.D{{"name", 1}}))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find flour types")
+	}
+
+	if err = cursor.All(ctx, &result); err != nil {
+		return nil, errors.Wrap(err, "failed to decode flour types")
+	}
+
+	return
+}
+
+func (repository *flourTypeRepository) Count(ctx context.Context) (count int64, err error) {
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	count, err = collection.CountDocuments(ctx, bson.D{})
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("failed to count flour types")
+		return 0, errors.Wrap(err, "failed to count flour types")
+	}
+
+	return
+}
+
+func (repository *flourTypeRepository) Update(ctx context.Context, flourType domain.FlourTypeEntity) (entity domain.FlourTypeEntity, err error) {
+	defer func() {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Stringer("id", flourType.Id).
+				Msg("failed to update flour type")
+		}
+	}()
+
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	result, err := collection.ReplaceOne(ctx, bson.D{{"_id", flourType.Id}}, flourType)
+	if err != nil {
+		return domain.FlourTypeEntity{}, errors.Wrap(err, "failed to update flour type")
+	}
+
+	if result.MatchedCount == 0 {
+		return domain.FlourTypeEntity{}, errors.Wrap(mongo.ErrNoDocuments, "failed to update flour type")
+	}
+
+	return flourType, nil
+}
+
+func (repository *flourTypeRepository) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	defer func() {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Stringer("id", id).
+				Msg("failed to delete flour type")
+		}
+	}()
+
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	result, err := collection.DeleteOne(ctx, bson.D{{"_id", id}})
+	if err != nil {
+		return errors.Wrap(err, "failed to delete flour type")
+	}
+
+	if result.DeletedCount == 0 {
+		return errors.Wrap(mongo.ErrNoDocuments, "failed to delete flour type")
+	}
+
+	return nil
+}
+
+func (repository *flourTypeRepository) getCollection() (*mongo.Collection, error) {
+	collection, err := repository.mongoDBService.GetCollection(FlourTypeCollection)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("collection", FlourTypeCollection).
+			Msg("failed to get collection")
+		return nil, errors.Wrap(err, "failed to get collection")
+	}
+	return collection, nil
+}
+
+func NewFlourTypeRepository(service domain.MongoDBService) (domain.FlourTypeRepository, error) {
+	if service == nil {
+		return nil, errors.New("service cannot be nil")
+	}
+
+	return &flourTypeRepository{mongoDBService: service}, nil
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/domain"
+)
+
+type embeddedFlourTypeRepository struct {
+	collection *embeddedCollection[domain.FlourTypeEntity]
+}
+
+func (repository *embeddedFlourTypeRepository) Create(ctx context.Context, flourType domain.FlourTypeEntity) (domain.FlourTypeEntity, error) {
+	if err := repository.collection.insert(ctx, flourType); err != nil {
+		return domain.FlourTypeEntity{}, errors.Wrap(err, "failed to insert flour type")
+	}
+	return flourType, nil
+}
+
+func (repository *embeddedFlourTypeRepository) GetById(ctx context.Context, id uuid.UUID) (domain.FlourTypeEntity, error) {
+	flourType, err := repository.collection.get(ctx, id)
+	return flourType, errors.Wrap(err, "failed to get flour type by id")
+}
+
+func (repository *embeddedFlourTypeRepository) GetByCode(ctx context.Context, code string) (domain.FlourTypeEntity, error) {
+	flourType, err := repository.collection.findOne(ctx, func(flourType domain.FlourTypeEntity) bool {
+		return flourType.Code == code
+	})
+	return flourType, errors.Wrap(err, "failed to get flour type by code")
+}
+
+func (repository *embeddedFlourTypeRepository) FindAll(ctx context.Context) ([]domain.FlourTypeEntity, error) {
+	flourTypes, err := repository.collection.find(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find flour types")
+	}
+
+	sortByName(flourTypes, func(flourType domain.FlourTypeEntity) string { return flourType.Name })
+
+	return flourTypes, nil
+}
+
+func (repository *embeddedFlourTypeRepository) Count(ctx context.Context) (int64, error) {
+	count, err := repository.collection.count(ctx, nil)
+	return count, errors.Wrap(err, "failed to count flour types")
+}
+
+func (repository *embeddedFlourTypeRepository) Update(ctx context.Context, flourType domain.FlourTypeEntity) (domain.FlourTypeEntity, error) {
+	if err := repository.collection.replace(ctx, flourType); err != nil {
+		return domain.FlourTypeEntity{}, errors.Wrap(err, "failed to update flour type")
+	}
+	return flourType, nil
+}
+
+func (repository *embeddedFlourTypeRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return errors.Wrap(repository.collection.delete(ctx, id), "failed to delete flour type")
+}
+
+func NewEmbeddedFlourTypeRepository(database domain.EmbeddedDatabase) (domain.FlourTypeRepository, error) {
+	collection, err := newEmbeddedCollection(database, FlourTypeCollection,
+		func(flourType domain.FlourTypeEntity) uuid.UUID { return flourType.Id },
+		uniqueIndex[domain.FlourTypeEntity]{name: "code_1", key: func(flourType domain.FlourTypeEntity) string { return flourType.Code }},
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create collection")
+	}
+
+	return &embeddedFlourTypeRepository{collection: collection}, nil
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/test"
+)
+
+func TestEmbeddedFlourTypeRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(EmbeddedFlourTypeRepositoryTestSuite))
+}
+
+type EmbeddedFlourTypeRepositoryTestSuite struct {
+	test.GoMockTestSuite
+
+	target domain.FlourTypeRepository
+}
+
+func (suite *EmbeddedFlourTypeRepositoryTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.target = test.Must(func() (domain.FlourTypeRepository, error) {
+		return NewEmbeddedFlourTypeRepository(newEmptyEmbeddedDatabase(suite.MockCtrl))
+	})
+
+	for _, flourType := range []domain.FlourTypeEntity{
+		{Id: test.FirstId, Code: "wheat", Name: "Wheat"},
+		{Id: test.SecondId, Code: "rye", Name: "Rye"},
+	} {
+		_, err := suite.target.Create(context.Background(), flourType)
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *EmbeddedFlourTypeRepositoryTestSuite) TestCreate_WithDuplicateCode() {
+	_, err := suite.target.Create(context.Background(), domain.FlourTypeEntity{Id: uuid.New(), Code: "rye"})
+
+	suite.True(mongo.IsDuplicateKeyError(err))
+}
+
+func (suite *EmbeddedFlourTypeRepositoryTestSuite) TestGetByCode() {
+	actual, err := suite.target.GetByCode(context.Background(), "rye")
+
+	suite.NoError(err)
+	suite.Equal(test.SecondId, actual.Id)
+}
+
+func (suite *EmbeddedFlourTypeRepositoryTestSuite) TestFindAll() {
+	actual, err := suite.target.FindAll(context.Background())
+
+	suite.NoError(err)
+	suite.Equal([]string{"Rye", "Wheat"}, []string{actual[0].Name, actual[1].Name})
+
+	count, err := suite.target.Count(context.Background())
+	suite.NoError(err)
+	suite.Equal(int64(2), count)
+}
+
+func (suite *EmbeddedFlourTypeRepositoryTestSuite) TestDelete() {
+	suite.NoError(suite.target.Delete(context.Background(), test.FirstId))
+
+	_, err := suite.target.GetById(context.Background(), test.FirstId)
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+}
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	"dough-calculator/internal/test"
+)
+
+func TestFlourTypeRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(FlourTypeRepositoryTestSuite))
+}
+
+type FlourTypeRepositoryTestSuite struct {
+	test.GoMockTestSuite
+
+	mongoDBService *mocks.MockMongoDBService
+
+	target *flourTypeRepository
+}
+
+func (suite *FlourTypeRepositoryTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.mongoDBService = mocks.NewMockMongoDBService(suite.MockCtrl)
+
+	suite.target = &flourTypeRepository{
+		mongoDBService: suite.mongoDBService,
+	}
+}
+
+func (suite *FlourTypeRepositoryTestSuite) TestNewFlourTypeRepository_WithError() {
+	tests := []struct {
+		name           string
+		mongoDBService domain.MongoDBService
+		errorMsg       string
+	}{
+		{
+			name:           "mongoDBService is nil",
+			mongoDBService: nil,
+			errorMsg:       "service cannot be nil",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			repository, err := NewFlourTypeRepository(tt.mongoDBService)
+
+			suite.ErrorContains(err, tt.errorMsg)
+			suite.Nil(repository)
+		})
+	}
+}
+
+func (suite *FlourTypeRepositoryTestSuite) TestMethods_WithErrorOnGetCollection() {
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{
+			name: "Create",
+			call: func() error {
+				_, err := suite.target.Create(context.Background(), domain.FlourTypeEntity{})
+				return err
+			},
+		},
+		{
+			name: "GetById",
+			call: func() error {
+				_, err := suite.target.GetById(context.Background(), uuid.New())
+				return err
+			},
+		},
+		{
+			name: "GetByCode",
+			call: func() error {
+				_, err := suite.target.GetByCode(context.Background(), "rye")
+				return err
+			},
+		},
+		{
+			name: "FindAll",
+			call: func() error {
+				_, err := suite.target.FindAll(context.Background())
+				return err
+			},
+		},
+		{
+			name: "Count",
+			call: func() error {
+				_, err := suite.target.Count(context.Background())
+				return err
+			},
+		},
+		{
+			name: "Update",
+			call: func() error {
+				_, err := suite.target.Update(context.Background(), domain.FlourTypeEntity{})
+				return err
+			},
+		},
+		{
+			name: "Delete",
+			call: func() error {
+				return suite.target.Delete(context.Background(), uuid.New())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.mongoDBService.EXPECT().GetCollection(FlourTypeCollection).
+				Return(nil, assert.AnError)
+
+			suite.ErrorContains(tt.call(), "failed to get collection")
+		})
+	}
+}
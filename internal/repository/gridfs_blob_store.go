@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/mongo/gridfs"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"dough-calculator/internal/config"
+	"dough-calculator/internal/domain"
+)
+
+const (
+	GridFSDefaultBucket = "images"
+)
+
+type gridFSBlobStore struct {
+	mongoDBService domain.MongoDBService
+	bucketName     string
+}
+
+func (store *gridFSBlobStore) Put(ctx context.Context, key string, content io.Reader) error {
+	bucket, err := store.getBucket(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = bucket.UploadFromStreamWithID(key, key, content); err != nil {
+		log.Error().
+			Err(err).
+			Str("key", key).
+			Msg("failed to upload blob")
+		return errors.Wrap(err, "failed to upload blob")
+	}
+
+	return nil
+}
+
+func (store *gridFSBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	bucket, err := store.getBucket(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := bucket.OpenDownloadStream(key)
+	if errors.Is(err, gridfs.ErrFileNotFound) {
+		return nil, errors.Wrapf(domain.ErrBlobNotFound, "blob '%s'", key)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open blob")
+	}
+
+	return stream, nil
+}
+
+func (store *gridFSBlobStore) Delete(ctx context.Context, key string) error {
+	bucket, err := store.getBucket(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = bucket.DeleteContext(ctx, key)
+	if err != nil && !errors.Is(err, gridfs.ErrFileNotFound) {
+		return errors.Wrap(err, "failed to delete blob")
+	}
+
+	return nil
+}
+
+// getBucket creates a bucket per call, buckets keep read and write deadlines and are not safe to share.
+func (store *gridFSBlobStore) getBucket(ctx context.Context) (*gridfs.Bucket, error) {
+	database, err := store.mongoDBService.GetDatabase()
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("failed to get database")
+		return nil, errors.Wrap(err, "failed to get database")
+	}
+
+	bucket, err := gridfs.NewBucket(database, options.GridFSBucket().SetName(store.mongoDBService.CollectionName(store.bucketName)))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create bucket")
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = bucket.SetReadDeadline(deadline)
+		_ = bucket.SetWriteDeadline(deadline)
+	}
+
+	return bucket, nil
+}
+
+func NewGridFSBlobStore(storage config.GridFSStorage, service domain.MongoDBService) (domain.BlobStore, error) {
+	if service == nil {
+		return nil, errors.New("service cannot be nil")
+	}
+
+	bucketName := storage.Bucket
+	if bucketName == "" {
+		bucketName = GridFSDefaultBucket
+	}
+
+	return &gridFSBlobStore{mongoDBService: service, bucketName: bucketName}, nil
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"dough-calculator/internal/domain/mocks"
+	"dough-calculator/internal/test"
+)
+
+func TestGridFSBlobStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(GridFSBlobStoreTestSuite))
+}
+
+type GridFSBlobStoreTestSuite struct {
+	test.GoMockTestSuite
+
+	mongoDBService *mocks.MockMongoDBService
+
+	target *gridFSBlobStore
+}
+
+func (suite *GridFSBlobStoreTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.mongoDBService = mocks.NewMockMongoDBService(suite.MockCtrl)
+
+	suite.target = &gridFSBlobStore{
+		mongoDBService: suite.mongoDBService,
+		bucketName:     GridFSDefaultBucket,
+	}
+}
+
+func (suite *GridFSBlobStoreTestSuite) TestPut_WithErrorOnGetDatabase() {
+	suite.mongoDBService.EXPECT().GetDatabase().Return(nil, assert.AnError)
+
+	err := suite.target.Put(context.Background(), "key", bytes.NewReader(nil))
+
+	suite.ErrorContains(err, "failed to get database")
+}
+
+func (suite *GridFSBlobStoreTestSuite) TestGet_WithErrorOnGetDatabase() {
+	suite.mongoDBService.EXPECT().GetDatabase().Return(nil, assert.AnError)
+
+	reader, err := suite.target.Get(context.Background(), "key")
+
+	suite.ErrorContains(err, "failed to get database")
+	suite.Nil(reader)
+}
+
+func (suite *GridFSBlobStoreTestSuite) TestDelete_WithErrorOnGetDatabase() {
+	suite.mongoDBService.EXPECT().GetDatabase().Return(nil, assert.AnError)
+
+	err := suite.target.Delete(context.Background(), "key")
+
+	suite.ErrorContains(err, "failed to get database")
+}
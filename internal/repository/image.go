@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"dough-calculator/internal/domain"
+)
+
+const (
+	ImageCollection = "images"
+)
+
+type imageRepository struct {
+	mongoDBService domain.MongoDBService
+}
+
+func (repository *imageRepository) Create(ctx context.Context, image domain.ImageEntity) (entity domain.ImageEntity, err error) {
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	result, err := collection.InsertOne(ctx, image)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Stringer("recipe_id", image.RecipeId).
+			Msg("failed to insert image")
+		return domain.ImageEntity{}, errors.Wrap(err, "failed to insert image")
+	}
+
+	log.Debug().Msgf("Inserted a single document: %s", result.InsertedID)
+
+	return image, nil
+}
+
+func (repository *imageRepository) GetById(ctx context.Context, id uuid.UUID) (entity domain.ImageEntity, err error) {
+	defer func() {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Stringer("id", id).
+				Msg("failed to get image by id")
+		}
+	}()
+
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	err = collection.
+		FindOne(ctx, bson.D{{"_id", id}}).
+		Decode(&entity)
+	if err != nil {
+		return domain.ImageEntity{}, errors.Wrap(err, "failed to find image")
+	}
+
+	return
+}
+
+func (repository *imageRepository) FindByRecipeId(ctx context.Context, recipeId uuid.UUID, bakeLogId *uuid.UUID, offset, limit int) (result []domain.ImageEntity, err error) {
+	defer func() {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Stringer("recipe_id", recipeId).
+				Msg("failed to find images")
+		}
+	}()
+
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	filter := bson.D{{"recipe_id", recipeId}}
+	if bakeLogId != nil {
+		filter = append(filter, bson.E{Key: "bake_log_id", Value: *bakeLogId})
+	}
+
+	cursor, err := collection.Find(ctx, filter, options.Find().
+		SetLimit(int64(limit)).
+		SetSkip(int64(offset)).
+		SetSort(bson.D{{"created_at", -1}}))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find images")
+	}
+
+	if err = cursor.All(ctx, &result); err != nil {
+		return nil, errors.Wrap(err, "failed to decode images")
+	}
+
+	return
+}
+
+func (repository *imageRepository) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	defer func() {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Stringer("id", id).
+				Msg("failed to delete image")
+		}
+	}()
+
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	result, err := collection.DeleteOne(ctx, bson.D{{"_id", id}})
+	if err != nil {
+		return errors.Wrap(err, "failed to delete image")
+	}
+
+	if result.DeletedCount == 0 {
+		return errors.Wrap(mongo.ErrNoDocuments, "failed to delete image")
+	}
+
+	return nil
+}
+
+func (repository *imageRepository) getCollection() (*mongo.Collection, error) {
+	collection, err := repository.mongoDBService.GetCollection(ImageCollection)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("collection", ImageCollection).
+			Msg("failed to get collection")
+		return nil, errors.Wrap(err, "failed to get collection")
+	}
+	return collection, nil
+}
+
+func NewImageRepository(service domain.MongoDBService) (domain.ImageRepository, error) {
+	if service == nil {
+		return nil, errors.New("service cannot be nil")
+	}
+
+	return &imageRepository{mongoDBService: service}, nil
+}
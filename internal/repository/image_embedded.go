@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/domain"
+)
+
+type embeddedImageRepository struct {
+	collection *embeddedCollection[domain.ImageEntity]
+}
+
+func (repository *embeddedImageRepository) Create(ctx context.Context, image domain.ImageEntity) (domain.ImageEntity, error) {
+	if err := repository.collection.insert(ctx, image); err != nil {
+		return domain.ImageEntity{}, errors.Wrap(err, "failed to insert image")
+	}
+	return image, nil
+}
+
+func (repository *embeddedImageRepository) GetById(ctx context.Context, id uuid.UUID) (domain.ImageEntity, error) {
+	image, err := repository.collection.get(ctx, id)
+	return image, errors.Wrap(err, "failed to get image by id")
+}
+
+// FindByRecipeId returns the images of a recipe, of one of its bake logs
+// when bakeLogId is set, newest first.
+func (repository *embeddedImageRepository) FindByRecipeId(ctx context.Context, recipeId uuid.UUID, bakeLogId *uuid.UUID, offset, limit int) ([]domain.ImageEntity, error) {
+	images, err := repository.collection.find(ctx, func(image domain.ImageEntity) bool {
+		return image.RecipeId == recipeId &&
+			(bakeLogId == nil || (image.BakeLogId != nil && *image.BakeLogId == *bakeLogId))
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find images")
+	}
+
+	sort.SliceStable(images, func(i, j int) bool { return images[i].CreatedAt.After(images[j].CreatedAt) })
+
+	return window(images, offset, limit), nil
+}
+
+func (repository *embeddedImageRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return errors.Wrap(repository.collection.delete(ctx, id), "failed to delete image")
+}
+
+func NewEmbeddedImageRepository(database domain.EmbeddedDatabase) (domain.ImageRepository, error) {
+	collection, err := newEmbeddedCollection(database, ImageCollection,
+		func(image domain.ImageEntity) uuid.UUID { return image.Id })
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create collection")
+	}
+
+	return &embeddedImageRepository{collection: collection}, nil
+}
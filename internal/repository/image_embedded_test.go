@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/test"
+)
+
+func TestEmbeddedImageRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(EmbeddedImageRepositoryTestSuite))
+}
+
+type EmbeddedImageRepositoryTestSuite struct {
+	test.GoMockTestSuite
+
+	target domain.ImageRepository
+}
+
+func (suite *EmbeddedImageRepositoryTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.target = test.Must(func() (domain.ImageRepository, error) {
+		return NewEmbeddedImageRepository(newEmptyEmbeddedDatabase(suite.MockCtrl))
+	})
+
+	bakeLogId := test.ThirdId
+	for _, image := range []domain.ImageEntity{
+		{Id: test.FirstId, RecipeId: test.FirstId, CreatedAt: test.Date},
+		{Id: test.SecondId, RecipeId: test.FirstId, BakeLogId: &bakeLogId, CreatedAt: test.Date.Add(time.Hour)},
+		{Id: test.ThirdId, RecipeId: test.SecondId, CreatedAt: test.Date},
+	} {
+		_, err := suite.target.Create(context.Background(), image)
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *EmbeddedImageRepositoryTestSuite) TestFindByRecipeId() {
+	actual, err := suite.target.FindByRecipeId(context.Background(), test.FirstId, nil, 0, 10)
+
+	suite.NoError(err)
+	suite.Equal([]uuid.UUID{test.SecondId, test.FirstId}, []uuid.UUID{actual[0].Id, actual[1].Id})
+}
+
+func (suite *EmbeddedImageRepositoryTestSuite) TestFindByRecipeId_WithBakeLogId() {
+	bakeLogId := test.ThirdId
+
+	actual, err := suite.target.FindByRecipeId(context.Background(), test.FirstId, &bakeLogId, 0, 10)
+
+	suite.NoError(err)
+	suite.Len(actual, 1)
+	suite.Equal(test.SecondId, actual[0].Id)
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	"dough-calculator/internal/test"
+)
+
+func TestImageRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ImageRepositoryTestSuite))
+}
+
+type ImageRepositoryTestSuite struct {
+	test.GoMockTestSuite
+
+	mongoDBService *mocks.MockMongoDBService
+
+	target *imageRepository
+}
+
+func (suite *ImageRepositoryTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.mongoDBService = mocks.NewMockMongoDBService(suite.MockCtrl)
+
+	suite.target = &imageRepository{
+		mongoDBService: suite.mongoDBService,
+	}
+}
+
+func (suite *ImageRepositoryTestSuite) TestNewImageRepository_WithError() {
+	tests := []struct {
+		name           string
+		mongoDBService domain.MongoDBService
+		errorMsg       string
+	}{
+		{
+			name:           "mongoDBService is nil",
+			mongoDBService: nil,
+			errorMsg:       "service cannot be nil",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			repository, err := NewImageRepository(tt.mongoDBService)
+
+			suite.ErrorContains(err, tt.errorMsg)
+			suite.Nil(repository)
+		})
+	}
+}
+
+func (suite *ImageRepositoryTestSuite) TestCreate_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(ImageCollection).
+		Return(nil, assert.AnError)
+
+	entity, err := suite.target.Create(context.Background(), domain.ImageEntity{})
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Equal(domain.ImageEntity{}, entity)
+}
+
+func (suite *ImageRepositoryTestSuite) TestGetById_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(ImageCollection).
+		Return(nil, assert.AnError)
+
+	entity, err := suite.target.GetById(context.Background(), uuid.New())
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Equal(domain.ImageEntity{}, entity)
+}
+
+func (suite *ImageRepositoryTestSuite) TestFindByRecipeId_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(ImageCollection).
+		Return(nil, assert.AnError)
+
+	entities, err := suite.target.FindByRecipeId(context.Background(), uuid.New(), nil, 0, 10)
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Nil(entities)
+}
+
+func (suite *ImageRepositoryTestSuite) TestDelete_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(ImageCollection).
+		Return(nil, assert.AnError)
+
+	err := suite.target.Delete(context.Background(), uuid.New())
+
+	suite.ErrorContains(err, "failed to get collection")
+}
@@ -0,0 +1,138 @@
+//go:build integration && docker
+
+package integration_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/repository"
+	"dough-calculator/internal/test"
+)
+
+func TestBakeLogRepositoryTestSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &BakeLogRepositoryTestSuite{
+		MongoDBServiceDockerIntegrationTestSuite: test.NewMongoDBServiceDockerIntegrationTestSuite(dockerStarter),
+	})
+}
+
+type BakeLogRepositoryTestSuite struct {
+	test.MongoDBServiceDockerIntegrationTestSuite
+
+	target domain.BakeLogRepository
+}
+
+func (suite *BakeLogRepositoryTestSuite) SetupSuite() {
+	suite.MongoDBServiceDockerIntegrationTestSuite.SetupSuite()
+
+	suite.target = test.Must(func() (domain.BakeLogRepository, error) {
+		return repository.NewBakeLogRepository(suite.Stub)
+	})
+
+	suite.Require().NoError(migrate(suite.Stub))
+}
+
+func (suite *BakeLogRepositoryTestSuite) AfterTest(suiteName, testName string) {
+	err := suite.Drop(repository.BakeLogCollection)
+	suite.Require().NoError(err)
+}
+
+func (suite *BakeLogRepositoryTestSuite) TestCreate() {
+	expected := generateBakeLogEntity(uuid.New(), 4, time.Now())
+
+	actual, err := suite.target.Create(context.Background(), expected)
+
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *BakeLogRepositoryTestSuite) TestGetById() {
+	expected := generateBakeLogEntity(uuid.New(), 4, time.Now())
+
+	_, err := suite.target.Create(context.Background(), expected)
+	suite.Require().NoError(err)
+
+	actual, err := suite.target.GetById(context.Background(), expected.Id)
+
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *BakeLogRepositoryTestSuite) TestGetById_WithBakeLogNotFound_ShouldReturnNoDocumentsError() {
+	_, err := suite.target.GetById(context.Background(), uuid.New())
+
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+}
+
+func (suite *BakeLogRepositoryTestSuite) TestFindByRecipeId() {
+	recipeId := uuid.New()
+	now := time.Now()
+	first := generateBakeLogEntity(recipeId, 3, now.Add(-2*time.Hour))
+	second := generateBakeLogEntity(recipeId, 4, now.Add(-time.Hour))
+	third := generateBakeLogEntity(recipeId, 5, now)
+
+	for _, bakeLog := range []domain.BakeLogEntity{first, second, third, generateBakeLogEntity(uuid.New(), 1, now)} {
+		_, err := suite.target.Create(context.Background(), bakeLog)
+		suite.Require().NoError(err)
+	}
+
+	actual, err := suite.target.FindByRecipeId(context.Background(), recipeId, 1, 2)
+
+	suite.NoError(err)
+	suite.Equal([]domain.BakeLogEntity{second, first}, actual)
+}
+
+func (suite *BakeLogRepositoryTestSuite) TestSummarize() {
+	recipeId := uuid.New()
+	now := time.Now()
+
+	for _, bakeLog := range []domain.BakeLogEntity{
+		generateBakeLogEntity(recipeId, 3, now.Add(-2*time.Hour)),
+		generateBakeLogEntity(recipeId, 4, now),
+		generateBakeLogEntity(recipeId, 5, now.Add(-time.Hour)),
+		generateBakeLogEntity(uuid.New(), 1, now.Add(time.Hour)),
+	} {
+		_, err := suite.target.Create(context.Background(), bakeLog)
+		suite.Require().NoError(err)
+	}
+
+	actual, err := suite.target.Summarize(context.Background(), recipeId)
+
+	lastBakedAt := now.Truncate(time.Second).UTC()
+	suite.NoError(err)
+	suite.Equal(domain.BakeLogSummaryEntity{
+		BakeCount:     3,
+		AverageRating: 4,
+		LastBakedAt:   &lastBakedAt,
+	}, actual)
+}
+
+func (suite *BakeLogRepositoryTestSuite) TestSummarize_WithEmptyData_ShouldReturnEmptySummary() {
+	actual, err := suite.target.Summarize(context.Background(), uuid.New())
+
+	suite.NoError(err)
+	suite.Equal(domain.BakeLogSummaryEntity{}, actual)
+}
+
+func generateBakeLogEntity(recipeId uuid.UUID, rating int, bakedAt time.Time) domain.BakeLogEntity {
+	return domain.BakeLogEntity{
+		Id:            uuid.New(),
+		RecipeId:      recipeId,
+		RecipeVersion: 1,
+		ScaledWeight:  1920,
+		Temperatures:  domain.BakeTemperatures{Room: 22, Dough: 25, Oven: 250},
+		Timings:       domain.BakeTimings{BulkFermentationMinutes: 300, ProofMinutes: 720, BakeMinutes: 45},
+		Notes:         "test notes",
+		Rating:        rating,
+		BakedAt:       bakedAt.Truncate(time.Second).UTC(),
+		CreatedAt:     time.Now().Truncate(time.Second).UTC(),
+	}
+}
@@ -5,11 +5,13 @@ package integration_test
 import (
 	"context"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	"dough-calculator/internal/domain"
 	"dough-calculator/internal/domain/mocks"
@@ -18,6 +20,8 @@ import (
 )
 
 func TestFlourRepositoryTestSuite(t *testing.T) {
+	t.Parallel()
+
 	suite.Run(t, &FlourRepositoryTestSuite{
 		MongoDBServiceDockerIntegrationTestSuite: test.NewMongoDBServiceDockerIntegrationTestSuite(dockerStarter),
 	})
@@ -37,10 +41,12 @@ func (suite *FlourRepositoryTestSuite) SetupSuite() {
 	suite.target = test.Must(func() (domain.FlourRepository, error) {
 		return repository.NewFlourRepository(suite.Stub)
 	})
+
+	suite.Require().NoError(migrate(suite.Stub))
 }
 
 func (suite *FlourRepositoryTestSuite) AfterTest(suiteName, testName string) {
-	err := suite.Drop(repository.FlourDatabase, repository.FlourCollection)
+	err := suite.Drop(repository.FlourCollection)
 	suite.Require().NoError(err)
 }
 
@@ -54,7 +60,7 @@ func (suite *FlourRepositoryTestSuite) TestCreate() {
 
 	var saved domain.FlourEntity
 
-	err = suite.MStub().MustGetCollection(repository.FlourDatabase, repository.FlourCollection).
+	err = suite.MStub().MustGetCollection(repository.FlourCollection).
 		FindOne(context.Background(), bson.D{{"_id", expected.Id}}).
 		Decode(&saved)
 
@@ -102,17 +108,84 @@ func (suite *FlourRepositoryTestSuite) TestFind() {
 	_, err = suite.target.Create(context.Background(), second)
 	suite.Require().NoError(err)
 
-	actual, err := suite.target.Find(context.Background(), 0, 1)
+	names := []string{first.Name, second.Name}
+	slices.Sort(names)
+
+	actual, err := suite.target.Find(context.Background(), domain.FlourFilter{}, domain.PageRequest{SortField: "name", Limit: 1})
+
+	suite.NoError(err)
+	suite.Len(actual.Flours, 1)
+	suite.Equal(names[0], actual.Flours[0].Name)
+	suite.Equal(domain.PageInfo{Total: 2, HasNext: true}, actual.Page)
+
+	actual, err = suite.target.Find(context.Background(), domain.FlourFilter{}, domain.PageRequest{
+		SortField: "name",
+		After:     &domain.PageKey{Value: actual.Flours[0].Name, Id: actual.Flours[0].Id},
+		Limit:     1,
+	})
+
+	suite.NoError(err)
+	suite.Len(actual.Flours, 1)
+	suite.Equal(names[1], actual.Flours[0].Name)
+	suite.Equal(domain.PageInfo{Total: 2, HasPrev: true}, actual.Page)
+}
+
+func (suite *FlourRepositoryTestSuite) TestFind_WithFilter() {
+	bread := generateFlourEntity()
+	bread.FlourType = "bread"
+	bread.ProteinContent = 13.5
+	spelt := generateFlourEntity()
+	spelt.FlourType = "spelt"
+	spelt.ProteinContent = 12
+	rye := generateFlourEntity()
+	rye.FlourType = "rye"
+	rye.ProteinContent = 8.5
+
+	for _, flour := range []domain.FlourEntity{bread, spelt, rye} {
+		_, err := suite.target.Create(context.Background(), flour)
+		suite.Require().NoError(err)
+	}
+
+	minProtein := 10.0
+	actual, err := suite.target.Find(context.Background(), domain.FlourFilter{
+		FlourTypes: []string{"bread", "rye"},
+		MinProtein: &minProtein,
+	}, domain.PageRequest{SortField: "protein_content", Limit: 10})
+
+	suite.NoError(err)
+	suite.Equal([]domain.FlourEntity{bread}, actual.Flours)
+	suite.Equal(domain.PageInfo{Total: 1}, actual.Page)
+
+	maxProtein := 12.0
+	actual, err = suite.target.Find(context.Background(), domain.FlourFilter{
+		MaxProtein: &maxProtein,
+	}, domain.PageRequest{SortField: "protein_content", Limit: 10})
 
 	suite.NoError(err)
-	suite.Contains(actual, first)
+	suite.Equal([]domain.FlourEntity{rye, spelt}, actual.Flours)
+	suite.Equal(domain.PageInfo{Total: 2}, actual.Page)
+}
+
+func (suite *FlourRepositoryTestSuite) TestCountByType() {
+	for _, flourType := range []string{"rye", "rye", "spelt"} {
+		flour := generateFlourEntity()
+		flour.FlourType = flourType
+		_, err := suite.target.Create(context.Background(), flour)
+		suite.Require().NoError(err)
+	}
+
+	count, err := suite.target.CountByType(context.Background(), "rye")
+
+	suite.NoError(err)
+	suite.Equal(int64(2), count)
 }
 
 func (suite *FlourRepositoryTestSuite) TestFind_WithEmptyData_ShouldReturnNil() {
-	actual, err := suite.target.Find(context.Background(), 1, 0)
+	actual, err := suite.target.Find(context.Background(), domain.FlourFilter{}, domain.PageRequest{SortField: "created_at", Offset: 1, Limit: 25})
 
 	suite.NoError(err)
-	suite.Nil(actual)
+	suite.Nil(actual.Flours)
+	suite.Zero(actual.Page.Total)
 }
 
 func (suite *FlourRepositoryTestSuite) TestFindByName() {
@@ -133,6 +206,120 @@ func (suite *FlourRepositoryTestSuite) TestFindByName_WithEntityNotExists_Should
 	suite.Nil(actual)
 }
 
+func (suite *FlourRepositoryTestSuite) TestFindByName_ShouldMatchEscapedPrefix() {
+	entity := generateFlourEntity()
+	entity.Name = "T65 (French) flour"
+	_, err := suite.target.Create(context.Background(), entity)
+	suite.Require().NoError(err)
+
+	actual, err := suite.target.SearchByName(context.Background(), "t65 (fr")
+
+	suite.NoError(err)
+	suite.Equal([]domain.FlourEntity{entity}, actual)
+
+	actual, err = suite.target.SearchByName(context.Background(), "French")
+
+	suite.NoError(err)
+	suite.Nil(actual)
+
+	actual, err = suite.target.SearchByName(context.Background(), "(a+)+")
+
+	suite.NoError(err)
+	suite.Nil(actual)
+}
+
+func (suite *FlourRepositoryTestSuite) TestUpdate() {
+	entity := generateFlourEntity()
+	_, err := suite.target.Create(context.Background(), entity)
+	suite.Require().NoError(err)
+
+	entity.Description = "updated description"
+	entity.ProteinContent = 12.5
+
+	actual, err := suite.target.Update(context.Background(), entity)
+
+	suite.NoError(err)
+	suite.Equal(entity, actual)
+
+	saved, err := suite.target.FindById(context.Background(), entity.Id)
+
+	suite.NoError(err)
+	suite.Equal(entity, saved)
+}
+
+func (suite *FlourRepositoryTestSuite) TestUpdate_WithEntityNotFound_ShouldReturnError() {
+	_, err := suite.target.Update(context.Background(), generateFlourEntity())
+
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+}
+
+func (suite *FlourRepositoryTestSuite) TestGetByName() {
+	entity := generateFlourEntity()
+	entity.Name = "T65 (French) flour"
+	_, err := suite.target.Create(context.Background(), entity)
+	suite.Require().NoError(err)
+
+	actual, err := suite.target.GetByName(context.Background(), "t65 (french) FLOUR")
+
+	suite.NoError(err)
+	suite.Equal(entity, actual)
+
+	_, err = suite.target.GetByName(context.Background(), "t65 (french)")
+
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+}
+
+func (suite *FlourRepositoryTestSuite) TestFindAll() {
+	first := generateFlourEntity()
+	first.Name = "Rye flour"
+	second := generateFlourEntity()
+	second.Name = "Bread flour"
+
+	for _, entity := range []domain.FlourEntity{first, second} {
+		_, err := suite.target.Create(context.Background(), entity)
+		suite.Require().NoError(err)
+	}
+
+	actual, err := suite.target.FindAll(context.Background())
+
+	suite.NoError(err)
+	suite.Equal([]domain.FlourEntity{second, first}, actual)
+}
+
+func (suite *FlourRepositoryTestSuite) TestFindAll_WithEmptyData_ShouldReturnEmptySlice() {
+	actual, err := suite.target.FindAll(context.Background())
+
+	suite.NoError(err)
+	suite.Equal([]domain.FlourEntity{}, actual)
+}
+
+func (suite *FlourRepositoryTestSuite) TestTextSearch() {
+	// the text index is dropped together with the collection after each test
+	suite.Require().NoError(migrate(suite.Stub))
+	target := suite.target
+
+	rye := generateFlourEntity()
+	rye.Name = "Dark Rye"
+	_, err := target.Create(context.Background(), rye)
+	suite.Require().NoError(err)
+
+	blend := generateFlourEntity()
+	blend.Description = "wheat with a little rye"
+	_, err = target.Create(context.Background(), blend)
+	suite.Require().NoError(err)
+
+	_, err = target.Create(context.Background(), generateFlourEntity())
+	suite.Require().NoError(err)
+
+	actual, err := target.TextSearch(context.Background(), "rye", 0, 1)
+
+	suite.NoError(err)
+	suite.Equal(int64(2), actual.Total)
+	suite.Require().Len(actual.Hits, 1)
+	suite.Equal(rye, actual.Hits[0].Flour)
+	suite.Positive(actual.Hits[0].Score)
+}
+
 func generateFlourEntity() domain.FlourEntity {
 	id := uuid.New()
 
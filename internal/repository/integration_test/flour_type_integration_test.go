@@ -0,0 +1,149 @@
+//go:build integration && docker
+
+package integration_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/repository"
+	"dough-calculator/internal/test"
+)
+
+func TestFlourTypeRepositoryTestSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &FlourTypeRepositoryTestSuite{
+		MongoDBServiceDockerIntegrationTestSuite: test.NewMongoDBServiceDockerIntegrationTestSuite(dockerStarter),
+	})
+}
+
+type FlourTypeRepositoryTestSuite struct {
+	test.MongoDBServiceDockerIntegrationTestSuite
+
+	target domain.FlourTypeRepository
+}
+
+func (suite *FlourTypeRepositoryTestSuite) SetupTest() {
+	// the unique code index is dropped together with the collection after each test
+	suite.target = test.Must(func() (domain.FlourTypeRepository, error) {
+		return repository.NewFlourTypeRepository(suite.Stub)
+	})
+
+	suite.Require().NoError(migrate(suite.Stub))
+}
+
+func (suite *FlourTypeRepositoryTestSuite) AfterTest(suiteName, testName string) {
+	err := suite.Drop(repository.FlourTypeCollection)
+	suite.Require().NoError(err)
+}
+
+func (suite *FlourTypeRepositoryTestSuite) TestCreate() {
+	expected := generateFlourTypeEntity("rye")
+
+	actual, err := suite.target.Create(context.Background(), expected)
+
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *FlourTypeRepositoryTestSuite) TestCreate_WithDuplicateCode_ShouldReturnError() {
+	_, err := suite.target.Create(context.Background(), generateFlourTypeEntity("rye"))
+	suite.Require().NoError(err)
+
+	_, err = suite.target.Create(context.Background(), generateFlourTypeEntity("rye"))
+
+	suite.True(mongo.IsDuplicateKeyError(err))
+}
+
+func (suite *FlourTypeRepositoryTestSuite) TestGetByIdAndCode() {
+	expected := generateFlourTypeEntity("spelt")
+	_, err := suite.target.Create(context.Background(), expected)
+	suite.Require().NoError(err)
+
+	actual, err := suite.target.GetById(context.Background(), expected.Id)
+
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+
+	actual, err = suite.target.GetByCode(context.Background(), "spelt")
+
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+
+	_, err = suite.target.GetByCode(context.Background(), "einkorn")
+
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+}
+
+func (suite *FlourTypeRepositoryTestSuite) TestFindAllAndCount() {
+	spelt := generateFlourTypeEntity("spelt")
+	bread := generateFlourTypeEntity("bread")
+
+	for _, flourType := range []domain.FlourTypeEntity{spelt, bread} {
+		_, err := suite.target.Create(context.Background(), flourType)
+		suite.Require().NoError(err)
+	}
+
+	actual, err := suite.target.FindAll(context.Background())
+
+	suite.NoError(err)
+	suite.Equal([]domain.FlourTypeEntity{bread, spelt}, actual)
+
+	count, err := suite.target.Count(context.Background())
+
+	suite.NoError(err)
+	suite.Equal(int64(2), count)
+}
+
+func (suite *FlourTypeRepositoryTestSuite) TestUpdate() {
+	entity := generateFlourTypeEntity("rye")
+	_, err := suite.target.Create(context.Background(), entity)
+	suite.Require().NoError(err)
+
+	updatedAt := time.Now().UTC().Truncate(time.Millisecond)
+	entity.Name = "Dark rye"
+	entity.UpdatedAt = &updatedAt
+
+	_, err = suite.target.Update(context.Background(), entity)
+	suite.NoError(err)
+
+	actual, err := suite.target.GetById(context.Background(), entity.Id)
+
+	suite.NoError(err)
+	suite.Equal(entity, actual)
+
+	_, err = suite.target.Update(context.Background(), generateFlourTypeEntity("spelt"))
+
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+}
+
+func (suite *FlourTypeRepositoryTestSuite) TestDelete() {
+	entity := generateFlourTypeEntity("rye")
+	_, err := suite.target.Create(context.Background(), entity)
+	suite.Require().NoError(err)
+
+	suite.NoError(suite.target.Delete(context.Background(), entity.Id))
+
+	_, err = suite.target.GetById(context.Background(), entity.Id)
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+
+	suite.ErrorIs(suite.target.Delete(context.Background(), entity.Id), mongo.ErrNoDocuments)
+}
+
+func generateFlourTypeEntity(code string) domain.FlourTypeEntity {
+	return domain.FlourTypeEntity{
+		Id:          uuid.New(),
+		Code:        code,
+		Name:        code,
+		Description: fmt.Sprintf("test flour type %s", code),
+		CreatedAt:   time.Now().UTC().Truncate(time.Millisecond),
+	}
+}
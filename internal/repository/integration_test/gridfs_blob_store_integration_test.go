@@ -0,0 +1,78 @@
+//go:build integration && docker
+
+package integration_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"dough-calculator/internal/config"
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/repository"
+	"dough-calculator/internal/test"
+)
+
+func TestGridFSBlobStoreTestSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &GridFSBlobStoreTestSuite{
+		MongoDBServiceDockerIntegrationTestSuite: test.NewMongoDBServiceDockerIntegrationTestSuite(dockerStarter),
+	})
+}
+
+type GridFSBlobStoreTestSuite struct {
+	test.MongoDBServiceDockerIntegrationTestSuite
+
+	target domain.BlobStore
+}
+
+func (suite *GridFSBlobStoreTestSuite) SetupSuite() {
+	suite.MongoDBServiceDockerIntegrationTestSuite.SetupSuite()
+
+	suite.target = test.Must(func() (domain.BlobStore, error) {
+		return repository.NewGridFSBlobStore(config.GridFSStorage{Bucket: "test-images"}, suite.Stub)
+	})
+}
+
+func (suite *GridFSBlobStoreTestSuite) AfterTest(suiteName, testName string) {
+	suite.Require().NoError(suite.Drop("test-images.files"))
+	suite.Require().NoError(suite.Drop("test-images.chunks"))
+}
+
+func (suite *GridFSBlobStoreTestSuite) TestPutAndGet() {
+	content := bytes.Repeat([]byte("crumb"), 100_000)
+
+	suite.Require().NoError(suite.target.Put(context.Background(), "images/recipe/image", bytes.NewReader(content)))
+
+	reader, err := suite.target.Get(context.Background(), "images/recipe/image")
+	suite.Require().NoError(err)
+	defer reader.Close()
+
+	actual, err := io.ReadAll(reader)
+	suite.NoError(err)
+	suite.Equal(content, actual)
+}
+
+func (suite *GridFSBlobStoreTestSuite) TestGet_WithMissingBlob_ShouldReturnBlobNotFoundError() {
+	reader, err := suite.target.Get(context.Background(), "missing")
+
+	suite.ErrorIs(err, domain.ErrBlobNotFound)
+	suite.Nil(reader)
+}
+
+func (suite *GridFSBlobStoreTestSuite) TestDelete() {
+	suite.Require().NoError(suite.target.Put(context.Background(), "image", bytes.NewReader([]byte("content"))))
+
+	suite.NoError(suite.target.Delete(context.Background(), "image"))
+
+	_, err := suite.target.Get(context.Background(), "image")
+	suite.ErrorIs(err, domain.ErrBlobNotFound)
+}
+
+func (suite *GridFSBlobStoreTestSuite) TestDelete_WithMissingBlob_ShouldNotFail() {
+	suite.NoError(suite.target.Delete(context.Background(), "missing"))
+}
@@ -0,0 +1,123 @@
+//go:build integration && docker
+
+package integration_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/repository"
+	"dough-calculator/internal/test"
+)
+
+func TestImageRepositoryTestSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &ImageRepositoryTestSuite{
+		MongoDBServiceDockerIntegrationTestSuite: test.NewMongoDBServiceDockerIntegrationTestSuite(dockerStarter),
+	})
+}
+
+type ImageRepositoryTestSuite struct {
+	test.MongoDBServiceDockerIntegrationTestSuite
+
+	target domain.ImageRepository
+}
+
+func (suite *ImageRepositoryTestSuite) SetupSuite() {
+	suite.MongoDBServiceDockerIntegrationTestSuite.SetupSuite()
+
+	suite.target = test.Must(func() (domain.ImageRepository, error) {
+		return repository.NewImageRepository(suite.Stub)
+	})
+
+	suite.Require().NoError(migrate(suite.Stub))
+}
+
+func (suite *ImageRepositoryTestSuite) AfterTest(suiteName, testName string) {
+	err := suite.Drop(repository.ImageCollection)
+	suite.Require().NoError(err)
+}
+
+func (suite *ImageRepositoryTestSuite) TestCreateAndGetById() {
+	bakeLogId := uuid.New()
+	expected := generateImageEntity(uuid.New(), &bakeLogId, time.Now())
+
+	_, err := suite.target.Create(context.Background(), expected)
+	suite.Require().NoError(err)
+
+	actual, err := suite.target.GetById(context.Background(), expected.Id)
+
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *ImageRepositoryTestSuite) TestGetById_WithImageNotFound_ShouldReturnNoDocumentsError() {
+	_, err := suite.target.GetById(context.Background(), uuid.New())
+
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+}
+
+func (suite *ImageRepositoryTestSuite) TestFindByRecipeId() {
+	recipeId := uuid.New()
+	bakeLogId := uuid.New()
+	now := time.Now()
+	first := generateImageEntity(recipeId, nil, now.Add(-2*time.Hour))
+	second := generateImageEntity(recipeId, &bakeLogId, now.Add(-time.Hour))
+	third := generateImageEntity(recipeId, &bakeLogId, now)
+
+	for _, image := range []domain.ImageEntity{first, second, third, generateImageEntity(uuid.New(), nil, now)} {
+		_, err := suite.target.Create(context.Background(), image)
+		suite.Require().NoError(err)
+	}
+
+	all, err := suite.target.FindByRecipeId(context.Background(), recipeId, nil, 0, 10)
+	suite.NoError(err)
+	suite.Equal([]domain.ImageEntity{third, second, first}, all)
+
+	ofBake, err := suite.target.FindByRecipeId(context.Background(), recipeId, &bakeLogId, 1, 10)
+	suite.NoError(err)
+	suite.Equal([]domain.ImageEntity{second}, ofBake)
+}
+
+func (suite *ImageRepositoryTestSuite) TestDelete() {
+	image := generateImageEntity(uuid.New(), nil, time.Now())
+
+	_, err := suite.target.Create(context.Background(), image)
+	suite.Require().NoError(err)
+
+	suite.NoError(suite.target.Delete(context.Background(), image.Id))
+
+	_, err = suite.target.GetById(context.Background(), image.Id)
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+}
+
+func (suite *ImageRepositoryTestSuite) TestDelete_WithImageNotFound_ShouldReturnNoDocumentsError() {
+	err := suite.target.Delete(context.Background(), uuid.New())
+
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+}
+
+func generateImageEntity(recipeId uuid.UUID, bakeLogId *uuid.UUID, createdAt time.Time) domain.ImageEntity {
+	id := uuid.New()
+
+	return domain.ImageEntity{
+		Id:           id,
+		RecipeId:     recipeId,
+		BakeLogId:    bakeLogId,
+		FileName:     "crumb.jpg",
+		ContentType:  "image/jpeg",
+		Size:         2048,
+		Width:        640,
+		Height:       480,
+		BlobKey:      "images/" + recipeId.String() + "/" + id.String(),
+		ThumbnailKey: "images/" + recipeId.String() + "/" + id.String() + "-thumbnail",
+		CreatedAt:    createdAt.Truncate(time.Second).UTC(),
+	}
+}
@@ -0,0 +1,153 @@
+//go:build integration && docker
+
+package integration_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/repository"
+	"dough-calculator/internal/test"
+)
+
+func TestInventoryRepositoryTestSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &InventoryRepositoryTestSuite{
+		MongoDBServiceDockerIntegrationTestSuite: test.NewMongoDBServiceDockerIntegrationTestSuite(dockerStarter),
+	})
+}
+
+type InventoryRepositoryTestSuite struct {
+	test.MongoDBServiceDockerIntegrationTestSuite
+
+	target domain.InventoryRepository
+}
+
+func (suite *InventoryRepositoryTestSuite) SetupTest() {
+	suite.target = test.Must(func() (domain.InventoryRepository, error) {
+		return repository.NewInventoryRepository(suite.Stub)
+	})
+
+	suite.Require().NoError(migrate(suite.Stub))
+}
+
+func (suite *InventoryRepositoryTestSuite) AfterTest(suiteName, testName string) {
+	err := suite.Drop(repository.InventoryCollection)
+	suite.Require().NoError(err)
+}
+
+func (suite *InventoryRepositoryTestSuite) TestUpsertAndGet() {
+	expected := generateInventoryItemEntity("Salt", 1000, 200)
+
+	actual, err := suite.target.Upsert(context.Background(), expected)
+
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+
+	actual, err = suite.target.GetById(context.Background(), expected.Id)
+
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+
+	updatedAt := time.Now().UTC().Truncate(time.Millisecond)
+	expected.Quantity = 500
+	expected.UpdatedAt = &updatedAt
+
+	_, err = suite.target.Upsert(context.Background(), expected)
+	suite.NoError(err)
+
+	actual, err = suite.target.GetByKey(context.Background(), expected.Key)
+
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+
+	_, err = suite.target.GetById(context.Background(), uuid.New())
+
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+}
+
+func (suite *InventoryRepositoryTestSuite) TestFind() {
+	salt := generateInventoryItemEntity("Salt", 100, 200)
+	seeds := generateInventoryItemEntity("Seeds", 500, 0)
+	yeast := generateInventoryItemEntity("Yeast", 300, 50)
+
+	for _, item := range []domain.InventoryItemEntity{yeast, salt, seeds} {
+		_, err := suite.target.Upsert(context.Background(), item)
+		suite.Require().NoError(err)
+	}
+
+	actual, err := suite.target.Find(context.Background(), false)
+
+	suite.NoError(err)
+	suite.Equal([]domain.InventoryItemEntity{salt, seeds, yeast}, actual)
+
+	actual, err = suite.target.Find(context.Background(), true)
+
+	suite.NoError(err)
+	suite.Equal([]domain.InventoryItemEntity{salt}, actual)
+
+	actual, err = suite.target.FindByKeys(context.Background(), []string{"yeast", "seeds", "honey"})
+
+	suite.NoError(err)
+	suite.Equal([]domain.InventoryItemEntity{seeds, yeast}, actual)
+}
+
+func (suite *InventoryRepositoryTestSuite) TestDeduct() {
+	salt := generateInventoryItemEntity("Salt", 100, 0)
+	seeds := generateInventoryItemEntity("Seeds", 500, 0)
+
+	for _, item := range []domain.InventoryItemEntity{salt, seeds} {
+		_, err := suite.target.Upsert(context.Background(), item)
+		suite.Require().NoError(err)
+	}
+
+	err := suite.target.Deduct(context.Background(), []domain.InventoryDeduction{
+		{Key: "salt", Amount: 22.5},
+		{Key: "seeds", Amount: 600},
+		{Key: "honey", Amount: 10},
+	})
+
+	suite.NoError(err)
+
+	actual, err := suite.target.Find(context.Background(), false)
+
+	suite.NoError(err)
+	suite.Require().Len(actual, 2)
+	suite.Equal(77.5, actual[0].Quantity)
+	suite.NotNil(actual[0].UpdatedAt)
+	suite.Equal(-100.0, actual[1].Quantity)
+	suite.NotNil(actual[1].UpdatedAt)
+}
+
+func (suite *InventoryRepositoryTestSuite) TestDelete() {
+	entity := generateInventoryItemEntity("Salt", 100, 0)
+	_, err := suite.target.Upsert(context.Background(), entity)
+	suite.Require().NoError(err)
+
+	suite.NoError(suite.target.Delete(context.Background(), entity.Id))
+
+	_, err = suite.target.GetById(context.Background(), entity.Id)
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+
+	suite.ErrorIs(suite.target.Delete(context.Background(), entity.Id), mongo.ErrNoDocuments)
+}
+
+func generateInventoryItemEntity(name string, quantity, lowStockThreshold float64) domain.InventoryItemEntity {
+	return domain.InventoryItemEntity{
+		Id:                uuid.New(),
+		Key:               strings.ToLower(name),
+		Kind:              domain.InventoryKindIngredient,
+		Name:              name,
+		Quantity:          quantity,
+		LowStockThreshold: lowStockThreshold,
+		CreatedAt:         time.Now().UTC().Truncate(time.Millisecond),
+	}
+}
@@ -0,0 +1,171 @@
+//go:build integration && docker
+
+package integration_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/repository"
+	"dough-calculator/internal/test"
+)
+
+func TestMigrationRepositoryTestSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &MigrationRepositoryTestSuite{
+		MongoDBServiceDockerIntegrationTestSuite: test.NewMongoDBServiceDockerIntegrationTestSuite(dockerStarter),
+	})
+}
+
+type MigrationRepositoryTestSuite struct {
+	test.MongoDBServiceDockerIntegrationTestSuite
+
+	target domain.MigrationRepository
+}
+
+func (suite *MigrationRepositoryTestSuite) SetupTest() {
+	suite.target = test.Must(func() (domain.MigrationRepository, error) {
+		return repository.NewMigrationRepository(suite.Stub)
+	})
+}
+
+func (suite *MigrationRepositoryTestSuite) AfterTest(suiteName, testName string) {
+	suite.Require().NoError(suite.Drop(repository.MigrationCollection))
+	suite.Require().NoError(suite.Drop(repository.MigrationLockCollection))
+}
+
+func (suite *MigrationRepositoryTestSuite) TestSaveFindAndDelete() {
+	appliedAt := time.Date(2024, 3, 2, 4, 0, 0, 0, time.UTC)
+	second := domain.MigrationEntity{Version: 2, Description: "second", AppliedAt: appliedAt}
+	first := domain.MigrationEntity{Version: 1, Description: "first", AppliedAt: appliedAt}
+
+	actual, err := suite.target.FindApplied(context.Background())
+
+	suite.NoError(err)
+	suite.Equal([]domain.MigrationEntity{}, actual)
+
+	suite.Require().NoError(suite.target.Save(context.Background(), second))
+	suite.Require().NoError(suite.target.Save(context.Background(), first))
+	suite.Require().NoError(suite.target.Save(context.Background(), first))
+
+	actual, err = suite.target.FindApplied(context.Background())
+
+	suite.NoError(err)
+	suite.Equal([]domain.MigrationEntity{first, second}, actual)
+
+	suite.Require().NoError(suite.target.Delete(context.Background(), 2))
+
+	actual, err = suite.target.FindApplied(context.Background())
+
+	suite.NoError(err)
+	suite.Equal([]domain.MigrationEntity{first}, actual)
+}
+
+func (suite *MigrationRepositoryTestSuite) TestLock() {
+	ctx := context.Background()
+	expiresAt := time.Now().Add(time.Minute)
+
+	locked, err := suite.target.Lock(ctx, "first", expiresAt)
+	suite.NoError(err)
+	suite.True(locked)
+
+	locked, err = suite.target.Lock(ctx, "second", expiresAt)
+	suite.NoError(err)
+	suite.False(locked)
+
+	// only the owner releases the lock
+	suite.NoError(suite.target.Unlock(ctx, "second"))
+	locked, err = suite.target.Lock(ctx, "second", expiresAt)
+	suite.NoError(err)
+	suite.False(locked)
+
+	suite.NoError(suite.target.Unlock(ctx, "first"))
+	actual, err := suite.target.LockExpiresAt(ctx)
+	suite.NoError(err)
+	suite.Zero(actual)
+	locked, err = suite.target.Lock(ctx, "second", expiresAt)
+	suite.NoError(err)
+	suite.True(locked)
+}
+
+func (suite *MigrationRepositoryTestSuite) TestLock_WithExpiredLock() {
+	ctx := context.Background()
+
+	locked, err := suite.target.Lock(ctx, "first", time.Now().Add(-time.Second))
+	suite.Require().NoError(err)
+	suite.Require().True(locked)
+
+	locked, err = suite.target.Lock(ctx, "second", time.Now().Add(time.Minute))
+
+	suite.NoError(err)
+	suite.True(locked)
+}
+
+func (suite *MigrationRepositoryTestSuite) TestRenew() {
+	ctx := context.Background()
+
+	locked, err := suite.target.Lock(ctx, "first", time.Now().Add(-time.Second))
+	suite.Require().NoError(err)
+	suite.Require().True(locked)
+
+	expiresAt := time.Now().Add(time.Minute)
+	renewed, err := suite.target.Renew(ctx, "first", expiresAt)
+
+	suite.NoError(err)
+	suite.True(renewed)
+	actual, err := suite.target.LockExpiresAt(ctx)
+	suite.NoError(err)
+	suite.WithinDuration(expiresAt, actual, time.Millisecond)
+
+	// the renewed lock is held again, not expired
+	locked, err = suite.target.Lock(ctx, "second", time.Now().Add(time.Minute))
+
+	suite.NoError(err)
+	suite.False(locked)
+
+	renewed, err = suite.target.Renew(ctx, "second", time.Now().Add(time.Minute))
+
+	suite.NoError(err)
+	suite.False(renewed)
+}
+
+func (suite *MigrationRepositoryTestSuite) TestMigrations_UpAndDown() {
+	migrations := test.Must(func() ([]domain.Migration, error) {
+		return repository.NewMigrations(suite.Stub)
+	})
+	ctx := context.Background()
+
+	for _, migration := range migrations {
+		suite.Require().NoError(migration.Up(ctx))
+		// applying a migration again changes nothing
+		suite.Require().NoError(migration.Up(ctx))
+	}
+	suite.Equal(10, suite.countIndexes(repository.SourdoughRecipeCollection))
+
+	for i := len(migrations) - 1; i >= 0; i-- {
+		suite.Require().NoError(migrations[i].Down(ctx))
+		suite.Require().NoError(migrations[i].Down(ctx))
+	}
+	// only the _id index is left
+	suite.Equal(1, suite.countIndexes(repository.SourdoughRecipeCollection))
+
+	suite.Require().NoError(suite.Drop(repository.SourdoughRecipeCollection))
+}
+
+func (suite *MigrationRepositoryTestSuite) countIndexes(collection string) int {
+	mongoCollection, err := suite.Stub.GetCollection(collection)
+	suite.Require().NoError(err)
+
+	cursor, err := mongoCollection.Indexes().List(context.Background())
+	suite.Require().NoError(err)
+
+	var indexes []bson.M
+	suite.Require().NoError(cursor.All(context.Background(), &indexes))
+	return len(indexes)
+}
@@ -0,0 +1,178 @@
+//go:build integration && docker
+
+package integration_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/repository"
+	"dough-calculator/internal/test"
+)
+
+func TestProductionPlanRepositoryTestSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &ProductionPlanRepositoryTestSuite{
+		MongoDBServiceDockerIntegrationTestSuite: test.NewMongoDBServiceDockerIntegrationTestSuite(dockerStarter),
+	})
+}
+
+type ProductionPlanRepositoryTestSuite struct {
+	test.MongoDBServiceDockerIntegrationTestSuite
+
+	target domain.ProductionPlanRepository
+}
+
+func (suite *ProductionPlanRepositoryTestSuite) SetupTest() {
+	suite.target = test.Must(func() (domain.ProductionPlanRepository, error) {
+		return repository.NewProductionPlanRepository(suite.Stub)
+	})
+
+	suite.Require().NoError(migrate(suite.Stub))
+}
+
+func (suite *ProductionPlanRepositoryTestSuite) AfterTest(suiteName, testName string) {
+	err := suite.Drop(repository.ProductionPlanCollection)
+	suite.Require().NoError(err)
+}
+
+func (suite *ProductionPlanRepositoryTestSuite) TestCreateAndGetById() {
+	expected := generateProductionPlanEntity(time.Date(2024, 3, 2, 4, 0, 0, 0, time.UTC))
+
+	actual, err := suite.target.Create(context.Background(), expected)
+
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+
+	actual, err = suite.target.GetById(context.Background(), expected.Id)
+
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+
+	_, err = suite.target.GetById(context.Background(), uuid.New())
+
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+}
+
+func (suite *ProductionPlanRepositoryTestSuite) TestFind() {
+	friday := generateProductionPlanEntity(time.Date(2024, 3, 1, 4, 0, 0, 0, time.UTC))
+	saturday := generateProductionPlanEntity(time.Date(2024, 3, 2, 4, 0, 0, 0, time.UTC))
+	sunday := generateProductionPlanEntity(time.Date(2024, 3, 3, 4, 0, 0, 0, time.UTC))
+
+	for _, plan := range []domain.ProductionPlanEntity{saturday, friday, sunday} {
+		_, err := suite.target.Create(context.Background(), plan)
+		suite.Require().NoError(err)
+	}
+
+	actual, err := suite.target.Find(context.Background(), 0, 25)
+
+	suite.NoError(err)
+	suite.Equal([]domain.ProductionPlanEntity{sunday, saturday, friday}, actual)
+
+	actual, err = suite.target.Find(context.Background(), 1, 1)
+
+	suite.NoError(err)
+	suite.Equal([]domain.ProductionPlanEntity{saturday}, actual)
+}
+
+func (suite *ProductionPlanRepositoryTestSuite) TestUpdate() {
+	entity := generateProductionPlanEntity(time.Date(2024, 3, 2, 4, 0, 0, 0, time.UTC))
+	_, err := suite.target.Create(context.Background(), entity)
+	suite.Require().NoError(err)
+
+	updatedAt := time.Now().UTC().Truncate(time.Millisecond)
+	entity.MixerCapacity = 8
+	entity.Items = entity.Items[:1]
+	entity.UpdatedAt = &updatedAt
+
+	_, err = suite.target.Update(context.Background(), entity)
+	suite.NoError(err)
+
+	actual, err := suite.target.GetById(context.Background(), entity.Id)
+
+	suite.NoError(err)
+	suite.Equal(entity, actual)
+
+	_, err = suite.target.Update(context.Background(), generateProductionPlanEntity(time.Now().UTC().Truncate(time.Millisecond)))
+
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+}
+
+func (suite *ProductionPlanRepositoryTestSuite) TestUpdate_WithCompletedPlan() {
+	entity := generateProductionPlanEntity(time.Date(2024, 3, 2, 4, 0, 0, 0, time.UTC))
+	_, err := suite.target.Create(context.Background(), entity)
+	suite.Require().NoError(err)
+
+	completed, err := suite.target.Complete(context.Background(), entity.Id, time.Now().UTC().Truncate(time.Millisecond))
+	suite.Require().NoError(err)
+
+	entity.MixerCapacity = 8
+
+	_, err = suite.target.Update(context.Background(), entity)
+
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+
+	actual, err := suite.target.GetById(context.Background(), entity.Id)
+
+	suite.NoError(err)
+	suite.Equal(completed, actual)
+}
+
+func (suite *ProductionPlanRepositoryTestSuite) TestComplete() {
+	entity := generateProductionPlanEntity(time.Date(2024, 3, 2, 4, 0, 0, 0, time.UTC))
+	_, err := suite.target.Create(context.Background(), entity)
+	suite.Require().NoError(err)
+
+	completedAt := time.Now().UTC().Truncate(time.Millisecond)
+	entity.CompletedAt = &completedAt
+	entity.UpdatedAt = &completedAt
+
+	actual, err := suite.target.Complete(context.Background(), entity.Id, completedAt)
+
+	suite.NoError(err)
+	suite.Equal(entity, actual)
+
+	_, err = suite.target.Complete(context.Background(), entity.Id, completedAt.Add(time.Minute))
+
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+
+	actual, err = suite.target.GetById(context.Background(), entity.Id)
+
+	suite.NoError(err)
+	suite.Equal(entity, actual)
+}
+
+func (suite *ProductionPlanRepositoryTestSuite) TestDelete() {
+	entity := generateProductionPlanEntity(time.Date(2024, 3, 2, 4, 0, 0, 0, time.UTC))
+	_, err := suite.target.Create(context.Background(), entity)
+	suite.Require().NoError(err)
+
+	suite.NoError(suite.target.Delete(context.Background(), entity.Id))
+
+	_, err = suite.target.GetById(context.Background(), entity.Id)
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+
+	suite.ErrorIs(suite.target.Delete(context.Background(), entity.Id), mongo.ErrNoDocuments)
+}
+
+func generateProductionPlanEntity(date time.Time) domain.ProductionPlanEntity {
+	return domain.ProductionPlanEntity{
+		Id:            uuid.New(),
+		Name:          "test production plan",
+		Date:          date,
+		MixerCapacity: 5,
+		MixMinutes:    20,
+		Items: []domain.ProductionPlanItem{
+			{RecipeId: uuid.New(), Pieces: 12, PieceWeight: 900, FermentationMinutes: 240},
+			{RecipeId: uuid.New(), Pieces: 3, PieceWeight: 1000},
+		},
+		CreatedAt: time.Now().UTC().Truncate(time.Millisecond),
+	}
+}
@@ -3,11 +3,14 @@
 package integration_test
 
 import (
+	"context"
 	"os"
 	"testing"
 
 	"github.com/rs/zerolog/log"
 
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/repository"
 	"dough-calculator/internal/test"
 )
 
@@ -29,3 +32,20 @@ func TestMain(m *testing.M) {
 
 	os.Exit(code)
 }
+
+// migrate creates the collection indexes the way the application does on
+// startup. Dropping a collection drops its indexes, so suites relying on
+// an index call it again after a drop.
+func migrate(service domain.MongoDBService) error {
+	migrations, err := repository.NewMigrations(service)
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range migrations {
+		if err = migration.Up(context.Background()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
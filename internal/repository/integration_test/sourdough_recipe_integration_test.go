@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	"dough-calculator/internal/domain"
 	"dough-calculator/internal/domain/mocks"
@@ -19,6 +20,8 @@ import (
 )
 
 func TestSourdoughRecipeRepositoryTestSuite(t *testing.T) {
+	t.Parallel()
+
 	suite.Run(t, &SourdoughRecipeRepositoryTestSuite{
 		MongoDBServiceDockerIntegrationTestSuite: test.NewMongoDBServiceDockerIntegrationTestSuite(dockerStarter),
 	})
@@ -38,10 +41,12 @@ func (suite *SourdoughRecipeRepositoryTestSuite) SetupSuite() {
 	suite.target = test.Must(func() (domain.SourdoughRecipeRepository, error) {
 		return repository.NewSourdoughRecipeRepository(suite.Stub)
 	})
+
+	suite.Require().NoError(migrate(suite.Stub))
 }
 
 func (suite *SourdoughRecipeRepositoryTestSuite) AfterTest(suiteName, testName string) {
-	err := suite.Drop(repository.SourdoughRecipeDatabase, repository.SourdoughRecipeCollection)
+	err := suite.Drop(repository.SourdoughRecipeCollection)
 	suite.Require().NoError(err)
 }
 
@@ -55,7 +60,7 @@ func (suite *SourdoughRecipeRepositoryTestSuite) TestCreate() {
 
 	var saved domain.SourdoughRecipeEntity
 
-	err = suite.MStub().MustGetCollection(repository.SourdoughRecipeDatabase, repository.SourdoughRecipeCollection).
+	err = suite.MStub().MustGetCollection(repository.SourdoughRecipeCollection).
 		FindOne(context.Background(), bson.D{{"_id", expected.Id}}).
 		Decode(&saved)
 
@@ -95,6 +100,52 @@ func (suite *SourdoughRecipeRepositoryTestSuite) TestGetById_WithEntityNotFound_
 	suite.ErrorContains(err, "failed to find recipe")
 }
 
+func (suite *SourdoughRecipeRepositoryTestSuite) TestUpdate() {
+	entity := generateSourdoughRecipeEntity()
+	_, err := suite.target.Create(context.Background(), entity)
+	suite.Require().NoError(err)
+
+	updatedAt := time.Now().Truncate(time.Second).UTC()
+	entity.Description = "updated description"
+	entity.Version = 2
+	entity.UpdatedAt = &updatedAt
+
+	actual, err := suite.target.Update(context.Background(), entity, 1)
+
+	suite.NoError(err)
+	suite.Equal(entity, actual)
+
+	saved, err := suite.target.GetById(context.Background(), entity.Id)
+
+	suite.NoError(err)
+	suite.Equal(entity, saved)
+}
+
+func (suite *SourdoughRecipeRepositoryTestSuite) TestUpdate_WithChangedVersion_ShouldReturnNoDocumentsError() {
+	entity := generateSourdoughRecipeEntity()
+	_, err := suite.target.Create(context.Background(), entity)
+	suite.Require().NoError(err)
+
+	stale := entity
+	stale.Description = "stale description"
+	stale.Version = 2
+
+	_, err = suite.target.Update(context.Background(), stale, 2)
+
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+
+	saved, err := suite.target.GetById(context.Background(), entity.Id)
+
+	suite.NoError(err)
+	suite.Equal(entity, saved)
+}
+
+func (suite *SourdoughRecipeRepositoryTestSuite) TestUpdate_WithEntityNotFound_ShouldReturnNoDocumentsError() {
+	_, err := suite.target.Update(context.Background(), generateSourdoughRecipeEntity(), 1)
+
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+}
+
 func (suite *SourdoughRecipeRepositoryTestSuite) TestFind() {
 	first := generateSourdoughRecipeEntity()
 	first.CreatedAt = time.Now().Add(-time.Hour).Truncate(time.Second).UTC()
@@ -106,17 +157,119 @@ func (suite *SourdoughRecipeRepositoryTestSuite) TestFind() {
 	_, err = suite.target.Create(context.Background(), second)
 	suite.Require().NoError(err)
 
-	actual, err := suite.target.Find(context.Background(), 0, 1)
+	actual, err := suite.target.Find(context.Background(), domain.SourdoughRecipeFilter{},
+		domain.PageRequest{SortField: "created_at", Descending: true, Limit: 1})
 
 	suite.NoError(err)
-	suite.Equal([]domain.SourdoughRecipeEntity{second}, actual)
+	suite.Equal([]domain.SourdoughRecipeEntity{second}, actual.Recipes)
+	suite.Equal(domain.PageInfo{Total: 2, HasNext: true}, actual.Page)
 }
 
-func (suite *SourdoughRecipeRepositoryTestSuite) TestFind_WithEmptyData_ShouldReturnNil() {
-	actual, err := suite.target.Find(context.Background(), 1, 0)
+func (suite *SourdoughRecipeRepositoryTestSuite) TestFind_WithKeyset_ShouldPageInBothDirections() {
+	older := generateSourdoughRecipeEntity()
+	olderUpdatedAt := time.Now().Add(-time.Hour).Truncate(time.Second).UTC()
+	older.UpdatedAt = &olderUpdatedAt
+	_, err := suite.target.Create(context.Background(), older)
+	suite.Require().NoError(err)
+
+	newer := generateSourdoughRecipeEntity()
+	newerUpdatedAt := time.Now().Truncate(time.Second).UTC()
+	newer.UpdatedAt = &newerUpdatedAt
+	_, err = suite.target.Create(context.Background(), newer)
+	suite.Require().NoError(err)
+
+	neverUpdated := generateSourdoughRecipeEntity()
+	_, err = suite.target.Create(context.Background(), neverUpdated)
+	suite.Require().NoError(err)
+
+	page := domain.PageRequest{SortField: "updated_at", Descending: true, Limit: 2}
+
+	first, err := suite.target.Find(context.Background(), domain.SourdoughRecipeFilter{}, page)
 
 	suite.NoError(err)
-	suite.Nil(actual)
+	suite.Equal([]domain.SourdoughRecipeEntity{newer, older}, first.Recipes)
+	suite.Equal(domain.PageInfo{Total: 3, HasNext: true}, first.Page)
+
+	page.After = &domain.PageKey{Value: olderUpdatedAt, Id: older.Id}
+	second, err := suite.target.Find(context.Background(), domain.SourdoughRecipeFilter{}, page)
+
+	suite.NoError(err)
+	suite.Equal([]domain.SourdoughRecipeEntity{neverUpdated}, second.Recipes)
+	suite.Equal(domain.PageInfo{Total: 3, HasPrev: true}, second.Page)
+
+	page.After, page.Before = nil, &domain.PageKey{Id: neverUpdated.Id}
+	previous, err := suite.target.Find(context.Background(), domain.SourdoughRecipeFilter{}, page)
+
+	suite.NoError(err)
+	suite.Equal([]domain.SourdoughRecipeEntity{newer, older}, previous.Recipes)
+	suite.Equal(domain.PageInfo{Total: 3, HasNext: true}, previous.Page)
+}
+
+func (suite *SourdoughRecipeRepositoryTestSuite) TestFind_WithEmptyData_ShouldReturnEmpty() {
+	actual, err := suite.target.Find(context.Background(), domain.SourdoughRecipeFilter{},
+		domain.PageRequest{SortField: "created_at", Offset: 1, Limit: 25})
+
+	suite.NoError(err)
+	suite.Empty(actual.Recipes)
+	suite.Zero(actual.Page.Total)
+	suite.Empty(actual.Facets.Tags)
+	suite.Empty(actual.Facets.Hydration)
+}
+
+func (suite *SourdoughRecipeRepositoryTestSuite) TestFind_WithFilter_ShouldReturnFacets() {
+	rye := generateSourdoughRecipeEntity()
+	rye.CreatedAt = time.Now().Add(-time.Hour).Truncate(time.Second).UTC()
+	rye.Tags = []string{"rye", "whole grain"}
+	rye.Category = "bread"
+	rye.Flour[0].FlourType = "rye"
+	rye.Details.Water.BakerPercentage = 78
+	_, err := suite.target.Create(context.Background(), rye)
+	suite.Require().NoError(err)
+
+	enriched := generateSourdoughRecipeEntity()
+	enriched.CreatedAt = time.Now().Truncate(time.Second).UTC()
+	enriched.Tags = []string{"rye", "enriched"}
+	enriched.Category = "bun"
+	enriched.Details.Water.BakerPercentage = 62
+	_, err = suite.target.Create(context.Background(), enriched)
+	suite.Require().NoError(err)
+
+	stiff := generateSourdoughRecipeEntity()
+	stiff.Tags = []string{"wheat"}
+	stiff.Details.Water.BakerPercentage = 55
+	_, err = suite.target.Create(context.Background(), stiff)
+	suite.Require().NoError(err)
+
+	minHydration := 60.0
+	eighty, sixtyFive := 80.0, 65.0
+
+	actual, err := suite.target.Find(context.Background(), domain.SourdoughRecipeFilter{
+		Tags:         []string{"rye"},
+		MinHydration: &minHydration,
+	}, domain.PageRequest{SortField: "created_at", Descending: true, Limit: 25})
+
+	suite.NoError(err)
+	suite.Equal([]domain.SourdoughRecipeEntity{enriched, rye}, actual.Recipes)
+	suite.Equal(int64(2), actual.Page.Total)
+	suite.Equal(domain.SourdoughRecipeFacets{
+		Tags: []domain.FacetCount{
+			{Value: "rye", Count: 2},
+			{Value: "enriched", Count: 1},
+			{Value: "whole grain", Count: 1},
+		},
+		Categories: []domain.FacetCount{
+			{Value: "bread", Count: 1},
+			{Value: "bun", Count: 1},
+		},
+		FlourTypes: []domain.FacetCount{
+			{Value: "rye", Count: 1},
+			{Value: "test", Count: 1},
+		},
+		Hydration: []domain.HydrationBucket{
+			{Min: 60, Max: &sixtyFive, Count: 1},
+			{Min: 75, Max: &eighty, Count: 1},
+		},
+	}, actual.Facets)
 }
 
 func (suite *SourdoughRecipeRepositoryTestSuite) TestFindByName() {
@@ -137,6 +290,121 @@ func (suite *SourdoughRecipeRepositoryTestSuite) TestFindByName_WithEntityNotExi
 	suite.Nil(actual)
 }
 
+func (suite *SourdoughRecipeRepositoryTestSuite) TestFindByName_ShouldMatchEscapedPrefix() {
+	entity := generateSourdoughRecipeEntity()
+	entity.Name = "Pain de campagne (80%)"
+	_, err := suite.target.Create(context.Background(), entity)
+	suite.Require().NoError(err)
+
+	actual, err := suite.target.SearchByName(context.Background(), "pain de campagne (8")
+
+	suite.NoError(err)
+	suite.Equal([]domain.SourdoughRecipeEntity{entity}, actual)
+
+	actual, err = suite.target.SearchByName(context.Background(), ".*campagne")
+
+	suite.NoError(err)
+	suite.Nil(actual)
+}
+
+func (suite *SourdoughRecipeRepositoryTestSuite) TestGetByName() {
+	entity := generateSourdoughRecipeEntity()
+	_, err := suite.target.Create(context.Background(), entity)
+	suite.Require().NoError(err)
+
+	actual, err := suite.target.GetByName(context.Background(), entity.Name)
+
+	suite.NoError(err)
+	suite.Equal(entity, actual)
+
+	_, err = suite.target.GetByName(context.Background(), entity.Name[:10])
+
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+}
+
+func (suite *SourdoughRecipeRepositoryTestSuite) TestFindAll() {
+	rye := generateSourdoughRecipeEntity()
+	rye.Name = "Rye"
+	baguette := generateSourdoughRecipeEntity()
+	baguette.Name = "Baguette"
+	country := generateSourdoughRecipeEntity()
+	country.Name = "Country loaf"
+
+	for _, entity := range []domain.SourdoughRecipeEntity{rye, baguette, country} {
+		_, err := suite.target.Create(context.Background(), entity)
+		suite.Require().NoError(err)
+	}
+
+	actual, err := suite.target.FindAll(context.Background(), domain.SourdoughRecipeFilter{})
+
+	suite.NoError(err)
+	suite.Equal([]domain.SourdoughRecipeEntity{baguette, country, rye}, actual)
+
+	actual, err = suite.target.FindAll(context.Background(), domain.SourdoughRecipeFilter{Ids: []uuid.UUID{rye.Id, country.Id}})
+
+	suite.NoError(err)
+	suite.Equal([]domain.SourdoughRecipeEntity{country, rye}, actual)
+}
+
+func (suite *SourdoughRecipeRepositoryTestSuite) TestFindAll_WithEmptyData_ShouldReturnEmptySlice() {
+	actual, err := suite.target.FindAll(context.Background(), domain.SourdoughRecipeFilter{})
+
+	suite.NoError(err)
+	suite.Equal([]domain.SourdoughRecipeEntity{}, actual)
+}
+
+func (suite *SourdoughRecipeRepositoryTestSuite) TestTextSearch() {
+	// the text index is dropped together with the collection after each test
+	suite.Require().NoError(migrate(suite.Stub))
+	target := suite.target
+
+	byName := generateSourdoughRecipeEntity()
+	byName.Name = "Country Rye " + byName.Id.String()
+	_, err := target.Create(context.Background(), byName)
+	suite.Require().NoError(err)
+
+	byFlour := generateSourdoughRecipeEntity()
+	byFlour.Flour[0].Name = "Dark Rye"
+	_, err = target.Create(context.Background(), byFlour)
+	suite.Require().NoError(err)
+
+	_, err = target.Create(context.Background(), generateSourdoughRecipeEntity())
+	suite.Require().NoError(err)
+
+	actual, err := target.TextSearch(context.Background(), "rye", 0, 10)
+
+	suite.NoError(err)
+	suite.Equal(int64(2), actual.Total)
+	suite.Require().Len(actual.Hits, 2)
+	suite.Equal(byName, actual.Hits[0].Recipe)
+	suite.Equal(byFlour, actual.Hits[1].Recipe)
+	suite.Greater(actual.Hits[0].Score, actual.Hits[1].Score)
+}
+
+func (suite *SourdoughRecipeRepositoryTestSuite) TestFindFamily() {
+	root := generateSourdoughRecipeEntity()
+	root.CreatedAt = time.Now().Add(-time.Hour).Truncate(time.Second).UTC()
+
+	child := generateSourdoughRecipeEntity()
+	child.ParentId = &root.Id
+	child.Ancestors = []uuid.UUID{root.Id}
+
+	grandchild := generateSourdoughRecipeEntity()
+	grandchild.CreatedAt = time.Now().Add(time.Hour).Truncate(time.Second).UTC()
+	grandchild.ParentId = &child.Id
+	grandchild.Ancestors = []uuid.UUID{root.Id, child.Id}
+
+	for _, entity := range []domain.SourdoughRecipeEntity{root, child, grandchild, generateSourdoughRecipeEntity()} {
+		_, err := suite.target.Create(context.Background(), entity)
+		suite.Require().NoError(err)
+	}
+
+	actual, err := suite.target.FindFamily(context.Background(), root.Id)
+
+	suite.NoError(err)
+	suite.Equal([]domain.SourdoughRecipeEntity{root, child, grandchild}, actual)
+}
+
 func generateSourdoughRecipeEntity() domain.SourdoughRecipeEntity {
 	id := uuid.New()
 
@@ -228,6 +496,7 @@ func generateSourdoughRecipeEntity() domain.SourdoughRecipeEntity {
 				},
 			},
 			CreatedAt: time.Now().Truncate(time.Second).UTC(),
+			Version:   1,
 			Yield: domain.RecipeYield{
 				Unit:   "loaf",
 				Amount: 2,
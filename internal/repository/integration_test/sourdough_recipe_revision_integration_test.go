@@ -0,0 +1,127 @@
+//go:build integration && docker
+
+package integration_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/repository"
+	"dough-calculator/internal/test"
+)
+
+func TestSourdoughRecipeRevisionRepositoryTestSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &SourdoughRecipeRevisionRepositoryTestSuite{
+		MongoDBServiceDockerIntegrationTestSuite: test.NewMongoDBServiceDockerIntegrationTestSuite(dockerStarter),
+	})
+}
+
+type SourdoughRecipeRevisionRepositoryTestSuite struct {
+	test.MongoDBServiceDockerIntegrationTestSuite
+
+	target domain.SourdoughRecipeRevisionRepository
+}
+
+func (suite *SourdoughRecipeRevisionRepositoryTestSuite) SetupSuite() {
+	suite.MongoDBServiceDockerIntegrationTestSuite.SetupSuite()
+
+	suite.target = test.Must(func() (domain.SourdoughRecipeRevisionRepository, error) {
+		return repository.NewSourdoughRecipeRevisionRepository(suite.Stub)
+	})
+
+	suite.Require().NoError(migrate(suite.Stub))
+}
+
+func (suite *SourdoughRecipeRevisionRepositoryTestSuite) AfterTest(suiteName, testName string) {
+	err := suite.Drop(repository.SourdoughRecipeRevisionCollection)
+	suite.Require().NoError(err)
+}
+
+func (suite *SourdoughRecipeRevisionRepositoryTestSuite) TestCreate() {
+	expected := generateSourdoughRecipeRevisionEntity(generateSourdoughRecipeEntity(), 1)
+
+	actual, err := suite.target.Create(context.Background(), expected)
+
+	suite.NoError(err)
+	suite.Equal(expected, actual)
+}
+
+func (suite *SourdoughRecipeRevisionRepositoryTestSuite) TestCreate_WithVersionExists_ShouldReturnError() {
+	recipe := generateSourdoughRecipeEntity()
+
+	_, err := suite.target.Create(context.Background(), generateSourdoughRecipeRevisionEntity(recipe, 1))
+	suite.Require().NoError(err)
+
+	_, err = suite.target.Create(context.Background(), generateSourdoughRecipeRevisionEntity(recipe, 1))
+
+	suite.ErrorContains(err, "failed to insert sourdough recipe revision")
+}
+
+func (suite *SourdoughRecipeRevisionRepositoryTestSuite) TestGetByRecipeIdAndVersion() {
+	recipe := generateSourdoughRecipeEntity()
+	first := generateSourdoughRecipeRevisionEntity(recipe, 1)
+	second := generateSourdoughRecipeRevisionEntity(recipe, 2)
+
+	_, err := suite.target.Create(context.Background(), first)
+	suite.Require().NoError(err)
+	_, err = suite.target.Create(context.Background(), second)
+	suite.Require().NoError(err)
+
+	actual, err := suite.target.GetByRecipeIdAndVersion(context.Background(), recipe.Id, 2)
+
+	suite.NoError(err)
+	suite.Equal(second, actual)
+}
+
+func (suite *SourdoughRecipeRevisionRepositoryTestSuite) TestGetByRecipeIdAndVersion_WithRevisionNotFound_ShouldReturnNoDocumentsError() {
+	_, err := suite.target.GetByRecipeIdAndVersion(context.Background(), uuid.New(), 1)
+
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+}
+
+func (suite *SourdoughRecipeRevisionRepositoryTestSuite) TestFindByRecipeId() {
+	recipe := generateSourdoughRecipeEntity()
+	first := generateSourdoughRecipeRevisionEntity(recipe, 1)
+	second := generateSourdoughRecipeRevisionEntity(recipe, 2)
+	third := generateSourdoughRecipeRevisionEntity(recipe, 3)
+
+	for _, revision := range []domain.SourdoughRecipeRevisionEntity{first, second, third} {
+		_, err := suite.target.Create(context.Background(), revision)
+		suite.Require().NoError(err)
+	}
+
+	_, err := suite.target.Create(context.Background(), generateSourdoughRecipeRevisionEntity(generateSourdoughRecipeEntity(), 1))
+	suite.Require().NoError(err)
+
+	actual, err := suite.target.FindByRecipeId(context.Background(), recipe.Id, 1, 2)
+
+	suite.NoError(err)
+	suite.Equal([]domain.SourdoughRecipeRevisionEntity{second, first}, actual)
+}
+
+func (suite *SourdoughRecipeRevisionRepositoryTestSuite) TestFindByRecipeId_WithEmptyData_ShouldReturnNil() {
+	actual, err := suite.target.FindByRecipeId(context.Background(), uuid.New(), 0, 10)
+
+	suite.NoError(err)
+	suite.Nil(actual)
+}
+
+func generateSourdoughRecipeRevisionEntity(recipe domain.SourdoughRecipeEntity, version int) domain.SourdoughRecipeRevisionEntity {
+	recipe.Version = version
+
+	return domain.SourdoughRecipeRevisionEntity{
+		Id:        uuid.New(),
+		RecipeId:  recipe.Id,
+		Version:   version,
+		Recipe:    recipe,
+		CreatedAt: time.Now().Truncate(time.Second).UTC(),
+	}
+}
@@ -0,0 +1,109 @@
+//go:build integration && docker
+
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/repository"
+	"dough-calculator/internal/test"
+)
+
+func TestTransactionRunnerTestSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &TransactionRunnerTestSuite{
+		MongoDBServiceDockerIntegrationTestSuite: test.NewMongoDBServiceDockerIntegrationTestSuite(dockerStarter),
+	})
+}
+
+type TransactionRunnerTestSuite struct {
+	test.MongoDBServiceDockerIntegrationTestSuite
+
+	flourRepository domain.FlourRepository
+	target          domain.TransactionRunner
+}
+
+func (suite *TransactionRunnerTestSuite) SetupTest() {
+	suite.flourRepository = test.Must(func() (domain.FlourRepository, error) {
+		return repository.NewFlourRepository(suite.Stub)
+	})
+	suite.target = test.Must(func() (domain.TransactionRunner, error) {
+		return repository.NewTransactionRunner(suite.Stub)
+	})
+
+	suite.Require().NoError(migrate(suite.Stub))
+}
+
+func (suite *TransactionRunnerTestSuite) AfterTest(suiteName, testName string) {
+	err := suite.Drop(repository.FlourCollection)
+	suite.Require().NoError(err)
+}
+
+func (suite *TransactionRunnerTestSuite) TestWithTransaction() {
+	flour := generateFlourEntity()
+
+	err := suite.target.WithTransaction(context.Background(), func(ctx context.Context) error {
+		_, err := suite.flourRepository.Create(ctx, flour)
+		return err
+	})
+
+	suite.NoError(err)
+
+	_, err = suite.flourRepository.FindById(context.Background(), flour.Id)
+
+	suite.NoError(err)
+}
+
+func (suite *TransactionRunnerTestSuite) TestWithTransaction_WithError() {
+	flour := generateFlourEntity()
+
+	err := suite.target.WithTransaction(context.Background(), func(ctx context.Context) error {
+		if _, err := suite.flourRepository.Create(ctx, flour); err != nil {
+			return err
+		}
+		return assert.AnError
+	})
+
+	suite.True(internalErrors.IsServiceError(err))
+	suite.ErrorContains(err, assert.AnError.Error())
+
+	_, err = suite.flourRepository.FindById(context.Background(), flour.Id)
+
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+}
+
+func TestStandaloneTransactionRunnerTestSuite(t *testing.T) {
+	t.Parallel()
+
+	starter := test.NewStandaloneMongoDBDockerStarter()
+	if err := starter.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = starter.Stop() }()
+
+	suite.Run(t, &StandaloneTransactionRunnerTestSuite{
+		MongoDBServiceDockerIntegrationTestSuite: test.NewMongoDBServiceDockerIntegrationTestSuite(starter),
+	})
+}
+
+// StandaloneTransactionRunnerTestSuite runs against a MongoDB without
+// replica set, which cannot run transactions.
+type StandaloneTransactionRunnerTestSuite struct {
+	test.MongoDBServiceDockerIntegrationTestSuite
+}
+
+func (suite *StandaloneTransactionRunnerTestSuite) TestNewTransactionRunner() {
+	runner, err := repository.NewTransactionRunner(suite.Stub)
+
+	suite.ErrorIs(err, repository.ErrTransactionsUnsupported)
+	suite.True(internalErrors.IsServiceError(err))
+	suite.Nil(runner)
+}
@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"dough-calculator/internal/domain"
+)
+
+const (
+	InventoryCollection = "inventory"
+)
+
+type inventoryRepository struct {
+	mongoDBService domain.MongoDBService
+}
+
+func (repository *inventoryRepository) Upsert(ctx context.Context, item domain.InventoryItemEntity) (entity domain.InventoryItemEntity, err error) {
+	defer func() {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("key", item.Key).
+				Msg("failed to upsert inventory item")
+		}
+	}()
+
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	_, err = collection.ReplaceOne(ctx, bson.D{{"key", item.Key}}, item, options.Replace().SetUpsert(true))
+	if err != nil {
+		return domain.InventoryItemEntity{}, errors.Wrap(err, "failed to upsert inventory item")
+	}
+
+	return item, nil
+}
+
+func (repository *inventoryRepository) GetById(ctx context.Context, id uuid.UUID) (domain.InventoryItemEntity, error) {
+	return repository.findOne(ctx, bson.D{{"_id", id}})
+}
+
+func (repository *inventoryRepository) GetByKey(ctx context.Context, key string) (domain.InventoryItemEntity, error) {
+	return repository.findOne(ctx, bson.D{{"key", key}})
+}
+
+func (repository *inventoryRepository) findOne(ctx context.Context, filter bson.D) (entity domain.InventoryItemEntity, err error) {
+	defer func() {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Interface("filter", filter).
+				Msg("failed to find inventory item")
+		}
+	}()
+
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	err = collection.FindOne(ctx, filter).Decode(&entity)
+	if err != nil {
+		return domain.InventoryItemEntity{}, errors.Wrap(err, "failed to find inventory item")
+	}
+
+	return
+}
+
+func (repository *inventoryRepository) Find(ctx context.Context, lowStock bool) ([]domain.InventoryItemEntity, error) {
+	filter := bson.D{}
+	if lowStock {
+		filter = bson.D{
+			{"low_stock_threshold", bson.D{{"$gt", 0}}},
+			{"$expr", bson.D{{"$lte", bson.A{"$quantity", "$low_stock_threshold"}}}},
+		}
+	}
+
+	return repository.find(ctx, filter)
+}
+
+func (repository *inventoryRepository) FindByKeys(ctx context.Context, keys []string) ([]domain.InventoryItemEntity, error) {
+	return repository.find(ctx, bson.D{{"key", bson.D{{"$in", keys}}}})
+}
+
+func (repository *inventoryRepository) find(ctx context.Context, filter bson.D) (result []domain.InventoryItemEntity, err error) {
+	defer func() {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Msg("failed to find inventory items")
+		}
+	}()
+
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	cursor, err := collection.Find(ctx, filter, options.Find().SetSort(bson.D{{"kind", 1}, {"name", 1}}))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find inventory items")
+	}
+
+	if err = cursor.All(ctx, &result); err != nil {
+		return nil, errors.Wrap(err, "failed to decode inventory items")
+	}
+
+	return
+}
+
+func (repository *inventoryRepository) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	defer func() {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Stringer("id", id).
+				Msg("failed to delete inventory item")
+		}
+	}()
+
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	result, err := collection.DeleteOne(ctx, bson.D{{"_id", id}})
+	if err != nil {
+		return errors.Wrap(err, "failed to delete inventory item")
+	}
+
+	if result.DeletedCount == 0 {
+		return errors.Wrap(mongo.ErrNoDocuments, "failed to delete inventory item")
+	}
+
+	return nil
+}
+
+// Deduct takes all deductions off their items in one ordered bulk write.
+// Deductions of untracked items are skipped. The write is only atomic in a
+// transaction, so callers run it through the TransactionRunner together
+// with the writes the deduction belongs to.
+func (repository *inventoryRepository) Deduct(ctx context.Context, deductions []domain.InventoryDeduction) (err error) {
+	defer func() {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Int("deductions", len(deductions)).
+				Msg("failed to deduct inventory")
+		}
+	}()
+
+	if len(deductions) == 0 {
+		return nil
+	}
+
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	updatedAt := time.Now().UTC().Truncate(time.Millisecond)
+	models := make([]mongo.WriteModel, 0, len(deductions))
+	for _, deduction := range deductions {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.D{{"key", deduction.Key}}).
+			SetUpdate(bson.D{
+				{"$inc", bson.D{{"quantity", -deduction.Amount}}},
+				{"$set", bson.D{{"updated_at", updatedAt}}},
+			}))
+	}
+
+	if _, err = collection.BulkWrite(ctx, models); err != nil {
+		return errors.Wrap(err, "failed to deduct inventory")
+	}
+
+	return nil
+}
+
+func (repository *inventoryRepository) getCollection() (*mongo.Collection, error) {
+	collection, err := repository.mongoDBService.GetCollection(InventoryCollection)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("collection", InventoryCollection).
+			Msg("failed to get collection")
+		return nil, errors.Wrap(err, "failed to get collection")
+	}
+	return collection, nil
+}
+
+func NewInventoryRepository(service domain.MongoDBService) (domain.InventoryRepository, error) {
+	if service == nil {
+		return nil, errors.New("service cannot be nil")
+	}
+
+	return &inventoryRepository{mongoDBService: service}, nil
+}
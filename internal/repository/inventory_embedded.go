@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/domain"
+)
+
+type embeddedInventoryRepository struct {
+	collection *embeddedCollection[domain.InventoryItemEntity]
+}
+
+// Upsert stores the item under its key. The item keeps the id of the item
+// already stored under the key, so the service looks it up first.
+func (repository *embeddedInventoryRepository) Upsert(ctx context.Context, item domain.InventoryItemEntity) (domain.InventoryItemEntity, error) {
+	if err := repository.collection.save(ctx, item); err != nil {
+		return domain.InventoryItemEntity{}, errors.Wrap(err, "failed to upsert inventory item")
+	}
+	return item, nil
+}
+
+func (repository *embeddedInventoryRepository) GetById(ctx context.Context, id uuid.UUID) (domain.InventoryItemEntity, error) {
+	item, err := repository.collection.get(ctx, id)
+	return item, errors.Wrap(err, "failed to find inventory item")
+}
+
+func (repository *embeddedInventoryRepository) GetByKey(ctx context.Context, key string) (domain.InventoryItemEntity, error) {
+	item, err := repository.collection.findOne(ctx, func(item domain.InventoryItemEntity) bool {
+		return item.Key == key
+	})
+	return item, errors.Wrap(err, "failed to find inventory item")
+}
+
+func (repository *embeddedInventoryRepository) Find(ctx context.Context, lowStock bool) ([]domain.InventoryItemEntity, error) {
+	return repository.find(ctx, func(item domain.InventoryItemEntity) bool {
+		return !lowStock || (item.LowStockThreshold > 0 && item.Quantity <= item.LowStockThreshold)
+	})
+}
+
+func (repository *embeddedInventoryRepository) FindByKeys(ctx context.Context, keys []string) ([]domain.InventoryItemEntity, error) {
+	return repository.find(ctx, func(item domain.InventoryItemEntity) bool {
+		return slices.Contains(keys, item.Key)
+	})
+}
+
+// find returns the matching items ordered by kind and name.
+func (repository *embeddedInventoryRepository) find(ctx context.Context, match func(item domain.InventoryItemEntity) bool) ([]domain.InventoryItemEntity, error) {
+	items, err := repository.collection.find(ctx, match)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find inventory items")
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Kind != items[j].Kind {
+			return items[i].Kind < items[j].Kind
+		}
+		return items[i].Name < items[j].Name
+	})
+
+	return items, nil
+}
+
+func (repository *embeddedInventoryRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return errors.Wrap(repository.collection.delete(ctx, id), "failed to delete inventory item")
+}
+
+// Deduct takes all deductions off their items in a single write, so either
+// every tracked item is deducted or none is. Deductions of untracked items
+// are skipped.
+func (repository *embeddedInventoryRepository) Deduct(ctx context.Context, deductions []domain.InventoryDeduction) error {
+	if len(deductions) == 0 {
+		return nil
+	}
+
+	amounts := map[string]float64{}
+	for _, deduction := range deductions {
+		amounts[deduction.Key] += deduction.Amount
+	}
+
+	updatedAt := time.Now().UTC().Truncate(time.Millisecond)
+	_, err := repository.collection.update(ctx, func(item domain.InventoryItemEntity) bool {
+		_, ok := amounts[item.Key]
+		return ok
+	}, func(item *domain.InventoryItemEntity) {
+		item.Quantity -= amounts[item.Key]
+		item.UpdatedAt = &updatedAt
+	})
+
+	return errors.Wrap(err, "failed to deduct inventory")
+}
+
+func NewEmbeddedInventoryRepository(database domain.EmbeddedDatabase) (domain.InventoryRepository, error) {
+	collection, err := newEmbeddedCollection(database, InventoryCollection,
+		func(item domain.InventoryItemEntity) uuid.UUID { return item.Id },
+		uniqueIndex[domain.InventoryItemEntity]{name: "key_1", key: func(item domain.InventoryItemEntity) string { return item.Key }},
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create collection")
+	}
+
+	return &embeddedInventoryRepository{collection: collection}, nil
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/test"
+)
+
+func TestEmbeddedInventoryRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(EmbeddedInventoryRepositoryTestSuite))
+}
+
+type EmbeddedInventoryRepositoryTestSuite struct {
+	test.GoMockTestSuite
+
+	target domain.InventoryRepository
+}
+
+func (suite *EmbeddedInventoryRepositoryTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.target = test.Must(func() (domain.InventoryRepository, error) {
+		return NewEmbeddedInventoryRepository(newEmptyEmbeddedDatabase(suite.MockCtrl))
+	})
+
+	for _, item := range []domain.InventoryItemEntity{
+		{Id: test.FirstId, Key: "water", Kind: "water", Name: "Water", Quantity: 5000},
+		{Id: test.SecondId, Key: "flour:rye", Kind: "flour", Name: "Rye", Quantity: 800, LowStockThreshold: 1000},
+		{Id: test.ThirdId, Key: "flour:spelt", Kind: "flour", Name: "Spelt", Quantity: 2000, LowStockThreshold: 1000},
+	} {
+		_, err := suite.target.Upsert(context.Background(), item)
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *EmbeddedInventoryRepositoryTestSuite) TestUpsert() {
+	_, err := suite.target.Upsert(context.Background(), domain.InventoryItemEntity{Id: test.FirstId, Key: "water", Quantity: 10})
+	suite.NoError(err)
+
+	actual, err := suite.target.GetByKey(context.Background(), "water")
+	suite.NoError(err)
+	suite.Equal(10.0, actual.Quantity)
+
+	_, err = suite.target.Upsert(context.Background(), domain.InventoryItemEntity{Id: uuid.New(), Key: "water"})
+	suite.True(mongo.IsDuplicateKeyError(err))
+}
+
+func (suite *EmbeddedInventoryRepositoryTestSuite) TestFind() {
+	actual, err := suite.target.Find(context.Background(), false)
+
+	suite.NoError(err)
+	suite.Equal([]string{"Rye", "Spelt", "Water"}, []string{actual[0].Name, actual[1].Name, actual[2].Name})
+
+	actual, err = suite.target.Find(context.Background(), true)
+
+	suite.NoError(err)
+	suite.Len(actual, 1)
+	suite.Equal(test.SecondId, actual[0].Id)
+}
+
+func (suite *EmbeddedInventoryRepositoryTestSuite) TestDeduct() {
+	err := suite.target.Deduct(context.Background(), []domain.InventoryDeduction{
+		{Key: "flour:rye", Amount: 300},
+		{Key: "flour:rye", Amount: 200},
+		{Key: "water", Amount: 1000},
+		{Key: "salt", Amount: 20},
+	})
+
+	suite.NoError(err)
+	actual, err := suite.target.FindByKeys(context.Background(), []string{"flour:rye", "water"})
+	suite.NoError(err)
+	suite.Equal(300.0, actual[0].Quantity)
+	suite.NotNil(actual[0].UpdatedAt)
+	suite.Equal(4000.0, actual[1].Quantity)
+}
+
+func (suite *EmbeddedInventoryRepositoryTestSuite) TestDelete() {
+	suite.NoError(suite.target.Delete(context.Background(), test.FirstId))
+
+	suite.ErrorIs(suite.target.Delete(context.Background(), test.FirstId), mongo.ErrNoDocuments)
+}
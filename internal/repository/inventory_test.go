@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	"dough-calculator/internal/test"
+)
+
+func TestInventoryRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(InventoryRepositoryTestSuite))
+}
+
+type InventoryRepositoryTestSuite struct {
+	test.GoMockTestSuite
+
+	mongoDBService *mocks.MockMongoDBService
+
+	target *inventoryRepository
+}
+
+func (suite *InventoryRepositoryTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.mongoDBService = mocks.NewMockMongoDBService(suite.MockCtrl)
+
+	suite.target = &inventoryRepository{
+		mongoDBService: suite.mongoDBService,
+	}
+}
+
+func (suite *InventoryRepositoryTestSuite) TestNewInventoryRepository_WithError() {
+	tests := []struct {
+		name           string
+		mongoDBService domain.MongoDBService
+		errorMsg       string
+	}{
+		{
+			name:           "mongoDBService is nil",
+			mongoDBService: nil,
+			errorMsg:       "service cannot be nil",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			repository, err := NewInventoryRepository(tt.mongoDBService)
+
+			suite.ErrorContains(err, tt.errorMsg)
+			suite.Nil(repository)
+		})
+	}
+}
+
+func (suite *InventoryRepositoryTestSuite) TestUpsert_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(InventoryCollection).
+		Return(nil, assert.AnError)
+
+	entity, err := suite.target.Upsert(context.Background(), domain.InventoryItemEntity{})
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Equal(domain.InventoryItemEntity{}, entity)
+}
+
+func (suite *InventoryRepositoryTestSuite) TestGetById_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(InventoryCollection).
+		Return(nil, assert.AnError)
+
+	entity, err := suite.target.GetById(context.Background(), uuid.UUID{})
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Equal(domain.InventoryItemEntity{}, entity)
+}
+
+func (suite *InventoryRepositoryTestSuite) TestGetByKey_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(InventoryCollection).
+		Return(nil, assert.AnError)
+
+	entity, err := suite.target.GetByKey(context.Background(), "salt")
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Equal(domain.InventoryItemEntity{}, entity)
+}
+
+func (suite *InventoryRepositoryTestSuite) TestFind_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(InventoryCollection).
+		Return(nil, assert.AnError)
+
+	entities, err := suite.target.Find(context.Background(), true)
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Nil(entities)
+}
+
+func (suite *InventoryRepositoryTestSuite) TestFindByKeys_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(InventoryCollection).
+		Return(nil, assert.AnError)
+
+	entities, err := suite.target.FindByKeys(context.Background(), []string{"salt"})
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Nil(entities)
+}
+
+func (suite *InventoryRepositoryTestSuite) TestDelete_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(InventoryCollection).
+		Return(nil, assert.AnError)
+
+	err := suite.target.Delete(context.Background(), uuid.UUID{})
+
+	suite.ErrorContains(err, "failed to get collection")
+}
+
+func (suite *InventoryRepositoryTestSuite) TestDeduct_WithoutDeductions() {
+	err := suite.target.Deduct(context.Background(), nil)
+
+	suite.NoError(err)
+}
+
+func (suite *InventoryRepositoryTestSuite) TestDeduct_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(InventoryCollection).
+		Return(nil, assert.AnError)
+
+	err := suite.target.Deduct(context.Background(), []domain.InventoryDeduction{{Key: "salt", Amount: 10}})
+
+	suite.ErrorContains(err, "failed to get collection")
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"dough-calculator/internal/config"
+	"dough-calculator/internal/domain"
+)
+
+type localBlobStore struct {
+	root string
+}
+
+func (store *localBlobStore) Put(_ context.Context, key string, content io.Reader) (err error) {
+	path, err := store.path(key)
+	if err != nil {
+		return
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return errors.Wrap(err, "failed to create blob directory")
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return errors.Wrap(err, "failed to create blob file")
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(file.Name())
+		}
+	}()
+
+	_, err = io.Copy(file, content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to write blob")
+	}
+
+	if err = os.Rename(file.Name(), path); err != nil {
+		return errors.Wrap(err, "failed to store blob")
+	}
+
+	log.Debug().Str("key", key).Msg("Stored blob on local disk")
+
+	return nil
+}
+
+func (store *localBlobStore) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	path, err := store.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, errors.Wrapf(domain.ErrBlobNotFound, "blob '%s'", key)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open blob")
+	}
+
+	return file, nil
+}
+
+func (store *localBlobStore) Delete(_ context.Context, key string) error {
+	path, err := store.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return errors.Wrap(err, "failed to delete blob")
+	}
+
+	return nil
+}
+
+// path resolves the key below the root directory and rejects keys escaping it.
+func (store *localBlobStore) path(key string) (string, error) {
+	path := filepath.Join(store.root, filepath.FromSlash(key))
+	if !strings.HasPrefix(path, store.root+string(filepath.Separator)) {
+		return "", errors.Errorf("invalid blob key '%s'", key)
+	}
+	return path, nil
+}
+
+func NewLocalBlobStore(storage config.LocalStorage) (domain.BlobStore, error) {
+	if storage.Path == "" {
+		return nil, errors.New("path cannot be empty")
+	}
+
+	root, err := filepath.Abs(storage.Path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve path")
+	}
+
+	if err = os.MkdirAll(root, 0o755); err != nil {
+		return nil, errors.Wrap(err, "failed to create root directory")
+	}
+
+	return &localBlobStore{root: root}, nil
+}
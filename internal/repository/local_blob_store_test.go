@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"dough-calculator/internal/config"
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/test"
+)
+
+func TestLocalBlobStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(LocalBlobStoreTestSuite))
+}
+
+type LocalBlobStoreTestSuite struct {
+	suite.Suite
+
+	root   string
+	target domain.BlobStore
+}
+
+func (suite *LocalBlobStoreTestSuite) SetupTest() {
+	suite.root = suite.T().TempDir()
+
+	suite.target = test.Must(func() (domain.BlobStore, error) {
+		return NewLocalBlobStore(config.LocalStorage{Path: suite.root})
+	})
+}
+
+func (suite *LocalBlobStoreTestSuite) TestPutAndGet() {
+	err := suite.target.Put(context.Background(), "images/recipe/image", bytes.NewReader([]byte("content")))
+	suite.Require().NoError(err)
+
+	reader, err := suite.target.Get(context.Background(), "images/recipe/image")
+	suite.Require().NoError(err)
+	defer reader.Close()
+
+	content, err := io.ReadAll(reader)
+	suite.NoError(err)
+	suite.Equal("content", string(content))
+	suite.FileExists(filepath.Join(suite.root, "images", "recipe", "image"))
+}
+
+func (suite *LocalBlobStoreTestSuite) TestPut_ShouldOverwrite() {
+	suite.Require().NoError(suite.target.Put(context.Background(), "image", bytes.NewReader([]byte("first"))))
+	suite.Require().NoError(suite.target.Put(context.Background(), "image", bytes.NewReader([]byte("second"))))
+
+	content, err := os.ReadFile(filepath.Join(suite.root, "image"))
+	suite.NoError(err)
+	suite.Equal("second", string(content))
+}
+
+func (suite *LocalBlobStoreTestSuite) TestGet_WithMissingBlob_ShouldReturnBlobNotFoundError() {
+	reader, err := suite.target.Get(context.Background(), "missing")
+
+	suite.ErrorIs(err, domain.ErrBlobNotFound)
+	suite.Nil(reader)
+}
+
+func (suite *LocalBlobStoreTestSuite) TestDelete() {
+	suite.Require().NoError(suite.target.Put(context.Background(), "image", bytes.NewReader([]byte("content"))))
+
+	suite.NoError(suite.target.Delete(context.Background(), "image"))
+	suite.NoFileExists(filepath.Join(suite.root, "image"))
+}
+
+func (suite *LocalBlobStoreTestSuite) TestDelete_WithMissingBlob_ShouldNotFail() {
+	suite.NoError(suite.target.Delete(context.Background(), "missing"))
+}
+
+func (suite *LocalBlobStoreTestSuite) TestInvalidKey() {
+	for _, key := range []string{"", "../outside", "images/../../outside"} {
+		suite.Run(key, func() {
+			suite.ErrorContains(suite.target.Put(context.Background(), key, bytes.NewReader(nil)), "invalid blob key")
+
+			_, err := suite.target.Get(context.Background(), key)
+			suite.ErrorContains(err, "invalid blob key")
+
+			suite.ErrorContains(suite.target.Delete(context.Background(), key), "invalid blob key")
+		})
+	}
+}
+
+func (suite *LocalBlobStoreTestSuite) TestNewLocalBlobStore_WithEmptyPath() {
+	store, err := NewLocalBlobStore(config.LocalStorage{})
+
+	suite.ErrorContains(err, "path cannot be empty")
+	suite.Nil(store)
+}
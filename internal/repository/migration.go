@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"dough-calculator/internal/domain"
+)
+
+const (
+	MigrationCollection     = "schema_migrations"
+	MigrationLockCollection = "schema_migrations_lock"
+
+	// migrationLockId is the id of the single lock document
+	migrationLockId = "migrations"
+)
+
+type migrationRepository struct {
+	mongoDBService domain.MongoDBService
+}
+
+func (repository *migrationRepository) FindApplied(ctx context.Context) (result []domain.MigrationEntity, err error) {
+	collection, err := repository.getCollection(MigrationCollection)
+	if err != nil {
+		return
+	}
+
+	cursor, err := collection.Find(ctx, bson.D{}, options.Find().SetSort(bson.D{{"_id", 1}}))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find applied migrations")
+	}
+
+	result = []domain.MigrationEntity{}
+	if err = cursor.All(ctx, &result); err != nil {
+		return nil, errors.Wrap(err, "failed to decode applied migrations")
+	}
+
+	return
+}
+
+func (repository *migrationRepository) Save(ctx context.Context, migration domain.MigrationEntity) error {
+	collection, err := repository.getCollection(MigrationCollection)
+	if err != nil {
+		return err
+	}
+
+	_, err = collection.ReplaceOne(ctx, bson.D{{"_id", migration.Version}}, migration, options.Replace().SetUpsert(true))
+	if err != nil {
+		log.Error().
+			Err(err).
+			Int("version", migration.Version).
+			Msg("failed to save migration")
+		return errors.Wrap(err, "failed to save migration")
+	}
+
+	return nil
+}
+
+func (repository *migrationRepository) Delete(ctx context.Context, version int) error {
+	collection, err := repository.getCollection(MigrationCollection)
+	if err != nil {
+		return err
+	}
+
+	if _, err = collection.DeleteOne(ctx, bson.D{{"_id", version}}); err != nil {
+		log.Error().
+			Err(err).
+			Int("version", version).
+			Msg("failed to delete migration")
+		return errors.Wrap(err, "failed to delete migration")
+	}
+
+	return nil
+}
+
+// Lock upserts the lock document only when it is missing or expired. While
+// another owner holds the lock the filter matches nothing, and the upsert
+// fails on the duplicate lock id.
+func (repository *migrationRepository) Lock(ctx context.Context, owner string, expiresAt time.Time) (bool, error) {
+	collection, err := repository.getCollection(MigrationLockCollection)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = collection.UpdateOne(ctx,
+		bson.D{{"_id", migrationLockId}, {"expires_at", bson.D{{"$lt", time.Now().UTC()}}}},
+		bson.D{{"$set", bson.D{{"owner", owner}, {"expires_at", expiresAt.UTC()}}}},
+		options.Update().SetUpsert(true),
+	)
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, "failed to take migration lock")
+	}
+
+	return true, nil
+}
+
+func (repository *migrationRepository) LockExpiresAt(ctx context.Context) (time.Time, error) {
+	collection, err := repository.getCollection(MigrationLockCollection)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var lock struct {
+		ExpiresAt time.Time `bson:"expires_at"`
+	}
+	err = collection.FindOne(ctx, bson.D{{"_id", migrationLockId}}).Decode(&lock)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "failed to find migration lock")
+	}
+
+	return lock.ExpiresAt, nil
+}
+
+// Renew only matches the lock document of owner, so a lock that expired and
+// was taken by another owner is not extended.
+func (repository *migrationRepository) Renew(ctx context.Context, owner string, expiresAt time.Time) (bool, error) {
+	collection, err := repository.getCollection(MigrationLockCollection)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := collection.UpdateOne(ctx,
+		bson.D{{"_id", migrationLockId}, {"owner", owner}},
+		bson.D{{"$set", bson.D{{"expires_at", expiresAt.UTC()}}}},
+	)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to renew migration lock")
+	}
+
+	return result.MatchedCount > 0, nil
+}
+
+func (repository *migrationRepository) Unlock(ctx context.Context, owner string) error {
+	collection, err := repository.getCollection(MigrationLockCollection)
+	if err != nil {
+		return err
+	}
+
+	_, err = collection.DeleteOne(ctx, bson.D{{"_id", migrationLockId}, {"owner", owner}})
+	return errors.Wrap(err, "failed to release migration lock")
+}
+
+func (repository *migrationRepository) getCollection(name string) (*mongo.Collection, error) {
+	collection, err := repository.mongoDBService.GetCollection(name)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("collection", name).
+			Msg("failed to get collection")
+		return nil, errors.Wrap(err, "failed to get collection")
+	}
+	return collection, nil
+}
+
+func NewMigrationRepository(service domain.MongoDBService) (domain.MigrationRepository, error) {
+	if service == nil {
+		return nil, errors.New("service cannot be nil")
+	}
+
+	return &migrationRepository{mongoDBService: service}, nil
+}
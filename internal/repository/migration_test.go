@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	"dough-calculator/internal/test"
+)
+
+func TestMigrationRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(MigrationRepositoryTestSuite))
+}
+
+type MigrationRepositoryTestSuite struct {
+	test.GoMockTestSuite
+
+	mongoDBService *mocks.MockMongoDBService
+
+	target *migrationRepository
+}
+
+func (suite *MigrationRepositoryTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.mongoDBService = mocks.NewMockMongoDBService(suite.MockCtrl)
+
+	suite.target = &migrationRepository{
+		mongoDBService: suite.mongoDBService,
+	}
+}
+
+func (suite *MigrationRepositoryTestSuite) TestNewMigrationRepository() {
+	repository, err := NewMigrationRepository(suite.mongoDBService)
+
+	suite.NoError(err)
+	suite.NotNil(repository)
+
+	repository, err = NewMigrationRepository(nil)
+
+	suite.ErrorContains(err, "service cannot be nil")
+	suite.Nil(repository)
+}
+
+func (suite *MigrationRepositoryTestSuite) TestMethods_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(MigrationCollection).
+		Return(nil, assert.AnError).Times(3)
+	suite.mongoDBService.EXPECT().GetCollection(MigrationLockCollection).
+		Return(nil, assert.AnError).Times(4)
+	ctx := context.Background()
+
+	applied, err := suite.target.FindApplied(ctx)
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Nil(applied)
+
+	suite.ErrorContains(suite.target.Save(ctx, domain.MigrationEntity{Version: 1}), "failed to get collection")
+	suite.ErrorContains(suite.target.Delete(ctx, 1), "failed to get collection")
+
+	locked, err := suite.target.Lock(ctx, "owner", time.Now())
+	suite.ErrorContains(err, "failed to get collection")
+	suite.False(locked)
+
+	expiresAt, err := suite.target.LockExpiresAt(ctx)
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Zero(expiresAt)
+
+	renewed, err := suite.target.Renew(ctx, "owner", time.Now())
+	suite.ErrorContains(err, "failed to get collection")
+	suite.False(renewed)
+
+	suite.ErrorContains(suite.target.Unlock(ctx, "owner"), "failed to get collection")
+}
+
+func TestNewMigrations(t *testing.T) {
+	migrations, err := NewMigrations(nil)
+
+	assert.ErrorContains(t, err, "service cannot be nil")
+	assert.Nil(t, migrations)
+
+	migrations, err = NewMigrations(&mocks.MockMongoDBService{})
+
+	assert.NoError(t, err)
+	for i, migration := range migrations {
+		assert.Equal(t, i+1, migration.Version)
+		assert.NotEmpty(t, migration.Description)
+	}
+}
+
+func TestIndexMigration_WithErrorOnGetCollection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := mocks.NewMockMongoDBService(ctrl)
+	service.EXPECT().GetCollection(FlourCollection).
+		Return(nil, assert.AnError).Times(2)
+	migration := indexMigration(service, 1, "flour", []collectionIndexes{
+		{collection: FlourCollection},
+	})
+
+	assert.ErrorContains(t, migration.Up(context.Background()), "failed to get collection")
+	assert.ErrorContains(t, migration.Down(context.Background()), "failed to get collection")
+}
+
+func TestIndexName(t *testing.T) {
+	assert.Equal(t, "recipe_id_1_baked_at_-1", indexName(mongo.IndexModel{
+		Keys: bson.D{{"recipe_id", 1}, {"baked_at", -1}},
+	}))
+	assert.Equal(t, "code_1", indexName(mongo.IndexModel{
+		Keys:    bson.D{{"code", 1}},
+		Options: options.Index().SetUnique(true),
+	}))
+	assert.Equal(t, textSearchIndex, indexName(mongo.IndexModel{
+		Keys:    bson.D{{"name", "text"}},
+		Options: options.Index().SetName(textSearchIndex),
+	}))
+}
+
+func TestIsMissingIndexError(t *testing.T) {
+	assert.True(t, isMissingIndexError(mongo.CommandError{Code: indexNotFoundCode}))
+	assert.True(t, isMissingIndexError(mongo.CommandError{Code: namespaceNotFoundCode}))
+	assert.False(t, isMissingIndexError(mongo.CommandError{Code: 1}))
+	assert.False(t, isMissingIndexError(assert.AnError))
+	assert.False(t, isMissingIndexError(nil))
+}
@@ -0,0 +1,229 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"dough-calculator/internal/domain"
+)
+
+const (
+	namespaceNotFoundCode = 26
+	indexNotFoundCode     = 27
+)
+
+// collectionIndexes are the indexes a migration creates on a collection.
+type collectionIndexes struct {
+	collection string
+	indexes    []mongo.IndexModel
+}
+
+// initialIndexes are the indexes the repositories created on their own
+// before migrations existed. Indexes added later belong to new migrations,
+// this list must not change.
+var initialIndexes = []collectionIndexes{
+	{
+		collection: SourdoughRecipeCollection,
+		indexes: []mongo.IndexModel{
+			{
+				Keys:    bson.D{{"name", 1}},
+				Options: options.Index().SetUnique(true),
+			},
+			{
+				Keys: bson.D{{"ancestors", 1}},
+			},
+			{
+				Keys: bson.D{{"tags", 1}},
+			},
+			{
+				Keys: bson.D{{"category", 1}},
+			},
+			{
+				Keys: bson.D{{"created_at", -1}, {"_id", -1}},
+			},
+			{
+				Keys: bson.D{{"updated_at", -1}, {"_id", -1}},
+			},
+			{
+				Keys: bson.D{{hydrationField, 1}, {"_id", 1}},
+			},
+			{
+				Keys: bson.D{{totalWeightField, 1}, {"_id", 1}},
+			},
+			{
+				Keys: bson.D{{"name", "text"}, {"description", "text"}, {"tags", "text"}, {flourNameField, "text"}},
+				Options: options.Index().
+					SetName(textSearchIndex).
+					SetWeights(bson.D{{"name", 10}, {"tags", 5}, {flourNameField, 3}, {"description", 1}}),
+			},
+		},
+	},
+	{
+		collection: SourdoughRecipeRevisionCollection,
+		indexes: []mongo.IndexModel{
+			{
+				Keys:    bson.D{{"recipe_id", 1}, {"version", 1}},
+				Options: options.Index().SetUnique(true),
+			},
+		},
+	},
+	{
+		collection: FlourCollection,
+		indexes: []mongo.IndexModel{
+			{
+				Keys:    bson.D{{"name", 1}},
+				Options: options.Index().SetUnique(true),
+			},
+			{
+				Keys: bson.D{{"created_at", -1}, {"_id", -1}},
+			},
+			{
+				Keys: bson.D{{"flourtype", 1}},
+			},
+			{
+				Keys: bson.D{{"protein_content", 1}, {"_id", 1}},
+			},
+			{
+				Keys: bson.D{{"name", "text"}, {"description", "text"}, {"flourtype", "text"}},
+				Options: options.Index().
+					SetName(textSearchIndex).
+					SetWeights(bson.D{{"name", 10}, {"flourtype", 5}, {"description", 1}}),
+			},
+		},
+	},
+	{
+		collection: FlourTypeCollection,
+		indexes: []mongo.IndexModel{
+			{
+				Keys:    bson.D{{"code", 1}},
+				Options: options.Index().SetUnique(true),
+			},
+		},
+	},
+	{
+		collection: BakeLogCollection,
+		indexes: []mongo.IndexModel{
+			{
+				Keys: bson.D{{"recipe_id", 1}, {"baked_at", -1}},
+			},
+		},
+	},
+	{
+		collection: ImageCollection,
+		indexes: []mongo.IndexModel{
+			{
+				Keys: bson.D{{"recipe_id", 1}, {"created_at", -1}},
+			},
+			{
+				Keys: bson.D{{"bake_log_id", 1}},
+			},
+		},
+	},
+	{
+		collection: InventoryCollection,
+		indexes: []mongo.IndexModel{
+			{
+				Keys:    bson.D{{"key", 1}},
+				Options: options.Index().SetUnique(true),
+			},
+		},
+	},
+	{
+		collection: ProductionPlanCollection,
+		indexes: []mongo.IndexModel{
+			{
+				Keys: bson.D{{"date", -1}},
+			},
+		},
+	},
+}
+
+// indexMigration creates indexes on the way up and drops them on the way
+// down. Creating an index that exists with the same options does nothing,
+// so databases set up by earlier releases migrate without changes.
+func indexMigration(
+	service domain.MongoDBService,
+	version int,
+	description string,
+	indexes []collectionIndexes,
+) domain.Migration {
+	return domain.Migration{
+		Version:     version,
+		Description: description,
+		Up: func(ctx context.Context) error {
+			for _, collectionIndexes := range indexes {
+				collection, err := service.GetCollection(collectionIndexes.collection)
+				if err != nil {
+					return errors.Wrap(err, "failed to get collection")
+				}
+
+				if _, err = collection.Indexes().CreateMany(ctx, collectionIndexes.indexes); err != nil {
+					return errors.Wrapf(err, "failed to create indexes of %s", collectionIndexes.collection)
+				}
+			}
+			return nil
+		},
+		Down: func(ctx context.Context) error {
+			for _, collectionIndexes := range indexes {
+				collection, err := service.GetCollection(collectionIndexes.collection)
+				if err != nil {
+					return errors.Wrap(err, "failed to get collection")
+				}
+
+				for _, index := range collectionIndexes.indexes {
+					name := indexName(index)
+					_, err = collection.Indexes().DropOne(ctx, name)
+					if isMissingIndexError(err) {
+						log.Debug().Str("collection", collectionIndexes.collection).Str("index", name).Msg("Index already dropped")
+						continue
+					}
+					if err != nil {
+						return errors.Wrapf(err, "failed to drop index %s of %s", name, collectionIndexes.collection)
+					}
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// indexName returns the name MongoDB gives an index, the configured name or
+// the keys joined with their directions.
+func indexName(index mongo.IndexModel) string {
+	if index.Options != nil && index.Options.Name != nil {
+		return *index.Options.Name
+	}
+
+	keys, _ := index.Keys.(bson.D)
+	parts := make([]string, 0, 2*len(keys))
+	for _, key := range keys {
+		parts = append(parts, key.Key, fmt.Sprint(key.Value))
+	}
+	return strings.Join(parts, "_")
+}
+
+func isMissingIndexError(err error) bool {
+	var commandError mongo.CommandError
+	return errors.As(err, &commandError) &&
+		(commandError.Code == indexNotFoundCode || commandError.Code == namespaceNotFoundCode)
+}
+
+// NewMigrations returns the schema migrations of the MongoDB collections in
+// the order they apply. Add new migrations at the end with the next version
+// and never change a released one, databases record them as applied.
+func NewMigrations(service domain.MongoDBService) ([]domain.Migration, error) {
+	if service == nil {
+		return nil, errors.New("service cannot be nil")
+	}
+
+	return []domain.Migration{
+		indexMigration(service, 1, "create the indexes of every collection", initialIndexes),
+	}, nil
+}
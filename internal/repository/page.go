@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"slices"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"dough-calculator/internal/domain"
+)
+
+// pageQuery narrows filter down to the items of the requested page and
+// returns the find options ordering them by the sort field and then by id.
+// One item more than the limit is fetched to tell whether another page
+// follows.
+func pageQuery(filter bson.D, page domain.PageRequest) (bson.D, *options.FindOptions) {
+	descending, key := page.Descending, page.After
+	if page.Before != nil {
+		descending, key = !descending, page.Before
+	}
+
+	direction := 1
+	if descending {
+		direction = -1
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{page.SortField, direction}, {"_id", direction}}).
+		SetLimit(int64(page.Limit + 1))
+
+	if key == nil {
+		if page.Offset > 0 {
+			opts.SetSkip(int64(page.Offset))
+		}
+		return filter, opts
+	}
+
+	return bson.D{{"$and", bson.A{filter, keysetCondition(page.SortField, descending, *key)}}}, opts
+}
+
+// keysetCondition matches the items following key in the given order.
+// MongoDB sorts missing values before any other value, so a nil key value
+// is handled separately.
+func keysetCondition(field string, descending bool, key domain.PageKey) bson.D {
+	switch {
+	case !descending && key.Value != nil:
+		return bson.D{{"$or", bson.A{
+			bson.D{{field, bson.D{{"$gt", key.Value}}}},
+			bson.D{{field, key.Value}, {"_id", bson.D{{"$gt", key.Id}}}},
+		}}}
+	case !descending:
+		return bson.D{{"$or", bson.A{
+			bson.D{{field, nil}, {"_id", bson.D{{"$gt", key.Id}}}},
+			bson.D{{field, bson.D{{"$ne", nil}}}},
+		}}}
+	case key.Value != nil:
+		return bson.D{{"$or", bson.A{
+			bson.D{{field, bson.D{{"$lt", key.Value}}}},
+			bson.D{{field, key.Value}, {"_id", bson.D{{"$lt", key.Id}}}},
+			bson.D{{field, nil}},
+		}}}
+	default:
+		return bson.D{{field, nil}, {"_id", bson.D{{"$lt", key.Id}}}}
+	}
+}
+
+// pageResult trims the items fetched for a page query down to the page
+// limit, restores their order when paging backwards and reports whether
+// there are pages before and after them.
+func pageResult[T any](items []T, page domain.PageRequest) ([]T, domain.PageInfo) {
+	more := len(items) > page.Limit
+	if more {
+		items = items[:page.Limit]
+	}
+
+	if page.Before != nil {
+		slices.Reverse(items)
+		return items, domain.PageInfo{HasNext: true, HasPrev: more}
+	}
+
+	return items, domain.PageInfo{HasNext: more, HasPrev: page.After != nil || page.Offset > 0}
+}
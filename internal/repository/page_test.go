@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"dough-calculator/internal/domain"
+)
+
+func TestPageQuery(t *testing.T) {
+	id := uuid.New()
+	filter := bson.D{{"tags", "rye"}}
+
+	tests := []struct {
+		name          string
+		page          domain.PageRequest
+		expectedQuery bson.D
+		expectedSort  bson.D
+		expectedSkip  *int64
+	}{
+		{
+			name:          "first page",
+			page:          domain.PageRequest{SortField: "name", Offset: 20, Limit: 10},
+			expectedQuery: filter,
+			expectedSort:  bson.D{{"name", 1}, {"_id", 1}},
+			expectedSkip:  int64Pointer(20),
+		},
+		{
+			name: "ascending after",
+			page: domain.PageRequest{SortField: "name", After: &domain.PageKey{Value: "rye", Id: id}, Offset: 20, Limit: 10},
+			expectedQuery: bson.D{{"$and", bson.A{filter, bson.D{{"$or", bson.A{
+				bson.D{{"name", bson.D{{"$gt", "rye"}}}},
+				bson.D{{"name", "rye"}, {"_id", bson.D{{"$gt", id}}}},
+			}}}}}},
+			expectedSort: bson.D{{"name", 1}, {"_id", 1}},
+		},
+		{
+			name: "ascending after missing value",
+			page: domain.PageRequest{SortField: "updated_at", After: &domain.PageKey{Id: id}, Limit: 10},
+			expectedQuery: bson.D{{"$and", bson.A{filter, bson.D{{"$or", bson.A{
+				bson.D{{"updated_at", nil}, {"_id", bson.D{{"$gt", id}}}},
+				bson.D{{"updated_at", bson.D{{"$ne", nil}}}},
+			}}}}}},
+			expectedSort: bson.D{{"updated_at", 1}, {"_id", 1}},
+		},
+		{
+			name: "descending after",
+			page: domain.PageRequest{SortField: "name", Descending: true, After: &domain.PageKey{Value: "rye", Id: id}, Limit: 10},
+			expectedQuery: bson.D{{"$and", bson.A{filter, bson.D{{"$or", bson.A{
+				bson.D{{"name", bson.D{{"$lt", "rye"}}}},
+				bson.D{{"name", "rye"}, {"_id", bson.D{{"$lt", id}}}},
+				bson.D{{"name", nil}},
+			}}}}}},
+			expectedSort: bson.D{{"name", -1}, {"_id", -1}},
+		},
+		{
+			name: "descending before reverses the order",
+			page: domain.PageRequest{SortField: "updated_at", Descending: true, Before: &domain.PageKey{Id: id}, Limit: 10},
+			expectedQuery: bson.D{{"$and", bson.A{filter, bson.D{{"$or", bson.A{
+				bson.D{{"updated_at", nil}, {"_id", bson.D{{"$gt", id}}}},
+				bson.D{{"updated_at", bson.D{{"$ne", nil}}}},
+			}}}}}},
+			expectedSort: bson.D{{"updated_at", 1}, {"_id", 1}},
+		},
+		{
+			name:          "ascending before",
+			page:          domain.PageRequest{SortField: "updated_at", Before: &domain.PageKey{Id: id}, Limit: 10},
+			expectedQuery: bson.D{{"$and", bson.A{filter, bson.D{{"updated_at", nil}, {"_id", bson.D{{"$lt", id}}}}}}},
+			expectedSort:  bson.D{{"updated_at", -1}, {"_id", -1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, opts := pageQuery(filter, tt.page)
+
+			assert.Equal(t, tt.expectedQuery, query)
+			assert.Equal(t, tt.expectedSort, opts.Sort)
+			assert.Equal(t, tt.expectedSkip, opts.Skip)
+			assert.Equal(t, int64(11), *opts.Limit)
+		})
+	}
+}
+
+func TestPageResult(t *testing.T) {
+	key := &domain.PageKey{Id: uuid.New()}
+
+	tests := []struct {
+		name          string
+		items         []int
+		page          domain.PageRequest
+		expectedItems []int
+		expectedInfo  domain.PageInfo
+	}{
+		{
+			name:          "first page with more",
+			items:         []int{1, 2, 3},
+			page:          domain.PageRequest{Limit: 2},
+			expectedItems: []int{1, 2},
+			expectedInfo:  domain.PageInfo{HasNext: true},
+		},
+		{
+			name:          "last page after offset",
+			items:         []int{3},
+			page:          domain.PageRequest{Offset: 2, Limit: 2},
+			expectedItems: []int{3},
+			expectedInfo:  domain.PageInfo{HasPrev: true},
+		},
+		{
+			name:          "page after cursor",
+			items:         []int{3, 4},
+			page:          domain.PageRequest{After: key, Limit: 2},
+			expectedItems: []int{3, 4},
+			expectedInfo:  domain.PageInfo{HasPrev: true},
+		},
+		{
+			name:          "page before cursor",
+			items:         []int{4, 3, 2},
+			page:          domain.PageRequest{Before: key, Limit: 2},
+			expectedItems: []int{3, 4},
+			expectedInfo:  domain.PageInfo{HasNext: true, HasPrev: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, info := pageResult(tt.items, tt.page)
+
+			assert.Equal(t, tt.expectedItems, items)
+			assert.Equal(t, tt.expectedInfo, info)
+		})
+	}
+}
+
+func int64Pointer(value int64) *int64 {
+	return &value
+}
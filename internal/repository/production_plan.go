@@ -0,0 +1,202 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"dough-calculator/internal/domain"
+)
+
+const (
+	ProductionPlanCollection = "production-plans"
+)
+
+type productionPlanRepository struct {
+	mongoDBService domain.MongoDBService
+}
+
+func (repository *productionPlanRepository) Create(ctx context.Context, plan domain.ProductionPlanEntity) (entity domain.ProductionPlanEntity, err error) {
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	result, err := collection.InsertOne(ctx, plan)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("name", plan.Name).
+			Msg("failed to insert production plan")
+		return domain.ProductionPlanEntity{}, errors.Wrap(err, "failed to insert production plan")
+	}
+
+	log.Debug().Msgf("Inserted a single document: %s", result.InsertedID)
+
+	return plan, nil
+}
+
+func (repository *productionPlanRepository) GetById(ctx context.Context, id uuid.UUID) (entity domain.ProductionPlanEntity, err error) {
+	defer func() {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Stringer("id", id).
+				Msg("failed to get production plan by id")
+		}
+	}()
+
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	err = collection.
+		FindOne(ctx, bson.D{{"_id", id}}).
+		Decode(&entity)
+	if err != nil {
+		return domain.ProductionPlanEntity{}, errors.Wrap(err, "failed to find production plan")
+	}
+
+	return
+}
+
+func (repository *productionPlanRepository) Find(ctx context.Context, offset, limit int) (result []domain.ProductionPlanEntity, err error) {
+	defer func() {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Msg("failed to find production plans")
+		}
+	}()
+
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	cursor, err := collection.Find(ctx, bson.D{}, options.Find().
+		SetLimit(int64(limit)).
+		SetSkip(int64(offset)).
+		SetSort(bson.D{{"date", -1}, {"_id", 1}}))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find production plans")
+	}
+
+	if err = cursor.All(ctx, &result); err != nil {
+		return nil, errors.Wrap(err, "failed to decode production plans")
+	}
+
+	return
+}
+
+// Update replaces the plan unless it is completed, then there is no match
+// and mongo.ErrNoDocuments is returned.
+func (repository *productionPlanRepository) Update(ctx context.Context, plan domain.ProductionPlanEntity) (entity domain.ProductionPlanEntity, err error) {
+	defer func() {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Stringer("id", plan.Id).
+				Msg("failed to update production plan")
+		}
+	}()
+
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	result, err := collection.ReplaceOne(ctx, bson.D{{"_id", plan.Id}, {"completed_at", nil}}, plan)
+	if err != nil {
+		return domain.ProductionPlanEntity{}, errors.Wrap(err, "failed to update production plan")
+	}
+
+	if result.MatchedCount == 0 {
+		return domain.ProductionPlanEntity{}, errors.Wrap(mongo.ErrNoDocuments, "failed to update production plan")
+	}
+
+	return plan, nil
+}
+
+// Complete sets the completion time of the plan unless it is completed
+// already, then there is no match and mongo.ErrNoDocuments is returned.
+func (repository *productionPlanRepository) Complete(ctx context.Context, id uuid.UUID, completedAt time.Time) (entity domain.ProductionPlanEntity, err error) {
+	defer func() {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Stringer("id", id).
+				Msg("failed to complete production plan")
+		}
+	}()
+
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	err = collection.FindOneAndUpdate(ctx,
+		bson.D{{"_id", id}, {"completed_at", nil}},
+		bson.D{{"$set", bson.D{{"completed_at", completedAt}, {"updated_at", completedAt}}}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&entity)
+	if err != nil {
+		return domain.ProductionPlanEntity{}, errors.Wrap(err, "failed to complete production plan")
+	}
+
+	return
+}
+
+func (repository *productionPlanRepository) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	defer func() {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Stringer("id", id).
+				Msg("failed to delete production plan")
+		}
+	}()
+
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	result, err := collection.DeleteOne(ctx, bson.D{{"_id", id}})
+	if err != nil {
+		return errors.Wrap(err, "failed to delete production plan")
+	}
+
+	if result.DeletedCount == 0 {
+		return errors.Wrap(mongo.ErrNoDocuments, "failed to delete production plan")
+	}
+
+	return nil
+}
+
+func (repository *productionPlanRepository) getCollection() (*mongo.Collection, error) {
+	collection, err := repository.mongoDBService.GetCollection(ProductionPlanCollection)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("collection", ProductionPlanCollection).
+			Msg("failed to get collection")
+		return nil, errors.Wrap(err, "failed to get collection")
+	}
+	return collection, nil
+}
+
+func NewProductionPlanRepository(service domain.MongoDBService) (domain.ProductionPlanRepository, error) {
+	if service == nil {
+		return nil, errors.New("service cannot be nil")
+	}
+
+	return &productionPlanRepository{mongoDBService: service}, nil
+}
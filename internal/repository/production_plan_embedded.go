@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"dough-calculator/internal/domain"
+)
+
+type embeddedProductionPlanRepository struct {
+	collection *embeddedCollection[domain.ProductionPlanEntity]
+}
+
+func (repository *embeddedProductionPlanRepository) Create(ctx context.Context, plan domain.ProductionPlanEntity) (domain.ProductionPlanEntity, error) {
+	if err := repository.collection.insert(ctx, plan); err != nil {
+		return domain.ProductionPlanEntity{}, errors.Wrap(err, "failed to insert production plan")
+	}
+	return plan, nil
+}
+
+func (repository *embeddedProductionPlanRepository) GetById(ctx context.Context, id uuid.UUID) (domain.ProductionPlanEntity, error) {
+	plan, err := repository.collection.get(ctx, id)
+	return plan, errors.Wrap(err, "failed to get production plan by id")
+}
+
+// Find returns the production plans, latest date first.
+func (repository *embeddedProductionPlanRepository) Find(ctx context.Context, offset, limit int) ([]domain.ProductionPlanEntity, error) {
+	plans, err := repository.collection.find(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find production plans")
+	}
+
+	sort.SliceStable(plans, func(i, j int) bool { return plans[i].Date.After(plans[j].Date) })
+
+	return window(plans, offset, limit), nil
+}
+
+// Update replaces the plan unless it is completed, then there is no match
+// and mongo.ErrNoDocuments is returned.
+func (repository *embeddedProductionPlanRepository) Update(ctx context.Context, plan domain.ProductionPlanEntity) (domain.ProductionPlanEntity, error) {
+	matched, err := repository.collection.update(
+		ctx,
+		func(existing domain.ProductionPlanEntity) bool {
+			return existing.Id == plan.Id && existing.CompletedAt == nil
+		},
+		func(existing *domain.ProductionPlanEntity) { *existing = plan })
+	if err != nil {
+		return domain.ProductionPlanEntity{}, errors.Wrap(err, "failed to update production plan")
+	}
+	if matched == 0 {
+		return domain.ProductionPlanEntity{}, errors.Wrap(mongo.ErrNoDocuments, "failed to update production plan")
+	}
+	return plan, nil
+}
+
+// Complete sets the completion time of the plan unless it is completed
+// already, then there is no match and mongo.ErrNoDocuments is returned.
+func (repository *embeddedProductionPlanRepository) Complete(ctx context.Context, id uuid.UUID, completedAt time.Time) (domain.ProductionPlanEntity, error) {
+	matched, err := repository.collection.update(
+		ctx,
+		func(plan domain.ProductionPlanEntity) bool { return plan.Id == id && plan.CompletedAt == nil },
+		func(plan *domain.ProductionPlanEntity) {
+			plan.CompletedAt = &completedAt
+			plan.UpdatedAt = &completedAt
+		})
+	if err != nil {
+		return domain.ProductionPlanEntity{}, errors.Wrap(err, "failed to complete production plan")
+	}
+	if matched == 0 {
+		return domain.ProductionPlanEntity{}, errors.Wrap(mongo.ErrNoDocuments, "failed to complete production plan")
+	}
+
+	plan, err := repository.collection.get(ctx, id)
+	return plan, errors.Wrap(err, "failed to get production plan by id")
+}
+
+func (repository *embeddedProductionPlanRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return errors.Wrap(repository.collection.delete(ctx, id), "failed to delete production plan")
+}
+
+func NewEmbeddedProductionPlanRepository(database domain.EmbeddedDatabase) (domain.ProductionPlanRepository, error) {
+	collection, err := newEmbeddedCollection(database, ProductionPlanCollection,
+		func(plan domain.ProductionPlanEntity) uuid.UUID { return plan.Id })
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create collection")
+	}
+
+	return &embeddedProductionPlanRepository{collection: collection}, nil
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/test"
+)
+
+func TestEmbeddedProductionPlanRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(EmbeddedProductionPlanRepositoryTestSuite))
+}
+
+type EmbeddedProductionPlanRepositoryTestSuite struct {
+	test.GoMockTestSuite
+
+	target domain.ProductionPlanRepository
+}
+
+func (suite *EmbeddedProductionPlanRepositoryTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.target = test.Must(func() (domain.ProductionPlanRepository, error) {
+		return NewEmbeddedProductionPlanRepository(newEmptyEmbeddedDatabase(suite.MockCtrl))
+	})
+
+	for i, id := range []uuid.UUID{test.FirstId, test.SecondId, test.ThirdId} {
+		_, err := suite.target.Create(context.Background(), domain.ProductionPlanEntity{
+			Id:   id,
+			Date: test.Date.Add(time.Duration(i) * 24 * time.Hour),
+		})
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *EmbeddedProductionPlanRepositoryTestSuite) TestFind() {
+	actual, err := suite.target.Find(context.Background(), 1, 5)
+
+	suite.NoError(err)
+	suite.Equal([]uuid.UUID{test.SecondId, test.FirstId}, []uuid.UUID{actual[0].Id, actual[1].Id})
+}
+
+func (suite *EmbeddedProductionPlanRepositoryTestSuite) TestUpdate_WithMissingPlan() {
+	_, err := suite.target.Update(context.Background(), domain.ProductionPlanEntity{Id: uuid.New()})
+
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+}
+
+func (suite *EmbeddedProductionPlanRepositoryTestSuite) TestUpdate_WithCompletedPlan() {
+	_, err := suite.target.Complete(context.Background(), test.FirstId, test.Date)
+	suite.Require().NoError(err)
+
+	_, err = suite.target.Update(context.Background(), domain.ProductionPlanEntity{Id: test.FirstId, Name: "changed"})
+
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+
+	actual, err := suite.target.GetById(context.Background(), test.FirstId)
+
+	suite.NoError(err)
+	suite.Empty(actual.Name)
+	suite.NotNil(actual.CompletedAt)
+}
+
+func (suite *EmbeddedProductionPlanRepositoryTestSuite) TestComplete() {
+	completedAt := test.Date.Truncate(time.Millisecond)
+
+	actual, err := suite.target.Complete(context.Background(), test.FirstId, completedAt)
+
+	suite.NoError(err)
+	suite.Equal(completedAt, *actual.CompletedAt)
+	suite.Equal(completedAt, *actual.UpdatedAt)
+
+	_, err = suite.target.Complete(context.Background(), test.FirstId, completedAt.Add(time.Hour))
+
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+
+	_, err = suite.target.Complete(context.Background(), uuid.New(), completedAt)
+
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+}
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	"dough-calculator/internal/test"
+)
+
+func TestProductionPlanRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ProductionPlanRepositoryTestSuite))
+}
+
+type ProductionPlanRepositoryTestSuite struct {
+	test.GoMockTestSuite
+
+	mongoDBService *mocks.MockMongoDBService
+
+	target *productionPlanRepository
+}
+
+func (suite *ProductionPlanRepositoryTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.mongoDBService = mocks.NewMockMongoDBService(suite.MockCtrl)
+
+	suite.target = &productionPlanRepository{
+		mongoDBService: suite.mongoDBService,
+	}
+}
+
+func (suite *ProductionPlanRepositoryTestSuite) TestNewProductionPlanRepository_WithError() {
+	tests := []struct {
+		name           string
+		mongoDBService domain.MongoDBService
+		errorMsg       string
+	}{
+		{
+			name:           "mongoDBService is nil",
+			mongoDBService: nil,
+			errorMsg:       "service cannot be nil",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			repository, err := NewProductionPlanRepository(tt.mongoDBService)
+
+			suite.ErrorContains(err, tt.errorMsg)
+			suite.Nil(repository)
+		})
+	}
+}
+
+func (suite *ProductionPlanRepositoryTestSuite) TestCreate_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(ProductionPlanCollection).
+		Return(nil, assert.AnError)
+
+	entity, err := suite.target.Create(context.Background(), domain.ProductionPlanEntity{})
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Equal(domain.ProductionPlanEntity{}, entity)
+}
+
+func (suite *ProductionPlanRepositoryTestSuite) TestGetById_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(ProductionPlanCollection).
+		Return(nil, assert.AnError)
+
+	entity, err := suite.target.GetById(context.Background(), uuid.UUID{})
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Equal(domain.ProductionPlanEntity{}, entity)
+}
+
+func (suite *ProductionPlanRepositoryTestSuite) TestFind_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(ProductionPlanCollection).
+		Return(nil, assert.AnError)
+
+	entities, err := suite.target.Find(context.Background(), 0, 1)
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Nil(entities)
+}
+
+func (suite *ProductionPlanRepositoryTestSuite) TestUpdate_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(ProductionPlanCollection).
+		Return(nil, assert.AnError)
+
+	entity, err := suite.target.Update(context.Background(), domain.ProductionPlanEntity{})
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Equal(domain.ProductionPlanEntity{}, entity)
+}
+
+func (suite *ProductionPlanRepositoryTestSuite) TestComplete_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(ProductionPlanCollection).
+		Return(nil, assert.AnError)
+
+	entity, err := suite.target.Complete(context.Background(), test.FirstId, test.Date)
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Equal(domain.ProductionPlanEntity{}, entity)
+}
+
+func (suite *ProductionPlanRepositoryTestSuite) TestDelete_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(ProductionPlanCollection).
+		Return(nil, assert.AnError)
+
+	err := suite.target.Delete(context.Background(), uuid.UUID{})
+
+	suite.ErrorContains(err, "failed to get collection")
+}
@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"regexp"
+	"slices"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
@@ -15,10 +17,22 @@ import (
 )
 
 const (
-	SourdoughRecipeDatabase   = "dough-calculator"
 	SourdoughRecipeCollection = "sourdough-recipes"
 )
 
+const (
+	hydrationField   = "recipe_details.water.bakerpercentage"
+	flourAmountField = "recipe_details.flour.amount"
+	flourTypeField   = "flour.flourentity.flourtype"
+	flourNameField   = "flour.flourentity.name"
+	totalWeightField = "recipe_details.total_weight"
+	textSearchIndex  = "text_search"
+)
+
+// hydrationBucketBoundaries are the lower bounds of the hydration facet
+// buckets. Recipes at or above the last boundary share the last bucket.
+var hydrationBucketBoundaries = []float64{0, 60, 65, 70, 75, 80, 85, 90, 100}
+
 type sourdoughRecipeRepository struct {
 	mongoDBService domain.MongoDBService
 }
@@ -67,7 +81,69 @@ func (repository *sourdoughRecipeRepository) GetById(ctx context.Context, id uui
 	return
 }
 
-func (repository *sourdoughRecipeRepository) Find(ctx context.Context, offset, limit int) (result []domain.SourdoughRecipeEntity, err error) {
+// Update replaces the recipe only while it is at version, a recipe changed
+// in the meantime is not matched.
+func (repository *sourdoughRecipeRepository) Update(ctx context.Context, recipe domain.SourdoughRecipeEntity, version int) (entity domain.SourdoughRecipeEntity, err error) {
+	defer func() {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Stringer("id", recipe.Id).
+				Msg("failed to update recipe")
+		}
+	}()
+
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	// recipes stored before they were versioned have no version field
+	versionFilter := any(version)
+	if version == 0 {
+		versionFilter = bson.D{{"$in", bson.A{0, nil}}}
+	}
+
+	result, err := collection.ReplaceOne(ctx, bson.D{{"_id", recipe.Id}, {"version", versionFilter}}, recipe)
+	if err != nil {
+		return domain.SourdoughRecipeEntity{}, errors.Wrap(err, "failed to update sourdough recipe")
+	}
+
+	if result.MatchedCount == 0 {
+		return domain.SourdoughRecipeEntity{}, errors.Wrap(mongo.ErrNoDocuments, "failed to update sourdough recipe")
+	}
+
+	return recipe, nil
+}
+
+// GetByName returns the recipe of exactly the given name, names are unique.
+func (repository *sourdoughRecipeRepository) GetByName(ctx context.Context, name string) (entity domain.SourdoughRecipeEntity, err error) {
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	err = collection.
+		FindOne(ctx, bson.D{{"name", name}}).
+		Decode(&entity)
+	if err != nil {
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			log.Error().
+				Err(err).
+				Str("name", name).
+				Msg("failed to get recipe by name")
+		}
+		return domain.SourdoughRecipeEntity{}, errors.Wrap(err, "failed to get recipe by name")
+	}
+
+	return
+}
+
+func (repository *sourdoughRecipeRepository) Find(
+	ctx context.Context,
+	filter domain.SourdoughRecipeFilter,
+	page domain.PageRequest,
+) (result domain.SourdoughRecipeSearchResult, err error) {
 	defer func() {
 		if err != nil {
 			log.Error().
@@ -81,15 +157,146 @@ func (repository *sourdoughRecipeRepository) Find(ctx context.Context, offset, l
 		return
 	}
 
-	cursor, err := collection.Find(ctx, bson.D{}, options.Find().
-		SetLimit(int64(limit)).
-		SetSkip(int64(offset)).
-		SetSort(bson.D{{"created_at", -1}}))
+	query := recipeFilterQuery(filter)
+
+	pageFilter, opts := pageQuery(query, page)
+	cursor, err := collection.Find(ctx, pageFilter, opts)
+	if err != nil {
+		return domain.SourdoughRecipeSearchResult{}, errors.Wrap(err, "failed to find recipes")
+	}
+
+	var recipes []domain.SourdoughRecipeEntity
+	if err = cursor.All(ctx, &recipes); err != nil {
+		return domain.SourdoughRecipeSearchResult{}, errors.Wrap(err, "failed to decode recipes")
+	}
+	result.Recipes, result.Page = pageResult(recipes, page)
+
+	cursor, err = collection.Aggregate(ctx, mongo.Pipeline{
+		{{"$match", query}},
+		{{"$facet", bson.D{
+			{"total", bson.A{bson.D{{"$count", "count"}}}},
+			{"tags", countBy("$tags", bson.D{{"$unwind", "$tags"}})},
+			{"categories", countBy("$category", bson.D{{"$match", bson.D{{"category", bson.D{{"$nin", bson.A{nil, ""}}}}}}})},
+			{"flour_types", countBy("$flour_type",
+				bson.D{{"$project", bson.D{{"flour_type", bson.D{{"$setUnion", bson.A{"$" + flourTypeField, bson.A{}}}}}}}},
+				bson.D{{"$unwind", "$flour_type"}},
+				bson.D{{"$match", bson.D{{"flour_type", bson.D{{"$nin", bson.A{nil, ""}}}}}}},
+			)},
+			{"hydration", bson.A{
+				bson.D{{"$match", bson.D{{hydrationField, bson.D{{"$type", "number"}}}}}},
+				bson.D{{"$bucket", bson.D{
+					{"groupBy", "$" + hydrationField},
+					{"boundaries", hydrationBucketBoundaries},
+					{"default", hydrationBucketBoundaries[len(hydrationBucketBoundaries)-1]},
+				}}},
+			}},
+		}}},
+	})
+	if err != nil {
+		return domain.SourdoughRecipeSearchResult{}, errors.Wrap(err, "failed to count recipes")
+	}
+
+	var facets []struct {
+		Total  []struct{ Count int64 }      `bson:"total"`
+		Facets domain.SourdoughRecipeFacets `bson:",inline"`
+	}
+	if err = cursor.All(ctx, &facets); err != nil {
+		return domain.SourdoughRecipeSearchResult{}, errors.Wrap(err, "failed to decode recipe facets")
+	}
+
+	if len(facets) > 0 {
+		result.Facets = facets[0].Facets
+		if len(facets[0].Total) > 0 {
+			result.Page.Total = facets[0].Total[0].Count
+		}
+	}
+
+	for i, bucket := range result.Facets.Hydration {
+		index := slices.Index(hydrationBucketBoundaries, bucket.Min)
+		if index >= 0 && index < len(hydrationBucketBoundaries)-1 {
+			result.Facets.Hydration[i].Max = &hydrationBucketBoundaries[index+1]
+		}
+	}
+
+	return
+}
+
+// recipeFilterQuery translates a recipe filter into a MongoDB query document.
+func recipeFilterQuery(filter domain.SourdoughRecipeFilter) bson.D {
+	query := bson.D{}
+
+	if len(filter.Ids) > 0 {
+		query = append(query, bson.E{Key: "_id", Value: bson.D{{"$in", filter.Ids}}})
+	}
+	if len(filter.Tags) > 0 {
+		query = append(query, bson.E{Key: "tags", Value: bson.D{{"$all", filter.Tags}}})
+	}
+	if len(filter.Categories) > 0 {
+		query = append(query, bson.E{Key: "category", Value: bson.D{{"$in", filter.Categories}}})
+	}
+	if len(filter.FlourTypes) > 0 {
+		query = append(query, bson.E{Key: flourTypeField, Value: bson.D{{"$in", filter.FlourTypes}}})
+	}
+	if bounds := rangeQuery(filter.MinHydration, filter.MaxHydration); len(bounds) > 0 {
+		query = append(query, bson.E{Key: hydrationField, Value: bounds})
+	}
+	if bounds := rangeQuery(filter.MinFlour, filter.MaxFlour); len(bounds) > 0 {
+		query = append(query, bson.E{Key: flourAmountField, Value: bounds})
+	}
+	if bounds := rangeQuery(filter.CreatedAfter, filter.CreatedBefore); len(bounds) > 0 {
+		query = append(query, bson.E{Key: "created_at", Value: bounds})
+	}
+
+	return query
+}
+
+func rangeQuery[T any](lower, upper *T) bson.D {
+	bounds := bson.D{}
+	if lower != nil {
+		bounds = append(bounds, bson.E{Key: "$gte", Value: *lower})
+	}
+	if upper != nil {
+		bounds = append(bounds, bson.E{Key: "$lte", Value: *upper})
+	}
+	return bounds
+}
+
+// countBy returns a facet pipeline counting the documents per value of field,
+// most frequent first, after running the given preparation stages.
+func countBy(field string, stages ...bson.D) bson.A {
+	pipeline := bson.A{}
+	for _, stage := range stages {
+		pipeline = append(pipeline, stage)
+	}
+
+	return append(pipeline,
+		bson.D{{"$group", bson.D{{"_id", field}, {"count", bson.D{{"$sum", 1}}}}}},
+		bson.D{{"$sort", bson.D{{"count", -1}, {"_id", 1}}}},
+	)
+}
+
+// FindAll returns every recipe matching the filter ordered by name.
+func (repository *sourdoughRecipeRepository) FindAll(ctx context.Context, filter domain.SourdoughRecipeFilter) (recipes []domain.SourdoughRecipeEntity, err error) {
+	defer func() {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Msg("failed to find all recipes")
+		}
+	}()
+
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	cursor, err := collection.Find(ctx, recipeFilterQuery(filter), options.Find().SetSort(bson.D{{"name", 1}, {"_id", 1}}))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to find recipes")
 	}
 
-	if err = cursor.All(ctx, &result); err != nil {
+	recipes = []domain.SourdoughRecipeEntity{}
+	if err = cursor.All(ctx, &recipes); err != nil {
 		return nil, errors.Wrap(err, "failed to decode recipes")
 	}
 
@@ -113,7 +320,7 @@ func (repository *sourdoughRecipeRepository) SearchByName(ctx context.Context, n
 
 	cur, err := collection.Find(ctx, bson.D{{
 		"name", bson.D{{
-			"$regex", primitive.Regex{Pattern: name, Options: "i"},
+			"$regex", primitive.Regex{Pattern: "^" + regexp.QuoteMeta(name), Options: "i"},
 		}},
 	}})
 
@@ -130,12 +337,82 @@ func (repository *sourdoughRecipeRepository) SearchByName(ctx context.Context, n
 	return
 }
 
+func (repository *sourdoughRecipeRepository) FindFamily(ctx context.Context, rootId uuid.UUID) (recipes []domain.SourdoughRecipeEntity, err error) {
+	defer func() {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Stringer("root_id", rootId).
+				Msg("failed to find recipe family")
+		}
+	}()
+
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	cursor, err := collection.Find(ctx, bson.D{{
+		"$or", bson.A{
+			bson.D{{"_id", rootId}},
+			bson.D{{"ancestors", rootId}},
+		},
+	}}, options.Find().SetSort(bson.D{{"created_at", 1}}))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find recipe family")
+	}
+
+	if err = cursor.All(ctx, &recipes); err != nil {
+		return nil, errors.Wrap(err, "failed to decode recipes")
+	}
+
+	return
+}
+
+func (repository *sourdoughRecipeRepository) TextSearch(ctx context.Context, query string, offset, limit int) (result domain.SourdoughRecipeTextSearchResult, err error) {
+	defer func() {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("query", query).
+				Msg("failed to search recipes by text")
+		}
+	}()
+
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	filter := bson.D{{"$text", bson.D{{"$search", query}}}}
+	score := bson.D{{"$meta", "textScore"}}
+
+	cursor, err := collection.Find(ctx, filter, options.Find().
+		SetProjection(bson.D{{"score", score}}).
+		SetSort(bson.D{{"score", score}, {"_id", 1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit)))
+	if err != nil {
+		return domain.SourdoughRecipeTextSearchResult{}, errors.Wrap(err, "failed to search recipes")
+	}
+
+	if err = cursor.All(ctx, &result.Hits); err != nil {
+		return domain.SourdoughRecipeTextSearchResult{}, errors.Wrap(err, "failed to decode recipes")
+	}
+
+	result.Total, err = collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return domain.SourdoughRecipeTextSearchResult{}, errors.Wrap(err, "failed to count recipes")
+	}
+
+	return
+}
+
 func (repository *sourdoughRecipeRepository) getCollection() (*mongo.Collection, error) {
-	collection, err := repository.mongoDBService.GetCollection(SourdoughRecipeDatabase, SourdoughRecipeCollection)
+	collection, err := repository.mongoDBService.GetCollection(SourdoughRecipeCollection)
 	if err != nil {
 		log.Error().
 			Err(err).
-			Str("database", SourdoughRecipeDatabase).
 			Str("collection", SourdoughRecipeCollection).
 			Msg("failed to get collection")
 		return nil, errors.Wrap(err, "failed to get collection")
@@ -148,20 +425,5 @@ func NewSourdoughRecipeRepository(service domain.MongoDBService) (domain.Sourdou
 		return nil, errors.New("service cannot be nil")
 	}
 
-	collection, err := service.GetCollection(SourdoughRecipeDatabase, SourdoughRecipeCollection)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get collection")
-	}
-
-	_, err = collection.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
-		{
-			Keys:    bson.D{{"name", 1}},
-			Options: options.Index().SetUnique(true),
-		},
-	})
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to create index")
-	}
-
 	return &sourdoughRecipeRepository{mongoDBService: service}, nil
 }
@@ -0,0 +1,301 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"dough-calculator/internal/domain"
+)
+
+// recipeSortKeys give the values recipes are paged by, nil where MongoDB
+// would find the field missing.
+var recipeSortKeys = map[string]func(recipe domain.SourdoughRecipeEntity) any{
+	"created_at": func(recipe domain.SourdoughRecipeEntity) any {
+		return recipe.CreatedAt
+	},
+	"updated_at": func(recipe domain.SourdoughRecipeEntity) any {
+		if recipe.UpdatedAt == nil {
+			return nil
+		}
+		return *recipe.UpdatedAt
+	},
+	"name": func(recipe domain.SourdoughRecipeEntity) any {
+		return recipe.Name
+	},
+	hydrationField: func(recipe domain.SourdoughRecipeEntity) any {
+		return recipe.Details.Water.BakerPercentage
+	},
+	totalWeightField: func(recipe domain.SourdoughRecipeEntity) any {
+		return float64(recipe.Details.TotalWeight)
+	},
+}
+
+// embeddedSourdoughRecipeRepository keeps the recipes in an embedded
+// database, with the same unique recipe names as the MongoDB repository.
+type embeddedSourdoughRecipeRepository struct {
+	collection *embeddedCollection[domain.SourdoughRecipeEntity]
+}
+
+func (repository *embeddedSourdoughRecipeRepository) Create(ctx context.Context, recipe domain.SourdoughRecipeEntity) (domain.SourdoughRecipeEntity, error) {
+	if err := repository.collection.insert(ctx, recipe); err != nil {
+		return domain.SourdoughRecipeEntity{}, errors.Wrap(err, "failed to insert sourdough recipe")
+	}
+	return recipe, nil
+}
+
+func (repository *embeddedSourdoughRecipeRepository) GetById(ctx context.Context, id uuid.UUID) (domain.SourdoughRecipeEntity, error) {
+	recipe, err := repository.collection.get(ctx, id)
+	return recipe, errors.Wrap(err, "failed to find recipe")
+}
+
+// Update replaces the recipe only while it is at version, a recipe changed
+// in the meantime is not matched.
+func (repository *embeddedSourdoughRecipeRepository) Update(ctx context.Context, recipe domain.SourdoughRecipeEntity, version int) (domain.SourdoughRecipeEntity, error) {
+	matched, err := repository.collection.update(
+		ctx,
+		func(existing domain.SourdoughRecipeEntity) bool {
+			return existing.Id == recipe.Id && existing.Version == version
+		},
+		func(existing *domain.SourdoughRecipeEntity) { *existing = recipe })
+	if err != nil {
+		return domain.SourdoughRecipeEntity{}, errors.Wrap(err, "failed to update sourdough recipe")
+	}
+	if matched == 0 {
+		return domain.SourdoughRecipeEntity{}, errors.Wrap(mongo.ErrNoDocuments, "failed to update sourdough recipe")
+	}
+	return recipe, nil
+}
+
+// GetByName returns the recipe of exactly the given name, names are unique.
+func (repository *embeddedSourdoughRecipeRepository) GetByName(ctx context.Context, name string) (domain.SourdoughRecipeEntity, error) {
+	recipe, err := repository.collection.findOne(ctx, func(recipe domain.SourdoughRecipeEntity) bool {
+		return recipe.Name == name
+	})
+	return recipe, errors.Wrap(err, "failed to get recipe by name")
+}
+
+func (repository *embeddedSourdoughRecipeRepository) Find(
+	ctx context.Context,
+	filter domain.SourdoughRecipeFilter,
+	page domain.PageRequest,
+) (domain.SourdoughRecipeSearchResult, error) {
+	sortKey, ok := recipeSortKeys[page.SortField]
+	if !ok {
+		return domain.SourdoughRecipeSearchResult{}, errors.Errorf("unsupported sort field '%s'", page.SortField)
+	}
+
+	recipes, err := repository.collection.find(ctx, recipeFilterMatch(filter))
+	if err != nil {
+		return domain.SourdoughRecipeSearchResult{}, errors.Wrap(err, "failed to find recipes")
+	}
+
+	result := domain.SourdoughRecipeSearchResult{Facets: recipeFacets(recipes)}
+	result.Recipes, result.Page = embeddedPage(recipes, page, sortKey, recipeId)
+	result.Page.Total = int64(len(recipes))
+
+	return result, nil
+}
+
+// recipeFilterMatch matches the recipes recipeFilterQuery selects in
+// MongoDB, where recipes without a category never match a category.
+func recipeFilterMatch(filter domain.SourdoughRecipeFilter) func(recipe domain.SourdoughRecipeEntity) bool {
+	return func(recipe domain.SourdoughRecipeEntity) bool {
+		if len(filter.Ids) > 0 && !slices.Contains(filter.Ids, recipe.Id) {
+			return false
+		}
+		for _, tag := range filter.Tags {
+			if !slices.Contains(recipe.Tags, tag) {
+				return false
+			}
+		}
+		if len(filter.Categories) > 0 && (recipe.Category == "" || !slices.Contains(filter.Categories, recipe.Category)) {
+			return false
+		}
+		if len(filter.FlourTypes) > 0 && !slices.ContainsFunc(recipe.Flour, func(flour domain.FlourAmount) bool {
+			return slices.Contains(filter.FlourTypes, flour.FlourType)
+		}) {
+			return false
+		}
+		if filter.CreatedAfter != nil && recipe.CreatedAt.Before(*filter.CreatedAfter) {
+			return false
+		}
+		if filter.CreatedBefore != nil && recipe.CreatedAt.After(*filter.CreatedBefore) {
+			return false
+		}
+
+		return inRange(recipe.Details.Water.BakerPercentage, filter.MinHydration, filter.MaxHydration) &&
+			inRange(recipe.Details.Flour.Amount, filter.MinFlour, filter.MaxFlour)
+	}
+}
+
+// recipeFacets counts tags, categories, flour types and hydration buckets
+// of the recipes like the facet stage of the MongoDB repository.
+func recipeFacets(recipes []domain.SourdoughRecipeEntity) domain.SourdoughRecipeFacets {
+	tags, categories, flourTypes := map[string]int{}, map[string]int{}, map[string]int{}
+	hydration := map[float64]int{}
+
+	for _, recipe := range recipes {
+		for _, tag := range recipe.Tags {
+			tags[tag]++
+		}
+		if recipe.Category != "" {
+			categories[recipe.Category]++
+		}
+
+		seen := map[string]bool{}
+		for _, flour := range recipe.Flour {
+			if flour.FlourType != "" && !seen[flour.FlourType] {
+				seen[flour.FlourType] = true
+				flourTypes[flour.FlourType]++
+			}
+		}
+
+		hydration[hydrationBucket(recipe.Details.Water.BakerPercentage)]++
+	}
+
+	facets := domain.SourdoughRecipeFacets{
+		Tags:       facetCounts(tags),
+		Categories: facetCounts(categories),
+		FlourTypes: facetCounts(flourTypes),
+		Hydration:  []domain.HydrationBucket{},
+	}
+
+	for i, boundary := range hydrationBucketBoundaries {
+		count, ok := hydration[boundary]
+		if !ok {
+			continue
+		}
+		bucket := domain.HydrationBucket{Min: boundary, Count: count}
+		if i < len(hydrationBucketBoundaries)-1 {
+			bucket.Max = &hydrationBucketBoundaries[i+1]
+		}
+		facets.Hydration = append(facets.Hydration, bucket)
+	}
+
+	return facets
+}
+
+// hydrationBucket returns the lower bound of the bucket of a hydration,
+// values outside the boundaries fall into the last bucket.
+func hydrationBucket(hydration float64) float64 {
+	last := len(hydrationBucketBoundaries) - 1
+	for i := last - 1; i >= 0; i-- {
+		if hydration >= hydrationBucketBoundaries[i] && hydration < hydrationBucketBoundaries[i+1] {
+			return hydrationBucketBoundaries[i]
+		}
+	}
+	return hydrationBucketBoundaries[last]
+}
+
+// facetCounts orders the counts most frequent first and then by value.
+func facetCounts(counts map[string]int) []domain.FacetCount {
+	facets := make([]domain.FacetCount, 0, len(counts))
+	for value, count := range counts {
+		facets = append(facets, domain.FacetCount{Value: value, Count: count})
+	}
+
+	sort.Slice(facets, func(i, j int) bool {
+		if facets[i].Count != facets[j].Count {
+			return facets[i].Count > facets[j].Count
+		}
+		return facets[i].Value < facets[j].Value
+	})
+
+	return facets
+}
+
+// FindAll returns every recipe matching the filter ordered by name.
+func (repository *embeddedSourdoughRecipeRepository) FindAll(ctx context.Context, filter domain.SourdoughRecipeFilter) ([]domain.SourdoughRecipeEntity, error) {
+	recipes, err := repository.collection.find(ctx, recipeFilterMatch(filter))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find recipes")
+	}
+
+	sortByName(recipes, func(recipe domain.SourdoughRecipeEntity) string { return recipe.Name })
+
+	return recipes, nil
+}
+
+func (repository *embeddedSourdoughRecipeRepository) SearchByName(ctx context.Context, name string) ([]domain.SourdoughRecipeEntity, error) {
+	prefix := strings.ToLower(name)
+
+	recipes, err := repository.collection.find(ctx, func(recipe domain.SourdoughRecipeEntity) bool {
+		return strings.HasPrefix(strings.ToLower(recipe.Name), prefix)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find recipe")
+	}
+
+	sortByName(recipes, func(recipe domain.SourdoughRecipeEntity) string { return recipe.Name })
+
+	return recipes, nil
+}
+
+func (repository *embeddedSourdoughRecipeRepository) TextSearch(ctx context.Context, query string, offset, limit int) (domain.SourdoughRecipeTextSearchResult, error) {
+	recipes, err := repository.collection.find(ctx, nil)
+	if err != nil {
+		return domain.SourdoughRecipeTextSearchResult{}, errors.Wrap(err, "failed to search recipes")
+	}
+
+	hits := []domain.SourdoughRecipeTextSearchHit{}
+	for _, recipe := range recipes {
+		flourNames := make([]string, len(recipe.Flour))
+		for i, flour := range recipe.Flour {
+			flourNames[i] = flour.Name
+		}
+
+		score := textScore(query,
+			textField{weight: 10, values: []string{recipe.Name}},
+			textField{weight: 5, values: recipe.Tags},
+			textField{weight: 3, values: flourNames},
+			textField{weight: 1, values: []string{recipe.Description}},
+		)
+		if score > 0 {
+			hits = append(hits, domain.SourdoughRecipeTextSearchHit{Recipe: recipe, Score: score})
+		}
+	}
+
+	// recipes come in id order, a stable sort keeps it for equal scores
+	sort.SliceStable(hits, func(i, j int) bool { return hits[i].Score > hits[j].Score })
+
+	return domain.SourdoughRecipeTextSearchResult{
+		Hits:  window(hits, offset, limit),
+		Total: int64(len(hits)),
+	}, nil
+}
+
+// FindFamily returns the root recipe and every recipe derived from it,
+// oldest first.
+func (repository *embeddedSourdoughRecipeRepository) FindFamily(ctx context.Context, rootId uuid.UUID) ([]domain.SourdoughRecipeEntity, error) {
+	recipes, err := repository.collection.find(ctx, func(recipe domain.SourdoughRecipeEntity) bool {
+		return recipe.Id == rootId || slices.Contains(recipe.Ancestors, rootId)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find recipe family")
+	}
+
+	sort.SliceStable(recipes, func(i, j int) bool { return recipes[i].CreatedAt.Before(recipes[j].CreatedAt) })
+
+	return recipes, nil
+}
+
+func recipeId(recipe domain.SourdoughRecipeEntity) uuid.UUID {
+	return recipe.Id
+}
+
+func NewEmbeddedSourdoughRecipeRepository(database domain.EmbeddedDatabase) (domain.SourdoughRecipeRepository, error) {
+	collection, err := newEmbeddedCollection(database, SourdoughRecipeCollection, recipeId,
+		uniqueIndex[domain.SourdoughRecipeEntity]{name: "name_1", key: func(recipe domain.SourdoughRecipeEntity) string { return recipe.Name }},
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create collection")
+	}
+
+	return &embeddedSourdoughRecipeRepository{collection: collection}, nil
+}
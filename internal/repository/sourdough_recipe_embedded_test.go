@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/test"
+	"dough-calculator/internal/utils"
+)
+
+func TestEmbeddedSourdoughRecipeRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(EmbeddedSourdoughRecipeRepositoryTestSuite))
+}
+
+type EmbeddedSourdoughRecipeRepositoryTestSuite struct {
+	test.GoMockTestSuite
+
+	target domain.SourdoughRecipeRepository
+}
+
+func (suite *EmbeddedSourdoughRecipeRepositoryTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.target = test.Must(func() (domain.SourdoughRecipeRepository, error) {
+		return NewEmbeddedSourdoughRecipeRepository(newEmptyEmbeddedDatabase(suite.MockCtrl))
+	})
+
+	recipe := func(id uuid.UUID, name string, hydration float64, createdAt time.Time, tags ...string) domain.SourdoughRecipeEntity {
+		return domain.SourdoughRecipeEntity{RecipeEntity: domain.RecipeEntity{
+			Id:        id,
+			Name:      name,
+			Details:   domain.RecipeDetails{Water: domain.BakerAmount{BakerPercentage: hydration}},
+			CreatedAt: createdAt,
+			Tags:      tags,
+		}}
+	}
+
+	country := recipe(test.FirstId, "Country Loaf", 72, test.Date, "rustic", "weekend")
+	country.Category = "bread"
+	country.Flour = []domain.FlourAmount{{FlourEntity: domain.FlourEntity{Name: "Whole Rye", FlourType: "rye"}}}
+	rye := recipe(test.SecondId, "Rye Country", 82, test.Date.Add(time.Hour), "rustic")
+	rye.Ancestors = []uuid.UUID{test.FirstId}
+	pizza := recipe(test.ThirdId, "Pizza", 62, test.Date.Add(2*time.Hour))
+
+	for _, recipe := range []domain.SourdoughRecipeEntity{country, rye, pizza} {
+		_, err := suite.target.Create(context.Background(), recipe)
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *EmbeddedSourdoughRecipeRepositoryTestSuite) TestCreate_WithDuplicateName() {
+	_, err := suite.target.Create(context.Background(), domain.SourdoughRecipeEntity{
+		RecipeEntity: domain.RecipeEntity{Id: uuid.New(), Name: "Pizza"},
+	})
+
+	suite.True(mongo.IsDuplicateKeyError(err))
+}
+
+func (suite *EmbeddedSourdoughRecipeRepositoryTestSuite) TestGetById_WithMissingRecipe() {
+	_, err := suite.target.GetById(context.Background(), uuid.New())
+
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+}
+
+func (suite *EmbeddedSourdoughRecipeRepositoryTestSuite) TestUpdate() {
+	pizza, err := suite.target.GetById(context.Background(), test.ThirdId)
+	suite.Require().NoError(err)
+
+	updated := pizza
+	updated.Description = "thin crust"
+	updated.Version = 1
+
+	_, err = suite.target.Update(context.Background(), updated, 0)
+	suite.NoError(err)
+
+	// a second writer that read version 0 no longer matches
+	stale := pizza
+	stale.Description = "stale"
+	stale.Version = 1
+
+	_, err = suite.target.Update(context.Background(), stale, 0)
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+
+	actual, err := suite.target.GetById(context.Background(), test.ThirdId)
+
+	suite.NoError(err)
+	suite.Equal(updated, actual)
+}
+
+func (suite *EmbeddedSourdoughRecipeRepositoryTestSuite) TestFind() {
+	minHydration := 70.0
+
+	actual, err := suite.target.Find(context.Background(),
+		domain.SourdoughRecipeFilter{Tags: []string{"rustic"}, MinHydration: &minHydration},
+		domain.PageRequest{SortField: "created_at", Descending: true, Limit: 10},
+	)
+
+	suite.NoError(err)
+	suite.Equal([]uuid.UUID{test.SecondId, test.FirstId}, utils.Map(actual.Recipes, recipeId))
+	suite.Equal(domain.PageInfo{Total: 2}, actual.Page)
+
+	seventyFive, eightyFive := 75.0, 85.0
+	suite.Equal(domain.SourdoughRecipeFacets{
+		Tags:       []domain.FacetCount{{Value: "rustic", Count: 2}, {Value: "weekend", Count: 1}},
+		Categories: []domain.FacetCount{{Value: "bread", Count: 1}},
+		FlourTypes: []domain.FacetCount{{Value: "rye", Count: 1}},
+		Hydration: []domain.HydrationBucket{
+			{Min: 70, Max: &seventyFive, Count: 1},
+			{Min: 80, Max: &eightyFive, Count: 1},
+		},
+	}, actual.Facets)
+}
+
+func (suite *EmbeddedSourdoughRecipeRepositoryTestSuite) TestFind_WithCategories() {
+	actual, err := suite.target.Find(context.Background(),
+		domain.SourdoughRecipeFilter{Categories: []string{"bread"}},
+		domain.PageRequest{SortField: "name", Limit: 10},
+	)
+
+	suite.NoError(err)
+	suite.Equal([]uuid.UUID{test.FirstId}, utils.Map(actual.Recipes, recipeId))
+}
+
+func (suite *EmbeddedSourdoughRecipeRepositoryTestSuite) TestFindAll() {
+	actual, err := suite.target.FindAll(context.Background(), domain.SourdoughRecipeFilter{FlourTypes: []string{"rye"}})
+
+	suite.NoError(err)
+	suite.Equal([]uuid.UUID{test.FirstId}, utils.Map(actual, recipeId))
+}
+
+func (suite *EmbeddedSourdoughRecipeRepositoryTestSuite) TestTextSearch() {
+	actual, err := suite.target.TextSearch(context.Background(), "country", 0, 1)
+
+	suite.NoError(err)
+	suite.Equal(int64(2), actual.Total)
+	suite.Len(actual.Hits, 1)
+}
+
+func (suite *EmbeddedSourdoughRecipeRepositoryTestSuite) TestFindFamily() {
+	actual, err := suite.target.FindFamily(context.Background(), test.FirstId)
+
+	suite.NoError(err)
+	suite.Equal([]uuid.UUID{test.FirstId, test.SecondId}, utils.Map(actual, recipeId))
+}
+
+func TestHydrationBucket(t *testing.T) {
+	assert.Equal(t, 0.0, hydrationBucket(45))
+	assert.Equal(t, 65.0, hydrationBucket(65))
+	assert.Equal(t, 100.0, hydrationBucket(120))
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"dough-calculator/internal/domain"
+)
+
+const (
+	SourdoughRecipeRevisionCollection = "sourdough-recipe-revisions"
+)
+
+type sourdoughRecipeRevisionRepository struct {
+	mongoDBService domain.MongoDBService
+}
+
+func (repository *sourdoughRecipeRevisionRepository) Create(ctx context.Context, revision domain.SourdoughRecipeRevisionEntity) (entity domain.SourdoughRecipeRevisionEntity, err error) {
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	result, err := collection.InsertOne(ctx, revision)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Stringer("recipe_id", revision.RecipeId).
+			Int("version", revision.Version).
+			Msg("failed to insert recipe revision")
+		return domain.SourdoughRecipeRevisionEntity{}, errors.Wrap(err, "failed to insert sourdough recipe revision")
+	}
+
+	log.Debug().Msgf("Inserted a single document: %s", result.InsertedID)
+
+	return revision, nil
+}
+
+func (repository *sourdoughRecipeRevisionRepository) GetByRecipeIdAndVersion(ctx context.Context, recipeId uuid.UUID, version int) (entity domain.SourdoughRecipeRevisionEntity, err error) {
+	defer func() {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Stringer("recipe_id", recipeId).
+				Int("version", version).
+				Msg("failed to get recipe revision")
+		}
+	}()
+
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	err = collection.
+		FindOne(ctx, bson.D{{"recipe_id", recipeId}, {"version", version}}).
+		Decode(&entity)
+	if err != nil {
+		return domain.SourdoughRecipeRevisionEntity{}, errors.Wrap(err, "failed to find recipe revision")
+	}
+
+	return
+}
+
+func (repository *sourdoughRecipeRevisionRepository) FindByRecipeId(ctx context.Context, recipeId uuid.UUID, offset, limit int) (result []domain.SourdoughRecipeRevisionEntity, err error) {
+	defer func() {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Stringer("recipe_id", recipeId).
+				Msg("failed to find recipe revisions")
+		}
+	}()
+
+	collection, err := repository.getCollection()
+	if err != nil {
+		return
+	}
+
+	cursor, err := collection.Find(ctx, bson.D{{"recipe_id", recipeId}}, options.Find().
+		SetLimit(int64(limit)).
+		SetSkip(int64(offset)).
+		SetSort(bson.D{{"version", -1}}))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find recipe revisions")
+	}
+
+	if err = cursor.All(ctx, &result); err != nil {
+		return nil, errors.Wrap(err, "failed to decode recipe revisions")
+	}
+
+	return
+}
+
+func (repository *sourdoughRecipeRevisionRepository) getCollection() (*mongo.Collection, error) {
+	collection, err := repository.mongoDBService.GetCollection(SourdoughRecipeRevisionCollection)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("collection", SourdoughRecipeRevisionCollection).
+			Msg("failed to get collection")
+		return nil, errors.Wrap(err, "failed to get collection")
+	}
+	return collection, nil
+}
+
+func NewSourdoughRecipeRevisionRepository(service domain.MongoDBService) (domain.SourdoughRecipeRevisionRepository, error) {
+	if service == nil {
+		return nil, errors.New("service cannot be nil")
+	}
+
+	return &sourdoughRecipeRevisionRepository{mongoDBService: service}, nil
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/domain"
+)
+
+type embeddedSourdoughRecipeRevisionRepository struct {
+	collection *embeddedCollection[domain.SourdoughRecipeRevisionEntity]
+}
+
+func (repository *embeddedSourdoughRecipeRevisionRepository) Create(ctx context.Context, revision domain.SourdoughRecipeRevisionEntity) (domain.SourdoughRecipeRevisionEntity, error) {
+	if err := repository.collection.insert(ctx, revision); err != nil {
+		return domain.SourdoughRecipeRevisionEntity{}, errors.Wrap(err, "failed to insert recipe revision")
+	}
+	return revision, nil
+}
+
+func (repository *embeddedSourdoughRecipeRevisionRepository) GetByRecipeIdAndVersion(ctx context.Context, recipeId uuid.UUID, version int) (domain.SourdoughRecipeRevisionEntity, error) {
+	revision, err := repository.collection.findOne(ctx, func(revision domain.SourdoughRecipeRevisionEntity) bool {
+		return revision.RecipeId == recipeId && revision.Version == version
+	})
+	return revision, errors.Wrap(err, "failed to get recipe revision")
+}
+
+// FindByRecipeId returns the revisions of a recipe, newest version first.
+func (repository *embeddedSourdoughRecipeRevisionRepository) FindByRecipeId(ctx context.Context, recipeId uuid.UUID, offset, limit int) ([]domain.SourdoughRecipeRevisionEntity, error) {
+	revisions, err := repository.collection.find(ctx, func(revision domain.SourdoughRecipeRevisionEntity) bool {
+		return revision.RecipeId == recipeId
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find recipe revisions")
+	}
+
+	sort.SliceStable(revisions, func(i, j int) bool { return revisions[i].Version > revisions[j].Version })
+
+	return window(revisions, offset, limit), nil
+}
+
+func NewEmbeddedSourdoughRecipeRevisionRepository(database domain.EmbeddedDatabase) (domain.SourdoughRecipeRevisionRepository, error) {
+	collection, err := newEmbeddedCollection(database, SourdoughRecipeRevisionCollection,
+		func(revision domain.SourdoughRecipeRevisionEntity) uuid.UUID { return revision.Id },
+		uniqueIndex[domain.SourdoughRecipeRevisionEntity]{
+			name: "recipe_id_1_version_1",
+			key: func(revision domain.SourdoughRecipeRevisionEntity) string {
+				return fmt.Sprintf("%s/%d", revision.RecipeId, revision.Version)
+			},
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create collection")
+	}
+
+	return &embeddedSourdoughRecipeRevisionRepository{collection: collection}, nil
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/test"
+)
+
+func TestEmbeddedSourdoughRecipeRevisionRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(EmbeddedSourdoughRecipeRevisionRepositoryTestSuite))
+}
+
+type EmbeddedSourdoughRecipeRevisionRepositoryTestSuite struct {
+	test.GoMockTestSuite
+
+	target domain.SourdoughRecipeRevisionRepository
+}
+
+func (suite *EmbeddedSourdoughRecipeRevisionRepositoryTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.target = test.Must(func() (domain.SourdoughRecipeRevisionRepository, error) {
+		return NewEmbeddedSourdoughRecipeRevisionRepository(newEmptyEmbeddedDatabase(suite.MockCtrl))
+	})
+
+	for version, id := range []uuid.UUID{test.FirstId, test.SecondId, test.ThirdId} {
+		_, err := suite.target.Create(context.Background(), domain.SourdoughRecipeRevisionEntity{
+			Id:       id,
+			RecipeId: test.FirstId,
+			Version:  version + 1,
+		})
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *EmbeddedSourdoughRecipeRevisionRepositoryTestSuite) TestCreate_WithDuplicateVersion() {
+	_, err := suite.target.Create(context.Background(), domain.SourdoughRecipeRevisionEntity{
+		Id:       uuid.New(),
+		RecipeId: test.FirstId,
+		Version:  2,
+	})
+
+	suite.True(mongo.IsDuplicateKeyError(err))
+}
+
+func (suite *EmbeddedSourdoughRecipeRevisionRepositoryTestSuite) TestGetByRecipeIdAndVersion() {
+	actual, err := suite.target.GetByRecipeIdAndVersion(context.Background(), test.FirstId, 2)
+
+	suite.NoError(err)
+	suite.Equal(test.SecondId, actual.Id)
+
+	_, err = suite.target.GetByRecipeIdAndVersion(context.Background(), test.FirstId, 4)
+	suite.ErrorIs(err, mongo.ErrNoDocuments)
+}
+
+func (suite *EmbeddedSourdoughRecipeRevisionRepositoryTestSuite) TestFindByRecipeId() {
+	actual, err := suite.target.FindByRecipeId(context.Background(), test.FirstId, 1, 1)
+
+	suite.NoError(err)
+	suite.Len(actual, 1)
+	suite.Equal(2, actual[0].Version)
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	"dough-calculator/internal/test"
+)
+
+func TestSourdoughRecipeRevisionRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(SourdoughRecipeRevisionRepositoryTestSuite))
+}
+
+type SourdoughRecipeRevisionRepositoryTestSuite struct {
+	test.GoMockTestSuite
+
+	mongoDBService *mocks.MockMongoDBService
+
+	target *sourdoughRecipeRevisionRepository
+}
+
+func (suite *SourdoughRecipeRevisionRepositoryTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.mongoDBService = mocks.NewMockMongoDBService(suite.MockCtrl)
+
+	suite.target = &sourdoughRecipeRevisionRepository{
+		mongoDBService: suite.mongoDBService,
+	}
+}
+
+func (suite *SourdoughRecipeRevisionRepositoryTestSuite) TestNewSourdoughRecipeRevisionRepository_WithError() {
+	tests := []struct {
+		name           string
+		mongoDBService domain.MongoDBService
+		errorMsg       string
+	}{
+		{
+			name:           "mongoDBService is nil",
+			mongoDBService: nil,
+			errorMsg:       "service cannot be nil",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			repository, err := NewSourdoughRecipeRevisionRepository(tt.mongoDBService)
+
+			suite.ErrorContains(err, tt.errorMsg)
+			suite.Nil(repository)
+		})
+	}
+}
+
+func (suite *SourdoughRecipeRevisionRepositoryTestSuite) TestGetCollection_WithError() {
+	suite.mongoDBService.EXPECT().GetCollection(SourdoughRecipeRevisionCollection).
+		Return(nil, assert.AnError)
+
+	collection, err := suite.target.getCollection()
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Nil(collection)
+}
+
+func (suite *SourdoughRecipeRevisionRepositoryTestSuite) TestCreate_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(SourdoughRecipeRevisionCollection).
+		Return(nil, assert.AnError)
+
+	entity, err := suite.target.Create(context.Background(), domain.SourdoughRecipeRevisionEntity{})
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Equal(domain.SourdoughRecipeRevisionEntity{}, entity)
+}
+
+func (suite *SourdoughRecipeRevisionRepositoryTestSuite) TestGetByRecipeIdAndVersion_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(SourdoughRecipeRevisionCollection).
+		Return(nil, assert.AnError)
+
+	entity, err := suite.target.GetByRecipeIdAndVersion(context.Background(), uuid.UUID{}, 1)
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Equal(domain.SourdoughRecipeRevisionEntity{}, entity)
+}
+
+func (suite *SourdoughRecipeRevisionRepositoryTestSuite) TestFindByRecipeId_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(SourdoughRecipeRevisionCollection).
+		Return(nil, assert.AnError)
+
+	entities, err := suite.target.FindByRecipeId(context.Background(), uuid.UUID{}, 0, 1)
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Nil(entities)
+}
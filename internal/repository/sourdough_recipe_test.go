@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
 
 	"dough-calculator/internal/domain"
 	"dough-calculator/internal/domain/mocks"
@@ -46,16 +47,6 @@ func (suite *SourdoughRecipeRepositoryTestSuite) TestNewSourdoughRecipeRepositor
 			mongoDBService: nil,
 			errorMsg:       "service cannot be nil",
 		},
-		{
-			name: "mongoDBService.GetCollection returns error",
-			mongoDBService: func() domain.MongoDBService {
-				suite.mongoDBService.EXPECT().GetCollection(SourdoughRecipeDatabase, SourdoughRecipeCollection).
-					Return(nil, assert.AnError)
-
-				return suite.mongoDBService
-			}(),
-			errorMsg: "failed to get collection",
-		},
 	}
 
 	for _, tt := range tests {
@@ -69,7 +60,7 @@ func (suite *SourdoughRecipeRepositoryTestSuite) TestNewSourdoughRecipeRepositor
 }
 
 func (suite *SourdoughRecipeRepositoryTestSuite) TestGetCollection_WithError() {
-	suite.mongoDBService.EXPECT().GetCollection(SourdoughRecipeDatabase, SourdoughRecipeCollection).
+	suite.mongoDBService.EXPECT().GetCollection(SourdoughRecipeCollection).
 		Return(nil, assert.AnError)
 
 	collection, err := suite.target.getCollection()
@@ -79,7 +70,7 @@ func (suite *SourdoughRecipeRepositoryTestSuite) TestGetCollection_WithError() {
 }
 
 func (suite *SourdoughRecipeRepositoryTestSuite) TestCreate_WithErrorOnGetCollection() {
-	suite.mongoDBService.EXPECT().GetCollection(SourdoughRecipeDatabase, SourdoughRecipeCollection).
+	suite.mongoDBService.EXPECT().GetCollection(SourdoughRecipeCollection).
 		Return(nil, assert.AnError)
 
 	recipe := domain.SourdoughRecipeEntity{}
@@ -91,7 +82,7 @@ func (suite *SourdoughRecipeRepositoryTestSuite) TestCreate_WithErrorOnGetCollec
 }
 
 func (suite *SourdoughRecipeRepositoryTestSuite) TestGetById_WithErrorOnGetCollection() {
-	suite.mongoDBService.EXPECT().GetCollection(SourdoughRecipeDatabase, SourdoughRecipeCollection).
+	suite.mongoDBService.EXPECT().GetCollection(SourdoughRecipeCollection).
 		Return(nil, assert.AnError)
 
 	entity, err := suite.target.GetById(context.Background(), uuid.UUID{})
@@ -101,17 +92,83 @@ func (suite *SourdoughRecipeRepositoryTestSuite) TestGetById_WithErrorOnGetColle
 }
 
 func (suite *SourdoughRecipeRepositoryTestSuite) TestFind_WithErrorOnGetCollection() {
-	suite.mongoDBService.EXPECT().GetCollection(SourdoughRecipeDatabase, SourdoughRecipeCollection).
+	suite.mongoDBService.EXPECT().GetCollection(SourdoughRecipeCollection).
 		Return(nil, assert.AnError)
 
-	entities, err := suite.target.Find(context.Background(), 0, 1)
+	result, err := suite.target.Find(context.Background(), domain.SourdoughRecipeFilter{}, domain.PageRequest{SortField: "created_at", Limit: 1})
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Equal(domain.SourdoughRecipeSearchResult{}, result)
+}
+
+func (suite *SourdoughRecipeRepositoryTestSuite) TestGetByName_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(SourdoughRecipeCollection).
+		Return(nil, assert.AnError)
+
+	entity, err := suite.target.GetByName(context.Background(), "rye")
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Equal(domain.SourdoughRecipeEntity{}, entity)
+}
+
+func (suite *SourdoughRecipeRepositoryTestSuite) TestFindAll_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(SourdoughRecipeCollection).
+		Return(nil, assert.AnError)
+
+	entities, err := suite.target.FindAll(context.Background(), domain.SourdoughRecipeFilter{})
 
 	suite.ErrorContains(err, "failed to get collection")
 	suite.Nil(entities)
 }
 
+func (suite *SourdoughRecipeRepositoryTestSuite) TestRecipeFilterQuery() {
+	minHydration := 70.0
+	maxFlour := 1000.0
+	createdAfter := test.Date
+
+	tests := []struct {
+		name     string
+		filter   domain.SourdoughRecipeFilter
+		expected bson.D
+	}{
+		{
+			name:     "empty filter",
+			filter:   domain.SourdoughRecipeFilter{},
+			expected: bson.D{},
+		},
+		{
+			name: "all criteria",
+			filter: domain.SourdoughRecipeFilter{
+				Ids:          []uuid.UUID{test.FirstId},
+				Tags:         []string{"rye", "enriched"},
+				Categories:   []string{"bread"},
+				FlourTypes:   []string{"whole grain"},
+				MinHydration: &minHydration,
+				MaxHydration: &minHydration,
+				MaxFlour:     &maxFlour,
+				CreatedAfter: &createdAfter,
+			},
+			expected: bson.D{
+				{"_id", bson.D{{"$in", []uuid.UUID{test.FirstId}}}},
+				{"tags", bson.D{{"$all", []string{"rye", "enriched"}}}},
+				{"category", bson.D{{"$in", []string{"bread"}}}},
+				{"flour.flourentity.flourtype", bson.D{{"$in", []string{"whole grain"}}}},
+				{"recipe_details.water.bakerpercentage", bson.D{{"$gte", 70.0}, {"$lte", 70.0}}},
+				{"recipe_details.flour.amount", bson.D{{"$lte", 1000.0}}},
+				{"created_at", bson.D{{"$gte", test.Date}}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.Equal(tt.expected, recipeFilterQuery(tt.filter))
+		})
+	}
+}
+
 func (suite *SourdoughRecipeRepositoryTestSuite) TestSearchByName_WithErrorOnGetCollection() {
-	suite.mongoDBService.EXPECT().GetCollection(SourdoughRecipeDatabase, SourdoughRecipeCollection).
+	suite.mongoDBService.EXPECT().GetCollection(SourdoughRecipeCollection).
 		Return(nil, assert.AnError)
 
 	entities, err := suite.target.SearchByName(context.Background(), "")
@@ -119,3 +176,33 @@ func (suite *SourdoughRecipeRepositoryTestSuite) TestSearchByName_WithErrorOnGet
 	suite.ErrorContains(err, "failed to get collection")
 	suite.Nil(entities)
 }
+
+func (suite *SourdoughRecipeRepositoryTestSuite) TestUpdate_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(SourdoughRecipeCollection).
+		Return(nil, assert.AnError)
+
+	entity, err := suite.target.Update(context.Background(), domain.SourdoughRecipeEntity{}, 1)
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Equal(domain.SourdoughRecipeEntity{}, entity)
+}
+
+func (suite *SourdoughRecipeRepositoryTestSuite) TestFindFamily_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(SourdoughRecipeCollection).
+		Return(nil, assert.AnError)
+
+	entities, err := suite.target.FindFamily(context.Background(), uuid.New())
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Nil(entities)
+}
+
+func (suite *SourdoughRecipeRepositoryTestSuite) TestTextSearch_WithErrorOnGetCollection() {
+	suite.mongoDBService.EXPECT().GetCollection(SourdoughRecipeCollection).
+		Return(nil, assert.AnError)
+
+	result, err := suite.target.TextSearch(context.Background(), "rye", 0, 10)
+
+	suite.ErrorContains(err, "failed to get collection")
+	suite.Equal(domain.SourdoughRecipeTextSearchResult{}, result)
+}
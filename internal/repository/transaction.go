@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+)
+
+type transactionRunner struct {
+	mongoDBService domain.MongoDBService
+}
+
+// ErrTransactionsUnsupported is returned by NewTransactionRunner for a
+// standalone MongoDB server, which cannot run multi-document transactions.
+var ErrTransactionsUnsupported = internalErrors.NewInternalServerError(
+	"MongoDB does not support transactions",
+	"run MongoDB as a replica set, a single node one is enough")
+
+// WithTransaction runs fn in a session transaction. A ctx that already
+// carries a session joins its transaction, so services can nest their
+// writes in the transaction of a caller. Service errors of fn are returned
+// as they are, any other failure as an internal server error.
+func (runner *transactionRunner) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if mongo.SessionFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	client, err := runner.mongoDBService.GetClient()
+	if err != nil {
+		return internalErrors.NewInternalServerErrorWrap(err, "failed to get client")
+	}
+
+	session, err := client.StartSession()
+	if err != nil {
+		return internalErrors.NewInternalServerErrorWrap(err, "failed to start session")
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessionCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessionCtx)
+	})
+	if err != nil && !internalErrors.IsServiceError(err) {
+		log.Err(err).
+			Msg("failed to run transaction")
+
+		return internalErrors.NewInternalServerErrorWrap(err, "failed to run transaction")
+	}
+
+	return err
+}
+
+// supportsTransactions asks the server whether it is a replica set member or
+// a mongos, the deployments multi-document transactions run on.
+func supportsTransactions(service domain.MongoDBService) (bool, error) {
+	client, err := service.GetClient()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get client")
+	}
+
+	var hello struct {
+		SetName string `bson:"setName"`
+		Msg     string `bson:"msg"`
+	}
+	err = client.Database("admin").RunCommand(context.Background(), bson.D{{"hello", 1}}).Decode(&hello)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to run hello command")
+	}
+
+	return hello.SetName != "" || hello.Msg == "isdbgrid", nil
+}
+
+// NewTransactionRunner fails with ErrTransactionsUnsupported on a standalone
+// MongoDB server, the writes the services run in transactions could not be
+// made atomic there.
+func NewTransactionRunner(service domain.MongoDBService) (domain.TransactionRunner, error) {
+	if service == nil {
+		return nil, errors.New("service cannot be nil")
+	}
+
+	transactions, err := supportsTransactions(service)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to detect transaction support")
+	}
+	if !transactions {
+		log.Error().Msg("MongoDB does not support transactions, run it as a replica set")
+		return nil, ErrTransactionsUnsupported
+	}
+
+	return &transactionRunner{mongoDBService: service}, nil
+}
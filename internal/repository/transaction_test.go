@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func TestTransactionRunnerTestSuite(t *testing.T) {
+	suite.Run(t, new(TransactionRunnerTestSuite))
+}
+
+type TransactionRunnerTestSuite struct {
+	test.GoMockTestSuite
+
+	mongoDBService *mocks.MockMongoDBService
+}
+
+func (suite *TransactionRunnerTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.mongoDBService = mocks.NewMockMongoDBService(suite.MockCtrl)
+}
+
+func (suite *TransactionRunnerTestSuite) TestNewTransactionRunner_WithError() {
+	tests := []struct {
+		name           string
+		mongoDBService domain.MongoDBService
+		errorMsg       string
+	}{
+		{
+			name:           "mongoDBService is nil",
+			mongoDBService: nil,
+			errorMsg:       "service cannot be nil",
+		},
+		{
+			name: "mongoDBService.GetClient returns error",
+			mongoDBService: func() domain.MongoDBService {
+				suite.mongoDBService.EXPECT().GetClient().
+					Return(nil, assert.AnError)
+
+				return suite.mongoDBService
+			}(),
+			errorMsg: "failed to detect transaction support",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			runner, err := NewTransactionRunner(tt.mongoDBService)
+
+			suite.ErrorContains(err, tt.errorMsg)
+			suite.Nil(runner)
+		})
+	}
+}
+
+func (suite *TransactionRunnerTestSuite) TestWithTransaction_WithErrorOnGetClient() {
+	suite.mongoDBService.EXPECT().GetClient().
+		Return(nil, assert.AnError)
+
+	target := &transactionRunner{mongoDBService: suite.mongoDBService}
+
+	err := target.WithTransaction(context.Background(), func(context.Context) error {
+		suite.Fail("fn must not run without a session")
+		return nil
+	})
+
+	suite.Equal(internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to get client"), err)
+}
+
+func (suite *TransactionRunnerTestSuite) TestWithTransaction_WithRunningTransaction() {
+	// starting a session does not reach the server
+	client, err := mongo.Connect(context.Background(), options.Client().ApplyURI("mongodb://localhost:27017"))
+	suite.Require().NoError(err)
+	defer func() { _ = client.Disconnect(context.Background()) }()
+	session, err := client.StartSession()
+	suite.Require().NoError(err)
+	defer session.EndSession(context.Background())
+
+	target := &transactionRunner{mongoDBService: suite.mongoDBService}
+	ctx := mongo.NewSessionContext(context.Background(), session)
+
+	err = target.WithTransaction(ctx, func(actual context.Context) error {
+		suite.Equal(ctx, actual)
+		return assert.AnError
+	})
+
+	suite.ErrorIs(err, assert.AnError)
+}
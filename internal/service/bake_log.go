@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/utils"
+)
+
+type bakeLogService struct {
+	transactionRunner      domain.TransactionRunner
+	repository             domain.BakeLogRepository
+	sourdoughRecipeService domain.SourdoughRecipeService
+	revisionRepository     domain.SourdoughRecipeRevisionRepository
+	inventoryService       domain.InventoryService
+}
+
+func (service *bakeLogService) Create(ctx context.Context, recipeId uuid.UUID, request domain.CreateBakeLogRequest) (domain.BakeLogDto, error) {
+	if request.Rating < domain.MinBakeRating || request.Rating > domain.MaxBakeRating {
+		return domain.BakeLogDto{}, internalErrors.BakeLogInvalidRating(request.Rating)
+	}
+
+	if request.ScaledWeight < 0 {
+		return domain.BakeLogDto{}, internalErrors.BakeLogInvalidScaledWeight(request.ScaledWeight)
+	}
+
+	recipe, err := service.sourdoughRecipeService.FindById(ctx, recipeId)
+	if err != nil {
+		return domain.BakeLogDto{}, err
+	}
+
+	recipeVersion := request.RecipeVersion
+	if recipeVersion == 0 {
+		recipeVersion = recipe.Version
+	} else if recipeVersion < 0 || recipeVersion > recipe.Version {
+		return domain.BakeLogDto{}, internalErrors.BakeLogInvalidRecipeVersion(recipeVersion, recipe.Version)
+	}
+
+	if recipeVersion != recipe.Version {
+		recipe, err = service.getRevision(ctx, recipeId, recipeVersion)
+		if err != nil {
+			return domain.BakeLogDto{}, err
+		}
+	}
+
+	// the deducted ingredients are scaled by the weight of the baked recipe,
+	// with a positive one the scaled weight is positive too
+	if recipe.Details.TotalWeight <= 0 {
+		return domain.BakeLogDto{}, internalErrors.BakeLogRecipeWithoutWeight(recipeVersion)
+	}
+
+	scaledWeight := request.ScaledWeight
+	if scaledWeight == 0 {
+		scaledWeight = recipe.Details.TotalWeight
+	}
+
+	now := time.Now()
+	bakedAt := now
+	if request.BakedAt != nil {
+		bakedAt = *request.BakedAt
+	}
+
+	// the bake log and the deduction of its ingredients are stored together
+	var bakeLog domain.BakeLogEntity
+	err = service.transactionRunner.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		bakeLog, err = service.repository.Create(ctx, domain.BakeLogEntity{
+			Id:            uuid.New(),
+			RecipeId:      recipeId,
+			RecipeVersion: recipeVersion,
+			ScaledWeight:  scaledWeight,
+			Temperatures:  request.Temperatures.ToEntity(),
+			Timings:       request.Timings.ToEntity(),
+			Notes:         request.Notes,
+			Rating:        request.Rating,
+			BakedAt:       bakedAt,
+			CreatedAt:     now,
+		})
+		if err != nil {
+			log.Err(err).
+				Str("recipe_id", recipeId.String()).
+				Msg("failed to create bake log")
+
+			return internalErrors.NewInternalServerErrorWrap(err, "failed to create bake log")
+		}
+
+		return service.inventoryService.Deduct(ctx, scaleSourdoughRecipe(recipe, scaledWeight))
+	})
+	if err != nil {
+		return domain.BakeLogDto{}, err
+	}
+
+	return bakeLog.ToDto(), nil
+}
+
+func (service *bakeLogService) FindById(ctx context.Context, recipeId, id uuid.UUID) (domain.BakeLogDto, error) {
+	bakeLog, err := service.repository.GetById(ctx, id)
+	if err != nil {
+		log.Err(err).
+			Str("id", id.String()).
+			Msg("failed to find bake log by id")
+
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return domain.BakeLogDto{}, internalErrors.BakeLogNotFound(id)
+		}
+
+		return domain.BakeLogDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to find bake log by id")
+	}
+
+	if bakeLog.RecipeId != recipeId {
+		return domain.BakeLogDto{}, internalErrors.BakeLogNotFound(id)
+	}
+
+	return bakeLog.ToDto(), nil
+}
+
+func (service *bakeLogService) FindByRecipeId(ctx context.Context, recipeId uuid.UUID, offset, limit int) ([]domain.BakeLogDto, error) {
+	bakeLogs, err := service.repository.FindByRecipeId(ctx, recipeId, offset, limit)
+	if err != nil {
+		log.Err(err).
+			Str("recipe_id", recipeId.String()).
+			Msg("failed to find bake logs")
+
+		return nil, internalErrors.NewInternalServerErrorWrap(err, "failed to find bake logs")
+	}
+
+	return utils.Map(bakeLogs, func(entity domain.BakeLogEntity) domain.BakeLogDto {
+		return entity.ToDto()
+	}), nil
+}
+
+func (service *bakeLogService) Summary(ctx context.Context, recipeId uuid.UUID) (domain.BakeLogSummaryDto, error) {
+	summary, err := service.repository.Summarize(ctx, recipeId)
+	if err != nil {
+		log.Err(err).
+			Str("recipe_id", recipeId.String()).
+			Msg("failed to summarize bake logs")
+
+		return domain.BakeLogSummaryDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to summarize bake logs")
+	}
+
+	return domain.BakeLogSummaryDto{
+		RecipeId:      recipeId,
+		BakeCount:     summary.BakeCount,
+		AverageRating: summary.AverageRating,
+		LastBakedAt:   summary.LastBakedAt,
+	}, nil
+}
+
+// getRevision returns the recipe as it was at the version, the bake of an
+// older version deducts the ingredients of that version.
+func (service *bakeLogService) getRevision(ctx context.Context, recipeId uuid.UUID, version int) (domain.SourdoughRecipeDto, error) {
+	revision, err := service.revisionRepository.GetByRecipeIdAndVersion(ctx, recipeId, version)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return domain.SourdoughRecipeDto{}, internalErrors.SourdoughRecipeRevisionNotFound(recipeId, version)
+		}
+
+		return domain.SourdoughRecipeDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to find recipe revision")
+	}
+	return revision.Recipe.ToDto(), nil
+}
+
+func NewBakeLogService(
+	transactionRunner domain.TransactionRunner,
+	repository domain.BakeLogRepository,
+	sourdoughRecipeService domain.SourdoughRecipeService,
+	revisionRepository domain.SourdoughRecipeRevisionRepository,
+	inventoryService domain.InventoryService,
+) (domain.BakeLogService, error) {
+	if transactionRunner == nil {
+		return nil, errors.New("transactionRunner cannot be nil")
+	}
+
+	if repository == nil {
+		return nil, errors.New("repository cannot be nil")
+	}
+
+	if sourdoughRecipeService == nil {
+		return nil, errors.New("sourdoughRecipeService cannot be nil")
+	}
+
+	if revisionRepository == nil {
+		return nil, errors.New("revisionRepository cannot be nil")
+	}
+
+	if inventoryService == nil {
+		return nil, errors.New("inventoryService cannot be nil")
+	}
+
+	return &bakeLogService{
+		transactionRunner:      transactionRunner,
+		repository:             repository,
+		sourdoughRecipeService: sourdoughRecipeService,
+		revisionRepository:     revisionRepository,
+		inventoryService:       inventoryService,
+	}, nil
+}
@@ -0,0 +1,471 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func TestBakeLogServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(BakeLogServiceTestSuite))
+}
+
+type BakeLogServiceTestSuite struct {
+	test.GoMockTestSuite
+
+	ctx                    context.Context
+	transactionRunner      *mocks.MockTransactionRunner
+	repository             *mocks.MockBakeLogRepository
+	sourdoughRecipeService *mocks.MockSourdoughRecipeService
+	revisionRepository     *mocks.MockSourdoughRecipeRevisionRepository
+	inventoryService       *mocks.MockInventoryService
+
+	target domain.BakeLogService
+}
+
+func (suite *BakeLogServiceTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.ctx = context.Background()
+	suite.transactionRunner = mocks.NewMockTransactionRunner(suite.MockCtrl)
+	suite.repository = mocks.NewMockBakeLogRepository(suite.MockCtrl)
+	suite.sourdoughRecipeService = mocks.NewMockSourdoughRecipeService(suite.MockCtrl)
+	suite.revisionRepository = mocks.NewMockSourdoughRecipeRevisionRepository(suite.MockCtrl)
+	suite.inventoryService = mocks.NewMockInventoryService(suite.MockCtrl)
+
+	suite.target = test.Must(func() (domain.BakeLogService, error) {
+		return NewBakeLogService(
+			suite.transactionRunner,
+			suite.repository,
+			suite.sourdoughRecipeService,
+			suite.revisionRepository,
+			suite.inventoryService,
+		)
+	})
+}
+
+func (suite *BakeLogServiceTestSuite) TestCreate() {
+	request := domain.CreateBakeLogRequest{
+		RecipeVersion: 2,
+		ScaledWeight:  1800,
+		Temperatures:  domain.BakeTemperaturesDto{Room: 22.5, Dough: 25, Oven: 250},
+		Timings:       domain.BakeTimingsDto{BulkFermentationMinutes: 300, ProofMinutes: 720, BakeMinutes: 45},
+		Notes:         "great oven spring",
+		Rating:        4,
+		BakedAt:       &test.Date,
+	}
+	revision := createRevisionEntity(2)
+	revision.Recipe.AdditionalIngredients[0].Amount = 30
+	txCtx := context.WithValue(suite.ctx, "transaction", true)
+
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, test.ThirdId).
+		Return(createBakeLogRecipe(3), nil)
+	suite.revisionRepository.EXPECT().GetByRecipeIdAndVersion(suite.ctx, test.ThirdId, 2).
+		Return(revision, nil)
+	suite.expectTransaction(txCtx)
+	suite.repository.EXPECT().Create(txCtx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entity domain.BakeLogEntity) (domain.BakeLogEntity, error) {
+			suite.NotEmpty(entity.Id)
+			suite.NotEmpty(entity.CreatedAt)
+			suite.Equal(test.ThirdId, entity.RecipeId)
+			suite.Equal(2, entity.RecipeVersion)
+			suite.Equal(1800, entity.ScaledWeight)
+			suite.Equal(request.Temperatures.ToEntity(), entity.Temperatures)
+			suite.Equal(request.Timings.ToEntity(), entity.Timings)
+			suite.Equal("great oven spring", entity.Notes)
+			suite.Equal(4, entity.Rating)
+			suite.Equal(test.Date, entity.BakedAt)
+			return entity, nil
+		})
+	// the revision that was baked is deducted, not the current recipe
+	suite.inventoryService.EXPECT().Deduct(txCtx, scaleSourdoughRecipe(revision.Recipe.ToDto(), 1800)).
+		Return(nil)
+
+	result, err := suite.target.Create(suite.ctx, test.ThirdId, request)
+
+	suite.NoError(err)
+	suite.Equal(test.ThirdId, result.RecipeId)
+	suite.Equal(2, result.RecipeVersion)
+	suite.Equal(test.Date, result.BakedAt)
+}
+
+func (suite *BakeLogServiceTestSuite) TestCreate_WithDefaults() {
+	recipe := createBakeLogRecipe(3)
+
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, test.ThirdId).
+		Return(recipe, nil)
+	suite.expectTransaction(suite.ctx)
+	suite.repository.EXPECT().Create(suite.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entity domain.BakeLogEntity) (domain.BakeLogEntity, error) {
+			return entity, nil
+		})
+	suite.inventoryService.EXPECT().Deduct(suite.ctx, scaleSourdoughRecipe(recipe, 1920)).
+		Return(nil)
+
+	before := time.Now()
+	result, err := suite.target.Create(suite.ctx, test.ThirdId, domain.CreateBakeLogRequest{Rating: 5})
+
+	suite.NoError(err)
+	suite.Equal(3, result.RecipeVersion)
+	suite.Equal(1920, result.ScaledWeight)
+	suite.False(result.BakedAt.Before(before))
+	suite.Equal(result.CreatedAt, result.BakedAt)
+}
+
+// expectTransaction runs the transaction with txCtx, so the test sees which
+// calls take part in it.
+func (suite *BakeLogServiceTestSuite) expectTransaction(txCtx context.Context) {
+	suite.transactionRunner.EXPECT().WithTransaction(suite.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, fn func(ctx context.Context) error) error {
+			return fn(txCtx)
+		})
+}
+
+func (suite *BakeLogServiceTestSuite) TestCreate_WithInvalidRequest() {
+	tests := []struct {
+		name          string
+		request       domain.CreateBakeLogRequest
+		expectedError error
+	}{
+		{
+			name:          "rating lower than minimum",
+			request:       domain.CreateBakeLogRequest{Rating: 0},
+			expectedError: internalErrors.BakeLogInvalidRating(0),
+		},
+		{
+			name:          "rating greater than maximum",
+			request:       domain.CreateBakeLogRequest{Rating: 6},
+			expectedError: internalErrors.BakeLogInvalidRating(6),
+		},
+		{
+			name:          "negative scaled weight",
+			request:       domain.CreateBakeLogRequest{Rating: 3, ScaledWeight: -1},
+			expectedError: internalErrors.BakeLogInvalidScaledWeight(-1),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			result, err := suite.target.Create(suite.ctx, test.ThirdId, tt.request)
+
+			suite.Equal(tt.expectedError, err)
+			suite.Empty(result)
+		})
+	}
+}
+
+func (suite *BakeLogServiceTestSuite) TestCreate_WithInvalidRecipeVersion() {
+	tests := []struct {
+		name    string
+		version int
+	}{
+		{name: "negative version", version: -1},
+		{name: "version greater than current", version: 4},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, test.ThirdId).
+				Return(createBakeLogRecipe(3), nil)
+
+			result, err := suite.target.Create(suite.ctx, test.ThirdId, domain.CreateBakeLogRequest{
+				RecipeVersion: tt.version,
+				Rating:        3,
+			})
+
+			suite.Equal(internalErrors.BakeLogInvalidRecipeVersion(tt.version, 3), err)
+			suite.Empty(result)
+		})
+	}
+}
+
+func (suite *BakeLogServiceTestSuite) TestCreate_WithRecipeWithoutWeight() {
+	tests := []struct {
+		name         string
+		scaledWeight int
+	}{
+		{name: "recipe weight", scaledWeight: 0},
+		{name: "scaled weight", scaledWeight: 1800},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			recipe := createBakeLogRecipe(3)
+			recipe.Details = domain.RecipeDetailsDto{}
+
+			suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, test.ThirdId).
+				Return(recipe, nil)
+
+			result, err := suite.target.Create(suite.ctx, test.ThirdId, domain.CreateBakeLogRequest{
+				ScaledWeight: tt.scaledWeight,
+				Rating:       3,
+			})
+
+			suite.Equal(internalErrors.BakeLogRecipeWithoutWeight(3), err)
+			suite.Empty(result)
+		})
+	}
+}
+
+func (suite *BakeLogServiceTestSuite) TestCreate_WithErrorOnFindRecipe() {
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, test.ThirdId).
+		Return(domain.SourdoughRecipeDto{}, internalErrors.SourdoughRecipeNotFound(test.ThirdId.String()))
+
+	result, err := suite.target.Create(suite.ctx, test.ThirdId, domain.CreateBakeLogRequest{Rating: 3})
+
+	suite.Equal(internalErrors.SourdoughRecipeNotFound(test.ThirdId.String()), err)
+	suite.Empty(result)
+}
+
+func (suite *BakeLogServiceTestSuite) TestCreate_WithErrorOnGetRevision() {
+	tests := []struct {
+		name          string
+		err           error
+		expectedError error
+	}{
+		{
+			name:          "revision not found",
+			err:           mongo.ErrNoDocuments,
+			expectedError: internalErrors.SourdoughRecipeRevisionNotFound(test.ThirdId, 2),
+		},
+		{
+			name:          "repository fails",
+			err:           assert.AnError,
+			expectedError: internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to find recipe revision"),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, test.ThirdId).
+				Return(createBakeLogRecipe(3), nil)
+			suite.revisionRepository.EXPECT().GetByRecipeIdAndVersion(suite.ctx, test.ThirdId, 2).
+				Return(domain.SourdoughRecipeRevisionEntity{}, tt.err)
+
+			result, err := suite.target.Create(suite.ctx, test.ThirdId, domain.CreateBakeLogRequest{RecipeVersion: 2, Rating: 3})
+
+			suite.Equal(tt.expectedError, err)
+			suite.Empty(result)
+		})
+	}
+}
+
+func (suite *BakeLogServiceTestSuite) TestCreate_WithErrorOnDeduct() {
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, test.ThirdId).
+		Return(createBakeLogRecipe(1), nil)
+	suite.expectTransaction(suite.ctx)
+	suite.repository.EXPECT().Create(suite.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entity domain.BakeLogEntity) (domain.BakeLogEntity, error) {
+			return entity, nil
+		})
+	suite.inventoryService.EXPECT().Deduct(suite.ctx, gomock.Any()).
+		Return(assert.AnError)
+
+	result, err := suite.target.Create(suite.ctx, test.ThirdId, domain.CreateBakeLogRequest{Rating: 3})
+
+	suite.Equal(assert.AnError, err)
+	suite.Empty(result)
+}
+
+func (suite *BakeLogServiceTestSuite) TestCreate_WithErrorOnCreate() {
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, test.ThirdId).
+		Return(createBakeLogRecipe(1), nil)
+	suite.expectTransaction(suite.ctx)
+	suite.repository.EXPECT().Create(suite.ctx, gomock.Any()).
+		Return(domain.BakeLogEntity{}, assert.AnError)
+
+	result, err := suite.target.Create(suite.ctx, test.ThirdId, domain.CreateBakeLogRequest{Rating: 3})
+
+	suite.Equal(internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to create bake log"), err)
+	suite.Empty(result)
+}
+
+func (suite *BakeLogServiceTestSuite) TestFindById() {
+	entity := createBakeLogEntity()
+
+	suite.repository.EXPECT().GetById(suite.ctx, test.FirstId).
+		Return(entity, nil)
+
+	result, err := suite.target.FindById(suite.ctx, test.ThirdId, test.FirstId)
+
+	suite.NoError(err)
+	suite.Equal(entity.ToDto(), result)
+}
+
+func (suite *BakeLogServiceTestSuite) TestFindById_WithOtherRecipe() {
+	suite.repository.EXPECT().GetById(suite.ctx, test.FirstId).
+		Return(createBakeLogEntity(), nil)
+
+	result, err := suite.target.FindById(suite.ctx, test.SecondId, test.FirstId)
+
+	suite.Equal(internalErrors.BakeLogNotFound(test.FirstId), err)
+	suite.Empty(result)
+}
+
+func (suite *BakeLogServiceTestSuite) TestFindById_WithError() {
+	tests := []struct {
+		name                string
+		errorFromRepository error
+		expectedError       error
+	}{
+		{
+			name:                "with basic error",
+			errorFromRepository: assert.AnError,
+			expectedError:       internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to find bake log by id"),
+		},
+		{
+			name:                "with document not found error",
+			errorFromRepository: mongo.ErrNoDocuments,
+			expectedError:       internalErrors.BakeLogNotFound(test.FirstId),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.repository.EXPECT().GetById(suite.ctx, test.FirstId).
+				Return(domain.BakeLogEntity{}, tt.errorFromRepository)
+
+			result, err := suite.target.FindById(suite.ctx, test.ThirdId, test.FirstId)
+
+			suite.Equal(tt.expectedError, err)
+			suite.Empty(result)
+		})
+	}
+}
+
+func (suite *BakeLogServiceTestSuite) TestFindByRecipeId() {
+	entity := createBakeLogEntity()
+
+	suite.repository.EXPECT().FindByRecipeId(suite.ctx, test.ThirdId, 0, 10).
+		Return([]domain.BakeLogEntity{entity}, nil)
+
+	result, err := suite.target.FindByRecipeId(suite.ctx, test.ThirdId, 0, 10)
+
+	suite.NoError(err)
+	suite.Equal([]domain.BakeLogDto{entity.ToDto()}, result)
+}
+
+func (suite *BakeLogServiceTestSuite) TestFindByRecipeId_WithError() {
+	suite.repository.EXPECT().FindByRecipeId(suite.ctx, test.ThirdId, 0, 10).
+		Return(nil, assert.AnError)
+
+	result, err := suite.target.FindByRecipeId(suite.ctx, test.ThirdId, 0, 10)
+
+	suite.Equal(internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to find bake logs"), err)
+	suite.Nil(result)
+}
+
+func (suite *BakeLogServiceTestSuite) TestSummary() {
+	suite.repository.EXPECT().Summarize(suite.ctx, test.ThirdId).
+		Return(domain.BakeLogSummaryEntity{BakeCount: 3, AverageRating: 4.5, LastBakedAt: &test.Date}, nil)
+
+	result, err := suite.target.Summary(suite.ctx, test.ThirdId)
+
+	suite.NoError(err)
+	suite.Equal(domain.BakeLogSummaryDto{
+		RecipeId:      test.ThirdId,
+		BakeCount:     3,
+		AverageRating: 4.5,
+		LastBakedAt:   &test.Date,
+	}, result)
+}
+
+func (suite *BakeLogServiceTestSuite) TestSummary_WithError() {
+	suite.repository.EXPECT().Summarize(suite.ctx, test.ThirdId).
+		Return(domain.BakeLogSummaryEntity{}, assert.AnError)
+
+	result, err := suite.target.Summary(suite.ctx, test.ThirdId)
+
+	suite.Equal(internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to summarize bake logs"), err)
+	suite.Empty(result)
+}
+
+func TestNewBakeLogService_WithError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	transactionRunner := mocks.NewMockTransactionRunner(mockCtrl)
+	repository := mocks.NewMockBakeLogRepository(mockCtrl)
+	sourdoughRecipeService := mocks.NewMockSourdoughRecipeService(mockCtrl)
+	revisionRepository := mocks.NewMockSourdoughRecipeRevisionRepository(mockCtrl)
+
+	tests := []struct {
+		name     string
+		creator  func() (domain.BakeLogService, error)
+		errorMsg string
+	}{
+		{
+			name: "transactionRunner is nil",
+			creator: func() (domain.BakeLogService, error) {
+				return NewBakeLogService(nil, nil, nil, nil, nil)
+			},
+			errorMsg: "transactionRunner cannot be nil",
+		},
+		{
+			name: "repository is nil",
+			creator: func() (domain.BakeLogService, error) {
+				return NewBakeLogService(transactionRunner, nil, nil, nil, nil)
+			},
+			errorMsg: "repository cannot be nil",
+		},
+		{
+			name: "sourdoughRecipeService is nil",
+			creator: func() (domain.BakeLogService, error) {
+				return NewBakeLogService(transactionRunner, repository, nil, nil, nil)
+			},
+			errorMsg: "sourdoughRecipeService cannot be nil",
+		},
+		{
+			name: "revisionRepository is nil",
+			creator: func() (domain.BakeLogService, error) {
+				return NewBakeLogService(transactionRunner, repository, sourdoughRecipeService, nil, nil)
+			},
+			errorMsg: "revisionRepository cannot be nil",
+		},
+		{
+			name: "inventoryService is nil",
+			creator: func() (domain.BakeLogService, error) {
+				return NewBakeLogService(transactionRunner, repository, sourdoughRecipeService, revisionRepository, nil)
+			},
+			errorMsg: "inventoryService cannot be nil",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, err := tt.creator()
+
+			assert.Nil(t, service)
+			assert.ErrorContains(t, err, tt.errorMsg)
+		})
+	}
+}
+
+func createBakeLogRecipe(version int) domain.SourdoughRecipeDto {
+	return createRevisionEntity(version).Recipe.ToDto()
+}
+
+func createBakeLogEntity() domain.BakeLogEntity {
+	return domain.BakeLogEntity{
+		Id:            test.FirstId,
+		RecipeId:      test.ThirdId,
+		RecipeVersion: 1,
+		ScaledWeight:  1920,
+		Temperatures:  domain.BakeTemperatures{Room: 22, Dough: 25, Oven: 250},
+		Timings:       domain.BakeTimings{BulkFermentationMinutes: 300, ProofMinutes: 720, BakeMinutes: 45},
+		Notes:         "test notes",
+		Rating:        4,
+		BakedAt:       test.Date,
+		CreatedAt:     test.Date,
+	}
+}
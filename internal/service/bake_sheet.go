@@ -0,0 +1,180 @@
+package service
+
+import (
+	"embed"
+	htmlTemplate "html/template"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	textTemplate "text/template"
+
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/config"
+	"dough-calculator/internal/domain"
+)
+
+//go:embed templates/bake_sheet.md.tmpl templates/bake_sheet.html.tmpl
+var bakeSheetTemplates embed.FS
+
+const (
+	bakeSheetMarkdownTemplate = "bake_sheet.md.tmpl"
+	bakeSheetHtmlTemplate     = "bake_sheet.html.tmpl"
+)
+
+// bakeSheetTemplate is satisfied by both text/template and html/template.
+type bakeSheetTemplate interface {
+	Execute(writer io.Writer, data any) error
+}
+
+// bakeSheetRow is one line of the ingredient table, the percentage is
+// relative to the total flour of the dough.
+type bakeSheetRow struct {
+	Name            string
+	Amount          float64
+	BakerPercentage float64
+}
+
+type bakeSheetLevain struct {
+	Amount          float64
+	BakerPercentage float64
+	Starter         float64
+	Flour           []bakeSheetRow
+	Water           float64
+}
+
+type bakeSheetTotals struct {
+	Flour       float64
+	Water       float64
+	Levain      float64
+	Additional  float64
+	Hydration   float64
+	TotalWeight int
+}
+
+type bakeSheet struct {
+	Name                  string
+	Notes                 string
+	Yield                 domain.RecipeYieldDto
+	Flour                 []bakeSheetRow
+	Water                 []bakeSheetRow
+	AdditionalIngredients []bakeSheetRow
+	Levain                bakeSheetLevain
+	Totals                bakeSheetTotals
+}
+
+type bakeSheetRenderer struct {
+	templates map[string]bakeSheetTemplate
+}
+
+func (renderer *bakeSheetRenderer) Render(writer io.Writer, format string, recipe domain.SourdoughRecipeDto) error {
+	tmpl, ok := renderer.templates[format]
+	if !ok {
+		return errors.Errorf("bake sheet format %s is not supported", format)
+	}
+
+	if err := tmpl.Execute(writer, newBakeSheet(recipe)); err != nil {
+		return errors.Wrapf(err, "failed to render %s bake sheet", format)
+	}
+
+	return nil
+}
+
+func newBakeSheet(recipe domain.SourdoughRecipeDto) bakeSheet {
+	details := recipe.Details
+	flourTotal := details.Flour.Amount
+
+	percentageOf := func(amount float64) float64 {
+		if flourTotal == 0 {
+			return 0
+		}
+		return math.Round(amount/flourTotal*1000) / 10
+	}
+	flourRows := func(flour []domain.FlourAmountDto) []bakeSheetRow {
+		rows := make([]bakeSheetRow, 0, len(flour))
+		for _, amount := range flour {
+			rows = append(rows, bakeSheetRow{Name: amount.Name, Amount: amount.Amount, BakerPercentage: percentageOf(amount.Amount)})
+		}
+		return rows
+	}
+	bakerRows := func(amounts []domain.BakerAmountDto) []bakeSheetRow {
+		rows := make([]bakeSheetRow, 0, len(amounts))
+		for _, amount := range amounts {
+			rows = append(rows, bakeSheetRow{Name: amount.Name, Amount: amount.Amount, BakerPercentage: percentageOf(amount.Amount)})
+		}
+		return rows
+	}
+
+	return bakeSheet{
+		Name:                  recipe.Name,
+		Notes:                 recipe.Description,
+		Yield:                 recipe.Yield,
+		Flour:                 flourRows(recipe.Flour),
+		Water:                 bakerRows(recipe.Water),
+		AdditionalIngredients: bakerRows(recipe.AdditionalIngredients),
+		Levain: bakeSheetLevain{
+			Amount:          recipe.Levain.Amount.Amount,
+			BakerPercentage: percentageOf(recipe.Levain.Amount.Amount),
+			Starter:         recipe.Levain.Starter.Amount,
+			Flour:           flourRows(recipe.Levain.Flour),
+			Water:           recipe.Levain.Water.Amount,
+		},
+		Totals: bakeSheetTotals{
+			Flour:       flourTotal,
+			Water:       details.Water.Amount,
+			Levain:      details.Levain.Amount,
+			Additional:  details.AdditionalIngredients.Amount,
+			Hydration:   percentageOf(details.Water.Amount),
+			TotalWeight: details.TotalWeight,
+		},
+	}
+}
+
+// readBakeSheetTemplate prefers the template of the same name in the
+// configured directory over the embedded one.
+func readBakeSheetTemplate(templates config.Templates, name string) (string, error) {
+	if templates.Path != "" {
+		content, err := os.ReadFile(filepath.Join(templates.Path, name))
+		if err == nil {
+			return string(content), nil
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return "", errors.Wrapf(err, "failed to read template %s", name)
+		}
+	}
+
+	content, err := bakeSheetTemplates.ReadFile("templates/" + name)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read embedded template %s", name)
+	}
+
+	return string(content), nil
+}
+
+func NewBakeSheetRenderer(templates config.Templates) (domain.BakeSheetRenderer, error) {
+	markdown, err := readBakeSheetTemplate(templates, bakeSheetMarkdownTemplate)
+	if err != nil {
+		return nil, err
+	}
+	markdownSheet, err := textTemplate.New(bakeSheetMarkdownTemplate).Parse(markdown)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse template %s", bakeSheetMarkdownTemplate)
+	}
+
+	html, err := readBakeSheetTemplate(templates, bakeSheetHtmlTemplate)
+	if err != nil {
+		return nil, err
+	}
+	htmlSheet, err := htmlTemplate.New(bakeSheetHtmlTemplate).Parse(html)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse template %s", bakeSheetHtmlTemplate)
+	}
+
+	return &bakeSheetRenderer{
+		templates: map[string]bakeSheetTemplate{
+			domain.BakeSheetFormatMarkdown: markdownSheet,
+			domain.BakeSheetFormatHtml:     htmlSheet,
+		},
+	}, nil
+}
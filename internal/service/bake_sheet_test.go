@@ -0,0 +1,164 @@
+package service
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"dough-calculator/internal/config"
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/test"
+)
+
+func TestBakeSheetRenderer_Render_Markdown(t *testing.T) {
+	renderer, err := NewBakeSheetRenderer(config.Templates{})
+	require.NoError(t, err)
+
+	var actual bytes.Buffer
+	err = renderer.Render(&actual, domain.BakeSheetFormatMarkdown, createBakeSheetRecipe())
+
+	require.NoError(t, err)
+	expected, err := os.ReadFile("testdata/bake_sheet.md")
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), actual.String())
+}
+
+func TestBakeSheetRenderer_Render_Html(t *testing.T) {
+	renderer, err := NewBakeSheetRenderer(config.Templates{})
+	require.NoError(t, err)
+
+	recipe := createBakeSheetRecipe()
+	recipe.Name = "Country <loaf>"
+
+	var actual bytes.Buffer
+	err = renderer.Render(&actual, domain.BakeSheetFormatHtml, recipe)
+
+	require.NoError(t, err)
+	assert.Contains(t, actual.String(), "<h1>Country &lt;loaf&gt;</h1>")
+	assert.Contains(t, actual.String(), `<tr><td>Bread flour</td><td class="number">450</td><td class="number">90.0</td></tr>`)
+	assert.Contains(t, actual.String(), `<tr><td>Starter</td><td class="number">10</td></tr>`)
+	assert.Contains(t, actual.String(), `<tr><th>Dough</th><th class="number">1001</th></tr>`)
+	assert.Contains(t, actual.String(), "<p>Shape tight, bake at 250 °C.</p>")
+}
+
+func TestBakeSheetRenderer_Render_WithOverride(t *testing.T) {
+	path := t.TempDir()
+	err := os.WriteFile(filepath.Join(path, "bake_sheet.md.tmpl"), []byte("{{.Name}}: {{.Totals.TotalWeight}} g"), 0644)
+	require.NoError(t, err)
+
+	renderer, err := NewBakeSheetRenderer(config.Templates{Path: path})
+	require.NoError(t, err)
+
+	var markdown bytes.Buffer
+	err = renderer.Render(&markdown, domain.BakeSheetFormatMarkdown, createBakeSheetRecipe())
+
+	require.NoError(t, err)
+	assert.Equal(t, "Country loaf: 1001 g", markdown.String())
+
+	var html bytes.Buffer
+	err = renderer.Render(&html, domain.BakeSheetFormatHtml, createBakeSheetRecipe())
+
+	require.NoError(t, err)
+	assert.Contains(t, html.String(), "<h1>Country loaf</h1>")
+}
+
+func TestBakeSheetRenderer_Render_WithUnknownFormat(t *testing.T) {
+	renderer, err := NewBakeSheetRenderer(config.Templates{})
+	require.NoError(t, err)
+
+	err = renderer.Render(&bytes.Buffer{}, "pdf", createBakeSheetRecipe())
+
+	assert.ErrorContains(t, err, "bake sheet format pdf is not supported")
+}
+
+func TestBakeSheetRenderer_Render_WithErrorOnExecute(t *testing.T) {
+	path := t.TempDir()
+	err := os.WriteFile(filepath.Join(path, "bake_sheet.md.tmpl"), []byte("{{.Unknown}}"), 0644)
+	require.NoError(t, err)
+
+	renderer, err := NewBakeSheetRenderer(config.Templates{Path: path})
+	require.NoError(t, err)
+
+	err = renderer.Render(&bytes.Buffer{}, domain.BakeSheetFormatMarkdown, createBakeSheetRecipe())
+
+	assert.ErrorContains(t, err, "failed to render markdown bake sheet")
+}
+
+func TestNewBakeSheetRenderer_WithInvalidOverride(t *testing.T) {
+	tests := []struct {
+		name             string
+		file             string
+		expectedErrorMsg string
+	}{
+		{
+			name:             "markdown",
+			file:             "bake_sheet.md.tmpl",
+			expectedErrorMsg: "failed to parse template bake_sheet.md.tmpl",
+		},
+		{
+			name:             "html",
+			file:             "bake_sheet.html.tmpl",
+			expectedErrorMsg: "failed to parse template bake_sheet.html.tmpl",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := t.TempDir()
+			err := os.WriteFile(filepath.Join(path, tt.file), []byte("{{.Name"), 0644)
+			require.NoError(t, err)
+
+			renderer, err := NewBakeSheetRenderer(config.Templates{Path: path})
+
+			assert.ErrorContains(t, err, tt.expectedErrorMsg)
+			assert.Nil(t, renderer)
+		})
+	}
+}
+
+func TestNewBakeSheetRenderer_WithUnreadableOverride(t *testing.T) {
+	path := t.TempDir()
+	err := os.Mkdir(filepath.Join(path, "bake_sheet.md.tmpl"), 0755)
+	require.NoError(t, err)
+
+	renderer, err := NewBakeSheetRenderer(config.Templates{Path: path})
+
+	assert.ErrorContains(t, err, "failed to read template bake_sheet.md.tmpl")
+	assert.Nil(t, renderer)
+}
+
+func createBakeSheetRecipe() domain.SourdoughRecipeDto {
+	return domain.SourdoughRecipeDto{
+		RecipeDto: domain.RecipeDto{
+			Id:          test.FirstId,
+			Name:        "Country loaf",
+			Description: "Shape tight, bake at 250 °C.",
+			Flour: []domain.FlourAmountDto{
+				{FlourDto: domain.FlourDto{Id: test.SecondId, Name: "Bread flour"}, Amount: 450},
+				{FlourDto: domain.FlourDto{Id: test.ThirdId, Name: "Whole wheat flour"}, Amount: 50},
+			},
+			Water: []domain.BakerAmountDto{{Amount: 350, BakerPercentage: 70}},
+			AdditionalIngredients: []domain.BakerAmountDto{
+				{Name: "Salt", Amount: 11, BakerPercentage: 2.2},
+			},
+			Details: domain.RecipeDetailsDto{
+				Flour:                 domain.BakerAmountDto{Amount: 500},
+				Water:                 domain.BakerAmountDto{Amount: 350},
+				Levain:                domain.BakerAmountDto{Amount: 90},
+				AdditionalIngredients: domain.BakerAmountDto{Amount: 11},
+				TotalWeight:           1001,
+			},
+			Yield: domain.RecipeYieldDto{Unit: "loaf", Amount: 1},
+		},
+		Levain: domain.SourdoughLevainAgentDto{
+			Amount:  domain.BakerAmountDto{Amount: 90, BakerPercentage: 18},
+			Starter: domain.BakerAmountDto{Amount: 10},
+			Flour:   []domain.FlourAmountDto{{FlourDto: domain.FlourDto{Id: test.SecondId, Name: "Bread flour"}, Amount: 40}},
+			Water:   domain.BakerAmountDto{Amount: 40},
+		},
+	}
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/utils"
+)
+
+type calculatorService struct{}
+
+// CalculateSourdough computes the details and baker percentages of a recipe
+// draft with the same math as a saved recipe, without touching the
+// repository. Water, additional ingredients and the levain are expressed
+// relative to the dough flour; the levain starter and water relative to the
+// levain flour.
+func (service *calculatorService) CalculateSourdough(
+	_ context.Context,
+	request domain.CalculateSourdoughRecipeRequest,
+) (domain.SourdoughRecipeDto, error) {
+	if err := service.validate(request); err != nil {
+		return domain.SourdoughRecipeDto{}, err
+	}
+
+	createRequest := request.CreateSourdoughRecipeRequest
+	flourAmount := calculateSourdoughRecipeDetails(createRequest).Flour.Amount
+
+	withPercentage := func(amount domain.BakerAmountDto) domain.BakerAmountDto {
+		amount.BakerPercentage = amount.Amount / flourAmount * 100
+		return amount
+	}
+	createRequest.Water = utils.Map(createRequest.Water, withPercentage)
+	createRequest.AdditionalIngredients = utils.Map(createRequest.AdditionalIngredients, withPercentage)
+	createRequest.Levain = service.calculateLevain(createRequest.Levain, flourAmount)
+
+	recipe := domain.SourdoughRecipeDto{
+		RecipeDto: domain.RecipeDto{
+			Name:                  createRequest.Name,
+			Description:           createRequest.Description,
+			Flour:                 createRequest.Flour,
+			Water:                 createRequest.Water,
+			AdditionalIngredients: createRequest.AdditionalIngredients,
+			Details:               calculateSourdoughRecipeDetails(createRequest),
+			NutritionFacts:        createRequest.NutritionFacts,
+			Yield:                 createRequest.Yield,
+			Tags:                  normalizeTags(createRequest.Tags),
+			Category:              normalizeTag(createRequest.Category),
+		},
+		Levain: createRequest.Levain,
+	}
+
+	if request.FinalDoughWeight != nil {
+		recipe = scaleSourdoughRecipe(recipe, *request.FinalDoughWeight)
+	}
+
+	return recipe, nil
+}
+
+func (service *calculatorService) validate(request domain.CalculateSourdoughRecipeRequest) error {
+	var flourAmount float64
+	for _, flour := range request.Flour {
+		if flour.Amount < 0 {
+			return internalErrors.CalculationRequestInvalid(
+				fmt.Sprintf("amount %.2f of flour %s must not be negative", flour.Amount, flour.Name))
+		}
+		flourAmount += flour.Amount
+	}
+	if flourAmount == 0 {
+		return internalErrors.CalculationRequestInvalid("flour amount must be greater than 0")
+	}
+
+	amounts := append(append([]domain.BakerAmountDto{}, request.Water...), request.AdditionalIngredients...)
+	amounts = append(amounts, request.Levain.Amount, request.Levain.Starter, request.Levain.Water)
+	for _, amount := range amounts {
+		if amount.Amount < 0 {
+			return internalErrors.CalculationRequestInvalid(
+				fmt.Sprintf("amount %.2f of %s must not be negative", amount.Amount, amount.Name))
+		}
+	}
+
+	if request.FinalDoughWeight != nil && *request.FinalDoughWeight <= 0 {
+		return internalErrors.CalculationRequestInvalid(
+			fmt.Sprintf("final dough weight %d must be greater than 0", *request.FinalDoughWeight))
+	}
+
+	return nil
+}
+
+// calculateLevain fills in the levain percentages. A levain without an
+// amount weighs as much as its starter, flour and water together.
+func (service *calculatorService) calculateLevain(levain domain.SourdoughLevainAgentDto, flourAmount float64) domain.SourdoughLevainAgentDto {
+	var levainFlourAmount float64
+	for _, flour := range levain.Flour {
+		levainFlourAmount += flour.Amount
+	}
+
+	if levain.Amount.Amount == 0 {
+		levain.Amount.Amount = levain.Starter.Amount + levainFlourAmount + levain.Water.Amount
+	}
+	levain.Amount.BakerPercentage = levain.Amount.Amount / flourAmount * 100
+
+	if levainFlourAmount > 0 {
+		levain.Starter.BakerPercentage = levain.Starter.Amount / levainFlourAmount * 100
+		levain.Water.BakerPercentage = levain.Water.Amount / levainFlourAmount * 100
+	}
+
+	return levain
+}
+
+func NewCalculatorService() (domain.CalculatorService, error) {
+	return &calculatorService{}, nil
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func TestCalculatorServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(CalculatorServiceTestSuite))
+}
+
+type CalculatorServiceTestSuite struct {
+	suite.Suite
+
+	ctx context.Context
+
+	target domain.CalculatorService
+}
+
+func (suite *CalculatorServiceTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+
+	suite.target = test.Must(NewCalculatorService)
+}
+
+func (suite *CalculatorServiceTestSuite) TestCalculateSourdough() {
+	actual, err := suite.target.CalculateSourdough(suite.ctx, domain.CalculateSourdoughRecipeRequest{
+		CreateSourdoughRecipeRequest: suite.createRequest(),
+	})
+
+	suite.NoError(err)
+	suite.Equal(uuid.Nil, actual.Id)
+	suite.Equal("Country loaf", actual.Name)
+	suite.Equal([]string{"rustic"}, actual.Tags)
+	suite.Equal("bread", actual.Category)
+	suite.Equal([]domain.BakerAmountDto{
+		{Name: "Water", Amount: 700, BakerPercentage: 70},
+		{Name: "Bassinage", Amount: 50, BakerPercentage: 5},
+	}, actual.Water)
+	suite.Equal([]domain.BakerAmountDto{{Name: "Salt", Amount: 20, BakerPercentage: 2}}, actual.AdditionalIngredients)
+	suite.Equal(domain.BakerAmountDto{Amount: 200, BakerPercentage: 20}, actual.Levain.Amount)
+	suite.InDelta(22.22, actual.Levain.Starter.BakerPercentage, 0.01)
+	suite.Equal(domain.BakerAmountDto{Amount: 90, BakerPercentage: 100}, actual.Levain.Water)
+	suite.Equal(domain.RecipeDetailsDto{
+		Flour:                 domain.BakerAmountDto{Amount: 1000, BakerPercentage: 100},
+		Water:                 domain.BakerAmountDto{Amount: 750, BakerPercentage: 75},
+		Levain:                domain.BakerAmountDto{Amount: 200, BakerPercentage: 20},
+		AdditionalIngredients: domain.BakerAmountDto{Amount: 20, BakerPercentage: 2},
+		TotalWeight:           1970,
+	}, actual.Details)
+}
+
+func (suite *CalculatorServiceTestSuite) TestCalculateSourdough_WithFinalDoughWeight() {
+	finalDoughWeight := 985
+
+	actual, err := suite.target.CalculateSourdough(suite.ctx, domain.CalculateSourdoughRecipeRequest{
+		CreateSourdoughRecipeRequest: suite.createRequest(),
+		FinalDoughWeight:             &finalDoughWeight,
+	})
+
+	suite.NoError(err)
+	suite.Equal(450.0, actual.Flour[0].Amount)
+	suite.Equal(50.0, actual.Flour[1].Amount)
+	suite.Equal(domain.BakerAmountDto{Name: "Water", Amount: 350, BakerPercentage: 70}, actual.Water[0])
+	suite.Equal(domain.BakerAmountDto{Name: "Salt", Amount: 10, BakerPercentage: 2}, actual.AdditionalIngredients[0])
+	suite.Equal(domain.BakerAmountDto{Amount: 100, BakerPercentage: 20}, actual.Levain.Amount)
+	suite.Equal(45.0, actual.Levain.Flour[0].Amount)
+	suite.Equal(domain.RecipeDetailsDto{
+		Flour:                 domain.BakerAmountDto{Amount: 500, BakerPercentage: 100},
+		Water:                 domain.BakerAmountDto{Amount: 375, BakerPercentage: 75},
+		Levain:                domain.BakerAmountDto{Amount: 100, BakerPercentage: 20},
+		AdditionalIngredients: domain.BakerAmountDto{Amount: 10, BakerPercentage: 2},
+		TotalWeight:           985,
+	}, actual.Details)
+}
+
+func (suite *CalculatorServiceTestSuite) TestCalculateSourdough_WithLevainAmount() {
+	request := suite.createRequest()
+	request.Levain.Amount = domain.BakerAmountDto{Amount: 150}
+
+	actual, err := suite.target.CalculateSourdough(suite.ctx, domain.CalculateSourdoughRecipeRequest{
+		CreateSourdoughRecipeRequest: request,
+	})
+
+	suite.NoError(err)
+	suite.Equal(domain.BakerAmountDto{Amount: 150, BakerPercentage: 15}, actual.Levain.Amount)
+	suite.Equal(1920, actual.Details.TotalWeight)
+}
+
+func (suite *CalculatorServiceTestSuite) TestCalculateSourdough_WithInvalidRequest() {
+	zero := 0
+
+	tests := []struct {
+		name          string
+		modify        func(request *domain.CalculateSourdoughRecipeRequest)
+		expectedError error
+	}{
+		{
+			name: "without flour",
+			modify: func(request *domain.CalculateSourdoughRecipeRequest) {
+				request.Flour = nil
+			},
+			expectedError: internalErrors.CalculationRequestInvalid("flour amount must be greater than 0"),
+		},
+		{
+			name: "negative flour",
+			modify: func(request *domain.CalculateSourdoughRecipeRequest) {
+				request.Flour[1].Amount = -100
+			},
+			expectedError: internalErrors.CalculationRequestInvalid("amount -100.00 of flour Rye flour must not be negative"),
+		},
+		{
+			name: "negative water",
+			modify: func(request *domain.CalculateSourdoughRecipeRequest) {
+				request.Water[1].Amount = -50
+			},
+			expectedError: internalErrors.CalculationRequestInvalid("amount -50.00 of Bassinage must not be negative"),
+		},
+		{
+			name: "zero final dough weight",
+			modify: func(request *domain.CalculateSourdoughRecipeRequest) {
+				request.FinalDoughWeight = &zero
+			},
+			expectedError: internalErrors.CalculationRequestInvalid("final dough weight 0 must be greater than 0"),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			request := domain.CalculateSourdoughRecipeRequest{CreateSourdoughRecipeRequest: suite.createRequest()}
+			tt.modify(&request)
+
+			_, err := suite.target.CalculateSourdough(suite.ctx, request)
+
+			suite.Equal(tt.expectedError, err)
+		})
+	}
+}
+
+func (suite *CalculatorServiceTestSuite) createRequest() domain.CreateSourdoughRecipeRequest {
+	breadFlour := domain.FlourDto{Id: test.FirstId, Name: "Bread flour"}
+
+	return domain.CreateSourdoughRecipeRequest{
+		Name: "Country loaf",
+		Flour: []domain.FlourAmountDto{
+			{FlourDto: breadFlour, Amount: 900},
+			{FlourDto: domain.FlourDto{Id: test.SecondId, Name: "Rye flour"}, Amount: 100},
+		},
+		Water: []domain.BakerAmountDto{
+			{Name: "Water", Amount: 700},
+			{Name: "Bassinage", Amount: 50},
+		},
+		Levain: domain.SourdoughLevainAgentDto{
+			Starter: domain.BakerAmountDto{Amount: 20},
+			Flour:   []domain.FlourAmountDto{{FlourDto: breadFlour, Amount: 90}},
+			Water:   domain.BakerAmountDto{Amount: 90},
+		},
+		AdditionalIngredients: []domain.BakerAmountDto{{Name: "Salt", Amount: 20}},
+		Tags:                  []string{" Rustic "},
+		Category:              "Bread",
+	}
+}
@@ -32,6 +32,7 @@ func TestConfigManager_ParseConfig(t *testing.T) {
 			Rest: config.Rest{
 				Server:               ":8080",
 				ContextPath:          "/v1",
+				PublicUrl:            "http://localhost:8080",
 				ReadTimeout:          5,
 				WriteTimeout:         5,
 				IdleTimeout:          120,
@@ -42,6 +43,14 @@ func TestConfigManager_ParseConfig(t *testing.T) {
 			Uri:               "mongodb://localhost:27017",
 			ConnectionTimeout: 10000,
 		},
+		Storage: config.Storage{
+			Type:          config.StorageTypeGridFS,
+			Local:         config.LocalStorage{Path: "/tmp/images"},
+			GridFS:        config.GridFSStorage{Bucket: "images"},
+			MaxImageSize:  1048576,
+			ThumbnailSize: 200,
+		},
+		Templates: config.Templates{Path: "/tmp/templates"},
 	}, managerStr.config)
 }
 
@@ -61,6 +70,7 @@ func TestConfigManager_ParseConfig_WithEnvironmentVariableOverride(t *testing.T)
 			Rest: config.Rest{
 				Server:               ":8080",
 				ContextPath:          "/v1",
+				PublicUrl:            "http://localhost:8080",
 				ReadTimeout:          5,
 				WriteTimeout:         5,
 				IdleTimeout:          120,
@@ -71,6 +81,14 @@ func TestConfigManager_ParseConfig_WithEnvironmentVariableOverride(t *testing.T)
 			Uri:               "test_uri_override",
 			ConnectionTimeout: 10000,
 		},
+		Storage: config.Storage{
+			Type:          config.StorageTypeGridFS,
+			Local:         config.LocalStorage{Path: "/tmp/images"},
+			GridFS:        config.GridFSStorage{Bucket: "images"},
+			MaxImageSize:  1048576,
+			ThumbnailSize: 200,
+		},
+		Templates: config.Templates{Path: "/tmp/templates"},
 	}, managerStr.config)
 }
 
@@ -129,6 +147,7 @@ func TestConfigManager_GetConfig(t *testing.T) {
 			Rest: config.Rest{
 				Server:               ":8080",
 				ContextPath:          "/v1",
+				PublicUrl:            "http://localhost:8080",
 				ReadTimeout:          5,
 				WriteTimeout:         5,
 				IdleTimeout:          120,
@@ -139,5 +158,13 @@ func TestConfigManager_GetConfig(t *testing.T) {
 			Uri:               "mongodb://localhost:27017",
 			ConnectionTimeout: 10000,
 		},
+		Storage: config.Storage{
+			Type:          config.StorageTypeGridFS,
+			Local:         config.LocalStorage{Path: "/tmp/images"},
+			GridFS:        config.GridFSStorage{Bucket: "images"},
+			MaxImageSize:  1048576,
+			ThumbnailSize: 200,
+		},
+		Templates: config.Templates{Path: "/tmp/templates"},
 	}, manager.GetConfig())
 }
@@ -0,0 +1,283 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	bolt "go.etcd.io/bbolt"
+
+	"dough-calculator/internal/config"
+	"dough-calculator/internal/domain"
+)
+
+// boltDatabase keeps every collection in a bucket of a bolt file, the
+// documents keyed by the bytes of their id.
+type boltDatabase struct {
+	db *bolt.DB
+}
+
+func (database *boltDatabase) Load(collection string) (map[uuid.UUID][]byte, error) {
+	documents := map[uuid.UUID][]byte{}
+
+	err := database.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(collection))
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(key, value []byte) error {
+			id, err := uuid.FromBytes(key)
+			if err != nil {
+				return errors.Wrapf(err, "invalid document key in bucket %s", collection)
+			}
+			// values are only valid during the transaction
+			documents[id] = append([]byte(nil), value...)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load bucket %s", collection)
+	}
+
+	return documents, nil
+}
+
+func (database *boltDatabase) Put(collection string, documents map[uuid.UUID][]byte) error {
+	err := database.db.Update(func(tx *bolt.Tx) error {
+		return boltPut(tx, collection, documents)
+	})
+
+	return errors.Wrapf(err, "failed to write bucket %s", collection)
+}
+
+func (database *boltDatabase) Delete(collection string, id uuid.UUID) error {
+	err := database.db.Update(func(tx *bolt.Tx) error {
+		return boltDelete(tx, collection, id)
+	})
+
+	return errors.Wrapf(err, "failed to delete from bucket %s", collection)
+}
+
+// Begin starts a bolt read-write transaction, bolt runs one at a time.
+func (database *boltDatabase) Begin() (domain.EmbeddedTransaction, error) {
+	tx, err := database.db.Begin(true)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to begin transaction")
+	}
+
+	return &boltTransaction{tx: tx}, nil
+}
+
+func (database *boltDatabase) Close() error {
+	return database.db.Close()
+}
+
+type boltTransaction struct {
+	tx *bolt.Tx
+}
+
+func (transaction *boltTransaction) Put(collection string, documents map[uuid.UUID][]byte) error {
+	return errors.Wrapf(boltPut(transaction.tx, collection, documents), "failed to write bucket %s", collection)
+}
+
+func (transaction *boltTransaction) Delete(collection string, id uuid.UUID) error {
+	return errors.Wrapf(boltDelete(transaction.tx, collection, id), "failed to delete from bucket %s", collection)
+}
+
+func (transaction *boltTransaction) Commit() error {
+	return errors.Wrap(transaction.tx.Commit(), "failed to commit transaction")
+}
+
+func (transaction *boltTransaction) Rollback() error {
+	return errors.Wrap(transaction.tx.Rollback(), "failed to roll back transaction")
+}
+
+func boltPut(tx *bolt.Tx, collection string, documents map[uuid.UUID][]byte) error {
+	bucket, err := tx.CreateBucketIfNotExists([]byte(collection))
+	if err != nil {
+		return err
+	}
+
+	for id, document := range documents {
+		if err := bucket.Put(id[:], document); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func boltDelete(tx *bolt.Tx, collection string, id uuid.UUID) error {
+	bucket := tx.Bucket([]byte(collection))
+	if bucket == nil {
+		return nil
+	}
+	return bucket.Delete(id[:])
+}
+
+// memoryDatabase keeps the collections for the lifetime of the process.
+// Writers hold writeMutex, a transaction from Begin until it ends.
+type memoryDatabase struct {
+	writeMutex  sync.Mutex
+	mutex       sync.Mutex
+	collections map[string]map[uuid.UUID][]byte
+}
+
+func (database *memoryDatabase) Load(collection string) (map[uuid.UUID][]byte, error) {
+	database.mutex.Lock()
+	defer database.mutex.Unlock()
+
+	documents := make(map[uuid.UUID][]byte, len(database.collections[collection]))
+	for id, document := range database.collections[collection] {
+		documents[id] = document
+	}
+
+	return documents, nil
+}
+
+func (database *memoryDatabase) Put(collection string, documents map[uuid.UUID][]byte) error {
+	database.writeMutex.Lock()
+	defer database.writeMutex.Unlock()
+	database.mutex.Lock()
+	defer database.mutex.Unlock()
+
+	database.put(collection, documents)
+
+	return nil
+}
+
+func (database *memoryDatabase) Delete(collection string, id uuid.UUID) error {
+	database.writeMutex.Lock()
+	defer database.writeMutex.Unlock()
+	database.mutex.Lock()
+	defer database.mutex.Unlock()
+
+	database.delete(collection, id)
+
+	return nil
+}
+
+func (database *memoryDatabase) Begin() (domain.EmbeddedTransaction, error) {
+	database.writeMutex.Lock()
+
+	return &memoryTransaction{database: database}, nil
+}
+
+func (database *memoryDatabase) Close() error {
+	return nil
+}
+
+// put stores the documents, the caller holds both locks.
+func (database *memoryDatabase) put(collection string, documents map[uuid.UUID][]byte) {
+	stored, ok := database.collections[collection]
+	if !ok {
+		stored = map[uuid.UUID][]byte{}
+		database.collections[collection] = stored
+	}
+	for id, document := range documents {
+		stored[id] = document
+	}
+}
+
+func (database *memoryDatabase) delete(collection string, id uuid.UUID) {
+	delete(database.collections[collection], id)
+}
+
+// memoryTransaction records the writes of a transaction and replays them on
+// commit, it holds the write lock of the database until it ends.
+type memoryTransaction struct {
+	database *memoryDatabase
+	writes   []func()
+	done     bool
+}
+
+func (transaction *memoryTransaction) Put(collection string, documents map[uuid.UUID][]byte) error {
+	if transaction.done {
+		return errors.New("transaction has ended")
+	}
+
+	copied := make(map[uuid.UUID][]byte, len(documents))
+	for id, document := range documents {
+		copied[id] = document
+	}
+	transaction.writes = append(transaction.writes, func() { transaction.database.put(collection, copied) })
+
+	return nil
+}
+
+func (transaction *memoryTransaction) Delete(collection string, id uuid.UUID) error {
+	if transaction.done {
+		return errors.New("transaction has ended")
+	}
+
+	transaction.writes = append(transaction.writes, func() { transaction.database.delete(collection, id) })
+
+	return nil
+}
+
+func (transaction *memoryTransaction) Commit() error {
+	if transaction.done {
+		return errors.New("transaction has ended")
+	}
+	defer transaction.end()
+
+	// readers see all writes of the transaction at once
+	transaction.database.mutex.Lock()
+	defer transaction.database.mutex.Unlock()
+	for _, write := range transaction.writes {
+		write()
+	}
+
+	return nil
+}
+
+func (transaction *memoryTransaction) Rollback() error {
+	if transaction.done {
+		return errors.New("transaction has ended")
+	}
+	transaction.end()
+
+	return nil
+}
+
+func (transaction *memoryTransaction) end() {
+	transaction.done = true
+	transaction.writes = nil
+	transaction.database.writeMutex.Unlock()
+}
+
+// NewEmbeddedDatabase opens the embedded database selected by the database
+// type of the configuration.
+func NewEmbeddedDatabase(database config.Database) (domain.EmbeddedDatabase, error) {
+	switch database.Type {
+	case config.DatabaseTypeBolt:
+		return newBoltDatabase(database.BoltPath())
+	case config.DatabaseTypeMemory:
+		log.Warn().Msg("Using the in-memory database, all data is lost on shutdown")
+		return newMemoryDatabase(), nil
+	default:
+		return nil, errors.Errorf("unsupported embedded database type '%s'", database.Type)
+	}
+}
+
+func newBoltDatabase(path string) (domain.EmbeddedDatabase, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, errors.Wrapf(err, "failed to create directory of %s", path)
+	}
+
+	// a second process holding the file lock makes Open fail instead of block
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open database %s", path)
+	}
+
+	return &boltDatabase{db: db}, nil
+}
+
+func newMemoryDatabase() domain.EmbeddedDatabase {
+	return &memoryDatabase{collections: map[string]map[uuid.UUID][]byte{}}
+}
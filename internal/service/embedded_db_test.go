@@ -0,0 +1,124 @@
+package service
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"dough-calculator/internal/config"
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/test"
+)
+
+func TestNewEmbeddedDatabase_WithBolt(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data", "dough.db")
+
+	database, err := NewEmbeddedDatabase(config.Database{Type: config.DatabaseTypeBolt, Path: path})
+	require.NoError(t, err)
+
+	assert.NoError(t, database.Put("flour", map[uuid.UUID][]byte{
+		test.FirstId:  []byte("first"),
+		test.SecondId: []byte("second"),
+	}))
+	assert.NoError(t, database.Delete("flour", test.SecondId))
+	assert.NoError(t, database.Delete("missing", test.SecondId))
+	require.NoError(t, database.Close())
+
+	// the documents survive reopening the file
+	database, err = NewEmbeddedDatabase(config.Database{Type: config.DatabaseTypeBolt, Path: path})
+	require.NoError(t, err)
+	defer database.Close()
+
+	documents, err := database.Load("flour")
+	assert.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID][]byte{test.FirstId: []byte("first")}, documents)
+
+	documents, err = database.Load("missing")
+	assert.NoError(t, err)
+	assert.Empty(t, documents)
+}
+
+func TestNewEmbeddedDatabase_WithLockedBoltFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dough.db")
+
+	database, err := NewEmbeddedDatabase(config.Database{Type: config.DatabaseTypeBolt, Path: path})
+	require.NoError(t, err)
+	defer database.Close()
+
+	_, err = NewEmbeddedDatabase(config.Database{Type: config.DatabaseTypeBolt, Path: path})
+
+	assert.ErrorContains(t, err, "failed to open database")
+}
+
+func TestNewEmbeddedDatabase_WithMemory(t *testing.T) {
+	database, err := NewEmbeddedDatabase(config.Database{Type: config.DatabaseTypeMemory})
+	require.NoError(t, err)
+
+	assert.NoError(t, database.Put("flour", map[uuid.UUID][]byte{test.FirstId: []byte("first")}))
+	assert.NoError(t, database.Put("flour", map[uuid.UUID][]byte{test.SecondId: []byte("second")}))
+	assert.NoError(t, database.Delete("flour", test.FirstId))
+
+	documents, err := database.Load("flour")
+	assert.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID][]byte{test.SecondId: []byte("second")}, documents)
+	assert.NoError(t, database.Close())
+}
+
+func TestEmbeddedDatabase_Begin(t *testing.T) {
+	bolt, err := NewEmbeddedDatabase(config.Database{Type: config.DatabaseTypeBolt, Path: filepath.Join(t.TempDir(), "dough.db")})
+	require.NoError(t, err)
+	defer bolt.Close()
+
+	for name, database := range map[string]domain.EmbeddedDatabase{
+		"bolt":   bolt,
+		"memory": newMemoryDatabase(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, database.Put("flour", map[uuid.UUID][]byte{test.FirstId: []byte("first")}))
+
+			transaction, err := database.Begin()
+			require.NoError(t, err)
+			assert.NoError(t, transaction.Put("flour", map[uuid.UUID][]byte{test.SecondId: []byte("second")}))
+			assert.NoError(t, transaction.Delete("flour", test.FirstId))
+			assert.NoError(t, transaction.Rollback())
+
+			documents, err := database.Load("flour")
+			assert.NoError(t, err)
+			assert.Equal(t, map[uuid.UUID][]byte{test.FirstId: []byte("first")}, documents)
+
+			transaction, err = database.Begin()
+			require.NoError(t, err)
+			assert.NoError(t, transaction.Put("flour", map[uuid.UUID][]byte{test.SecondId: []byte("second")}))
+			assert.NoError(t, transaction.Delete("flour", test.FirstId))
+			assert.NoError(t, transaction.Commit())
+
+			documents, err = database.Load("flour")
+			assert.NoError(t, err)
+			assert.Equal(t, map[uuid.UUID][]byte{test.SecondId: []byte("second")}, documents)
+
+			// a write outside waits for the open transaction
+			transaction, err = database.Begin()
+			require.NoError(t, err)
+			written := make(chan error)
+			go func() { written <- database.Put("flour", map[uuid.UUID][]byte{test.ThirdId: []byte("third")}) }()
+			select {
+			case <-written:
+				t.Fatal("put did not wait for the transaction")
+			case <-time.After(50 * time.Millisecond):
+			}
+			assert.NoError(t, transaction.Commit())
+			assert.NoError(t, <-written)
+		})
+	}
+}
+
+func TestNewEmbeddedDatabase_WithUnsupportedType(t *testing.T) {
+	database, err := NewEmbeddedDatabase(config.Database{Type: config.DatabaseTypeMongoDB})
+
+	assert.ErrorContains(t, err, "unsupported embedded database type 'mongodb'")
+	assert.Nil(t, database)
+}
@@ -2,6 +2,9 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
@@ -12,11 +15,54 @@ import (
 	internalErrors "dough-calculator/internal/errors"
 )
 
+// flourSortOptions are the supported sort parameter values of the flour
+// list, newest first by default.
+var flourSortOptions = sortOptions[domain.FlourEntity]{
+	defaultSort: "created",
+	options: map[string]sortOption[domain.FlourEntity]{
+		"created": {
+			field:      "created_at",
+			kind:       sortKindTime,
+			descending: true,
+			key: func(flour domain.FlourEntity) domain.PageKey {
+				if flour.CreatedAt.IsZero() {
+					return domain.PageKey{Id: flour.Id}
+				}
+				return domain.PageKey{Value: flour.CreatedAt, Id: flour.Id}
+			},
+		},
+		"name": {
+			field: "name",
+			kind:  sortKindString,
+			key: func(flour domain.FlourEntity) domain.PageKey {
+				return domain.PageKey{Value: flour.Name, Id: flour.Id}
+			},
+		},
+		"protein": {
+			field: "protein_content",
+			kind:  sortKindNumber,
+			key: func(flour domain.FlourEntity) domain.PageKey {
+				if flour.ProteinContent == 0 {
+					return domain.PageKey{Id: flour.Id}
+				}
+				return domain.PageKey{Value: flour.ProteinContent, Id: flour.Id}
+			},
+		},
+	},
+}
+
 type flourService struct {
-	repository domain.FlourRepository
+	repository     domain.FlourRepository
+	typeRepository domain.FlourTypeRepository
 }
 
 func (service *flourService) Create(ctx context.Context, request domain.CreateFlourRequest) (domain.FlourDto, error) {
+	request.FlourType = normalizeFlourTypeCode(request.FlourType)
+
+	if err := service.validate(ctx, request); err != nil {
+		return domain.FlourDto{}, err
+	}
+
 	createdEntity, err := service.repository.Create(ctx, service.toEntity(request))
 
 	if err != nil {
@@ -47,21 +93,42 @@ func (service *flourService) FindById(ctx context.Context, id uuid.UUID) (domain
 	return flourEntity.ToDto(), nil
 }
 
-func (service *flourService) Find(ctx context.Context, offset, limit int) ([]domain.FlourDto, error) {
-	flourEntities, err := service.repository.Find(ctx, offset, limit)
+func (service *flourService) Find(ctx context.Context, filter domain.FlourFilter, params domain.PageParams) (domain.FlourPageDto, error) {
+	if filter.MinProtein != nil && filter.MaxProtein != nil && *filter.MinProtein > *filter.MaxProtein {
+		return domain.FlourPageDto{}, internalErrors.FlourFilterInvalid(fmt.Sprintf(
+			"min protein %.2f must not be greater than max protein %.2f", *filter.MinProtein, *filter.MaxProtein))
+	}
+
+	pager, err := flourSortOptions.pager(params)
+	if err != nil {
+		return domain.FlourPageDto{}, err
+	}
+
+	var flourTypes []string
+	for _, flourType := range filter.FlourTypes {
+		if code := normalizeFlourTypeCode(flourType); code != "" && !slices.Contains(flourTypes, code) {
+			flourTypes = append(flourTypes, code)
+		}
+	}
+	filter.FlourTypes = flourTypes
+
+	page, err := service.repository.Find(ctx, filter, pager.request)
 	if err != nil {
 		log.Err(err).
 			Msg("failed to find flours")
 
-		return nil, internalErrors.NewInternalServerErrorWrap(err, "failed to find flours")
+		return domain.FlourPageDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to find flours")
 	}
 
-	flours := make([]domain.FlourDto, len(flourEntities))
-	for i, flourEntity := range flourEntities {
+	flours := make([]domain.FlourDto, len(page.Flours))
+	for i, flourEntity := range page.Flours {
 		flours[i] = flourEntity.ToDto()
 	}
 
-	return flours, nil
+	return domain.FlourPageDto{
+		Items:   flours,
+		PageDto: pager.page(page.Flours, page.Page),
+	}, nil
 }
 
 func (service *flourService) SearchByName(ctx context.Context, name string) ([]domain.FlourDto, error) {
@@ -82,20 +149,107 @@ func (service *flourService) SearchByName(ctx context.Context, name string) ([]d
 	return flours, nil
 }
 
+func (service *flourService) TextSearch(ctx context.Context, query string, offset, limit int) (domain.FlourTextSearchResultDto, error) {
+	if err := validateSearchQuery(query); err != nil {
+		return domain.FlourTextSearchResultDto{}, err
+	}
+
+	result, err := service.repository.TextSearch(ctx, query, offset, limit)
+	if err != nil {
+		log.Err(err).
+			Str("query", query).
+			Msg("failed to search flours by text")
+
+		return domain.FlourTextSearchResultDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to search flours by text")
+	}
+
+	terms := searchTerms(query)
+
+	items := make([]domain.FlourTextSearchHitDto, len(result.Hits))
+	for i, hit := range result.Hits {
+		items[i] = domain.FlourTextSearchHitDto{
+			Flour:      hit.Flour.ToDto(),
+			Score:      hit.Score,
+			Highlights: service.highlight(hit.Flour, terms),
+		}
+	}
+
+	return domain.FlourTextSearchResultDto{
+		Items: items,
+		Total: result.Total,
+	}, nil
+}
+
+func (service *flourService) highlight(flour domain.FlourEntity, terms []string) highlights {
+	result := highlights{}
+	result.add("name", flour.Name, terms, 0)
+	result.add("flour_type", flour.FlourType, terms, 0)
+	result.add("description", flour.Description, terms, highlightFragmentSize)
+	return result
+}
+
+// validate checks that the flour refers to a managed flour type and that
+// its percentages are within range.
+func (service *flourService) validate(ctx context.Context, request domain.CreateFlourRequest) error {
+	percentages := []struct {
+		name  string
+		value float64
+	}{
+		{"protein content", request.ProteinContent},
+		{"ash content", request.AshContent},
+		{"extraction rate", request.ExtractionRate},
+		{"suggested absorption", request.SuggestedAbsorption},
+	}
+	for _, percentage := range percentages {
+		if percentage.value < 0 || percentage.value > 100 {
+			return internalErrors.FlourInvalid(
+				fmt.Sprintf("%s %.2f must be between 0 and 100", percentage.name, percentage.value))
+		}
+	}
+
+	if request.FlourType == "" {
+		return internalErrors.FlourInvalid("flour type is required")
+	}
+
+	if _, err := service.typeRepository.GetByCode(ctx, request.FlourType); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return internalErrors.FlourTypeNotFound(fmt.Sprintf("flour type %s not found", request.FlourType))
+		}
+
+		log.Err(err).
+			Str("flour_type", request.FlourType).
+			Msg("failed to find flour type")
+
+		return internalErrors.NewInternalServerErrorWrap(err, "failed to create flour")
+	}
+
+	return nil
+}
+
 func (service *flourService) toEntity(request domain.CreateFlourRequest) domain.FlourEntity {
+	// MongoDB keeps milliseconds only, so the created flour matches the stored one.
 	return domain.FlourEntity{
-		Id:             uuid.New(),
-		FlourType:      request.FlourType,
-		Name:           request.Name,
-		Description:    request.Description,
-		NutritionFacts: request.NutritionFacts.ToEntity(),
+		Id:                  uuid.New(),
+		FlourType:           request.FlourType,
+		Name:                request.Name,
+		Description:         request.Description,
+		NutritionFacts:      request.NutritionFacts.ToEntity(),
+		ProteinContent:      request.ProteinContent,
+		AshContent:          request.AshContent,
+		ExtractionRate:      request.ExtractionRate,
+		SuggestedAbsorption: request.SuggestedAbsorption,
+		CreatedAt:           time.Now().UTC().Truncate(time.Millisecond),
 	}
 }
 
-func NewFlourService(repository domain.FlourRepository) (domain.FlourService, error) {
+func NewFlourService(repository domain.FlourRepository, typeRepository domain.FlourTypeRepository) (domain.FlourService, error) {
 	if repository == nil {
 		return nil, errors.New("repository is nil")
 	}
 
-	return &flourService{repository: repository}, nil
+	if typeRepository == nil {
+		return nil, errors.New("type repository is nil")
+	}
+
+	return &flourService{repository: repository, typeRepository: typeRepository}, nil
 }
@@ -0,0 +1,405 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+)
+
+// flourCsvRow is a parsed CSV row, Id is set when the row names the flour to
+// replace.
+type flourCsvRow struct {
+	Id      *uuid.UUID
+	Request domain.CreateFlourRequest
+}
+
+// flourCsvColumn maps a CSV column to a field of the flour. Nested fields use
+// the dotted JSON path as column name.
+type flourCsvColumn struct {
+	name  string
+	value func(flour domain.FlourDto) string
+	set   func(row *flourCsvRow, value string) error
+}
+
+var flourCsvColumns = []flourCsvColumn{
+	{
+		name:  "id",
+		value: func(flour domain.FlourDto) string { return flour.Id.String() },
+		set: func(row *flourCsvRow, value string) error {
+			if value == "" {
+				return nil
+			}
+			id, err := uuid.Parse(value)
+			if err != nil {
+				return errors.Errorf("%s is not a valid id", value)
+			}
+			row.Id = &id
+			return nil
+		},
+	},
+	flourCsvString("flour_type",
+		func(flour domain.FlourDto) string { return flour.FlourType },
+		func(request *domain.CreateFlourRequest) *string { return &request.FlourType }),
+	flourCsvString("name",
+		func(flour domain.FlourDto) string { return flour.Name },
+		func(request *domain.CreateFlourRequest) *string { return &request.Name }),
+	flourCsvString("description",
+		func(flour domain.FlourDto) string { return flour.Description },
+		func(request *domain.CreateFlourRequest) *string { return &request.Description }),
+	{
+		name:  "nutrition_facts.calories",
+		value: func(flour domain.FlourDto) string { return strconv.Itoa(flour.NutritionFacts.Calories) },
+		set: func(row *flourCsvRow, value string) error {
+			if value == "" {
+				return nil
+			}
+			calories, err := strconv.Atoi(value)
+			if err != nil {
+				return errors.Errorf("%s is not a whole number", value)
+			}
+			row.Request.NutritionFacts.Calories = calories
+			return nil
+		},
+	},
+	flourCsvNumber("nutrition_facts.fat",
+		func(flour domain.FlourDto) float64 { return flour.NutritionFacts.Fat },
+		func(request *domain.CreateFlourRequest) *float64 { return &request.NutritionFacts.Fat }),
+	flourCsvNumber("nutrition_facts.carbs",
+		func(flour domain.FlourDto) float64 { return flour.NutritionFacts.Carbs },
+		func(request *domain.CreateFlourRequest) *float64 { return &request.NutritionFacts.Carbs }),
+	flourCsvNumber("nutrition_facts.protein",
+		func(flour domain.FlourDto) float64 { return flour.NutritionFacts.Protein },
+		func(request *domain.CreateFlourRequest) *float64 { return &request.NutritionFacts.Protein }),
+	flourCsvNumber("nutrition_facts.fiber",
+		func(flour domain.FlourDto) float64 { return flour.NutritionFacts.Fiber },
+		func(request *domain.CreateFlourRequest) *float64 { return &request.NutritionFacts.Fiber }),
+	flourCsvNumber("protein_content",
+		func(flour domain.FlourDto) float64 { return flour.ProteinContent },
+		func(request *domain.CreateFlourRequest) *float64 { return &request.ProteinContent }),
+	flourCsvNumber("ash_content",
+		func(flour domain.FlourDto) float64 { return flour.AshContent },
+		func(request *domain.CreateFlourRequest) *float64 { return &request.AshContent }),
+	flourCsvNumber("extraction_rate",
+		func(flour domain.FlourDto) float64 { return flour.ExtractionRate },
+		func(request *domain.CreateFlourRequest) *float64 { return &request.ExtractionRate }),
+	flourCsvNumber("suggested_absorption",
+		func(flour domain.FlourDto) float64 { return flour.SuggestedAbsorption },
+		func(request *domain.CreateFlourRequest) *float64 { return &request.SuggestedAbsorption }),
+}
+
+func flourCsvString(
+	name string,
+	value func(flour domain.FlourDto) string,
+	field func(request *domain.CreateFlourRequest) *string,
+) flourCsvColumn {
+	return flourCsvColumn{
+		name:  name,
+		value: value,
+		set: func(row *flourCsvRow, value string) error {
+			*field(&row.Request) = value
+			return nil
+		},
+	}
+}
+
+func flourCsvNumber(
+	name string,
+	value func(flour domain.FlourDto) float64,
+	field func(request *domain.CreateFlourRequest) *float64,
+) flourCsvColumn {
+	return flourCsvColumn{
+		name:  name,
+		value: func(flour domain.FlourDto) string { return strconv.FormatFloat(value(flour), 'f', -1, 64) },
+		set: func(row *flourCsvRow, value string) error {
+			if value == "" {
+				return nil
+			}
+			number, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return errors.Errorf("%s is not a number", value)
+			}
+			*field(&row.Request) = number
+			return nil
+		},
+	}
+}
+
+func (service *flourService) ExportCsv(ctx context.Context, writer io.Writer) error {
+	flours, err := service.repository.FindAll(ctx)
+	if err != nil {
+		log.Err(err).
+			Msg("failed to find flours to export")
+
+		return internalErrors.NewInternalServerErrorWrap(err, "failed to export flours")
+	}
+
+	csvWriter := csv.NewWriter(writer)
+
+	header := make([]string, len(flourCsvColumns))
+	for i, column := range flourCsvColumns {
+		header[i] = column.name
+	}
+	if err := csvWriter.Write(header); err != nil {
+		return errors.Wrap(err, "failed to write csv header")
+	}
+
+	record := make([]string, len(flourCsvColumns))
+	for _, flour := range flours {
+		dto := flour.ToDto()
+		for i, column := range flourCsvColumns {
+			record[i] = column.value(dto)
+		}
+		if err := csvWriter.Write(record); err != nil {
+			return errors.Wrap(err, "failed to write csv record")
+		}
+	}
+
+	csvWriter.Flush()
+	if err := csvWriter.Error(); err != nil {
+		return errors.Wrap(err, "failed to write csv")
+	}
+
+	return nil
+}
+
+// ImportCsv creates or replaces a flour for every CSV row. Rows are matched to
+// the catalogue by id when the id column is set and by name otherwise.
+// Invalid rows are reported and skipped, the other rows are still imported.
+func (service *flourService) ImportCsv(ctx context.Context, reader io.Reader, dryRun bool) (domain.FlourImportResultDto, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+
+	columns, err := readFlourCsvHeader(csvReader)
+	if err != nil {
+		return domain.FlourImportResultDto{}, err
+	}
+
+	catalogue, err := service.flourCatalogue(ctx)
+	if err != nil {
+		return domain.FlourImportResultDto{}, err
+	}
+
+	result := domain.FlourImportResultDto{DryRun: dryRun, Rows: []domain.FlourImportRowDto{}}
+
+	for {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil && !errors.Is(err, csv.ErrFieldCount) {
+			return domain.FlourImportResultDto{}, internalErrors.FlourImportInvalid(err.Error())
+		}
+		line, _ := csvReader.FieldPos(0)
+
+		row := domain.FlourImportRowDto{Row: line}
+		if err != nil {
+			row.Errors = []string{fmt.Sprintf("expected %d columns, got %d", len(columns), len(record))}
+		} else if row, err = service.importCsvRow(ctx, row, columns, record, dryRun, catalogue); err != nil {
+			return domain.FlourImportResultDto{}, err
+		}
+
+		if len(row.Errors) > 0 {
+			row.Action = domain.FlourImportActionFailed
+		}
+		switch row.Action {
+		case domain.FlourImportActionCreated:
+			result.Created++
+		case domain.FlourImportActionUpdated:
+			result.Updated++
+		default:
+			result.Failed++
+		}
+		result.Rows = append(result.Rows, row)
+	}
+
+	return result, nil
+}
+
+func readFlourCsvHeader(csvReader *csv.Reader) ([]flourCsvColumn, error) {
+	header, err := csvReader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, internalErrors.FlourImportInvalid("csv file is empty")
+	}
+	if err != nil {
+		return nil, internalErrors.FlourImportInvalid(err.Error())
+	}
+
+	columns := make([]flourCsvColumn, len(header))
+	seen := map[string]bool{}
+	for i, name := range header {
+		// spreadsheets often prefix the file with a byte order mark
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+
+		found := false
+		for _, column := range flourCsvColumns {
+			if column.name == name {
+				columns[i], found = column, true
+				break
+			}
+		}
+		if !found {
+			return nil, internalErrors.FlourImportInvalid(fmt.Sprintf("unknown column %s", name))
+		}
+		if seen[name] {
+			return nil, internalErrors.FlourImportInvalid(fmt.Sprintf("column %s is repeated", name))
+		}
+		seen[name] = true
+	}
+
+	if !seen["name"] {
+		return nil, internalErrors.FlourImportInvalid("column name is required")
+	}
+
+	return columns, nil
+}
+
+func (service *flourService) importCsvRow(
+	ctx context.Context,
+	row domain.FlourImportRowDto,
+	columns []flourCsvColumn,
+	record []string,
+	dryRun bool,
+	catalogue map[string]domain.FlourEntity,
+) (domain.FlourImportRowDto, error) {
+	var csvRow flourCsvRow
+	for i, column := range columns {
+		if err := column.set(&csvRow, strings.TrimSpace(record[i])); err != nil {
+			row.Errors = append(row.Errors, fmt.Sprintf("%s: %s", column.name, err.Error()))
+		}
+	}
+
+	request := csvRow.Request
+	request.FlourType = normalizeFlourTypeCode(request.FlourType)
+	row.Name = request.Name
+
+	if request.Name == "" {
+		row.Errors = append(row.Errors, "name is required")
+	}
+	if err := service.validate(ctx, request); err != nil {
+		var serviceError *internalErrors.ServiceError
+		if !errors.As(err, &serviceError) || serviceError.ResponseCode >= 500 {
+			return row, err
+		}
+		row.Errors = append(row.Errors, serviceError.Details)
+	}
+	if len(row.Errors) > 0 {
+		return row, nil
+	}
+
+	existing, err := service.findImported(ctx, csvRow.Id, request.Name, catalogue)
+	if err != nil {
+		var serviceError *internalErrors.ServiceError
+		if !errors.As(err, &serviceError) || serviceError.ResponseCode >= 500 {
+			return row, err
+		}
+		row.Errors = append(row.Errors, serviceError.Details)
+		return row, nil
+	}
+
+	entity := service.toEntity(request)
+	row.Action = domain.FlourImportActionCreated
+	if csvRow.Id != nil {
+		entity.Id = *csvRow.Id
+	}
+	if existing != nil {
+		entity.Id, entity.CreatedAt = existing.Id, existing.CreatedAt
+		row.Action = domain.FlourImportActionUpdated
+	}
+	row.FlourId = &entity.Id
+	if existing != nil {
+		delete(catalogue, existing.Name)
+	}
+	catalogue[request.Name] = entity
+
+	if dryRun {
+		return row, nil
+	}
+
+	if existing != nil {
+		_, err = service.repository.Update(ctx, entity)
+	} else {
+		_, err = service.repository.Create(ctx, entity)
+	}
+	if err != nil {
+		log.Err(err).
+			Int("row", row.Row).
+			Str("name", request.Name).
+			Msg("failed to import flour")
+
+		return row, internalErrors.NewInternalServerErrorWrap(err, "failed to import flour")
+	}
+
+	return row, nil
+}
+
+// flourCatalogue returns the flours by their name, so an import matches its
+// rows by name without a query per row. Names match exactly like the unique
+// index of the flour names, which is case-sensitive.
+func (service *flourService) flourCatalogue(ctx context.Context) (map[string]domain.FlourEntity, error) {
+	flours, err := service.repository.FindAll(ctx)
+	if err != nil {
+		log.Err(err).
+			Msg("failed to find flours")
+
+		return nil, internalErrors.NewInternalServerErrorWrap(err, "failed to find flours")
+	}
+
+	catalogue := make(map[string]domain.FlourEntity, len(flours))
+	for _, flour := range flours {
+		catalogue[flour.Name] = flour
+	}
+
+	return catalogue, nil
+}
+
+// findImported returns the flour an imported row replaces, nil when the row
+// is a new flour. A row naming both an id and the name of another flour is
+// rejected, names are unique. The catalogue holds the flours by name,
+// including the ones of earlier rows, which a dry run does not store.
+func (service *flourService) findImported(
+	ctx context.Context,
+	id *uuid.UUID,
+	name string,
+	catalogue map[string]domain.FlourEntity,
+) (*domain.FlourEntity, error) {
+	byName := catalogue[name]
+
+	if id == nil {
+		if byName.Id == uuid.Nil {
+			return nil, nil
+		}
+		return &byName, nil
+	}
+
+	if byName.Id != uuid.Nil && byName.Id != *id {
+		return nil, internalErrors.FlourInvalid(fmt.Sprintf("name %s is already used by flour %s", name, byName.Id.String()))
+	}
+
+	byId, err := service.repository.FindById(ctx, *id)
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		if byName.Id == *id {
+			return &byName, nil
+		}
+		return nil, nil
+	case err != nil:
+		log.Err(err).
+			Str("id", id.String()).
+			Msg("failed to find flour by id")
+
+		return nil, internalErrors.NewInternalServerErrorWrap(err, "failed to find flour by id")
+	}
+
+	return &byId, nil
+}
@@ -0,0 +1,375 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+const flourCsvHeader = "id,flour_type,name,description,nutrition_facts.calories,nutrition_facts.fat," +
+	"nutrition_facts.carbs,nutrition_facts.protein,nutrition_facts.fiber,protein_content,ash_content," +
+	"extraction_rate,suggested_absorption\n"
+
+func TestFlourCsvServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(FlourCsvServiceTestSuite))
+}
+
+type FlourCsvServiceTestSuite struct {
+	test.GoMockTestSuite
+
+	ctx            context.Context
+	repository     *mocks.MockFlourRepository
+	typeRepository *mocks.MockFlourTypeRepository
+
+	target domain.FlourService
+}
+
+func (suite *FlourCsvServiceTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.ctx = context.Background()
+	suite.repository = mocks.NewMockFlourRepository(suite.MockCtrl)
+	suite.typeRepository = mocks.NewMockFlourTypeRepository(suite.MockCtrl)
+
+	suite.target = test.Must(func() (domain.FlourService, error) {
+		return NewFlourService(suite.repository, suite.typeRepository)
+	})
+}
+
+func (suite *FlourCsvServiceTestSuite) TestExportCsv() {
+	suite.repository.EXPECT().FindAll(suite.ctx).
+		Return([]domain.FlourEntity{
+			{
+				Id:             test.FirstId,
+				FlourType:      "bread",
+				Name:           "Bread flour",
+				Description:    "Strong, white",
+				NutritionFacts: domain.NutritionFacts{Calories: 364, Fat: 1.5, Carbs: 72, Protein: 12.5, Fiber: 3},
+				ProteinContent: 12.5,
+				AshContent:     0.55,
+				ExtractionRate: 75,
+				CreatedAt:      test.Date,
+			},
+			{Id: test.SecondId, FlourType: "rye", Name: "Rye flour"},
+		}, nil)
+
+	var actual bytes.Buffer
+	err := suite.target.ExportCsv(suite.ctx, &actual)
+
+	suite.NoError(err)
+	suite.Equal(flourCsvHeader+
+		test.FirstId.String()+`,bread,Bread flour,"Strong, white",364,1.5,72,12.5,3,12.5,0.55,75,0`+"\n"+
+		test.SecondId.String()+",rye,Rye flour,,0,0,0,0,0,0,0,0,0\n",
+		actual.String())
+}
+
+func (suite *FlourCsvServiceTestSuite) TestExportCsv_WithError() {
+	suite.repository.EXPECT().FindAll(suite.ctx).
+		Return(nil, assert.AnError)
+
+	err := suite.target.ExportCsv(suite.ctx, &bytes.Buffer{})
+
+	suite.ErrorContains(err, "failed to export flours")
+}
+
+func (suite *FlourCsvServiceTestSuite) TestImportCsv() {
+	existing := domain.FlourEntity{Id: test.SecondId, FlourType: "rye", Name: "Rye flour", CreatedAt: test.Date}
+
+	suite.typeRepository.EXPECT().GetByCode(suite.ctx, gomock.Any()).
+		Return(domain.FlourTypeEntity{}, nil).AnyTimes()
+	suite.repository.EXPECT().FindAll(suite.ctx).
+		Return([]domain.FlourEntity{existing}, nil)
+
+	var created, updated domain.FlourEntity
+	suite.repository.EXPECT().Create(suite.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entity domain.FlourEntity) (domain.FlourEntity, error) {
+			created = entity
+			return entity, nil
+		})
+	suite.repository.EXPECT().Update(suite.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entity domain.FlourEntity) (domain.FlourEntity, error) {
+			updated = entity
+			return entity, nil
+		})
+
+	csv := "\ufeffName, Flour_Type ,nutrition_facts.calories,nutrition_facts.protein,protein_content\n" +
+		"Bread flour,Bread,364,12.5,12.5\n" +
+		"Rye flour,rye,,,9\n"
+
+	actual, err := suite.target.ImportCsv(suite.ctx, strings.NewReader(csv), false)
+
+	suite.NoError(err)
+	suite.Equal(domain.FlourImportResultDto{
+		Created: 1,
+		Updated: 1,
+		Rows: []domain.FlourImportRowDto{
+			{Row: 2, Name: "Bread flour", Action: domain.FlourImportActionCreated, FlourId: &created.Id},
+			{Row: 3, Name: "Rye flour", Action: domain.FlourImportActionUpdated, FlourId: &existing.Id},
+		},
+	}, actual)
+
+	suite.Equal("bread", created.FlourType)
+	suite.Equal(domain.NutritionFacts{Calories: 364, Protein: 12.5}, created.NutritionFacts)
+	suite.Equal(12.5, created.ProteinContent)
+
+	suite.Equal(existing.Id, updated.Id)
+	suite.Equal(existing.CreatedAt, updated.CreatedAt)
+	suite.Equal("Rye flour", updated.Name)
+	suite.Equal(9.0, updated.ProteinContent)
+}
+
+func (suite *FlourCsvServiceTestSuite) TestImportCsv_ById() {
+	existing := domain.FlourEntity{Id: test.FirstId, FlourType: "bread", Name: "Bread flour", CreatedAt: test.Date}
+
+	suite.typeRepository.EXPECT().GetByCode(suite.ctx, "bread").
+		Return(domain.FlourTypeEntity{}, nil).Times(3)
+	suite.repository.EXPECT().FindAll(suite.ctx).
+		Return([]domain.FlourEntity{existing}, nil)
+	suite.repository.EXPECT().FindById(suite.ctx, test.FirstId).
+		Return(existing, nil)
+	suite.repository.EXPECT().FindById(suite.ctx, test.SecondId).
+		Return(domain.FlourEntity{}, mongo.ErrNoDocuments)
+
+	csv := "id,name,flour_type\n" +
+		test.FirstId.String() + ",Strong bread flour,bread\n" +
+		test.SecondId.String() + ",Baguette flour,bread\n" +
+		test.ThirdId.String() + ",Strong bread flour,bread\n"
+
+	actual, err := suite.target.ImportCsv(suite.ctx, strings.NewReader(csv), true)
+
+	suite.NoError(err)
+	suite.Equal(domain.FlourImportResultDto{
+		DryRun:  true,
+		Created: 1,
+		Updated: 1,
+		Failed:  1,
+		Rows: []domain.FlourImportRowDto{
+			{Row: 2, Name: "Strong bread flour", Action: domain.FlourImportActionUpdated, FlourId: &test.FirstId},
+			{Row: 3, Name: "Baguette flour", Action: domain.FlourImportActionCreated, FlourId: &test.SecondId},
+			{
+				Row:    4,
+				Name:   "Strong bread flour",
+				Action: domain.FlourImportActionFailed,
+				Errors: []string{"name Strong bread flour is already used by flour " + test.FirstId.String()},
+			},
+		},
+	}, actual)
+}
+
+func (suite *FlourCsvServiceTestSuite) TestImportCsv_WithNameInOtherCase() {
+	existing := domain.FlourEntity{Id: test.FirstId, FlourType: "rye", Name: "Rye flour", CreatedAt: test.Date}
+
+	suite.typeRepository.EXPECT().GetByCode(suite.ctx, "rye").
+		Return(domain.FlourTypeEntity{}, nil)
+	suite.repository.EXPECT().FindAll(suite.ctx).
+		Return([]domain.FlourEntity{existing}, nil)
+
+	csv := "name,flour_type\nrye flour,rye\n"
+
+	actual, err := suite.target.ImportCsv(suite.ctx, strings.NewReader(csv), true)
+
+	suite.NoError(err)
+	suite.Equal(1, actual.Created)
+	suite.Zero(actual.Updated)
+	suite.NotEqual(existing.Id, *actual.Rows[0].FlourId)
+}
+
+func (suite *FlourCsvServiceTestSuite) TestImportCsv_WithDryRunAndRepeatedName() {
+	suite.typeRepository.EXPECT().GetByCode(suite.ctx, "rye").
+		Return(domain.FlourTypeEntity{}, nil).Times(2)
+	suite.repository.EXPECT().FindAll(suite.ctx).
+		Return([]domain.FlourEntity{}, nil)
+
+	csv := "name,flour_type\nRye flour,rye\nRye flour,rye\n"
+
+	actual, err := suite.target.ImportCsv(suite.ctx, strings.NewReader(csv), true)
+
+	suite.NoError(err)
+	suite.Equal(1, actual.Created)
+	suite.Equal(1, actual.Updated)
+	suite.Equal(actual.Rows[0].FlourId, actual.Rows[1].FlourId)
+}
+
+func (suite *FlourCsvServiceTestSuite) TestImportCsv_WithInvalidRows() {
+	suite.typeRepository.EXPECT().GetByCode(suite.ctx, "bread").
+		Return(domain.FlourTypeEntity{}, nil).AnyTimes()
+	suite.typeRepository.EXPECT().GetByCode(suite.ctx, "unknown").
+		Return(domain.FlourTypeEntity{}, mongo.ErrNoDocuments)
+	suite.repository.EXPECT().FindAll(suite.ctx).
+		Return([]domain.FlourEntity{}, nil)
+
+	csv := "id,name,flour_type,nutrition_facts.calories,protein_content\n" +
+		"not-an-id,Bread flour,bread,1.5,abc\n" +
+		",,bread,,\n" +
+		",Mystery flour,unknown,,\n" +
+		",Too short\n" +
+		",Too strong,bread,,120\n"
+
+	actual, err := suite.target.ImportCsv(suite.ctx, strings.NewReader(csv), false)
+
+	suite.NoError(err)
+	suite.Equal(domain.FlourImportResultDto{
+		Failed: 5,
+		Rows: []domain.FlourImportRowDto{
+			{
+				Row:    2,
+				Name:   "Bread flour",
+				Action: domain.FlourImportActionFailed,
+				Errors: []string{
+					"id: not-an-id is not a valid id",
+					"nutrition_facts.calories: 1.5 is not a whole number",
+					"protein_content: abc is not a number",
+				},
+			},
+			{Row: 3, Action: domain.FlourImportActionFailed, Errors: []string{"name is required"}},
+			{Row: 4, Name: "Mystery flour", Action: domain.FlourImportActionFailed, Errors: []string{"flour type unknown not found"}},
+			{Row: 5, Action: domain.FlourImportActionFailed, Errors: []string{"expected 5 columns, got 2"}},
+			{
+				Row:    6,
+				Name:   "Too strong",
+				Action: domain.FlourImportActionFailed,
+				Errors: []string{"protein content 120.00 must be between 0 and 100"},
+			},
+		},
+	}, actual)
+}
+
+func (suite *FlourCsvServiceTestSuite) TestImportCsv_WithInvalidFile() {
+	tests := []struct {
+		name          string
+		csv           string
+		expectedError error
+	}{
+		{
+			name:          "empty",
+			csv:           "",
+			expectedError: internalErrors.FlourImportInvalid("csv file is empty"),
+		},
+		{
+			name:          "unknown column",
+			csv:           "name,colour\n",
+			expectedError: internalErrors.FlourImportInvalid("unknown column colour"),
+		},
+		{
+			name:          "repeated column",
+			csv:           "name,Name\n",
+			expectedError: internalErrors.FlourImportInvalid("column name is repeated"),
+		},
+		{
+			name:          "missing name column",
+			csv:           "flour_type\n",
+			expectedError: internalErrors.FlourImportInvalid("column name is required"),
+		},
+		{
+			name:          "malformed quotes",
+			csv:           "name\n\"Rye\"flour\"\n",
+			expectedError: internalErrors.FlourImportInvalid(`parse error on line 2, column 5: extraneous or missing " in quoted-field`),
+		},
+	}
+
+	// a file with a valid header loads the catalogue before its rows fail
+	suite.repository.EXPECT().FindAll(suite.ctx).
+		Return([]domain.FlourEntity{}, nil).AnyTimes()
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			actual, err := suite.target.ImportCsv(suite.ctx, strings.NewReader(tt.csv), false)
+
+			suite.Equal(tt.expectedError, err)
+			suite.Equal(domain.FlourImportResultDto{}, actual)
+		})
+	}
+}
+
+func (suite *FlourCsvServiceTestSuite) TestImportCsv_WithRepositoryError() {
+	tests := []struct {
+		name             string
+		csv              string
+		mock             func()
+		expectedErrorMsg string
+	}{
+		{
+			name: "flour type lookup",
+			csv:  "name,flour_type\nRye flour,rye\n",
+			mock: func() {
+				suite.repository.EXPECT().FindAll(suite.ctx).
+					Return([]domain.FlourEntity{}, nil)
+				suite.typeRepository.EXPECT().GetByCode(suite.ctx, "rye").
+					Return(domain.FlourTypeEntity{}, assert.AnError)
+			},
+			expectedErrorMsg: "failed to create flour",
+		},
+		{
+			name: "catalogue",
+			csv:  "name,flour_type\nRye flour,rye\n",
+			mock: func() {
+				suite.repository.EXPECT().FindAll(suite.ctx).
+					Return(nil, assert.AnError)
+			},
+			expectedErrorMsg: "failed to find flours",
+		},
+		{
+			name: "id lookup",
+			csv:  "id,name,flour_type\n" + test.FirstId.String() + ",Rye flour,rye\n",
+			mock: func() {
+				suite.typeRepository.EXPECT().GetByCode(suite.ctx, "rye").
+					Return(domain.FlourTypeEntity{}, nil)
+				suite.repository.EXPECT().FindAll(suite.ctx).
+					Return([]domain.FlourEntity{}, nil)
+				suite.repository.EXPECT().FindById(suite.ctx, test.FirstId).
+					Return(domain.FlourEntity{}, assert.AnError)
+			},
+			expectedErrorMsg: "failed to find flour by id",
+		},
+		{
+			name: "create",
+			csv:  "name,flour_type\nRye flour,rye\n",
+			mock: func() {
+				suite.typeRepository.EXPECT().GetByCode(suite.ctx, "rye").
+					Return(domain.FlourTypeEntity{}, nil)
+				suite.repository.EXPECT().FindAll(suite.ctx).
+					Return([]domain.FlourEntity{}, nil)
+				suite.repository.EXPECT().Create(suite.ctx, gomock.Any()).
+					Return(domain.FlourEntity{}, assert.AnError)
+			},
+			expectedErrorMsg: "failed to import flour",
+		},
+		{
+			name: "update",
+			csv:  "name,flour_type\nRye flour,rye\n",
+			mock: func() {
+				suite.typeRepository.EXPECT().GetByCode(suite.ctx, "rye").
+					Return(domain.FlourTypeEntity{}, nil)
+				suite.repository.EXPECT().FindAll(suite.ctx).
+					Return([]domain.FlourEntity{{Id: uuid.New(), Name: "Rye flour", CreatedAt: time.Now()}}, nil)
+				suite.repository.EXPECT().Update(suite.ctx, gomock.Any()).
+					Return(domain.FlourEntity{}, assert.AnError)
+			},
+			expectedErrorMsg: "failed to import flour",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mock()
+
+			actual, err := suite.target.ImportCsv(suite.ctx, strings.NewReader(tt.csv), false)
+
+			suite.ErrorContains(err, tt.expectedErrorMsg)
+			suite.Equal(domain.FlourImportResultDto{}, actual)
+		})
+	}
+}
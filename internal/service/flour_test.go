@@ -22,8 +22,9 @@ func TestFlourServiceTestSuite(t *testing.T) {
 type FlourServiceTestSuite struct {
 	test.GoMockTestSuite
 
-	ctx        context.Context
-	repository *mocks.MockFlourRepository
+	ctx            context.Context
+	repository     *mocks.MockFlourRepository
+	typeRepository *mocks.MockFlourTypeRepository
 
 	target domain.FlourService
 }
@@ -33,15 +34,19 @@ func (suite *FlourServiceTestSuite) SetupTest() {
 
 	suite.ctx = context.Background()
 	suite.repository = mocks.NewMockFlourRepository(suite.MockCtrl)
+	suite.typeRepository = mocks.NewMockFlourTypeRepository(suite.MockCtrl)
 
 	suite.target = test.Must(func() (domain.FlourService, error) {
-		return NewFlourService(suite.repository)
+		return NewFlourService(suite.repository, suite.typeRepository)
 	})
 }
 
 func (suite *FlourServiceTestSuite) TestCreate() {
 	createRequest := suite.createRequest()
 
+	suite.typeRepository.EXPECT().GetByCode(suite.ctx, "whole_wheat").
+		Return(domain.FlourTypeEntity{Code: "whole_wheat"}, nil)
+
 	var savedEntity domain.FlourEntity
 	suite.repository.EXPECT().Create(suite.ctx, gomock.Any()).
 		DoAndReturn(func(ctx context.Context, entity domain.FlourEntity) (domain.FlourEntity, error) {
@@ -53,11 +58,84 @@ func (suite *FlourServiceTestSuite) TestCreate() {
 
 	suite.NoError(err)
 	suite.Equal(savedEntity.ToDto(), actualDto)
+	suite.Equal("whole_wheat", savedEntity.FlourType)
+	suite.Equal(13.5, savedEntity.ProteinContent)
+	suite.Equal(1.5, savedEntity.AshContent)
+	suite.Equal(100.0, savedEntity.ExtractionRate)
+	suite.Equal(78.0, savedEntity.SuggestedAbsorption)
+}
+
+func (suite *FlourServiceTestSuite) TestCreate_WithInvalidRequest() {
+	tests := []struct {
+		name          string
+		modify        func(request *domain.CreateFlourRequest)
+		typeError     error
+		checksType    bool
+		expectedError error
+	}{
+		{
+			name:          "negative protein content",
+			modify:        func(request *domain.CreateFlourRequest) { request.ProteinContent = -1 },
+			expectedError: internalErrors.FlourInvalid("protein content -1.00 must be between 0 and 100"),
+		},
+		{
+			name:          "ash content above 100",
+			modify:        func(request *domain.CreateFlourRequest) { request.AshContent = 101 },
+			expectedError: internalErrors.FlourInvalid("ash content 101.00 must be between 0 and 100"),
+		},
+		{
+			name:          "extraction rate above 100",
+			modify:        func(request *domain.CreateFlourRequest) { request.ExtractionRate = 120 },
+			expectedError: internalErrors.FlourInvalid("extraction rate 120.00 must be between 0 and 100"),
+		},
+		{
+			name:          "negative suggested absorption",
+			modify:        func(request *domain.CreateFlourRequest) { request.SuggestedAbsorption = -5 },
+			expectedError: internalErrors.FlourInvalid("suggested absorption -5.00 must be between 0 and 100"),
+		},
+		{
+			name:          "missing flour type",
+			modify:        func(request *domain.CreateFlourRequest) { request.FlourType = " " },
+			expectedError: internalErrors.FlourInvalid("flour type is required"),
+		},
+		{
+			name:          "unknown flour type",
+			modify:        func(request *domain.CreateFlourRequest) {},
+			typeError:     mongo.ErrNoDocuments,
+			checksType:    true,
+			expectedError: internalErrors.FlourTypeNotFound("flour type whole_wheat not found"),
+		},
+		{
+			name:          "error on flour type lookup",
+			modify:        func(request *domain.CreateFlourRequest) {},
+			typeError:     assert.AnError,
+			checksType:    true,
+			expectedError: internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to create flour"),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			request := suite.createRequest()
+			tt.modify(&request)
+
+			if tt.checksType {
+				suite.typeRepository.EXPECT().GetByCode(suite.ctx, "whole_wheat").
+					Return(domain.FlourTypeEntity{}, tt.typeError)
+			}
+
+			_, err := suite.target.Create(suite.ctx, request)
+
+			suite.Equal(tt.expectedError, err)
+		})
+	}
 }
 
 func (suite *FlourServiceTestSuite) TestCreate_WithError() {
 	createRequest := suite.createRequest()
 
+	suite.typeRepository.EXPECT().GetByCode(suite.ctx, "whole_wheat").
+		Return(domain.FlourTypeEntity{Code: "whole_wheat"}, nil)
 	suite.repository.EXPECT().Create(suite.ctx, gomock.Any()).
 		Return(domain.FlourEntity{}, assert.AnError)
 
@@ -68,7 +146,7 @@ func (suite *FlourServiceTestSuite) TestCreate_WithError() {
 
 func (suite *FlourServiceTestSuite) createRequest() domain.CreateFlourRequest {
 	return domain.CreateFlourRequest{
-		FlourType:   "Test FlourDto",
+		FlourType:   "Whole Wheat",
 		Name:        "Test Name",
 		Description: "Test Description",
 		NutritionFacts: domain.NutritionFactsDto{
@@ -78,6 +156,10 @@ func (suite *FlourServiceTestSuite) createRequest() domain.CreateFlourRequest {
 			Protein:  1,
 			Fiber:    1,
 		},
+		ProteinContent:      13.5,
+		AshContent:          1.5,
+		ExtractionRate:      100,
+		SuggestedAbsorption: 78,
 	}
 }
 
@@ -129,20 +211,58 @@ func (suite *FlourServiceTestSuite) TestFindById_WithError() {
 func (suite *FlourServiceTestSuite) TestFind() {
 	entity := suite.createEntity()
 
-	suite.repository.EXPECT().Find(suite.ctx, 0, 10).
-		Return([]domain.FlourEntity{entity}, nil)
+	suite.repository.EXPECT().
+		Find(suite.ctx, domain.FlourFilter{}, domain.PageRequest{SortField: "created_at", Descending: true, Limit: 10}).
+		Return(domain.FlourPage{
+			Flours: []domain.FlourEntity{entity},
+			Page:   domain.PageInfo{Total: 1},
+		}, nil)
 
-	actualDto, err := suite.target.Find(suite.ctx, 0, 10)
+	actualDto, err := suite.target.Find(suite.ctx, domain.FlourFilter{}, domain.PageParams{Limit: 10})
+
+	suite.NoError(err)
+	suite.Equal(domain.FlourPageDto{
+		Items:   []domain.FlourDto{entity.ToDto()},
+		PageDto: domain.PageDto{Total: 1},
+	}, actualDto)
+}
+
+func (suite *FlourServiceTestSuite) TestFind_WithFilter() {
+	minProtein := 11.0
+	maxProtein := 14.0
+
+	suite.repository.EXPECT().
+		Find(suite.ctx, domain.FlourFilter{
+			FlourTypes: []string{"whole_wheat", "rye"},
+			MinProtein: &minProtein,
+			MaxProtein: &maxProtein,
+		}, domain.PageRequest{SortField: "protein_content", Limit: 10}).
+		Return(domain.FlourPage{}, nil)
+
+	_, err := suite.target.Find(suite.ctx, domain.FlourFilter{
+		FlourTypes: []string{"Whole Wheat", "rye", "whole-wheat", " "},
+		MinProtein: &minProtein,
+		MaxProtein: &maxProtein,
+	}, domain.PageParams{Sort: "protein", Limit: 10})
 
 	suite.NoError(err)
-	suite.Equal([]domain.FlourDto{entity.ToDto()}, actualDto)
+}
+
+func (suite *FlourServiceTestSuite) TestFind_WithInvalidProteinRange() {
+	minProtein := 14.0
+	maxProtein := 11.0
+
+	_, err := suite.target.Find(suite.ctx, domain.FlourFilter{MinProtein: &minProtein, MaxProtein: &maxProtein},
+		domain.PageParams{Limit: 10})
+
+	suite.Equal(internalErrors.FlourFilterInvalid("min protein 14.00 must not be greater than max protein 11.00"), err)
 }
 
 func (suite *FlourServiceTestSuite) TestFind_WithError() {
-	suite.repository.EXPECT().Find(suite.ctx, 0, 10).
-		Return([]domain.FlourEntity{}, assert.AnError)
+	suite.repository.EXPECT().Find(suite.ctx, gomock.Any(), gomock.Any()).
+		Return(domain.FlourPage{}, assert.AnError)
 
-	_, err := suite.target.Find(suite.ctx, 0, 10)
+	_, err := suite.target.Find(suite.ctx, domain.FlourFilter{}, domain.PageParams{Limit: 10})
 
 	suite.ErrorContains(err, "failed to find flours")
 }
@@ -170,6 +290,49 @@ func (suite *FlourServiceTestSuite) TestSearchByName_WithError() {
 	suite.ErrorContains(err, "failed to search flours by name")
 }
 
+func (suite *FlourServiceTestSuite) TestTextSearch() {
+	entity := suite.createEntity()
+
+	suite.repository.EXPECT().TextSearch(suite.ctx, "test name -rye", 0, 10).
+		Return(domain.FlourTextSearchResult{
+			Hits:  []domain.FlourTextSearchHit{{Flour: entity, Score: 1.5}},
+			Total: 11,
+		}, nil)
+
+	actualDto, err := suite.target.TextSearch(suite.ctx, "test name -rye", 0, 10)
+
+	suite.NoError(err)
+	suite.Equal(domain.FlourTextSearchResultDto{
+		Items: []domain.FlourTextSearchHitDto{
+			{
+				Flour: entity.ToDto(),
+				Score: 1.5,
+				Highlights: map[string][]string{
+					"name":        {"<em>Test</em> <em>Name</em>"},
+					"flour_type":  {"<em>Test</em> FlourDto"},
+					"description": {"<em>Test</em> Description"},
+				},
+			},
+		},
+		Total: 11,
+	}, actualDto)
+}
+
+func (suite *FlourServiceTestSuite) TestTextSearch_WithInvalidQuery() {
+	_, err := suite.target.TextSearch(suite.ctx, " ", 0, 10)
+
+	suite.Equal(internalErrors.SearchQueryInvalid("search query is required"), err)
+}
+
+func (suite *FlourServiceTestSuite) TestTextSearch_WithError() {
+	suite.repository.EXPECT().TextSearch(suite.ctx, "rye", 0, 10).
+		Return(domain.FlourTextSearchResult{}, assert.AnError)
+
+	_, err := suite.target.TextSearch(suite.ctx, "rye", 0, 10)
+
+	suite.ErrorContains(err, "failed to search flours by text")
+}
+
 func (suite *FlourServiceTestSuite) createEntity() domain.FlourEntity {
 	return domain.FlourEntity{
 		Id:          test.FirstId,
@@ -187,7 +350,13 @@ func (suite *FlourServiceTestSuite) createEntity() domain.FlourEntity {
 }
 
 func TestNewFlourService_WithNilRepository(t *testing.T) {
-	_, err := NewFlourService(nil)
+	_, err := NewFlourService(nil, mocks.NewMockFlourTypeRepository(gomock.NewController(t)))
 
 	assert.EqualError(t, err, "repository is nil")
 }
+
+func TestNewFlourService_WithNilTypeRepository(t *testing.T) {
+	_, err := NewFlourService(mocks.NewMockFlourRepository(gomock.NewController(t)), nil)
+
+	assert.EqualError(t, err, "type repository is nil")
+}
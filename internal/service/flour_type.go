@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/utils"
+)
+
+const maxFlourTypeCodeLength = 64
+
+var flourTypeCodePattern = regexp.MustCompile(`^[a-z0-9]+(_[a-z0-9]+)*$`)
+
+// defaultFlourTypes are added by SeedDefaults to an empty flour type list.
+var defaultFlourTypes = []domain.CreateFlourTypeRequest{
+	{Code: "bread", Name: "Bread", Description: "Strong white wheat flour with a high protein content"},
+	{Code: "whole_wheat", Name: "Whole wheat", Description: "Wheat flour milled from the whole kernel"},
+	{Code: "rye", Name: "Rye", Description: "Flour milled from rye berries"},
+	{Code: "spelt", Name: "Spelt", Description: "Flour milled from spelt, an ancient wheat"},
+	{Code: "einkorn", Name: "Einkorn", Description: "Flour milled from einkorn, the oldest cultivated wheat"},
+}
+
+type flourTypeService struct {
+	repository      domain.FlourTypeRepository
+	flourRepository domain.FlourRepository
+}
+
+func (service *flourTypeService) Create(ctx context.Context, request domain.CreateFlourTypeRequest) (domain.FlourTypeDto, error) {
+	code := normalizeFlourTypeCode(request.Code)
+	if err := validateFlourTypeCode(code); err != nil {
+		return domain.FlourTypeDto{}, err
+	}
+
+	name := strings.TrimSpace(request.Name)
+	if name == "" {
+		return domain.FlourTypeDto{}, internalErrors.FlourTypeInvalid("name is required")
+	}
+
+	_, err := service.repository.GetByCode(ctx, code)
+	if err == nil {
+		return domain.FlourTypeDto{}, internalErrors.FlourTypeAlreadyExists(code)
+	} else if !errors.Is(err, mongo.ErrNoDocuments) {
+		log.Err(err).
+			Str("code", code).
+			Msg("failed to find flour type by code")
+
+		return domain.FlourTypeDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to create flour type")
+	}
+
+	created, err := service.repository.Create(ctx, domain.FlourTypeEntity{
+		Id:          uuid.New(),
+		Code:        code,
+		Name:        name,
+		Description: strings.TrimSpace(request.Description),
+		CreatedAt:   time.Now().UTC().Truncate(time.Millisecond),
+	})
+	if err != nil {
+		log.Err(err).
+			Str("code", code).
+			Msg("failed to create flour type")
+
+		if mongo.IsDuplicateKeyError(err) {
+			return domain.FlourTypeDto{}, internalErrors.FlourTypeAlreadyExists(code)
+		}
+
+		return domain.FlourTypeDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to create flour type")
+	}
+
+	return created.ToDto(), nil
+}
+
+func (service *flourTypeService) FindById(ctx context.Context, id uuid.UUID) (domain.FlourTypeDto, error) {
+	flourType, err := service.getById(ctx, id)
+	if err != nil {
+		return domain.FlourTypeDto{}, err
+	}
+	return flourType.ToDto(), nil
+}
+
+func (service *flourTypeService) FindAll(ctx context.Context) ([]domain.FlourTypeDto, error) {
+	flourTypes, err := service.repository.FindAll(ctx)
+	if err != nil {
+		log.Err(err).
+			Msg("failed to find flour types")
+
+		return nil, internalErrors.NewInternalServerErrorWrap(err, "failed to find flour types")
+	}
+
+	return utils.Map(flourTypes, func(flourType domain.FlourTypeEntity) domain.FlourTypeDto {
+		return flourType.ToDto()
+	}), nil
+}
+
+func (service *flourTypeService) Update(ctx context.Context, id uuid.UUID, request domain.UpdateFlourTypeRequest) (domain.FlourTypeDto, error) {
+	name := strings.TrimSpace(request.Name)
+	if name == "" {
+		return domain.FlourTypeDto{}, internalErrors.FlourTypeInvalid("name is required")
+	}
+
+	flourType, err := service.getById(ctx, id)
+	if err != nil {
+		return domain.FlourTypeDto{}, err
+	}
+
+	updatedAt := time.Now().UTC().Truncate(time.Millisecond)
+	flourType.Name = name
+	flourType.Description = strings.TrimSpace(request.Description)
+	flourType.UpdatedAt = &updatedAt
+
+	updated, err := service.repository.Update(ctx, flourType)
+	if err != nil {
+		log.Err(err).
+			Str("id", id.String()).
+			Msg("failed to update flour type")
+
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return domain.FlourTypeDto{}, flourTypeByIdNotFound(id)
+		}
+
+		return domain.FlourTypeDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to update flour type")
+	}
+
+	return updated.ToDto(), nil
+}
+
+// Delete removes a flour type unless flours still refer to it.
+func (service *flourTypeService) Delete(ctx context.Context, id uuid.UUID) error {
+	flourType, err := service.getById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	flours, err := service.flourRepository.CountByType(ctx, flourType.Code)
+	if err != nil {
+		return internalErrors.NewInternalServerErrorWrap(err, "failed to delete flour type")
+	}
+	if flours > 0 {
+		return internalErrors.FlourTypeInUse(flourType.Code, flours)
+	}
+
+	if err = service.repository.Delete(ctx, id); err != nil {
+		log.Err(err).
+			Str("id", id.String()).
+			Msg("failed to delete flour type")
+
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return flourTypeByIdNotFound(id)
+		}
+
+		return internalErrors.NewInternalServerErrorWrap(err, "failed to delete flour type")
+	}
+
+	return nil
+}
+
+func (service *flourTypeService) SeedDefaults(ctx context.Context) error {
+	count, err := service.repository.Count(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to count flour types")
+	}
+
+	if count > 0 {
+		return nil
+	}
+
+	for _, request := range defaultFlourTypes {
+		if _, err = service.Create(ctx, request); err != nil {
+			return errors.Wrapf(err, "failed to create flour type %s", request.Code)
+		}
+	}
+
+	log.Info().
+		Int("count", len(defaultFlourTypes)).
+		Msg("added default flour types")
+
+	return nil
+}
+
+func (service *flourTypeService) getById(ctx context.Context, id uuid.UUID) (domain.FlourTypeEntity, error) {
+	flourType, err := service.repository.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return domain.FlourTypeEntity{}, flourTypeByIdNotFound(id)
+		}
+
+		return domain.FlourTypeEntity{}, internalErrors.NewInternalServerErrorWrap(err, "failed to find flour type")
+	}
+	return flourType, nil
+}
+
+func flourTypeByIdNotFound(id uuid.UUID) error {
+	return internalErrors.FlourTypeNotFound(fmt.Sprintf("flour type with id %s not found", id.String()))
+}
+
+// normalizeFlourTypeCode lower-cases a flour type code and joins its words
+// with underscores.
+func normalizeFlourTypeCode(code string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(code), func(r rune) bool {
+		return r == ' ' || r == '\t' || r == '-' || r == '_'
+	}), "_")
+}
+
+func validateFlourTypeCode(code string) error {
+	if code == "" {
+		return internalErrors.FlourTypeInvalid("code is required")
+	}
+
+	if len(code) > maxFlourTypeCodeLength {
+		return internalErrors.FlourTypeInvalid(
+			fmt.Sprintf("code must not be longer than %d characters", maxFlourTypeCodeLength))
+	}
+
+	if !flourTypeCodePattern.MatchString(code) {
+		return internalErrors.FlourTypeInvalid(
+			fmt.Sprintf("code %s must consist of ASCII letters, digits and underscores", code))
+	}
+
+	return nil
+}
+
+func NewFlourTypeService(repository domain.FlourTypeRepository, flourRepository domain.FlourRepository) (domain.FlourTypeService, error) {
+	if repository == nil {
+		return nil, errors.New("repository is nil")
+	}
+
+	if flourRepository == nil {
+		return nil, errors.New("flour repository is nil")
+	}
+
+	return &flourTypeService{repository: repository, flourRepository: flourRepository}, nil
+}
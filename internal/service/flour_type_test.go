@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func TestFlourTypeServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(FlourTypeServiceTestSuite))
+}
+
+type FlourTypeServiceTestSuite struct {
+	test.GoMockTestSuite
+
+	ctx             context.Context
+	repository      *mocks.MockFlourTypeRepository
+	flourRepository *mocks.MockFlourRepository
+
+	target domain.FlourTypeService
+}
+
+func (suite *FlourTypeServiceTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.ctx = context.Background()
+	suite.repository = mocks.NewMockFlourTypeRepository(suite.MockCtrl)
+	suite.flourRepository = mocks.NewMockFlourRepository(suite.MockCtrl)
+
+	suite.target = test.Must(func() (domain.FlourTypeService, error) {
+		return NewFlourTypeService(suite.repository, suite.flourRepository)
+	})
+}
+
+func (suite *FlourTypeServiceTestSuite) TestCreate() {
+	suite.repository.EXPECT().GetByCode(suite.ctx, "whole_wheat").
+		Return(domain.FlourTypeEntity{}, mongo.ErrNoDocuments)
+
+	var savedEntity domain.FlourTypeEntity
+	suite.repository.EXPECT().Create(suite.ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, entity domain.FlourTypeEntity) (domain.FlourTypeEntity, error) {
+			savedEntity = entity
+			return entity, nil
+		})
+
+	actualDto, err := suite.target.Create(suite.ctx, domain.CreateFlourTypeRequest{
+		Code:        " Whole-Wheat ",
+		Name:        " Whole wheat ",
+		Description: "Wheat flour milled from the whole kernel",
+	})
+
+	suite.NoError(err)
+	suite.Equal(savedEntity.ToDto(), actualDto)
+	suite.Equal("whole_wheat", savedEntity.Code)
+	suite.Equal("Whole wheat", savedEntity.Name)
+	suite.False(savedEntity.CreatedAt.IsZero())
+}
+
+func (suite *FlourTypeServiceTestSuite) TestCreate_WithInvalidRequest() {
+	tests := []struct {
+		name          string
+		request       domain.CreateFlourTypeRequest
+		expectedError error
+	}{
+		{
+			name:          "missing code",
+			request:       domain.CreateFlourTypeRequest{Name: "Rye"},
+			expectedError: internalErrors.FlourTypeInvalid("code is required"),
+		},
+		{
+			name:          "code with invalid characters",
+			request:       domain.CreateFlourTypeRequest{Code: "rye/dark", Name: "Rye"},
+			expectedError: internalErrors.FlourTypeInvalid("code rye/dark must consist of ASCII letters, digits and underscores"),
+		},
+		{
+			name:          "missing name",
+			request:       domain.CreateFlourTypeRequest{Code: "rye", Name: " "},
+			expectedError: internalErrors.FlourTypeInvalid("name is required"),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			_, err := suite.target.Create(suite.ctx, tt.request)
+
+			suite.Equal(tt.expectedError, err)
+		})
+	}
+}
+
+func (suite *FlourTypeServiceTestSuite) TestCreate_WithExistingCode() {
+	suite.repository.EXPECT().GetByCode(suite.ctx, "rye").
+		Return(domain.FlourTypeEntity{Code: "rye"}, nil)
+
+	_, err := suite.target.Create(suite.ctx, domain.CreateFlourTypeRequest{Code: "Rye", Name: "Rye"})
+
+	suite.Equal(internalErrors.FlourTypeAlreadyExists("rye"), err)
+}
+
+func (suite *FlourTypeServiceTestSuite) TestCreate_WithError() {
+	suite.repository.EXPECT().GetByCode(suite.ctx, "rye").
+		Return(domain.FlourTypeEntity{}, mongo.ErrNoDocuments)
+	suite.repository.EXPECT().Create(suite.ctx, gomock.Any()).
+		Return(domain.FlourTypeEntity{}, assert.AnError)
+
+	_, err := suite.target.Create(suite.ctx, domain.CreateFlourTypeRequest{Code: "rye", Name: "Rye"})
+
+	suite.ErrorContains(err, "failed to create flour type")
+}
+
+func (suite *FlourTypeServiceTestSuite) TestFindById_WithError() {
+	tests := []struct {
+		name                string
+		errorFromRepository error
+		expectedError       error
+	}{
+		{
+			name:                "with basic error",
+			errorFromRepository: assert.AnError,
+			expectedError:       internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to find flour type"),
+		},
+		{
+			name:                "with document not found error",
+			errorFromRepository: mongo.ErrNoDocuments,
+			expectedError:       flourTypeByIdNotFound(test.FirstId),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.repository.EXPECT().GetById(suite.ctx, test.FirstId).
+				Return(domain.FlourTypeEntity{}, tt.errorFromRepository)
+
+			_, err := suite.target.FindById(suite.ctx, test.FirstId)
+
+			suite.Equal(tt.expectedError, err)
+		})
+	}
+}
+
+func (suite *FlourTypeServiceTestSuite) TestFindAll() {
+	entity := suite.createEntity()
+
+	suite.repository.EXPECT().FindAll(suite.ctx).
+		Return([]domain.FlourTypeEntity{entity}, nil)
+
+	actual, err := suite.target.FindAll(suite.ctx)
+
+	suite.NoError(err)
+	suite.Equal([]domain.FlourTypeDto{entity.ToDto()}, actual)
+}
+
+func (suite *FlourTypeServiceTestSuite) TestUpdate() {
+	entity := suite.createEntity()
+
+	suite.repository.EXPECT().GetById(suite.ctx, entity.Id).Return(entity, nil)
+
+	var savedEntity domain.FlourTypeEntity
+	suite.repository.EXPECT().Update(suite.ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, entity domain.FlourTypeEntity) (domain.FlourTypeEntity, error) {
+			savedEntity = entity
+			return entity, nil
+		})
+
+	actual, err := suite.target.Update(suite.ctx, entity.Id, domain.UpdateFlourTypeRequest{Name: "Dark rye"})
+
+	suite.NoError(err)
+	suite.Equal(savedEntity.ToDto(), actual)
+	suite.Equal("rye", savedEntity.Code)
+	suite.Equal("Dark rye", savedEntity.Name)
+	suite.Empty(savedEntity.Description)
+	suite.NotNil(savedEntity.UpdatedAt)
+}
+
+func (suite *FlourTypeServiceTestSuite) TestUpdate_WithoutName() {
+	_, err := suite.target.Update(suite.ctx, test.FirstId, domain.UpdateFlourTypeRequest{})
+
+	suite.Equal(internalErrors.FlourTypeInvalid("name is required"), err)
+}
+
+func (suite *FlourTypeServiceTestSuite) TestDelete() {
+	entity := suite.createEntity()
+
+	suite.repository.EXPECT().GetById(suite.ctx, entity.Id).Return(entity, nil)
+	suite.flourRepository.EXPECT().CountByType(suite.ctx, "rye").Return(int64(0), nil)
+	suite.repository.EXPECT().Delete(suite.ctx, entity.Id).Return(nil)
+
+	suite.NoError(suite.target.Delete(suite.ctx, entity.Id))
+}
+
+func (suite *FlourTypeServiceTestSuite) TestDelete_WhenInUse() {
+	entity := suite.createEntity()
+
+	suite.repository.EXPECT().GetById(suite.ctx, entity.Id).Return(entity, nil)
+	suite.flourRepository.EXPECT().CountByType(suite.ctx, "rye").Return(int64(3), nil)
+
+	err := suite.target.Delete(suite.ctx, entity.Id)
+
+	suite.Equal(internalErrors.FlourTypeInUse("rye", 3), err)
+}
+
+func (suite *FlourTypeServiceTestSuite) TestDelete_WithNotFound() {
+	suite.repository.EXPECT().GetById(suite.ctx, test.FirstId).
+		Return(domain.FlourTypeEntity{}, mongo.ErrNoDocuments)
+
+	err := suite.target.Delete(suite.ctx, test.FirstId)
+
+	suite.Equal(flourTypeByIdNotFound(test.FirstId), err)
+}
+
+func (suite *FlourTypeServiceTestSuite) TestSeedDefaults() {
+	suite.repository.EXPECT().Count(suite.ctx).Return(int64(0), nil)
+	suite.repository.EXPECT().GetByCode(suite.ctx, gomock.Any()).
+		Return(domain.FlourTypeEntity{}, mongo.ErrNoDocuments).
+		Times(len(defaultFlourTypes))
+
+	var codes []string
+	suite.repository.EXPECT().Create(suite.ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, entity domain.FlourTypeEntity) (domain.FlourTypeEntity, error) {
+			codes = append(codes, entity.Code)
+			return entity, nil
+		}).
+		Times(len(defaultFlourTypes))
+
+	suite.NoError(suite.target.SeedDefaults(suite.ctx))
+	suite.Equal([]string{"bread", "whole_wheat", "rye", "spelt", "einkorn"}, codes)
+}
+
+func (suite *FlourTypeServiceTestSuite) TestSeedDefaults_WithExistingTypes() {
+	suite.repository.EXPECT().Count(suite.ctx).Return(int64(2), nil)
+
+	suite.NoError(suite.target.SeedDefaults(suite.ctx))
+}
+
+func (suite *FlourTypeServiceTestSuite) TestSeedDefaults_WithError() {
+	suite.repository.EXPECT().Count(suite.ctx).Return(int64(0), assert.AnError)
+
+	suite.ErrorContains(suite.target.SeedDefaults(suite.ctx), "failed to count flour types")
+}
+
+func (suite *FlourTypeServiceTestSuite) createEntity() domain.FlourTypeEntity {
+	return domain.FlourTypeEntity{
+		Id:          test.FirstId,
+		Code:        "rye",
+		Name:        "Rye",
+		Description: "Flour milled from rye berries",
+		CreatedAt:   test.Date,
+	}
+}
+
+func TestNormalizeFlourTypeCode(t *testing.T) {
+	tests := map[string]string{
+		"Whole Wheat":    "whole_wheat",
+		" whole-wheat ":  "whole_wheat",
+		"WHOLE__wheat":   "whole_wheat",
+		"rye":            "rye",
+		"":               "",
+		"type 00\tflour": "type_00_flour",
+	}
+
+	for code, expected := range tests {
+		assert.Equal(t, expected, normalizeFlourTypeCode(code), code)
+	}
+}
+
+func TestNewFlourTypeService_WithNilRepository(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	_, err := NewFlourTypeService(nil, mocks.NewMockFlourRepository(ctrl))
+	assert.EqualError(t, err, "repository is nil")
+
+	_, err = NewFlourTypeService(mocks.NewMockFlourTypeRepository(ctrl), nil)
+	assert.EqualError(t, err, "flour repository is nil")
+}
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"fmt"
+	"html"
+	"slices"
+	"strings"
+	"unicode"
+
+	internalErrors "dough-calculator/internal/errors"
+)
+
+const (
+	highlightPreTag       = "<em>"
+	highlightPostTag      = "</em>"
+	highlightEllipsis     = "…"
+	highlightFragmentSize = 120
+	maxSearchQueryLength  = 256
+)
+
+// highlights maps a document field to its fragments matching the search terms.
+type highlights map[string][]string
+
+// add highlights text and records it under field when it matches any of the
+// terms. A positive size cuts long texts down to a fragment of about size
+// characters around the first match.
+func (h highlights) add(field, text string, terms []string, size int) {
+	if fragment, ok := highlight(text, terms, size); ok {
+		h[field] = append(h[field], fragment)
+	}
+}
+
+type wordSpan struct {
+	start, end int
+	matched    bool
+}
+
+// validateSearchQuery rejects queries that are empty or too long to be a
+// reasonable full-text search.
+func validateSearchQuery(query string) error {
+	if strings.TrimSpace(query) == "" {
+		return internalErrors.SearchQueryInvalid("search query is required")
+	}
+
+	if len([]rune(query)) > maxSearchQueryLength {
+		return internalErrors.SearchQueryInvalid(
+			fmt.Sprintf("search query must not be longer than %d characters", maxSearchQueryLength))
+	}
+
+	return nil
+}
+
+// searchTerms extracts the lower-cased words of a MongoDB text search query,
+// leaving out negated terms.
+func searchTerms(query string) []string {
+	var terms []string
+	for _, field := range strings.Fields(strings.ReplaceAll(query, `"`, " ")) {
+		if strings.HasPrefix(field, "-") {
+			continue
+		}
+
+		for _, word := range strings.FieldsFunc(strings.ToLower(field), isNotWordRune) {
+			if !slices.Contains(terms, word) {
+				terms = append(terms, word)
+			}
+		}
+	}
+	return terms
+}
+
+// highlight wraps every word of text starting with one of terms in
+// highlight tags and escapes the rest as HTML. It reports whether any word
+// matched.
+func highlight(text string, terms []string, size int) (string, bool) {
+	runes := []rune(text)
+	spans := matchWords(runes, terms)
+
+	first := slices.IndexFunc(spans, func(span wordSpan) bool { return span.matched })
+	if first < 0 {
+		return "", false
+	}
+
+	from, to := 0, len(runes)
+	if size > 0 && len(runes) > size {
+		from, to = fragmentBounds(spans, first, size, len(runes))
+	}
+
+	var builder strings.Builder
+	if from > 0 {
+		builder.WriteString(highlightEllipsis)
+	}
+
+	position := from
+	for _, span := range spans {
+		if !span.matched || span.start < from || span.end > to {
+			continue
+		}
+
+		builder.WriteString(html.EscapeString(string(runes[position:span.start])))
+		builder.WriteString(highlightPreTag)
+		builder.WriteString(html.EscapeString(string(runes[span.start:span.end])))
+		builder.WriteString(highlightPostTag)
+		position = span.end
+	}
+	builder.WriteString(html.EscapeString(string(runes[position:to])))
+
+	if to < len(runes) {
+		builder.WriteString(highlightEllipsis)
+	}
+
+	return builder.String(), true
+}
+
+// fragmentBounds picks a window of about size runes starting a little before
+// the first matching word, aligned to word boundaries.
+func fragmentBounds(spans []wordSpan, first, size, length int) (int, int) {
+	from := max(spans[first].start-size/4, 0)
+	if from > 0 {
+		for _, span := range spans {
+			if span.end > from {
+				from = span.start
+				break
+			}
+		}
+	}
+
+	to := from + size
+	if to >= length {
+		return from, length
+	}
+
+	end := spans[first].end
+	for _, span := range spans[first:] {
+		if span.end > to {
+			break
+		}
+		end = span.end
+	}
+
+	return from, end
+}
+
+func matchWords(text []rune, terms []string) []wordSpan {
+	var spans []wordSpan
+	for i := 0; i < len(text); {
+		if isNotWordRune(text[i]) {
+			i++
+			continue
+		}
+
+		j := i
+		for j < len(text) && !isNotWordRune(text[j]) {
+			j++
+		}
+
+		word := strings.ToLower(string(text[i:j]))
+		spans = append(spans, wordSpan{
+			start: i,
+			end:   j,
+			matched: slices.ContainsFunc(terms, func(term string) bool {
+				return strings.HasPrefix(word, term)
+			}),
+		})
+		i = j
+	}
+	return spans
+}
+
+func isNotWordRune(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
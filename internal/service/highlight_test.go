@@ -0,0 +1,121 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	internalErrors "dough-calculator/internal/errors"
+)
+
+func TestValidateSearchQuery(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		expectedError error
+	}{
+		{name: "valid", query: "rye", expectedError: nil},
+		{name: "blank", query: "  ", expectedError: internalErrors.SearchQueryInvalid("search query is required")},
+		{
+			name:          "too long",
+			query:         strings.Repeat("a", 257),
+			expectedError: internalErrors.SearchQueryInvalid("search query must not be longer than 256 characters"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedError, validateSearchQuery(tt.query))
+		})
+	}
+}
+
+func TestSearchTerms(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{name: "words", query: "Rye  whole-grain rye", expected: []string{"rye", "whole", "grain"}},
+		{name: "phrase", query: `"country loaf"`, expected: []string{"country", "loaf"}},
+		{name: "negation", query: "rye -spelt", expected: []string{"rye"}},
+		{name: "regex characters", query: "(a+)+ .*", expected: []string{"a"}},
+		{name: "empty", query: "", expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, searchTerms(tt.query))
+		})
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	description := "A long fermented loaf with a tangy crumb. " +
+		"It uses a generous share of dark rye flour and a stiff levain, " +
+		"baked in a dutch oven until the crust is deeply caramelized."
+
+	tests := []struct {
+		name            string
+		text            string
+		terms           []string
+		size            int
+		expected        string
+		expectedMatched bool
+	}{
+		{
+			name:            "whole text",
+			text:            "Country Rye & Spelt",
+			terms:           []string{"rye", "spel"},
+			expected:        "Country <em>Rye</em> &amp; <em>Spelt</em>",
+			expectedMatched: true,
+		},
+		{
+			name:            "escapes markup",
+			text:            "<b>rye</b>",
+			terms:           []string{"rye"},
+			expected:        "&lt;b&gt;<em>rye</em>&lt;/b&gt;",
+			expectedMatched: true,
+		},
+		{
+			name:            "no match",
+			text:            "Country loaf",
+			terms:           []string{"rye"},
+			expected:        "",
+			expectedMatched: false,
+		},
+		{
+			name:            "word prefix only",
+			text:            "Dryer dough",
+			terms:           []string{"rye"},
+			expected:        "",
+			expectedMatched: false,
+		},
+		{
+			name:            "fragment",
+			text:            description,
+			terms:           []string{"rye"},
+			size:            60,
+			expected:        "…share of dark <em>rye</em> flour and a stiff levain, baked in a dutch…",
+			expectedMatched: true,
+		},
+		{
+			name:            "short text is not cut",
+			text:            "Dark rye",
+			terms:           []string{"rye"},
+			size:            60,
+			expected:        "Dark <em>rye</em>",
+			expectedMatched: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, matched := highlight(tt.text, tt.terms, tt.size)
+
+			assert.Equal(t, tt.expectedMatched, matched)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+)
+
+const (
+	defaultDoughStyle      = "country"
+	defaultFlourAbsorption = 65
+)
+
+// doughStyle is the hydration range of a dough style relative to the
+// absorption of its flour blend, in baker percentage points.
+type doughStyle struct {
+	minOffset float64
+	maxOffset float64
+}
+
+var doughStyles = map[string]doughStyle{
+	"stiff":      {minOffset: -15, maxOffset: -8},
+	"sandwich":   {minOffset: -5, maxOffset: 0},
+	"country":    {minOffset: 0, maxOffset: 5},
+	"open_crumb": {minOffset: 5, maxOffset: 10},
+	"ciabatta":   {minOffset: 10, maxOffset: 15},
+}
+
+// defaultFlourAbsorptions are used for flours without a suggested
+// absorption, by flour type code.
+var defaultFlourAbsorptions = map[string]float64{
+	"bread":       65,
+	"whole_wheat": 75,
+	"rye":         80,
+	"spelt":       60,
+	"einkorn":     55,
+}
+
+type hydrationService struct {
+	flourRepository        domain.FlourRepository
+	sourdoughRecipeService domain.SourdoughRecipeService
+}
+
+// Suggest derives the hydration range of a blend from the weighted water
+// absorption of its flours and the offsets of the dough style. The water of
+// an existing recipe is scaled to the middle of the range; levain water is
+// left as it is, like the recipe's own hydration figure.
+func (service *hydrationService) Suggest(ctx context.Context, request domain.HydrationSuggestionRequest) (domain.HydrationSuggestionDto, error) {
+	styleName, style, err := service.style(request.Style)
+	if err != nil {
+		return domain.HydrationSuggestionDto{}, err
+	}
+
+	if len(request.Flour) == 0 && request.RecipeId == nil {
+		return domain.HydrationSuggestionDto{}, internalErrors.HydrationRequestInvalid("flour or recipe_id is required")
+	}
+	if request.Apply && request.RecipeId == nil {
+		return domain.HydrationSuggestionDto{}, internalErrors.HydrationRequestInvalid("recipe_id is required to apply the suggestion")
+	}
+
+	var recipe *domain.SourdoughRecipeDto
+	if request.RecipeId != nil {
+		recipeDto, err := service.sourdoughRecipeService.FindById(ctx, *request.RecipeId)
+		if err != nil {
+			return domain.HydrationSuggestionDto{}, err
+		}
+		recipe = &recipeDto
+	}
+
+	blend := request.Flour
+	if len(blend) == 0 {
+		blend = recipe.Flour
+	}
+
+	flours := make([]domain.FlourAbsorptionDto, len(blend))
+	for i, amount := range blend {
+		flours[i], err = service.absorption(ctx, amount, len(request.Flour) == 0)
+		if err != nil {
+			return domain.HydrationSuggestionDto{}, err
+		}
+	}
+
+	var blendWeight, absorbedWater float64
+	for _, flour := range flours {
+		blendWeight += flour.Amount
+		absorbedWater += flour.Amount * flour.Absorption
+	}
+	if blendWeight == 0 {
+		return domain.HydrationSuggestionDto{}, internalErrors.HydrationRequestInvalid("flour blend must not be empty")
+	}
+
+	absorption := absorbedWater / blendWeight
+	suggestion := domain.HydrationSuggestionDto{
+		Style:        styleName,
+		FlourWeight:  blendWeight,
+		Absorption:   roundTo(absorption, 1),
+		MinHydration: roundTo(absorption+style.minOffset, 1),
+		MaxHydration: roundTo(absorption+style.maxOffset, 1),
+		Hydration:    roundTo(absorption+(style.minOffset+style.maxOffset)/2, 1),
+		Flours:       flours,
+	}
+
+	if recipe != nil {
+		suggestion.FlourWeight = recipe.Details.Flour.Amount
+		if suggestion.FlourWeight == 0 {
+			return domain.HydrationSuggestionDto{}, internalErrors.HydrationRequestInvalid("recipe has no flour")
+		}
+
+		suggestion.Recipe, err = service.adjustRecipe(ctx, *recipe, suggestion.Hydration, request.Apply)
+		if err != nil {
+			return domain.HydrationSuggestionDto{}, err
+		}
+	}
+
+	suggestion.MinWater = math.Round(suggestion.FlourWeight * suggestion.MinHydration / 100)
+	suggestion.MaxWater = math.Round(suggestion.FlourWeight * suggestion.MaxHydration / 100)
+	suggestion.Water = math.Round(suggestion.FlourWeight * suggestion.Hydration / 100)
+
+	return suggestion, nil
+}
+
+func (service *hydrationService) style(name string) (string, doughStyle, error) {
+	name = normalizeFlourTypeCode(name)
+	if name == "" {
+		name = defaultDoughStyle
+	}
+
+	style, ok := doughStyles[name]
+	if !ok {
+		names := make([]string, 0, len(doughStyles))
+		for styleName := range doughStyles {
+			names = append(names, styleName)
+		}
+		slices.Sort(names)
+
+		return "", doughStyle{}, internalErrors.HydrationRequestInvalid(
+			fmt.Sprintf("style %s must be one of %s", name, strings.Join(names, ", ")))
+	}
+
+	return name, style, nil
+}
+
+// absorption looks up the flour of the amount in the catalogue. Flours of an
+// existing recipe fall back to the flour stored with the recipe when they are
+// no longer in the catalogue.
+func (service *hydrationService) absorption(ctx context.Context, amount domain.FlourAmountDto, recipeFlour bool) (domain.FlourAbsorptionDto, error) {
+	if amount.Amount <= 0 {
+		return domain.FlourAbsorptionDto{}, internalErrors.HydrationRequestInvalid(
+			fmt.Sprintf("amount %.2f of flour %s must be greater than 0", amount.Amount, amount.Id.String()))
+	}
+
+	flour := amount.FlourDto
+	entity, err := service.flourRepository.FindById(ctx, amount.Id)
+	if err == nil {
+		flour = entity.ToDto()
+	} else if !errors.Is(err, mongo.ErrNoDocuments) {
+		log.Err(err).
+			Str("id", amount.Id.String()).
+			Msg("failed to find flour")
+
+		return domain.FlourAbsorptionDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to suggest hydration")
+	} else if !recipeFlour {
+		return domain.FlourAbsorptionDto{}, internalErrors.HydrationFlourNotFound(amount.Id)
+	}
+
+	absorption, estimated := flourAbsorption(flour)
+
+	return domain.FlourAbsorptionDto{
+		FlourId:    amount.Id,
+		Name:       flour.Name,
+		FlourType:  flour.FlourType,
+		Amount:     amount.Amount,
+		Absorption: absorption,
+		Estimated:  estimated,
+	}, nil
+}
+
+// flourAbsorption returns the suggested absorption of the flour, or the
+// default of its flour type when none is set, in which case the result is
+// reported as estimated.
+func flourAbsorption(flour domain.FlourDto) (float64, bool) {
+	if flour.SuggestedAbsorption != 0 {
+		return flour.SuggestedAbsorption, false
+	}
+
+	if typeAbsorption, ok := defaultFlourAbsorptions[normalizeFlourTypeCode(flour.FlourType)]; ok {
+		return typeAbsorption, true
+	}
+
+	return defaultFlourAbsorption, true
+}
+
+// adjustRecipe scales the recipe's water to the hydration. Unless apply is
+// set the adjusted recipe is only returned as a preview.
+func (service *hydrationService) adjustRecipe(
+	ctx context.Context,
+	recipe domain.SourdoughRecipeDto,
+	hydration float64,
+	apply bool,
+) (*domain.SourdoughRecipeDto, error) {
+	recipe.Water = scaleWaterToHydration(recipe.Details.Flour.Amount, recipe.Water, hydration)
+
+	if apply {
+		updated, err := service.sourdoughRecipeService.Update(ctx, recipe.Id, recipe.ToCreateRequest())
+		if err != nil {
+			return nil, err
+		}
+		return &updated, nil
+	}
+
+	recipe.Details = calculateSourdoughRecipeDetails(recipe.ToCreateRequest())
+
+	return &recipe, nil
+}
+
+func roundTo(value float64, decimals int) float64 {
+	factor := math.Pow(10, float64(decimals))
+	return math.Round(value*factor) / factor
+}
+
+func NewHydrationService(
+	flourRepository domain.FlourRepository,
+	sourdoughRecipeService domain.SourdoughRecipeService,
+) (domain.HydrationService, error) {
+	if flourRepository == nil {
+		return nil, errors.New("flourRepository cannot be nil")
+	}
+
+	if sourdoughRecipeService == nil {
+		return nil, errors.New("sourdoughRecipeService cannot be nil")
+	}
+
+	return &hydrationService{
+		flourRepository:        flourRepository,
+		sourdoughRecipeService: sourdoughRecipeService,
+	}, nil
+}
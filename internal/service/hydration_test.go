@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func TestHydrationServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(HydrationServiceTestSuite))
+}
+
+type HydrationServiceTestSuite struct {
+	test.GoMockTestSuite
+
+	ctx                    context.Context
+	flourRepository        *mocks.MockFlourRepository
+	sourdoughRecipeService *mocks.MockSourdoughRecipeService
+
+	target domain.HydrationService
+}
+
+func (suite *HydrationServiceTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.ctx = context.Background()
+	suite.flourRepository = mocks.NewMockFlourRepository(suite.MockCtrl)
+	suite.sourdoughRecipeService = mocks.NewMockSourdoughRecipeService(suite.MockCtrl)
+
+	suite.target = test.Must(func() (domain.HydrationService, error) {
+		return NewHydrationService(suite.flourRepository, suite.sourdoughRecipeService)
+	})
+}
+
+func (suite *HydrationServiceTestSuite) TestSuggest() {
+	bread := domain.FlourEntity{Id: test.FirstId, Name: "Bread flour", FlourType: "bread", SuggestedAbsorption: 66}
+	wholeWheat := domain.FlourEntity{Id: test.SecondId, Name: "Whole wheat flour", FlourType: "whole_wheat"}
+
+	suite.flourRepository.EXPECT().FindById(suite.ctx, bread.Id).Return(bread, nil)
+	suite.flourRepository.EXPECT().FindById(suite.ctx, wholeWheat.Id).Return(wholeWheat, nil)
+
+	actual, err := suite.target.Suggest(suite.ctx, domain.HydrationSuggestionRequest{
+		Flour: []domain.FlourAmountDto{
+			{FlourDto: domain.FlourDto{Id: bread.Id}, Amount: 700},
+			{FlourDto: domain.FlourDto{Id: wholeWheat.Id}, Amount: 300},
+		},
+		Style: "Open Crumb",
+	})
+
+	suite.NoError(err)
+	suite.Equal(domain.HydrationSuggestionDto{
+		Style:        "open_crumb",
+		FlourWeight:  1000,
+		Absorption:   68.7,
+		MinHydration: 73.7,
+		MaxHydration: 78.7,
+		Hydration:    76.2,
+		MinWater:     737,
+		MaxWater:     787,
+		Water:        762,
+		Flours: []domain.FlourAbsorptionDto{
+			{FlourId: bread.Id, Name: "Bread flour", FlourType: "bread", Amount: 700, Absorption: 66},
+			{FlourId: wholeWheat.Id, Name: "Whole wheat flour", FlourType: "whole_wheat", Amount: 300, Absorption: 75, Estimated: true},
+		},
+	}, actual)
+}
+
+func (suite *HydrationServiceTestSuite) TestSuggest_WithDefaultStyleAndUnknownFlourType() {
+	flour := domain.FlourEntity{Id: test.FirstId, FlourType: "emmer"}
+
+	suite.flourRepository.EXPECT().FindById(suite.ctx, flour.Id).Return(flour, nil)
+
+	actual, err := suite.target.Suggest(suite.ctx, domain.HydrationSuggestionRequest{
+		Flour: []domain.FlourAmountDto{{FlourDto: domain.FlourDto{Id: flour.Id}, Amount: 500}},
+	})
+
+	suite.NoError(err)
+	suite.Equal("country", actual.Style)
+	suite.Equal(65.0, actual.Absorption)
+	suite.Equal(67.5, actual.Hydration)
+	suite.Equal(338.0, actual.Water)
+}
+
+func (suite *HydrationServiceTestSuite) TestSuggest_WithRecipePreview() {
+	recipe := suite.createRecipe()
+
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, recipe.Id).Return(recipe, nil)
+	suite.flourRepository.EXPECT().FindById(suite.ctx, test.FirstId).
+		Return(domain.FlourEntity{}, mongo.ErrNoDocuments)
+
+	actual, err := suite.target.Suggest(suite.ctx, domain.HydrationSuggestionRequest{
+		Style:    "sandwich",
+		RecipeId: &recipe.Id,
+	})
+
+	suite.NoError(err)
+	suite.Equal(1000.0, actual.FlourWeight)
+	suite.Equal(57.5, actual.Hydration)
+	suite.Equal(575.0, actual.Water)
+	suite.True(actual.Flours[0].Estimated)
+	suite.Require().NotNil(actual.Recipe)
+	suite.Equal([]domain.BakerAmountDto{
+		{Name: "Water", Amount: 460, BakerPercentage: 46},
+		{Name: "Bassinage", Amount: 115, BakerPercentage: 11.5},
+	}, actual.Recipe.Water)
+	suite.Equal(575.0, actual.Recipe.Details.Water.Amount)
+	suite.InDelta(57.5, actual.Recipe.Details.Water.BakerPercentage, 0.001)
+	suite.Equal(1795, actual.Recipe.Details.TotalWeight)
+}
+
+func (suite *HydrationServiceTestSuite) TestSuggest_WithRecipeApplied() {
+	recipe := suite.createRecipe()
+	bread := domain.FlourEntity{Id: test.SecondId, FlourType: "bread", SuggestedAbsorption: 70}
+
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, recipe.Id).Return(recipe, nil)
+	suite.flourRepository.EXPECT().FindById(suite.ctx, bread.Id).Return(bread, nil)
+
+	var updateRequest domain.CreateSourdoughRecipeRequest
+	suite.sourdoughRecipeService.EXPECT().Update(suite.ctx, recipe.Id, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, request domain.CreateSourdoughRecipeRequest) (domain.SourdoughRecipeDto, error) {
+			updateRequest = request
+			updated := recipe
+			updated.Version = 2
+			return updated, nil
+		})
+
+	actual, err := suite.target.Suggest(suite.ctx, domain.HydrationSuggestionRequest{
+		Flour:    []domain.FlourAmountDto{{FlourDto: domain.FlourDto{Id: bread.Id}, Amount: 100}},
+		Style:    "ciabatta",
+		RecipeId: &recipe.Id,
+		Apply:    true,
+	})
+
+	suite.NoError(err)
+	suite.Equal(82.5, actual.Hydration)
+	suite.Equal(825.0, actual.Water)
+	suite.Equal(2, actual.Recipe.Version)
+	suite.InDelta(660, updateRequest.Water[0].Amount, 0.001)
+	suite.InDelta(165, updateRequest.Water[1].Amount, 0.001)
+}
+
+func (suite *HydrationServiceTestSuite) TestSuggest_WithInvalidRequest() {
+	recipeId := test.ThirdId
+
+	tests := []struct {
+		name          string
+		request       domain.HydrationSuggestionRequest
+		expectedError error
+	}{
+		{
+			name:          "unknown style",
+			request:       domain.HydrationSuggestionRequest{Style: "focaccia", RecipeId: &recipeId},
+			expectedError: internalErrors.HydrationRequestInvalid("style focaccia must be one of ciabatta, country, open_crumb, sandwich, stiff"),
+		},
+		{
+			name:          "without flour and recipe",
+			request:       domain.HydrationSuggestionRequest{},
+			expectedError: internalErrors.HydrationRequestInvalid("flour or recipe_id is required"),
+		},
+		{
+			name: "apply without recipe",
+			request: domain.HydrationSuggestionRequest{
+				Flour: []domain.FlourAmountDto{{FlourDto: domain.FlourDto{Id: test.FirstId}, Amount: 100}},
+				Apply: true,
+			},
+			expectedError: internalErrors.HydrationRequestInvalid("recipe_id is required to apply the suggestion"),
+		},
+		{
+			name: "flour without amount",
+			request: domain.HydrationSuggestionRequest{
+				Flour: []domain.FlourAmountDto{{FlourDto: domain.FlourDto{Id: test.FirstId}}},
+			},
+			expectedError: internalErrors.HydrationRequestInvalid(
+				"amount 0.00 of flour 74c65c5a-44e7-44a2-8cdd-cd7c49cfcb42 must be greater than 0"),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			_, err := suite.target.Suggest(suite.ctx, tt.request)
+
+			suite.Equal(tt.expectedError, err)
+		})
+	}
+}
+
+func (suite *HydrationServiceTestSuite) TestSuggest_WithFlourNotFound() {
+	suite.flourRepository.EXPECT().FindById(suite.ctx, test.FirstId).
+		Return(domain.FlourEntity{}, mongo.ErrNoDocuments)
+
+	_, err := suite.target.Suggest(suite.ctx, domain.HydrationSuggestionRequest{
+		Flour: []domain.FlourAmountDto{{FlourDto: domain.FlourDto{Id: test.FirstId}, Amount: 100}},
+	})
+
+	suite.Equal(internalErrors.HydrationFlourNotFound(test.FirstId), err)
+}
+
+func (suite *HydrationServiceTestSuite) TestSuggest_WithErrorOnFindFlour() {
+	suite.flourRepository.EXPECT().FindById(suite.ctx, test.FirstId).
+		Return(domain.FlourEntity{}, assert.AnError)
+
+	_, err := suite.target.Suggest(suite.ctx, domain.HydrationSuggestionRequest{
+		Flour: []domain.FlourAmountDto{{FlourDto: domain.FlourDto{Id: test.FirstId}, Amount: 100}},
+	})
+
+	suite.ErrorContains(err, "failed to suggest hydration")
+}
+
+func (suite *HydrationServiceTestSuite) TestSuggest_WithErrorOnFindRecipe() {
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, test.ThirdId).
+		Return(domain.SourdoughRecipeDto{}, assert.AnError)
+
+	_, err := suite.target.Suggest(suite.ctx, domain.HydrationSuggestionRequest{RecipeId: &test.ThirdId})
+
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func (suite *HydrationServiceTestSuite) createRecipe() domain.SourdoughRecipeDto {
+	return domain.SourdoughRecipeDto{
+		RecipeDto: domain.RecipeDto{
+			Id: test.ThirdId,
+			Flour: []domain.FlourAmountDto{
+				{FlourDto: domain.FlourDto{Id: test.FirstId, FlourType: "spelt"}, Amount: 1000},
+			},
+			Water: []domain.BakerAmountDto{
+				{Name: "Water", Amount: 560, BakerPercentage: 56},
+				{Name: "Bassinage", Amount: 140, BakerPercentage: 14},
+			},
+			AdditionalIngredients: []domain.BakerAmountDto{
+				{Name: "Salt", Amount: 20, BakerPercentage: 2},
+			},
+			Details: domain.RecipeDetailsDto{
+				Flour:                 domain.BakerAmountDto{Amount: 1000, BakerPercentage: 100},
+				Water:                 domain.BakerAmountDto{Amount: 700, BakerPercentage: 70},
+				Levain:                domain.BakerAmountDto{Amount: 200},
+				AdditionalIngredients: domain.BakerAmountDto{Amount: 20, BakerPercentage: 2},
+				TotalWeight:           1920,
+			},
+			Version: 1,
+		},
+		Levain: domain.SourdoughLevainAgentDto{
+			Amount: domain.BakerAmountDto{Amount: 200},
+		},
+	}
+}
+
+func TestNewHydrationService_WithNilDependencies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	_, err := NewHydrationService(nil, mocks.NewMockSourdoughRecipeService(ctrl))
+	assert.EqualError(t, err, "flourRepository cannot be nil")
+
+	_, err = NewHydrationService(mocks.NewMockFlourRepository(ctrl), nil)
+	assert.EqualError(t, err, "sourdoughRecipeService cannot be nil")
+}
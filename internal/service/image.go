@@ -0,0 +1,349 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"dough-calculator/internal/config"
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/utils"
+)
+
+// maxImagePixels guards against decompression bombs, a small file can declare huge dimensions.
+const maxImagePixels = 50_000_000
+
+var supportedImageContentTypes = []string{"image/jpeg", "image/png", "image/gif"}
+
+type imageService struct {
+	repository             domain.ImageRepository
+	blobStore              domain.BlobStore
+	sourdoughRecipeService domain.SourdoughRecipeService
+	bakeLogService         domain.BakeLogService
+	storage                config.Storage
+}
+
+func (service *imageService) Upload(ctx context.Context, recipeId uuid.UUID, request domain.UploadImageRequest) (domain.ImageDto, error) {
+	size := int64(len(request.Content))
+	if size == 0 {
+		return domain.ImageDto{}, internalErrors.ImageInvalid("image is empty")
+	}
+
+	if maxSize := service.storage.MaxImageSizeBytes(); size > maxSize {
+		return domain.ImageDto{}, internalErrors.ImageTooLarge(size, maxSize)
+	}
+
+	// the content type declared by the client is not trusted, it is sniffed from the content
+	contentType := http.DetectContentType(request.Content)
+	if !slices.Contains(supportedImageContentTypes, contentType) {
+		return domain.ImageDto{}, internalErrors.ImageUnsupportedContentType(contentType)
+	}
+
+	if _, err := service.sourdoughRecipeService.FindById(ctx, recipeId); err != nil {
+		return domain.ImageDto{}, err
+	}
+
+	if request.BakeLogId != nil {
+		if _, err := service.bakeLogService.FindById(ctx, recipeId, *request.BakeLogId); err != nil {
+			return domain.ImageDto{}, err
+		}
+	}
+
+	imageConfig, _, err := image.DecodeConfig(bytes.NewReader(request.Content))
+	if err != nil {
+		return domain.ImageDto{}, internalErrors.ImageInvalid("failed to read image header")
+	}
+
+	if imageConfig.Width*imageConfig.Height > maxImagePixels {
+		return domain.ImageDto{}, internalErrors.ImageInvalid("image dimensions are too large")
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(request.Content))
+	if err != nil {
+		return domain.ImageDto{}, internalErrors.ImageInvalid("failed to decode image")
+	}
+
+	thumbnail, err := encodeThumbnail(decoded, contentType, service.storage.ThumbnailSizePixels())
+	if err != nil {
+		log.Err(err).
+			Str("recipe_id", recipeId.String()).
+			Msg("failed to create thumbnail")
+
+		return domain.ImageDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to create thumbnail")
+	}
+
+	id := uuid.New()
+	entity := domain.ImageEntity{
+		Id:           id,
+		RecipeId:     recipeId,
+		BakeLogId:    request.BakeLogId,
+		FileName:     request.FileName,
+		ContentType:  contentType,
+		Size:         size,
+		Width:        imageConfig.Width,
+		Height:       imageConfig.Height,
+		BlobKey:      "images/" + recipeId.String() + "/" + id.String(),
+		ThumbnailKey: "images/" + recipeId.String() + "/" + id.String() + "-thumbnail",
+		CreatedAt:    time.Now(),
+	}
+
+	if err = service.blobStore.Put(ctx, entity.BlobKey, bytes.NewReader(request.Content)); err != nil {
+		log.Err(err).
+			Str("recipe_id", recipeId.String()).
+			Msg("failed to store image")
+
+		return domain.ImageDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to store image")
+	}
+
+	if err = service.blobStore.Put(ctx, entity.ThumbnailKey, bytes.NewReader(thumbnail)); err != nil {
+		log.Err(err).
+			Str("recipe_id", recipeId.String()).
+			Msg("failed to store thumbnail")
+
+		service.deleteBlobs(ctx, entity.BlobKey)
+
+		return domain.ImageDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to store thumbnail")
+	}
+
+	created, err := service.repository.Create(ctx, entity)
+	if err != nil {
+		log.Err(err).
+			Str("recipe_id", recipeId.String()).
+			Msg("failed to create image")
+
+		service.deleteBlobs(ctx, entity.BlobKey, entity.ThumbnailKey)
+
+		return domain.ImageDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to create image")
+	}
+
+	return created.ToDto(), nil
+}
+
+func (service *imageService) FindByRecipeId(ctx context.Context, recipeId uuid.UUID, bakeLogId *uuid.UUID, offset, limit int) ([]domain.ImageDto, error) {
+	images, err := service.repository.FindByRecipeId(ctx, recipeId, bakeLogId, offset, limit)
+	if err != nil {
+		log.Err(err).
+			Str("recipe_id", recipeId.String()).
+			Msg("failed to find images")
+
+		return nil, internalErrors.NewInternalServerErrorWrap(err, "failed to find images")
+	}
+
+	return utils.Map(images, func(entity domain.ImageEntity) domain.ImageDto {
+		return entity.ToDto()
+	}), nil
+}
+
+func (service *imageService) Open(ctx context.Context, recipeId, id uuid.UUID, thumbnail bool) (domain.ImageContent, error) {
+	entity, err := service.findById(ctx, recipeId, id)
+	if err != nil {
+		return domain.ImageContent{}, err
+	}
+
+	key, contentType := entity.BlobKey, entity.ContentType
+	if thumbnail {
+		key, contentType = entity.ThumbnailKey, thumbnailContentType(entity.ContentType)
+	}
+
+	content, err := service.blobStore.Get(ctx, key)
+	if err != nil {
+		log.Err(err).
+			Str("id", id.String()).
+			Str("key", key).
+			Msg("failed to open image")
+
+		if errors.Is(err, domain.ErrBlobNotFound) {
+			return domain.ImageContent{}, internalErrors.ImageNotFound(id)
+		}
+
+		return domain.ImageContent{}, internalErrors.NewInternalServerErrorWrap(err, "failed to open image")
+	}
+
+	return domain.ImageContent{ContentType: contentType, Content: content}, nil
+}
+
+func (service *imageService) Delete(ctx context.Context, recipeId, id uuid.UUID) error {
+	entity, err := service.findById(ctx, recipeId, id)
+	if err != nil {
+		return err
+	}
+
+	// blobs go first, a failure leaves the metadata in place so the delete can be retried
+	for _, key := range []string{entity.BlobKey, entity.ThumbnailKey} {
+		if err = service.blobStore.Delete(ctx, key); err != nil {
+			log.Err(err).
+				Str("id", id.String()).
+				Str("key", key).
+				Msg("failed to delete image content")
+
+			return internalErrors.NewInternalServerErrorWrap(err, "failed to delete image")
+		}
+	}
+
+	if err = service.repository.Delete(ctx, id); err != nil {
+		log.Err(err).
+			Str("id", id.String()).
+			Msg("failed to delete image")
+
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return internalErrors.ImageNotFound(id)
+		}
+
+		return internalErrors.NewInternalServerErrorWrap(err, "failed to delete image")
+	}
+
+	return nil
+}
+
+func (service *imageService) findById(ctx context.Context, recipeId, id uuid.UUID) (domain.ImageEntity, error) {
+	entity, err := service.repository.GetById(ctx, id)
+	if err != nil {
+		log.Err(err).
+			Str("id", id.String()).
+			Msg("failed to find image by id")
+
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return domain.ImageEntity{}, internalErrors.ImageNotFound(id)
+		}
+
+		return domain.ImageEntity{}, internalErrors.NewInternalServerErrorWrap(err, "failed to find image by id")
+	}
+
+	if entity.RecipeId != recipeId {
+		return domain.ImageEntity{}, internalErrors.ImageNotFound(id)
+	}
+
+	return entity, nil
+}
+
+func (service *imageService) deleteBlobs(ctx context.Context, keys ...string) {
+	for _, key := range keys {
+		if err := service.blobStore.Delete(ctx, key); err != nil {
+			log.Err(err).
+				Str("key", key).
+				Msg("failed to clean up image content")
+		}
+	}
+}
+
+// encodeThumbnail scales the image to fit a square of the given size. JPEG sources stay JPEG,
+// PNG and GIF sources become PNG to keep transparency.
+func encodeThumbnail(source image.Image, contentType string, size int) ([]byte, error) {
+	thumbnail := resize(source, size)
+
+	var buffer bytes.Buffer
+	var err error
+	if thumbnailContentType(contentType) == "image/jpeg" {
+		err = jpeg.Encode(&buffer, thumbnail, &jpeg.Options{Quality: 85})
+	} else {
+		err = png.Encode(&buffer, thumbnail)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode thumbnail")
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func thumbnailContentType(contentType string) string {
+	if contentType == "image/jpeg" {
+		return "image/jpeg"
+	}
+	return "image/png"
+}
+
+// resize downscales with an area average, images already within the size are only copied.
+func resize(source image.Image, size int) image.Image {
+	bounds := source.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	targetWidth, targetHeight := width, height
+	if width > size || height > size {
+		if width >= height {
+			targetWidth, targetHeight = size, max(1, height*size/width)
+		} else {
+			targetWidth, targetHeight = max(1, width*size/height), size
+		}
+	}
+
+	target := image.NewRGBA(image.Rect(0, 0, targetWidth, targetHeight))
+	for y := 0; y < targetHeight; y++ {
+		fromY, toY := sourceSpan(bounds.Min.Y, y, height, targetHeight)
+		for x := 0; x < targetWidth; x++ {
+			fromX, toX := sourceSpan(bounds.Min.X, x, width, targetWidth)
+
+			var r, g, b, a, count uint64
+			for sy := fromY; sy < toY; sy++ {
+				for sx := fromX; sx < toX; sx++ {
+					pr, pg, pb, pa := source.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(pr), g+uint64(pg), b+uint64(pb), a+uint64(pa)
+					count++
+				}
+			}
+
+			target.SetRGBA64(x, y, color.RGBA64{
+				R: uint16(r / count),
+				G: uint16(g / count),
+				B: uint16(b / count),
+				A: uint16(a / count),
+			})
+		}
+	}
+
+	return target
+}
+
+// sourceSpan returns the source pixel range covered by a target pixel, at least one pixel wide.
+func sourceSpan(origin, position, sourceLength, targetLength int) (int, int) {
+	from := position * sourceLength / targetLength
+	to := (position + 1) * sourceLength / targetLength
+	if to <= from {
+		to = from + 1
+	}
+	return origin + from, origin + to
+}
+
+func NewImageService(
+	repository domain.ImageRepository,
+	blobStore domain.BlobStore,
+	sourdoughRecipeService domain.SourdoughRecipeService,
+	bakeLogService domain.BakeLogService,
+	storage config.Storage,
+) (domain.ImageService, error) {
+	if repository == nil {
+		return nil, errors.New("repository cannot be nil")
+	}
+
+	if blobStore == nil {
+		return nil, errors.New("blobStore cannot be nil")
+	}
+
+	if sourdoughRecipeService == nil {
+		return nil, errors.New("sourdoughRecipeService cannot be nil")
+	}
+
+	if bakeLogService == nil {
+		return nil, errors.New("bakeLogService cannot be nil")
+	}
+
+	return &imageService{
+		repository:             repository,
+		blobStore:              blobStore,
+		sourdoughRecipeService: sourdoughRecipeService,
+		bakeLogService:         bakeLogService,
+		storage:                storage,
+	}, nil
+}
@@ -0,0 +1,558 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/config"
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func TestImageServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ImageServiceTestSuite))
+}
+
+type ImageServiceTestSuite struct {
+	test.GoMockTestSuite
+
+	ctx                    context.Context
+	repository             *mocks.MockImageRepository
+	blobStore              *mocks.MockBlobStore
+	sourdoughRecipeService *mocks.MockSourdoughRecipeService
+	bakeLogService         *mocks.MockBakeLogService
+
+	target domain.ImageService
+}
+
+func (suite *ImageServiceTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.ctx = context.Background()
+	suite.repository = mocks.NewMockImageRepository(suite.MockCtrl)
+	suite.blobStore = mocks.NewMockBlobStore(suite.MockCtrl)
+	suite.sourdoughRecipeService = mocks.NewMockSourdoughRecipeService(suite.MockCtrl)
+	suite.bakeLogService = mocks.NewMockBakeLogService(suite.MockCtrl)
+
+	suite.target = test.Must(func() (domain.ImageService, error) {
+		return NewImageService(suite.repository, suite.blobStore, suite.sourdoughRecipeService, suite.bakeLogService, config.Storage{
+			MaxImageSize:  1 << 20,
+			ThumbnailSize: 100,
+		})
+	})
+}
+
+func (suite *ImageServiceTestSuite) TestUpload() {
+	content := encodePng(suite.T(), 400, 200)
+	var thumbnail []byte
+
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, test.ThirdId).
+		Return(domain.SourdoughRecipeDto{}, nil)
+	suite.blobStore.EXPECT().Put(suite.ctx, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, key string, reader io.Reader) error {
+			suite.Regexp("^images/"+test.ThirdId.String()+"/[0-9a-f-]{36}$", key)
+			actual, err := io.ReadAll(reader)
+			suite.NoError(err)
+			suite.Equal(content, actual)
+			return nil
+		})
+	suite.blobStore.EXPECT().Put(suite.ctx, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, key string, reader io.Reader) (err error) {
+			suite.Regexp("^images/"+test.ThirdId.String()+"/[0-9a-f-]{36}-thumbnail$", key)
+			thumbnail, err = io.ReadAll(reader)
+			return err
+		})
+	suite.repository.EXPECT().Create(suite.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entity domain.ImageEntity) (domain.ImageEntity, error) {
+			suite.NotEmpty(entity.Id)
+			suite.NotEmpty(entity.CreatedAt)
+			suite.Equal(test.ThirdId, entity.RecipeId)
+			suite.Nil(entity.BakeLogId)
+			suite.Equal("crumb.png", entity.FileName)
+			suite.Equal("image/png", entity.ContentType)
+			suite.Equal(int64(len(content)), entity.Size)
+			suite.Equal(400, entity.Width)
+			suite.Equal(200, entity.Height)
+			suite.Equal("images/"+test.ThirdId.String()+"/"+entity.Id.String(), entity.BlobKey)
+			suite.Equal(entity.BlobKey+"-thumbnail", entity.ThumbnailKey)
+			return entity, nil
+		})
+
+	result, err := suite.target.Upload(suite.ctx, test.ThirdId, domain.UploadImageRequest{
+		FileName: "crumb.png",
+		Content:  content,
+	})
+
+	suite.NoError(err)
+	suite.Equal(test.ThirdId, result.RecipeId)
+	suite.Equal("image/png", result.ContentType)
+
+	decoded, format, err := image.Decode(bytes.NewReader(thumbnail))
+	suite.Require().NoError(err)
+	suite.Equal("png", format)
+	suite.Equal(image.Rect(0, 0, 100, 50), decoded.Bounds())
+}
+
+func (suite *ImageServiceTestSuite) TestUpload_WithBakeLog() {
+	bakeLogId := test.FirstId
+
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, test.ThirdId).
+		Return(domain.SourdoughRecipeDto{}, nil)
+	suite.bakeLogService.EXPECT().FindById(suite.ctx, test.ThirdId, bakeLogId).
+		Return(domain.BakeLogDto{}, nil)
+	suite.blobStore.EXPECT().Put(suite.ctx, gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	suite.repository.EXPECT().Create(suite.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entity domain.ImageEntity) (domain.ImageEntity, error) {
+			return entity, nil
+		})
+
+	result, err := suite.target.Upload(suite.ctx, test.ThirdId, domain.UploadImageRequest{
+		BakeLogId: &bakeLogId,
+		FileName:  "crumb.jpg",
+		Content:   encodeJpeg(suite.T(), 50, 80),
+	})
+
+	suite.NoError(err)
+	suite.Equal(&bakeLogId, result.BakeLogId)
+	suite.Equal("image/jpeg", result.ContentType)
+	suite.Equal(50, result.Width)
+	suite.Equal(80, result.Height)
+}
+
+func (suite *ImageServiceTestSuite) TestUpload_WithInvalidContent() {
+	tests := []struct {
+		name          string
+		content       []byte
+		expectedError error
+	}{
+		{
+			name:          "empty content",
+			content:       nil,
+			expectedError: internalErrors.ImageInvalid("image is empty"),
+		},
+		{
+			name:          "content exceeding the limit",
+			content:       make([]byte, 1<<20+1),
+			expectedError: internalErrors.ImageTooLarge(1<<20+1, 1<<20),
+		},
+		{
+			name:          "unsupported content type",
+			content:       []byte("plain text is not an image"),
+			expectedError: internalErrors.ImageUnsupportedContentType("text/plain; charset=utf-8"),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			result, err := suite.target.Upload(suite.ctx, test.ThirdId, domain.UploadImageRequest{Content: tt.content})
+
+			suite.Equal(tt.expectedError, err)
+			suite.Empty(result)
+		})
+	}
+}
+
+func (suite *ImageServiceTestSuite) TestUpload_WithCorruptedImage() {
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, test.ThirdId).
+		Return(domain.SourdoughRecipeDto{}, nil)
+
+	result, err := suite.target.Upload(suite.ctx, test.ThirdId, domain.UploadImageRequest{
+		Content: []byte("\x89PNG\r\n\x1a\ncorrupted"),
+	})
+
+	suite.Equal(internalErrors.ImageInvalid("failed to read image header"), err)
+	suite.Empty(result)
+}
+
+func (suite *ImageServiceTestSuite) TestUpload_WithErrorOnFindRecipe() {
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, test.ThirdId).
+		Return(domain.SourdoughRecipeDto{}, internalErrors.SourdoughRecipeNotFound(test.ThirdId.String()))
+
+	result, err := suite.target.Upload(suite.ctx, test.ThirdId, domain.UploadImageRequest{Content: encodePng(suite.T(), 10, 10)})
+
+	suite.Equal(internalErrors.SourdoughRecipeNotFound(test.ThirdId.String()), err)
+	suite.Empty(result)
+}
+
+func (suite *ImageServiceTestSuite) TestUpload_WithErrorOnFindBakeLog() {
+	bakeLogId := test.FirstId
+
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, test.ThirdId).
+		Return(domain.SourdoughRecipeDto{}, nil)
+	suite.bakeLogService.EXPECT().FindById(suite.ctx, test.ThirdId, bakeLogId).
+		Return(domain.BakeLogDto{}, internalErrors.BakeLogNotFound(bakeLogId))
+
+	result, err := suite.target.Upload(suite.ctx, test.ThirdId, domain.UploadImageRequest{
+		BakeLogId: &bakeLogId,
+		Content:   encodePng(suite.T(), 10, 10),
+	})
+
+	suite.Equal(internalErrors.BakeLogNotFound(bakeLogId), err)
+	suite.Empty(result)
+}
+
+func (suite *ImageServiceTestSuite) TestUpload_WithErrorOnStoreImage() {
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, test.ThirdId).
+		Return(domain.SourdoughRecipeDto{}, nil)
+	suite.blobStore.EXPECT().Put(suite.ctx, gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+	result, err := suite.target.Upload(suite.ctx, test.ThirdId, domain.UploadImageRequest{Content: encodePng(suite.T(), 10, 10)})
+
+	suite.Equal(internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to store image"), err)
+	suite.Empty(result)
+}
+
+func (suite *ImageServiceTestSuite) TestUpload_WithErrorOnStoreThumbnail_ShouldDeleteImage() {
+	var imageKey string
+
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, test.ThirdId).
+		Return(domain.SourdoughRecipeDto{}, nil)
+	suite.blobStore.EXPECT().Put(suite.ctx, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, key string, _ io.Reader) error {
+			imageKey = key
+			return nil
+		})
+	suite.blobStore.EXPECT().Put(suite.ctx, gomock.Any(), gomock.Any()).Return(assert.AnError)
+	suite.blobStore.EXPECT().Delete(suite.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, key string) error {
+			suite.Equal(imageKey, key)
+			return nil
+		})
+
+	result, err := suite.target.Upload(suite.ctx, test.ThirdId, domain.UploadImageRequest{Content: encodePng(suite.T(), 10, 10)})
+
+	suite.Equal(internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to store thumbnail"), err)
+	suite.Empty(result)
+}
+
+func (suite *ImageServiceTestSuite) TestUpload_WithErrorOnCreate_ShouldDeleteBlobs() {
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, test.ThirdId).
+		Return(domain.SourdoughRecipeDto{}, nil)
+	suite.blobStore.EXPECT().Put(suite.ctx, gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	suite.repository.EXPECT().Create(suite.ctx, gomock.Any()).
+		Return(domain.ImageEntity{}, assert.AnError)
+	suite.blobStore.EXPECT().Delete(suite.ctx, gomock.Any()).Return(nil).Times(2)
+
+	result, err := suite.target.Upload(suite.ctx, test.ThirdId, domain.UploadImageRequest{Content: encodePng(suite.T(), 10, 10)})
+
+	suite.Equal(internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to create image"), err)
+	suite.Empty(result)
+}
+
+func (suite *ImageServiceTestSuite) TestFindByRecipeId() {
+	entity := createImageEntity()
+	bakeLogId := test.FirstId
+
+	suite.repository.EXPECT().FindByRecipeId(suite.ctx, test.ThirdId, &bakeLogId, 0, 10).
+		Return([]domain.ImageEntity{entity}, nil)
+
+	result, err := suite.target.FindByRecipeId(suite.ctx, test.ThirdId, &bakeLogId, 0, 10)
+
+	suite.NoError(err)
+	suite.Equal([]domain.ImageDto{entity.ToDto()}, result)
+}
+
+func (suite *ImageServiceTestSuite) TestFindByRecipeId_WithError() {
+	suite.repository.EXPECT().FindByRecipeId(suite.ctx, test.ThirdId, nil, 0, 10).
+		Return(nil, assert.AnError)
+
+	result, err := suite.target.FindByRecipeId(suite.ctx, test.ThirdId, nil, 0, 10)
+
+	suite.Equal(internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to find images"), err)
+	suite.Nil(result)
+}
+
+func (suite *ImageServiceTestSuite) TestOpen() {
+	tests := []struct {
+		name                string
+		contentType         string
+		thumbnail           bool
+		expectedKey         string
+		expectedContentType string
+	}{
+		{
+			name:                "original",
+			contentType:         "image/gif",
+			expectedKey:         "images/key",
+			expectedContentType: "image/gif",
+		},
+		{
+			name:                "png thumbnail of gif",
+			contentType:         "image/gif",
+			thumbnail:           true,
+			expectedKey:         "images/key-thumbnail",
+			expectedContentType: "image/png",
+		},
+		{
+			name:                "jpeg thumbnail of jpeg",
+			contentType:         "image/jpeg",
+			thumbnail:           true,
+			expectedKey:         "images/key-thumbnail",
+			expectedContentType: "image/jpeg",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			entity := createImageEntity()
+			entity.ContentType = tt.contentType
+			content := io.NopCloser(bytes.NewReader([]byte("content")))
+
+			suite.repository.EXPECT().GetById(suite.ctx, test.SecondId).Return(entity, nil)
+			suite.blobStore.EXPECT().Get(suite.ctx, tt.expectedKey).Return(content, nil)
+
+			result, err := suite.target.Open(suite.ctx, test.ThirdId, test.SecondId, tt.thumbnail)
+
+			suite.NoError(err)
+			suite.Equal(domain.ImageContent{ContentType: tt.expectedContentType, Content: content}, result)
+		})
+	}
+}
+
+func (suite *ImageServiceTestSuite) TestOpen_WithError() {
+	tests := []struct {
+		name          string
+		mocks         func()
+		expectedError error
+	}{
+		{
+			name: "image not found",
+			mocks: func() {
+				suite.repository.EXPECT().GetById(suite.ctx, test.SecondId).Return(domain.ImageEntity{}, mongo.ErrNoDocuments)
+			},
+			expectedError: internalErrors.ImageNotFound(test.SecondId),
+		},
+		{
+			name: "error on find image",
+			mocks: func() {
+				suite.repository.EXPECT().GetById(suite.ctx, test.SecondId).Return(domain.ImageEntity{}, assert.AnError)
+			},
+			expectedError: internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to find image by id"),
+		},
+		{
+			name: "image of another recipe",
+			mocks: func() {
+				entity := createImageEntity()
+				entity.RecipeId = test.FirstId
+				suite.repository.EXPECT().GetById(suite.ctx, test.SecondId).Return(entity, nil)
+			},
+			expectedError: internalErrors.ImageNotFound(test.SecondId),
+		},
+		{
+			name: "blob not found",
+			mocks: func() {
+				suite.repository.EXPECT().GetById(suite.ctx, test.SecondId).Return(createImageEntity(), nil)
+				suite.blobStore.EXPECT().Get(suite.ctx, "images/key").Return(nil, domain.ErrBlobNotFound)
+			},
+			expectedError: internalErrors.ImageNotFound(test.SecondId),
+		},
+		{
+			name: "error on open blob",
+			mocks: func() {
+				suite.repository.EXPECT().GetById(suite.ctx, test.SecondId).Return(createImageEntity(), nil)
+				suite.blobStore.EXPECT().Get(suite.ctx, "images/key").Return(nil, assert.AnError)
+			},
+			expectedError: internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to open image"),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mocks()
+
+			result, err := suite.target.Open(suite.ctx, test.ThirdId, test.SecondId, false)
+
+			suite.Equal(tt.expectedError, err)
+			suite.Empty(result)
+		})
+	}
+}
+
+func (suite *ImageServiceTestSuite) TestDelete() {
+	suite.repository.EXPECT().GetById(suite.ctx, test.SecondId).Return(createImageEntity(), nil)
+	suite.blobStore.EXPECT().Delete(suite.ctx, "images/key").Return(nil)
+	suite.blobStore.EXPECT().Delete(suite.ctx, "images/key-thumbnail").Return(nil)
+	suite.repository.EXPECT().Delete(suite.ctx, test.SecondId).Return(nil)
+
+	err := suite.target.Delete(suite.ctx, test.ThirdId, test.SecondId)
+
+	suite.NoError(err)
+}
+
+func (suite *ImageServiceTestSuite) TestDelete_WithError() {
+	tests := []struct {
+		name          string
+		mocks         func()
+		expectedError error
+	}{
+		{
+			name: "image not found",
+			mocks: func() {
+				suite.repository.EXPECT().GetById(suite.ctx, test.SecondId).Return(domain.ImageEntity{}, mongo.ErrNoDocuments)
+			},
+			expectedError: internalErrors.ImageNotFound(test.SecondId),
+		},
+		{
+			name: "error on delete blob",
+			mocks: func() {
+				suite.repository.EXPECT().GetById(suite.ctx, test.SecondId).Return(createImageEntity(), nil)
+				suite.blobStore.EXPECT().Delete(suite.ctx, "images/key").Return(assert.AnError)
+			},
+			expectedError: internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to delete image"),
+		},
+		{
+			name: "error on delete metadata",
+			mocks: func() {
+				suite.repository.EXPECT().GetById(suite.ctx, test.SecondId).Return(createImageEntity(), nil)
+				suite.blobStore.EXPECT().Delete(suite.ctx, gomock.Any()).Return(nil).Times(2)
+				suite.repository.EXPECT().Delete(suite.ctx, test.SecondId).Return(assert.AnError)
+			},
+			expectedError: internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to delete image"),
+		},
+		{
+			name: "metadata deleted concurrently",
+			mocks: func() {
+				suite.repository.EXPECT().GetById(suite.ctx, test.SecondId).Return(createImageEntity(), nil)
+				suite.blobStore.EXPECT().Delete(suite.ctx, gomock.Any()).Return(nil).Times(2)
+				suite.repository.EXPECT().Delete(suite.ctx, test.SecondId).Return(mongo.ErrNoDocuments)
+			},
+			expectedError: internalErrors.ImageNotFound(test.SecondId),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mocks()
+
+			err := suite.target.Delete(suite.ctx, test.ThirdId, test.SecondId)
+
+			suite.Equal(tt.expectedError, err)
+		})
+	}
+}
+
+func TestResize(t *testing.T) {
+	tests := []struct {
+		name           string
+		width, height  int
+		expectedBounds image.Rectangle
+	}{
+		{name: "landscape", width: 400, height: 100, expectedBounds: image.Rect(0, 0, 200, 50)},
+		{name: "portrait", width: 100, height: 400, expectedBounds: image.Rect(0, 0, 50, 200)},
+		{name: "thin strip", width: 1000, height: 1, expectedBounds: image.Rect(0, 0, 200, 1)},
+		{name: "smaller than size", width: 80, height: 60, expectedBounds: image.Rect(0, 0, 80, 60)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := image.NewRGBA(image.Rect(0, 0, tt.width, tt.height))
+			fill(source, color.RGBA{R: 200, G: 100, B: 50, A: 255})
+
+			result := resize(source, 200)
+
+			assert.Equal(t, tt.expectedBounds, result.Bounds())
+			assert.Equal(t, color.RGBA{R: 200, G: 100, B: 50, A: 255}, color.RGBAModel.Convert(result.At(0, 0)))
+		})
+	}
+}
+
+func TestNewImageService_WithNilDependencies(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repository := mocks.NewMockImageRepository(mockCtrl)
+	blobStore := mocks.NewMockBlobStore(mockCtrl)
+	sourdoughRecipeService := mocks.NewMockSourdoughRecipeService(mockCtrl)
+
+	tests := []struct {
+		name     string
+		creator  func() (domain.ImageService, error)
+		errorMsg string
+	}{
+		{
+			name: "repository",
+			creator: func() (domain.ImageService, error) {
+				return NewImageService(nil, nil, nil, nil, config.Storage{})
+			},
+			errorMsg: "repository cannot be nil",
+		},
+		{
+			name: "blobStore",
+			creator: func() (domain.ImageService, error) {
+				return NewImageService(repository, nil, nil, nil, config.Storage{})
+			},
+			errorMsg: "blobStore cannot be nil",
+		},
+		{
+			name: "sourdoughRecipeService",
+			creator: func() (domain.ImageService, error) {
+				return NewImageService(repository, blobStore, nil, nil, config.Storage{})
+			},
+			errorMsg: "sourdoughRecipeService cannot be nil",
+		},
+		{
+			name: "bakeLogService",
+			creator: func() (domain.ImageService, error) {
+				return NewImageService(repository, blobStore, sourdoughRecipeService, nil, config.Storage{})
+			},
+			errorMsg: "bakeLogService cannot be nil",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, err := tt.creator()
+
+			assert.Nil(t, service)
+			assert.ErrorContains(t, err, tt.errorMsg)
+		})
+	}
+}
+
+func createImageEntity() domain.ImageEntity {
+	return domain.ImageEntity{
+		Id:           test.SecondId,
+		RecipeId:     test.ThirdId,
+		FileName:     "crumb.png",
+		ContentType:  "image/png",
+		Size:         1024,
+		Width:        640,
+		Height:       480,
+		BlobKey:      "images/key",
+		ThumbnailKey: "images/key-thumbnail",
+		CreatedAt:    test.Date,
+	}
+}
+
+func encodePng(t *testing.T, width, height int) []byte {
+	var buffer bytes.Buffer
+	assert.NoError(t, png.Encode(&buffer, image.NewRGBA(image.Rect(0, 0, width, height))))
+	return buffer.Bytes()
+}
+
+func encodeJpeg(t *testing.T, width, height int) []byte {
+	var buffer bytes.Buffer
+	assert.NoError(t, jpeg.Encode(&buffer, image.NewRGBA(image.Rect(0, 0, width, height)), nil))
+	return buffer.Bytes()
+}
+
+func fill(target *image.RGBA, c color.RGBA) {
+	for y := target.Bounds().Min.Y; y < target.Bounds().Max.Y; y++ {
+		for x := target.Bounds().Min.X; x < target.Bounds().Max.X; x++ {
+			target.SetRGBA(x, y, c)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
 
 	"dough-calculator/internal/domain"
 	"dough-calculator/internal/service"
@@ -14,11 +15,12 @@ import (
 )
 
 const (
-	testDb         = "test_db"
 	testCollection = "test_collection"
 )
 
 func TestMongoDBTestSuite(t *testing.T) {
+	t.Parallel()
+
 	suite.Run(t, &MongoDBTestSuite{
 		MongoDBDockerIntegrationTestSuite: test.NewMongoDBDockerIntegrationTestSuite(dockerStarter),
 	})
@@ -39,7 +41,7 @@ func (suite *MongoDBTestSuite) SetupSuite() {
 }
 
 func (suite *MongoDBTestSuite) AfterTest(suiteName, testName string) {
-	collection, err := suite.service.GetCollection(testDb, testCollection)
+	collection, err := suite.service.GetCollection(testCollection)
 	suite.Require().NoError(err)
 
 	err = collection.Drop(context.Background())
@@ -62,11 +64,11 @@ func (suite *MongoDBTestSuite) TestGetClient() {
 }
 
 func (suite *MongoDBTestSuite) TestGetDatabase() {
-	newDatabase, err := suite.service.GetDatabase(testDb)
+	newDatabase, err := suite.service.GetDatabase()
 	suite.Require().NoError(err)
 	suite.Require().NotNil(newDatabase)
 
-	existingDatabase, err := suite.service.GetDatabase(testDb)
+	existingDatabase, err := suite.service.GetDatabase()
 
 	suite.Require().NoError(err)
 	suite.Require().NotNil(existingDatabase)
@@ -75,14 +77,37 @@ func (suite *MongoDBTestSuite) TestGetDatabase() {
 }
 
 func (suite *MongoDBTestSuite) TestGetCollection() {
-	newCollection, err := suite.service.GetCollection(testDb, testCollection)
+	newCollection, err := suite.service.GetCollection(testCollection)
 	suite.Require().NoError(err)
 	suite.Require().NotNil(newCollection)
 
-	existingCollection, err := suite.service.GetCollection(testDb, testCollection)
+	existingCollection, err := suite.service.GetCollection(testCollection)
 
 	suite.Require().NoError(err)
 	suite.Require().NotNil(existingCollection)
 
 	suite.Equal(*newCollection, *existingCollection)
 }
+
+func (suite *MongoDBTestSuite) TestGetCollection_WithConfiguredNames() {
+	databaseConfig := suite.GetConfig()
+	databaseConfig.CollectionPrefix = "prefix_"
+	target := test.Must(func() (domain.MongoDBService, error) {
+		return service.NewMongoDBService(databaseConfig)
+	})
+	defer target.Disconnect()
+
+	collection, err := target.GetCollection(testCollection)
+	suite.Require().NoError(err)
+
+	_, err = collection.InsertOne(context.Background(), bson.D{{"name", "rye"}})
+	suite.Require().NoError(err)
+
+	client, err := target.GetClient()
+	suite.Require().NoError(err)
+	names, err := client.Database(databaseConfig.DatabaseName()).ListCollectionNames(context.Background(), bson.D{})
+	suite.NoError(err)
+	suite.Contains(names, "prefix_"+testCollection)
+
+	suite.NoError(collection.Drop(context.Background()))
+}
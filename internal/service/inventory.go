@@ -0,0 +1,272 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/utils"
+)
+
+type inventoryService struct {
+	transactionRunner           domain.TransactionRunner
+	repository                  domain.InventoryRepository
+	flourRepository             domain.FlourRepository
+	sourdoughRecipeScaleService domain.SourdoughRecipeScaleService
+}
+
+// Upsert sets the on-hand quantity of a flour of the catalogue or of another
+// ingredient, creating the item on its first use.
+func (service *inventoryService) Upsert(ctx context.Context, request domain.UpsertInventoryItemRequest) (domain.InventoryItemDto, error) {
+	item, err := service.validate(ctx, request)
+	if err != nil {
+		return domain.InventoryItemDto{}, err
+	}
+
+	existing, err := service.repository.GetByKey(ctx, item.Key)
+	switch {
+	case err == nil:
+		updatedAt := time.Now().UTC().Truncate(time.Millisecond)
+		item.Id = existing.Id
+		item.CreatedAt = existing.CreatedAt
+		item.UpdatedAt = &updatedAt
+	case errors.Is(err, mongo.ErrNoDocuments):
+		item.Id = uuid.New()
+		item.CreatedAt = time.Now().UTC().Truncate(time.Millisecond)
+	default:
+		return domain.InventoryItemDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to find inventory item")
+	}
+
+	upserted, err := service.repository.Upsert(ctx, item)
+	if err != nil {
+		log.Err(err).
+			Str("key", item.Key).
+			Msg("failed to upsert inventory item")
+
+		return domain.InventoryItemDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to upsert inventory item")
+	}
+
+	return upserted.ToDto(), nil
+}
+
+func (service *inventoryService) FindById(ctx context.Context, id uuid.UUID) (domain.InventoryItemDto, error) {
+	item, err := service.repository.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return domain.InventoryItemDto{}, internalErrors.InventoryItemNotFound(id)
+		}
+
+		return domain.InventoryItemDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to find inventory item")
+	}
+	return item.ToDto(), nil
+}
+
+func (service *inventoryService) Find(ctx context.Context, lowStock bool) ([]domain.InventoryItemDto, error) {
+	items, err := service.repository.Find(ctx, lowStock)
+	if err != nil {
+		log.Err(err).
+			Bool("low_stock", lowStock).
+			Msg("failed to find inventory items")
+
+		return nil, internalErrors.NewInternalServerErrorWrap(err, "failed to find inventory items")
+	}
+
+	return utils.Map(items, func(item domain.InventoryItemEntity) domain.InventoryItemDto {
+		return item.ToDto()
+	}), nil
+}
+
+func (service *inventoryService) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := service.repository.Delete(ctx, id); err != nil {
+		log.Err(err).
+			Str("id", id.String()).
+			Msg("failed to delete inventory item")
+
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return internalErrors.InventoryItemNotFound(id)
+		}
+
+		return internalErrors.NewInternalServerErrorWrap(err, "failed to delete inventory item")
+	}
+
+	return nil
+}
+
+// Deduct takes the flour and additional ingredients of the scaled recipes
+// off the pantry in one transaction, or in the transaction of the caller
+// when ctx belongs to one. Water and starter are not tracked.
+func (service *inventoryService) Deduct(ctx context.Context, recipes ...domain.SourdoughRecipeDto) error {
+	pickList := pickListOf(recipes)
+
+	deductions := make([]domain.InventoryDeduction, 0, len(pickList.Flour)+len(pickList.AdditionalIngredients))
+	for _, flour := range pickList.Flour {
+		deductions = append(deductions, domain.InventoryDeduction{Key: flour.Id.String(), Amount: flour.Amount})
+	}
+	for _, ingredient := range pickList.AdditionalIngredients {
+		deductions = append(deductions, domain.InventoryDeduction{Key: pickListKey(ingredient.Name), Amount: ingredient.Amount})
+	}
+
+	err := service.transactionRunner.WithTransaction(ctx, func(ctx context.Context) error {
+		return service.repository.Deduct(ctx, deductions)
+	})
+	if err != nil {
+		log.Err(err).
+			Int("recipes", len(recipes)).
+			Msg("failed to deduct inventory")
+
+		return internalErrors.NewInternalServerErrorWrap(err, "failed to deduct inventory")
+	}
+
+	return nil
+}
+
+// Shortages scales the batch and lists every flour and additional ingredient
+// of its pick list the pantry holds less of than required.
+func (service *inventoryService) Shortages(
+	ctx context.Context,
+	request domain.SourdoughRecipeBatchScaleRequest,
+) (domain.InventoryShortagesDto, error) {
+	batch, err := service.sourdoughRecipeScaleService.ScaleBatch(ctx, request)
+	if err != nil {
+		return domain.InventoryShortagesDto{}, err
+	}
+
+	keys := make([]string, 0, len(batch.PickList.Flour)+len(batch.PickList.AdditionalIngredients))
+	for _, flour := range batch.PickList.Flour {
+		keys = append(keys, flour.Id.String())
+	}
+	for _, ingredient := range batch.PickList.AdditionalIngredients {
+		keys = append(keys, pickListKey(ingredient.Name))
+	}
+
+	items, err := service.repository.FindByKeys(ctx, keys)
+	if err != nil {
+		log.Err(err).
+			Msg("failed to find inventory items")
+
+		return domain.InventoryShortagesDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to find inventory items")
+	}
+
+	onHand := make(map[string]domain.InventoryItemEntity, len(items))
+	for _, item := range items {
+		onHand[item.Key] = item
+	}
+
+	shortage := func(key, kind string, flourId *uuid.UUID, name string, required float64) *domain.InventoryShortageDto {
+		item, tracked := onHand[key]
+		if tracked && item.Quantity >= required {
+			return nil
+		}
+		return &domain.InventoryShortageDto{
+			Kind:     kind,
+			FlourId:  flourId,
+			Name:     name,
+			Required: required,
+			OnHand:   item.Quantity,
+			Missing:  math.Round((required-item.Quantity)*100) / 100,
+			Tracked:  tracked,
+		}
+	}
+
+	shortages := make([]domain.InventoryShortageDto, 0)
+	for _, flour := range batch.PickList.Flour {
+		flourId := flour.Id
+		if missing := shortage(flourId.String(), domain.InventoryKindFlour, &flourId, flour.Name, flour.Amount); missing != nil {
+			shortages = append(shortages, *missing)
+		}
+	}
+	for _, ingredient := range batch.PickList.AdditionalIngredients {
+		if missing := shortage(pickListKey(ingredient.Name), domain.InventoryKindIngredient, nil, ingredient.Name, ingredient.Amount); missing != nil {
+			shortages = append(shortages, *missing)
+		}
+	}
+
+	return domain.InventoryShortagesDto{PickList: batch.PickList, Shortages: shortages}, nil
+}
+
+// validate checks the request and builds the item it sets, keyed by the
+// flour id for flours of the catalogue and by the name otherwise.
+func (service *inventoryService) validate(
+	ctx context.Context,
+	request domain.UpsertInventoryItemRequest,
+) (domain.InventoryItemEntity, error) {
+	name := pickListKey(request.Name)
+	switch {
+	case request.FlourId == nil && name == "":
+		return domain.InventoryItemEntity{}, internalErrors.InventoryItemInvalid("flour id or name is required")
+	case request.FlourId != nil && name != "":
+		return domain.InventoryItemEntity{}, internalErrors.InventoryItemInvalid("only one of flour id and name may be set")
+	case request.Quantity < 0:
+		return domain.InventoryItemEntity{}, internalErrors.InventoryItemInvalid(
+			fmt.Sprintf("quantity %.2f must not be negative", request.Quantity))
+	case request.LowStockThreshold < 0:
+		return domain.InventoryItemEntity{}, internalErrors.InventoryItemInvalid(
+			fmt.Sprintf("low stock threshold %.2f must not be negative", request.LowStockThreshold))
+	}
+
+	item := domain.InventoryItemEntity{
+		Quantity:          request.Quantity,
+		LowStockThreshold: request.LowStockThreshold,
+	}
+
+	if request.FlourId == nil {
+		item.Key = name
+		item.Kind = domain.InventoryKindIngredient
+		item.Name = strings.TrimSpace(request.Name)
+		return item, nil
+	}
+
+	flour, err := service.flourRepository.FindById(ctx, *request.FlourId)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return domain.InventoryItemEntity{}, internalErrors.FlourByIdNotFound(*request.FlourId)
+		}
+
+		return domain.InventoryItemEntity{}, internalErrors.NewInternalServerErrorWrap(err, "failed to find flour by id")
+	}
+
+	item.Key = request.FlourId.String()
+	item.Kind = domain.InventoryKindFlour
+	item.FlourId = request.FlourId
+	item.Name = flour.Name
+	return item, nil
+}
+
+func NewInventoryService(
+	transactionRunner domain.TransactionRunner,
+	repository domain.InventoryRepository,
+	flourRepository domain.FlourRepository,
+	sourdoughRecipeScaleService domain.SourdoughRecipeScaleService,
+) (domain.InventoryService, error) {
+	if transactionRunner == nil {
+		return nil, errors.New("transactionRunner cannot be nil")
+	}
+
+	if repository == nil {
+		return nil, errors.New("repository cannot be nil")
+	}
+
+	if flourRepository == nil {
+		return nil, errors.New("flourRepository cannot be nil")
+	}
+
+	if sourdoughRecipeScaleService == nil {
+		return nil, errors.New("sourdoughRecipeScaleService cannot be nil")
+	}
+
+	return &inventoryService{
+		transactionRunner:           transactionRunner,
+		repository:                  repository,
+		flourRepository:             flourRepository,
+		sourdoughRecipeScaleService: sourdoughRecipeScaleService,
+	}, nil
+}
@@ -0,0 +1,507 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func TestInventoryServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(InventoryServiceTestSuite))
+}
+
+type InventoryServiceTestSuite struct {
+	test.GoMockTestSuite
+
+	ctx                         context.Context
+	transactionRunner           *mocks.MockTransactionRunner
+	repository                  *mocks.MockInventoryRepository
+	flourRepository             *mocks.MockFlourRepository
+	sourdoughRecipeScaleService *mocks.MockSourdoughRecipeScaleService
+
+	target domain.InventoryService
+}
+
+func (suite *InventoryServiceTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.ctx = context.Background()
+	suite.transactionRunner = mocks.NewMockTransactionRunner(suite.MockCtrl)
+	suite.repository = mocks.NewMockInventoryRepository(suite.MockCtrl)
+	suite.flourRepository = mocks.NewMockFlourRepository(suite.MockCtrl)
+	suite.sourdoughRecipeScaleService = mocks.NewMockSourdoughRecipeScaleService(suite.MockCtrl)
+
+	suite.target = test.Must(func() (domain.InventoryService, error) {
+		return NewInventoryService(suite.transactionRunner, suite.repository, suite.flourRepository, suite.sourdoughRecipeScaleService)
+	})
+}
+
+func (suite *InventoryServiceTestSuite) TestUpsert_WithNewFlour() {
+	flourId := test.FirstId
+
+	suite.flourRepository.EXPECT().FindById(suite.ctx, flourId).
+		Return(domain.FlourEntity{Id: flourId, Name: "Bread flour"}, nil)
+	suite.repository.EXPECT().GetByKey(suite.ctx, flourId.String()).
+		Return(domain.InventoryItemEntity{}, mongo.ErrNoDocuments)
+
+	var savedEntity domain.InventoryItemEntity
+	suite.repository.EXPECT().Upsert(suite.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entity domain.InventoryItemEntity) (domain.InventoryItemEntity, error) {
+			savedEntity = entity
+			return entity, nil
+		})
+
+	actual, err := suite.target.Upsert(suite.ctx, domain.UpsertInventoryItemRequest{
+		FlourId:           &flourId,
+		Quantity:          5000,
+		LowStockThreshold: 1000,
+	})
+
+	suite.NoError(err)
+	suite.Equal(savedEntity.ToDto(), actual)
+	suite.NotEqual(uuid.UUID{}, savedEntity.Id)
+	suite.Equal(flourId.String(), savedEntity.Key)
+	suite.Equal(domain.InventoryKindFlour, savedEntity.Kind)
+	suite.Equal(&flourId, savedEntity.FlourId)
+	suite.Equal("Bread flour", savedEntity.Name)
+	suite.Equal(5000.0, savedEntity.Quantity)
+	suite.Equal(1000.0, savedEntity.LowStockThreshold)
+	suite.False(savedEntity.CreatedAt.IsZero())
+	suite.Nil(savedEntity.UpdatedAt)
+}
+
+func (suite *InventoryServiceTestSuite) TestUpsert_WithExistingIngredient() {
+	existing := domain.InventoryItemEntity{
+		Id:        test.SecondId,
+		Key:       "salt",
+		Kind:      domain.InventoryKindIngredient,
+		Name:      "Salt",
+		Quantity:  100,
+		CreatedAt: test.Date,
+	}
+
+	suite.repository.EXPECT().GetByKey(suite.ctx, "salt").Return(existing, nil)
+
+	var savedEntity domain.InventoryItemEntity
+	suite.repository.EXPECT().Upsert(suite.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, entity domain.InventoryItemEntity) (domain.InventoryItemEntity, error) {
+			savedEntity = entity
+			return entity, nil
+		})
+
+	actual, err := suite.target.Upsert(suite.ctx, domain.UpsertInventoryItemRequest{Name: " Salt ", Quantity: 1000})
+
+	suite.NoError(err)
+	suite.Equal(savedEntity.ToDto(), actual)
+	suite.Equal(test.SecondId, savedEntity.Id)
+	suite.Equal("salt", savedEntity.Key)
+	suite.Equal(domain.InventoryKindIngredient, savedEntity.Kind)
+	suite.Nil(savedEntity.FlourId)
+	suite.Equal("Salt", savedEntity.Name)
+	suite.Equal(1000.0, savedEntity.Quantity)
+	suite.Equal(test.Date, savedEntity.CreatedAt)
+	suite.NotNil(savedEntity.UpdatedAt)
+}
+
+func (suite *InventoryServiceTestSuite) TestUpsert_WithInvalidRequest() {
+	flourId := test.FirstId
+
+	tests := []struct {
+		name          string
+		request       domain.UpsertInventoryItemRequest
+		expectedError error
+	}{
+		{
+			name:          "neither flour id nor name",
+			request:       domain.UpsertInventoryItemRequest{Name: "  ", Quantity: 10},
+			expectedError: internalErrors.InventoryItemInvalid("flour id or name is required"),
+		},
+		{
+			name:          "flour id and name",
+			request:       domain.UpsertInventoryItemRequest{FlourId: &flourId, Name: "Salt"},
+			expectedError: internalErrors.InventoryItemInvalid("only one of flour id and name may be set"),
+		},
+		{
+			name:          "negative quantity",
+			request:       domain.UpsertInventoryItemRequest{Name: "Salt", Quantity: -1},
+			expectedError: internalErrors.InventoryItemInvalid("quantity -1.00 must not be negative"),
+		},
+		{
+			name:          "negative low stock threshold",
+			request:       domain.UpsertInventoryItemRequest{Name: "Salt", LowStockThreshold: -5},
+			expectedError: internalErrors.InventoryItemInvalid("low stock threshold -5.00 must not be negative"),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			actual, err := suite.target.Upsert(suite.ctx, tt.request)
+
+			suite.Equal(tt.expectedError, err)
+			suite.Empty(actual)
+		})
+	}
+}
+
+func (suite *InventoryServiceTestSuite) TestUpsert_WithError() {
+	flourId := test.FirstId
+
+	tests := []struct {
+		name          string
+		request       domain.UpsertInventoryItemRequest
+		mocks         func()
+		expectedError error
+	}{
+		{
+			name:    "flour not found",
+			request: domain.UpsertInventoryItemRequest{FlourId: &flourId},
+			mocks: func() {
+				suite.flourRepository.EXPECT().FindById(suite.ctx, flourId).
+					Return(domain.FlourEntity{}, mongo.ErrNoDocuments)
+			},
+			expectedError: internalErrors.FlourByIdNotFound(flourId),
+		},
+		{
+			name:    "find flour fails",
+			request: domain.UpsertInventoryItemRequest{FlourId: &flourId},
+			mocks: func() {
+				suite.flourRepository.EXPECT().FindById(suite.ctx, flourId).
+					Return(domain.FlourEntity{}, assert.AnError)
+			},
+			expectedError: internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to find flour by id"),
+		},
+		{
+			name:    "find item fails",
+			request: domain.UpsertInventoryItemRequest{Name: "Salt"},
+			mocks: func() {
+				suite.repository.EXPECT().GetByKey(suite.ctx, "salt").
+					Return(domain.InventoryItemEntity{}, assert.AnError)
+			},
+			expectedError: internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to find inventory item"),
+		},
+		{
+			name:    "upsert fails",
+			request: domain.UpsertInventoryItemRequest{Name: "Salt"},
+			mocks: func() {
+				suite.repository.EXPECT().GetByKey(suite.ctx, "salt").
+					Return(domain.InventoryItemEntity{}, mongo.ErrNoDocuments)
+				suite.repository.EXPECT().Upsert(suite.ctx, gomock.Any()).
+					Return(domain.InventoryItemEntity{}, assert.AnError)
+			},
+			expectedError: internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to upsert inventory item"),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mocks()
+
+			actual, err := suite.target.Upsert(suite.ctx, tt.request)
+
+			suite.Equal(tt.expectedError, err)
+			suite.Empty(actual)
+		})
+	}
+}
+
+func (suite *InventoryServiceTestSuite) TestFindById() {
+	entity := createInventoryItemEntity()
+
+	suite.repository.EXPECT().GetById(suite.ctx, entity.Id).Return(entity, nil)
+
+	actual, err := suite.target.FindById(suite.ctx, entity.Id)
+
+	suite.NoError(err)
+	suite.Equal(entity.ToDto(), actual)
+}
+
+func (suite *InventoryServiceTestSuite) TestFindById_WithError() {
+	tests := []struct {
+		name          string
+		err           error
+		expectedError error
+	}{
+		{
+			name:          "not found",
+			err:           mongo.ErrNoDocuments,
+			expectedError: internalErrors.InventoryItemNotFound(test.FirstId),
+		},
+		{
+			name:          "repository error",
+			err:           assert.AnError,
+			expectedError: internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to find inventory item"),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.repository.EXPECT().GetById(suite.ctx, test.FirstId).
+				Return(domain.InventoryItemEntity{}, tt.err)
+
+			actual, err := suite.target.FindById(suite.ctx, test.FirstId)
+
+			suite.Equal(tt.expectedError, err)
+			suite.Empty(actual)
+		})
+	}
+}
+
+func (suite *InventoryServiceTestSuite) TestFind() {
+	entity := createInventoryItemEntity()
+
+	suite.repository.EXPECT().Find(suite.ctx, true).Return([]domain.InventoryItemEntity{entity}, nil)
+
+	actual, err := suite.target.Find(suite.ctx, true)
+
+	suite.NoError(err)
+	suite.Equal([]domain.InventoryItemDto{entity.ToDto()}, actual)
+}
+
+func (suite *InventoryServiceTestSuite) TestFind_WithError() {
+	suite.repository.EXPECT().Find(suite.ctx, false).Return(nil, assert.AnError)
+
+	actual, err := suite.target.Find(suite.ctx, false)
+
+	suite.Equal(internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to find inventory items"), err)
+	suite.Nil(actual)
+}
+
+func (suite *InventoryServiceTestSuite) TestDelete() {
+	suite.repository.EXPECT().Delete(suite.ctx, test.FirstId).Return(nil)
+
+	suite.NoError(suite.target.Delete(suite.ctx, test.FirstId))
+}
+
+func (suite *InventoryServiceTestSuite) TestDelete_WithError() {
+	tests := []struct {
+		name          string
+		err           error
+		expectedError error
+	}{
+		{
+			name:          "not found",
+			err:           mongo.ErrNoDocuments,
+			expectedError: internalErrors.InventoryItemNotFound(test.FirstId),
+		},
+		{
+			name:          "repository error",
+			err:           assert.AnError,
+			expectedError: internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to delete inventory item"),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.repository.EXPECT().Delete(suite.ctx, test.FirstId).Return(tt.err)
+
+			suite.Equal(tt.expectedError, suite.target.Delete(suite.ctx, test.FirstId))
+		})
+	}
+}
+
+func (suite *InventoryServiceTestSuite) expectTransaction() {
+	suite.transactionRunner.EXPECT().WithTransaction(suite.ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+}
+
+func (suite *InventoryServiceTestSuite) TestDeduct() {
+	suite.expectTransaction()
+	suite.repository.EXPECT().Deduct(suite.ctx, []domain.InventoryDeduction{
+		{Key: test.FirstId.String(), Amount: 1100},
+		{Key: test.SecondId.String(), Amount: 100},
+		{Key: "salt", Amount: 22},
+	}).Return(nil)
+
+	err := suite.target.Deduct(suite.ctx, createInventoryRecipe(), createInventoryRecipe())
+
+	suite.NoError(err)
+}
+
+func (suite *InventoryServiceTestSuite) TestDeduct_WithError() {
+	suite.expectTransaction()
+	suite.repository.EXPECT().Deduct(suite.ctx, gomock.Any()).Return(assert.AnError)
+
+	err := suite.target.Deduct(suite.ctx, createInventoryRecipe())
+
+	suite.Equal(internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to deduct inventory"), err)
+}
+
+func (suite *InventoryServiceTestSuite) TestShortages() {
+	request := domain.SourdoughRecipeBatchScaleRequest{
+		Items: []domain.SourdoughRecipeBatchScaleItemDto{{RecipeId: test.ThirdId, Pieces: 2, PieceWeight: 500}},
+	}
+	pickList := pickListOf([]domain.SourdoughRecipeDto{createInventoryRecipe(), createInventoryRecipe()})
+
+	suite.sourdoughRecipeScaleService.EXPECT().ScaleBatch(suite.ctx, request).
+		Return(domain.SourdoughRecipeBatchScaleDto{PickList: pickList}, nil)
+	suite.repository.EXPECT().FindByKeys(suite.ctx, []string{test.FirstId.String(), test.SecondId.String(), "salt"}).
+		Return([]domain.InventoryItemEntity{
+			{Key: test.FirstId.String(), Quantity: 5000},
+			{Key: test.SecondId.String(), Quantity: 40.5},
+		}, nil)
+
+	actual, err := suite.target.Shortages(suite.ctx, request)
+
+	suite.NoError(err)
+	suite.Equal(pickList, actual.PickList)
+	secondId := test.SecondId
+	suite.Equal([]domain.InventoryShortageDto{
+		{
+			Kind:     domain.InventoryKindFlour,
+			FlourId:  &secondId,
+			Name:     "Rye flour",
+			Required: 100,
+			OnHand:   40.5,
+			Missing:  59.5,
+			Tracked:  true,
+		},
+		{
+			Kind:     domain.InventoryKindIngredient,
+			Name:     "Salt",
+			Required: 22,
+			Missing:  22,
+		},
+	}, actual.Shortages)
+}
+
+func (suite *InventoryServiceTestSuite) TestShortages_WithError() {
+	request := domain.SourdoughRecipeBatchScaleRequest{
+		Items: []domain.SourdoughRecipeBatchScaleItemDto{{RecipeId: test.ThirdId, Pieces: 2, PieceWeight: 500}},
+	}
+
+	tests := []struct {
+		name          string
+		mocks         func()
+		expectedError error
+	}{
+		{
+			name: "scale batch fails",
+			mocks: func() {
+				suite.sourdoughRecipeScaleService.EXPECT().ScaleBatch(suite.ctx, request).
+					Return(domain.SourdoughRecipeBatchScaleDto{}, assert.AnError)
+			},
+			expectedError: assert.AnError,
+		},
+		{
+			name: "find items fails",
+			mocks: func() {
+				suite.sourdoughRecipeScaleService.EXPECT().ScaleBatch(suite.ctx, request).
+					Return(domain.SourdoughRecipeBatchScaleDto{}, nil)
+				suite.repository.EXPECT().FindByKeys(suite.ctx, gomock.Any()).
+					Return(nil, assert.AnError)
+			},
+			expectedError: internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to find inventory items"),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mocks()
+
+			actual, err := suite.target.Shortages(suite.ctx, request)
+
+			suite.Equal(tt.expectedError, err)
+			suite.Empty(actual)
+		})
+	}
+}
+
+func TestNewInventoryService_WithError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	transactionRunner := mocks.NewMockTransactionRunner(mockCtrl)
+	repository := mocks.NewMockInventoryRepository(mockCtrl)
+	flourRepository := mocks.NewMockFlourRepository(mockCtrl)
+
+	tests := []struct {
+		name     string
+		creator  func() (domain.InventoryService, error)
+		errorMsg string
+	}{
+		{
+			name: "transactionRunner is nil",
+			creator: func() (domain.InventoryService, error) {
+				return NewInventoryService(nil, nil, nil, nil)
+			},
+			errorMsg: "transactionRunner cannot be nil",
+		},
+		{
+			name: "repository is nil",
+			creator: func() (domain.InventoryService, error) {
+				return NewInventoryService(transactionRunner, nil, nil, nil)
+			},
+			errorMsg: "repository cannot be nil",
+		},
+		{
+			name: "flourRepository is nil",
+			creator: func() (domain.InventoryService, error) {
+				return NewInventoryService(transactionRunner, repository, nil, nil)
+			},
+			errorMsg: "flourRepository cannot be nil",
+		},
+		{
+			name: "sourdoughRecipeScaleService is nil",
+			creator: func() (domain.InventoryService, error) {
+				return NewInventoryService(transactionRunner, repository, flourRepository, nil)
+			},
+			errorMsg: "sourdoughRecipeScaleService cannot be nil",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, err := tt.creator()
+
+			assert.Nil(t, service)
+			assert.ErrorContains(t, err, tt.errorMsg)
+		})
+	}
+}
+
+func createInventoryItemEntity() domain.InventoryItemEntity {
+	flourId := test.SecondId
+	return domain.InventoryItemEntity{
+		Id:                test.FirstId,
+		Key:               flourId.String(),
+		Kind:              domain.InventoryKindFlour,
+		FlourId:           &flourId,
+		Name:              "Rye flour",
+		Quantity:          800,
+		LowStockThreshold: 1000,
+		CreatedAt:         test.Date,
+	}
+}
+
+// createInventoryRecipe is a 1000 g dough of bread and rye flour with a rye
+// levain, water, starter and salt.
+func createInventoryRecipe() domain.SourdoughRecipeDto {
+	return domain.SourdoughRecipeDto{
+		RecipeDto: domain.RecipeDto{
+			Id:    test.ThirdId,
+			Name:  "Country loaf",
+			Flour: []domain.FlourAmountDto{{FlourDto: domain.FlourDto{Id: test.FirstId, Name: "Bread flour"}, Amount: 550}},
+			Water: []domain.BakerAmountDto{{Name: "Water", Amount: 380}},
+			AdditionalIngredients: []domain.BakerAmountDto{
+				{Name: "Salt", Amount: 11},
+			},
+		},
+		Levain: domain.SourdoughLevainAgentDto{
+			Flour:   []domain.FlourAmountDto{{FlourDto: domain.FlourDto{Id: test.SecondId, Name: "Rye flour"}, Amount: 50}},
+			Water:   domain.BakerAmountDto{Name: "Water", Amount: 50},
+			Starter: domain.BakerAmountDto{Name: "Starter", Amount: 10},
+		},
+	}
+}
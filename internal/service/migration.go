@@ -0,0 +1,299 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"dough-calculator/internal/config"
+	"dough-calculator/internal/domain"
+)
+
+const defaultMigrationLockPollInterval = time.Second
+
+var errMigrationLockLost = errors.New("lost the migration lock")
+
+type migrationService struct {
+	repository domain.MigrationRepository
+	migrations []domain.Migration
+	config     config.Migrations
+
+	// owner identifies this process in the lock document
+	owner            string
+	lockPollInterval time.Duration
+}
+
+func (service *migrationService) Up(ctx context.Context) (applied int, err error) {
+	err = service.withLock(ctx, func(ctx context.Context) error {
+		appliedVersions, err := service.appliedVersions(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range service.migrations {
+			if _, ok := appliedVersions[migration.Version]; ok {
+				delete(appliedVersions, migration.Version)
+				continue
+			}
+
+			if err = migration.Up(ctx); err != nil {
+				return errors.Wrapf(err, "failed to apply migration %d", migration.Version)
+			}
+
+			err = service.repository.Save(ctx, domain.MigrationEntity{
+				Version:     migration.Version,
+				Description: migration.Description,
+				AppliedAt:   time.Now().UTC().Truncate(time.Millisecond),
+			})
+			if err != nil {
+				return err
+			}
+
+			applied++
+			log.Info().
+				Int("version", migration.Version).
+				Str("description", migration.Description).
+				Msg("Applied migration")
+		}
+
+		for version := range appliedVersions {
+			log.Warn().
+				Int("version", version).
+				Msg("Database has a migration applied that the application does not know")
+		}
+		return nil
+	})
+
+	return
+}
+
+func (service *migrationService) Down(ctx context.Context, steps int) (reverted int, err error) {
+	if steps <= 0 {
+		return 0, errors.New("steps must be positive")
+	}
+
+	err = service.withLock(ctx, func(ctx context.Context) error {
+		applied, err := service.repository.FindApplied(ctx)
+		if err != nil {
+			return err
+		}
+
+		for i := len(applied) - 1; i >= 0 && reverted < steps; i-- {
+			migration, ok := service.migration(applied[i].Version)
+			if !ok {
+				return errors.Errorf("cannot revert unknown migration %d", applied[i].Version)
+			}
+
+			if err = migration.Down(ctx); err != nil {
+				return errors.Wrapf(err, "failed to revert migration %d", migration.Version)
+			}
+
+			if err = service.repository.Delete(ctx, migration.Version); err != nil {
+				return err
+			}
+
+			reverted++
+			log.Info().
+				Int("version", migration.Version).
+				Str("description", migration.Description).
+				Msg("Reverted migration")
+		}
+		return nil
+	})
+
+	return
+}
+
+func (service *migrationService) Status(ctx context.Context) ([]domain.MigrationStatus, error) {
+	applied, err := service.repository.FindApplied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	appliedAt := make(map[int]time.Time, len(applied))
+	for _, migration := range applied {
+		appliedAt[migration.Version] = migration.AppliedAt
+	}
+
+	result := make([]domain.MigrationStatus, 0, len(service.migrations))
+	for _, migration := range service.migrations {
+		status := domain.MigrationStatus{
+			Version:     migration.Version,
+			Description: migration.Description,
+		}
+		if at, ok := appliedAt[migration.Version]; ok {
+			status.AppliedAt = &at
+		}
+		result = append(result, status)
+	}
+
+	return result, nil
+}
+
+// withLock runs action while holding the migration lock, so that several
+// instances starting at once apply every migration a single time. The lock
+// expires on its own when a process dies holding it, and is renewed while
+// action runs. When the lock is lost anyway, the context of action is
+// cancelled so that it records nothing more.
+//
+// A waiting process gives up once the lock was neither released nor renewed
+// for the lock timeout, a holder that keeps renewing is still migrating.
+func (service *migrationService) withLock(ctx context.Context, action func(ctx context.Context) error) error {
+	timeout := service.config.Lock()
+	deadline := time.Now().Add(timeout)
+	var heldUntil time.Time
+
+	for {
+		locked, err := service.repository.Lock(ctx, service.owner, time.Now().Add(timeout))
+		if err != nil {
+			return err
+		}
+		if locked {
+			break
+		}
+
+		expiresAt, err := service.repository.LockExpiresAt(ctx)
+		if err != nil {
+			return err
+		}
+		if expiresAt.After(heldUntil) {
+			if !heldUntil.IsZero() {
+				deadline = time.Now().Add(timeout)
+			}
+			heldUntil = expiresAt
+		}
+
+		if time.Now().After(deadline) {
+			return errors.New("timed out waiting for the migration lock")
+		}
+
+		log.Debug().Msg("Waiting for the migration lock")
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "stopped waiting for the migration lock")
+		case <-time.After(service.lockPollInterval):
+		}
+	}
+
+	defer func() {
+		if err := service.repository.Unlock(context.WithoutCancel(ctx), service.owner); err != nil {
+			log.Error().Err(err).Msg("failed to release migration lock")
+		}
+	}()
+
+	actionCtx, cancel := context.WithCancelCause(ctx)
+	renewed := make(chan struct{})
+	go func() {
+		defer close(renewed)
+		service.renewLock(actionCtx, cancel, timeout)
+	}()
+
+	err := action(actionCtx)
+	cancel(nil)
+	<-renewed
+
+	if cause := context.Cause(actionCtx); errors.Is(cause, errMigrationLockLost) {
+		return cause
+	}
+	return err
+}
+
+// renewLock extends the lock every third of timeout until ctx is done. It
+// cancels ctx with errMigrationLockLost once another owner holds the lock or
+// the lock expired because renewing failed.
+func (service *migrationService) renewLock(ctx context.Context, cancel context.CancelCauseFunc, timeout time.Duration) {
+	ticker := time.NewTicker(timeout / 3)
+	defer ticker.Stop()
+
+	expiresAt := time.Now().Add(timeout)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		next := time.Now().Add(timeout)
+		renewed, err := service.repository.Renew(ctx, service.owner, next)
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err != nil:
+			log.Error().Err(err).Msg("failed to renew migration lock")
+			if time.Now().After(expiresAt) {
+				cancel(errMigrationLockLost)
+				return
+			}
+		case !renewed:
+			cancel(errMigrationLockLost)
+			return
+		default:
+			expiresAt = next
+		}
+	}
+}
+
+func (service *migrationService) appliedVersions(ctx context.Context) (map[int]struct{}, error) {
+	applied, err := service.repository.FindApplied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int]struct{}, len(applied))
+	for _, migration := range applied {
+		result[migration.Version] = struct{}{}
+	}
+	return result, nil
+}
+
+func (service *migrationService) migration(version int) (domain.Migration, bool) {
+	for _, migration := range service.migrations {
+		if migration.Version == version {
+			return migration, true
+		}
+	}
+	return domain.Migration{}, false
+}
+
+func migrationOwner() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("%s-%d-%s", hostname, os.Getpid(), uuid.NewString())
+}
+
+func NewMigrationService(
+	repository domain.MigrationRepository,
+	migrations []domain.Migration,
+	config config.Migrations,
+) (domain.MigrationService, error) {
+	if repository == nil {
+		return nil, errors.New("repository cannot be nil")
+	}
+
+	for i, migration := range migrations {
+		if migration.Version <= 0 {
+			return nil, errors.Errorf("migration %d has a non-positive version", migration.Version)
+		}
+		if i > 0 && migration.Version <= migrations[i-1].Version {
+			return nil, errors.Errorf("migration %d is not in ascending version order", migration.Version)
+		}
+		if migration.Up == nil || migration.Down == nil {
+			return nil, errors.Errorf("migration %d must have both up and down", migration.Version)
+		}
+	}
+
+	return &migrationService{
+		repository:       repository,
+		migrations:       migrations,
+		config:           config,
+		owner:            migrationOwner(),
+		lockPollInterval: defaultMigrationLockPollInterval,
+	}, nil
+}
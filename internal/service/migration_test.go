@@ -0,0 +1,349 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/config"
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	"dough-calculator/internal/test"
+)
+
+func TestMigrationServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(MigrationServiceTestSuite))
+}
+
+type MigrationServiceTestSuite struct {
+	test.GoMockTestSuite
+
+	ctx        context.Context
+	repository *mocks.MockMigrationRepository
+	// calls records the migration steps in the order they ran
+	calls []string
+
+	target *migrationService
+}
+
+func (suite *MigrationServiceTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.ctx = context.Background()
+	suite.repository = mocks.NewMockMigrationRepository(suite.MockCtrl)
+	suite.calls = nil
+
+	service := test.Must(func() (domain.MigrationService, error) {
+		return NewMigrationService(suite.repository, []domain.Migration{
+			suite.migration(1, "first"),
+			suite.migration(2, "second"),
+			suite.migration(3, "third"),
+		}, config.Migrations{LockTimeout: 50 * time.Millisecond})
+	})
+	suite.target = service.(*migrationService)
+	suite.target.lockPollInterval = time.Millisecond
+}
+
+func (suite *MigrationServiceTestSuite) migration(version int, description string) domain.Migration {
+	return domain.Migration{
+		Version:     version,
+		Description: description,
+		Up: func(ctx context.Context) error {
+			suite.calls = append(suite.calls, "up "+description)
+			return nil
+		},
+		Down: func(ctx context.Context) error {
+			suite.calls = append(suite.calls, "down "+description)
+			return nil
+		},
+	}
+}
+
+// expectLock takes and releases the lock, renewing it as often as the
+// migrations take long enough for.
+func (suite *MigrationServiceTestSuite) expectLock() {
+	suite.repository.EXPECT().Lock(suite.ctx, suite.target.owner, gomock.Any()).Return(true, nil)
+	suite.repository.EXPECT().Renew(gomock.Any(), suite.target.owner, gomock.Any()).Return(true, nil).AnyTimes()
+	suite.repository.EXPECT().Unlock(gomock.Any(), suite.target.owner).Return(nil)
+}
+
+func (suite *MigrationServiceTestSuite) TestNewMigrationService_WithError() {
+	up := func(ctx context.Context) error { return nil }
+	tests := []struct {
+		name       string
+		repository domain.MigrationRepository
+		migrations []domain.Migration
+		errorMsg   string
+	}{
+		{
+			name:     "repository is nil",
+			errorMsg: "repository cannot be nil",
+		},
+		{
+			name:       "non-positive version",
+			repository: suite.repository,
+			migrations: []domain.Migration{{Version: 0, Up: up, Down: up}},
+			errorMsg:   "migration 0 has a non-positive version",
+		},
+		{
+			name:       "unordered versions",
+			repository: suite.repository,
+			migrations: []domain.Migration{{Version: 2, Up: up, Down: up}, {Version: 2, Up: up, Down: up}},
+			errorMsg:   "migration 2 is not in ascending version order",
+		},
+		{
+			name:       "missing down",
+			repository: suite.repository,
+			migrations: []domain.Migration{{Version: 1, Up: up}},
+			errorMsg:   "migration 1 must have both up and down",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			service, err := NewMigrationService(tt.repository, tt.migrations, config.Migrations{})
+
+			suite.ErrorContains(err, tt.errorMsg)
+			suite.Nil(service)
+		})
+	}
+}
+
+func (suite *MigrationServiceTestSuite) TestUp() {
+	suite.expectLock()
+	suite.repository.EXPECT().FindApplied(gomock.Any()).
+		Return([]domain.MigrationEntity{{Version: 1}, {Version: 7}}, nil)
+	var saved []domain.MigrationEntity
+	suite.repository.EXPECT().Save(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, migration domain.MigrationEntity) error {
+			suite.WithinDuration(time.Now(), migration.AppliedAt, time.Second)
+			migration.AppliedAt = time.Time{}
+			saved = append(saved, migration)
+			return nil
+		}).Times(2)
+
+	applied, err := suite.target.Up(suite.ctx)
+
+	suite.NoError(err)
+	suite.Equal(2, applied)
+	suite.Equal([]string{"up second", "up third"}, suite.calls)
+	suite.Equal([]domain.MigrationEntity{{Version: 2, Description: "second"}, {Version: 3, Description: "third"}}, saved)
+}
+
+func (suite *MigrationServiceTestSuite) TestUp_WithFailingMigration() {
+	suite.target.migrations[1].Up = func(ctx context.Context) error { return assert.AnError }
+	suite.expectLock()
+	suite.repository.EXPECT().FindApplied(gomock.Any()).Return([]domain.MigrationEntity{}, nil)
+	suite.repository.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+
+	applied, err := suite.target.Up(suite.ctx)
+
+	suite.ErrorIs(err, assert.AnError)
+	suite.ErrorContains(err, "failed to apply migration 2")
+	suite.Equal(1, applied)
+	suite.Equal([]string{"up first"}, suite.calls)
+}
+
+func (suite *MigrationServiceTestSuite) TestUp_WithMigrationOutlastingTheLockTimeout() {
+	suite.target.migrations[0].Up = func(ctx context.Context) error {
+		time.Sleep(100 * time.Millisecond)
+		return nil
+	}
+	suite.repository.EXPECT().Lock(suite.ctx, suite.target.owner, gomock.Any()).Return(true, nil)
+	suite.repository.EXPECT().Renew(gomock.Any(), suite.target.owner, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, expiresAt time.Time) (bool, error) {
+			suite.WithinDuration(time.Now().Add(50*time.Millisecond), expiresAt, 10*time.Millisecond)
+			return true, nil
+		}).MinTimes(2)
+	suite.repository.EXPECT().Unlock(gomock.Any(), suite.target.owner).Return(nil)
+	suite.repository.EXPECT().FindApplied(gomock.Any()).Return([]domain.MigrationEntity{{Version: 2}, {Version: 3}}, nil)
+	suite.repository.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+
+	applied, err := suite.target.Up(suite.ctx)
+
+	suite.NoError(err)
+	suite.Equal(1, applied)
+}
+
+func (suite *MigrationServiceTestSuite) TestUp_WithLockLost() {
+	tests := []struct {
+		name  string
+		renew func() *gomock.Call
+	}{
+		{
+			name: "taken by another owner",
+			renew: func() *gomock.Call {
+				return suite.repository.EXPECT().Renew(gomock.Any(), suite.target.owner, gomock.Any()).Return(false, nil)
+			},
+		},
+		{
+			name: "renewing fails until the lock expired",
+			renew: func() *gomock.Call {
+				return suite.repository.EXPECT().Renew(gomock.Any(), suite.target.owner, gomock.Any()).
+					Return(false, assert.AnError).MinTimes(3)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			// the migration runs until the lock is lost and then records nothing
+			suite.target.migrations[0].Up = func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}
+			suite.repository.EXPECT().Lock(suite.ctx, suite.target.owner, gomock.Any()).Return(true, nil)
+			tt.renew()
+			suite.repository.EXPECT().Unlock(gomock.Any(), suite.target.owner).Return(nil)
+			suite.repository.EXPECT().FindApplied(gomock.Any()).Return([]domain.MigrationEntity{}, nil)
+
+			applied, err := suite.target.Up(suite.ctx)
+
+			suite.ErrorIs(err, errMigrationLockLost)
+			suite.Zero(applied)
+		})
+	}
+}
+
+func (suite *MigrationServiceTestSuite) TestUp_WithLockHeldByAnotherOwner() {
+	suite.repository.EXPECT().Lock(suite.ctx, suite.target.owner, gomock.Any()).Return(false, nil).MinTimes(2)
+	// the other owner does not renew its lock
+	suite.repository.EXPECT().LockExpiresAt(suite.ctx).Return(time.Now().Add(time.Hour), nil).MinTimes(2)
+
+	applied, err := suite.target.Up(suite.ctx)
+
+	suite.ErrorContains(err, "timed out waiting for the migration lock")
+	suite.Zero(applied)
+	suite.Empty(suite.calls)
+}
+
+func (suite *MigrationServiceTestSuite) TestUp_WithLockReleasedWhileWaiting() {
+	gomock.InOrder(
+		suite.repository.EXPECT().Lock(suite.ctx, suite.target.owner, gomock.Any()).Return(false, nil),
+		suite.repository.EXPECT().LockExpiresAt(suite.ctx).Return(time.Now().Add(time.Second), nil),
+		suite.repository.EXPECT().Lock(suite.ctx, suite.target.owner, gomock.Any()).Return(true, nil),
+	)
+	suite.repository.EXPECT().Renew(gomock.Any(), suite.target.owner, gomock.Any()).Return(true, nil).AnyTimes()
+	suite.repository.EXPECT().Unlock(gomock.Any(), suite.target.owner).Return(nil)
+	suite.repository.EXPECT().FindApplied(gomock.Any()).
+		Return([]domain.MigrationEntity{{Version: 1}, {Version: 2}, {Version: 3}}, nil)
+
+	applied, err := suite.target.Up(suite.ctx)
+
+	suite.NoError(err)
+	suite.Zero(applied)
+}
+
+func (suite *MigrationServiceTestSuite) TestUp_WithLockRenewedByAnotherOwner() {
+	// the other owner migrates for three lock timeouts, renewing its lock
+	releasedAt := time.Now().Add(150 * time.Millisecond)
+	suite.repository.EXPECT().Lock(suite.ctx, suite.target.owner, gomock.Any()).
+		DoAndReturn(func(context.Context, string, time.Time) (bool, error) {
+			return time.Now().After(releasedAt), nil
+		}).MinTimes(2)
+	suite.repository.EXPECT().LockExpiresAt(suite.ctx).
+		DoAndReturn(func(context.Context) (time.Time, error) {
+			return time.Now().Add(50 * time.Millisecond), nil
+		}).MinTimes(1)
+	suite.repository.EXPECT().Renew(gomock.Any(), suite.target.owner, gomock.Any()).Return(true, nil).AnyTimes()
+	suite.repository.EXPECT().Unlock(gomock.Any(), suite.target.owner).Return(nil)
+	suite.repository.EXPECT().FindApplied(gomock.Any()).
+		Return([]domain.MigrationEntity{{Version: 1}, {Version: 2}, {Version: 3}}, nil)
+
+	applied, err := suite.target.Up(suite.ctx)
+
+	suite.NoError(err)
+	suite.Zero(applied)
+}
+
+func (suite *MigrationServiceTestSuite) TestUp_WithErrorOnLockExpiresAt() {
+	suite.repository.EXPECT().Lock(suite.ctx, suite.target.owner, gomock.Any()).Return(false, nil)
+	suite.repository.EXPECT().LockExpiresAt(suite.ctx).Return(time.Time{}, assert.AnError)
+
+	applied, err := suite.target.Up(suite.ctx)
+
+	suite.ErrorIs(err, assert.AnError)
+	suite.Zero(applied)
+}
+
+func (suite *MigrationServiceTestSuite) TestUp_WithErrorOnLock() {
+	suite.repository.EXPECT().Lock(suite.ctx, suite.target.owner, gomock.Any()).Return(false, assert.AnError)
+
+	_, err := suite.target.Up(suite.ctx)
+
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func (suite *MigrationServiceTestSuite) TestDown() {
+	suite.expectLock()
+	suite.repository.EXPECT().FindApplied(gomock.Any()).
+		Return([]domain.MigrationEntity{{Version: 1}, {Version: 2}, {Version: 3}}, nil)
+	gomock.InOrder(
+		suite.repository.EXPECT().Delete(gomock.Any(), 3).Return(nil),
+		suite.repository.EXPECT().Delete(gomock.Any(), 2).Return(nil),
+	)
+
+	reverted, err := suite.target.Down(suite.ctx, 2)
+
+	suite.NoError(err)
+	suite.Equal(2, reverted)
+	suite.Equal([]string{"down third", "down second"}, suite.calls)
+}
+
+func (suite *MigrationServiceTestSuite) TestDown_WithMoreStepsThanApplied() {
+	suite.expectLock()
+	suite.repository.EXPECT().FindApplied(gomock.Any()).Return([]domain.MigrationEntity{{Version: 1}}, nil)
+	suite.repository.EXPECT().Delete(gomock.Any(), 1).Return(nil)
+
+	reverted, err := suite.target.Down(suite.ctx, 5)
+
+	suite.NoError(err)
+	suite.Equal(1, reverted)
+}
+
+func (suite *MigrationServiceTestSuite) TestDown_WithUnknownMigration() {
+	suite.expectLock()
+	suite.repository.EXPECT().FindApplied(gomock.Any()).
+		Return([]domain.MigrationEntity{{Version: 1}, {Version: 7}}, nil)
+
+	reverted, err := suite.target.Down(suite.ctx, 1)
+
+	suite.ErrorContains(err, "cannot revert unknown migration 7")
+	suite.Zero(reverted)
+	suite.Empty(suite.calls)
+}
+
+func (suite *MigrationServiceTestSuite) TestDown_WithNonPositiveSteps() {
+	reverted, err := suite.target.Down(suite.ctx, 0)
+
+	suite.ErrorContains(err, "steps must be positive")
+	suite.Zero(reverted)
+}
+
+func (suite *MigrationServiceTestSuite) TestStatus() {
+	appliedAt := test.Date
+	suite.repository.EXPECT().FindApplied(gomock.Any()).
+		Return([]domain.MigrationEntity{{Version: 1, AppliedAt: appliedAt}}, nil)
+
+	actual, err := suite.target.Status(suite.ctx)
+
+	suite.NoError(err)
+	suite.Equal([]domain.MigrationStatus{
+		{Version: 1, Description: "first", AppliedAt: &appliedAt},
+		{Version: 2, Description: "second"},
+		{Version: 3, Description: "third"},
+	}, actual)
+}
+
+func (suite *MigrationServiceTestSuite) TestStatus_WithError() {
+	suite.repository.EXPECT().FindApplied(gomock.Any()).Return(nil, assert.AnError)
+
+	actual, err := suite.target.Status(suite.ctx)
+
+	suite.ErrorIs(err, assert.AnError)
+	suite.Nil(actual)
+}
@@ -5,8 +5,8 @@ import (
 	"sync"
 
 	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
 	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"dough-calculator/internal/config"
 	"dough-calculator/internal/domain"
@@ -14,48 +14,37 @@ import (
 
 type mongoDBService struct {
 	*mongo.Client
-	databases   sync.Map
+	database    *mongo.Database
 	collections sync.Map
 	config      config.Database
 }
 
-func (service *mongoDBService) GetDatabase(name string) (*mongo.Database, error) {
-	value, ok := service.databases.Load(name)
-	if ok {
-		return value.(*mongo.Database), nil
-	}
-
-	database := service.Database(name)
-	if database == nil {
-		return nil, errors.New("database cannot be nil")
-	}
-
-	service.databases.Store(name, database)
-
-	return database, nil
+func (service *mongoDBService) GetDatabase() (*mongo.Database, error) {
+	return service.database, nil
 }
 
-func (service *mongoDBService) GetCollection(databaseName, collectionName string) (*mongo.Collection, error) {
-	database, err := service.GetDatabase(databaseName)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get database")
-	}
+func (service *mongoDBService) GetCollection(name string) (*mongo.Collection, error) {
+	name = service.CollectionName(name)
 
-	value, ok := service.collections.Load(collectionName)
+	value, ok := service.collections.Load(name)
 	if ok {
 		return value.(*mongo.Collection), nil
 	}
 
-	collection := database.Collection(collectionName)
+	collection := service.database.Collection(name)
 	if collection == nil {
 		return nil, errors.New("collection cannot be nil")
 	}
 
-	service.collections.Store(collectionName, collection)
+	service.collections.Store(name, collection)
 
 	return collection, nil
 }
 
+func (service *mongoDBService) CollectionName(name string) string {
+	return service.config.CollectionName(name)
+}
+
 func (service *mongoDBService) GetClient() (*mongo.Client, error) {
 	return service.Client, nil
 }
@@ -67,9 +56,15 @@ func (service *mongoDBService) Disconnect() error {
 }
 
 func NewMongoDBService(config config.Database) (domain.MongoDBService, error) {
+	opts, err := clientOptions(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to configure client")
+	}
+	log.Info().Fields(clientSettings(config, opts)).Msg("Connecting to MongoDB")
+
 	ctx, cancel := context.WithTimeout(context.Background(), config.ConnectionTimeout)
 	defer cancel()
-	client, err := mongo.Connect(ctx, options.Client().ApplyURI(config.Uri))
+	client, err := mongo.Connect(ctx, opts)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create database")
 	}
@@ -86,5 +81,10 @@ func newMongoDBService(config config.Database, client *mongo.Client) (domain.Mon
 		return nil, errors.New("client cannot be nil")
 	}
 
-	return &mongoDBService{config: config, Client: client}, nil
+	database := client.Database(config.DatabaseName())
+	if database == nil {
+		return nil, errors.New("database cannot be nil")
+	}
+
+	return &mongoDBService{config: config, Client: client, database: database}, nil
 }
@@ -0,0 +1,280 @@
+package service
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+
+	"dough-calculator/internal/config"
+)
+
+const redacted = "[redacted]"
+
+var readConcernLevels = map[string]bool{
+	"local":        true,
+	"available":    true,
+	"majority":     true,
+	"linearizable": true,
+	"snapshot":     true,
+}
+
+// clientOptions applies the Uri and then the client options of the config,
+// which win over the options of the Uri.
+func clientOptions(database config.Database) (*options.ClientOptions, error) {
+	opts := options.Client().ApplyURI(database.Uri)
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid uri")
+	}
+
+	pool := database.Pool
+	if pool.MinSize > 0 {
+		opts.SetMinPoolSize(pool.MinSize)
+	}
+	if pool.MaxSize > 0 {
+		opts.SetMaxPoolSize(pool.MaxSize)
+	}
+	if pool.MaxIdleTime > 0 {
+		opts.SetMaxConnIdleTime(pool.MaxIdleTime)
+	}
+	if pool.MaxSize > 0 && pool.MinSize > pool.MaxSize {
+		return nil, errors.Errorf("pool min size %d is greater than max size %d", pool.MinSize, pool.MaxSize)
+	}
+
+	if database.SocketTimeout > 0 {
+		opts.SetSocketTimeout(database.SocketTimeout)
+	}
+	if database.ServerSelectionTimeout > 0 {
+		opts.SetServerSelectionTimeout(database.ServerSelectionTimeout)
+	}
+
+	if level := database.ReadConcern; level != "" {
+		if !readConcernLevels[level] {
+			return nil, errors.Errorf("unsupported read concern '%s'", level)
+		}
+		opts.SetReadConcern(&readconcern.ReadConcern{Level: level})
+	}
+
+	if database.WriteConcern.Configured() {
+		opts.SetWriteConcern(newWriteConcern(opts.WriteConcern, database.WriteConcern))
+	}
+
+	if database.ReadPreference != "" {
+		mode, err := readpref.ModeFromString(database.ReadPreference)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unsupported read preference '%s'", database.ReadPreference)
+		}
+		readPreference, err := readpref.New(mode)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid read preference")
+		}
+		opts.SetReadPreference(readPreference)
+	}
+
+	if database.TLS.Configured() {
+		tlsConfig, err := newTLSConfig(opts.TLSConfig, database.TLS)
+		if err != nil {
+			return nil, err
+		}
+		opts.SetTLSConfig(tlsConfig)
+	}
+
+	if database.Auth.Configured() {
+		credential, err := newCredential(opts.Auth, database.Auth)
+		if err != nil {
+			return nil, err
+		}
+		opts.SetAuth(credential)
+	}
+
+	return opts, nil
+}
+
+// newWriteConcern overrides the write concern of the Uri with the options
+// set in the config, the ones left unset keep the value of the Uri.
+func newWriteConcern(uriWriteConcern *writeconcern.WriteConcern, writeConcern config.WriteConcern) *writeconcern.WriteConcern {
+	var result writeconcern.WriteConcern
+	if uriWriteConcern != nil {
+		result = *uriWriteConcern
+	}
+
+	if w := writeConcern.W; w != "" {
+		if members, err := strconv.Atoi(w); err == nil {
+			result.W = members
+		} else {
+			// "majority" or the name of a tag set
+			result.W = w
+		}
+	}
+	if writeConcern.Journal {
+		journal := true
+		result.Journal = &journal
+	}
+	if writeConcern.Timeout > 0 {
+		result.WTimeout = writeConcern.Timeout
+	}
+
+	return &result
+}
+
+// newTLSConfig extends the TLS config of the Uri with the options set in the
+// config, so the files of the Uri are kept unless the config sets its own.
+func newTLSConfig(uriTLSConfig *tls.Config, tlsConfig config.TLS) (*tls.Config, error) {
+	result := &tls.Config{}
+	if uriTLSConfig != nil {
+		result = uriTLSConfig.Clone()
+	}
+
+	if result.MinVersion < tls.VersionTLS12 {
+		result.MinVersion = tls.VersionTLS12
+	}
+	if tlsConfig.InsecureSkipVerify {
+		result.InsecureSkipVerify = true
+	}
+
+	if tlsConfig.CAFile != "" {
+		pem, err := os.ReadFile(tlsConfig.CAFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read tls ca file")
+		}
+
+		result.RootCAs = x509.NewCertPool()
+		if !result.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, errors.Errorf("tls ca file '%s' has no certificate", tlsConfig.CAFile)
+		}
+	}
+
+	if tlsConfig.CertificateKeyFile != "" {
+		pem, err := os.ReadFile(tlsConfig.CertificateKeyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read tls certificate key file")
+		}
+
+		certificate, err := tls.X509KeyPair(pem, pem)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid tls certificate key file")
+		}
+		result.Certificates = []tls.Certificate{certificate}
+	}
+
+	return result, nil
+}
+
+// newCredential overrides the credential of the Uri with the one of the
+// config.
+func newCredential(uriCredential *options.Credential, auth config.Auth) (options.Credential, error) {
+	var result options.Credential
+	if uriCredential != nil {
+		result = *uriCredential
+	}
+
+	if auth.Mechanism != "" {
+		result.AuthMechanism = auth.Mechanism
+	}
+	if auth.Source != "" {
+		result.AuthSource = auth.Source
+	}
+	if auth.Username != "" {
+		result.Username = auth.Username
+	}
+
+	if auth.UsernameFile != "" {
+		username, err := readSecret(auth.UsernameFile)
+		if err != nil {
+			return options.Credential{}, errors.Wrap(err, "failed to read username file")
+		}
+		result.Username = username
+	}
+
+	if auth.PasswordFile != "" {
+		password, err := readSecret(auth.PasswordFile)
+		if err != nil {
+			return options.Credential{}, errors.Wrap(err, "failed to read password file")
+		}
+		result.Password = password
+		result.PasswordSet = true
+	}
+
+	return result, nil
+}
+
+// readSecret reads a secret file, without the line break editors and
+// secret stores leave at its end.
+func readSecret(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(content), "\r\n"), nil
+}
+
+// clientSettings describes the effective client options for the startup
+// log. Passwords are redacted and the hosts come without credentials.
+func clientSettings(database config.Database, opts *options.ClientOptions) map[string]interface{} {
+	settings := map[string]interface{}{
+		"hosts":            strings.Join(opts.Hosts, ","),
+		"database":         database.DatabaseName(),
+		"collectionPrefix": database.CollectionPrefix,
+		"connectTimeout":   database.ConnectionTimeout.String(),
+		"tls":              opts.TLSConfig != nil,
+	}
+
+	if opts.ReplicaSet != nil {
+		settings["replicaSet"] = *opts.ReplicaSet
+	}
+	if opts.MinPoolSize != nil {
+		settings["minPoolSize"] = *opts.MinPoolSize
+	}
+	if opts.MaxPoolSize != nil {
+		settings["maxPoolSize"] = *opts.MaxPoolSize
+	}
+	if opts.MaxConnIdleTime != nil {
+		settings["maxConnIdleTime"] = opts.MaxConnIdleTime.String()
+	}
+	if opts.SocketTimeout != nil {
+		settings["socketTimeout"] = opts.SocketTimeout.String()
+	}
+	if opts.ServerSelectionTimeout != nil {
+		settings["serverSelectionTimeout"] = opts.ServerSelectionTimeout.String()
+	}
+	if opts.ReadConcern != nil {
+		settings["readConcern"] = opts.ReadConcern.Level
+	}
+	if opts.WriteConcern != nil {
+		settings["writeConcern"] = map[string]interface{}{
+			"w":        opts.WriteConcern.W,
+			"journal":  opts.WriteConcern.Journal != nil && *opts.WriteConcern.Journal,
+			"wtimeout": opts.WriteConcern.WTimeout.String(),
+		}
+	}
+	if opts.ReadPreference != nil {
+		settings["readPreference"] = opts.ReadPreference.Mode().String()
+	}
+
+	if tlsConfig := database.TLS; tlsConfig.Configured() {
+		settings["tlsCAFile"] = tlsConfig.CAFile
+		settings["tlsCertificateKeyFile"] = tlsConfig.CertificateKeyFile
+		settings["tlsInsecureSkipVerify"] = tlsConfig.InsecureSkipVerify
+	}
+
+	if opts.Auth != nil {
+		auth := map[string]interface{}{
+			"mechanism": opts.Auth.AuthMechanism,
+			"source":    opts.Auth.AuthSource,
+			"username":  opts.Auth.Username,
+		}
+		if opts.Auth.PasswordSet || opts.Auth.Password != "" {
+			auth["password"] = redacted
+		}
+		settings["auth"] = auth
+	}
+
+	return settings
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"dough-calculator/internal/config"
+)
+
+func newTestMongoDBService(t *testing.T, database config.Database) *mongoDBService {
+	// connecting does not reach the server, names resolve without one
+	client, err := mongo.Connect(context.Background(), options.Client().ApplyURI("mongodb://localhost:27017"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Disconnect(context.Background()) })
+
+	service, err := newMongoDBService(database, client)
+	require.NoError(t, err)
+	return service.(*mongoDBService)
+}
+
+func TestMongoDBService_GetCollection(t *testing.T) {
+	tests := []struct {
+		name               string
+		database           config.Database
+		expectedDatabase   string
+		expectedCollection string
+	}{
+		{
+			name:               "default database",
+			database:           config.Database{Uri: "mongodb://localhost:27017"},
+			expectedDatabase:   "dough-calculator",
+			expectedCollection: "flour",
+		},
+		{
+			name:               "database of the uri",
+			database:           config.Database{Uri: "mongodb://localhost:27017/staging"},
+			expectedDatabase:   "staging",
+			expectedCollection: "flour",
+		},
+		{
+			name: "configured name and prefix",
+			database: config.Database{
+				Uri:              "mongodb://localhost:27017/staging",
+				Name:             "qa",
+				CollectionPrefix: "qa_",
+			},
+			expectedDatabase:   "qa",
+			expectedCollection: "qa_flour",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := newTestMongoDBService(t, tt.database)
+
+			collection, err := target.GetCollection("flour")
+
+			assert.NoError(t, err)
+			database, err := target.GetDatabase()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedDatabase, database.Name())
+			assert.Equal(t, tt.expectedDatabase, collection.Database().Name())
+			assert.Equal(t, tt.expectedCollection, collection.Name())
+			assert.Equal(t, tt.expectedCollection, target.CollectionName("flour"))
+		})
+	}
+}
+
+func TestNewMongoDBService_WithNilClient(t *testing.T) {
+	service, err := newMongoDBService(config.Database{}, nil)
+
+	assert.ErrorContains(t, err, "client cannot be nil")
+	assert.Nil(t, service)
+}
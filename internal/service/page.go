@@ -0,0 +1,213 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+)
+
+const (
+	defaultPageLimit = 25
+	maxPageLimit     = 100
+
+	orderAscending  = "asc"
+	orderDescending = "desc"
+)
+
+type sortKind int
+
+const (
+	sortKindString sortKind = iota
+	sortKindNumber
+	sortKindTime
+)
+
+// sortOption describes a sort parameter value of a list endpoint: the field
+// it orders by, the type of the field values and how to read the sort key
+// of an item.
+type sortOption[T any] struct {
+	field      string
+	kind       sortKind
+	descending bool
+	key        func(T) domain.PageKey
+}
+
+// sortOptions holds the sort parameter values supported by a list endpoint
+// and the one used when none is given.
+type sortOptions[T any] struct {
+	defaultSort string
+	options     map[string]sortOption[T]
+}
+
+// pager turns page parameters into a repository page request and builds the
+// cursors of the resulting page.
+type pager[T any] struct {
+	option  sortOption[T]
+	scope   string
+	request domain.PageRequest
+}
+
+// cursor is the decoded form of the opaque page cursors handed out to
+// clients. Scope binds a cursor to the sort it was created for.
+type cursor struct {
+	Scope    string          `json:"s"`
+	Value    json.RawMessage `json:"v"`
+	Id       uuid.UUID       `json:"id"`
+	Backward bool            `json:"b,omitempty"`
+}
+
+func (options sortOptions[T]) pager(params domain.PageParams) (pager[T], error) {
+	limit := params.Limit
+	if limit == 0 {
+		limit = defaultPageLimit
+	}
+	if limit < 1 || limit > maxPageLimit {
+		return pager[T]{}, internalErrors.InvalidPageRequest(
+			fmt.Sprintf("limit must be between 1 and %d", maxPageLimit))
+	}
+
+	if params.Offset < 0 {
+		return pager[T]{}, internalErrors.InvalidPageRequest("offset must not be negative")
+	}
+
+	name := strings.ToLower(strings.TrimSpace(params.Sort))
+	if name == "" {
+		name = options.defaultSort
+	}
+
+	option, ok := options.options[name]
+	if !ok {
+		return pager[T]{}, internalErrors.InvalidPageRequest(
+			fmt.Sprintf("sort must be one of %s", strings.Join(options.names(), ", ")))
+	}
+
+	descending := option.descending
+	switch strings.ToLower(strings.TrimSpace(params.Order)) {
+	case "":
+	case orderAscending:
+		descending = false
+	case orderDescending:
+		descending = true
+	default:
+		return pager[T]{}, internalErrors.InvalidPageRequest(
+			fmt.Sprintf("order must be one of %s, %s", orderAscending, orderDescending))
+	}
+
+	result := pager[T]{
+		option: option,
+		scope:  name + ":" + orderName(descending),
+		request: domain.PageRequest{
+			SortField:  option.field,
+			Descending: descending,
+			Offset:     params.Offset,
+			Limit:      limit,
+		},
+	}
+
+	if params.Cursor != "" {
+		key, backward, err := result.decodeCursor(params.Cursor)
+		if err != nil {
+			return pager[T]{}, err
+		}
+
+		result.request.Offset = 0
+		if backward {
+			result.request.Before = &key
+		} else {
+			result.request.After = &key
+		}
+	}
+
+	return result, nil
+}
+
+func (options sortOptions[T]) names() []string {
+	names := make([]string, 0, len(options.options))
+	for name := range options.options {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// page builds the page envelope for items fetched with the pager's request.
+func (pager pager[T]) page(items []T, info domain.PageInfo) domain.PageDto {
+	page := domain.PageDto{Total: info.Total}
+	if len(items) == 0 {
+		return page
+	}
+
+	if info.HasNext {
+		page.NextCursor = pager.encodeCursor(pager.option.key(items[len(items)-1]), false)
+	}
+	if info.HasPrev {
+		page.PrevCursor = pager.encodeCursor(pager.option.key(items[0]), true)
+	}
+
+	return page
+}
+
+func (pager pager[T]) encodeCursor(key domain.PageKey, backward bool) string {
+	value, _ := json.Marshal(key.Value)
+	data, _ := json.Marshal(cursor{
+		Scope:    pager.scope,
+		Value:    value,
+		Id:       key.Id,
+		Backward: backward,
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func (pager pager[T]) decodeCursor(encoded string) (domain.PageKey, bool, error) {
+	invalid := internalErrors.InvalidPageRequest("cursor is not valid")
+
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return domain.PageKey{}, false, invalid
+	}
+
+	var decoded cursor
+	if err = json.Unmarshal(data, &decoded); err != nil || decoded.Scope != pager.scope {
+		return domain.PageKey{}, false, invalid
+	}
+
+	key := domain.PageKey{Id: decoded.Id}
+	if len(decoded.Value) == 0 || string(decoded.Value) == "null" {
+		return key, decoded.Backward, nil
+	}
+
+	switch pager.option.kind {
+	case sortKindNumber:
+		var value float64
+		err = json.Unmarshal(decoded.Value, &value)
+		key.Value = value
+	case sortKindTime:
+		var value time.Time
+		err = json.Unmarshal(decoded.Value, &value)
+		key.Value = value
+	default:
+		var value string
+		err = json.Unmarshal(decoded.Value, &value)
+		key.Value = value
+	}
+	if err != nil {
+		return domain.PageKey{}, false, invalid
+	}
+
+	return key, decoded.Backward, nil
+}
+
+func orderName(descending bool) string {
+	if descending {
+		return orderDescending
+	}
+	return orderAscending
+}
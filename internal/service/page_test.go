@@ -0,0 +1,110 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func TestSortOptions_Pager(t *testing.T) {
+	tests := []struct {
+		name            string
+		params          domain.PageParams
+		expectedRequest domain.PageRequest
+		expectedError   error
+	}{
+		{
+			name:            "defaults",
+			params:          domain.PageParams{},
+			expectedRequest: domain.PageRequest{SortField: "created_at", Descending: true, Limit: 25},
+		},
+		{
+			name:            "sort and order",
+			params:          domain.PageParams{Sort: "Name", Order: "DESC", Offset: 5, Limit: 100},
+			expectedRequest: domain.PageRequest{SortField: "name", Descending: true, Offset: 5, Limit: 100},
+		},
+		{
+			name:          "limit too large",
+			params:        domain.PageParams{Limit: 101},
+			expectedError: internalErrors.InvalidPageRequest("limit must be between 1 and 100"),
+		},
+		{
+			name:          "negative offset",
+			params:        domain.PageParams{Offset: -1},
+			expectedError: internalErrors.InvalidPageRequest("offset must not be negative"),
+		},
+		{
+			name:          "unknown sort",
+			params:        domain.PageParams{Sort: "hydration"},
+			expectedError: internalErrors.InvalidPageRequest("sort must be one of created, name, protein"),
+		},
+		{
+			name:          "unknown order",
+			params:        domain.PageParams{Order: "up"},
+			expectedError: internalErrors.InvalidPageRequest("order must be one of asc, desc"),
+		},
+		{
+			name:          "malformed cursor",
+			params:        domain.PageParams{Cursor: "not a cursor"},
+			expectedError: internalErrors.InvalidPageRequest("cursor is not valid"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pager, err := flourSortOptions.pager(tt.params)
+
+			assert.Equal(t, tt.expectedError, err)
+			assert.Equal(t, tt.expectedRequest, pager.request)
+		})
+	}
+}
+
+func TestPager_Cursors(t *testing.T) {
+	flours := []domain.FlourEntity{
+		{Id: uuid.New(), Name: "Rye", CreatedAt: test.Date},
+		{Id: uuid.New(), Name: "Spelt"},
+	}
+
+	first, err := flourSortOptions.pager(domain.PageParams{Offset: 2, Limit: 2})
+	require.NoError(t, err)
+
+	page := first.page(flours, domain.PageInfo{Total: 10, HasNext: true, HasPrev: true})
+	assert.Equal(t, int64(10), page.Total)
+
+	next, err := flourSortOptions.pager(domain.PageParams{Cursor: page.NextCursor, Offset: 2, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, domain.PageRequest{
+		SortField:  "created_at",
+		Descending: true,
+		After:      &domain.PageKey{Id: flours[1].Id},
+		Limit:      2,
+	}, next.request)
+
+	prev, err := flourSortOptions.pager(domain.PageParams{Cursor: page.PrevCursor, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, domain.PageRequest{
+		SortField:  "created_at",
+		Descending: true,
+		Before:     &domain.PageKey{Value: test.Date, Id: flours[0].Id},
+		Limit:      2,
+	}, prev.request)
+
+	_, err = flourSortOptions.pager(domain.PageParams{Sort: "name", Cursor: page.NextCursor})
+	assert.Equal(t, internalErrors.InvalidPageRequest("cursor is not valid"), err)
+}
+
+func TestPager_Page_WithoutMorePages(t *testing.T) {
+	pager, err := flourSortOptions.pager(domain.PageParams{})
+	require.NoError(t, err)
+
+	page := pager.page([]domain.FlourEntity{{Id: uuid.New()}}, domain.PageInfo{Total: 1})
+
+	assert.Equal(t, domain.PageDto{Total: 1}, page)
+}
@@ -0,0 +1,381 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/utils"
+)
+
+// defaultMixMinutes is the time the mixer is busy with one mix when a plan
+// does not set it.
+const defaultMixMinutes = 20
+
+type productionPlanService struct {
+	transactionRunner           domain.TransactionRunner
+	repository                  domain.ProductionPlanRepository
+	sourdoughRecipeService      domain.SourdoughRecipeService
+	sourdoughRecipeScaleService domain.SourdoughRecipeScaleService
+	bakeLogService              domain.BakeLogService
+	inventoryService            domain.InventoryService
+}
+
+func (service *productionPlanService) Create(ctx context.Context, request domain.CreateProductionPlanRequest) (domain.ProductionPlanDto, error) {
+	request, err := service.validate(ctx, request)
+	if err != nil {
+		return domain.ProductionPlanDto{}, err
+	}
+
+	created, err := service.repository.Create(ctx, domain.ProductionPlanEntity{
+		Id:            uuid.New(),
+		Name:          request.Name,
+		Date:          request.Date,
+		MixerCapacity: request.MixerCapacity,
+		MixMinutes:    request.MixMinutes,
+		Items:         utils.Map(request.Items, func(item domain.ProductionPlanItemDto) domain.ProductionPlanItem { return item.ToEntity() }),
+		CreatedAt:     time.Now().UTC().Truncate(time.Millisecond),
+	})
+	if err != nil {
+		log.Err(err).
+			Str("name", request.Name).
+			Msg("failed to create production plan")
+
+		return domain.ProductionPlanDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to create production plan")
+	}
+
+	return created.ToDto(), nil
+}
+
+func (service *productionPlanService) FindById(ctx context.Context, id uuid.UUID) (domain.ProductionPlanDto, error) {
+	plan, err := service.getById(ctx, id)
+	if err != nil {
+		return domain.ProductionPlanDto{}, err
+	}
+	return plan.ToDto(), nil
+}
+
+func (service *productionPlanService) Find(ctx context.Context, offset, limit int) ([]domain.ProductionPlanDto, error) {
+	plans, err := service.repository.Find(ctx, offset, limit)
+	if err != nil {
+		log.Err(err).
+			Msg("failed to find production plans")
+
+		return nil, internalErrors.NewInternalServerErrorWrap(err, "failed to find production plans")
+	}
+
+	return utils.Map(plans, func(plan domain.ProductionPlanEntity) domain.ProductionPlanDto {
+		return plan.ToDto()
+	}), nil
+}
+
+// Update replaces the plan unless it is completed. Like Complete, the update
+// only matches a plan that is not completed yet, so a plan completed
+// concurrently is not changed afterwards.
+func (service *productionPlanService) Update(
+	ctx context.Context,
+	id uuid.UUID,
+	request domain.CreateProductionPlanRequest,
+) (domain.ProductionPlanDto, error) {
+	request, err := service.validate(ctx, request)
+	if err != nil {
+		return domain.ProductionPlanDto{}, err
+	}
+
+	plan, err := service.getById(ctx, id)
+	if err != nil {
+		return domain.ProductionPlanDto{}, err
+	}
+	if plan.CompletedAt != nil {
+		return domain.ProductionPlanDto{}, internalErrors.ProductionPlanAlreadyCompleted(id)
+	}
+
+	updatedAt := time.Now().UTC().Truncate(time.Millisecond)
+	plan.Name = request.Name
+	plan.Date = request.Date
+	plan.MixerCapacity = request.MixerCapacity
+	plan.MixMinutes = request.MixMinutes
+	plan.Items = utils.Map(request.Items, func(item domain.ProductionPlanItemDto) domain.ProductionPlanItem { return item.ToEntity() })
+	plan.UpdatedAt = &updatedAt
+
+	updated, err := service.repository.Update(ctx, plan)
+	if err != nil {
+		log.Err(err).
+			Str("id", id.String()).
+			Msg("failed to update production plan")
+
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return domain.ProductionPlanDto{}, internalErrors.ProductionPlanAlreadyCompleted(id)
+		}
+
+		return domain.ProductionPlanDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to update production plan")
+	}
+
+	return updated.ToDto(), nil
+}
+
+func (service *productionPlanService) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := service.repository.Delete(ctx, id); err != nil {
+		log.Err(err).
+			Str("id", id.String()).
+			Msg("failed to delete production plan")
+
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return internalErrors.ProductionPlanNotFound(id)
+		}
+
+		return internalErrors.NewInternalServerErrorWrap(err, "failed to delete production plan")
+	}
+
+	return nil
+}
+
+// Schedule splits every item of the plan into as few mixes as the mixer
+// capacity allows, spreading the pieces evenly over them, and orders the
+// mixes longest fermentation first so the doughs are ready close together.
+// The mixes follow each other every MixMinutes starting at the plan date.
+func (service *productionPlanService) Schedule(ctx context.Context, id uuid.UUID) (domain.ProductionScheduleDto, error) {
+	plan, err := service.getById(ctx, id)
+	if err != nil {
+		return domain.ProductionScheduleDto{}, err
+	}
+	return service.schedule(ctx, plan)
+}
+
+func (service *productionPlanService) schedule(ctx context.Context, plan domain.ProductionPlanEntity) (domain.ProductionScheduleDto, error) {
+	var err error
+	var mixes []domain.ProductionMixDto
+	for _, item := range plan.Items {
+		fermentationMinutes := item.FermentationMinutes
+		if fermentationMinutes == 0 {
+			if fermentationMinutes, err = service.lastFermentationMinutes(ctx, item.RecipeId); err != nil {
+				return domain.ProductionScheduleDto{}, err
+			}
+		}
+
+		piecesPerMix := int(plan.MixerCapacity*1000) / item.PieceWeight
+		batches := int(math.Ceil(float64(item.Pieces) / float64(piecesPerMix)))
+		for batch := 0; batch < batches; batch++ {
+			pieces := item.Pieces / batches
+			if batch < item.Pieces%batches {
+				pieces++
+			}
+
+			recipe, err := service.sourdoughRecipeScaleService.Scale(ctx, item.RecipeId, domain.SourdoughRecipeScaleRequestDto{
+				FinalDoughWeight: pieces * item.PieceWeight,
+			})
+			if err != nil {
+				return domain.ProductionScheduleDto{}, err
+			}
+
+			mixes = append(mixes, domain.ProductionMixDto{
+				RecipeId:            item.RecipeId,
+				RecipeName:          recipe.Name,
+				Batch:               batch + 1,
+				Batches:             batches,
+				Pieces:              pieces,
+				PieceWeight:         item.PieceWeight,
+				DoughWeight:         pieces * item.PieceWeight,
+				FermentationMinutes: fermentationMinutes,
+				Recipe:              recipe,
+			})
+		}
+	}
+
+	sort.SliceStable(mixes, func(i, j int) bool {
+		return mixes[i].FermentationMinutes > mixes[j].FermentationMinutes
+	})
+
+	mixDuration := time.Duration(plan.MixMinutes) * time.Minute
+	for index := range mixes {
+		mixes[index].Sequence = index + 1
+		mixes[index].MixAt = plan.Date.Add(time.Duration(index) * mixDuration)
+		mixes[index].ReadyAt = mixes[index].MixAt.
+			Add(mixDuration).
+			Add(time.Duration(mixes[index].FermentationMinutes) * time.Minute)
+	}
+
+	return domain.ProductionScheduleDto{
+		PlanId:        plan.Id,
+		Name:          plan.Name,
+		Date:          plan.Date,
+		MixerCapacity: plan.MixerCapacity,
+		Mixes:         mixes,
+	}, nil
+}
+
+// Complete marks the plan as produced and deducts the ingredients of all its
+// mixes from the inventory, both in one transaction. A plan can be completed
+// only once: the completion only matches a plan that is not completed yet,
+// so of two concurrent calls one fails and its deduction is rolled back.
+func (service *productionPlanService) Complete(ctx context.Context, id uuid.UUID) (domain.ProductionPlanDto, error) {
+	plan, err := service.getById(ctx, id)
+	if err != nil {
+		return domain.ProductionPlanDto{}, err
+	}
+	if plan.CompletedAt != nil {
+		return domain.ProductionPlanDto{}, internalErrors.ProductionPlanAlreadyCompleted(id)
+	}
+
+	schedule, err := service.schedule(ctx, plan)
+	if err != nil {
+		return domain.ProductionPlanDto{}, err
+	}
+	recipes := utils.Map(schedule.Mixes, func(mix domain.ProductionMixDto) domain.SourdoughRecipeDto { return mix.Recipe })
+
+	var completed domain.ProductionPlanEntity
+	err = service.transactionRunner.WithTransaction(ctx, func(ctx context.Context) error {
+		completedAt := time.Now().UTC().Truncate(time.Millisecond)
+
+		var err error
+		completed, err = service.repository.Complete(ctx, id, completedAt)
+		if err != nil {
+			log.Err(err).
+				Str("id", id.String()).
+				Msg("failed to complete production plan")
+
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return internalErrors.ProductionPlanAlreadyCompleted(id)
+			}
+
+			return internalErrors.NewInternalServerErrorWrap(err, "failed to complete production plan")
+		}
+
+		return service.inventoryService.Deduct(ctx, recipes...)
+	})
+	if err != nil {
+		return domain.ProductionPlanDto{}, err
+	}
+
+	return completed.ToDto(), nil
+}
+
+// lastFermentationMinutes is the bulk fermentation and proof of the latest
+// bake of the recipe, 0 if it was never baked.
+func (service *productionPlanService) lastFermentationMinutes(ctx context.Context, recipeId uuid.UUID) (int, error) {
+	bakeLogs, err := service.bakeLogService.FindByRecipeId(ctx, recipeId, 0, 1)
+	if err != nil {
+		return 0, err
+	}
+	if len(bakeLogs) == 0 {
+		return 0, nil
+	}
+	return bakeLogs[0].Timings.BulkFermentationMinutes + bakeLogs[0].Timings.ProofMinutes, nil
+}
+
+// validate checks the request, fills in the default mix minutes and makes
+// sure every recipe of the plan exists.
+func (service *productionPlanService) validate(
+	ctx context.Context,
+	request domain.CreateProductionPlanRequest,
+) (domain.CreateProductionPlanRequest, error) {
+	request.Name = strings.TrimSpace(request.Name)
+	if request.Name == "" {
+		return request, internalErrors.ProductionPlanInvalid("name is required")
+	}
+	if request.Date.IsZero() {
+		return request, internalErrors.ProductionPlanInvalid("date is required")
+	}
+	if request.MixerCapacity <= 0 {
+		return request, internalErrors.ProductionPlanInvalid(
+			fmt.Sprintf("mixer capacity %.2f must be greater than 0", request.MixerCapacity))
+	}
+	if request.MixMinutes < 0 {
+		return request, internalErrors.ProductionPlanInvalid(
+			fmt.Sprintf("mix minutes %d must not be negative", request.MixMinutes))
+	}
+	if request.MixMinutes == 0 {
+		request.MixMinutes = defaultMixMinutes
+	}
+	if len(request.Items) == 0 {
+		return request, internalErrors.ProductionPlanInvalid("items are required")
+	}
+
+	for _, item := range request.Items {
+		switch {
+		case item.Pieces <= 0:
+			return request, internalErrors.ProductionPlanInvalid(
+				fmt.Sprintf("pieces %d of recipe %s must be greater than 0", item.Pieces, item.RecipeId.String()))
+		case item.PieceWeight <= 0:
+			return request, internalErrors.ProductionPlanInvalid(
+				fmt.Sprintf("piece weight %d of recipe %s must be greater than 0", item.PieceWeight, item.RecipeId.String()))
+		case float64(item.PieceWeight) > request.MixerCapacity*1000:
+			return request, internalErrors.ProductionPlanInvalid(
+				fmt.Sprintf("piece weight %d g of recipe %s exceeds the mixer capacity of %.2f kg",
+					item.PieceWeight, item.RecipeId.String(), request.MixerCapacity))
+		case item.FermentationMinutes < 0:
+			return request, internalErrors.ProductionPlanInvalid(
+				fmt.Sprintf("fermentation minutes %d of recipe %s must not be negative", item.FermentationMinutes, item.RecipeId.String()))
+		}
+
+		if _, err := service.sourdoughRecipeService.FindById(ctx, item.RecipeId); err != nil {
+			return request, err
+		}
+	}
+
+	return request, nil
+}
+
+func (service *productionPlanService) getById(ctx context.Context, id uuid.UUID) (domain.ProductionPlanEntity, error) {
+	plan, err := service.repository.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return domain.ProductionPlanEntity{}, internalErrors.ProductionPlanNotFound(id)
+		}
+
+		return domain.ProductionPlanEntity{}, internalErrors.NewInternalServerErrorWrap(err, "failed to find production plan")
+	}
+	return plan, nil
+}
+
+func NewProductionPlanService(
+	transactionRunner domain.TransactionRunner,
+	repository domain.ProductionPlanRepository,
+	sourdoughRecipeService domain.SourdoughRecipeService,
+	sourdoughRecipeScaleService domain.SourdoughRecipeScaleService,
+	bakeLogService domain.BakeLogService,
+	inventoryService domain.InventoryService,
+) (domain.ProductionPlanService, error) {
+	if transactionRunner == nil {
+		return nil, errors.New("transactionRunner cannot be nil")
+	}
+
+	if repository == nil {
+		return nil, errors.New("repository cannot be nil")
+	}
+
+	if sourdoughRecipeService == nil {
+		return nil, errors.New("sourdoughRecipeService cannot be nil")
+	}
+
+	if sourdoughRecipeScaleService == nil {
+		return nil, errors.New("sourdoughRecipeScaleService cannot be nil")
+	}
+
+	if bakeLogService == nil {
+		return nil, errors.New("bakeLogService cannot be nil")
+	}
+
+	if inventoryService == nil {
+		return nil, errors.New("inventoryService cannot be nil")
+	}
+
+	return &productionPlanService{
+		transactionRunner:           transactionRunner,
+		repository:                  repository,
+		sourdoughRecipeService:      sourdoughRecipeService,
+		sourdoughRecipeScaleService: sourdoughRecipeScaleService,
+		bakeLogService:              bakeLogService,
+		inventoryService:            inventoryService,
+	}, nil
+}
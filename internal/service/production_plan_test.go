@@ -0,0 +1,733 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func TestProductionPlanServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ProductionPlanServiceTestSuite))
+}
+
+type ProductionPlanServiceTestSuite struct {
+	test.GoMockTestSuite
+
+	ctx                         context.Context
+	transactionRunner           *mocks.MockTransactionRunner
+	repository                  *mocks.MockProductionPlanRepository
+	sourdoughRecipeService      *mocks.MockSourdoughRecipeService
+	sourdoughRecipeScaleService *mocks.MockSourdoughRecipeScaleService
+	bakeLogService              *mocks.MockBakeLogService
+	inventoryService            *mocks.MockInventoryService
+
+	target domain.ProductionPlanService
+}
+
+func (suite *ProductionPlanServiceTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.ctx = context.Background()
+	suite.transactionRunner = mocks.NewMockTransactionRunner(suite.MockCtrl)
+	suite.repository = mocks.NewMockProductionPlanRepository(suite.MockCtrl)
+	suite.sourdoughRecipeService = mocks.NewMockSourdoughRecipeService(suite.MockCtrl)
+	suite.sourdoughRecipeScaleService = mocks.NewMockSourdoughRecipeScaleService(suite.MockCtrl)
+	suite.bakeLogService = mocks.NewMockBakeLogService(suite.MockCtrl)
+	suite.inventoryService = mocks.NewMockInventoryService(suite.MockCtrl)
+
+	suite.target = test.Must(func() (domain.ProductionPlanService, error) {
+		return NewProductionPlanService(
+			suite.transactionRunner,
+			suite.repository,
+			suite.sourdoughRecipeService,
+			suite.sourdoughRecipeScaleService,
+			suite.bakeLogService,
+			suite.inventoryService,
+		)
+	})
+}
+
+func (suite *ProductionPlanServiceTestSuite) TestCreate() {
+	request := suite.createRequest()
+	request.Name = " Saturday market "
+	request.MixMinutes = 0
+
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, test.SecondId).Return(domain.SourdoughRecipeDto{}, nil)
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, test.ThirdId).Return(domain.SourdoughRecipeDto{}, nil)
+
+	var savedEntity domain.ProductionPlanEntity
+	suite.repository.EXPECT().Create(suite.ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, entity domain.ProductionPlanEntity) (domain.ProductionPlanEntity, error) {
+			savedEntity = entity
+			return entity, nil
+		})
+
+	actual, err := suite.target.Create(suite.ctx, request)
+
+	suite.NoError(err)
+	suite.Equal(savedEntity.ToDto(), actual)
+	suite.NotEqual(uuid.UUID{}, savedEntity.Id)
+	suite.Equal("Saturday market", savedEntity.Name)
+	suite.Equal(defaultMixMinutes, savedEntity.MixMinutes)
+	suite.Equal(request.Items, actual.Items)
+	suite.False(savedEntity.CreatedAt.IsZero())
+	suite.Nil(savedEntity.UpdatedAt)
+}
+
+func (suite *ProductionPlanServiceTestSuite) TestCreate_WithInvalidRequest() {
+	tests := []struct {
+		name          string
+		modify        func(request *domain.CreateProductionPlanRequest)
+		expectedError error
+	}{
+		{
+			name:          "missing name",
+			modify:        func(request *domain.CreateProductionPlanRequest) { request.Name = " " },
+			expectedError: internalErrors.ProductionPlanInvalid("name is required"),
+		},
+		{
+			name:          "missing date",
+			modify:        func(request *domain.CreateProductionPlanRequest) { request.Date = time.Time{} },
+			expectedError: internalErrors.ProductionPlanInvalid("date is required"),
+		},
+		{
+			name:          "zero mixer capacity",
+			modify:        func(request *domain.CreateProductionPlanRequest) { request.MixerCapacity = 0 },
+			expectedError: internalErrors.ProductionPlanInvalid("mixer capacity 0.00 must be greater than 0"),
+		},
+		{
+			name:          "negative mix minutes",
+			modify:        func(request *domain.CreateProductionPlanRequest) { request.MixMinutes = -5 },
+			expectedError: internalErrors.ProductionPlanInvalid("mix minutes -5 must not be negative"),
+		},
+		{
+			name:          "missing items",
+			modify:        func(request *domain.CreateProductionPlanRequest) { request.Items = nil },
+			expectedError: internalErrors.ProductionPlanInvalid("items are required"),
+		},
+		{
+			name:   "zero pieces",
+			modify: func(request *domain.CreateProductionPlanRequest) { request.Items[0].Pieces = 0 },
+			expectedError: internalErrors.ProductionPlanInvalid(
+				"pieces 0 of recipe a7670bf9-f4b0-4e5c-8edc-140812dbf719 must be greater than 0"),
+		},
+		{
+			name:   "zero piece weight",
+			modify: func(request *domain.CreateProductionPlanRequest) { request.Items[0].PieceWeight = 0 },
+			expectedError: internalErrors.ProductionPlanInvalid(
+				"piece weight 0 of recipe a7670bf9-f4b0-4e5c-8edc-140812dbf719 must be greater than 0"),
+		},
+		{
+			name:   "piece heavier than the mixer capacity",
+			modify: func(request *domain.CreateProductionPlanRequest) { request.Items[0].PieceWeight = 5001 },
+			expectedError: internalErrors.ProductionPlanInvalid(
+				"piece weight 5001 g of recipe a7670bf9-f4b0-4e5c-8edc-140812dbf719 exceeds the mixer capacity of 5.00 kg"),
+		},
+		{
+			name:   "negative fermentation minutes",
+			modify: func(request *domain.CreateProductionPlanRequest) { request.Items[0].FermentationMinutes = -1 },
+			expectedError: internalErrors.ProductionPlanInvalid(
+				"fermentation minutes -1 of recipe a7670bf9-f4b0-4e5c-8edc-140812dbf719 must not be negative"),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			request := suite.createRequest()
+			tt.modify(&request)
+
+			_, err := suite.target.Create(suite.ctx, request)
+
+			suite.Equal(tt.expectedError, err)
+		})
+	}
+}
+
+func (suite *ProductionPlanServiceTestSuite) TestCreate_WithUnknownRecipe() {
+	expectedError := internalErrors.SourdoughRecipeNotFound("recipe not found")
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, test.SecondId).Return(domain.SourdoughRecipeDto{}, expectedError)
+
+	_, err := suite.target.Create(suite.ctx, suite.createRequest())
+
+	suite.Equal(expectedError, err)
+}
+
+func (suite *ProductionPlanServiceTestSuite) TestCreate_WithError() {
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, gomock.Any()).Return(domain.SourdoughRecipeDto{}, nil).Times(2)
+	suite.repository.EXPECT().Create(suite.ctx, gomock.Any()).
+		Return(domain.ProductionPlanEntity{}, assert.AnError)
+
+	_, err := suite.target.Create(suite.ctx, suite.createRequest())
+
+	suite.ErrorContains(err, "failed to create production plan")
+}
+
+func (suite *ProductionPlanServiceTestSuite) TestFindById() {
+	entity := suite.createEntity()
+
+	suite.repository.EXPECT().GetById(suite.ctx, entity.Id).Return(entity, nil)
+
+	actual, err := suite.target.FindById(suite.ctx, entity.Id)
+
+	suite.NoError(err)
+	suite.Equal(entity.ToDto(), actual)
+}
+
+func (suite *ProductionPlanServiceTestSuite) TestFindById_WithError() {
+	tests := []struct {
+		name                string
+		errorFromRepository error
+		expectedError       error
+	}{
+		{
+			name:                "with basic error",
+			errorFromRepository: assert.AnError,
+			expectedError:       internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to find production plan"),
+		},
+		{
+			name:                "with document not found error",
+			errorFromRepository: mongo.ErrNoDocuments,
+			expectedError:       internalErrors.ProductionPlanNotFound(test.FirstId),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.repository.EXPECT().GetById(suite.ctx, test.FirstId).
+				Return(domain.ProductionPlanEntity{}, tt.errorFromRepository)
+
+			_, err := suite.target.FindById(suite.ctx, test.FirstId)
+
+			suite.Equal(tt.expectedError, err)
+		})
+	}
+}
+
+func (suite *ProductionPlanServiceTestSuite) TestFind() {
+	entity := suite.createEntity()
+
+	suite.repository.EXPECT().Find(suite.ctx, 10, 5).Return([]domain.ProductionPlanEntity{entity}, nil)
+
+	actual, err := suite.target.Find(suite.ctx, 10, 5)
+
+	suite.NoError(err)
+	suite.Equal([]domain.ProductionPlanDto{entity.ToDto()}, actual)
+}
+
+func (suite *ProductionPlanServiceTestSuite) TestFind_WithError() {
+	suite.repository.EXPECT().Find(suite.ctx, 0, 25).Return(nil, assert.AnError)
+
+	_, err := suite.target.Find(suite.ctx, 0, 25)
+
+	suite.ErrorContains(err, "failed to find production plans")
+}
+
+func (suite *ProductionPlanServiceTestSuite) TestUpdate() {
+	entity := suite.createEntity()
+	request := suite.createRequest()
+	request.MixerCapacity = 8
+	request.Items = request.Items[:1]
+
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, test.SecondId).Return(domain.SourdoughRecipeDto{}, nil)
+	suite.repository.EXPECT().GetById(suite.ctx, entity.Id).Return(entity, nil)
+
+	var savedEntity domain.ProductionPlanEntity
+	suite.repository.EXPECT().Update(suite.ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, entity domain.ProductionPlanEntity) (domain.ProductionPlanEntity, error) {
+			savedEntity = entity
+			return entity, nil
+		})
+
+	actual, err := suite.target.Update(suite.ctx, entity.Id, request)
+
+	suite.NoError(err)
+	suite.Equal(savedEntity.ToDto(), actual)
+	suite.Equal(entity.Id, savedEntity.Id)
+	suite.Equal(entity.CreatedAt, savedEntity.CreatedAt)
+	suite.Equal(8.0, savedEntity.MixerCapacity)
+	suite.Len(savedEntity.Items, 1)
+	suite.NotNil(savedEntity.UpdatedAt)
+}
+
+func (suite *ProductionPlanServiceTestSuite) TestUpdate_WithError() {
+	tests := []struct {
+		name                string
+		errorFromRepository error
+		expectedError       error
+	}{
+		{
+			name:                "with basic error",
+			errorFromRepository: assert.AnError,
+			expectedError:       internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to update production plan"),
+		},
+		{
+			name:                "with plan completed concurrently",
+			errorFromRepository: mongo.ErrNoDocuments,
+			expectedError:       internalErrors.ProductionPlanAlreadyCompleted(test.FirstId),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, gomock.Any()).Return(domain.SourdoughRecipeDto{}, nil).Times(2)
+			suite.repository.EXPECT().GetById(suite.ctx, test.FirstId).Return(suite.createEntity(), nil)
+			suite.repository.EXPECT().Update(suite.ctx, gomock.Any()).
+				Return(domain.ProductionPlanEntity{}, tt.errorFromRepository)
+
+			_, err := suite.target.Update(suite.ctx, test.FirstId, suite.createRequest())
+
+			suite.Equal(tt.expectedError, err)
+		})
+	}
+}
+
+func (suite *ProductionPlanServiceTestSuite) TestUpdate_WithCompletedPlan() {
+	entity := suite.createEntity()
+	completedAt := time.Now().UTC()
+	entity.CompletedAt = &completedAt
+
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, gomock.Any()).Return(domain.SourdoughRecipeDto{}, nil).Times(2)
+	suite.repository.EXPECT().GetById(suite.ctx, entity.Id).Return(entity, nil)
+
+	_, err := suite.target.Update(suite.ctx, entity.Id, suite.createRequest())
+
+	suite.Equal(internalErrors.ProductionPlanAlreadyCompleted(entity.Id), err)
+}
+
+func (suite *ProductionPlanServiceTestSuite) TestDelete() {
+	suite.repository.EXPECT().Delete(suite.ctx, test.FirstId).Return(nil)
+
+	suite.NoError(suite.target.Delete(suite.ctx, test.FirstId))
+}
+
+func (suite *ProductionPlanServiceTestSuite) TestDelete_WithError() {
+	tests := []struct {
+		name                string
+		errorFromRepository error
+		expectedError       error
+	}{
+		{
+			name:                "with basic error",
+			errorFromRepository: assert.AnError,
+			expectedError:       internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to delete production plan"),
+		},
+		{
+			name:                "with document not found error",
+			errorFromRepository: mongo.ErrNoDocuments,
+			expectedError:       internalErrors.ProductionPlanNotFound(test.FirstId),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.repository.EXPECT().Delete(suite.ctx, test.FirstId).Return(tt.errorFromRepository)
+
+			err := suite.target.Delete(suite.ctx, test.FirstId)
+
+			suite.Equal(tt.expectedError, err)
+		})
+	}
+}
+
+func (suite *ProductionPlanServiceTestSuite) TestSchedule() {
+	entity := suite.createEntity()
+	baguette := domain.SourdoughRecipeDto{RecipeDto: domain.RecipeDto{Id: test.SecondId, Name: "Baguette"}}
+	rye := domain.SourdoughRecipeDto{RecipeDto: domain.RecipeDto{Id: test.ThirdId, Name: "Rye"}}
+
+	suite.repository.EXPECT().GetById(suite.ctx, entity.Id).Return(entity, nil)
+	suite.bakeLogService.EXPECT().FindByRecipeId(suite.ctx, test.ThirdId, 0, 1).
+		Return([]domain.BakeLogDto{{Timings: domain.BakeTimingsDto{BulkFermentationMinutes: 300, ProofMinutes: 90}}}, nil)
+	suite.sourdoughRecipeScaleService.EXPECT().
+		Scale(suite.ctx, test.SecondId, domain.SourdoughRecipeScaleRequestDto{FinalDoughWeight: 4 * 900}).
+		Return(baguette, nil).
+		Times(3)
+	suite.sourdoughRecipeScaleService.EXPECT().
+		Scale(suite.ctx, test.ThirdId, domain.SourdoughRecipeScaleRequestDto{FinalDoughWeight: 3 * 1000}).
+		Return(rye, nil)
+
+	actual, err := suite.target.Schedule(suite.ctx, entity.Id)
+
+	suite.NoError(err)
+	suite.Equal(entity.Id, actual.PlanId)
+	suite.Equal(entity.Name, actual.Name)
+	suite.Equal(entity.Date, actual.Date)
+	suite.Equal(entity.MixerCapacity, actual.MixerCapacity)
+	suite.Equal([]domain.ProductionMixDto{
+		{
+			Sequence:            1,
+			RecipeId:            test.ThirdId,
+			RecipeName:          "Rye",
+			Batch:               1,
+			Batches:             1,
+			Pieces:              3,
+			PieceWeight:         1000,
+			DoughWeight:         3000,
+			FermentationMinutes: 390,
+			MixAt:               entity.Date,
+			ReadyAt:             entity.Date.Add(410 * time.Minute),
+			Recipe:              rye,
+		},
+		{
+			Sequence:            2,
+			RecipeId:            test.SecondId,
+			RecipeName:          "Baguette",
+			Batch:               1,
+			Batches:             3,
+			Pieces:              4,
+			PieceWeight:         900,
+			DoughWeight:         3600,
+			FermentationMinutes: 240,
+			MixAt:               entity.Date.Add(20 * time.Minute),
+			ReadyAt:             entity.Date.Add(280 * time.Minute),
+			Recipe:              baguette,
+		},
+		{
+			Sequence:            3,
+			RecipeId:            test.SecondId,
+			RecipeName:          "Baguette",
+			Batch:               2,
+			Batches:             3,
+			Pieces:              4,
+			PieceWeight:         900,
+			DoughWeight:         3600,
+			FermentationMinutes: 240,
+			MixAt:               entity.Date.Add(40 * time.Minute),
+			ReadyAt:             entity.Date.Add(300 * time.Minute),
+			Recipe:              baguette,
+		},
+		{
+			Sequence:            4,
+			RecipeId:            test.SecondId,
+			RecipeName:          "Baguette",
+			Batch:               3,
+			Batches:             3,
+			Pieces:              4,
+			PieceWeight:         900,
+			DoughWeight:         3600,
+			FermentationMinutes: 240,
+			MixAt:               entity.Date.Add(60 * time.Minute),
+			ReadyAt:             entity.Date.Add(320 * time.Minute),
+			Recipe:              baguette,
+		},
+	}, actual.Mixes)
+}
+
+func (suite *ProductionPlanServiceTestSuite) TestSchedule_SpreadsPiecesOverMixes() {
+	entity := suite.createEntity()
+	entity.Items = []domain.ProductionPlanItem{{RecipeId: test.SecondId, Pieces: 11, PieceWeight: 900, FermentationMinutes: 240}}
+
+	suite.repository.EXPECT().GetById(suite.ctx, entity.Id).Return(entity, nil)
+	suite.sourdoughRecipeScaleService.EXPECT().
+		Scale(suite.ctx, test.SecondId, domain.SourdoughRecipeScaleRequestDto{FinalDoughWeight: 4 * 900}).
+		Return(domain.SourdoughRecipeDto{}, nil).
+		Times(2)
+	suite.sourdoughRecipeScaleService.EXPECT().
+		Scale(suite.ctx, test.SecondId, domain.SourdoughRecipeScaleRequestDto{FinalDoughWeight: 3 * 900}).
+		Return(domain.SourdoughRecipeDto{}, nil)
+
+	actual, err := suite.target.Schedule(suite.ctx, entity.Id)
+
+	suite.NoError(err)
+	suite.Len(actual.Mixes, 3)
+	suite.Equal(4, actual.Mixes[0].Pieces)
+	suite.Equal(4, actual.Mixes[1].Pieces)
+	suite.Equal(3, actual.Mixes[2].Pieces)
+}
+
+func (suite *ProductionPlanServiceTestSuite) TestSchedule_WithoutBakeLogs() {
+	entity := suite.createEntity()
+	entity.Items = entity.Items[1:]
+
+	suite.repository.EXPECT().GetById(suite.ctx, entity.Id).Return(entity, nil)
+	suite.bakeLogService.EXPECT().FindByRecipeId(suite.ctx, test.ThirdId, 0, 1).Return(nil, nil)
+	suite.sourdoughRecipeScaleService.EXPECT().Scale(suite.ctx, test.ThirdId, gomock.Any()).
+		Return(domain.SourdoughRecipeDto{}, nil)
+
+	actual, err := suite.target.Schedule(suite.ctx, entity.Id)
+
+	suite.NoError(err)
+	suite.Equal(0, actual.Mixes[0].FermentationMinutes)
+	suite.Equal(entity.Date.Add(20*time.Minute), actual.Mixes[0].ReadyAt)
+}
+
+func (suite *ProductionPlanServiceTestSuite) TestSchedule_WithError() {
+	tests := []struct {
+		name          string
+		mocks         func(entity domain.ProductionPlanEntity)
+		expectedError error
+	}{
+		{
+			name: "plan not found",
+			mocks: func(entity domain.ProductionPlanEntity) {
+				suite.repository.EXPECT().GetById(suite.ctx, entity.Id).Return(domain.ProductionPlanEntity{}, mongo.ErrNoDocuments)
+			},
+			expectedError: internalErrors.ProductionPlanNotFound(test.FirstId),
+		},
+		{
+			name: "scale fails",
+			mocks: func(entity domain.ProductionPlanEntity) {
+				suite.repository.EXPECT().GetById(suite.ctx, entity.Id).Return(entity, nil)
+				suite.sourdoughRecipeScaleService.EXPECT().Scale(suite.ctx, test.SecondId, gomock.Any()).
+					Return(domain.SourdoughRecipeDto{}, assert.AnError)
+			},
+			expectedError: assert.AnError,
+		},
+		{
+			name: "bake logs fail",
+			mocks: func(entity domain.ProductionPlanEntity) {
+				suite.repository.EXPECT().GetById(suite.ctx, entity.Id).Return(entity, nil)
+				suite.sourdoughRecipeScaleService.EXPECT().Scale(suite.ctx, test.SecondId, gomock.Any()).
+					Return(domain.SourdoughRecipeDto{}, nil).
+					Times(3)
+				suite.bakeLogService.EXPECT().FindByRecipeId(suite.ctx, test.ThirdId, 0, 1).Return(nil, assert.AnError)
+			},
+			expectedError: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			entity := suite.createEntity()
+			tt.mocks(entity)
+
+			_, err := suite.target.Schedule(suite.ctx, entity.Id)
+
+			suite.Equal(tt.expectedError, err)
+		})
+	}
+}
+
+func (suite *ProductionPlanServiceTestSuite) TestComplete() {
+	entity := suite.createEntity()
+	entity.Items = entity.Items[:1]
+	entity.Items[0].Pieces = 5
+	baguette := domain.SourdoughRecipeDto{RecipeDto: domain.RecipeDto{Id: test.SecondId, Name: "Baguette"}}
+	txCtx := context.WithValue(suite.ctx, "transaction", true)
+
+	suite.repository.EXPECT().GetById(suite.ctx, entity.Id).Return(entity, nil)
+	suite.sourdoughRecipeScaleService.EXPECT().
+		Scale(suite.ctx, test.SecondId, domain.SourdoughRecipeScaleRequestDto{FinalDoughWeight: 5 * 900}).
+		Return(baguette, nil)
+	suite.expectTransaction(txCtx)
+
+	var completed domain.ProductionPlanEntity
+	suite.repository.EXPECT().Complete(txCtx, entity.Id, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, completedAt time.Time) (domain.ProductionPlanEntity, error) {
+			completed = entity
+			completed.CompletedAt = &completedAt
+			completed.UpdatedAt = &completedAt
+			return completed, nil
+		})
+	suite.inventoryService.EXPECT().Deduct(txCtx, baguette).Return(nil)
+
+	actual, err := suite.target.Complete(suite.ctx, entity.Id)
+
+	suite.NoError(err)
+	suite.Equal(completed.ToDto(), actual)
+	suite.NotNil(actual.CompletedAt)
+}
+
+// expectTransaction runs the transaction with txCtx, so the test sees which
+// calls take part in it.
+func (suite *ProductionPlanServiceTestSuite) expectTransaction(txCtx context.Context) {
+	suite.transactionRunner.EXPECT().WithTransaction(suite.ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, fn func(ctx context.Context) error) error {
+			return fn(txCtx)
+		})
+}
+
+func (suite *ProductionPlanServiceTestSuite) TestComplete_WithError() {
+	completedAt := test.Date
+	baguette := domain.SourdoughRecipeDto{RecipeDto: domain.RecipeDto{Id: test.SecondId, Name: "Baguette"}}
+	entity := suite.createEntity()
+	entity.Items = entity.Items[:1]
+	entity.Items[0].Pieces = 5
+
+	tests := []struct {
+		name          string
+		mocks         func()
+		expectedError error
+	}{
+		{
+			name: "plan not found",
+			mocks: func() {
+				suite.repository.EXPECT().GetById(suite.ctx, entity.Id).
+					Return(domain.ProductionPlanEntity{}, mongo.ErrNoDocuments)
+			},
+			expectedError: internalErrors.ProductionPlanNotFound(entity.Id),
+		},
+		{
+			name: "plan already completed",
+			mocks: func() {
+				completed := entity
+				completed.CompletedAt = &completedAt
+				suite.repository.EXPECT().GetById(suite.ctx, entity.Id).Return(completed, nil)
+			},
+			expectedError: internalErrors.ProductionPlanAlreadyCompleted(entity.Id),
+		},
+		{
+			name: "scale fails",
+			mocks: func() {
+				suite.repository.EXPECT().GetById(suite.ctx, entity.Id).Return(entity, nil)
+				suite.sourdoughRecipeScaleService.EXPECT().Scale(suite.ctx, test.SecondId, gomock.Any()).
+					Return(domain.SourdoughRecipeDto{}, assert.AnError)
+			},
+			expectedError: assert.AnError,
+		},
+		{
+			name: "plan completed concurrently",
+			mocks: func() {
+				suite.repository.EXPECT().GetById(suite.ctx, entity.Id).Return(entity, nil)
+				suite.sourdoughRecipeScaleService.EXPECT().Scale(suite.ctx, test.SecondId, gomock.Any()).
+					Return(baguette, nil)
+				suite.expectTransaction(suite.ctx)
+				suite.repository.EXPECT().Complete(suite.ctx, entity.Id, gomock.Any()).
+					Return(domain.ProductionPlanEntity{}, mongo.ErrNoDocuments)
+			},
+			expectedError: internalErrors.ProductionPlanAlreadyCompleted(entity.Id),
+		},
+		{
+			name: "complete fails",
+			mocks: func() {
+				suite.repository.EXPECT().GetById(suite.ctx, entity.Id).Return(entity, nil)
+				suite.sourdoughRecipeScaleService.EXPECT().Scale(suite.ctx, test.SecondId, gomock.Any()).
+					Return(baguette, nil)
+				suite.expectTransaction(suite.ctx)
+				suite.repository.EXPECT().Complete(suite.ctx, entity.Id, gomock.Any()).
+					Return(domain.ProductionPlanEntity{}, assert.AnError)
+			},
+			expectedError: internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to complete production plan"),
+		},
+		{
+			name: "deduct fails",
+			mocks: func() {
+				suite.repository.EXPECT().GetById(suite.ctx, entity.Id).Return(entity, nil)
+				suite.sourdoughRecipeScaleService.EXPECT().Scale(suite.ctx, test.SecondId, gomock.Any()).
+					Return(baguette, nil)
+				suite.expectTransaction(suite.ctx)
+				suite.repository.EXPECT().Complete(suite.ctx, entity.Id, gomock.Any()).Return(entity, nil)
+				suite.inventoryService.EXPECT().Deduct(suite.ctx, baguette).Return(assert.AnError)
+			},
+			expectedError: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mocks()
+
+			actual, err := suite.target.Complete(suite.ctx, entity.Id)
+
+			suite.Equal(tt.expectedError, err)
+			suite.Empty(actual)
+		})
+	}
+}
+
+func (suite *ProductionPlanServiceTestSuite) createRequest() domain.CreateProductionPlanRequest {
+	return domain.CreateProductionPlanRequest{
+		Name:          "Saturday market",
+		Date:          test.Date,
+		MixerCapacity: 5,
+		MixMinutes:    20,
+		Items: []domain.ProductionPlanItemDto{
+			{RecipeId: test.SecondId, Pieces: 12, PieceWeight: 900, FermentationMinutes: 240},
+			{RecipeId: test.ThirdId, Pieces: 3, PieceWeight: 1000},
+		},
+	}
+}
+
+func (suite *ProductionPlanServiceTestSuite) createEntity() domain.ProductionPlanEntity {
+	return domain.ProductionPlanEntity{
+		Id:            test.FirstId,
+		Name:          "Saturday market",
+		Date:          test.Date,
+		MixerCapacity: 5,
+		MixMinutes:    20,
+		Items: []domain.ProductionPlanItem{
+			{RecipeId: test.SecondId, Pieces: 12, PieceWeight: 900, FermentationMinutes: 240},
+			{RecipeId: test.ThirdId, Pieces: 3, PieceWeight: 1000},
+		},
+		CreatedAt: test.Date,
+	}
+}
+
+func TestNewProductionPlanService_WithError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	transactionRunner := mocks.NewMockTransactionRunner(mockCtrl)
+	repository := mocks.NewMockProductionPlanRepository(mockCtrl)
+	sourdoughRecipeService := mocks.NewMockSourdoughRecipeService(mockCtrl)
+	sourdoughRecipeScaleService := mocks.NewMockSourdoughRecipeScaleService(mockCtrl)
+	bakeLogService := mocks.NewMockBakeLogService(mockCtrl)
+
+	tests := []struct {
+		name     string
+		creator  func() (domain.ProductionPlanService, error)
+		errorMsg string
+	}{
+		{
+			name: "transactionRunner is nil",
+			creator: func() (domain.ProductionPlanService, error) {
+				return NewProductionPlanService(nil, nil, nil, nil, nil, nil)
+			},
+			errorMsg: "transactionRunner cannot be nil",
+		},
+		{
+			name: "repository is nil",
+			creator: func() (domain.ProductionPlanService, error) {
+				return NewProductionPlanService(transactionRunner, nil, nil, nil, nil, nil)
+			},
+			errorMsg: "repository cannot be nil",
+		},
+		{
+			name: "sourdoughRecipeService is nil",
+			creator: func() (domain.ProductionPlanService, error) {
+				return NewProductionPlanService(transactionRunner, repository, nil, nil, nil, nil)
+			},
+			errorMsg: "sourdoughRecipeService cannot be nil",
+		},
+		{
+			name: "sourdoughRecipeScaleService is nil",
+			creator: func() (domain.ProductionPlanService, error) {
+				return NewProductionPlanService(transactionRunner, repository, sourdoughRecipeService, nil, nil, nil)
+			},
+			errorMsg: "sourdoughRecipeScaleService cannot be nil",
+		},
+		{
+			name: "bakeLogService is nil",
+			creator: func() (domain.ProductionPlanService, error) {
+				return NewProductionPlanService(transactionRunner, repository, sourdoughRecipeService, sourdoughRecipeScaleService, nil, nil)
+			},
+			errorMsg: "bakeLogService cannot be nil",
+		},
+		{
+			name: "inventoryService is nil",
+			creator: func() (domain.ProductionPlanService, error) {
+				return NewProductionPlanService(transactionRunner, repository, sourdoughRecipeService, sourdoughRecipeScaleService, bakeLogService, nil)
+			},
+			errorMsg: "inventoryService cannot be nil",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, err := tt.creator()
+
+			assert.Nil(t, service)
+			assert.ErrorContains(t, err, tt.errorMsg)
+		})
+	}
+}
@@ -0,0 +1,345 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+)
+
+var recipeBundleConflicts = []string{
+	domain.RecipeBundleConflictRename,
+	domain.RecipeBundleConflictSkip,
+	domain.RecipeBundleConflictReplace,
+}
+
+type recipeBundleService struct {
+	transactionRunner domain.TransactionRunner
+	recipeRepository  domain.SourdoughRecipeRepository
+	recipeService     domain.SourdoughRecipeService
+	flourRepository   domain.FlourRepository
+	flourService      domain.FlourService
+}
+
+// Export bundles the recipes matching the filter with their flours. Flours
+// are taken from the catalogue, or from the recipe when the catalogue no
+// longer has them.
+func (service *recipeBundleService) Export(ctx context.Context, filter domain.SourdoughRecipeFilter) (domain.RecipeBundleDto, error) {
+	if err := validateRecipeFilter(filter); err != nil {
+		return domain.RecipeBundleDto{}, err
+	}
+
+	filter.Tags = normalizeTags(filter.Tags)
+	filter.Categories = normalizeTags(filter.Categories)
+
+	recipes, err := service.recipeRepository.FindAll(ctx, filter)
+	if err != nil {
+		log.Err(err).
+			Msg("failed to find recipes to export")
+
+		return domain.RecipeBundleDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to export recipes")
+	}
+
+	bundle := domain.RecipeBundleDto{
+		SchemaVersion: domain.RecipeBundleSchemaVersion,
+		ExportedAt:    time.Now().UTC().Truncate(time.Millisecond),
+		Flours:        []domain.FlourDto{},
+		Recipes:       make([]domain.SourdoughRecipeDto, 0, len(recipes)),
+	}
+
+	exported := map[uuid.UUID]bool{}
+	for _, recipe := range recipes {
+		bundle.Recipes = append(bundle.Recipes, recipe.ToDto())
+
+		for _, flour := range append(slices.Clone(recipe.Flour), recipe.Levain.Flour...) {
+			if exported[flour.Id] {
+				continue
+			}
+			exported[flour.Id] = true
+
+			catalogued, err := service.flourRepository.FindById(ctx, flour.Id)
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				catalogued = flour.FlourEntity
+			} else if err != nil {
+				log.Err(err).
+					Str("id", flour.Id.String()).
+					Msg("failed to find flour to export")
+
+				return domain.RecipeBundleDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to export recipes")
+			}
+
+			bundle.Flours = append(bundle.Flours, catalogued.ToDto())
+		}
+	}
+
+	slices.SortFunc(bundle.Flours, func(a, b domain.FlourDto) int {
+		if a.Name != b.Name {
+			return strings.Compare(a.Name, b.Name)
+		}
+		return strings.Compare(a.Id.String(), b.Id.String())
+	})
+
+	return bundle, nil
+}
+
+// Import creates the recipes of the bundle in a single transaction. Bundle
+// flours are looked up by id, then by name, and created when the catalogue
+// has neither; the recipes are remapped to the catalogue flours. A recipe
+// whose name is taken is renamed, skipped or replaces the existing recipe
+// as onConflict says. Forks are imported as standalone recipes.
+func (service *recipeBundleService) Import(
+	ctx context.Context,
+	bundle domain.RecipeBundleDto,
+	onConflict string,
+) (domain.RecipeBundleImportResultDto, error) {
+	if onConflict == "" {
+		onConflict = domain.RecipeBundleConflictRename
+	}
+
+	if err := validateRecipeBundle(bundle, onConflict); err != nil {
+		return domain.RecipeBundleImportResultDto{}, err
+	}
+
+	var result domain.RecipeBundleImportResultDto
+	err := service.transactionRunner.WithTransaction(ctx, func(ctx context.Context) (err error) {
+		result, err = service.importBundle(ctx, bundle, onConflict)
+		return err
+	})
+	if err != nil {
+		var serviceError *internalErrors.ServiceError
+		if errors.As(err, &serviceError) {
+			return domain.RecipeBundleImportResultDto{}, err
+		}
+
+		log.Err(err).
+			Msg("failed to import recipe bundle")
+
+		return domain.RecipeBundleImportResultDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to import recipe bundle")
+	}
+
+	return result, nil
+}
+
+func validateRecipeBundle(bundle domain.RecipeBundleDto, onConflict string) error {
+	if bundle.SchemaVersion != domain.RecipeBundleSchemaVersion {
+		return internalErrors.RecipeBundleInvalid(fmt.Sprintf(
+			"schema version %d is not supported, expected %d", bundle.SchemaVersion, domain.RecipeBundleSchemaVersion))
+	}
+
+	if !slices.Contains(recipeBundleConflicts, onConflict) {
+		return internalErrors.RecipeBundleInvalid(fmt.Sprintf(
+			"on conflict %s must be one of %s", onConflict, strings.Join(recipeBundleConflicts, ", ")))
+	}
+
+	flours := map[uuid.UUID]bool{}
+	for i, flour := range bundle.Flours {
+		switch {
+		case flour.Id == uuid.Nil:
+			return internalErrors.RecipeBundleInvalid(fmt.Sprintf("flour %d has no id", i+1))
+		case strings.TrimSpace(flour.Name) == "":
+			return internalErrors.RecipeBundleInvalid(fmt.Sprintf("flour %s has no name", flour.Id.String()))
+		case flours[flour.Id]:
+			return internalErrors.RecipeBundleInvalid(fmt.Sprintf("flour %s is listed more than once", flour.Id.String()))
+		}
+		flours[flour.Id] = true
+	}
+
+	for i, recipe := range bundle.Recipes {
+		if strings.TrimSpace(recipe.Name) == "" {
+			return internalErrors.RecipeBundleInvalid(fmt.Sprintf("recipe %d has no name", i+1))
+		}
+
+		for _, flour := range append(slices.Clone(recipe.Flour), recipe.Levain.Flour...) {
+			if !flours[flour.Id] {
+				return internalErrors.RecipeBundleInvalid(fmt.Sprintf(
+					"recipe %s uses flour %s that is not part of the bundle", recipe.Name, flour.Id.String()))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (service *recipeBundleService) importBundle(
+	ctx context.Context,
+	bundle domain.RecipeBundleDto,
+	onConflict string,
+) (domain.RecipeBundleImportResultDto, error) {
+	result := domain.RecipeBundleImportResultDto{
+		Flours:  make([]domain.RecipeBundleFlourImportDto, 0, len(bundle.Flours)),
+		Recipes: make([]domain.RecipeBundleRecipeImportDto, 0, len(bundle.Recipes)),
+	}
+
+	flours := make(map[uuid.UUID]domain.FlourDto, len(bundle.Flours))
+	for _, flour := range bundle.Flours {
+		imported, action, err := service.importFlour(ctx, flour)
+		if err != nil {
+			return domain.RecipeBundleImportResultDto{}, err
+		}
+
+		flours[flour.Id] = imported
+		result.Flours = append(result.Flours, domain.RecipeBundleFlourImportDto{
+			SourceId: flour.Id,
+			Id:       imported.Id,
+			Name:     imported.Name,
+			Action:   action,
+		})
+	}
+
+	for _, recipe := range bundle.Recipes {
+		imported, err := service.importRecipe(ctx, recipe, flours, onConflict)
+		if err != nil {
+			return domain.RecipeBundleImportResultDto{}, err
+		}
+
+		result.Recipes = append(result.Recipes, imported)
+	}
+
+	return result, nil
+}
+
+func (service *recipeBundleService) importFlour(ctx context.Context, flour domain.FlourDto) (domain.FlourDto, string, error) {
+	existing, err := service.flourRepository.FindById(ctx, flour.Id)
+	if err == nil {
+		return existing.ToDto(), domain.RecipeBundleFlourMatched, nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return domain.FlourDto{}, "", errors.Wrap(err, "failed to find flour by id")
+	}
+
+	existing, err = service.flourRepository.GetByName(ctx, flour.Name)
+	if err == nil {
+		return existing.ToDto(), domain.RecipeBundleFlourRemapped, nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return domain.FlourDto{}, "", errors.Wrap(err, "failed to find flour by name")
+	}
+
+	created, err := service.flourService.Create(ctx, flour.ToCreateRequest())
+	if err != nil {
+		return domain.FlourDto{}, "", err
+	}
+
+	return created, domain.RecipeBundleFlourCreated, nil
+}
+
+func (service *recipeBundleService) importRecipe(
+	ctx context.Context,
+	recipe domain.SourdoughRecipeDto,
+	flours map[uuid.UUID]domain.FlourDto,
+	onConflict string,
+) (domain.RecipeBundleRecipeImportDto, error) {
+	request := recipe.ToCreateRequest()
+	request.Flour = remapBundleFlour(request.Flour, flours)
+	request.Levain.Flour = remapBundleFlour(request.Levain.Flour, flours)
+
+	imported := domain.RecipeBundleRecipeImportDto{
+		SourceId: recipe.Id,
+		Name:     request.Name,
+		Action:   domain.RecipeBundleRecipeCreated,
+	}
+
+	existing, err := service.recipeRepository.GetByName(ctx, request.Name)
+	switch {
+	case err == nil && onConflict == domain.RecipeBundleConflictSkip:
+		imported.Id = existing.Id
+		imported.Action = domain.RecipeBundleRecipeSkipped
+		return imported, nil
+	case err == nil && onConflict == domain.RecipeBundleConflictReplace:
+		replaced, err := service.recipeService.Update(ctx, existing.Id, request)
+		if err != nil {
+			return domain.RecipeBundleRecipeImportDto{}, err
+		}
+		imported.Id = replaced.Id
+		imported.Action = domain.RecipeBundleRecipeReplaced
+		return imported, nil
+	case err == nil:
+		if request.Name, err = service.freeRecipeName(ctx, request.Name); err != nil {
+			return domain.RecipeBundleRecipeImportDto{}, err
+		}
+		imported.Name = request.Name
+		imported.Action = domain.RecipeBundleRecipeRenamed
+	case !errors.Is(err, mongo.ErrNoDocuments):
+		return domain.RecipeBundleRecipeImportDto{}, errors.Wrap(err, "failed to find recipe by name")
+	}
+
+	created, err := service.recipeService.Create(ctx, request)
+	if err != nil {
+		return domain.RecipeBundleRecipeImportDto{}, err
+	}
+	imported.Id = created.Id
+
+	return imported, nil
+}
+
+// freeRecipeName returns the first of "name (imported)", "name (imported 2)",
+// ... no recipe is called yet.
+func (service *recipeBundleService) freeRecipeName(ctx context.Context, name string) (string, error) {
+	for i := 1; ; i++ {
+		candidate := fmt.Sprintf("%s (imported)", name)
+		if i > 1 {
+			candidate = fmt.Sprintf("%s (imported %d)", name, i)
+		}
+
+		_, err := service.recipeRepository.GetByName(ctx, candidate)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return candidate, nil
+		}
+		if err != nil {
+			return "", errors.Wrap(err, "failed to find recipe by name")
+		}
+	}
+}
+
+func remapBundleFlour(amounts []domain.FlourAmountDto, flours map[uuid.UUID]domain.FlourDto) []domain.FlourAmountDto {
+	remapped := make([]domain.FlourAmountDto, 0, len(amounts))
+	for _, amount := range amounts {
+		remapped = append(remapped, domain.FlourAmountDto{FlourDto: flours[amount.Id], Amount: amount.Amount})
+	}
+	return remapped
+}
+
+func NewRecipeBundleService(
+	transactionRunner domain.TransactionRunner,
+	recipeRepository domain.SourdoughRecipeRepository,
+	recipeService domain.SourdoughRecipeService,
+	flourRepository domain.FlourRepository,
+	flourService domain.FlourService,
+) (domain.RecipeBundleService, error) {
+	if transactionRunner == nil {
+		return nil, errors.New("transactionRunner cannot be nil")
+	}
+
+	if recipeRepository == nil {
+		return nil, errors.New("recipeRepository cannot be nil")
+	}
+
+	if recipeService == nil {
+		return nil, errors.New("recipeService cannot be nil")
+	}
+
+	if flourRepository == nil {
+		return nil, errors.New("flourRepository cannot be nil")
+	}
+
+	if flourService == nil {
+		return nil, errors.New("flourService cannot be nil")
+	}
+
+	return &recipeBundleService{
+		transactionRunner: transactionRunner,
+		recipeRepository:  recipeRepository,
+		recipeService:     recipeService,
+		flourRepository:   flourRepository,
+		flourService:      flourService,
+	}, nil
+}
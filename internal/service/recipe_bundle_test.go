@@ -0,0 +1,455 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func TestRecipeBundleServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(RecipeBundleServiceTestSuite))
+}
+
+type RecipeBundleServiceTestSuite struct {
+	test.GoMockTestSuite
+
+	ctx               context.Context
+	transactionRunner *mocks.MockTransactionRunner
+	recipeRepository  *mocks.MockSourdoughRecipeRepository
+	recipeService     *mocks.MockSourdoughRecipeService
+	flourRepository   *mocks.MockFlourRepository
+	flourService      *mocks.MockFlourService
+
+	breadFlour domain.FlourEntity
+	ryeFlour   domain.FlourEntity
+
+	target domain.RecipeBundleService
+}
+
+func (suite *RecipeBundleServiceTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.ctx = context.Background()
+	suite.transactionRunner = mocks.NewMockTransactionRunner(suite.MockCtrl)
+	suite.recipeRepository = mocks.NewMockSourdoughRecipeRepository(suite.MockCtrl)
+	suite.recipeService = mocks.NewMockSourdoughRecipeService(suite.MockCtrl)
+	suite.flourRepository = mocks.NewMockFlourRepository(suite.MockCtrl)
+	suite.flourService = mocks.NewMockFlourService(suite.MockCtrl)
+
+	suite.breadFlour = domain.FlourEntity{Id: test.FirstId, Name: "Bread flour", FlourType: "bread", ProteinContent: 12.5}
+	suite.ryeFlour = domain.FlourEntity{Id: test.SecondId, Name: "Rye flour", FlourType: "rye"}
+
+	suite.target = test.Must(func() (domain.RecipeBundleService, error) {
+		return NewRecipeBundleService(suite.transactionRunner, suite.recipeRepository, suite.recipeService, suite.flourRepository, suite.flourService)
+	})
+}
+
+func (suite *RecipeBundleServiceTestSuite) TestExport() {
+	catalogued := suite.breadFlour
+	catalogued.Description = "Updated in the catalogue"
+
+	country := suite.createRecipe(test.FirstId, "Country loaf", suite.breadFlour, suite.ryeFlour)
+	baguette := suite.createRecipe(test.SecondId, "Baguette", suite.breadFlour)
+
+	suite.recipeRepository.EXPECT().
+		FindAll(suite.ctx, domain.SourdoughRecipeFilter{Ids: []uuid.UUID{test.FirstId, test.SecondId}, Tags: []string{"rye"}}).
+		Return([]domain.SourdoughRecipeEntity{country, baguette}, nil)
+	suite.flourRepository.EXPECT().FindById(suite.ctx, suite.breadFlour.Id).Return(catalogued, nil)
+	suite.flourRepository.EXPECT().FindById(suite.ctx, suite.ryeFlour.Id).
+		Return(domain.FlourEntity{}, mongo.ErrNoDocuments)
+
+	actual, err := suite.target.Export(suite.ctx, domain.SourdoughRecipeFilter{
+		Ids:  []uuid.UUID{test.FirstId, test.SecondId},
+		Tags: []string{" Rye "},
+	})
+
+	suite.NoError(err)
+	suite.Equal(domain.RecipeBundleSchemaVersion, actual.SchemaVersion)
+	suite.WithinDuration(time.Now(), actual.ExportedAt, time.Minute)
+	suite.Equal([]domain.FlourDto{catalogued.ToDto(), suite.ryeFlour.ToDto()}, actual.Flours)
+	suite.Equal([]domain.SourdoughRecipeDto{country.ToDto(), baguette.ToDto()}, actual.Recipes)
+}
+
+func (suite *RecipeBundleServiceTestSuite) TestExport_WithoutRecipes() {
+	suite.recipeRepository.EXPECT().FindAll(suite.ctx, domain.SourdoughRecipeFilter{}).
+		Return([]domain.SourdoughRecipeEntity{}, nil)
+
+	actual, err := suite.target.Export(suite.ctx, domain.SourdoughRecipeFilter{})
+
+	suite.NoError(err)
+	suite.Equal([]domain.FlourDto{}, actual.Flours)
+	suite.Equal([]domain.SourdoughRecipeDto{}, actual.Recipes)
+}
+
+func (suite *RecipeBundleServiceTestSuite) TestExport_WithInvalidFilter() {
+	minHydration, maxHydration := 80.0, 70.0
+
+	actual, err := suite.target.Export(suite.ctx, domain.SourdoughRecipeFilter{MinHydration: &minHydration, MaxHydration: &maxHydration})
+
+	suite.Equal(internalErrors.SourdoughRecipeFilterInvalid("min hydration 80.00 must not be greater than max hydration 70.00"), err)
+	suite.Equal(domain.RecipeBundleDto{}, actual)
+}
+
+func (suite *RecipeBundleServiceTestSuite) TestExport_WithError() {
+	tests := []struct {
+		name string
+		mock func()
+	}{
+		{
+			name: "find recipes",
+			mock: func() {
+				suite.recipeRepository.EXPECT().FindAll(suite.ctx, gomock.Any()).Return(nil, assert.AnError)
+			},
+		},
+		{
+			name: "find flour",
+			mock: func() {
+				suite.recipeRepository.EXPECT().FindAll(suite.ctx, gomock.Any()).
+					Return([]domain.SourdoughRecipeEntity{suite.createRecipe(test.FirstId, "Baguette", suite.breadFlour)}, nil)
+				suite.flourRepository.EXPECT().FindById(suite.ctx, suite.breadFlour.Id).
+					Return(domain.FlourEntity{}, assert.AnError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mock()
+
+			actual, err := suite.target.Export(suite.ctx, domain.SourdoughRecipeFilter{})
+
+			suite.ErrorContains(err, "failed to export recipes")
+			suite.Equal(domain.RecipeBundleDto{}, actual)
+		})
+	}
+}
+
+func (suite *RecipeBundleServiceTestSuite) TestImport() {
+	spelt := domain.FlourDto{Id: test.ThirdId, Name: "Spelt flour", FlourType: "spelt"}
+	createdSpelt := spelt
+	createdSpelt.Id = uuid.New()
+	// the target installation knows the rye flour under another id
+	targetRye := suite.ryeFlour
+	targetRye.Id = uuid.New()
+
+	country := suite.createRecipe(uuid.New(), "Country loaf", suite.breadFlour, suite.ryeFlour).ToDto()
+	country.Levain.Flour = []domain.FlourAmountDto{{FlourDto: spelt, Amount: 50}}
+	rye := suite.createRecipe(uuid.New(), "Rye", suite.ryeFlour).ToDto()
+	existingRye := suite.createRecipe(uuid.New(), "Rye", targetRye)
+
+	bundle := domain.RecipeBundleDto{
+		SchemaVersion: domain.RecipeBundleSchemaVersion,
+		Flours:        []domain.FlourDto{suite.breadFlour.ToDto(), suite.ryeFlour.ToDto(), spelt},
+		Recipes:       []domain.SourdoughRecipeDto{country, rye},
+	}
+
+	suite.expectTransaction()
+	suite.flourRepository.EXPECT().FindById(suite.ctx, suite.breadFlour.Id).Return(suite.breadFlour, nil)
+	suite.flourRepository.EXPECT().FindById(suite.ctx, suite.ryeFlour.Id).Return(domain.FlourEntity{}, mongo.ErrNoDocuments)
+	suite.flourRepository.EXPECT().GetByName(suite.ctx, "Rye flour").Return(targetRye, nil)
+	suite.flourRepository.EXPECT().FindById(suite.ctx, spelt.Id).Return(domain.FlourEntity{}, mongo.ErrNoDocuments)
+	suite.flourRepository.EXPECT().GetByName(suite.ctx, "Spelt flour").Return(domain.FlourEntity{}, mongo.ErrNoDocuments)
+	suite.flourService.EXPECT().Create(suite.ctx, spelt.ToCreateRequest()).Return(createdSpelt, nil)
+
+	expectedCountry := country.ToCreateRequest()
+	expectedCountry.Flour = []domain.FlourAmountDto{
+		{FlourDto: suite.breadFlour.ToDto(), Amount: 500},
+		{FlourDto: targetRye.ToDto(), Amount: 500},
+	}
+	expectedCountry.Levain.Flour = []domain.FlourAmountDto{{FlourDto: createdSpelt, Amount: 50}}
+	createdCountry := country
+	createdCountry.Id = uuid.New()
+
+	suite.recipeRepository.EXPECT().GetByName(suite.ctx, "Country loaf").
+		Return(domain.SourdoughRecipeEntity{}, mongo.ErrNoDocuments)
+	suite.recipeService.EXPECT().Create(suite.ctx, expectedCountry).Return(createdCountry, nil)
+
+	expectedRye := rye.ToCreateRequest()
+	expectedRye.Name = "Rye (imported 2)"
+	expectedRye.Flour = []domain.FlourAmountDto{{FlourDto: targetRye.ToDto(), Amount: 500}}
+	expectedRye.Levain.Flour = []domain.FlourAmountDto{{FlourDto: targetRye.ToDto(), Amount: 50}}
+	createdRye := rye
+	createdRye.Id = uuid.New()
+
+	suite.recipeRepository.EXPECT().GetByName(suite.ctx, "Rye").Return(existingRye, nil)
+	suite.recipeRepository.EXPECT().GetByName(suite.ctx, "Rye (imported)").Return(existingRye, nil)
+	suite.recipeRepository.EXPECT().GetByName(suite.ctx, "Rye (imported 2)").
+		Return(domain.SourdoughRecipeEntity{}, mongo.ErrNoDocuments)
+	suite.recipeService.EXPECT().Create(suite.ctx, expectedRye).Return(createdRye, nil)
+
+	actual, err := suite.target.Import(suite.ctx, bundle, "")
+
+	suite.NoError(err)
+	suite.Equal(domain.RecipeBundleImportResultDto{
+		Flours: []domain.RecipeBundleFlourImportDto{
+			{SourceId: suite.breadFlour.Id, Id: suite.breadFlour.Id, Name: "Bread flour", Action: domain.RecipeBundleFlourMatched},
+			{SourceId: suite.ryeFlour.Id, Id: targetRye.Id, Name: "Rye flour", Action: domain.RecipeBundleFlourRemapped},
+			{SourceId: spelt.Id, Id: createdSpelt.Id, Name: "Spelt flour", Action: domain.RecipeBundleFlourCreated},
+		},
+		Recipes: []domain.RecipeBundleRecipeImportDto{
+			{SourceId: country.Id, Id: createdCountry.Id, Name: "Country loaf", Action: domain.RecipeBundleRecipeCreated},
+			{SourceId: rye.Id, Id: createdRye.Id, Name: "Rye (imported 2)", Action: domain.RecipeBundleRecipeRenamed},
+		},
+	}, actual)
+}
+
+func (suite *RecipeBundleServiceTestSuite) TestImport_WithConflict() {
+	rye := suite.createRecipe(uuid.New(), "Rye", suite.ryeFlour).ToDto()
+	existingRye := suite.createRecipe(uuid.New(), "Rye", suite.ryeFlour)
+	bundle := domain.RecipeBundleDto{
+		SchemaVersion: domain.RecipeBundleSchemaVersion,
+		Flours:        []domain.FlourDto{suite.ryeFlour.ToDto()},
+		Recipes:       []domain.SourdoughRecipeDto{rye},
+	}
+
+	tests := []struct {
+		name       string
+		onConflict string
+		mock       func()
+		expected   domain.RecipeBundleRecipeImportDto
+	}{
+		{
+			name:       "skip",
+			onConflict: domain.RecipeBundleConflictSkip,
+			mock:       func() {},
+			expected:   domain.RecipeBundleRecipeImportDto{SourceId: rye.Id, Id: existingRye.Id, Name: "Rye", Action: domain.RecipeBundleRecipeSkipped},
+		},
+		{
+			name:       "replace",
+			onConflict: domain.RecipeBundleConflictReplace,
+			mock: func() {
+				replaced := rye
+				replaced.Id = existingRye.Id
+				suite.recipeService.EXPECT().Update(suite.ctx, existingRye.Id, rye.ToCreateRequest()).Return(replaced, nil)
+			},
+			expected: domain.RecipeBundleRecipeImportDto{SourceId: rye.Id, Id: existingRye.Id, Name: "Rye", Action: domain.RecipeBundleRecipeReplaced},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.expectTransaction()
+			suite.flourRepository.EXPECT().FindById(suite.ctx, suite.ryeFlour.Id).Return(suite.ryeFlour, nil)
+			suite.recipeRepository.EXPECT().GetByName(suite.ctx, "Rye").Return(existingRye, nil)
+			tt.mock()
+
+			actual, err := suite.target.Import(suite.ctx, bundle, tt.onConflict)
+
+			suite.NoError(err)
+			suite.Equal([]domain.RecipeBundleRecipeImportDto{tt.expected}, actual.Recipes)
+		})
+	}
+}
+
+func (suite *RecipeBundleServiceTestSuite) TestImport_WithInvalidBundle() {
+	valid := func() domain.RecipeBundleDto {
+		return domain.RecipeBundleDto{
+			SchemaVersion: domain.RecipeBundleSchemaVersion,
+			Flours:        []domain.FlourDto{suite.breadFlour.ToDto()},
+			Recipes:       []domain.SourdoughRecipeDto{suite.createRecipe(test.ThirdId, "Baguette", suite.breadFlour).ToDto()},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		bundle     func() domain.RecipeBundleDto
+		onConflict string
+		details    string
+	}{
+		{
+			name: "unsupported schema version",
+			bundle: func() domain.RecipeBundleDto {
+				bundle := valid()
+				bundle.SchemaVersion = 2
+				return bundle
+			},
+			details: "schema version 2 is not supported, expected 1",
+		},
+		{
+			name:       "unknown conflict strategy",
+			bundle:     valid,
+			onConflict: "merge",
+			details:    "on conflict merge must be one of rename, skip, replace",
+		},
+		{
+			name: "flour without id",
+			bundle: func() domain.RecipeBundleDto {
+				bundle := valid()
+				bundle.Flours[0].Id = uuid.Nil
+				return bundle
+			},
+			details: "flour 1 has no id",
+		},
+		{
+			name: "flour without name",
+			bundle: func() domain.RecipeBundleDto {
+				bundle := valid()
+				bundle.Flours[0].Name = " "
+				return bundle
+			},
+			details: "flour " + test.FirstId.String() + " has no name",
+		},
+		{
+			name: "repeated flour",
+			bundle: func() domain.RecipeBundleDto {
+				bundle := valid()
+				bundle.Flours = append(bundle.Flours, bundle.Flours[0])
+				return bundle
+			},
+			details: "flour " + test.FirstId.String() + " is listed more than once",
+		},
+		{
+			name: "recipe without name",
+			bundle: func() domain.RecipeBundleDto {
+				bundle := valid()
+				bundle.Recipes[0].Name = ""
+				return bundle
+			},
+			details: "recipe 1 has no name",
+		},
+		{
+			name: "recipe flour missing",
+			bundle: func() domain.RecipeBundleDto {
+				bundle := valid()
+				bundle.Recipes[0].Levain.Flour = []domain.FlourAmountDto{{FlourDto: suite.ryeFlour.ToDto(), Amount: 50}}
+				return bundle
+			},
+			details: "recipe Baguette uses flour " + test.SecondId.String() + " that is not part of the bundle",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			actual, err := suite.target.Import(suite.ctx, tt.bundle(), tt.onConflict)
+
+			suite.Equal(internalErrors.RecipeBundleInvalid(tt.details), err)
+			suite.Equal(domain.RecipeBundleImportResultDto{}, actual)
+		})
+	}
+}
+
+func (suite *RecipeBundleServiceTestSuite) TestImport_WithError() {
+	bundle := domain.RecipeBundleDto{
+		SchemaVersion: domain.RecipeBundleSchemaVersion,
+		Flours:        []domain.FlourDto{suite.ryeFlour.ToDto()},
+		Recipes:       []domain.SourdoughRecipeDto{suite.createRecipe(uuid.New(), "Rye", suite.ryeFlour).ToDto()},
+	}
+	flourInvalid := internalErrors.FlourInvalid("flour type rye not found")
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expectedError error
+	}{
+		{
+			name: "transaction",
+			mock: func() {
+				suite.transactionRunner.EXPECT().WithTransaction(suite.ctx, gomock.Any()).Return(assert.AnError)
+			},
+			expectedError: internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to import recipe bundle"),
+		},
+		{
+			name: "find flour",
+			mock: func() {
+				suite.expectTransaction()
+				suite.flourRepository.EXPECT().FindById(suite.ctx, suite.ryeFlour.Id).Return(domain.FlourEntity{}, assert.AnError)
+			},
+			expectedError: internalErrors.NewInternalServerErrorWrap(
+				errors.Wrap(assert.AnError, "failed to find flour by id"), "failed to import recipe bundle"),
+		},
+		{
+			name: "create flour",
+			mock: func() {
+				suite.expectTransaction()
+				suite.flourRepository.EXPECT().FindById(suite.ctx, suite.ryeFlour.Id).Return(domain.FlourEntity{}, mongo.ErrNoDocuments)
+				suite.flourRepository.EXPECT().GetByName(suite.ctx, "Rye flour").Return(domain.FlourEntity{}, mongo.ErrNoDocuments)
+				suite.flourService.EXPECT().Create(suite.ctx, gomock.Any()).Return(domain.FlourDto{}, flourInvalid)
+			},
+			expectedError: flourInvalid,
+		},
+		{
+			name: "find recipe",
+			mock: func() {
+				suite.expectTransaction()
+				suite.flourRepository.EXPECT().FindById(suite.ctx, suite.ryeFlour.Id).Return(suite.ryeFlour, nil)
+				suite.recipeRepository.EXPECT().GetByName(suite.ctx, "Rye").Return(domain.SourdoughRecipeEntity{}, assert.AnError)
+			},
+			expectedError: internalErrors.NewInternalServerErrorWrap(
+				errors.Wrap(assert.AnError, "failed to find recipe by name"), "failed to import recipe bundle"),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mock()
+
+			actual, err := suite.target.Import(suite.ctx, bundle, domain.RecipeBundleConflictRename)
+
+			suite.EqualError(err, tt.expectedError.Error())
+			suite.Equal(domain.RecipeBundleImportResultDto{}, actual)
+		})
+	}
+}
+
+func (suite *RecipeBundleServiceTestSuite) expectTransaction() {
+	suite.transactionRunner.EXPECT().WithTransaction(suite.ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+}
+
+func (suite *RecipeBundleServiceTestSuite) createRecipe(id uuid.UUID, name string, flours ...domain.FlourEntity) domain.SourdoughRecipeEntity {
+	recipe := domain.SourdoughRecipeEntity{
+		RecipeEntity: domain.RecipeEntity{
+			Id:        id,
+			Name:      name,
+			Water:     []domain.BakerAmount{{Amount: 700}},
+			CreatedAt: test.Date,
+			Version:   1,
+		},
+		Levain: domain.SourdoughLevainAgent{
+			Flour: []domain.FlourAmount{{FlourEntity: flours[0], Amount: 50}},
+		},
+	}
+	for _, flour := range flours {
+		recipe.Flour = append(recipe.Flour, domain.FlourAmount{FlourEntity: flour, Amount: 500})
+	}
+	return recipe
+}
+
+func TestNewRecipeBundleService_WithNilDependencies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	transactionRunner := mocks.NewMockTransactionRunner(ctrl)
+	recipeRepository := mocks.NewMockSourdoughRecipeRepository(ctrl)
+	recipeService := mocks.NewMockSourdoughRecipeService(ctrl)
+	flourRepository := mocks.NewMockFlourRepository(ctrl)
+	flourService := mocks.NewMockFlourService(ctrl)
+
+	_, err := NewRecipeBundleService(nil, recipeRepository, recipeService, flourRepository, flourService)
+	assert.EqualError(t, err, "transactionRunner cannot be nil")
+
+	_, err = NewRecipeBundleService(transactionRunner, nil, recipeService, flourRepository, flourService)
+	assert.EqualError(t, err, "recipeRepository cannot be nil")
+
+	_, err = NewRecipeBundleService(transactionRunner, recipeRepository, nil, flourRepository, flourService)
+	assert.EqualError(t, err, "recipeService cannot be nil")
+
+	_, err = NewRecipeBundleService(transactionRunner, recipeRepository, recipeService, nil, flourService)
+	assert.EqualError(t, err, "flourRepository cannot be nil")
+
+	_, err = NewRecipeBundleService(transactionRunner, recipeRepository, recipeService, flourRepository, nil)
+	assert.EqualError(t, err, "flourService cannot be nil")
+}
@@ -0,0 +1,422 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"dough-calculator/internal/config"
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+)
+
+// recipeJsonLdMaxImages caps the images listed in the JSON-LD of a recipe,
+// search engines only show the first ones.
+const recipeJsonLdMaxImages = 10
+
+// recipeJsonLdServingSize is the nutrition facts entry published when the
+// recipe has it, otherwise the first entry by name is used.
+const recipeJsonLdServingSize = "100g"
+
+var (
+	jsonLdLeadingNumberPattern = regexp.MustCompile(`^\s*(\d+(?:[.,]\d+)?)\s*(.*)$`)
+)
+
+type recipeJsonLdService struct {
+	sourdoughRecipeService domain.SourdoughRecipeService
+	parseService           domain.SourdoughRecipeParseService
+	bakeLogService         domain.BakeLogService
+	imageService           domain.ImageService
+	rest                   config.Rest
+}
+
+// Export maps the recipe with the given id to a schema.org Recipe.
+func (service *recipeJsonLdService) Export(ctx context.Context, id uuid.UUID) (domain.SchemaOrgRecipeDto, error) {
+	recipe, err := service.sourdoughRecipeService.FindById(ctx, id)
+	if err != nil {
+		return domain.SchemaOrgRecipeDto{}, err
+	}
+
+	return service.ExportRecipe(ctx, recipe)
+}
+
+// ExportRecipe maps the recipe to a schema.org Recipe. Ingredients are listed
+// in grams, times come from the latest bake and images link to the image
+// download of the API.
+func (service *recipeJsonLdService) ExportRecipe(ctx context.Context, recipe domain.SourdoughRecipeDto) (domain.SchemaOrgRecipeDto, error) {
+	bakeLogs, err := service.bakeLogService.FindByRecipeId(ctx, recipe.Id, 0, 1)
+	if err != nil {
+		return domain.SchemaOrgRecipeDto{}, err
+	}
+
+	images, err := service.imageService.FindByRecipeId(ctx, recipe.Id, nil, 0, recipeJsonLdMaxImages)
+	if err != nil {
+		return domain.SchemaOrgRecipeDto{}, err
+	}
+
+	jsonLd := domain.SchemaOrgRecipeDto{
+		Context:          domain.SchemaOrgContext,
+		Type:             domain.SchemaOrgRecipeType,
+		Identifier:       recipe.Id.String(),
+		Name:             recipe.Name,
+		Description:      recipe.Description,
+		RecipeIngredient: recipeIngredients(recipe),
+		Nutrition:        schemaOrgNutrition(recipe.NutritionFacts),
+		RecipeCategory:   recipe.Category,
+		Keywords:         strings.Join(recipe.Tags, ", "),
+		DatePublished:    recipe.CreatedAt.Format(time.DateOnly),
+	}
+
+	if recipe.Yield.Amount > 0 {
+		jsonLd.RecipeYield = strings.TrimSpace(fmt.Sprintf("%d %s", recipe.Yield.Amount, recipe.Yield.Unit))
+	}
+
+	if recipe.UpdatedAt != nil {
+		jsonLd.DateModified = recipe.UpdatedAt.Format(time.DateOnly)
+	}
+
+	if len(bakeLogs) > 0 {
+		timings := bakeLogs[0].Timings
+		prepMinutes := timings.BulkFermentationMinutes + timings.ProofMinutes
+		jsonLd.PrepTime = isoDuration(prepMinutes)
+		jsonLd.CookTime = isoDuration(timings.BakeMinutes)
+		jsonLd.TotalTime = isoDuration(prepMinutes + timings.BakeMinutes)
+	}
+
+	baseUrl := strings.TrimSuffix(service.rest.PublicUrl, "/") + service.rest.ContextPath
+	for _, image := range images {
+		jsonLd.Image = append(jsonLd.Image,
+			fmt.Sprintf("%s/recipe/sourdough/%s/images/%s", baseUrl, recipe.Id.String(), image.Id.String()))
+	}
+
+	return jsonLd, nil
+}
+
+// Import creates a recipe from the first schema.org Recipe of a JSON-LD
+// document. The ingredients are read like a plain-text ingredient list, the
+// ones that could not be read are reported instead of failing the import.
+func (service *recipeJsonLdService) Import(ctx context.Context, content []byte) (domain.RecipeJsonLdImportDto, error) {
+	document, err := findSchemaOrgRecipe(content)
+	if err != nil {
+		return domain.RecipeJsonLdImportDto{}, internalErrors.RecipeJsonLdInvalid(err.Error())
+	}
+
+	name := strings.TrimSpace(document.Name)
+	if name == "" {
+		return domain.RecipeJsonLdImportDto{}, internalErrors.RecipeJsonLdInvalid("recipe name is required")
+	}
+
+	ingredients := jsonLdTexts(document.RecipeIngredient)
+	if len(ingredients) == 0 {
+		ingredients = jsonLdTexts(document.Ingredients)
+	}
+	if len(ingredients) == 0 {
+		return domain.RecipeJsonLdImportDto{}, internalErrors.RecipeJsonLdInvalid("recipe has no recipeIngredient")
+	}
+
+	parsed, err := service.parseService.Parse(ctx, domain.SourdoughRecipeParseRequest{
+		Name: name,
+		Text: strings.Join(ingredients, "\n"),
+	})
+	if err != nil {
+		return domain.RecipeJsonLdImportDto{}, err
+	}
+
+	request := parsed.Recipe
+	request.Description = strings.TrimSpace(document.Description)
+	request.Yield = jsonLdYield(jsonLdTexts(document.RecipeYield))
+	request.NutritionFacts = jsonLdNutritionFacts(document.Nutrition)
+	request.Tags = jsonLdKeywords(jsonLdTexts(document.Keywords))
+	if categories := jsonLdTexts(document.RecipeCategory); len(categories) > 0 {
+		request.Category = categories[0]
+	}
+
+	recipe, err := service.sourdoughRecipeService.Create(ctx, request)
+	if err != nil {
+		return domain.RecipeJsonLdImportDto{}, err
+	}
+
+	return domain.RecipeJsonLdImportDto{
+		Recipe:       recipe,
+		FlourMatches: parsed.FlourMatches,
+		Unrecognised: parsed.Unrecognised,
+	}, nil
+}
+
+// schemaOrgRecipeDocument reads the schema.org Recipe properties the import
+// uses. Most properties may be a text, a number or a list of them.
+type schemaOrgRecipeDocument struct {
+	Type             json.RawMessage           `json:"@type"`
+	Graph            []json.RawMessage         `json:"@graph"`
+	Name             string                    `json:"name"`
+	Description      string                    `json:"description"`
+	RecipeIngredient json.RawMessage           `json:"recipeIngredient"`
+	Ingredients      json.RawMessage           `json:"ingredients"`
+	RecipeYield      json.RawMessage           `json:"recipeYield"`
+	RecipeCategory   json.RawMessage           `json:"recipeCategory"`
+	Keywords         json.RawMessage           `json:"keywords"`
+	Nutrition        *schemaOrgNutritionSource `json:"nutrition"`
+}
+
+type schemaOrgNutritionSource struct {
+	ServingSize         json.RawMessage `json:"servingSize"`
+	Calories            json.RawMessage `json:"calories"`
+	FatContent          json.RawMessage `json:"fatContent"`
+	CarbohydrateContent json.RawMessage `json:"carbohydrateContent"`
+	ProteinContent      json.RawMessage `json:"proteinContent"`
+	FiberContent        json.RawMessage `json:"fiberContent"`
+}
+
+// findSchemaOrgRecipe returns the first node typed Recipe of a JSON-LD
+// document, looking into top level lists and @graph.
+func findSchemaOrgRecipe(content []byte) (schemaOrgRecipeDocument, error) {
+	content = bytes.TrimSpace(content)
+	if !json.Valid(content) {
+		return schemaOrgRecipeDocument{}, errors.New("document is not valid JSON")
+	}
+
+	nodes := []json.RawMessage{content}
+	for len(nodes) > 0 {
+		node := nodes[0]
+		nodes = nodes[1:]
+
+		var list []json.RawMessage
+		if json.Unmarshal(node, &list) == nil {
+			nodes = append(nodes, list...)
+			continue
+		}
+
+		var document schemaOrgRecipeDocument
+		if json.Unmarshal(node, &document) != nil {
+			continue
+		}
+		for _, nodeType := range jsonLdTexts(document.Type) {
+			if nodeType == domain.SchemaOrgRecipeType || nodeType == domain.SchemaOrgContext+"/"+domain.SchemaOrgRecipeType {
+				return document, nil
+			}
+		}
+		nodes = append(nodes, document.Graph...)
+	}
+
+	return schemaOrgRecipeDocument{}, errors.New("document has no schema.org Recipe")
+}
+
+// jsonLdTexts reads a text, a number or a list of them, skipping blanks.
+func jsonLdTexts(raw json.RawMessage) []string {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	var values []any
+	if json.Unmarshal(raw, &values) != nil {
+		var value any
+		if json.Unmarshal(raw, &value) != nil {
+			return nil
+		}
+		values = []any{value}
+	}
+
+	texts := make([]string, 0, len(values))
+	for _, value := range values {
+		var text string
+		switch typed := value.(type) {
+		case string:
+			text = strings.TrimSpace(typed)
+		case float64:
+			text = strconv.FormatFloat(typed, 'f', -1, 64)
+		}
+		if text != "" {
+			texts = append(texts, text)
+		}
+	}
+	return texts
+}
+
+// jsonLdYield reads the first yield with a whole amount, "2 loaves" or 2.
+func jsonLdYield(yields []string) domain.RecipeYieldDto {
+	for _, yield := range yields {
+		match := jsonLdLeadingNumberPattern.FindStringSubmatch(yield)
+		if match == nil {
+			continue
+		}
+		amount, err := strconv.ParseFloat(strings.Replace(match[1], ",", ".", 1), 64)
+		if err != nil || amount < 1 {
+			continue
+		}
+		return domain.RecipeYieldDto{Unit: strings.TrimSpace(match[2]), Amount: int(math.Round(amount))}
+	}
+	return domain.RecipeYieldDto{}
+}
+
+// jsonLdKeywords splits comma separated keywords into tags.
+func jsonLdKeywords(keywords []string) []string {
+	var tags []string
+	for _, keyword := range keywords {
+		for _, tag := range strings.Split(keyword, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+	}
+	return tags
+}
+
+// jsonLdNutritionFacts keeps the nutrition under its serving size, or under
+// "serving" when none is given. Values are read up to their unit.
+func jsonLdNutritionFacts(nutrition *schemaOrgNutritionSource) map[string]domain.NutritionFactsDto {
+	if nutrition == nil {
+		return nil
+	}
+
+	servingSize := "serving"
+	if servingSizes := jsonLdTexts(nutrition.ServingSize); len(servingSizes) > 0 {
+		servingSize = servingSizes[0]
+	}
+
+	return map[string]domain.NutritionFactsDto{
+		servingSize: {
+			Calories: int(math.Round(jsonLdQuantity(nutrition.Calories))),
+			Fat:      jsonLdQuantity(nutrition.FatContent),
+			Carbs:    jsonLdQuantity(nutrition.CarbohydrateContent),
+			Protein:  jsonLdQuantity(nutrition.ProteinContent),
+			Fiber:    jsonLdQuantity(nutrition.FiberContent),
+		},
+	}
+}
+
+// jsonLdQuantity reads the number of a quantity such as "250 kcal", 0 when
+// there is none.
+func jsonLdQuantity(raw json.RawMessage) float64 {
+	texts := jsonLdTexts(raw)
+	if len(texts) == 0 {
+		return 0
+	}
+	match := jsonLdLeadingNumberPattern.FindStringSubmatch(texts[0])
+	if match == nil {
+		return 0
+	}
+	value, err := strconv.ParseFloat(strings.Replace(match[1], ",", ".", 1), 64)
+	if err != nil {
+		return 0
+	}
+	return value
+}
+
+// recipeIngredients lists the dough ingredients in grams, in the order they
+// are weighed: flours, water, levain and the rest.
+func recipeIngredients(recipe domain.SourdoughRecipeDto) []string {
+	ingredients := make([]string, 0, len(recipe.Flour)+len(recipe.Water)+len(recipe.AdditionalIngredients)+1)
+	for _, flour := range recipe.Flour {
+		ingredients = append(ingredients, ingredientText(flour.Amount, flour.Name))
+	}
+	for _, water := range recipe.Water {
+		name := water.Name
+		if name == "" {
+			name = "water"
+		}
+		ingredients = append(ingredients, ingredientText(water.Amount, name))
+	}
+	if recipe.Levain.Amount.Amount > 0 {
+		ingredients = append(ingredients, ingredientText(recipe.Levain.Amount.Amount, "levain"))
+	}
+	for _, ingredient := range recipe.AdditionalIngredients {
+		ingredients = append(ingredients, ingredientText(ingredient.Amount, ingredient.Name))
+	}
+	return ingredients
+}
+
+func ingredientText(amount float64, name string) string {
+	return fmt.Sprintf("%s g %s", strconv.FormatFloat(roundTo(amount, 1), 'f', -1, 64), name)
+}
+
+// schemaOrgNutrition publishes one entry of the nutrition facts, preferably
+// the one per 100 g.
+func schemaOrgNutrition(nutritionFacts map[string]domain.NutritionFactsDto) *domain.SchemaOrgNutritionDto {
+	if len(nutritionFacts) == 0 {
+		return nil
+	}
+
+	servingSize := recipeJsonLdServingSize
+	if _, ok := nutritionFacts[servingSize]; !ok {
+		servingSizes := make([]string, 0, len(nutritionFacts))
+		for key := range nutritionFacts {
+			servingSizes = append(servingSizes, key)
+		}
+		sort.Strings(servingSizes)
+		servingSize = servingSizes[0]
+	}
+
+	facts := nutritionFacts[servingSize]
+	grams := func(value float64) string {
+		return strconv.FormatFloat(roundTo(value, 1), 'f', -1, 64) + " g"
+	}
+
+	return &domain.SchemaOrgNutritionDto{
+		Type:                "NutritionInformation",
+		ServingSize:         servingSize,
+		Calories:            fmt.Sprintf("%d kcal", facts.Calories),
+		FatContent:          grams(facts.Fat),
+		CarbohydrateContent: grams(facts.Carbs),
+		ProteinContent:      grams(facts.Protein),
+		FiberContent:        grams(facts.Fiber),
+	}
+}
+
+// isoDuration formats minutes as an ISO 8601 duration, e.g. PT1H30M, and
+// returns an empty string for no time at all.
+func isoDuration(minutes int) string {
+	if minutes <= 0 {
+		return ""
+	}
+
+	duration := "PT"
+	if hours := minutes / 60; hours > 0 {
+		duration += fmt.Sprintf("%dH", hours)
+	}
+	if minutes%60 > 0 {
+		duration += fmt.Sprintf("%dM", minutes%60)
+	}
+	return duration
+}
+
+func NewRecipeJsonLdService(
+	sourdoughRecipeService domain.SourdoughRecipeService,
+	parseService domain.SourdoughRecipeParseService,
+	bakeLogService domain.BakeLogService,
+	imageService domain.ImageService,
+	rest config.Rest,
+) (domain.RecipeJsonLdService, error) {
+	if sourdoughRecipeService == nil {
+		return nil, errors.New("sourdoughRecipeService cannot be nil")
+	}
+
+	if parseService == nil {
+		return nil, errors.New("parseService cannot be nil")
+	}
+
+	if bakeLogService == nil {
+		return nil, errors.New("bakeLogService cannot be nil")
+	}
+
+	if imageService == nil {
+		return nil, errors.New("imageService cannot be nil")
+	}
+
+	return &recipeJsonLdService{
+		sourdoughRecipeService: sourdoughRecipeService,
+		parseService:           parseService,
+		bakeLogService:         bakeLogService,
+		imageService:           imageService,
+		rest:                   rest,
+	}, nil
+}
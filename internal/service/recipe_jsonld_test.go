@@ -0,0 +1,349 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/config"
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func TestRecipeJsonLdServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(RecipeJsonLdServiceTestSuite))
+}
+
+type RecipeJsonLdServiceTestSuite struct {
+	test.GoMockTestSuite
+
+	ctx                    context.Context
+	sourdoughRecipeService *mocks.MockSourdoughRecipeService
+	parseService           *mocks.MockSourdoughRecipeParseService
+	bakeLogService         *mocks.MockBakeLogService
+	imageService           *mocks.MockImageService
+
+	target domain.RecipeJsonLdService
+}
+
+func (suite *RecipeJsonLdServiceTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.ctx = context.Background()
+	suite.sourdoughRecipeService = mocks.NewMockSourdoughRecipeService(suite.MockCtrl)
+	suite.parseService = mocks.NewMockSourdoughRecipeParseService(suite.MockCtrl)
+	suite.bakeLogService = mocks.NewMockBakeLogService(suite.MockCtrl)
+	suite.imageService = mocks.NewMockImageService(suite.MockCtrl)
+
+	suite.target = test.Must(func() (domain.RecipeJsonLdService, error) {
+		return NewRecipeJsonLdService(
+			suite.sourdoughRecipeService,
+			suite.parseService,
+			suite.bakeLogService,
+			suite.imageService,
+			config.Rest{ContextPath: "/v1", PublicUrl: "https://bakery.example/"},
+		)
+	})
+}
+
+func (suite *RecipeJsonLdServiceTestSuite) TestExport() {
+	recipe := suite.recipe()
+	updatedAt := time.Date(2024, 3, 2, 8, 0, 0, 0, time.UTC)
+	recipe.UpdatedAt = &updatedAt
+
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, test.FirstId).Return(recipe, nil)
+	suite.bakeLogService.EXPECT().FindByRecipeId(suite.ctx, test.FirstId, 0, 1).
+		Return([]domain.BakeLogDto{{Timings: domain.BakeTimingsDto{BulkFermentationMinutes: 240, ProofMinutes: 50, BakeMinutes: 45}}}, nil)
+	suite.imageService.EXPECT().FindByRecipeId(suite.ctx, test.FirstId, nil, 0, recipeJsonLdMaxImages).
+		Return([]domain.ImageDto{{Id: test.SecondId}, {Id: test.ThirdId}}, nil)
+
+	actual, err := suite.target.Export(suite.ctx, test.FirstId)
+
+	suite.NoError(err)
+	suite.Equal(domain.SchemaOrgRecipeDto{
+		Context:          "https://schema.org",
+		Type:             "Recipe",
+		Identifier:       test.FirstId.String(),
+		Name:             "Country loaf",
+		Description:      "Open crumb country loaf",
+		RecipeIngredient: []string{"450 g Bread flour", "50 g Rye flour", "375 g water", "100 g levain", "10 g Salt"},
+		RecipeYield:      "2 loaf",
+		Nutrition: &domain.SchemaOrgNutritionDto{
+			Type:                "NutritionInformation",
+			ServingSize:         "100g",
+			Calories:            "245 kcal",
+			FatContent:          "1.2 g",
+			CarbohydrateContent: "49.5 g",
+			ProteinContent:      "8.3 g",
+			FiberContent:        "2.1 g",
+		},
+		Image: []string{
+			"https://bakery.example/v1/recipe/sourdough/" + test.FirstId.String() + "/images/" + test.SecondId.String(),
+			"https://bakery.example/v1/recipe/sourdough/" + test.FirstId.String() + "/images/" + test.ThirdId.String(),
+		},
+		PrepTime:       "PT4H50M",
+		CookTime:       "PT45M",
+		TotalTime:      "PT5H35M",
+		RecipeCategory: "bread",
+		Keywords:       "rye, weekend",
+		DatePublished:  "2024-03-01",
+		DateModified:   "2024-03-02",
+	}, actual)
+}
+
+func (suite *RecipeJsonLdServiceTestSuite) TestExport_WithoutBakesAndImages() {
+	recipe := suite.recipe()
+	recipe.NutritionFacts = nil
+	recipe.Yield = domain.RecipeYieldDto{}
+
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, test.FirstId).Return(recipe, nil)
+	suite.bakeLogService.EXPECT().FindByRecipeId(suite.ctx, test.FirstId, 0, 1).Return([]domain.BakeLogDto{}, nil)
+	suite.imageService.EXPECT().FindByRecipeId(suite.ctx, test.FirstId, nil, 0, recipeJsonLdMaxImages).Return([]domain.ImageDto{}, nil)
+
+	actual, err := suite.target.Export(suite.ctx, test.FirstId)
+
+	suite.NoError(err)
+	suite.Nil(actual.Nutrition)
+	suite.Nil(actual.Image)
+	suite.Empty(actual.RecipeYield)
+	suite.Empty(actual.PrepTime)
+	suite.Empty(actual.CookTime)
+	suite.Empty(actual.TotalTime)
+}
+
+func (suite *RecipeJsonLdServiceTestSuite) TestExportRecipe() {
+	recipe := suite.recipe()
+	recipe.Name = "Country loaf scaled"
+
+	suite.bakeLogService.EXPECT().FindByRecipeId(suite.ctx, test.FirstId, 0, 1).Return([]domain.BakeLogDto{}, nil)
+	suite.imageService.EXPECT().FindByRecipeId(suite.ctx, test.FirstId, nil, 0, recipeJsonLdMaxImages).Return([]domain.ImageDto{}, nil)
+
+	actual, err := suite.target.ExportRecipe(suite.ctx, recipe)
+
+	suite.NoError(err)
+	suite.Equal(test.FirstId.String(), actual.Identifier)
+	suite.Equal("Country loaf scaled", actual.Name)
+}
+
+func (suite *RecipeJsonLdServiceTestSuite) TestExport_WithError() {
+	tests := []struct {
+		name  string
+		setup func()
+	}{
+		{
+			name: "recipe",
+			setup: func() {
+				suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, test.FirstId).Return(domain.SourdoughRecipeDto{}, assert.AnError)
+			},
+		},
+		{
+			name: "bake logs",
+			setup: func() {
+				suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, test.FirstId).Return(suite.recipe(), nil)
+				suite.bakeLogService.EXPECT().FindByRecipeId(suite.ctx, test.FirstId, 0, 1).Return(nil, assert.AnError)
+			},
+		},
+		{
+			name: "images",
+			setup: func() {
+				suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, test.FirstId).Return(suite.recipe(), nil)
+				suite.bakeLogService.EXPECT().FindByRecipeId(suite.ctx, test.FirstId, 0, 1).Return(nil, nil)
+				suite.imageService.EXPECT().FindByRecipeId(suite.ctx, test.FirstId, nil, 0, recipeJsonLdMaxImages).Return(nil, assert.AnError)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.setup()
+
+			_, err := suite.target.Export(suite.ctx, test.FirstId)
+
+			suite.ErrorIs(err, assert.AnError)
+		})
+	}
+}
+
+func (suite *RecipeJsonLdServiceTestSuite) TestImport() {
+	content := []byte(`{
+		"@context": "https://schema.org",
+		"@graph": [
+			{"@type": "WebPage", "name": "Recipes"},
+			{
+				"@type": ["Recipe"],
+				"name": " Country loaf ",
+				"description": "Open crumb country loaf",
+				"recipeIngredient": ["500 g bread flour", "375 g water", "1 egg"],
+				"recipeYield": ["2 loaves", "2"],
+				"recipeCategory": "Bread",
+				"keywords": "rye, weekend",
+				"nutrition": {
+					"@type": "NutritionInformation",
+					"servingSize": "100g",
+					"calories": "245 kcal",
+					"fatContent": "1,2 g",
+					"carbohydrateContent": 49.5
+				}
+			}
+		]
+	}`)
+	parsed := domain.SourdoughRecipeParseDto{
+		Recipe: domain.CreateSourdoughRecipeRequest{
+			Name:                  "Country loaf",
+			Flour:                 []domain.FlourAmountDto{{FlourDto: domain.FlourDto{Id: test.SecondId, Name: "Bread flour"}, Amount: 500}},
+			Water:                 []domain.BakerAmountDto{{Name: "water", Amount: 375, BakerPercentage: 75}},
+			AdditionalIngredients: []domain.BakerAmountDto{},
+		},
+		FlourMatches: []domain.ParsedFlourMatchDto{{Text: "bread flour", FlourId: test.SecondId, Name: "Bread flour", Score: 1}},
+		Unrecognised: []domain.UnrecognisedLineDto{{Line: 3, Text: "1 egg", Reason: "no amount in grams or percent found"}},
+	}
+	request := parsed.Recipe
+	request.Description = "Open crumb country loaf"
+	request.Yield = domain.RecipeYieldDto{Unit: "loaves", Amount: 2}
+	request.NutritionFacts = map[string]domain.NutritionFactsDto{"100g": {Calories: 245, Fat: 1.2, Carbs: 49.5}}
+	request.Tags = []string{"rye", "weekend"}
+	request.Category = "Bread"
+
+	suite.parseService.EXPECT().Parse(suite.ctx, domain.SourdoughRecipeParseRequest{
+		Name: "Country loaf",
+		Text: "500 g bread flour\n375 g water\n1 egg",
+	}).Return(parsed, nil)
+	suite.sourdoughRecipeService.EXPECT().Create(suite.ctx, request).Return(suite.recipe(), nil)
+
+	actual, err := suite.target.Import(suite.ctx, content)
+
+	suite.NoError(err)
+	suite.Equal(domain.RecipeJsonLdImportDto{
+		Recipe:       suite.recipe(),
+		FlourMatches: parsed.FlourMatches,
+		Unrecognised: parsed.Unrecognised,
+	}, actual)
+}
+
+func (suite *RecipeJsonLdServiceTestSuite) TestImport_WithInvalidDocument() {
+	tests := []struct {
+		name            string
+		content         string
+		expectedDetails string
+	}{
+		{
+			name:            "not json",
+			content:         "<html></html>",
+			expectedDetails: "document is not valid JSON",
+		},
+		{
+			name:            "no recipe",
+			content:         `[{"@type": "WebPage", "name": "Recipes"}]`,
+			expectedDetails: "document has no schema.org Recipe",
+		},
+		{
+			name:            "no name",
+			content:         `{"@type": "Recipe", "recipeIngredient": ["500 g bread flour"]}`,
+			expectedDetails: "recipe name is required",
+		},
+		{
+			name:            "no ingredients",
+			content:         `{"@type": "https://schema.org/Recipe", "name": "Country loaf", "recipeIngredient": []}`,
+			expectedDetails: "recipe has no recipeIngredient",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			_, err := suite.target.Import(suite.ctx, []byte(tt.content))
+
+			suite.Equal(internalErrors.RecipeJsonLdInvalid(tt.expectedDetails), err)
+		})
+	}
+}
+
+func (suite *RecipeJsonLdServiceTestSuite) TestImport_WithError() {
+	content := []byte(`{"@type": "Recipe", "name": "Country loaf", "ingredients": "500 g bread flour"}`)
+
+	suite.Run("parse", func() {
+		suite.parseService.EXPECT().Parse(suite.ctx, gomock.Any()).Return(domain.SourdoughRecipeParseDto{}, assert.AnError)
+
+		_, err := suite.target.Import(suite.ctx, content)
+
+		suite.ErrorIs(err, assert.AnError)
+	})
+
+	suite.Run("create", func() {
+		suite.parseService.EXPECT().Parse(suite.ctx, domain.SourdoughRecipeParseRequest{Name: "Country loaf", Text: "500 g bread flour"}).
+			Return(domain.SourdoughRecipeParseDto{}, nil)
+		suite.sourdoughRecipeService.EXPECT().Create(suite.ctx, gomock.Any()).Return(domain.SourdoughRecipeDto{}, assert.AnError)
+
+		_, err := suite.target.Import(suite.ctx, content)
+
+		suite.ErrorIs(err, assert.AnError)
+	})
+}
+
+func (suite *RecipeJsonLdServiceTestSuite) recipe() domain.SourdoughRecipeDto {
+	return domain.SourdoughRecipeDto{
+		RecipeDto: domain.RecipeDto{
+			Id:          test.FirstId,
+			Name:        "Country loaf",
+			Description: "Open crumb country loaf",
+			Flour: []domain.FlourAmountDto{
+				{FlourDto: domain.FlourDto{Id: test.SecondId, Name: "Bread flour"}, Amount: 450},
+				{FlourDto: domain.FlourDto{Id: test.ThirdId, Name: "Rye flour"}, Amount: 50},
+			},
+			Water:                 []domain.BakerAmountDto{{Amount: 375, BakerPercentage: 75}},
+			AdditionalIngredients: []domain.BakerAmountDto{{Name: "Salt", Amount: 10, BakerPercentage: 2}},
+			NutritionFacts: map[string]domain.NutritionFactsDto{
+				"loaf": {Calories: 2100},
+				"100g": {Calories: 245, Fat: 1.2, Carbs: 49.5, Protein: 8.3, Fiber: 2.1},
+			},
+			CreatedAt: time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+			Yield:     domain.RecipeYieldDto{Unit: "loaf", Amount: 2},
+			Tags:      []string{"rye", "weekend"},
+			Category:  "bread",
+		},
+		Levain: domain.SourdoughLevainAgentDto{Amount: domain.BakerAmountDto{Amount: 100, BakerPercentage: 20}},
+	}
+}
+
+func TestSchemaOrgNutrition_WithoutPer100g(t *testing.T) {
+	actual := schemaOrgNutrition(map[string]domain.NutritionFactsDto{
+		"slice": {Calories: 120},
+		"loaf":  {Calories: 2100},
+	})
+
+	assert.Equal(t, "loaf", actual.ServingSize)
+	assert.Equal(t, "2100 kcal", actual.Calories)
+}
+
+func TestIsoDuration(t *testing.T) {
+	assert.Equal(t, "", isoDuration(0))
+	assert.Equal(t, "PT45M", isoDuration(45))
+	assert.Equal(t, "PT2H", isoDuration(120))
+	assert.Equal(t, "PT26H5M", isoDuration(1565))
+}
+
+func TestNewRecipeJsonLdService_WithNilDependencies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	sourdoughRecipeService := mocks.NewMockSourdoughRecipeService(ctrl)
+	parseService := mocks.NewMockSourdoughRecipeParseService(ctrl)
+	bakeLogService := mocks.NewMockBakeLogService(ctrl)
+	imageService := mocks.NewMockImageService(ctrl)
+
+	_, err := NewRecipeJsonLdService(nil, parseService, bakeLogService, imageService, config.Rest{})
+	assert.EqualError(t, err, "sourdoughRecipeService cannot be nil")
+
+	_, err = NewRecipeJsonLdService(sourdoughRecipeService, nil, bakeLogService, imageService, config.Rest{})
+	assert.EqualError(t, err, "parseService cannot be nil")
+
+	_, err = NewRecipeJsonLdService(sourdoughRecipeService, parseService, nil, imageService, config.Rest{})
+	assert.EqualError(t, err, "bakeLogService cannot be nil")
+
+	_, err = NewRecipeJsonLdService(sourdoughRecipeService, parseService, bakeLogService, nil, config.Rest{})
+	assert.EqualError(t, err, "imageService cannot be nil")
+}
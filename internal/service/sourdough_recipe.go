@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,27 +17,152 @@ import (
 	"dough-calculator/internal/utils"
 )
 
+// recipeSortOptions are the supported sort parameter values of the recipe
+// list, newest first by default.
+var recipeSortOptions = sortOptions[domain.SourdoughRecipeEntity]{
+	defaultSort: "created",
+	options: map[string]sortOption[domain.SourdoughRecipeEntity]{
+		"created": {
+			field:      "created_at",
+			kind:       sortKindTime,
+			descending: true,
+			key: func(recipe domain.SourdoughRecipeEntity) domain.PageKey {
+				return domain.PageKey{Value: recipe.CreatedAt, Id: recipe.Id}
+			},
+		},
+		"updated": {
+			field:      "updated_at",
+			kind:       sortKindTime,
+			descending: true,
+			key: func(recipe domain.SourdoughRecipeEntity) domain.PageKey {
+				if recipe.UpdatedAt == nil {
+					return domain.PageKey{Id: recipe.Id}
+				}
+				return domain.PageKey{Value: *recipe.UpdatedAt, Id: recipe.Id}
+			},
+		},
+		"name": {
+			field: "name",
+			kind:  sortKindString,
+			key: func(recipe domain.SourdoughRecipeEntity) domain.PageKey {
+				return domain.PageKey{Value: recipe.Name, Id: recipe.Id}
+			},
+		},
+		"hydration": {
+			field: "recipe_details.water.bakerpercentage",
+			kind:  sortKindNumber,
+			key: func(recipe domain.SourdoughRecipeEntity) domain.PageKey {
+				return domain.PageKey{Value: recipe.Details.Water.BakerPercentage, Id: recipe.Id}
+			},
+		},
+		"total_weight": {
+			field: "recipe_details.total_weight",
+			kind:  sortKindNumber,
+			key: func(recipe domain.SourdoughRecipeEntity) domain.PageKey {
+				return domain.PageKey{Value: float64(recipe.Details.TotalWeight), Id: recipe.Id}
+			},
+		},
+	},
+}
+
 type sourdoughRecipeService struct {
-	repository domain.SourdoughRecipeRepository
+	transactionRunner  domain.TransactionRunner
+	repository         domain.SourdoughRecipeRepository
+	revisionRepository domain.SourdoughRecipeRevisionRepository
+	flourRepository    domain.FlourRepository
 }
 
 func (service *sourdoughRecipeService) Create(ctx context.Context, request domain.CreateSourdoughRecipeRequest) (domain.SourdoughRecipeDto, error) {
 	recipe := service.toNewRecipe(request)
 
-	createdEntity, err := service.repository.Create(ctx, recipe)
+	return service.create(ctx, recipe)
+}
 
-	if err != nil {
-		log.Err(err).
-			Str("name", recipe.Name).
-			Msg("failed to create recipe")
+// create stores the recipe and its first revision in one transaction.
+func (service *sourdoughRecipeService) create(ctx context.Context, recipe domain.SourdoughRecipeEntity) (domain.SourdoughRecipeDto, error) {
+	recipe.Version = 1
 
-		return domain.SourdoughRecipeDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to create recipe")
+	var createdEntity domain.SourdoughRecipeEntity
+	err := service.transactionRunner.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		createdEntity, err = service.repository.Create(ctx, recipe)
+		if err != nil {
+			log.Err(err).
+				Str("name", recipe.Name).
+				Msg("failed to create recipe")
+
+			if mongo.IsDuplicateKeyError(err) {
+				return internalErrors.SourdoughRecipeAlreadyExists(recipe.Name)
+			}
+
+			return internalErrors.NewInternalServerErrorWrap(err, "failed to create recipe")
+		}
+
+		return service.createRevision(ctx, createdEntity)
+	})
+	if err != nil {
+		return domain.SourdoughRecipeDto{}, err
 	}
 
 	return createdEntity.ToDto(), nil
 }
 
 func (service *sourdoughRecipeService) FindById(ctx context.Context, id uuid.UUID) (domain.SourdoughRecipeDto, error) {
+	recipe, err := service.getById(ctx, id)
+	if err != nil {
+		return domain.SourdoughRecipeDto{}, err
+	}
+	return recipe.ToDto(), nil
+}
+
+func (service *sourdoughRecipeService) Update(ctx context.Context, id uuid.UUID, request domain.CreateSourdoughRecipeRequest) (domain.SourdoughRecipeDto, error) {
+	existing, err := service.getById(ctx, id)
+	if err != nil {
+		return domain.SourdoughRecipeDto{}, err
+	}
+
+	updatedAt := time.Now()
+
+	recipe := service.toNewRecipe(request)
+	recipe.Id = existing.Id
+	recipe.CreatedAt = existing.CreatedAt
+	recipe.UpdatedAt = &updatedAt
+	recipe.Version = existing.Version + 1
+	recipe.ParentId = existing.ParentId
+	recipe.Ancestors = existing.Ancestors
+
+	// the update only matches the version read above, so of two concurrent
+	// updates one fails instead of both writing the same next version
+	var updatedEntity domain.SourdoughRecipeEntity
+	err = service.transactionRunner.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		updatedEntity, err = service.repository.Update(ctx, recipe, existing.Version)
+		if err != nil {
+			log.Err(err).
+				Str("id", id.String()).
+				Int("version", existing.Version).
+				Msg("failed to update recipe")
+
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return internalErrors.SourdoughRecipeVersionConflict(id, existing.Version)
+			}
+			if mongo.IsDuplicateKeyError(err) {
+				return internalErrors.SourdoughRecipeAlreadyExists(recipe.Name)
+			}
+
+			return internalErrors.NewInternalServerErrorWrap(err, "failed to update recipe")
+		}
+
+		return service.createRevision(ctx, updatedEntity)
+	})
+	if err != nil {
+		return domain.SourdoughRecipeDto{}, err
+	}
+
+	return updatedEntity.ToDto(), nil
+}
+
+func (service *sourdoughRecipeService) getById(ctx context.Context, id uuid.UUID) (domain.SourdoughRecipeEntity, error) {
 	recipe, err := service.repository.GetById(ctx, id)
 	if err != nil {
 		log.Err(err).
@@ -43,27 +170,87 @@ func (service *sourdoughRecipeService) FindById(ctx context.Context, id uuid.UUI
 			Msg("failed to find recipe by id")
 
 		if errors.Is(err, mongo.ErrNoDocuments) {
-			return domain.SourdoughRecipeDto{},
+			return domain.SourdoughRecipeEntity{},
 				internalErrors.SourdoughRecipeNotFound(fmt.Sprintf("recipe with id %s not found", id.String()))
 		}
 
-		return domain.SourdoughRecipeDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to find recipe by id")
+		return domain.SourdoughRecipeEntity{}, internalErrors.NewInternalServerErrorWrap(err, "failed to find recipe by id")
 	}
-	return recipe.ToDto(), nil
+	return recipe, nil
+}
+
+func (service *sourdoughRecipeService) createRevision(ctx context.Context, recipe domain.SourdoughRecipeEntity) error {
+	_, err := service.revisionRepository.Create(ctx, domain.SourdoughRecipeRevisionEntity{
+		Id:        uuid.New(),
+		RecipeId:  recipe.Id,
+		Version:   recipe.Version,
+		Recipe:    recipe,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		log.Err(err).
+			Str("id", recipe.Id.String()).
+			Int("version", recipe.Version).
+			Msg("failed to create recipe revision")
+
+		return internalErrors.NewInternalServerErrorWrap(err, "failed to create recipe revision")
+	}
+
+	return nil
 }
 
-func (service *sourdoughRecipeService) Find(ctx context.Context, offset, limit int) ([]domain.SourdoughRecipeDto, error) {
-	recipes, err := service.repository.Find(ctx, offset, limit)
+func (service *sourdoughRecipeService) Find(
+	ctx context.Context,
+	filter domain.SourdoughRecipeFilter,
+	params domain.PageParams,
+) (domain.SourdoughRecipeSearchResultDto, error) {
+	if err := validateRecipeFilter(filter); err != nil {
+		return domain.SourdoughRecipeSearchResultDto{}, err
+	}
+
+	pager, err := recipeSortOptions.pager(params)
+	if err != nil {
+		return domain.SourdoughRecipeSearchResultDto{}, err
+	}
+
+	filter.Tags = normalizeTags(filter.Tags)
+	filter.Categories = normalizeTags(filter.Categories)
+
+	result, err := service.repository.Find(ctx, filter, pager.request)
 	if err != nil {
 		log.Err(err).
 			Msg("failed to find recipes")
 
-		return nil, internalErrors.NewInternalServerErrorWrap(err, "failed to find recipes")
+		return domain.SourdoughRecipeSearchResultDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to find recipes")
 	}
 
-	return utils.Map(recipes, func(entity domain.SourdoughRecipeEntity) domain.SourdoughRecipeDto {
-		return entity.ToDto()
-	}), nil
+	return domain.SourdoughRecipeSearchResultDto{
+		Items: utils.Map(result.Recipes, func(recipe domain.SourdoughRecipeEntity) domain.SourdoughRecipeDto {
+			return recipe.ToDto()
+		}),
+		PageDto: pager.page(result.Recipes, result.Page),
+		Facets:  result.Facets.ToDto(),
+	}, nil
+}
+
+func validateRecipeFilter(filter domain.SourdoughRecipeFilter) error {
+	if filter.MinHydration != nil && filter.MaxHydration != nil && *filter.MinHydration > *filter.MaxHydration {
+		return internalErrors.SourdoughRecipeFilterInvalid(fmt.Sprintf(
+			"min hydration %.2f must not be greater than max hydration %.2f", *filter.MinHydration, *filter.MaxHydration))
+	}
+
+	if filter.MinFlour != nil && filter.MaxFlour != nil && *filter.MinFlour > *filter.MaxFlour {
+		return internalErrors.SourdoughRecipeFilterInvalid(fmt.Sprintf(
+			"min flour %.2f must not be greater than max flour %.2f", *filter.MinFlour, *filter.MaxFlour))
+	}
+
+	if filter.CreatedAfter != nil && filter.CreatedBefore != nil && filter.CreatedAfter.After(*filter.CreatedBefore) {
+		return internalErrors.SourdoughRecipeFilterInvalid(fmt.Sprintf(
+			"created after %s must not be later than created before %s",
+			filter.CreatedAfter.Format(time.RFC3339), filter.CreatedBefore.Format(time.RFC3339)))
+	}
+
+	return nil
 }
 
 func (service *sourdoughRecipeService) SearchByName(ctx context.Context, name string) ([]domain.SourdoughRecipeDto, error) {
@@ -81,6 +268,214 @@ func (service *sourdoughRecipeService) SearchByName(ctx context.Context, name st
 	}), nil
 }
 
+func (service *sourdoughRecipeService) TextSearch(ctx context.Context, query string, offset, limit int) (domain.SourdoughRecipeTextSearchResultDto, error) {
+	if err := validateSearchQuery(query); err != nil {
+		return domain.SourdoughRecipeTextSearchResultDto{}, err
+	}
+
+	result, err := service.repository.TextSearch(ctx, query, offset, limit)
+	if err != nil {
+		log.Err(err).
+			Str("query", query).
+			Msg("failed to search recipes by text")
+
+		return domain.SourdoughRecipeTextSearchResultDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to search recipes by text")
+	}
+
+	terms := searchTerms(query)
+
+	return domain.SourdoughRecipeTextSearchResultDto{
+		Items: utils.Map(result.Hits, func(hit domain.SourdoughRecipeTextSearchHit) domain.SourdoughRecipeTextSearchHitDto {
+			return domain.SourdoughRecipeTextSearchHitDto{
+				Recipe:     hit.Recipe.ToDto(),
+				Score:      hit.Score,
+				Highlights: service.highlight(hit.Recipe, terms),
+			}
+		}),
+		Total: result.Total,
+	}, nil
+}
+
+func (service *sourdoughRecipeService) highlight(recipe domain.SourdoughRecipeEntity, terms []string) highlights {
+	result := highlights{}
+	result.add("name", recipe.Name, terms, 0)
+	result.add("description", recipe.Description, terms, highlightFragmentSize)
+	for _, tag := range recipe.Tags {
+		result.add("tags", tag, terms, 0)
+	}
+	for _, flour := range recipe.Flour {
+		result.add("flour", flour.Name, terms, 0)
+	}
+	return result
+}
+
+func (service *sourdoughRecipeService) Fork(ctx context.Context, id uuid.UUID, request domain.ForkSourdoughRecipeRequest) (domain.SourdoughRecipeDto, error) {
+	if request.Name == "" {
+		return domain.SourdoughRecipeDto{}, internalErrors.SourdoughRecipeForkNameRequired()
+	}
+
+	parent, err := service.getById(ctx, id)
+	if err != nil {
+		return domain.SourdoughRecipeDto{}, err
+	}
+
+	forked, err := service.substituteFlour(ctx, parent.ToDto(), request.FlourSubstitutions)
+	if err != nil {
+		return domain.SourdoughRecipeDto{}, err
+	}
+
+	createRequest := forked.ToCreateRequest()
+	createRequest.Name = request.Name
+	if request.Description != "" {
+		createRequest.Description = request.Description
+	}
+
+	if request.Hydration != nil {
+		createRequest.Water, err = service.changeHydration(createRequest.Flour, createRequest.Water, *request.Hydration)
+		if err != nil {
+			return domain.SourdoughRecipeDto{}, err
+		}
+	}
+
+	recipe := service.toNewRecipe(createRequest)
+	recipe.ParentId = &parent.Id
+	recipe.Ancestors = append(append([]uuid.UUID{}, parent.Ancestors...), parent.Id)
+
+	return service.create(ctx, recipe)
+}
+
+func (service *sourdoughRecipeService) FamilyTree(ctx context.Context, id uuid.UUID) (domain.SourdoughRecipeFamilyTreeDto, error) {
+	recipe, err := service.getById(ctx, id)
+	if err != nil {
+		return domain.SourdoughRecipeFamilyTreeDto{}, err
+	}
+
+	rootId := recipe.Id
+	if len(recipe.Ancestors) > 0 {
+		rootId = recipe.Ancestors[0]
+	}
+
+	family, err := service.repository.FindFamily(ctx, rootId)
+	if err != nil {
+		log.Err(err).
+			Str("id", id.String()).
+			Str("root_id", rootId.String()).
+			Msg("failed to find recipe family")
+
+		return domain.SourdoughRecipeFamilyTreeDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to find recipe family")
+	}
+
+	children := make(map[uuid.UUID][]domain.SourdoughRecipeEntity, len(family))
+	var root *domain.SourdoughRecipeEntity
+	for i, member := range family {
+		if member.Id == rootId {
+			root = &family[i]
+			continue
+		}
+		if member.ParentId != nil {
+			children[*member.ParentId] = append(children[*member.ParentId], member)
+		}
+	}
+
+	if root == nil {
+		return domain.SourdoughRecipeFamilyTreeDto{},
+			internalErrors.SourdoughRecipeNotFound(fmt.Sprintf("recipe with id %s not found", rootId.String()))
+	}
+
+	return service.toFamilyTree(*root, children), nil
+}
+
+func (service *sourdoughRecipeService) toFamilyTree(
+	recipe domain.SourdoughRecipeEntity,
+	children map[uuid.UUID][]domain.SourdoughRecipeEntity,
+) domain.SourdoughRecipeFamilyTreeDto {
+	return domain.SourdoughRecipeFamilyTreeDto{
+		Id:        recipe.Id,
+		Name:      recipe.Name,
+		Version:   recipe.Version,
+		CreatedAt: recipe.CreatedAt,
+		Children: utils.Map(children[recipe.Id], func(child domain.SourdoughRecipeEntity) domain.SourdoughRecipeFamilyTreeDto {
+			return service.toFamilyTree(child, children)
+		}),
+	}
+}
+
+// substituteFlour applies the substitutions of a fork one after the other,
+// in the main dough and the levain like the substitution endpoint does,
+// with the flours resolved in the catalogue.
+func (service *sourdoughRecipeService) substituteFlour(
+	ctx context.Context,
+	recipe domain.SourdoughRecipeDto,
+	substitutions []domain.FlourSubstitutionDto,
+) (domain.SourdoughRecipeDto, error) {
+	for _, substitution := range substitutions {
+		if substitution.Percentage <= 0 || substitution.Percentage > 100 {
+			return domain.SourdoughRecipeDto{}, internalErrors.SourdoughRecipeForkInvalidSubstitution(
+				fmt.Sprintf("percentage %.2f must be greater than 0 and at most 100", substitution.Percentage))
+		}
+
+		if substitution.ToFlourId == substitution.FromFlourId {
+			return domain.SourdoughRecipeDto{}, internalErrors.SourdoughRecipeForkInvalidSubstitution(
+				fmt.Sprintf("flour %s cannot be substituted with itself", substitution.FromFlourId.String()))
+		}
+
+		fromFlour, toFlour, err := substitutionFlours(ctx, service.flourRepository, recipe, substitution.FromFlourId,
+			substitution.ToFlourId, substitutionTargetAll, internalErrors.SourdoughRecipeForkFlourNotFound)
+		if err != nil {
+			return domain.SourdoughRecipeDto{}, err
+		}
+
+		result, err := substituteRecipeFlour(recipe, fromFlour, toFlour, substitution.Percentage,
+			substitutionTargetAll, internalErrors.SourdoughRecipeForkInvalidSubstitution)
+		if err != nil {
+			return domain.SourdoughRecipeDto{}, err
+		}
+		recipe = result.Recipe
+	}
+
+	return recipe, nil
+}
+
+// changeHydration scales every water amount so the dough water reaches the
+// requested baker percentage of the total flour weight.
+func (service *sourdoughRecipeService) changeHydration(
+	flour []domain.FlourAmountDto,
+	water []domain.BakerAmountDto,
+	hydration float64,
+) ([]domain.BakerAmountDto, error) {
+	if hydration <= 0 {
+		return nil, internalErrors.SourdoughRecipeForkInvalidHydration(hydration)
+	}
+
+	return scaleWaterToHydration(calculateFlourAmount(flour).Amount, water, hydration), nil
+}
+
+// scaleWaterToHydration scales every water amount so the water reaches the
+// given baker percentage of totalFlourAmount. Without any water a single
+// "Water" amount is returned.
+func scaleWaterToHydration(totalFlourAmount float64, water []domain.BakerAmountDto, hydration float64) []domain.BakerAmountDto {
+	targetWaterAmount := totalFlourAmount * hydration / 100
+
+	var totalWaterAmount float64
+	for _, amount := range water {
+		totalWaterAmount += amount.Amount
+	}
+
+	if totalWaterAmount == 0 {
+		return []domain.BakerAmountDto{{Name: "Water", Amount: targetWaterAmount, BakerPercentage: hydration}}
+	}
+
+	factor := targetWaterAmount / totalWaterAmount
+
+	return utils.Map(water, func(amount domain.BakerAmountDto) domain.BakerAmountDto {
+		amount.Amount *= factor
+		if totalFlourAmount > 0 {
+			amount.BakerPercentage = amount.Amount / totalFlourAmount * 100
+		}
+		return amount
+	})
+}
+
 func (service *sourdoughRecipeService) toNewRecipe(request domain.CreateSourdoughRecipeRequest) domain.SourdoughRecipeEntity {
 	bakerAmountConverter := func(amount domain.BakerAmountDto) domain.BakerAmount {
 		return amount.ToEntity()
@@ -99,34 +494,38 @@ func (service *sourdoughRecipeService) toNewRecipe(request domain.CreateSourdoug
 			Flour:                 utils.Map(request.Flour, func(amount domain.FlourAmountDto) domain.FlourAmount { return amount.ToEntity() }),
 			Water:                 utils.Map(request.Water, bakerAmountConverter),
 			AdditionalIngredients: utils.Map(request.AdditionalIngredients, bakerAmountConverter),
-			Details:               service.calculateRecipeDetails(request).ToEntity(),
+			Details:               calculateSourdoughRecipeDetails(request).ToEntity(),
 			NutritionFacts:        nutritionFacts,
 			CreatedAt:             time.Now(),
 			Yield:                 request.Yield.ToEntity(),
+			Tags:                  normalizeTags(request.Tags),
+			Category:              normalizeTag(request.Category),
 		},
 		Levain: request.Levain.ToEntity(),
 	}
 }
 
-func (service *sourdoughRecipeService) calculateRecipeDetails(request domain.CreateSourdoughRecipeRequest) domain.RecipeDetailsDto {
-	flourAmount := service.calculateFlourAmount(request.Flour)
-	waterAmount := service.calculateWaterAmount(flourAmount, request.Water)
+// calculateSourdoughRecipeDetails calculates the details of a recipe the
+// same way as when the recipe is saved.
+func calculateSourdoughRecipeDetails(request domain.CreateSourdoughRecipeRequest) domain.RecipeDetailsDto {
+	flourAmount := calculateFlourAmount(request.Flour)
+	waterAmount := calculateWaterAmount(flourAmount, request.Water)
 	levainAmount := request.Levain.Amount
-	additionalIngredientsAmount := service.calculateAdditionalIngredientsAmount(flourAmount, request.AdditionalIngredients)
+	additionalIngredientsAmount := calculateAdditionalIngredientsAmount(flourAmount, request.AdditionalIngredients)
 
 	recipeDetails := domain.RecipeDetailsDto{
 		Flour:                 flourAmount,
 		Water:                 waterAmount,
 		Levain:                levainAmount,
 		AdditionalIngredients: additionalIngredientsAmount,
-		TotalWeight:           service.calculateTotalWeight(flourAmount, waterAmount, levainAmount, additionalIngredientsAmount),
+		TotalWeight:           calculateTotalWeight(flourAmount, waterAmount, levainAmount, additionalIngredientsAmount),
 	}
 
 	return recipeDetails
 
 }
 
-func (service *sourdoughRecipeService) calculateFlourAmount(flour []domain.FlourAmountDto) domain.BakerAmountDto {
+func calculateFlourAmount(flour []domain.FlourAmountDto) domain.BakerAmountDto {
 	if len(flour) == 0 {
 		return domain.BakerAmountDto{}
 	}
@@ -142,7 +541,7 @@ func (service *sourdoughRecipeService) calculateFlourAmount(flour []domain.Flour
 	}
 }
 
-func (service *sourdoughRecipeService) calculateWaterAmount(flour domain.BakerAmountDto, water []domain.BakerAmountDto) domain.BakerAmountDto {
+func calculateWaterAmount(flour domain.BakerAmountDto, water []domain.BakerAmountDto) domain.BakerAmountDto {
 	if len(water) == 0 {
 		return domain.BakerAmountDto{}
 	}
@@ -158,7 +557,7 @@ func (service *sourdoughRecipeService) calculateWaterAmount(flour domain.BakerAm
 	}
 }
 
-func (service *sourdoughRecipeService) calculateAdditionalIngredientsAmount(flour domain.BakerAmountDto, ingredients []domain.BakerAmountDto) domain.BakerAmountDto {
+func calculateAdditionalIngredientsAmount(flour domain.BakerAmountDto, ingredients []domain.BakerAmountDto) domain.BakerAmountDto {
 	if len(ingredients) == 0 {
 		return domain.BakerAmountDto{}
 	}
@@ -174,7 +573,7 @@ func (service *sourdoughRecipeService) calculateAdditionalIngredientsAmount(flou
 	}
 }
 
-func (service *sourdoughRecipeService) calculateTotalWeight(
+func calculateTotalWeight(
 	flourAmount domain.BakerAmountDto,
 	waterAmount domain.BakerAmountDto,
 	levainAmount domain.BakerAmountDto,
@@ -183,12 +582,49 @@ func (service *sourdoughRecipeService) calculateTotalWeight(
 	return int(flourAmount.Amount + waterAmount.Amount + levainAmount.Amount + additionalIngredientsAmount.Amount)
 }
 
-func NewSourdoughRecipeService(repository domain.SourdoughRecipeRepository) (domain.SourdoughRecipeService, error) {
+// normalizeTags lower-cases and trims the tags and drops empty and
+// duplicate ones, so that filtering and facet counts are case-insensitive.
+func normalizeTags(tags []string) []string {
+	var result []string
+	for _, tag := range tags {
+		tag = normalizeTag(tag)
+		if tag != "" && !slices.Contains(result, tag) {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.Join(strings.Fields(tag), " "))
+}
+
+func NewSourdoughRecipeService(
+	transactionRunner domain.TransactionRunner,
+	repository domain.SourdoughRecipeRepository,
+	revisionRepository domain.SourdoughRecipeRevisionRepository,
+	flourRepository domain.FlourRepository,
+) (domain.SourdoughRecipeService, error) {
+	if transactionRunner == nil {
+		return nil, errors.New("transactionRunner cannot be nil")
+	}
+
 	if repository == nil {
 		return nil, errors.New("repository cannot be nil")
 	}
 
+	if revisionRepository == nil {
+		return nil, errors.New("revisionRepository cannot be nil")
+	}
+
+	if flourRepository == nil {
+		return nil, errors.New("flourRepository cannot be nil")
+	}
+
 	return &sourdoughRecipeService{
-		repository: repository,
+		transactionRunner:  transactionRunner,
+		repository:         repository,
+		revisionRepository: revisionRepository,
+		flourRepository:    flourRepository,
 	}, nil
 }
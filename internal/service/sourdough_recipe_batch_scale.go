@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/utils"
+)
+
+const (
+	// batchScaleWorkers bounds the number of recipes scaled at the same time.
+	batchScaleWorkers = 4
+	// batchScaleMaxItems bounds the number of items of a batch.
+	batchScaleMaxItems = 100
+)
+
+// ScaleBatch scales every item of the batch to its pieces times piece weight
+// through Scale, using a bounded pool of workers, and sums the ingredients
+// of all scaled recipes up into a pick list. The sheets keep the order of
+// the items and the first failing item fails the whole batch.
+func (service *sourdoughRecipeScaleService) ScaleBatch(
+	ctx context.Context,
+	request domain.SourdoughRecipeBatchScaleRequest,
+) (domain.SourdoughRecipeBatchScaleDto, error) {
+	if err := service.validateBatch(request); err != nil {
+		return domain.SourdoughRecipeBatchScaleDto{}, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sheets := make([]domain.SourdoughRecipeBatchScaleSheetDto, len(request.Items))
+	jobs := make(chan int)
+
+	var (
+		wg      sync.WaitGroup
+		failure sync.Once
+		err     error
+	)
+	for worker := 0; worker < min(batchScaleWorkers, len(request.Items)); worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for index := range jobs {
+				item := request.Items[index]
+				recipe, scaleErr := service.Scale(ctx, item.RecipeId, domain.SourdoughRecipeScaleRequestDto{
+					FinalDoughWeight: item.Pieces * item.PieceWeight,
+				})
+				if scaleErr != nil {
+					failure.Do(func() {
+						err = scaleErr
+						cancel()
+					})
+					continue
+				}
+
+				sheets[index] = domain.SourdoughRecipeBatchScaleSheetDto{
+					SourdoughRecipeBatchScaleItemDto: item,
+					Recipe:                           recipe,
+				}
+			}
+		}()
+	}
+
+dispatch:
+	for index := range request.Items {
+		select {
+		case jobs <- index:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		return domain.SourdoughRecipeBatchScaleDto{}, err
+	}
+
+	return domain.SourdoughRecipeBatchScaleDto{
+		Sheets: sheets,
+		PickList: pickListOf(utils.Map(sheets, func(sheet domain.SourdoughRecipeBatchScaleSheetDto) domain.SourdoughRecipeDto {
+			return sheet.Recipe
+		})),
+	}, nil
+}
+
+func (service *sourdoughRecipeScaleService) validateBatch(request domain.SourdoughRecipeBatchScaleRequest) error {
+	if len(request.Items) == 0 {
+		return internalErrors.SourdoughRecipeBatchScaleInvalid("items are required")
+	}
+	if len(request.Items) > batchScaleMaxItems {
+		return internalErrors.SourdoughRecipeBatchScaleInvalid(
+			fmt.Sprintf("at most %d items can be scaled at once, got %d", batchScaleMaxItems, len(request.Items)))
+	}
+
+	for _, item := range request.Items {
+		if item.Pieces <= 0 {
+			return internalErrors.SourdoughRecipeBatchScaleInvalid(
+				fmt.Sprintf("pieces %d of recipe %s must be greater than 0", item.Pieces, item.RecipeId.String()))
+		}
+		if item.PieceWeight <= 0 {
+			return internalErrors.SourdoughRecipeBatchScaleInvalid(
+				fmt.Sprintf("piece weight %d of recipe %s must be greater than 0", item.PieceWeight, item.RecipeId.String()))
+		}
+	}
+
+	return nil
+}
+
+// pickListOf sums up the ingredients of the recipes in the order they first
+// appear. Flour is summed by id, additional ingredients by pickListKey.
+func pickListOf(recipes []domain.SourdoughRecipeDto) domain.PickListDto {
+	var pickList domain.PickListDto
+	flourIndex := make(map[uuid.UUID]int)
+	ingredientIndex := make(map[string]int)
+
+	addFlour := func(flour domain.FlourAmountDto) {
+		index, ok := flourIndex[flour.Id]
+		if !ok {
+			index = len(pickList.Flour)
+			flourIndex[flour.Id] = index
+			pickList.Flour = append(pickList.Flour, domain.FlourAmountDto{FlourDto: flour.FlourDto})
+		}
+		pickList.Flour[index].Amount += flour.Amount
+	}
+
+	for _, recipe := range recipes {
+		for _, flour := range recipe.Flour {
+			addFlour(flour)
+		}
+		for _, flour := range recipe.Levain.Flour {
+			addFlour(flour)
+		}
+
+		for _, water := range recipe.Water {
+			pickList.Water += water.Amount
+		}
+		pickList.Water += recipe.Levain.Water.Amount
+		pickList.Starter += recipe.Levain.Starter.Amount
+
+		for _, ingredient := range recipe.AdditionalIngredients {
+			key := pickListKey(ingredient.Name)
+			index, ok := ingredientIndex[key]
+			if !ok {
+				index = len(pickList.AdditionalIngredients)
+				ingredientIndex[key] = index
+				pickList.AdditionalIngredients = append(pickList.AdditionalIngredients, domain.BakerAmountDto{Name: ingredient.Name})
+			}
+			pickList.AdditionalIngredients[index].Amount += ingredient.Amount
+		}
+
+		pickList.TotalWeight += recipe.Details.TotalWeight
+	}
+
+	return pickList
+}
+
+// pickListKey is the name additional ingredients are summed up by.
+func pickListKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func (suite *SourdoughRecipeScaleServiceTestSuite) TestScaleBatch() {
+	country, rye := suite.batchRecipes()
+
+	suite.sourdoughRecipeScaleService.EXPECT().FindById(gomock.Any(), country.Id).Return(country, nil)
+	suite.sourdoughRecipeScaleService.EXPECT().FindById(gomock.Any(), rye.Id).Return(rye, nil)
+
+	batch, err := suite.target.ScaleBatch(suite.ctx, domain.SourdoughRecipeBatchScaleRequest{
+		Items: []domain.SourdoughRecipeBatchScaleItemDto{
+			{RecipeId: country.Id, Pieces: 2, PieceWeight: 540},
+			{RecipeId: rye.Id, Pieces: 1, PieceWeight: 910},
+		},
+	})
+
+	suite.NoError(err)
+	suite.Len(batch.Sheets, 2)
+	suite.Equal(domain.SourdoughRecipeBatchScaleItemDto{RecipeId: country.Id, Pieces: 2, PieceWeight: 540}, batch.Sheets[0].SourdoughRecipeBatchScaleItemDto)
+	suite.Equal(1080, batch.Sheets[0].Recipe.Details.TotalWeight)
+	suite.Equal(rye.Id, batch.Sheets[1].Recipe.Id)
+	suite.Equal(500.0, batch.Sheets[1].Recipe.Flour[0].Amount)
+	suite.Equal(domain.PickListDto{
+		Flour: []domain.FlourAmountDto{
+			{FlourDto: domain.FlourDto{Id: test.FirstId, Name: "Bread flour"}, Amount: 550},
+			{FlourDto: domain.FlourDto{Id: test.SecondId, Name: "Rye flour"}, Amount: 550},
+		},
+		Water:                 850,
+		Starter:               20,
+		AdditionalIngredients: []domain.BakerAmountDto{{Name: "Salt", Amount: 20}},
+		TotalWeight:           1990,
+	}, batch.PickList)
+}
+
+func (suite *SourdoughRecipeScaleServiceTestSuite) TestScaleBatch_WithErrorOnScale() {
+	country, rye := suite.batchRecipes()
+
+	suite.sourdoughRecipeScaleService.EXPECT().FindById(gomock.Any(), country.Id).
+		Return(domain.SourdoughRecipeDto{}, assert.AnError)
+	suite.sourdoughRecipeScaleService.EXPECT().FindById(gomock.Any(), rye.Id).
+		Return(rye, nil).
+		AnyTimes()
+
+	batch, err := suite.target.ScaleBatch(suite.ctx, domain.SourdoughRecipeBatchScaleRequest{
+		Items: []domain.SourdoughRecipeBatchScaleItemDto{
+			{RecipeId: country.Id, Pieces: 2, PieceWeight: 540},
+			{RecipeId: rye.Id, Pieces: 1, PieceWeight: 910},
+		},
+	})
+
+	suite.Equal(assert.AnError, err)
+	suite.Empty(batch)
+}
+
+func (suite *SourdoughRecipeScaleServiceTestSuite) TestScaleBatch_WithInvalidRequest() {
+	tests := []struct {
+		name          string
+		items         []domain.SourdoughRecipeBatchScaleItemDto
+		expectedError error
+	}{
+		{
+			name:          "without items",
+			expectedError: internalErrors.SourdoughRecipeBatchScaleInvalid("items are required"),
+		},
+		{
+			name:          "too many items",
+			items:         make([]domain.SourdoughRecipeBatchScaleItemDto, batchScaleMaxItems+1),
+			expectedError: internalErrors.SourdoughRecipeBatchScaleInvalid("at most 100 items can be scaled at once, got 101"),
+		},
+		{
+			name:          "without pieces",
+			items:         []domain.SourdoughRecipeBatchScaleItemDto{{RecipeId: test.FirstId, PieceWeight: 500}},
+			expectedError: internalErrors.SourdoughRecipeBatchScaleInvalid("pieces 0 of recipe 74c65c5a-44e7-44a2-8cdd-cd7c49cfcb42 must be greater than 0"),
+		},
+		{
+			name:          "without piece weight",
+			items:         []domain.SourdoughRecipeBatchScaleItemDto{{RecipeId: test.FirstId, Pieces: 2}},
+			expectedError: internalErrors.SourdoughRecipeBatchScaleInvalid("piece weight 0 of recipe 74c65c5a-44e7-44a2-8cdd-cd7c49cfcb42 must be greater than 0"),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			batch, err := suite.target.ScaleBatch(suite.ctx, domain.SourdoughRecipeBatchScaleRequest{Items: tt.items})
+
+			suite.Equal(tt.expectedError, err)
+			suite.Empty(batch)
+		})
+	}
+}
+
+func (suite *SourdoughRecipeScaleServiceTestSuite) batchRecipes() (domain.SourdoughRecipeDto, domain.SourdoughRecipeDto) {
+	breadFlour := domain.FlourDto{Id: test.FirstId, Name: "Bread flour"}
+	ryeFlour := domain.FlourDto{Id: test.SecondId, Name: "Rye flour"}
+
+	country := domain.SourdoughRecipeDto{
+		RecipeDto: domain.RecipeDto{
+			Id:                    test.ThirdId,
+			Name:                  "Country loaf",
+			Flour:                 []domain.FlourAmountDto{{FlourDto: breadFlour, Amount: 500}},
+			Water:                 []domain.BakerAmountDto{{Name: "Water", Amount: 350}},
+			AdditionalIngredients: []domain.BakerAmountDto{{Name: "Salt", Amount: 10}},
+			Details:               domain.RecipeDetailsDto{TotalWeight: 1080},
+			Version:               1,
+		},
+		Levain: domain.SourdoughLevainAgentDto{
+			Amount:  domain.BakerAmountDto{Amount: 220},
+			Starter: domain.BakerAmountDto{Amount: 20},
+			Flour: []domain.FlourAmountDto{
+				{FlourDto: breadFlour, Amount: 50},
+				{FlourDto: ryeFlour, Amount: 50},
+			},
+			Water: domain.BakerAmountDto{Amount: 100},
+		},
+	}
+	rye := domain.SourdoughRecipeDto{
+		RecipeDto: domain.RecipeDto{
+			Id:                    test.FirstId,
+			Name:                  "Rye loaf",
+			Flour:                 []domain.FlourAmountDto{{FlourDto: ryeFlour, Amount: 1000}},
+			Water:                 []domain.BakerAmountDto{{Name: "Water", Amount: 800}},
+			AdditionalIngredients: []domain.BakerAmountDto{{Name: " salt", Amount: 20}},
+			Details:               domain.RecipeDetailsDto{TotalWeight: 1820},
+			Version:               1,
+		},
+	}
+
+	return country, rye
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/utils"
+)
+
+// formulaPercentageTolerance is how far the flour percentages of a formula
+// may be off 100 to allow for rounded percentages.
+const formulaPercentageTolerance = 0.01
+
+// CreateFromFormula solves the gram amounts of a recipe given in baker
+// percentages and stores it like any other recipe. The levain flour, water
+// and starter are solved from the levain weight, its hydration and starter
+// percentage.
+func (service *sourdoughRecipeService) CreateFromFormula(
+	ctx context.Context,
+	request domain.CreateSourdoughRecipeFormulaRequest,
+) (domain.SourdoughRecipeDto, error) {
+	if err := service.validateFormula(request); err != nil {
+		return domain.SourdoughRecipeDto{}, err
+	}
+
+	return service.Create(ctx, solveSourdoughRecipeFormula(request))
+}
+
+func (service *sourdoughRecipeService) validateFormula(request domain.CreateSourdoughRecipeFormulaRequest) error {
+	if len(request.Flour) == 0 {
+		return internalErrors.SourdoughRecipeFormulaInvalid("flour is required")
+	}
+	if err := service.validateFlourPercentages("flour", request.Flour); err != nil {
+		return err
+	}
+	if len(request.Levain.Flour) > 0 {
+		if err := service.validateFlourPercentages("levain flour", request.Levain.Flour); err != nil {
+			return err
+		}
+	}
+
+	percentages := append(append([]domain.BakerAmountDto{}, request.Water...), request.AdditionalIngredients...)
+	percentages = append(percentages,
+		domain.BakerAmountDto{Name: "levain", BakerPercentage: request.Levain.BakerPercentage},
+		domain.BakerAmountDto{Name: "levain hydration", BakerPercentage: request.Levain.Hydration},
+		domain.BakerAmountDto{Name: "levain starter", BakerPercentage: request.Levain.StarterPercentage},
+	)
+	for _, percentage := range percentages {
+		if percentage.BakerPercentage < 0 {
+			return internalErrors.SourdoughRecipeFormulaInvalid(
+				fmt.Sprintf("baker percentage %.2f of %s must not be negative", percentage.BakerPercentage, percentage.Name))
+		}
+	}
+
+	switch {
+	case request.DoughWeight == nil && request.FlourWeight == nil:
+		return internalErrors.SourdoughRecipeFormulaInvalid("dough_weight or flour_weight is required")
+	case request.DoughWeight != nil && request.FlourWeight != nil:
+		return internalErrors.SourdoughRecipeFormulaInvalid("only one of dough_weight and flour_weight can be set")
+	case request.DoughWeight != nil && *request.DoughWeight <= 0:
+		return internalErrors.SourdoughRecipeFormulaInvalid(
+			fmt.Sprintf("dough weight %.2f must be greater than 0", *request.DoughWeight))
+	case request.FlourWeight != nil && *request.FlourWeight <= 0:
+		return internalErrors.SourdoughRecipeFormulaInvalid(
+			fmt.Sprintf("flour weight %.2f must be greater than 0", *request.FlourWeight))
+	}
+
+	return nil
+}
+
+func (service *sourdoughRecipeService) validateFlourPercentages(name string, flour []domain.FlourPercentageDto) error {
+	var total float64
+	for _, percentage := range flour {
+		if percentage.BakerPercentage <= 0 {
+			return internalErrors.SourdoughRecipeFormulaInvalid(
+				fmt.Sprintf("baker percentage %.2f of %s %s must be greater than 0", percentage.BakerPercentage, name, percentage.Name))
+		}
+		total += percentage.BakerPercentage
+	}
+
+	if math.Abs(total-100) > formulaPercentageTolerance {
+		return internalErrors.SourdoughRecipeFormulaInvalid(
+			fmt.Sprintf("%s baker percentages must add up to 100, got %.2f", name, total))
+	}
+
+	return nil
+}
+
+// solveSourdoughRecipeFormula turns a validated formula into a create request.
+// The dough weight is split by the sum of all percentages, the levain weight
+// by one plus its hydration and starter share of the levain flour.
+func solveSourdoughRecipeFormula(request domain.CreateSourdoughRecipeFormulaRequest) domain.CreateSourdoughRecipeRequest {
+	var flourWeight float64
+	if request.FlourWeight != nil {
+		flourWeight = *request.FlourWeight
+	} else {
+		totalPercentage := 100 + request.Levain.BakerPercentage
+		for _, amount := range append(append([]domain.BakerAmountDto{}, request.Water...), request.AdditionalIngredients...) {
+			totalPercentage += amount.BakerPercentage
+		}
+		flourWeight = *request.DoughWeight * 100 / totalPercentage
+	}
+
+	grams := func(weight, percentage float64) float64 {
+		return roundTo(weight*percentage/100, 1)
+	}
+	withAmount := func(amount domain.BakerAmountDto) domain.BakerAmountDto {
+		amount.Amount = grams(flourWeight, amount.BakerPercentage)
+		return amount
+	}
+
+	levain := request.Levain
+	levainFlour := levain.Flour
+	if len(levainFlour) == 0 {
+		levainFlour = request.Flour
+	}
+	levainWeight := flourWeight * levain.BakerPercentage / 100
+	levainFlourWeight := levainWeight / (1 + (levain.Hydration+levain.StarterPercentage)/100)
+
+	toFlourAmount := func(weight float64) func(flour domain.FlourPercentageDto) domain.FlourAmountDto {
+		return func(flour domain.FlourPercentageDto) domain.FlourAmountDto {
+			return domain.FlourAmountDto{FlourDto: flour.FlourDto, Amount: grams(weight, flour.BakerPercentage)}
+		}
+	}
+
+	return domain.CreateSourdoughRecipeRequest{
+		Name:        request.Name,
+		Description: request.Description,
+		Flour:       utils.Map(request.Flour, toFlourAmount(flourWeight)),
+		Water:       utils.Map(request.Water, withAmount),
+		Levain: domain.SourdoughLevainAgentDto{
+			Amount:  domain.BakerAmountDto{Amount: roundTo(levainWeight, 1), BakerPercentage: levain.BakerPercentage},
+			Starter: domain.BakerAmountDto{Amount: grams(levainFlourWeight, levain.StarterPercentage), BakerPercentage: levain.StarterPercentage},
+			Flour:   utils.Map(levainFlour, toFlourAmount(levainFlourWeight)),
+			Water:   domain.BakerAmountDto{Amount: grams(levainFlourWeight, levain.Hydration), BakerPercentage: levain.Hydration},
+		},
+		AdditionalIngredients: utils.Map(request.AdditionalIngredients, withAmount),
+		NutritionFacts:        request.NutritionFacts,
+		Yield:                 request.Yield,
+		Tags:                  request.Tags,
+		Category:              request.Category,
+	}
+}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func (suite *SourdoughRecipeServiceTestSuite) TestCreateFromFormula_WithDoughWeight() {
+	doughWeight := 1970.0
+	request := generateFormulaRequest()
+	request.DoughWeight = &doughWeight
+
+	suite.expectRecipeCreated()
+
+	dto, err := suite.target.CreateFromFormula(suite.ctx, request)
+
+	suite.NoError(err)
+	suite.Equal(1, dto.Version)
+	suite.Equal([]domain.FlourAmountDto{
+		{FlourDto: domain.FlourDto{Id: test.FirstId, Name: "Bread flour"}, Amount: 900},
+		{FlourDto: domain.FlourDto{Id: test.SecondId, Name: "Rye flour"}, Amount: 100},
+	}, dto.Flour)
+	suite.Equal([]domain.BakerAmountDto{{Name: "Water", Amount: 750, BakerPercentage: 75}}, dto.Water)
+	suite.Equal([]domain.BakerAmountDto{{Name: "Salt", Amount: 20, BakerPercentage: 2}}, dto.AdditionalIngredients)
+	suite.Equal(domain.SourdoughLevainAgentDto{
+		Amount:  domain.BakerAmountDto{Amount: 200, BakerPercentage: 20},
+		Starter: domain.BakerAmountDto{},
+		Flour: []domain.FlourAmountDto{
+			{FlourDto: domain.FlourDto{Id: test.FirstId, Name: "Bread flour"}, Amount: 90},
+			{FlourDto: domain.FlourDto{Id: test.SecondId, Name: "Rye flour"}, Amount: 10},
+		},
+		Water: domain.BakerAmountDto{Amount: 100, BakerPercentage: 100},
+	}, dto.Levain)
+	suite.Equal(domain.RecipeDetailsDto{
+		Flour:                 domain.BakerAmountDto{Amount: 1000, BakerPercentage: 100},
+		Water:                 domain.BakerAmountDto{Amount: 750, BakerPercentage: 75},
+		Levain:                domain.BakerAmountDto{Amount: 200, BakerPercentage: 20},
+		AdditionalIngredients: domain.BakerAmountDto{Amount: 20, BakerPercentage: 2},
+		TotalWeight:           1970,
+	}, dto.Details)
+}
+
+func (suite *SourdoughRecipeServiceTestSuite) TestCreateFromFormula_WithFlourWeightAndLevainFlour() {
+	flourWeight := 500.0
+	request := generateFormulaRequest()
+	request.FlourWeight = &flourWeight
+	request.Levain = domain.SourdoughLevainFormulaDto{
+		BakerPercentage:   20,
+		Hydration:         80,
+		StarterPercentage: 20,
+		Flour:             []domain.FlourPercentageDto{{FlourDto: domain.FlourDto{Id: test.SecondId, Name: "Rye flour"}, BakerPercentage: 100}},
+	}
+
+	suite.expectRecipeCreated()
+
+	dto, err := suite.target.CreateFromFormula(suite.ctx, request)
+
+	suite.NoError(err)
+	suite.Equal(500.0, dto.Details.Flour.Amount)
+	suite.Equal(domain.SourdoughLevainAgentDto{
+		Amount:  domain.BakerAmountDto{Amount: 100, BakerPercentage: 20},
+		Starter: domain.BakerAmountDto{Amount: 10, BakerPercentage: 20},
+		Flour:   []domain.FlourAmountDto{{FlourDto: domain.FlourDto{Id: test.SecondId, Name: "Rye flour"}, Amount: 50}},
+		Water:   domain.BakerAmountDto{Amount: 40, BakerPercentage: 80},
+	}, dto.Levain)
+	suite.Equal(985, dto.Details.TotalWeight)
+}
+
+func (suite *SourdoughRecipeServiceTestSuite) TestCreateFromFormula_WithInvalidFormula() {
+	doughWeight := 1000.0
+	zero := 0.0
+
+	tests := []struct {
+		name          string
+		modify        func(request *domain.CreateSourdoughRecipeFormulaRequest)
+		expectedError error
+	}{
+		{
+			name: "without flour",
+			modify: func(request *domain.CreateSourdoughRecipeFormulaRequest) {
+				request.Flour = nil
+			},
+			expectedError: internalErrors.SourdoughRecipeFormulaInvalid("flour is required"),
+		},
+		{
+			name: "flour not adding up to 100",
+			modify: func(request *domain.CreateSourdoughRecipeFormulaRequest) {
+				request.Flour[1].BakerPercentage = 20
+			},
+			expectedError: internalErrors.SourdoughRecipeFormulaInvalid("flour baker percentages must add up to 100, got 110.00"),
+		},
+		{
+			name: "levain flour not adding up to 100",
+			modify: func(request *domain.CreateSourdoughRecipeFormulaRequest) {
+				request.Levain.Flour = []domain.FlourPercentageDto{{FlourDto: domain.FlourDto{Name: "Rye flour"}, BakerPercentage: 50}}
+			},
+			expectedError: internalErrors.SourdoughRecipeFormulaInvalid("levain flour baker percentages must add up to 100, got 50.00"),
+		},
+		{
+			name: "zero flour percentage",
+			modify: func(request *domain.CreateSourdoughRecipeFormulaRequest) {
+				request.Flour = append(request.Flour, domain.FlourPercentageDto{FlourDto: domain.FlourDto{Name: "Spelt flour"}})
+			},
+			expectedError: internalErrors.SourdoughRecipeFormulaInvalid("baker percentage 0.00 of flour Spelt flour must be greater than 0"),
+		},
+		{
+			name: "negative water",
+			modify: func(request *domain.CreateSourdoughRecipeFormulaRequest) {
+				request.Water[0].BakerPercentage = -75
+			},
+			expectedError: internalErrors.SourdoughRecipeFormulaInvalid("baker percentage -75.00 of Water must not be negative"),
+		},
+		{
+			name: "negative levain hydration",
+			modify: func(request *domain.CreateSourdoughRecipeFormulaRequest) {
+				request.Levain.Hydration = -1
+			},
+			expectedError: internalErrors.SourdoughRecipeFormulaInvalid("baker percentage -1.00 of levain hydration must not be negative"),
+		},
+		{
+			name: "without weight",
+			modify: func(request *domain.CreateSourdoughRecipeFormulaRequest) {
+				request.DoughWeight = nil
+			},
+			expectedError: internalErrors.SourdoughRecipeFormulaInvalid("dough_weight or flour_weight is required"),
+		},
+		{
+			name: "with dough and flour weight",
+			modify: func(request *domain.CreateSourdoughRecipeFormulaRequest) {
+				request.FlourWeight = &doughWeight
+			},
+			expectedError: internalErrors.SourdoughRecipeFormulaInvalid("only one of dough_weight and flour_weight can be set"),
+		},
+		{
+			name: "zero dough weight",
+			modify: func(request *domain.CreateSourdoughRecipeFormulaRequest) {
+				request.DoughWeight = &zero
+			},
+			expectedError: internalErrors.SourdoughRecipeFormulaInvalid("dough weight 0.00 must be greater than 0"),
+		},
+		{
+			name: "zero flour weight",
+			modify: func(request *domain.CreateSourdoughRecipeFormulaRequest) {
+				request.DoughWeight = nil
+				request.FlourWeight = &zero
+			},
+			expectedError: internalErrors.SourdoughRecipeFormulaInvalid("flour weight 0.00 must be greater than 0"),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			request := generateFormulaRequest()
+			request.DoughWeight = &doughWeight
+			tt.modify(&request)
+
+			dto, err := suite.target.CreateFromFormula(suite.ctx, request)
+
+			suite.Equal(tt.expectedError, err)
+			suite.Empty(dto)
+		})
+	}
+}
+
+func (suite *SourdoughRecipeServiceTestSuite) expectRecipeCreated() {
+	suite.expectTransaction()
+	suite.repository.EXPECT().
+		Create(suite.ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, entity domain.SourdoughRecipeEntity) (domain.SourdoughRecipeEntity, error) {
+			return entity, nil
+		})
+	suite.revisionRepository.EXPECT().
+		Create(suite.ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, revision domain.SourdoughRecipeRevisionEntity) (domain.SourdoughRecipeRevisionEntity, error) {
+			return revision, nil
+		})
+}
+
+func generateFormulaRequest() domain.CreateSourdoughRecipeFormulaRequest {
+	return domain.CreateSourdoughRecipeFormulaRequest{
+		Name: "Country loaf",
+		Flour: []domain.FlourPercentageDto{
+			{FlourDto: domain.FlourDto{Id: test.FirstId, Name: "Bread flour"}, BakerPercentage: 90},
+			{FlourDto: domain.FlourDto{Id: test.SecondId, Name: "Rye flour"}, BakerPercentage: 10},
+		},
+		Water: []domain.BakerAmountDto{{Name: "Water", BakerPercentage: 75}},
+		Levain: domain.SourdoughLevainFormulaDto{
+			BakerPercentage: 20,
+			Hydration:       100,
+		},
+		AdditionalIngredients: []domain.BakerAmountDto{{Name: "Salt", BakerPercentage: 2}},
+	}
+}
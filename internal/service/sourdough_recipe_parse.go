@@ -0,0 +1,408 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+)
+
+const (
+	parsedFlour      = "flour"
+	parsedWater      = "water"
+	parsedLevain     = "levain"
+	parsedIngredient = "ingredient"
+)
+
+// flourMatchThreshold is the lowest name similarity accepted as a flour match.
+// It lets "bread flour" find "Strong bread flour" while "salt" stays clear of
+// "Spelt".
+const flourMatchThreshold = 0.65
+
+// defaultParseFlourWeight is the flour the percentages refer to when the text
+// has no flour in grams and the request sets no flour weight.
+const defaultParseFlourWeight = 1000.0
+
+// amountExpression matches an amount with a decimal point or comma, or with commas
+// grouping the thousands as in 1,000.
+const amountExpression = `[1-9]\d{0,2}(?:,\d{3})+(?:\.\d+)?|\d+(?:[.,]\d+)?`
+
+var (
+	leadingAmountPattern  = regexp.MustCompile(`(?i)^(` + amountExpression + `)\s*(kg|grams?|gr|g|ml|%)\.?\s+(?:of\s+)?(.+)$`)
+	trailingAmountPattern = regexp.MustCompile(`(?i)^(.+?)[\s:=-]+(` + amountExpression + `)\s*(kg|grams?|gr|g|ml|%)\.?$`)
+	thousandsPattern      = regexp.MustCompile(`^[1-9]\d{0,2}(?:,\d{3})+(?:\.\d+)?$`)
+	listBulletPattern     = regexp.MustCompile(`^[-*•·]+\s*`)
+)
+
+var (
+	levainKeywords  = []string{"levain", "leaven", "starter", "sourdough"}
+	flourKeywords   = []string{"flour", "meal"}
+	flourStopTokens = map[string]bool{"flour": true, "of": true, "the": true, "and": true}
+)
+
+// parsedItem is a recognised ingredient, in grams unless percentage is set.
+type parsedItem struct {
+	line       int
+	text       string
+	name       string
+	kind       string
+	grams      float64
+	percentage *float64
+	flour      domain.FlourEntity
+}
+
+type sourdoughRecipeParseService struct {
+	flourRepository domain.FlourRepository
+}
+
+// Parse turns a pasted ingredient list into a create request. Ingredients
+// are separated by lines, commas or semicolons and read as "500g bread flour"
+// or "bread flour: 500 g", in g, kg, ml or baker percent. Flours are matched
+// to the catalogue by name similarity and levain is assumed to be at 100%
+// hydration, built from the dough flour blend. Nothing is stored.
+func (service *sourdoughRecipeParseService) Parse(
+	ctx context.Context,
+	request domain.SourdoughRecipeParseRequest,
+) (domain.SourdoughRecipeParseDto, error) {
+	if strings.TrimSpace(request.Text) == "" {
+		return domain.SourdoughRecipeParseDto{}, internalErrors.SourdoughRecipeParseInvalid("text is required")
+	}
+	if request.FlourWeight != nil && *request.FlourWeight <= 0 {
+		return domain.SourdoughRecipeParseDto{}, internalErrors.SourdoughRecipeParseInvalid(
+			fmt.Sprintf("flour weight %.2f must be greater than 0", *request.FlourWeight))
+	}
+
+	flours, err := service.flourRepository.FindAll(ctx)
+	if err != nil {
+		log.Err(err).Msg("failed to find flours")
+
+		return domain.SourdoughRecipeParseDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to parse recipe")
+	}
+
+	result := domain.SourdoughRecipeParseDto{
+		FlourMatches: []domain.ParsedFlourMatchDto{},
+		Unrecognised: []domain.UnrecognisedLineDto{},
+	}
+	name := strings.TrimSpace(request.Name)
+
+	var items []parsedItem
+	for index, line := range strings.Split(request.Text, "\n") {
+		for _, text := range splitIngredients(line) {
+			item, ok := parseIngredient(text)
+			if !ok {
+				// a title line above the ingredients names the recipe
+				if name == "" && len(items) == 0 && len(result.Unrecognised) == 0 {
+					name = text
+					continue
+				}
+				result.Unrecognised = append(result.Unrecognised, domain.UnrecognisedLineDto{
+					Line: index + 1, Text: text, Reason: "no amount in grams or percent found",
+				})
+				continue
+			}
+			item.line = index + 1
+
+			item.kind = classifyIngredient(item.name)
+			if item.kind == parsedIngredient {
+				flour, score, found := matchFlour(item.name, flours)
+				switch {
+				case found:
+					item.kind = parsedFlour
+					item.flour = flour
+					result.FlourMatches = append(result.FlourMatches, domain.ParsedFlourMatchDto{
+						Text: item.name, FlourId: flour.Id, Name: flour.Name, Score: roundTo(score, 2),
+					})
+				case containsAny(strings.ToLower(item.name), flourKeywords):
+					result.Unrecognised = append(result.Unrecognised, domain.UnrecognisedLineDto{
+						Line: item.line, Text: text, Reason: fmt.Sprintf("no flour in the catalogue matches %s", item.name),
+					})
+					continue
+				}
+			}
+
+			items = append(items, item)
+		}
+	}
+
+	result.Recipe = buildParsedRecipe(name, items, request.FlourWeight)
+
+	return result, nil
+}
+
+// splitIngredients splits a line at semicolons and at commas that are not a
+// decimal or thousands separator.
+func splitIngredients(line string) []string {
+	var texts []string
+	runes := []rune(line)
+	start := 0
+	for i, r := range runes {
+		decimal := r == ',' && i > 0 && i < len(runes)-1 && unicode.IsDigit(runes[i-1]) && unicode.IsDigit(runes[i+1])
+		if (r == ',' || r == ';') && !decimal {
+			texts = append(texts, string(runes[start:i]))
+			start = i + 1
+		}
+	}
+	texts = append(texts, string(runes[start:]))
+
+	cleaned := texts[:0]
+	for _, text := range texts {
+		text = strings.TrimSpace(listBulletPattern.ReplaceAllString(strings.TrimSpace(text), ""))
+		text = strings.TrimSpace(strings.TrimSuffix(text, "."))
+		if text != "" {
+			cleaned = append(cleaned, text)
+		}
+	}
+	return cleaned
+}
+
+func parseIngredient(text string) (parsedItem, bool) {
+	var amount, unit, name string
+	if match := leadingAmountPattern.FindStringSubmatch(text); match != nil {
+		amount, unit, name = match[1], match[2], match[3]
+	} else if match = trailingAmountPattern.FindStringSubmatch(text); match != nil {
+		name, amount, unit = match[1], match[2], match[3]
+	} else {
+		return parsedItem{}, false
+	}
+
+	if thousandsPattern.MatchString(amount) {
+		amount = strings.ReplaceAll(amount, ",", "")
+	} else {
+		amount = strings.Replace(amount, ",", ".", 1)
+	}
+	value, err := strconv.ParseFloat(amount, 64)
+	if err != nil {
+		return parsedItem{}, false
+	}
+
+	item := parsedItem{text: text, name: strings.TrimSpace(name)}
+	switch strings.ToLower(unit) {
+	case "%":
+		item.percentage = &value
+	case "kg":
+		item.grams = value * 1000
+	default:
+		// water, milk and the like weigh about a gram per millilitre
+		item.grams = value
+	}
+	return item, true
+}
+
+func classifyIngredient(name string) string {
+	lower := strings.ToLower(name)
+	switch {
+	case containsAny(lower, levainKeywords):
+		return parsedLevain
+	case containsAny(lower, []string{"water"}):
+		return parsedWater
+	default:
+		return parsedIngredient
+	}
+}
+
+func containsAny(text string, keywords []string) bool {
+	for _, keyword := range keywords {
+		if strings.Contains(text, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchFlour returns the catalogue flour whose name is most similar to name,
+// the first one in catalogue order on equal scores.
+func matchFlour(name string, flours []domain.FlourEntity) (domain.FlourEntity, float64, bool) {
+	var best domain.FlourEntity
+	var bestScore float64
+	for _, flour := range flours {
+		if score := flourNameScore(name, flour.Name); score > bestScore {
+			best, bestScore = flour, score
+		}
+	}
+	return best, bestScore, bestScore >= flourMatchThreshold
+}
+
+// flourNameScore compares the words of both names, each word scored by its
+// closest counterpart and averaged in both directions, so extra words on
+// either side lower the score. Names written as one word ("wholewheat") are
+// compared as a whole as well.
+func flourNameScore(query, name string) float64 {
+	queryTokens, nameTokens := flourNameTokens(query), flourNameTokens(name)
+	if len(queryTokens) == 0 || len(nameTokens) == 0 {
+		return 0
+	}
+
+	tokenScore := (tokenCoverage(queryTokens, nameTokens) + tokenCoverage(nameTokens, queryTokens)) / 2
+	joinedScore := stringSimilarity(strings.Join(queryTokens, ""), strings.Join(nameTokens, ""))
+
+	return max(tokenScore, joinedScore)
+}
+
+func flourNameTokens(name string) []string {
+	tokens := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	significant := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		if !flourStopTokens[token] {
+			significant = append(significant, token)
+		}
+	}
+	if len(significant) == 0 {
+		return tokens
+	}
+	return significant
+}
+
+func tokenCoverage(tokens, candidates []string) float64 {
+	var total float64
+	for _, token := range tokens {
+		var best float64
+		for _, candidate := range candidates {
+			best = max(best, stringSimilarity(token, candidate))
+		}
+		total += best
+	}
+	return total / float64(len(tokens))
+}
+
+// stringSimilarity is one minus the edit distance relative to the longer
+// string.
+func stringSimilarity(a, b string) float64 {
+	first, second := []rune(a), []rune(b)
+	longest := max(len(first), len(second))
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(levenshtein(first, second))/float64(longest)
+}
+
+func levenshtein(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+// buildParsedRecipe converts the recognised ingredients to grams. Percentages
+// refer to flourWeight when set, else to the flour given in grams, else to
+// defaultParseFlourWeight. Repeated flours are added up.
+func buildParsedRecipe(name string, items []parsedItem, flourWeight *float64) domain.CreateSourdoughRecipeRequest {
+	base := defaultParseFlourWeight
+	var gramFlour float64
+	for _, item := range items {
+		if item.kind == parsedFlour && item.percentage == nil {
+			gramFlour += item.grams
+		}
+	}
+	switch {
+	case flourWeight != nil:
+		base = *flourWeight
+	case gramFlour > 0:
+		base = gramFlour
+	}
+
+	grams := func(item parsedItem) float64 {
+		if item.percentage != nil {
+			return roundTo(base**item.percentage/100, 1)
+		}
+		return item.grams
+	}
+
+	recipe := domain.CreateSourdoughRecipeRequest{
+		Name:                  name,
+		Flour:                 []domain.FlourAmountDto{},
+		Water:                 []domain.BakerAmountDto{},
+		AdditionalIngredients: []domain.BakerAmountDto{},
+	}
+
+	var levain float64
+	for _, item := range items {
+		switch item.kind {
+		case parsedFlour:
+			recipe.Flour = addParsedFlour(recipe.Flour, item.flour.ToDto(), grams(item))
+		case parsedWater:
+			recipe.Water = append(recipe.Water, domain.BakerAmountDto{Name: item.name, Amount: grams(item)})
+		case parsedLevain:
+			levain += grams(item)
+		default:
+			recipe.AdditionalIngredients = append(recipe.AdditionalIngredients, domain.BakerAmountDto{Name: item.name, Amount: grams(item)})
+		}
+	}
+
+	var doughFlour float64
+	for _, flour := range recipe.Flour {
+		doughFlour += flour.Amount
+	}
+	percentage := func(amount float64) float64 {
+		if doughFlour == 0 {
+			return 0
+		}
+		return roundTo(amount*100/doughFlour, 2)
+	}
+	for i := range recipe.Water {
+		recipe.Water[i].BakerPercentage = percentage(recipe.Water[i].Amount)
+	}
+	for i := range recipe.AdditionalIngredients {
+		recipe.AdditionalIngredients[i].BakerPercentage = percentage(recipe.AdditionalIngredients[i].Amount)
+	}
+
+	if levain > 0 {
+		levainFlour := levain / 2
+		recipe.Levain = domain.SourdoughLevainAgentDto{
+			Amount: domain.BakerAmountDto{Amount: roundTo(levain, 1), BakerPercentage: percentage(levain)},
+			Flour:  []domain.FlourAmountDto{},
+			Water:  domain.BakerAmountDto{Amount: roundTo(levain-levainFlour, 1), BakerPercentage: 100},
+		}
+		for _, flour := range recipe.Flour {
+			recipe.Levain.Flour = append(recipe.Levain.Flour, domain.FlourAmountDto{
+				FlourDto: flour.FlourDto,
+				Amount:   roundTo(levainFlour*flour.Amount/doughFlour, 1),
+			})
+		}
+	}
+
+	return recipe
+}
+
+func addParsedFlour(flours []domain.FlourAmountDto, flour domain.FlourDto, amount float64) []domain.FlourAmountDto {
+	for i := range flours {
+		if flours[i].Id == flour.Id {
+			flours[i].Amount += amount
+			return flours
+		}
+	}
+	return append(flours, domain.FlourAmountDto{FlourDto: flour, Amount: amount})
+}
+
+func NewSourdoughRecipeParseService(flourRepository domain.FlourRepository) (domain.SourdoughRecipeParseService, error) {
+	if flourRepository == nil {
+		return nil, errors.New("flourRepository cannot be nil")
+	}
+
+	return &sourdoughRecipeParseService{
+		flourRepository: flourRepository,
+	}, nil
+}
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func TestSourdoughRecipeParseServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SourdoughRecipeParseServiceTestSuite))
+}
+
+type SourdoughRecipeParseServiceTestSuite struct {
+	test.GoMockTestSuite
+
+	ctx             context.Context
+	flourRepository *mocks.MockFlourRepository
+
+	breadFlour domain.FlourEntity
+	ryeFlour   domain.FlourEntity
+	spelt      domain.FlourEntity
+
+	target domain.SourdoughRecipeParseService
+}
+
+func (suite *SourdoughRecipeParseServiceTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.ctx = context.Background()
+	suite.flourRepository = mocks.NewMockFlourRepository(suite.MockCtrl)
+
+	suite.breadFlour = domain.FlourEntity{Id: test.FirstId, Name: "Bread flour", FlourType: "bread"}
+	suite.ryeFlour = domain.FlourEntity{Id: test.SecondId, Name: "Rye flour", FlourType: "rye"}
+	suite.spelt = domain.FlourEntity{Id: test.ThirdId, Name: "Spelt", FlourType: "spelt"}
+
+	suite.target = test.Must(func() (domain.SourdoughRecipeParseService, error) {
+		return NewSourdoughRecipeParseService(suite.flourRepository)
+	})
+}
+
+func (suite *SourdoughRecipeParseServiceTestSuite) TestParse() {
+	suite.expectFlours()
+
+	actual, err := suite.target.Parse(suite.ctx, domain.SourdoughRecipeParseRequest{
+		Name: "Country loaf",
+		Text: "500g bread flour, 375g water, 100g levain, 10g salt",
+	})
+
+	suite.NoError(err)
+	suite.Equal(domain.SourdoughRecipeParseDto{
+		Recipe: domain.CreateSourdoughRecipeRequest{
+			Name:  "Country loaf",
+			Flour: []domain.FlourAmountDto{{FlourDto: suite.breadFlour.ToDto(), Amount: 500}},
+			Water: []domain.BakerAmountDto{{Name: "water", Amount: 375, BakerPercentage: 75}},
+			Levain: domain.SourdoughLevainAgentDto{
+				Amount: domain.BakerAmountDto{Amount: 100, BakerPercentage: 20},
+				Flour:  []domain.FlourAmountDto{{FlourDto: suite.breadFlour.ToDto(), Amount: 50}},
+				Water:  domain.BakerAmountDto{Amount: 50, BakerPercentage: 100},
+			},
+			AdditionalIngredients: []domain.BakerAmountDto{{Name: "salt", Amount: 10, BakerPercentage: 2}},
+		},
+		FlourMatches: []domain.ParsedFlourMatchDto{
+			{Text: "bread flour", FlourId: suite.breadFlour.Id, Name: "Bread flour", Score: 1},
+		},
+		Unrecognised: []domain.UnrecognisedLineDto{},
+	}, actual)
+}
+
+func (suite *SourdoughRecipeParseServiceTestSuite) TestParse_WithBakerPercentages() {
+	suite.expectFlours()
+	flourWeight := 500.0
+
+	actual, err := suite.target.Parse(suite.ctx, domain.SourdoughRecipeParseRequest{
+		Text: "Rye sourdough\n" +
+			"- bred flour 80%\n" +
+			"- rye: 20 %\n" +
+			"- water 78%\n" +
+			"- 2,5% salt\n" +
+			"- a pinch of love\n" +
+			"- 100g emmer flour\n",
+		FlourWeight: &flourWeight,
+	})
+
+	suite.NoError(err)
+	suite.Equal(domain.CreateSourdoughRecipeRequest{
+		Name: "Rye sourdough",
+		Flour: []domain.FlourAmountDto{
+			{FlourDto: suite.breadFlour.ToDto(), Amount: 400},
+			{FlourDto: suite.ryeFlour.ToDto(), Amount: 100},
+		},
+		Water:                 []domain.BakerAmountDto{{Name: "water", Amount: 390, BakerPercentage: 78}},
+		AdditionalIngredients: []domain.BakerAmountDto{{Name: "salt", Amount: 12.5, BakerPercentage: 2.5}},
+	}, actual.Recipe)
+	suite.Equal([]domain.ParsedFlourMatchDto{
+		{Text: "bred flour", FlourId: suite.breadFlour.Id, Name: "Bread flour", Score: 0.8},
+		{Text: "rye", FlourId: suite.ryeFlour.Id, Name: "Rye flour", Score: 1},
+	}, actual.FlourMatches)
+	suite.Equal([]domain.UnrecognisedLineDto{
+		{Line: 6, Text: "a pinch of love", Reason: "no amount in grams or percent found"},
+		{Line: 7, Text: "100g emmer flour", Reason: "no flour in the catalogue matches emmer flour"},
+	}, actual.Unrecognised)
+}
+
+func (suite *SourdoughRecipeParseServiceTestSuite) TestParse_WithRepeatedFlourAndUnits() {
+	suite.expectFlours()
+
+	actual, err := suite.target.Parse(suite.ctx, domain.SourdoughRecipeParseRequest{
+		Text: "1kg bread flour\n200 g Bread Flour\nwater: 780ml\n2% salt",
+	})
+
+	suite.NoError(err)
+	suite.Equal([]domain.FlourAmountDto{{FlourDto: suite.breadFlour.ToDto(), Amount: 1200}}, actual.Recipe.Flour)
+	suite.Equal([]domain.BakerAmountDto{{Name: "water", Amount: 780, BakerPercentage: 65}}, actual.Recipe.Water)
+	suite.Equal([]domain.BakerAmountDto{{Name: "salt", Amount: 24, BakerPercentage: 2}}, actual.Recipe.AdditionalIngredients)
+	suite.Empty(actual.Recipe.Name)
+}
+
+func (suite *SourdoughRecipeParseServiceTestSuite) TestParse_WithThousandsSeparator() {
+	suite.expectFlours()
+
+	actual, err := suite.target.Parse(suite.ctx, domain.SourdoughRecipeParseRequest{
+		Text: "1,000g bread flour, water 0,75 kg\n1,000.5 g Bread Flour",
+	})
+
+	suite.NoError(err)
+	suite.Equal([]domain.FlourAmountDto{{FlourDto: suite.breadFlour.ToDto(), Amount: 2000.5}}, actual.Recipe.Flour)
+	suite.Equal(750.0, actual.Recipe.Water[0].Amount)
+}
+
+func (suite *SourdoughRecipeParseServiceTestSuite) TestParse_WithInvalidRequest() {
+	zero := 0.0
+
+	tests := []struct {
+		name          string
+		request       domain.SourdoughRecipeParseRequest
+		expectedError error
+	}{
+		{
+			name:          "blank text",
+			request:       domain.SourdoughRecipeParseRequest{Text: " \n "},
+			expectedError: internalErrors.SourdoughRecipeParseInvalid("text is required"),
+		},
+		{
+			name:          "flour weight not positive",
+			request:       domain.SourdoughRecipeParseRequest{Text: "80% bread flour", FlourWeight: &zero},
+			expectedError: internalErrors.SourdoughRecipeParseInvalid("flour weight 0.00 must be greater than 0"),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			actual, err := suite.target.Parse(suite.ctx, tt.request)
+
+			suite.Equal(tt.expectedError, err)
+			suite.Equal(domain.SourdoughRecipeParseDto{}, actual)
+		})
+	}
+}
+
+func (suite *SourdoughRecipeParseServiceTestSuite) TestParse_WithRepositoryError() {
+	suite.flourRepository.EXPECT().FindAll(suite.ctx).Return(nil, assert.AnError)
+
+	actual, err := suite.target.Parse(suite.ctx, domain.SourdoughRecipeParseRequest{Text: "500g bread flour"})
+
+	suite.Equal(internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to parse recipe"), err)
+	suite.Equal(domain.SourdoughRecipeParseDto{}, actual)
+}
+
+func (suite *SourdoughRecipeParseServiceTestSuite) expectFlours() {
+	suite.flourRepository.EXPECT().FindAll(gomock.Any()).
+		Return([]domain.FlourEntity{suite.breadFlour, suite.ryeFlour, suite.spelt}, nil)
+}
+
+func TestFlourNameScore(t *testing.T) {
+	tests := []struct {
+		query    string
+		name     string
+		expected float64
+	}{
+		{query: "Bread flour", name: "bread flour", expected: 1},
+		{query: "wholewheat", name: "Whole Wheat Flour", expected: 1},
+		{query: "bread flour", name: "Strong bread flour", expected: 0.792},
+		{query: "bred", name: "Bread flour", expected: 0.8},
+		{query: "salt", name: "Spelt", expected: 0.6},
+		{query: "", name: "Spelt", expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query+" "+tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.expected, flourNameScore(tt.query, tt.name), 0.001)
+		})
+	}
+}
+
+func TestSplitIngredients(t *testing.T) {
+	assert.Equal(t,
+		[]string{"500g bread flour", "2,5% salt", "375g water", "rye", "1,000g flour"},
+		splitIngredients(" - 500g bread flour, 2,5% salt;375g water,rye, 1,000g flour."))
+}
+
+func TestNewSourdoughRecipeParseService_WithNilDependencies(t *testing.T) {
+	_, err := NewSourdoughRecipeParseService(nil)
+	assert.EqualError(t, err, "flourRepository cannot be nil")
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/utils"
+)
+
+type diffItem struct {
+	key        string
+	name       string
+	amount     float64
+	percentage float64
+}
+
+type sourdoughRecipeRevisionService struct {
+	repository             domain.SourdoughRecipeRevisionRepository
+	sourdoughRecipeService domain.SourdoughRecipeService
+}
+
+func (service *sourdoughRecipeRevisionService) FindByRecipeId(ctx context.Context, recipeId uuid.UUID, offset, limit int) ([]domain.SourdoughRecipeRevisionDto, error) {
+	revisions, err := service.repository.FindByRecipeId(ctx, recipeId, offset, limit)
+	if err != nil {
+		log.Err(err).
+			Str("recipe_id", recipeId.String()).
+			Msg("failed to find recipe revisions")
+
+		return nil, internalErrors.NewInternalServerErrorWrap(err, "failed to find recipe revisions")
+	}
+
+	return utils.Map(revisions, func(entity domain.SourdoughRecipeRevisionEntity) domain.SourdoughRecipeRevisionDto {
+		return entity.ToDto()
+	}), nil
+}
+
+func (service *sourdoughRecipeRevisionService) FindByVersion(ctx context.Context, recipeId uuid.UUID, version int) (domain.SourdoughRecipeRevisionDto, error) {
+	revision, err := service.getByVersion(ctx, recipeId, version)
+	if err != nil {
+		return domain.SourdoughRecipeRevisionDto{}, err
+	}
+
+	return revision.ToDto(), nil
+}
+
+func (service *sourdoughRecipeRevisionService) Diff(ctx context.Context, recipeId uuid.UUID, fromVersion, toVersion int) (domain.SourdoughRecipeRevisionDiffDto, error) {
+	from, err := service.getByVersion(ctx, recipeId, fromVersion)
+	if err != nil {
+		return domain.SourdoughRecipeRevisionDiffDto{}, err
+	}
+
+	to, err := service.getByVersion(ctx, recipeId, toVersion)
+	if err != nil {
+		return domain.SourdoughRecipeRevisionDiffDto{}, err
+	}
+
+	return service.diff(from.ToDto(), to.ToDto()), nil
+}
+
+func (service *sourdoughRecipeRevisionService) Restore(ctx context.Context, recipeId uuid.UUID, version int) (domain.SourdoughRecipeDto, error) {
+	revision, err := service.getByVersion(ctx, recipeId, version)
+	if err != nil {
+		return domain.SourdoughRecipeDto{}, err
+	}
+
+	return service.sourdoughRecipeService.Update(ctx, recipeId, revision.Recipe.ToDto().ToCreateRequest())
+}
+
+func (service *sourdoughRecipeRevisionService) getByVersion(ctx context.Context, recipeId uuid.UUID, version int) (domain.SourdoughRecipeRevisionEntity, error) {
+	revision, err := service.repository.GetByRecipeIdAndVersion(ctx, recipeId, version)
+	if err != nil {
+		log.Err(err).
+			Str("recipe_id", recipeId.String()).
+			Int("version", version).
+			Msg("failed to find recipe revision")
+
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return domain.SourdoughRecipeRevisionEntity{}, internalErrors.SourdoughRecipeRevisionNotFound(recipeId, version)
+		}
+
+		return domain.SourdoughRecipeRevisionEntity{}, internalErrors.NewInternalServerErrorWrap(err, "failed to find recipe revision")
+	}
+
+	return revision, nil
+}
+
+func (service *sourdoughRecipeRevisionService) diff(from, to domain.SourdoughRecipeRevisionDto) domain.SourdoughRecipeRevisionDiffDto {
+	return domain.SourdoughRecipeRevisionDiffDto{
+		RecipeId:    to.RecipeId,
+		FromVersion: from.Version,
+		ToVersion:   to.Version,
+		Flour:       service.diffItems(service.flourItems(from.Recipe.Flour), service.flourItems(to.Recipe.Flour)),
+		Water:       service.diffItems(service.bakerAmountItems(from.Recipe.Water), service.bakerAmountItems(to.Recipe.Water)),
+		AdditionalIngredients: service.diffItems(
+			service.bakerAmountItems(from.Recipe.AdditionalIngredients),
+			service.bakerAmountItems(to.Recipe.AdditionalIngredients),
+		),
+		Levain:  service.diffItems(service.levainItems(from.Recipe.Levain), service.levainItems(to.Recipe.Levain)),
+		Details: service.diffItems(service.detailsItems(from.Recipe.Details), service.detailsItems(to.Recipe.Details)),
+	}
+}
+
+func (service *sourdoughRecipeRevisionService) flourItems(flours []domain.FlourAmountDto) []diffItem {
+	var totalFlourAmount float64
+	for _, flour := range flours {
+		totalFlourAmount += flour.Amount
+	}
+
+	return utils.Map(flours, func(flour domain.FlourAmountDto) diffItem {
+		item := diffItem{
+			key:    flour.Id.String(),
+			name:   flour.Name,
+			amount: flour.Amount,
+		}
+
+		if totalFlourAmount != 0 {
+			item.percentage = flour.Amount / totalFlourAmount * 100
+		}
+
+		return item
+	})
+}
+
+func (service *sourdoughRecipeRevisionService) bakerAmountItems(amounts []domain.BakerAmountDto) []diffItem {
+	return utils.Map(amounts, func(amount domain.BakerAmountDto) diffItem {
+		return service.bakerAmountItem(amount.Name, amount)
+	})
+}
+
+func (service *sourdoughRecipeRevisionService) bakerAmountItem(name string, amount domain.BakerAmountDto) diffItem {
+	return diffItem{
+		key:        name,
+		name:       name,
+		amount:     amount.Amount,
+		percentage: amount.BakerPercentage,
+	}
+}
+
+func (service *sourdoughRecipeRevisionService) levainItems(levain domain.SourdoughLevainAgentDto) []diffItem {
+	items := []diffItem{
+		service.bakerAmountItem("amount", levain.Amount),
+		service.bakerAmountItem("starter", levain.Starter),
+		service.bakerAmountItem("water", levain.Water),
+	}
+
+	return append(items, service.flourItems(levain.Flour)...)
+}
+
+func (service *sourdoughRecipeRevisionService) detailsItems(details domain.RecipeDetailsDto) []diffItem {
+	return []diffItem{
+		service.bakerAmountItem("flour", details.Flour),
+		service.bakerAmountItem("water", details.Water),
+		service.bakerAmountItem("levain", details.Levain),
+		service.bakerAmountItem("additional_ingredients", details.AdditionalIngredients),
+		{key: "total_weight", name: "total_weight", amount: float64(details.TotalWeight)},
+	}
+}
+
+func (service *sourdoughRecipeRevisionService) diffItems(from, to []diffItem) []domain.IngredientDiffDto {
+	diffs := make([]domain.IngredientDiffDto, 0)
+
+	toItems := make(map[string]diffItem, len(to))
+	for _, item := range to {
+		toItems[item.key] = item
+	}
+
+	fromKeys := make(map[string]bool, len(from))
+	for _, fromItem := range from {
+		fromKeys[fromItem.key] = true
+
+		toItem, ok := toItems[fromItem.key]
+		if !ok {
+			diffs = append(diffs, domain.IngredientDiffDto{
+				Name:                fromItem.name,
+				Change:              domain.IngredientRemoved,
+				FromAmount:          fromItem.amount,
+				FromBakerPercentage: fromItem.percentage,
+			})
+			continue
+		}
+
+		if fromItem.amount == toItem.amount && fromItem.percentage == toItem.percentage {
+			continue
+		}
+
+		diffs = append(diffs, domain.IngredientDiffDto{
+			Name:                toItem.name,
+			Change:              domain.IngredientChanged,
+			FromAmount:          fromItem.amount,
+			ToAmount:            toItem.amount,
+			FromBakerPercentage: fromItem.percentage,
+			ToBakerPercentage:   toItem.percentage,
+		})
+	}
+
+	for _, toItem := range to {
+		if fromKeys[toItem.key] {
+			continue
+		}
+
+		diffs = append(diffs, domain.IngredientDiffDto{
+			Name:              toItem.name,
+			Change:            domain.IngredientAdded,
+			ToAmount:          toItem.amount,
+			ToBakerPercentage: toItem.percentage,
+		})
+	}
+
+	return diffs
+}
+
+func NewSourdoughRecipeRevisionService(
+	repository domain.SourdoughRecipeRevisionRepository,
+	sourdoughRecipeService domain.SourdoughRecipeService,
+) (domain.SourdoughRecipeRevisionService, error) {
+	if repository == nil {
+		return nil, errors.New("repository cannot be nil")
+	}
+
+	if sourdoughRecipeService == nil {
+		return nil, errors.New("sourdoughRecipeService cannot be nil")
+	}
+
+	return &sourdoughRecipeRevisionService{
+		repository:             repository,
+		sourdoughRecipeService: sourdoughRecipeService,
+	}, nil
+}
@@ -0,0 +1,313 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func TestSourdoughRecipeRevisionServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SourdoughRecipeRevisionServiceTestSuite))
+}
+
+type SourdoughRecipeRevisionServiceTestSuite struct {
+	test.GoMockTestSuite
+
+	ctx                    context.Context
+	repository             *mocks.MockSourdoughRecipeRevisionRepository
+	sourdoughRecipeService *mocks.MockSourdoughRecipeService
+
+	target domain.SourdoughRecipeRevisionService
+}
+
+func (suite *SourdoughRecipeRevisionServiceTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.ctx = context.Background()
+	suite.repository = mocks.NewMockSourdoughRecipeRevisionRepository(suite.MockCtrl)
+	suite.sourdoughRecipeService = mocks.NewMockSourdoughRecipeService(suite.MockCtrl)
+
+	suite.target = test.Must(func() (domain.SourdoughRecipeRevisionService, error) {
+		return NewSourdoughRecipeRevisionService(suite.repository, suite.sourdoughRecipeService)
+	})
+}
+
+func (suite *SourdoughRecipeRevisionServiceTestSuite) TestFindByRecipeId() {
+	revisions := []domain.SourdoughRecipeRevisionEntity{createRevisionEntity(2), createRevisionEntity(1)}
+
+	suite.repository.EXPECT().
+		FindByRecipeId(suite.ctx, test.ThirdId, 0, 10).
+		Return(revisions, nil)
+
+	result, err := suite.target.FindByRecipeId(suite.ctx, test.ThirdId, 0, 10)
+
+	suite.NoError(err)
+	suite.Equal([]domain.SourdoughRecipeRevisionDto{revisions[0].ToDto(), revisions[1].ToDto()}, result)
+}
+
+func (suite *SourdoughRecipeRevisionServiceTestSuite) TestFindByRecipeId_WithError() {
+	suite.repository.EXPECT().
+		FindByRecipeId(suite.ctx, test.ThirdId, 0, 10).
+		Return(nil, assert.AnError)
+
+	result, err := suite.target.FindByRecipeId(suite.ctx, test.ThirdId, 0, 10)
+
+	suite.Equal(internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to find recipe revisions"), err)
+	suite.Nil(result)
+}
+
+func (suite *SourdoughRecipeRevisionServiceTestSuite) TestFindByVersion() {
+	revision := createRevisionEntity(3)
+
+	suite.repository.EXPECT().
+		GetByRecipeIdAndVersion(suite.ctx, test.ThirdId, 3).
+		Return(revision, nil)
+
+	result, err := suite.target.FindByVersion(suite.ctx, test.ThirdId, 3)
+
+	suite.NoError(err)
+	suite.Equal(revision.ToDto(), result)
+}
+
+func (suite *SourdoughRecipeRevisionServiceTestSuite) TestFindByVersion_WithError() {
+	tests := []struct {
+		name                string
+		errorFromRepository error
+		expectedError       error
+	}{
+		{
+			name:                "with basic error",
+			errorFromRepository: assert.AnError,
+			expectedError:       internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to find recipe revision"),
+		},
+		{
+			name:                "with document not found error",
+			errorFromRepository: mongo.ErrNoDocuments,
+			expectedError:       internalErrors.SourdoughRecipeRevisionNotFound(test.ThirdId, 3),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.repository.EXPECT().
+				GetByRecipeIdAndVersion(suite.ctx, test.ThirdId, 3).
+				Return(domain.SourdoughRecipeRevisionEntity{}, tt.errorFromRepository)
+
+			result, err := suite.target.FindByVersion(suite.ctx, test.ThirdId, 3)
+
+			suite.Equal(tt.expectedError, err)
+			suite.Empty(result)
+		})
+	}
+}
+
+func (suite *SourdoughRecipeRevisionServiceTestSuite) TestDiff() {
+	from := createRevisionEntity(1)
+
+	to := createRevisionEntity(2)
+	to.Recipe.Flour = []domain.FlourAmount{
+		{FlourEntity: domain.FlourEntity{Id: test.FirstId, Name: "bread flour"}, Amount: 800},
+		{FlourEntity: domain.FlourEntity{Id: test.ThirdId, Name: "rye flour"}, Amount: 200},
+	}
+	to.Recipe.AdditionalIngredients = append(to.Recipe.AdditionalIngredients, domain.BakerAmount{
+		Name:            "Yeast",
+		Amount:          5,
+		BakerPercentage: 0.5,
+	})
+	to.Recipe.Details.AdditionalIngredients = domain.BakerAmount{Amount: 25, BakerPercentage: 2.5}
+	to.Recipe.Details.TotalWeight = 1925
+
+	suite.repository.EXPECT().
+		GetByRecipeIdAndVersion(suite.ctx, test.ThirdId, 1).
+		Return(from, nil)
+	suite.repository.EXPECT().
+		GetByRecipeIdAndVersion(suite.ctx, test.ThirdId, 2).
+		Return(to, nil)
+
+	result, err := suite.target.Diff(suite.ctx, test.ThirdId, 1, 2)
+
+	suite.NoError(err)
+	suite.Equal(domain.SourdoughRecipeRevisionDiffDto{
+		RecipeId:    test.ThirdId,
+		FromVersion: 1,
+		ToVersion:   2,
+		Flour: []domain.IngredientDiffDto{
+			{
+				Name:                "bread flour",
+				Change:              domain.IngredientChanged,
+				FromAmount:          900,
+				ToAmount:            800,
+				FromBakerPercentage: 90,
+				ToBakerPercentage:   80,
+			},
+			{
+				Name:                "whole wheat flour",
+				Change:              domain.IngredientRemoved,
+				FromAmount:          100,
+				FromBakerPercentage: 10,
+			},
+			{
+				Name:              "rye flour",
+				Change:            domain.IngredientAdded,
+				ToAmount:          200,
+				ToBakerPercentage: 20,
+			},
+		},
+		Water: []domain.IngredientDiffDto{},
+		AdditionalIngredients: []domain.IngredientDiffDto{
+			{
+				Name:              "Yeast",
+				Change:            domain.IngredientAdded,
+				ToAmount:          5,
+				ToBakerPercentage: 0.5,
+			},
+		},
+		Levain: []domain.IngredientDiffDto{},
+		Details: []domain.IngredientDiffDto{
+			{
+				Name:                "additional_ingredients",
+				Change:              domain.IngredientChanged,
+				FromAmount:          20,
+				ToAmount:            25,
+				FromBakerPercentage: 2,
+				ToBakerPercentage:   2.5,
+			},
+			{
+				Name:       "total_weight",
+				Change:     domain.IngredientChanged,
+				FromAmount: 1920,
+				ToAmount:   1925,
+			},
+		},
+	}, result)
+}
+
+func (suite *SourdoughRecipeRevisionServiceTestSuite) TestDiff_WithSameVersion_ShouldReturnEmptyDiff() {
+	revision := createRevisionEntity(1)
+
+	suite.repository.EXPECT().
+		GetByRecipeIdAndVersion(suite.ctx, test.ThirdId, 1).
+		Return(revision, nil).
+		Times(2)
+
+	result, err := suite.target.Diff(suite.ctx, test.ThirdId, 1, 1)
+
+	suite.NoError(err)
+	suite.Empty(result.Flour)
+	suite.Empty(result.Water)
+	suite.Empty(result.AdditionalIngredients)
+	suite.Empty(result.Levain)
+	suite.Empty(result.Details)
+}
+
+func (suite *SourdoughRecipeRevisionServiceTestSuite) TestDiff_WithMissingVersion() {
+	suite.repository.EXPECT().
+		GetByRecipeIdAndVersion(suite.ctx, test.ThirdId, 1).
+		Return(createRevisionEntity(1), nil)
+	suite.repository.EXPECT().
+		GetByRecipeIdAndVersion(suite.ctx, test.ThirdId, 5).
+		Return(domain.SourdoughRecipeRevisionEntity{}, mongo.ErrNoDocuments)
+
+	result, err := suite.target.Diff(suite.ctx, test.ThirdId, 1, 5)
+
+	suite.Equal(internalErrors.SourdoughRecipeRevisionNotFound(test.ThirdId, 5), err)
+	suite.Empty(result)
+}
+
+func (suite *SourdoughRecipeRevisionServiceTestSuite) TestRestore() {
+	revision := createRevisionEntity(1)
+	restored := revision.Recipe.ToDto()
+	restored.Version = 4
+
+	suite.repository.EXPECT().
+		GetByRecipeIdAndVersion(suite.ctx, test.ThirdId, 1).
+		Return(revision, nil)
+	suite.sourdoughRecipeService.EXPECT().
+		Update(suite.ctx, test.ThirdId, revision.Recipe.ToDto().ToCreateRequest()).
+		Return(restored, nil)
+
+	result, err := suite.target.Restore(suite.ctx, test.ThirdId, 1)
+
+	suite.NoError(err)
+	suite.Equal(restored, result)
+}
+
+func (suite *SourdoughRecipeRevisionServiceTestSuite) TestRestore_WithMissingVersion() {
+	suite.repository.EXPECT().
+		GetByRecipeIdAndVersion(suite.ctx, test.ThirdId, 1).
+		Return(domain.SourdoughRecipeRevisionEntity{}, mongo.ErrNoDocuments)
+
+	result, err := suite.target.Restore(suite.ctx, test.ThirdId, 1)
+
+	suite.Equal(internalErrors.SourdoughRecipeRevisionNotFound(test.ThirdId, 1), err)
+	suite.Empty(result)
+}
+
+func TestNewSourdoughRecipeRevisionService_WithNilRepository(t *testing.T) {
+	service, err := NewSourdoughRecipeRevisionService(nil, nil)
+
+	assert.Nil(t, service)
+	assert.ErrorContains(t, err, "repository cannot be nil")
+}
+
+func TestNewSourdoughRecipeRevisionService_WithNilSourdoughRecipeService(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	service, err := NewSourdoughRecipeRevisionService(mocks.NewMockSourdoughRecipeRevisionRepository(mockCtrl), nil)
+
+	assert.Nil(t, service)
+	assert.ErrorContains(t, err, "sourdoughRecipeService cannot be nil")
+}
+
+func createRevisionEntity(version int) domain.SourdoughRecipeRevisionEntity {
+	return domain.SourdoughRecipeRevisionEntity{
+		Id:       uuid.New(),
+		RecipeId: test.ThirdId,
+		Version:  version,
+		Recipe: domain.SourdoughRecipeEntity{
+			RecipeEntity: domain.RecipeEntity{
+				Id:   test.ThirdId,
+				Name: "test recipe",
+				Flour: []domain.FlourAmount{
+					{FlourEntity: domain.FlourEntity{Id: test.FirstId, Name: "bread flour"}, Amount: 900},
+					{FlourEntity: domain.FlourEntity{Id: test.SecondId, Name: "whole wheat flour"}, Amount: 100},
+				},
+				Water: []domain.BakerAmount{
+					{Name: "Water", Amount: 700, BakerPercentage: 70},
+				},
+				AdditionalIngredients: []domain.BakerAmount{
+					{Name: "Salt", Amount: 20, BakerPercentage: 2},
+				},
+				Details: domain.RecipeDetails{
+					Flour:                 domain.BakerAmount{Amount: 1000, BakerPercentage: 100},
+					Water:                 domain.BakerAmount{Amount: 700, BakerPercentage: 70},
+					Levain:                domain.BakerAmount{Amount: 200, BakerPercentage: 20},
+					AdditionalIngredients: domain.BakerAmount{Amount: 20, BakerPercentage: 2},
+					TotalWeight:           1920,
+				},
+				CreatedAt: test.Date,
+				Version:   version,
+			},
+			Levain: domain.SourdoughLevainAgent{
+				Amount:  domain.BakerAmount{Amount: 200, BakerPercentage: 20},
+				Starter: domain.BakerAmount{Amount: 20},
+				Flour: []domain.FlourAmount{
+					{FlourEntity: domain.FlourEntity{Id: test.FirstId, Name: "bread flour"}, Amount: 90},
+				},
+				Water: domain.BakerAmount{Amount: 90},
+			},
+		},
+		CreatedAt: test.Date,
+	}
+}
@@ -200,7 +200,8 @@ func (suite *SourdoughRecipeScaleServiceTestSuite) TestScale_WithScaleExists() {
 	})
 
 	suite.sourdoughRecipeScaleService.EXPECT().FindById(suite.ctx, dto.Id).
-		Return(dto, nil)
+		Return(dto, nil).
+		Times(2)
 
 	scaledDto, err := suite.target.Scale(suite.ctx, dto.Id, domain.SourdoughRecipeScaleRequestDto{FinalDoughWeight: 985})
 
@@ -348,6 +349,40 @@ func (suite *SourdoughRecipeScaleServiceTestSuite) TestScale_WithScaleExists() {
 	suite.Equal(expectedDto, newScaledDto)
 }
 
+func (suite *SourdoughRecipeScaleServiceTestSuite) TestScale_WithNewVersion_ShouldNotUseCachedScale() {
+	dto := createValidDTO(domain.SourdoughRecipeDto{
+		RecipeDto: domain.RecipeDto{
+			Id:      uuid.New(),
+			Version: 1,
+		},
+	})
+
+	updatedDto := createValidDTO(domain.SourdoughRecipeDto{
+		RecipeDto: domain.RecipeDto{
+			Id:      dto.Id,
+			Version: 2,
+		},
+	})
+	updatedDto.Name = "updated test recipe"
+
+	gomock.InOrder(
+		suite.sourdoughRecipeScaleService.EXPECT().FindById(suite.ctx, dto.Id).Return(dto, nil),
+		suite.sourdoughRecipeScaleService.EXPECT().FindById(suite.ctx, dto.Id).Return(updatedDto, nil),
+	)
+
+	scaledDto, err := suite.target.Scale(suite.ctx, dto.Id, domain.SourdoughRecipeScaleRequestDto{FinalDoughWeight: 985})
+
+	suite.NoError(err)
+	suite.Equal("test recipe", scaledDto.Name)
+	suite.Equal(1, scaledDto.Version)
+
+	newScaledDto, err := suite.target.Scale(suite.ctx, dto.Id, domain.SourdoughRecipeScaleRequestDto{FinalDoughWeight: 985})
+
+	suite.NoError(err)
+	suite.Equal("updated test recipe", newScaledDto.Name)
+	suite.Equal(2, newScaledDto.Version)
+}
+
 func (suite *SourdoughRecipeScaleServiceTestSuite) TestScale_WithErrorOnFind() {
 	suite.sourdoughRecipeScaleService.EXPECT().FindById(suite.ctx, gomock.Any()).
 		Return(domain.SourdoughRecipeDto{}, assert.AnError)
@@ -359,8 +394,6 @@ func (suite *SourdoughRecipeScaleServiceTestSuite) TestScale_WithErrorOnFind() {
 }
 
 func (suite *SourdoughRecipeScaleServiceTestSuite) TestScaleLevain() {
-	service := suite.target.(*sourdoughRecipeScaleService)
-
 	flour1 := domain.FlourDto{
 		Id:             uuid.New(),
 		FlourType:      "flour 1",
@@ -375,7 +408,7 @@ func (suite *SourdoughRecipeScaleServiceTestSuite) TestScaleLevain() {
 		Description:    "flour 2",
 		NutritionFacts: domain.NutritionFactsDto{},
 	}
-	scaledLevain := service.scaleLevain(1500, domain.SourdoughLevainAgentDto{
+	scaledLevain := scaleLevain(1500, domain.SourdoughLevainAgentDto{
 		Starter: domain.BakerAmountDto{
 			Amount:          20,
 			BakerPercentage: 2,
@@ -423,9 +456,7 @@ func (suite *SourdoughRecipeScaleServiceTestSuite) TestScaleLevain() {
 }
 
 func (suite *SourdoughRecipeScaleServiceTestSuite) TestScaleRecipeDetails() {
-	service := suite.target.(*sourdoughRecipeScaleService)
-
-	scaledRecipeDetails := service.scaleRecipeDetails(2000, domain.RecipeDetailsDto{
+	scaledRecipeDetails := scaleRecipeDetails(2000, domain.RecipeDetailsDto{
 		Flour: domain.BakerAmountDto{
 			Amount:          1000,
 			BakerPercentage: 100,
@@ -475,11 +506,10 @@ func (suite *SourdoughRecipeScaleServiceTestSuite) TestScaleRecipeDetails() {
 }
 
 func (suite *SourdoughRecipeScaleServiceTestSuite) TestScaleFlourAmounts() {
-	service := suite.target.(*sourdoughRecipeScaleService)
 	flourEntity1 := domain.FlourDto{Id: uuid.New()}
 	flourEntity2 := domain.FlourDto{Id: uuid.New()}
 
-	amount := service.scaleFlourAmounts(2000, []domain.FlourAmountDto{
+	amount := scaleFlourAmounts(2000, []domain.FlourAmountDto{
 		{
 			FlourDto: flourEntity1,
 			Amount:   1000,
@@ -503,10 +533,9 @@ func (suite *SourdoughRecipeScaleServiceTestSuite) TestScaleFlourAmounts() {
 }
 
 func (suite *SourdoughRecipeScaleServiceTestSuite) TestScaleFlourAmount() {
-	service := suite.target.(*sourdoughRecipeScaleService)
 	flourEntity := domain.FlourDto{Id: uuid.New()}
 
-	amount := service.scaleFlourAmount(2000, domain.FlourAmountDto{
+	amount := scaleFlourAmount(2000, domain.FlourAmountDto{
 		FlourDto: flourEntity,
 		Amount:   1000,
 	}, 1000)
@@ -518,9 +547,7 @@ func (suite *SourdoughRecipeScaleServiceTestSuite) TestScaleFlourAmount() {
 }
 
 func (suite *SourdoughRecipeScaleServiceTestSuite) TestScaleBakerAmounts() {
-	service := suite.target.(*sourdoughRecipeScaleService)
-
-	amount := service.scaleBakerAmounts(2000, []domain.BakerAmountDto{
+	amount := scaleBakerAmounts(2000, []domain.BakerAmountDto{
 		{
 			Amount:          1000,
 			BakerPercentage: 50,
@@ -548,9 +575,7 @@ func (suite *SourdoughRecipeScaleServiceTestSuite) TestScaleBakerAmounts() {
 }
 
 func (suite *SourdoughRecipeScaleServiceTestSuite) TestScaleBakerAmount() {
-	service := suite.target.(*sourdoughRecipeScaleService)
-
-	amount := service.scaleBakerAmount(2000, domain.BakerAmountDto{
+	amount := scaleBakerAmount(2000, domain.BakerAmountDto{
 		Amount:          1000,
 		BakerPercentage: 50,
 		Name:            "test",
@@ -564,9 +589,7 @@ func (suite *SourdoughRecipeScaleServiceTestSuite) TestScaleBakerAmount() {
 }
 
 func (suite *SourdoughRecipeScaleServiceTestSuite) TestScaleAmount() {
-	service := suite.target.(*sourdoughRecipeScaleService)
-
-	amount := service.scaleAmount(2000, 1000, 1000)
+	amount := scaleAmount(2000, 1000, 1000)
 
 	suite.Equal(float64(500), amount)
 }
@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"dough-calculator/internal/domain"
+	internalErrors "dough-calculator/internal/errors"
+)
+
+const (
+	substitutionTargetAll    = "all"
+	substitutionTargetDough  = "dough"
+	substitutionTargetLevain = "levain"
+)
+
+type sourdoughRecipeSubstitutionService struct {
+	flourRepository        domain.FlourRepository
+	sourdoughRecipeService domain.SourdoughRecipeService
+}
+
+// Substitute replaces the flour in the main dough and/or the levain with a
+// flour of the catalogue, see substituteRecipeFlour. The result is only a
+// preview unless the request asks to apply it.
+func (service *sourdoughRecipeSubstitutionService) Substitute(
+	ctx context.Context,
+	id uuid.UUID,
+	request domain.SourdoughRecipeSubstitutionRequest,
+) (domain.SourdoughRecipeSubstitutionDto, error) {
+	percentage, target, err := service.validate(request)
+	if err != nil {
+		return domain.SourdoughRecipeSubstitutionDto{}, err
+	}
+
+	recipe, err := service.sourdoughRecipeService.FindById(ctx, id)
+	if err != nil {
+		return domain.SourdoughRecipeSubstitutionDto{}, err
+	}
+
+	fromFlour, toFlour, err := substitutionFlours(ctx, service.flourRepository, recipe, request.FromFlourId, request.ToFlourId,
+		target, internalErrors.SourdoughRecipeSubstitutionFlourNotFound)
+	if err != nil {
+		return domain.SourdoughRecipeSubstitutionDto{}, err
+	}
+
+	result, err := substituteRecipeFlour(recipe, fromFlour, toFlour, percentage, target,
+		internalErrors.SourdoughRecipeSubstitutionInvalid)
+	if err != nil {
+		return domain.SourdoughRecipeSubstitutionDto{}, err
+	}
+
+	createRequest := result.Recipe.ToCreateRequest()
+	result.Recipe.Details = calculateSourdoughRecipeDetails(createRequest)
+
+	if request.Apply {
+		result.Recipe, err = service.sourdoughRecipeService.Update(ctx, recipe.Id, createRequest)
+		if err != nil {
+			return domain.SourdoughRecipeSubstitutionDto{}, err
+		}
+		result.Applied = true
+	}
+
+	return result, nil
+}
+
+func (service *sourdoughRecipeSubstitutionService) validate(request domain.SourdoughRecipeSubstitutionRequest) (float64, string, error) {
+	percentage := 100.0
+	if request.Percentage != nil {
+		percentage = *request.Percentage
+	}
+	if percentage <= 0 || percentage > 100 {
+		return 0, "", internalErrors.SourdoughRecipeSubstitutionInvalid(
+			fmt.Sprintf("percentage %.2f must be greater than 0 and at most 100", percentage))
+	}
+
+	target := strings.ToLower(strings.TrimSpace(request.Target))
+	if target == "" {
+		target = substitutionTargetAll
+	}
+	if target != substitutionTargetAll && target != substitutionTargetDough && target != substitutionTargetLevain {
+		return 0, "", internalErrors.SourdoughRecipeSubstitutionInvalid(
+			fmt.Sprintf("target %s must be one of all, dough, levain", target))
+	}
+
+	if request.FromFlourId == request.ToFlourId {
+		return 0, "", internalErrors.SourdoughRecipeSubstitutionInvalid(
+			fmt.Sprintf("flour %s cannot be substituted with itself", request.FromFlourId.String()))
+	}
+
+	return percentage, target, nil
+}
+
+// recipeFlour returns the flour stored with the recipe in the parts selected
+// by target.
+func recipeFlour(
+	recipe domain.SourdoughRecipeDto,
+	flourId uuid.UUID,
+	target string,
+) (domain.FlourDto, bool) {
+	var flour []domain.FlourAmountDto
+	if target != substitutionTargetLevain {
+		flour = append(flour, recipe.Flour...)
+	}
+	if target != substitutionTargetDough {
+		flour = append(flour, recipe.Levain.Flour...)
+	}
+
+	index := slices.IndexFunc(flour, func(amount domain.FlourAmountDto) bool {
+		return amount.Id == flourId && amount.Amount > 0
+	})
+	if index < 0 {
+		return domain.FlourDto{}, false
+	}
+
+	return flour[index].FlourDto, true
+}
+
+// substitutionFlours resolves the flours of a substitution in the parts of
+// the recipe selected by target. The flour to replace has to be in the
+// recipe, fromNotFound reports it otherwise, and is taken from the catalogue
+// while it is still there. The flour replacing it has to be in the catalogue.
+func substitutionFlours(
+	ctx context.Context,
+	flourRepository domain.FlourRepository,
+	recipe domain.SourdoughRecipeDto,
+	fromFlourId, toFlourId uuid.UUID,
+	target string,
+	fromNotFound func(flourId uuid.UUID) error,
+) (domain.FlourDto, domain.FlourDto, error) {
+	fromFlour, ok := recipeFlour(recipe, fromFlourId, target)
+	if !ok {
+		return domain.FlourDto{}, domain.FlourDto{}, fromNotFound(fromFlourId)
+	}
+
+	toFlour, err := findFlour(ctx, flourRepository, toFlourId)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return domain.FlourDto{}, domain.FlourDto{}, internalErrors.FlourByIdNotFound(toFlourId)
+	}
+	if err != nil {
+		return domain.FlourDto{}, domain.FlourDto{}, err
+	}
+
+	catalogueFromFlour, err := findFlour(ctx, flourRepository, fromFlourId)
+	if err == nil {
+		fromFlour = catalogueFromFlour
+	} else if !errors.Is(err, mongo.ErrNoDocuments) {
+		return domain.FlourDto{}, domain.FlourDto{}, err
+	}
+
+	return fromFlour, toFlour, nil
+}
+
+func findFlour(ctx context.Context, flourRepository domain.FlourRepository, id uuid.UUID) (domain.FlourDto, error) {
+	flour, err := flourRepository.FindById(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return domain.FlourDto{}, err
+		}
+
+		log.Err(err).
+			Str("id", id.String()).
+			Msg("failed to find flour")
+
+		return domain.FlourDto{}, internalErrors.NewInternalServerErrorWrap(err, "failed to substitute flour")
+	}
+
+	return flour.ToDto(), nil
+}
+
+// substituteRecipeFlour moves percentage percent of fromFlour to toFlour in
+// the parts of the recipe selected by target. The main dough water is
+// rebalanced by the absorption difference of the moved weight, the levain
+// water is left as it is so the levain keeps its consistency. A substitution
+// that cannot be made fails with the error built by invalid. The details of
+// the returned recipe are not recalculated.
+func substituteRecipeFlour(
+	recipe domain.SourdoughRecipeDto,
+	fromFlour domain.FlourDto,
+	toFlour domain.FlourDto,
+	percentage float64,
+	target string,
+	invalid func(details string) error,
+) (domain.SourdoughRecipeSubstitutionDto, error) {
+	var substitutedAmount, amount float64
+	if target != substitutionTargetLevain {
+		recipe.Flour, amount = substituteFlourAmount(recipe.Flour, fromFlour.Id, toFlour, percentage)
+		substitutedAmount += amount
+	}
+	if target != substitutionTargetDough {
+		recipe.Levain.Flour, amount = substituteFlourAmount(recipe.Levain.Flour, fromFlour.Id, toFlour, percentage)
+		substitutedAmount += amount
+	}
+
+	fromAbsorption, _ := flourAbsorption(fromFlour)
+	toAbsorption, _ := flourAbsorption(toFlour)
+	waterAdjustment := substitutedAmount * (toAbsorption - fromAbsorption) / 100
+
+	var err error
+	recipe.Water, err = rebalanceWater(recipe, waterAdjustment, invalid)
+	if err != nil {
+		return domain.SourdoughRecipeSubstitutionDto{}, err
+	}
+
+	return domain.SourdoughRecipeSubstitutionDto{
+		Recipe:            recipe,
+		SubstitutedAmount: substitutedAmount,
+		FromAbsorption:    fromAbsorption,
+		ToAbsorption:      toAbsorption,
+		WaterAdjustment:   waterAdjustment,
+	}, nil
+}
+
+// rebalanceWater scales the main dough water by the adjustment, keeping the
+// ratio between the water amounts.
+func rebalanceWater(
+	recipe domain.SourdoughRecipeDto,
+	adjustment float64,
+	invalid func(details string) error,
+) ([]domain.BakerAmountDto, error) {
+	if adjustment == 0 {
+		return recipe.Water, nil
+	}
+
+	var flourAmount, waterAmount float64
+	for _, amount := range recipe.Flour {
+		flourAmount += amount.Amount
+	}
+	for _, amount := range recipe.Water {
+		waterAmount += amount.Amount
+	}
+
+	if flourAmount == 0 {
+		return nil, invalid("recipe has no dough flour to rebalance the water for")
+	}
+	if waterAmount+adjustment < 0 {
+		return nil, invalid(
+			fmt.Sprintf("cannot remove %.2f of water, the dough has only %.2f", -adjustment, waterAmount))
+	}
+
+	return scaleWaterToHydration(flourAmount, recipe.Water, (waterAmount+adjustment)/flourAmount*100), nil
+}
+
+// substituteFlourAmount moves percentage percent of the flour fromFlourId to
+// toFlour and returns the moved weight. A flour that is not in the list yet
+// takes the place right after the substituted one.
+func substituteFlourAmount(
+	flour []domain.FlourAmountDto,
+	fromFlourId uuid.UUID,
+	toFlour domain.FlourDto,
+	percentage float64,
+) ([]domain.FlourAmountDto, float64) {
+	fromIndex := slices.IndexFunc(flour, func(amount domain.FlourAmountDto) bool {
+		return amount.Id == fromFlourId
+	})
+	if fromIndex < 0 {
+		return flour, 0
+	}
+
+	result := append([]domain.FlourAmountDto{}, flour...)
+	amount := result[fromIndex].Amount * percentage / 100
+	result[fromIndex].Amount -= amount
+
+	toIndex := slices.IndexFunc(result, func(amount domain.FlourAmountDto) bool {
+		return amount.Id == toFlour.Id
+	})
+	if toIndex < 0 {
+		toIndex = fromIndex + 1
+		result = slices.Insert(result, toIndex, domain.FlourAmountDto{FlourDto: toFlour})
+	}
+	result[toIndex].Amount += amount
+
+	return slices.DeleteFunc(result, func(amount domain.FlourAmountDto) bool {
+		return amount.Amount == 0
+	}), amount
+}
+
+func NewSourdoughRecipeSubstitutionService(
+	flourRepository domain.FlourRepository,
+	sourdoughRecipeService domain.SourdoughRecipeService,
+) (domain.SourdoughRecipeSubstitutionService, error) {
+	if flourRepository == nil {
+		return nil, errors.New("flourRepository cannot be nil")
+	}
+
+	if sourdoughRecipeService == nil {
+		return nil, errors.New("sourdoughRecipeService cannot be nil")
+	}
+
+	return &sourdoughRecipeSubstitutionService{
+		flourRepository:        flourRepository,
+		sourdoughRecipeService: sourdoughRecipeService,
+	}, nil
+}
@@ -0,0 +1,325 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/mock/gomock"
+
+	"dough-calculator/internal/domain"
+	"dough-calculator/internal/domain/mocks"
+	internalErrors "dough-calculator/internal/errors"
+	"dough-calculator/internal/test"
+)
+
+func TestSourdoughRecipeSubstitutionServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SourdoughRecipeSubstitutionServiceTestSuite))
+}
+
+type SourdoughRecipeSubstitutionServiceTestSuite struct {
+	test.GoMockTestSuite
+
+	ctx                    context.Context
+	flourRepository        *mocks.MockFlourRepository
+	sourdoughRecipeService *mocks.MockSourdoughRecipeService
+
+	recipeId   uuid.UUID
+	breadFlour domain.FlourEntity
+	wholeWheat domain.FlourEntity
+	spelt      domain.FlourEntity
+
+	target domain.SourdoughRecipeSubstitutionService
+}
+
+func (suite *SourdoughRecipeSubstitutionServiceTestSuite) SetupTest() {
+	suite.GoMockTestSuite.SetupTest()
+
+	suite.ctx = context.Background()
+	suite.flourRepository = mocks.NewMockFlourRepository(suite.MockCtrl)
+	suite.sourdoughRecipeService = mocks.NewMockSourdoughRecipeService(suite.MockCtrl)
+
+	suite.recipeId = uuid.MustParse("0b9c1f4e-6f64-4f5e-9f3a-3b8f2f1b6d21")
+	suite.breadFlour = domain.FlourEntity{Id: test.FirstId, Name: "Bread flour", FlourType: "bread", SuggestedAbsorption: 65}
+	suite.wholeWheat = domain.FlourEntity{Id: test.SecondId, Name: "Whole wheat flour", FlourType: "whole_wheat", SuggestedAbsorption: 75}
+	suite.spelt = domain.FlourEntity{Id: test.ThirdId, Name: "Spelt flour", FlourType: "whole_wheat"}
+
+	suite.target = test.Must(func() (domain.SourdoughRecipeSubstitutionService, error) {
+		return NewSourdoughRecipeSubstitutionService(suite.flourRepository, suite.sourdoughRecipeService)
+	})
+}
+
+func (suite *SourdoughRecipeSubstitutionServiceTestSuite) TestSubstitute() {
+	recipe := suite.createRecipe()
+
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, suite.recipeId).Return(recipe, nil)
+	suite.flourRepository.EXPECT().FindById(suite.ctx, suite.spelt.Id).Return(suite.spelt, nil)
+	suite.flourRepository.EXPECT().FindById(suite.ctx, suite.breadFlour.Id).Return(suite.breadFlour, nil)
+
+	actual, err := suite.target.Substitute(suite.ctx, suite.recipeId, domain.SourdoughRecipeSubstitutionRequest{
+		FromFlourId: suite.breadFlour.Id,
+		ToFlourId:   suite.spelt.Id,
+	})
+
+	suite.NoError(err)
+	suite.Equal(700.0, actual.SubstitutedAmount)
+	suite.Equal(65.0, actual.FromAbsorption)
+	suite.Equal(75.0, actual.ToAbsorption)
+	suite.InDelta(70, actual.WaterAdjustment, 0.001)
+	suite.False(actual.Applied)
+
+	suite.Equal([]domain.FlourAmountDto{
+		{FlourDto: suite.spelt.ToDto(), Amount: 600},
+		{FlourDto: suite.wholeWheat.ToDto(), Amount: 400},
+	}, actual.Recipe.Flour)
+	suite.Equal([]domain.FlourAmountDto{{FlourDto: suite.spelt.ToDto(), Amount: 100}}, actual.Recipe.Levain.Flour)
+
+	suite.Require().Len(actual.Recipe.Water, 2)
+	suite.Equal("Water", actual.Recipe.Water[0].Name)
+	suite.InDelta(616, actual.Recipe.Water[0].Amount, 0.001)
+	suite.InDelta(61.6, actual.Recipe.Water[0].BakerPercentage, 0.001)
+	suite.InDelta(154, actual.Recipe.Water[1].Amount, 0.001)
+
+	suite.Equal(domain.BakerAmountDto{Amount: 1000, BakerPercentage: 100}, actual.Recipe.Details.Flour)
+	suite.InDelta(770, actual.Recipe.Details.Water.Amount, 0.001)
+	suite.InDelta(77, actual.Recipe.Details.Water.BakerPercentage, 0.001)
+	suite.Equal(recipe.Details.Levain, actual.Recipe.Details.Levain)
+	suite.Equal(recipe.Details.AdditionalIngredients, actual.Recipe.Details.AdditionalIngredients)
+	suite.Equal(1990, actual.Recipe.Details.TotalWeight)
+}
+
+func (suite *SourdoughRecipeSubstitutionServiceTestSuite) TestSubstitute_PartiallyInDough() {
+	recipe := suite.createRecipe()
+	percentage := 50.0
+
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, suite.recipeId).Return(recipe, nil)
+	suite.flourRepository.EXPECT().FindById(suite.ctx, suite.wholeWheat.Id).Return(suite.wholeWheat, nil)
+	suite.flourRepository.EXPECT().FindById(suite.ctx, suite.breadFlour.Id).Return(suite.breadFlour, nil)
+
+	actual, err := suite.target.Substitute(suite.ctx, suite.recipeId, domain.SourdoughRecipeSubstitutionRequest{
+		FromFlourId: suite.breadFlour.Id,
+		ToFlourId:   suite.wholeWheat.Id,
+		Percentage:  &percentage,
+		Target:      "Dough",
+	})
+
+	suite.NoError(err)
+	suite.Equal(300.0, actual.SubstitutedAmount)
+	suite.InDelta(30, actual.WaterAdjustment, 0.001)
+	suite.Equal([]domain.FlourAmountDto{
+		{FlourDto: recipe.Flour[0].FlourDto, Amount: 300},
+		{FlourDto: suite.wholeWheat.ToDto(), Amount: 700},
+	}, actual.Recipe.Flour)
+	suite.Equal(recipe.Levain, actual.Recipe.Levain)
+	suite.InDelta(730, actual.Recipe.Details.Water.Amount, 0.001)
+	suite.Equal(1950, actual.Recipe.Details.TotalWeight)
+}
+
+func (suite *SourdoughRecipeSubstitutionServiceTestSuite) TestSubstitute_WithFlourMissingFromCatalogue() {
+	recipe := suite.createRecipe()
+	recipe.Flour[1].FlourType = "rye"
+	recipe.Flour[1].SuggestedAbsorption = 0
+
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, suite.recipeId).Return(recipe, nil)
+	suite.flourRepository.EXPECT().FindById(suite.ctx, suite.breadFlour.Id).Return(suite.breadFlour, nil)
+	suite.flourRepository.EXPECT().FindById(suite.ctx, suite.wholeWheat.Id).
+		Return(domain.FlourEntity{}, mongo.ErrNoDocuments)
+
+	actual, err := suite.target.Substitute(suite.ctx, suite.recipeId, domain.SourdoughRecipeSubstitutionRequest{
+		FromFlourId: suite.wholeWheat.Id,
+		ToFlourId:   suite.breadFlour.Id,
+		Target:      "dough",
+	})
+
+	suite.NoError(err)
+	suite.Equal(80.0, actual.FromAbsorption)
+	suite.InDelta(-60, actual.WaterAdjustment, 0.001)
+	suite.Equal([]domain.FlourAmountDto{{FlourDto: recipe.Flour[0].FlourDto, Amount: 1000}}, actual.Recipe.Flour)
+	suite.InDelta(640, actual.Recipe.Details.Water.Amount, 0.001)
+}
+
+func (suite *SourdoughRecipeSubstitutionServiceTestSuite) TestSubstitute_WithApply() {
+	recipe := suite.createRecipe()
+	updated := recipe
+	updated.Version = 2
+
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, suite.recipeId).Return(recipe, nil)
+	suite.flourRepository.EXPECT().FindById(suite.ctx, suite.spelt.Id).Return(suite.spelt, nil)
+	suite.flourRepository.EXPECT().FindById(suite.ctx, suite.breadFlour.Id).Return(suite.breadFlour, nil)
+	suite.sourdoughRecipeService.EXPECT().Update(suite.ctx, suite.recipeId, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, request domain.CreateSourdoughRecipeRequest) (domain.SourdoughRecipeDto, error) {
+			suite.Equal(recipe.Name, request.Name)
+			suite.Equal(suite.spelt.Id, request.Levain.Flour[0].Id)
+			suite.InDelta(568, request.Water[0].Amount, 0.001)
+			return updated, nil
+		})
+
+	actual, err := suite.target.Substitute(suite.ctx, suite.recipeId, domain.SourdoughRecipeSubstitutionRequest{
+		FromFlourId: suite.breadFlour.Id,
+		ToFlourId:   suite.spelt.Id,
+		Target:      "levain",
+		Apply:       true,
+	})
+
+	suite.NoError(err)
+	suite.True(actual.Applied)
+	suite.Equal(updated, actual.Recipe)
+	suite.Equal(100.0, actual.SubstitutedAmount)
+}
+
+func (suite *SourdoughRecipeSubstitutionServiceTestSuite) TestSubstitute_WithInvalidRequest() {
+	zero, tooMuch := 0.0, 120.0
+
+	tests := []struct {
+		name          string
+		request       domain.SourdoughRecipeSubstitutionRequest
+		expectedError error
+	}{
+		{
+			name:          "zero percentage",
+			request:       domain.SourdoughRecipeSubstitutionRequest{FromFlourId: test.FirstId, ToFlourId: test.SecondId, Percentage: &zero},
+			expectedError: internalErrors.SourdoughRecipeSubstitutionInvalid("percentage 0.00 must be greater than 0 and at most 100"),
+		},
+		{
+			name:          "percentage above 100",
+			request:       domain.SourdoughRecipeSubstitutionRequest{FromFlourId: test.FirstId, ToFlourId: test.SecondId, Percentage: &tooMuch},
+			expectedError: internalErrors.SourdoughRecipeSubstitutionInvalid("percentage 120.00 must be greater than 0 and at most 100"),
+		},
+		{
+			name:          "unknown target",
+			request:       domain.SourdoughRecipeSubstitutionRequest{FromFlourId: test.FirstId, ToFlourId: test.SecondId, Target: "starter"},
+			expectedError: internalErrors.SourdoughRecipeSubstitutionInvalid("target starter must be one of all, dough, levain"),
+		},
+		{
+			name:    "same flour",
+			request: domain.SourdoughRecipeSubstitutionRequest{FromFlourId: test.FirstId, ToFlourId: test.FirstId},
+			expectedError: internalErrors.SourdoughRecipeSubstitutionInvalid(
+				"flour 74c65c5a-44e7-44a2-8cdd-cd7c49cfcb42 cannot be substituted with itself"),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			_, err := suite.target.Substitute(suite.ctx, suite.recipeId, tt.request)
+
+			suite.Equal(tt.expectedError, err)
+		})
+	}
+}
+
+func (suite *SourdoughRecipeSubstitutionServiceTestSuite) TestSubstitute_WithFlourNotInRecipe() {
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, suite.recipeId).Return(suite.createRecipe(), nil)
+
+	_, err := suite.target.Substitute(suite.ctx, suite.recipeId, domain.SourdoughRecipeSubstitutionRequest{
+		FromFlourId: suite.wholeWheat.Id,
+		ToFlourId:   suite.spelt.Id,
+		Target:      "levain",
+	})
+
+	suite.Equal(internalErrors.SourdoughRecipeSubstitutionFlourNotFound(suite.wholeWheat.Id), err)
+}
+
+func (suite *SourdoughRecipeSubstitutionServiceTestSuite) TestSubstitute_WithReplacementNotFound() {
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, suite.recipeId).Return(suite.createRecipe(), nil)
+	suite.flourRepository.EXPECT().FindById(suite.ctx, suite.spelt.Id).
+		Return(domain.FlourEntity{}, mongo.ErrNoDocuments)
+
+	_, err := suite.target.Substitute(suite.ctx, suite.recipeId, domain.SourdoughRecipeSubstitutionRequest{
+		FromFlourId: suite.breadFlour.Id,
+		ToFlourId:   suite.spelt.Id,
+	})
+
+	suite.Equal(internalErrors.FlourByIdNotFound(suite.spelt.Id), err)
+}
+
+func (suite *SourdoughRecipeSubstitutionServiceTestSuite) TestSubstitute_WithErrorOnFindFlour() {
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, suite.recipeId).Return(suite.createRecipe(), nil)
+	suite.flourRepository.EXPECT().FindById(suite.ctx, suite.spelt.Id).Return(suite.spelt, nil)
+	suite.flourRepository.EXPECT().FindById(suite.ctx, suite.breadFlour.Id).
+		Return(domain.FlourEntity{}, assert.AnError)
+
+	_, err := suite.target.Substitute(suite.ctx, suite.recipeId, domain.SourdoughRecipeSubstitutionRequest{
+		FromFlourId: suite.breadFlour.Id,
+		ToFlourId:   suite.spelt.Id,
+	})
+
+	suite.ErrorContains(err, "failed to substitute flour")
+}
+
+func (suite *SourdoughRecipeSubstitutionServiceTestSuite) TestSubstitute_WithErrorOnFindRecipe() {
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, suite.recipeId).
+		Return(domain.SourdoughRecipeDto{}, assert.AnError)
+
+	_, err := suite.target.Substitute(suite.ctx, suite.recipeId, domain.SourdoughRecipeSubstitutionRequest{
+		FromFlourId: suite.breadFlour.Id,
+		ToFlourId:   suite.spelt.Id,
+	})
+
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func (suite *SourdoughRecipeSubstitutionServiceTestSuite) TestSubstitute_WithTooLittleWater() {
+	recipe := suite.createRecipe()
+	recipe.Water = []domain.BakerAmountDto{{Name: "Water", Amount: 10, BakerPercentage: 1}}
+	wholeWheat := suite.wholeWheat
+	wholeWheat.SuggestedAbsorption = 90
+
+	suite.sourdoughRecipeService.EXPECT().FindById(suite.ctx, suite.recipeId).Return(recipe, nil)
+	suite.flourRepository.EXPECT().FindById(suite.ctx, suite.breadFlour.Id).Return(suite.breadFlour, nil)
+	suite.flourRepository.EXPECT().FindById(suite.ctx, wholeWheat.Id).Return(wholeWheat, nil)
+
+	_, err := suite.target.Substitute(suite.ctx, suite.recipeId, domain.SourdoughRecipeSubstitutionRequest{
+		FromFlourId: wholeWheat.Id,
+		ToFlourId:   suite.breadFlour.Id,
+	})
+
+	suite.Equal(internalErrors.SourdoughRecipeSubstitutionInvalid("cannot remove 100.00 of water, the dough has only 10.00"), err)
+}
+
+func (suite *SourdoughRecipeSubstitutionServiceTestSuite) createRecipe() domain.SourdoughRecipeDto {
+	breadFlour := suite.breadFlour.ToDto()
+	breadFlour.SuggestedAbsorption = 0
+
+	return domain.SourdoughRecipeDto{
+		RecipeDto: domain.RecipeDto{
+			Id:   suite.recipeId,
+			Name: "Country loaf",
+			Flour: []domain.FlourAmountDto{
+				{FlourDto: breadFlour, Amount: 600},
+				{FlourDto: suite.wholeWheat.ToDto(), Amount: 400},
+			},
+			Water: []domain.BakerAmountDto{
+				{Name: "Water", Amount: 560, BakerPercentage: 56},
+				{Name: "Bassinage", Amount: 140, BakerPercentage: 14},
+			},
+			AdditionalIngredients: []domain.BakerAmountDto{{Name: "Salt", Amount: 20, BakerPercentage: 2}},
+			Details: domain.RecipeDetailsDto{
+				Flour:                 domain.BakerAmountDto{Amount: 1000, BakerPercentage: 100},
+				Water:                 domain.BakerAmountDto{Amount: 700, BakerPercentage: 70},
+				Levain:                domain.BakerAmountDto{Amount: 200, BakerPercentage: 20},
+				AdditionalIngredients: domain.BakerAmountDto{Amount: 20, BakerPercentage: 2},
+				TotalWeight:           1920,
+			},
+			Version: 1,
+		},
+		Levain: domain.SourdoughLevainAgentDto{
+			Amount:  domain.BakerAmountDto{Amount: 200, BakerPercentage: 20},
+			Starter: domain.BakerAmountDto{Amount: 20},
+			Flour:   []domain.FlourAmountDto{{FlourDto: breadFlour, Amount: 100}},
+			Water:   domain.BakerAmountDto{Amount: 80},
+		},
+	}
+}
+
+func TestNewSourdoughRecipeSubstitutionService_WithNilDependencies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	_, err := NewSourdoughRecipeSubstitutionService(nil, mocks.NewMockSourdoughRecipeService(ctrl))
+	assert.EqualError(t, err, "flourRepository cannot be nil")
+
+	_, err = NewSourdoughRecipeSubstitutionService(mocks.NewMockFlourRepository(ctrl), nil)
+	assert.EqualError(t, err, "sourdoughRecipeService cannot be nil")
+}
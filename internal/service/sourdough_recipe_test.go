@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -24,8 +25,11 @@ func TestSourdoughRecipeServiceTestSuite(t *testing.T) {
 type SourdoughRecipeServiceTestSuite struct {
 	test.GoMockTestSuite
 
-	ctx        context.Context
-	repository *mocks.MockSourdoughRecipeRepository
+	ctx                context.Context
+	transactionRunner  *mocks.MockTransactionRunner
+	repository         *mocks.MockSourdoughRecipeRepository
+	revisionRepository *mocks.MockSourdoughRecipeRevisionRepository
+	flourRepository    *mocks.MockFlourRepository
 
 	target domain.SourdoughRecipeService
 }
@@ -34,31 +38,219 @@ func (suite *SourdoughRecipeServiceTestSuite) SetupTest() {
 	suite.GoMockTestSuite.SetupTest()
 
 	suite.ctx = context.Background()
+	suite.transactionRunner = mocks.NewMockTransactionRunner(suite.MockCtrl)
 	suite.repository = mocks.NewMockSourdoughRecipeRepository(suite.MockCtrl)
+	suite.revisionRepository = mocks.NewMockSourdoughRecipeRevisionRepository(suite.MockCtrl)
+	suite.flourRepository = mocks.NewMockFlourRepository(suite.MockCtrl)
 
 	suite.target = test.Must(func() (domain.SourdoughRecipeService, error) {
-		return NewSourdoughRecipeService(suite.repository)
+		return NewSourdoughRecipeService(suite.transactionRunner, suite.repository, suite.revisionRepository, suite.flourRepository)
 	})
 }
 
+// expectTransaction runs the transaction with the context of the test.
+func (suite *SourdoughRecipeServiceTestSuite) expectTransaction() {
+	suite.transactionRunner.EXPECT().WithTransaction(suite.ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+}
+
 func (suite *SourdoughRecipeServiceTestSuite) TestCreate() {
 	createRequest := generateCreateRequest()
 
+	suite.expectTransaction()
 	suite.repository.EXPECT().
 		Create(suite.ctx, gomock.Any()).
 		DoAndReturn(func(ctx context.Context, entity domain.SourdoughRecipeEntity) (domain.SourdoughRecipeEntity, error) {
 			return entity, nil
 		})
+	suite.revisionRepository.EXPECT().
+		Create(suite.ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, revision domain.SourdoughRecipeRevisionEntity) (domain.SourdoughRecipeRevisionEntity, error) {
+			suite.Equal(revision.Recipe.Id, revision.RecipeId)
+			suite.Equal(1, revision.Version)
+			suite.Equal(1, revision.Recipe.Version)
+			return revision, nil
+		})
 
 	dto, err := suite.target.Create(suite.ctx, createRequest)
 
 	suite.NoError(err)
+	suite.Equal(1, dto.Version)
 	suite.Equal(createValidDTO(dto), dto)
 }
 
+func (suite *SourdoughRecipeServiceTestSuite) TestCreate_ShouldNormalizeTagsAndCategory() {
+	createRequest := generateCreateRequest()
+	createRequest.Tags = []string{" Rye ", "rye", "", "Whole  Grain"}
+	createRequest.Category = " Bread"
+
+	suite.expectTransaction()
+	suite.repository.EXPECT().
+		Create(suite.ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, entity domain.SourdoughRecipeEntity) (domain.SourdoughRecipeEntity, error) {
+			return entity, nil
+		})
+	suite.revisionRepository.EXPECT().
+		Create(suite.ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, revision domain.SourdoughRecipeRevisionEntity) (domain.SourdoughRecipeRevisionEntity, error) {
+			return revision, nil
+		})
+
+	dto, err := suite.target.Create(suite.ctx, createRequest)
+
+	suite.NoError(err)
+	suite.Equal([]string{"rye", "whole grain"}, dto.Tags)
+	suite.Equal("bread", dto.Category)
+}
+
+func (suite *SourdoughRecipeServiceTestSuite) TestCreate_WithErrorFromRevisionRepository() {
+	createRequest := generateCreateRequest()
+
+	suite.expectTransaction()
+	suite.repository.EXPECT().
+		Create(suite.ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, entity domain.SourdoughRecipeEntity) (domain.SourdoughRecipeEntity, error) {
+			return entity, nil
+		})
+	suite.revisionRepository.EXPECT().
+		Create(suite.ctx, gomock.Any()).
+		Return(domain.SourdoughRecipeRevisionEntity{}, assert.AnError)
+
+	dto, err := suite.target.Create(suite.ctx, createRequest)
+
+	suite.Equal(internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to create recipe revision"), err)
+	suite.Empty(dto)
+}
+
+func (suite *SourdoughRecipeServiceTestSuite) TestUpdate() {
+	createdAt := test.Date
+	existing := domain.SourdoughRecipeEntity{
+		RecipeEntity: domain.RecipeEntity{
+			Id:        test.ThirdId,
+			Name:      "old name",
+			CreatedAt: createdAt,
+			Version:   3,
+			ParentId:  &test.FirstId,
+			Ancestors: []uuid.UUID{test.FirstId},
+		},
+	}
+
+	suite.repository.EXPECT().
+		GetById(suite.ctx, existing.Id).
+		Return(existing, nil)
+	suite.expectTransaction()
+	suite.repository.EXPECT().
+		Update(suite.ctx, gomock.Any(), existing.Version).
+		DoAndReturn(func(ctx context.Context, entity domain.SourdoughRecipeEntity, _ int) (domain.SourdoughRecipeEntity, error) {
+			return entity, nil
+		})
+	suite.revisionRepository.EXPECT().
+		Create(suite.ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, revision domain.SourdoughRecipeRevisionEntity) (domain.SourdoughRecipeRevisionEntity, error) {
+			suite.Equal(existing.Id, revision.RecipeId)
+			suite.Equal(4, revision.Version)
+			suite.Equal("test recipe", revision.Recipe.Name)
+			return revision, nil
+		})
+
+	dto, err := suite.target.Update(suite.ctx, existing.Id, generateCreateRequest())
+
+	suite.NoError(err)
+	suite.Equal(existing.Id, dto.Id)
+	suite.Equal(createdAt, dto.CreatedAt)
+	suite.NotNil(dto.UpdatedAt)
+	suite.Equal(4, dto.Version)
+	suite.Equal("test recipe", dto.Name)
+	suite.Equal(1970, dto.Details.TotalWeight)
+	suite.Equal(existing.ParentId, dto.ParentId)
+	suite.Equal(existing.Ancestors, dto.Ancestors)
+}
+
+func (suite *SourdoughRecipeServiceTestSuite) TestUpdate_WithError() {
+	existing := domain.SourdoughRecipeEntity{
+		RecipeEntity: domain.RecipeEntity{
+			Id:      test.ThirdId,
+			Version: 1,
+		},
+	}
+
+	tests := []struct {
+		name          string
+		mocks         func()
+		expectedError error
+	}{
+		{
+			name: "recipe not found",
+			mocks: func() {
+				suite.repository.EXPECT().GetById(suite.ctx, existing.Id).
+					Return(domain.SourdoughRecipeEntity{}, mongo.ErrNoDocuments)
+			},
+			expectedError: internalErrors.SourdoughRecipeNotFound(fmt.Sprintf("recipe with id %s not found", existing.Id.String())),
+		},
+		{
+			name: "error on update",
+			mocks: func() {
+				suite.repository.EXPECT().GetById(suite.ctx, existing.Id).Return(existing, nil)
+				suite.expectTransaction()
+				suite.repository.EXPECT().Update(suite.ctx, gomock.Any(), existing.Version).
+					Return(domain.SourdoughRecipeEntity{}, assert.AnError)
+			},
+			expectedError: internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to update recipe"),
+		},
+		{
+			name: "recipe changed concurrently",
+			mocks: func() {
+				suite.repository.EXPECT().GetById(suite.ctx, existing.Id).Return(existing, nil)
+				suite.expectTransaction()
+				suite.repository.EXPECT().Update(suite.ctx, gomock.Any(), existing.Version).
+					Return(domain.SourdoughRecipeEntity{}, mongo.ErrNoDocuments)
+			},
+			expectedError: internalErrors.SourdoughRecipeVersionConflict(existing.Id, existing.Version),
+		},
+		{
+			name: "recipe name taken",
+			mocks: func() {
+				suite.repository.EXPECT().GetById(suite.ctx, existing.Id).Return(existing, nil)
+				suite.expectTransaction()
+				suite.repository.EXPECT().Update(suite.ctx, gomock.Any(), existing.Version).
+					Return(domain.SourdoughRecipeEntity{}, mongo.WriteException{WriteErrors: mongo.WriteErrors{{Code: 11000}}})
+			},
+			expectedError: internalErrors.SourdoughRecipeAlreadyExists("test recipe"),
+		},
+		{
+			name: "error on revision create",
+			mocks: func() {
+				suite.repository.EXPECT().GetById(suite.ctx, existing.Id).Return(existing, nil)
+				suite.expectTransaction()
+				suite.repository.EXPECT().Update(suite.ctx, gomock.Any(), existing.Version).
+					DoAndReturn(func(ctx context.Context, entity domain.SourdoughRecipeEntity, _ int) (domain.SourdoughRecipeEntity, error) {
+						return entity, nil
+					})
+				suite.revisionRepository.EXPECT().Create(suite.ctx, gomock.Any()).
+					Return(domain.SourdoughRecipeRevisionEntity{}, assert.AnError)
+			},
+			expectedError: internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to create recipe revision"),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mocks()
+
+			dto, err := suite.target.Update(suite.ctx, existing.Id, generateCreateRequest())
+
+			suite.Equal(tt.expectedError, err)
+			suite.Empty(dto)
+		})
+	}
+}
+
 func (suite *SourdoughRecipeServiceTestSuite) TestCreate_WithErrorFromRepository() {
 	createRequest := generateCreateRequest()
 
+	suite.expectTransaction()
 	suite.repository.EXPECT().
 		Create(suite.ctx, gomock.Any()).
 		Return(domain.SourdoughRecipeEntity{}, assert.AnError)
@@ -70,6 +262,20 @@ func (suite *SourdoughRecipeServiceTestSuite) TestCreate_WithErrorFromRepository
 	suite.Empty(dto)
 }
 
+func (suite *SourdoughRecipeServiceTestSuite) TestCreate_WithDuplicateName() {
+	createRequest := generateCreateRequest()
+
+	suite.expectTransaction()
+	suite.repository.EXPECT().
+		Create(suite.ctx, gomock.Any()).
+		Return(domain.SourdoughRecipeEntity{}, mongo.WriteException{WriteErrors: mongo.WriteErrors{{Code: 11000}}})
+
+	dto, err := suite.target.Create(suite.ctx, createRequest)
+
+	suite.Equal(internalErrors.SourdoughRecipeAlreadyExists("test recipe"), err)
+	suite.Empty(dto)
+}
+
 func (suite *SourdoughRecipeServiceTestSuite) TestFindById() {
 	entity := domain.SourdoughRecipeEntity{
 		RecipeEntity: domain.RecipeEntity{
@@ -127,44 +333,115 @@ func (suite *SourdoughRecipeServiceTestSuite) TestFindById_WithError() {
 func (suite *SourdoughRecipeServiceTestSuite) TestFind() {
 	entity := domain.SourdoughRecipeEntity{
 		RecipeEntity: domain.RecipeEntity{
-			Id: uuid.New(),
+			Id:   uuid.New(),
+			Tags: []string{"rye"},
 		},
 	}
+	maxHydration := 80.0
 
 	suite.repository.EXPECT().
-		Find(suite.ctx, 10, 0).
-		Return([]domain.SourdoughRecipeEntity{entity}, nil)
+		Find(suite.ctx, domain.SourdoughRecipeFilter{
+			Tags:         []string{"rye", "whole grain"},
+			Categories:   []string{"bread"},
+			MaxHydration: &maxHydration,
+		}, domain.PageRequest{SortField: "name", Offset: 10, Limit: 10}).
+		Return(domain.SourdoughRecipeSearchResult{
+			Recipes: []domain.SourdoughRecipeEntity{entity},
+			Page:    domain.PageInfo{Total: 11},
+			Facets: domain.SourdoughRecipeFacets{
+				Tags:      []domain.FacetCount{{Value: "rye", Count: 1}},
+				Hydration: []domain.HydrationBucket{{Min: 75, Max: &maxHydration, Count: 1}},
+			},
+		}, nil)
 
-	result, err := suite.target.Find(suite.ctx, 10, 0)
+	result, err := suite.target.Find(suite.ctx, domain.SourdoughRecipeFilter{
+		Tags:         []string{" Rye", "whole  GRAIN", "rye", ""},
+		Categories:   []string{"Bread"},
+		MaxHydration: &maxHydration,
+	}, domain.PageParams{Sort: "name", Offset: 10, Limit: 10})
 
 	suite.NoError(err)
-	suite.Equal([]domain.SourdoughRecipeDto{
-		{
-			RecipeDto: domain.RecipeDto{
-				Id:                    entity.Id,
-				Water:                 make([]domain.BakerAmountDto, 0),
-				Flour:                 make([]domain.FlourAmountDto, 0),
-				AdditionalIngredients: make([]domain.BakerAmountDto, 0),
-				NutritionFacts:        make(map[string]domain.NutritionFactsDto),
-			},
-			Levain: domain.SourdoughLevainAgentDto{
-				Flour: make([]domain.FlourAmountDto, 0),
+	suite.Equal(domain.SourdoughRecipeSearchResultDto{
+		Items: []domain.SourdoughRecipeDto{
+			{
+				RecipeDto: domain.RecipeDto{
+					Id:                    entity.Id,
+					Water:                 make([]domain.BakerAmountDto, 0),
+					Flour:                 make([]domain.FlourAmountDto, 0),
+					AdditionalIngredients: make([]domain.BakerAmountDto, 0),
+					NutritionFacts:        make(map[string]domain.NutritionFactsDto),
+					Tags:                  []string{"rye"},
+				},
+				Levain: domain.SourdoughLevainAgentDto{
+					Flour: make([]domain.FlourAmountDto, 0),
+				},
 			},
 		},
+		PageDto: domain.PageDto{Total: 11},
+		Facets: domain.SourdoughRecipeFacetsDto{
+			Tags:       []domain.FacetCountDto{{Value: "rye", Count: 1}},
+			Categories: make([]domain.FacetCountDto, 0),
+			FlourTypes: make([]domain.FacetCountDto, 0),
+			Hydration:  []domain.HydrationBucketDto{{Min: 75, Max: &maxHydration, Count: 1}},
+		},
 	}, result)
 }
 
+func (suite *SourdoughRecipeServiceTestSuite) TestFind_WithInvalidFilter() {
+	low, high := 60.0, 80.0
+	before, after := test.Date, test.Date.Add(time.Hour)
+
+	tests := []struct {
+		name          string
+		filter        domain.SourdoughRecipeFilter
+		expectedError error
+	}{
+		{
+			name:          "hydration range",
+			filter:        domain.SourdoughRecipeFilter{MinHydration: &high, MaxHydration: &low},
+			expectedError: internalErrors.SourdoughRecipeFilterInvalid("min hydration 80.00 must not be greater than max hydration 60.00"),
+		},
+		{
+			name:          "flour range",
+			filter:        domain.SourdoughRecipeFilter{MinFlour: &high, MaxFlour: &low},
+			expectedError: internalErrors.SourdoughRecipeFilterInvalid("min flour 80.00 must not be greater than max flour 60.00"),
+		},
+		{
+			name:   "created range",
+			filter: domain.SourdoughRecipeFilter{CreatedAfter: &after, CreatedBefore: &before},
+			expectedError: internalErrors.SourdoughRecipeFilterInvalid(
+				"created after 2020-01-25T02:01:01Z must not be later than created before 2020-01-25T01:01:01Z"),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			result, err := suite.target.Find(suite.ctx, tt.filter, domain.PageParams{Limit: 25})
+
+			suite.Empty(result)
+			suite.Equal(tt.expectedError, err)
+		})
+	}
+}
+
 func (suite *SourdoughRecipeServiceTestSuite) TestFind_WithError() {
 	suite.repository.EXPECT().
 		Find(suite.ctx, gomock.Any(), gomock.Any()).
-		Return([]domain.SourdoughRecipeEntity{}, assert.AnError)
+		Return(domain.SourdoughRecipeSearchResult{}, assert.AnError)
 
-	result, err := suite.target.Find(suite.ctx, 10, 0)
+	result, err := suite.target.Find(suite.ctx, domain.SourdoughRecipeFilter{}, domain.PageParams{Offset: 10})
 
 	suite.Empty(result)
 	suite.Equal(internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to find recipes"), err)
 }
 
+func (suite *SourdoughRecipeServiceTestSuite) TestFind_WithInvalidPage() {
+	result, err := suite.target.Find(suite.ctx, domain.SourdoughRecipeFilter{}, domain.PageParams{Sort: "flour"})
+
+	suite.Empty(result)
+	suite.Equal(internalErrors.InvalidPageRequest("sort must be one of created, hydration, name, total_weight, updated"), err)
+}
+
 func (suite *SourdoughRecipeServiceTestSuite) TestSearchByName() {
 	entity := domain.SourdoughRecipeEntity{
 		RecipeEntity: domain.RecipeEntity{
@@ -193,12 +470,69 @@ func (suite *SourdoughRecipeServiceTestSuite) TestSearchByName_WithError() {
 	suite.Equal(internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to search recipes by name"), err)
 }
 
-func (suite *SourdoughRecipeServiceTestSuite) TestCalculateRecipeDetails() {
-	service := suite.target.(*sourdoughRecipeService)
+func (suite *SourdoughRecipeServiceTestSuite) TestTextSearch() {
+	entity := domain.SourdoughRecipeEntity{
+		RecipeEntity: domain.RecipeEntity{
+			Id:          uuid.New(),
+			Name:        "Country Rye",
+			Description: "Half wheat, half rye",
+			Tags:        []string{"rye", "whole grain"},
+			Flour: []domain.FlourAmount{
+				{FlourEntity: domain.FlourEntity{Name: "Dark Rye"}, Amount: 500},
+				{FlourEntity: domain.FlourEntity{Name: "Bread Flour"}, Amount: 500},
+			},
+		},
+	}
+
+	suite.repository.EXPECT().
+		TextSearch(suite.ctx, "rye", 0, 10).
+		Return(domain.SourdoughRecipeTextSearchResult{
+			Hits:  []domain.SourdoughRecipeTextSearchHit{{Recipe: entity, Score: 12.5}},
+			Total: 1,
+		}, nil)
+
+	result, err := suite.target.TextSearch(suite.ctx, "rye", 0, 10)
 
+	suite.NoError(err)
+	suite.Equal(domain.SourdoughRecipeTextSearchResultDto{
+		Items: []domain.SourdoughRecipeTextSearchHitDto{
+			{
+				Recipe: entity.ToDto(),
+				Score:  12.5,
+				Highlights: map[string][]string{
+					"name":        {"Country <em>Rye</em>"},
+					"description": {"Half wheat, half <em>rye</em>"},
+					"tags":        {"<em>rye</em>"},
+					"flour":       {"Dark <em>Rye</em>"},
+				},
+			},
+		},
+		Total: 1,
+	}, result)
+}
+
+func (suite *SourdoughRecipeServiceTestSuite) TestTextSearch_WithInvalidQuery() {
+	result, err := suite.target.TextSearch(suite.ctx, "", 0, 10)
+
+	suite.Empty(result)
+	suite.Equal(internalErrors.SearchQueryInvalid("search query is required"), err)
+}
+
+func (suite *SourdoughRecipeServiceTestSuite) TestTextSearch_WithError() {
+	suite.repository.EXPECT().
+		TextSearch(suite.ctx, "rye", 0, 10).
+		Return(domain.SourdoughRecipeTextSearchResult{}, assert.AnError)
+
+	result, err := suite.target.TextSearch(suite.ctx, "rye", 0, 10)
+
+	suite.Empty(result)
+	suite.Equal(internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to search recipes by text"), err)
+}
+
+func (suite *SourdoughRecipeServiceTestSuite) TestCalculateRecipeDetails() {
 	request := generateCreateRequest()
 
-	recipeDetails := service.calculateRecipeDetails(request)
+	recipeDetails := calculateSourdoughRecipeDetails(request)
 
 	suite.Equal(domain.RecipeDetailsDto{
 		Flour: domain.BakerAmountDto{
@@ -222,9 +556,7 @@ func (suite *SourdoughRecipeServiceTestSuite) TestCalculateRecipeDetails() {
 }
 
 func (suite *SourdoughRecipeServiceTestSuite) TestCalculateFlourAmount() {
-	service := suite.target.(*sourdoughRecipeService)
-
-	amount := service.calculateFlourAmount([]domain.FlourAmountDto{
+	amount := calculateFlourAmount([]domain.FlourAmountDto{
 		{Amount: 900},
 		{Amount: 100},
 	})
@@ -236,8 +568,6 @@ func (suite *SourdoughRecipeServiceTestSuite) TestCalculateFlourAmount() {
 }
 
 func (suite *SourdoughRecipeServiceTestSuite) TestCalculateFlourAmount_WithEmptyIngredients_ShouldReturnEmpty() {
-	service := suite.target.(*sourdoughRecipeService)
-
 	tests := []struct {
 		name        string
 		ingredients []domain.FlourAmountDto
@@ -254,7 +584,7 @@ func (suite *SourdoughRecipeServiceTestSuite) TestCalculateFlourAmount_WithEmpty
 
 	for _, tt := range tests {
 		suite.Run(tt.name, func() {
-			amount := service.calculateFlourAmount(tt.ingredients)
+			amount := calculateFlourAmount(tt.ingredients)
 
 			suite.Empty(amount)
 		})
@@ -262,9 +592,7 @@ func (suite *SourdoughRecipeServiceTestSuite) TestCalculateFlourAmount_WithEmpty
 }
 
 func (suite *SourdoughRecipeServiceTestSuite) TestCalculateWaterAmount() {
-	service := suite.target.(*sourdoughRecipeService)
-
-	amount := service.calculateWaterAmount(domain.BakerAmountDto{Amount: 1000}, []domain.BakerAmountDto{
+	amount := calculateWaterAmount(domain.BakerAmountDto{Amount: 1000}, []domain.BakerAmountDto{
 		{Amount: 700},
 		{Amount: 50},
 	})
@@ -276,8 +604,6 @@ func (suite *SourdoughRecipeServiceTestSuite) TestCalculateWaterAmount() {
 }
 
 func (suite *SourdoughRecipeServiceTestSuite) TestCalculateWaterAmount_WithEmptyIngredients_ShouldReturnEmpty() {
-	service := suite.target.(*sourdoughRecipeService)
-
 	tests := []struct {
 		name        string
 		ingredients []domain.BakerAmountDto
@@ -294,7 +620,7 @@ func (suite *SourdoughRecipeServiceTestSuite) TestCalculateWaterAmount_WithEmpty
 
 	for _, tt := range tests {
 		suite.Run(tt.name, func() {
-			amount := service.calculateWaterAmount(domain.BakerAmountDto{Amount: 1000}, tt.ingredients)
+			amount := calculateWaterAmount(domain.BakerAmountDto{Amount: 1000}, tt.ingredients)
 
 			suite.Empty(amount)
 		})
@@ -302,9 +628,7 @@ func (suite *SourdoughRecipeServiceTestSuite) TestCalculateWaterAmount_WithEmpty
 }
 
 func (suite *SourdoughRecipeServiceTestSuite) TestCalculateAdditionalIngredientsAmount() {
-	service := suite.target.(*sourdoughRecipeService)
-
-	amount := service.calculateAdditionalIngredientsAmount(domain.BakerAmountDto{Amount: 1000}, []domain.BakerAmountDto{
+	amount := calculateAdditionalIngredientsAmount(domain.BakerAmountDto{Amount: 1000}, []domain.BakerAmountDto{
 		{Amount: 10},
 		{Amount: 50},
 	})
@@ -316,8 +640,6 @@ func (suite *SourdoughRecipeServiceTestSuite) TestCalculateAdditionalIngredients
 }
 
 func (suite *SourdoughRecipeServiceTestSuite) TestCalculateAdditionalIngredientsAmount_WithEmptyAdditionalIngredients_ShouldReturnEmpty() {
-	service := suite.target.(*sourdoughRecipeService)
-
 	tests := []struct {
 		name        string
 		ingredients []domain.BakerAmountDto
@@ -334,7 +656,7 @@ func (suite *SourdoughRecipeServiceTestSuite) TestCalculateAdditionalIngredients
 
 	for _, tt := range tests {
 		suite.Run(tt.name, func() {
-			amount := service.calculateAdditionalIngredientsAmount(domain.BakerAmountDto{Amount: 1000}, tt.ingredients)
+			amount := calculateAdditionalIngredientsAmount(domain.BakerAmountDto{Amount: 1000}, tt.ingredients)
 
 			suite.Empty(amount)
 		})
@@ -342,11 +664,394 @@ func (suite *SourdoughRecipeServiceTestSuite) TestCalculateAdditionalIngredients
 }
 
 func (suite *SourdoughRecipeServiceTestSuite) TestCalculateTotalWeight() {
+	totalWeight := calculateTotalWeight(domain.BakerAmountDto{Amount: 100}, domain.BakerAmountDto{Amount: 13.33}, domain.BakerAmountDto{Amount: 99.99}, domain.BakerAmountDto{Amount: 150})
+
+	suite.Equal(363, totalWeight)
+}
+
+func (suite *SourdoughRecipeServiceTestSuite) TestFork() {
 	service := suite.target.(*sourdoughRecipeService)
 
-	totalWeight := service.calculateTotalWeight(domain.BakerAmountDto{Amount: 100}, domain.BakerAmountDto{Amount: 13.33}, domain.BakerAmountDto{Amount: 99.99}, domain.BakerAmountDto{Amount: 150})
+	grandparentId := uuid.New()
+	parent := service.toNewRecipe(generateCreateRequest())
+	parent.Version = 3
+	parent.ParentId = &grandparentId
+	parent.Ancestors = []uuid.UUID{grandparentId}
+
+	ryeId := uuid.New()
+	hydration := 80.0
+	request := domain.ForkSourdoughRecipeRequest{
+		Name: "test recipe with rye",
+		FlourSubstitutions: []domain.FlourSubstitutionDto{
+			{
+				FromFlourId: test.FirstId,
+				ToFlourId:   ryeId,
+				Percentage:  20,
+			},
+		},
+		Hydration: &hydration,
+	}
 
-	suite.Equal(363, totalWeight)
+	suite.repository.EXPECT().
+		GetById(suite.ctx, parent.Id).
+		Return(parent, nil)
+	suite.flourRepository.EXPECT().
+		FindById(suite.ctx, ryeId).
+		Return(domain.FlourEntity{Id: ryeId, Name: "rye"}, nil)
+	suite.flourRepository.EXPECT().
+		FindById(suite.ctx, test.FirstId).
+		Return(domain.FlourEntity{}, mongo.ErrNoDocuments)
+	suite.expectTransaction()
+	suite.repository.EXPECT().
+		Create(suite.ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, entity domain.SourdoughRecipeEntity) (domain.SourdoughRecipeEntity, error) {
+			return entity, nil
+		})
+	suite.revisionRepository.EXPECT().
+		Create(suite.ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, revision domain.SourdoughRecipeRevisionEntity) (domain.SourdoughRecipeRevisionEntity, error) {
+			return revision, nil
+		})
+
+	dto, err := suite.target.Fork(suite.ctx, parent.Id, request)
+
+	suite.Require().NoError(err)
+	suite.NotEqual(parent.Id, dto.Id)
+	suite.Equal("test recipe with rye", dto.Name)
+	suite.Equal(parent.Description, dto.Description)
+	suite.Equal(1, dto.Version)
+	suite.Equal(&parent.Id, dto.ParentId)
+	suite.Equal([]uuid.UUID{grandparentId, parent.Id}, dto.Ancestors)
+
+	// 20% of the first flour, in the dough and in the levain
+	suite.Require().Len(dto.Flour, 3)
+	suite.Equal(test.FirstId, dto.Flour[0].Id)
+	suite.Equal(720.0, dto.Flour[0].Amount)
+	suite.Equal(ryeId, dto.Flour[1].Id)
+	suite.Equal(180.0, dto.Flour[1].Amount)
+	suite.Equal(test.SecondId, dto.Flour[2].Id)
+	suite.Equal(100.0, dto.Flour[2].Amount)
+
+	suite.Require().Len(dto.Levain.Flour, 3)
+	suite.Equal(36.0, dto.Levain.Flour[0].Amount)
+	suite.Equal(ryeId, dto.Levain.Flour[1].Id)
+	suite.Equal(9.0, dto.Levain.Flour[1].Amount)
+	suite.Equal(45.0, dto.Levain.Flour[2].Amount)
+
+	suite.Require().Len(dto.Water, 2)
+	suite.InDelta(746.67, dto.Water[0].Amount, 0.01)
+	suite.InDelta(74.67, dto.Water[0].BakerPercentage, 0.01)
+	suite.InDelta(53.33, dto.Water[1].Amount, 0.01)
+	suite.InDelta(5.33, dto.Water[1].BakerPercentage, 0.01)
+
+	suite.Equal(1000.0, dto.Details.Flour.Amount)
+	suite.InDelta(800, dto.Details.Water.Amount, 0.01)
+	suite.InDelta(80, dto.Details.Water.BakerPercentage, 0.01)
+}
+
+func (suite *SourdoughRecipeServiceTestSuite) TestFork_WithFullSubstitution_ShouldRemoveSourceFlour() {
+	service := suite.target.(*sourdoughRecipeService)
+
+	parent := service.toNewRecipe(generateCreateRequest())
+	request := domain.ForkSourdoughRecipeRequest{
+		Name: "test recipe without whole wheat",
+		FlourSubstitutions: []domain.FlourSubstitutionDto{
+			{
+				FromFlourId: test.SecondId,
+				ToFlourId:   test.FirstId,
+				Percentage:  100,
+			},
+		},
+	}
+
+	suite.repository.EXPECT().
+		GetById(suite.ctx, parent.Id).
+		Return(parent, nil)
+	suite.flourRepository.EXPECT().
+		FindById(suite.ctx, test.FirstId).
+		Return(domain.FlourEntity{Id: test.FirstId, Name: "bread flour"}, nil)
+	suite.flourRepository.EXPECT().
+		FindById(suite.ctx, test.SecondId).
+		Return(domain.FlourEntity{}, mongo.ErrNoDocuments)
+	suite.expectTransaction()
+	suite.repository.EXPECT().
+		Create(suite.ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, entity domain.SourdoughRecipeEntity) (domain.SourdoughRecipeEntity, error) {
+			return entity, nil
+		})
+	suite.revisionRepository.EXPECT().
+		Create(suite.ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, revision domain.SourdoughRecipeRevisionEntity) (domain.SourdoughRecipeRevisionEntity, error) {
+			return revision, nil
+		})
+
+	dto, err := suite.target.Fork(suite.ctx, parent.Id, request)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(dto.Flour, 1)
+	suite.Equal(test.FirstId, dto.Flour[0].Id)
+	suite.Equal(1000.0, dto.Flour[0].Amount)
+	suite.Require().Len(dto.Levain.Flour, 1)
+	suite.Equal(90.0, dto.Levain.Flour[0].Amount)
+	// both flours absorb the default, the water stays
+	suite.Equal(parent.ToDto().Water, dto.Water)
+	suite.Equal([]uuid.UUID{parent.Id}, dto.Ancestors)
+}
+
+func (suite *SourdoughRecipeServiceTestSuite) TestFork_WithSubstitution_ShouldRebalanceWater() {
+	service := suite.target.(*sourdoughRecipeService)
+
+	parent := service.toNewRecipe(generateCreateRequest())
+	ryeId := uuid.New()
+	request := domain.ForkSourdoughRecipeRequest{
+		Name: "test recipe with rye",
+		FlourSubstitutions: []domain.FlourSubstitutionDto{
+			{
+				FromFlourId: test.SecondId,
+				ToFlourId:   ryeId,
+				Percentage:  100,
+			},
+		},
+	}
+
+	suite.repository.EXPECT().
+		GetById(suite.ctx, parent.Id).
+		Return(parent, nil)
+	suite.flourRepository.EXPECT().
+		FindById(suite.ctx, ryeId).
+		Return(domain.FlourEntity{Id: ryeId, Name: "rye", SuggestedAbsorption: 75}, nil)
+	suite.flourRepository.EXPECT().
+		FindById(suite.ctx, test.SecondId).
+		Return(domain.FlourEntity{}, mongo.ErrNoDocuments)
+	suite.expectTransaction()
+	suite.repository.EXPECT().
+		Create(suite.ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, entity domain.SourdoughRecipeEntity) (domain.SourdoughRecipeEntity, error) {
+			return entity, nil
+		})
+	suite.revisionRepository.EXPECT().
+		Create(suite.ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, revision domain.SourdoughRecipeRevisionEntity) (domain.SourdoughRecipeRevisionEntity, error) {
+			return revision, nil
+		})
+
+	dto, err := suite.target.Fork(suite.ctx, parent.Id, request)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(dto.Flour, 2)
+	suite.Equal(ryeId, dto.Flour[1].Id)
+	suite.Equal(100.0, dto.Flour[1].Amount)
+	suite.Require().Len(dto.Levain.Flour, 2)
+	suite.Equal(ryeId, dto.Levain.Flour[1].Id)
+	suite.Equal(45.0, dto.Levain.Flour[1].Amount)
+
+	// 145 g of flour absorbing 10% more take 14.5 g more water
+	suite.Require().Len(dto.Water, 2)
+	suite.InDelta(713.53, dto.Water[0].Amount, 0.01)
+	suite.InDelta(50.97, dto.Water[1].Amount, 0.01)
+	suite.InDelta(764.5, dto.Details.Water.Amount, 0.01)
+}
+
+func (suite *SourdoughRecipeServiceTestSuite) TestFork_WithError() {
+	service := suite.target.(*sourdoughRecipeService)
+
+	parent := service.toNewRecipe(generateCreateRequest())
+	missingFlourId := uuid.New()
+	invalidHydration := 0.0
+
+	tests := []struct {
+		name          string
+		request       domain.ForkSourdoughRecipeRequest
+		expectedError error
+	}{
+		{
+			name:          "without name",
+			request:       domain.ForkSourdoughRecipeRequest{},
+			expectedError: internalErrors.SourdoughRecipeForkNameRequired(),
+		},
+		{
+			name: "with unknown source flour",
+			request: domain.ForkSourdoughRecipeRequest{
+				Name: "fork",
+				FlourSubstitutions: []domain.FlourSubstitutionDto{
+					{FromFlourId: missingFlourId, ToFlourId: test.FirstId, Percentage: 10},
+				},
+			},
+			expectedError: internalErrors.SourdoughRecipeForkFlourNotFound(missingFlourId),
+		},
+		{
+			name: "with invalid percentage",
+			request: domain.ForkSourdoughRecipeRequest{
+				Name: "fork",
+				FlourSubstitutions: []domain.FlourSubstitutionDto{
+					{FromFlourId: test.FirstId, Percentage: 0},
+				},
+			},
+			expectedError: internalErrors.SourdoughRecipeForkInvalidSubstitution("percentage 0.00 must be greater than 0 and at most 100"),
+		},
+		{
+			name: "with substitution by the same flour",
+			request: domain.ForkSourdoughRecipeRequest{
+				Name: "fork",
+				FlourSubstitutions: []domain.FlourSubstitutionDto{
+					{FromFlourId: test.SecondId, ToFlourId: test.SecondId, Percentage: 20},
+				},
+			},
+			expectedError: internalErrors.SourdoughRecipeForkInvalidSubstitution(
+				fmt.Sprintf("flour %s cannot be substituted with itself", test.SecondId.String())),
+		},
+		{
+			name: "with invalid hydration",
+			request: domain.ForkSourdoughRecipeRequest{
+				Name:      "fork",
+				Hydration: &invalidHydration,
+			},
+			expectedError: internalErrors.SourdoughRecipeForkInvalidHydration(0),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.repository.EXPECT().
+				GetById(suite.ctx, parent.Id).
+				Return(parent, nil).
+				MaxTimes(1)
+
+			dto, err := suite.target.Fork(suite.ctx, parent.Id, tt.request)
+
+			suite.Equal(tt.expectedError, err)
+			suite.Empty(dto)
+		})
+	}
+}
+
+func (suite *SourdoughRecipeServiceTestSuite) TestFork_WithTargetFlourNotInCatalogue() {
+	service := suite.target.(*sourdoughRecipeService)
+
+	parent := service.toNewRecipe(generateCreateRequest())
+	tests := []struct {
+		name      string
+		toFlourId uuid.UUID
+	}{
+		{name: "unknown flour", toFlourId: uuid.New()},
+		{name: "nil id", toFlourId: uuid.Nil},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.repository.EXPECT().
+				GetById(suite.ctx, parent.Id).
+				Return(parent, nil)
+			suite.flourRepository.EXPECT().
+				FindById(suite.ctx, tt.toFlourId).
+				Return(domain.FlourEntity{}, mongo.ErrNoDocuments)
+
+			dto, err := suite.target.Fork(suite.ctx, parent.Id, domain.ForkSourdoughRecipeRequest{
+				Name: "fork",
+				FlourSubstitutions: []domain.FlourSubstitutionDto{
+					{FromFlourId: test.FirstId, ToFlourId: tt.toFlourId, Percentage: 20},
+				},
+			})
+
+			suite.Equal(internalErrors.FlourByIdNotFound(tt.toFlourId), err)
+			suite.Empty(dto)
+		})
+	}
+}
+
+func (suite *SourdoughRecipeServiceTestSuite) TestFork_WithParentNotFound() {
+	id := uuid.New()
+
+	suite.repository.EXPECT().
+		GetById(suite.ctx, id).
+		Return(domain.SourdoughRecipeEntity{}, mongo.ErrNoDocuments)
+
+	dto, err := suite.target.Fork(suite.ctx, id, domain.ForkSourdoughRecipeRequest{Name: "fork"})
+
+	suite.Equal(internalErrors.SourdoughRecipeNotFound(fmt.Sprintf("recipe with id %s not found", id.String())), err)
+	suite.Empty(dto)
+}
+
+func (suite *SourdoughRecipeServiceTestSuite) TestFamilyTree() {
+	root := generateFamilyMember("country loaf", nil)
+	rye := generateFamilyMember("country loaf with rye", &root)
+	spelt := generateFamilyMember("country loaf with spelt", &root)
+	darkRye := generateFamilyMember("dark rye", &rye)
+
+	suite.repository.EXPECT().
+		GetById(suite.ctx, darkRye.Id).
+		Return(darkRye, nil)
+	suite.repository.EXPECT().
+		FindFamily(suite.ctx, root.Id).
+		Return([]domain.SourdoughRecipeEntity{root, rye, spelt, darkRye}, nil)
+
+	familyTree, err := suite.target.FamilyTree(suite.ctx, darkRye.Id)
+
+	suite.NoError(err)
+	suite.Equal(domain.SourdoughRecipeFamilyTreeDto{
+		Id:        root.Id,
+		Name:      root.Name,
+		Version:   1,
+		CreatedAt: test.Date,
+		Children: []domain.SourdoughRecipeFamilyTreeDto{
+			{
+				Id:        rye.Id,
+				Name:      rye.Name,
+				Version:   1,
+				CreatedAt: test.Date,
+				Children: []domain.SourdoughRecipeFamilyTreeDto{
+					{
+						Id:        darkRye.Id,
+						Name:      darkRye.Name,
+						Version:   1,
+						CreatedAt: test.Date,
+						Children:  []domain.SourdoughRecipeFamilyTreeDto{},
+					},
+				},
+			},
+			{
+				Id:        spelt.Id,
+				Name:      spelt.Name,
+				Version:   1,
+				CreatedAt: test.Date,
+				Children:  []domain.SourdoughRecipeFamilyTreeDto{},
+			},
+		},
+	}, familyTree)
+}
+
+func (suite *SourdoughRecipeServiceTestSuite) TestFamilyTree_WithError() {
+	root := generateFamilyMember("country loaf", nil)
+
+	suite.repository.EXPECT().
+		GetById(suite.ctx, root.Id).
+		Return(root, nil)
+	suite.repository.EXPECT().
+		FindFamily(suite.ctx, root.Id).
+		Return(nil, assert.AnError)
+
+	familyTree, err := suite.target.FamilyTree(suite.ctx, root.Id)
+
+	suite.Equal(internalErrors.NewInternalServerErrorWrap(assert.AnError, "failed to find recipe family"), err)
+	suite.Empty(familyTree)
+}
+
+func generateFamilyMember(name string, parent *domain.SourdoughRecipeEntity) domain.SourdoughRecipeEntity {
+	entity := domain.SourdoughRecipeEntity{
+		RecipeEntity: domain.RecipeEntity{
+			Id:        uuid.New(),
+			Name:      name,
+			CreatedAt: test.Date,
+			Version:   1,
+		},
+	}
+
+	if parent != nil {
+		entity.ParentId = &parent.Id
+		entity.Ancestors = append(append([]uuid.UUID{}, parent.Ancestors...), parent.Id)
+	}
+
+	return entity
 }
 
 func generateCreateRequest() domain.CreateSourdoughRecipeRequest {
@@ -557,6 +1262,7 @@ func createValidDTO(dto domain.SourdoughRecipeDto) domain.SourdoughRecipeDto {
 			},
 			CreatedAt: dto.CreatedAt,
 			UpdatedAt: nil,
+			Version:   dto.Version,
 			Yield: domain.RecipeYieldDto{
 				Unit:   "loaf",
 				Amount: 2,
@@ -611,9 +1317,54 @@ func createValidDTO(dto domain.SourdoughRecipeDto) domain.SourdoughRecipeDto {
 	}
 }
 
-func TestNewSourdoughRecipeService_WithNilRepository(t *testing.T) {
-	service, err := NewSourdoughRecipeService(nil)
+func TestNewSourdoughRecipeService_WithError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	transactionRunner := mocks.NewMockTransactionRunner(mockCtrl)
 
-	assert.Nil(t, service)
-	assert.ErrorContains(t, err, "repository cannot be nil")
+	tests := []struct {
+		name     string
+		creator  func() (domain.SourdoughRecipeService, error)
+		errorMsg string
+	}{
+		{
+			name: "transactionRunner is nil",
+			creator: func() (domain.SourdoughRecipeService, error) {
+				return NewSourdoughRecipeService(nil, nil, nil, nil)
+			},
+			errorMsg: "transactionRunner cannot be nil",
+		},
+		{
+			name: "repository is nil",
+			creator: func() (domain.SourdoughRecipeService, error) {
+				return NewSourdoughRecipeService(transactionRunner, nil, nil, nil)
+			},
+			errorMsg: "repository cannot be nil",
+		},
+		{
+			name: "revisionRepository is nil",
+			creator: func() (domain.SourdoughRecipeService, error) {
+				return NewSourdoughRecipeService(transactionRunner, mocks.NewMockSourdoughRecipeRepository(mockCtrl), nil, nil)
+			},
+			errorMsg: "revisionRepository cannot be nil",
+		},
+		{
+			name: "flourRepository is nil",
+			creator: func() (domain.SourdoughRecipeService, error) {
+				return NewSourdoughRecipeService(transactionRunner, mocks.NewMockSourdoughRecipeRepository(mockCtrl),
+					mocks.NewMockSourdoughRecipeRevisionRepository(mockCtrl), nil)
+			},
+			errorMsg: "flourRepository cannot be nil",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, err := tt.creator()
+
+			assert.Nil(t, service)
+			assert.ErrorContains(t, err, tt.errorMsg)
+		})
+	}
 }
@@ -20,35 +20,88 @@ type MongoDBStarter interface {
 type mongoDBDockerStarter struct {
 	dbContainer *mongodb.MongoDBContainer
 	config      config.Database
+	// standalone starts a server without replica set, which has no transactions
+	standalone bool
 }
 
 func (starter *mongoDBDockerStarter) Start() error {
 	ctx := context.Background()
 
 	image := testcontainers.WithImage("mongo:latest")
-	container, err := mongodb.RunContainer(ctx, image)
+	if starter.standalone {
+		container, err := mongodb.RunContainer(ctx, image)
+		if err != nil {
+			return errors.Wrap(err, "failed to start mongodb container")
+		}
+		return starter.started(ctx, container, "")
+	}
+
+	// transactions need a replica set, a single node one is enough
+	replicaSet := testcontainers.CustomizeRequest(testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{Cmd: []string{"--replSet", "rs0", "--bind_ip_all"}},
+	})
+	container, err := mongodb.RunContainer(ctx, image, replicaSet)
 	if err != nil {
 		return errors.Wrap(err, "failed to start mongodb container")
 	}
 
+	err = initiateReplicaSet(ctx, container)
+	if err != nil {
+		_ = container.Terminate(ctx)
+		return errors.Wrap(err, "failed to initiate mongodb replica set")
+	}
+
+	// the member knows itself by its container address, connect to it directly
+	return starter.started(ctx, container, "/?directConnection=true")
+}
+
+// started keeps the container and the config connecting to it, the options
+// are appended to its connection string.
+func (starter *mongoDBDockerStarter) started(ctx context.Context, container *mongodb.MongoDBContainer, options string) error {
+	uri, err := container.ConnectionString(ctx)
+	if err != nil {
+		_ = container.Terminate(ctx)
+		return errors.Wrap(err, "failed to get mongodb connection string")
+	}
+	uri += options
+
 	starter.dbContainer = container
+	// the config is set once here, suites running in parallel only read it
+	starter.config = config.Database{Uri: uri, ConnectionTimeout: time.Second * 5}
 
 	return nil
 }
 
-func (starter *mongoDBDockerStarter) GetConfig() (config.Database, error) {
-	if starter.dbContainer == nil {
-		return config.Database{}, errors.New("container is not started")
+// initiateReplicaSet starts the replica set of the container and waits until
+// its member is elected primary.
+func initiateReplicaSet(ctx context.Context, container *mongodb.MongoDBContainer) error {
+	exitCode, _, err := container.Exec(ctx, []string{"mongosh", "--quiet", "--eval", "rs.initiate()"})
+	if err != nil {
+		return errors.Wrap(err, "failed to run rs.initiate")
+	}
+	if exitCode != 0 {
+		return errors.Errorf("rs.initiate exited with code %d", exitCode)
 	}
 
-	if starter.config == (config.Database{}) {
-		var err error
-		starter.config.Uri, err = starter.dbContainer.ConnectionString(context.Background())
+	deadline := time.Now().Add(30 * time.Second)
+	for time.Now().Before(deadline) {
+		exitCode, _, err = container.Exec(ctx,
+			[]string{"mongosh", "--quiet", "--eval", "if (!db.hello().isWritablePrimary) quit(1)"})
 		if err != nil {
-			return config.Database{}, err
+			return errors.Wrap(err, "failed to check primary")
 		}
+		if exitCode == 0 {
+			return nil
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
 
-		starter.config.ConnectionTimeout = time.Second * 5
+	return errors.New("replica set member was not elected primary")
+}
+
+func (starter *mongoDBDockerStarter) GetConfig() (config.Database, error) {
+	if starter.dbContainer == nil {
+		return config.Database{}, errors.New("container is not started")
 	}
 
 	return starter.config, nil
@@ -66,3 +119,9 @@ func (starter *mongoDBDockerStarter) Stop() error {
 func NewMongoDBDockerStarter() MongoDBStarter {
 	return &mongoDBDockerStarter{}
 }
+
+// NewStandaloneMongoDBDockerStarter starts a MongoDB without replica set,
+// for tests of deployments that cannot run transactions.
+func NewStandaloneMongoDBDockerStarter() MongoDBStarter {
+	return &mongoDBDockerStarter{standalone: true}
+}
@@ -1,18 +1,30 @@
 package test
 
 import (
+	"strings"
+
+	"github.com/google/uuid"
+
 	"dough-calculator/internal/config"
 )
 
+// MongoDBDockerIntegrationTestSuite gives each suite a database of its own,
+// so that suites of a package run in parallel against one container.
 type MongoDBDockerIntegrationTestSuite struct {
 	ApplicationTestSuite
-	starter MongoDBStarter
+	starter  MongoDBStarter
+	database string
 }
 
 func (suite *MongoDBDockerIntegrationTestSuite) GetConfig() config.Database {
 	dbConfig, err := suite.starter.GetConfig()
 	suite.Require().NoError(err)
 
+	if suite.database == "" {
+		suite.database = "test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	}
+	dbConfig.Name = suite.database
+
 	return dbConfig
 }
 
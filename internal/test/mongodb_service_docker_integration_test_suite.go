@@ -6,37 +6,45 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
+	"dough-calculator/internal/config"
 	"dough-calculator/internal/domain"
 )
 
+// MongoServiceStub resolves database and collection names from the config
+// the way the MongoDB service does.
 type MongoServiceStub struct {
 	client *mongo.Client
+	config config.Database
 }
 
 func (serviceStub *MongoServiceStub) GetClient() (*mongo.Client, error) {
 	return serviceStub.client, nil
 }
 
-func (serviceStub *MongoServiceStub) GetDatabase(name string) (*mongo.Database, error) {
-	database := serviceStub.client.Database(name)
+func (serviceStub *MongoServiceStub) GetDatabase() (*mongo.Database, error) {
+	database := serviceStub.client.Database(serviceStub.config.DatabaseName())
 
 	return database, nil
 }
 
-func (serviceStub *MongoServiceStub) GetCollection(databaseName, collectionName string) (*mongo.Collection, error) {
-	database, err := serviceStub.GetDatabase(databaseName)
+func (serviceStub *MongoServiceStub) GetCollection(name string) (*mongo.Collection, error) {
+	database, err := serviceStub.GetDatabase()
 	if err != nil {
 		return nil, err
 	}
 
-	collection := database.Collection(collectionName)
+	collection := database.Collection(serviceStub.CollectionName(name))
 
 	return collection, nil
 }
 
-func (serviceStub *MongoServiceStub) MustGetCollection(databaseName, collectionName string) *mongo.Collection {
+func (serviceStub *MongoServiceStub) CollectionName(name string) string {
+	return serviceStub.config.CollectionName(name)
+}
+
+func (serviceStub *MongoServiceStub) MustGetCollection(name string) *mongo.Collection {
 	return Must(func() (*mongo.Collection, error) {
-		return serviceStub.GetCollection(databaseName, collectionName)
+		return serviceStub.GetCollection(name)
 	})
 }
 
@@ -66,11 +74,12 @@ func (suite *MongoDBServiceDockerIntegrationTestSuite) SetupSuite() {
 
 	suite.Stub = &MongoServiceStub{
 		client: client,
+		config: config,
 	}
 }
 
-func (suite *MongoDBServiceDockerIntegrationTestSuite) Drop(database, collection string) error {
-	getCollection, err := suite.Stub.GetCollection(database, collection)
+func (suite *MongoDBServiceDockerIntegrationTestSuite) Drop(collection string) error {
+	getCollection, err := suite.Stub.GetCollection(collection)
 	if err != nil {
 		return err
 	}